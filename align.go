@@ -0,0 +1,42 @@
+package fastpfor
+
+import "unsafe"
+
+// NewAlignedUint32Slice returns a zeroed slice of n values whose first element
+// is 16-byte aligned, so the SIMD kernels can work on it in place instead of
+// copying through aligned temporaries. Go only guarantees 4-byte alignment for
+// []uint32, and subslices (e.g. buf[k:]) lose any alignment the allocation had.
+//
+// UnpackUint32 and its WithBuffer/WithLength variants benefit when dst holds at
+// least a full block (128 values): full blocks are unpacked directly into dst and
+// delta blocks are decoded in place.
+//
+// Smaller destinations are replaced by the decoders with freshly allocated
+// slices. The capacity of the returned slice is n, so appending beyond n
+// reallocates and loses the alignment. Panics if n is negative.
+func NewAlignedUint32Slice(n int) []uint32 {
+	if n < 0 {
+		panic("fastpfor: NewAlignedUint32Slice: negative length")
+	}
+	if n == 0 {
+		return []uint32{}
+	}
+	const elemSize = int(unsafe.Sizeof(uint32(0)))
+	storage := make([]uint32, n+16/elemSize-1)
+	base := uintptr(unsafe.Pointer(&storage[0]))
+	start := int(align16(base)-base) / elemSize
+	return storage[start : start+n : start+n]
+}
+
+// alignedScratch returns the 16-byte aligned part of storage as a scratch
+// slice of 2*blockSize values; the second block is exception scratch space.
+func alignedScratch(storage *[2*blockSize + 4]uint32) []uint32 {
+	base := uintptr(unsafe.Pointer(storage))
+	start := int(align16(base)-base) / int(unsafe.Sizeof(uint32(0)))
+	return storage[start : start+2*blockSize]
+}
+
+func align16(ptr uintptr) uintptr {
+	const mask = 16 - 1 // mask to round up to the next 16-byte boundary
+	return (ptr + mask) &^ mask
+}
@@ -0,0 +1,54 @@
+package fastpfor
+
+import (
+	"slices"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNewAlignedUint32Slice verifies alignment, length and capacity.
+func TestNewAlignedUint32Slice(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, n := range []int{1, 3, 4, 127, blockSize, 2 * blockSize, 1000} {
+		s := NewAlignedUint32Slice(n)
+		assert.Len(s, n)
+		assert.Equal(n, cap(s))
+		assert.True(isAligned16(&s[0]), "n=%d", n)
+		for _, v := range s {
+			assert.Zero(v)
+		}
+	}
+
+	assert.Empty(NewAlignedUint32Slice(0))
+	assert.Panics(func() { NewAlignedUint32Slice(-1) })
+
+	// Decoding a full block keeps the aligned destination
+	values := genMixed(blockSize)
+	dst := NewAlignedUint32Slice(blockSize)
+	decoded, err := UnpackUint32(dst[:0], PackDeltaUint32(nil, slices.Clone(values)))
+	assert.NoError(err)
+	assert.Equal(values, decoded)
+	assert.Same(&dst[0], &decoded[0])
+}
+
+func isAligned16(p *uint32) bool {
+	return align16(uintptr(unsafe.Pointer(p))) == uintptr(unsafe.Pointer(p))
+}
+
+func BenchmarkUnpackAlignedDst(b *testing.B) {
+	buf := PackUint32(nil, genSequential(blockSize))
+	for name, dst := range map[string][]uint32{
+		"aligned":   NewAlignedUint32Slice(blockSize),
+		"unaligned": NewAlignedUint32Slice(blockSize + 1)[1:],
+	} {
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				_, _ = UnpackUint32(dst[:0], buf)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package fastpfor
+
+// PackAuto packs up to BlockSize values with whichever of PackUint32,
+// PackFORUint32 (including constant blocks) and PackDeltaUint32 produces the
+// smallest block, preferring them in that order on ties, so blocks keep
+// constant-time random access where that costs nothing. The choice is recorded
+// in the header, so UnpackUint32 and the readers decode the block like any other.
+//
+// Every candidate is encoded, so packing costs about three times as much as with
+// a fixed encoding. Note that delta blocks lose constant-time random access
+// (see GetAt and SlimReader.Get).
+func PackAuto(dst []byte, values []uint32) []byte {
+	e := encoderPool.Get().(*Encoder)
+	defer encoderPool.Put(e)
+	return e.PackAuto(dst, values)
+}
+
+// PackAuto packs up to BlockSize values like PackAuto and appends the smallest
+// block to dst.
+func (e *Encoder) PackAuto(dst []byte, values []uint32) []byte {
+	start := len(dst)
+	dst = e.Pack(dst, values)
+
+	end := len(dst)
+	dst = keepSmaller(e.PackFOR(dst, values), start, end)
+
+	end = len(dst)
+	return keepSmaller(e.PackDelta(dst, values), start, end)
+}
+
+// keepSmaller keeps the smaller of the blocks dst[start:end] and dst[end:],
+// preferring the first one on ties, at dst[start:].
+func keepSmaller(dst []byte, start, end int) []byte {
+	if len(dst)-end >= end-start {
+		return dst[:end]
+	}
+	n := copy(dst[start:], dst[end:])
+	return dst[:start+n]
+}
@@ -0,0 +1,59 @@
+package fastpfor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPackAuto verifies that PackAuto selects the smallest encoding and that
+// the blocks round-trip.
+func TestPackAuto(t *testing.T) {
+	constant := make([]uint32, blockSize)
+	small := make([]uint32, blockSize)
+	for i := range constant {
+		constant[i] = 1 << 30
+		small[i] = 4711
+	}
+	tests := []struct {
+		name     string
+		values   []uint32
+		delta    bool
+		codec    uint32
+		expected func([]byte, []uint32) []byte
+	}{
+		{"small", []uint32{3, 1, 4, 1, 5, 9, 2, 6}, false, codecFastPFOR, PackUint32},
+		{"sorted", genLargeSorted(blockSize), true, codecFastPFOR, PackDeltaUint32},
+		{"clustered", genClustered(blockSize), false, codecFOR, PackFORUint32},
+		{"constant", constant, false, codecConstant, PackFORUint32},
+		// One delta exception is smaller than the base record
+		{"smallConstant", small, true, codecFastPFOR, PackDeltaUint32},
+		{"empty", nil, false, codecFastPFOR, PackUint32},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			prefix := []byte{0xAA, 0xBB}
+			buf := PackAuto(append([]byte(nil), prefix...), tc.values)
+			assert.Equal(prefix, buf[:len(prefix)])
+			buf = buf[len(prefix):]
+			assert.Equal(tc.expected(nil, tc.values), buf)
+			assert.LessOrEqual(len(buf), len(PackUint32(nil, tc.values)))
+			assert.LessOrEqual(len(buf), len(PackDeltaUint32(nil, tc.values)))
+			assert.LessOrEqual(len(buf), len(PackFORUint32(nil, tc.values)))
+
+			header, _, _, err := readHeader(buf)
+			assert.NoError(err)
+			assert.Equal(tc.delta, header&headerDeltaFlag != 0)
+			assert.Equal(tc.codec, blockCodec(buf, header))
+
+			got, err := UnpackUint32(nil, buf)
+			assert.NoError(err)
+			assert.Equal(len(tc.values), len(got))
+			if len(tc.values) > 0 {
+				assert.Equal(tc.values, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,367 @@
+//go:build amd64 && !noasm
+
+package fastpfor
+
+// AVX-512 entry points provided by avx512_amd64.s (generated by internal/avo).
+// The bit packing kernels are only called through the tables returned by
+// packKernelsAVX512, unpackKernelsAVX512 and unpackDeltaKernelsAVX512. Unlike the SSE2 kernels, none of
+// them requires aligned buffers.
+
+//go:noescape
+func deltaDecodeAVX512(dst *uint32, src *uint32, n int)
+
+//go:noescape
+func deltaDecodeWithOverflowAVX512(dst *uint32, src *uint32, n int) uint8
+
+//go:noescape
+func zigzagEncodeAVX512(buf *uint32, n int)
+
+//go:noescape
+func zigzagDecodeAVX512(buf *uint32, n int)
+
+// deltaDecodeAVX512Preferred is deltaDecodeSIMD for the AVX-512 kernels, which
+// decode in place without alignment requirements.
+func deltaDecodeAVX512Preferred(dst, deltas []uint32, useZigZag bool) {
+	n := len(deltas)
+	if n == 0 {
+		return
+	}
+	if n > blockSize {
+		deltaDecodeScalar(dst, deltas, useZigZag)
+		return
+	}
+	if !useZigZag {
+		deltaDecodeAVX512(&dst[0], &deltas[0], n)
+		return
+	}
+	// Zigzag decoding is done in place, so the input is only modified if it is
+	// the output anyway.
+	src := deltas
+	if &dst[0] != &deltas[0] {
+		var tmp [blockSize]uint32
+		src = tmp[:n]
+		copy(src, deltas)
+	}
+	zigzagDecodeAVX512(&src[0], n)
+	deltaDecodeAVX512(&dst[0], &src[0], n)
+}
+
+// deltaDecodeWithOverflowAVX512Preferred is deltaDecodeWithOverflowSIMD for the
+// AVX-512 kernels.
+func deltaDecodeWithOverflowAVX512Preferred(dst, deltas []uint32, useZigZag bool) uint8 {
+	n := len(deltas)
+	if n == 0 {
+		return 0
+	}
+	if n > blockSize {
+		return deltaDecodeWithOverflowScalar(dst, deltas, useZigZag)
+	}
+	// For zigzag, overflow detection doesn't apply (see deltaDecodeWithOverflowSIMD)
+	if useZigZag {
+		deltaDecodeAVX512Preferred(dst, deltas, useZigZag)
+		return 0
+	}
+	return deltaDecodeWithOverflowAVX512(&dst[0], &deltas[0], n)
+}
+
+// zigzagEncodeAVX512Preferred zigzag-encodes buf in place.
+func zigzagEncodeAVX512Preferred(buf []uint32) {
+	if len(buf) > 0 {
+		zigzagEncodeAVX512(&buf[0], len(buf))
+	}
+}
+
+// zigzagDecodeAVX512Preferred decodes the zigzag codes in buf in place.
+func zigzagDecodeAVX512Preferred(buf []uint32) {
+	if len(buf) > 0 {
+		zigzagDecodeAVX512(&buf[0], len(buf))
+	}
+}
+
+//go:noescape
+func pack32AVX512_1(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_2(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_3(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_4(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_5(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_6(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_7(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_8(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_9(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_10(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_11(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_12(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_13(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_14(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_15(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_16(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_17(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_18(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_19(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_20(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_21(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_22(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_23(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_24(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_25(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_26(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_27(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_28(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_29(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_30(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_31(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX512_32(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_1(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_2(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_3(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_4(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_5(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_6(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_7(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_8(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_9(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_10(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_11(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_12(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_13(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_14(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_15(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_16(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_17(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_18(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_19(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_20(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_21(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_22(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_23(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_24(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_25(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_26(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_27(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_28(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_29(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_30(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_31(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX512_32(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_1(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_2(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_3(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_4(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_5(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_6(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_7(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_8(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_9(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_10(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_11(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_12(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_13(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_14(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_15(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_16(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_17(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_18(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_19(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_20(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_21(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_22(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_23(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_24(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_25(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_26(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_27(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_28(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_29(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_30(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_31(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX512_32(in *byte, out uintptr, offset int, seed *byte)
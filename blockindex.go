@@ -0,0 +1,276 @@
+package fastpfor
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// MaxInlineValues is the maximum number of values of a block that
+// NewInlineBlockIndex stores in the index entry instead of the block bytes.
+const MaxInlineValues = 4
+
+// blockIndexInline is set in the flags byte of serialized indexes with inline
+// entries (see BlockIndex.AppendBinary).
+const blockIndexInline = 2
+
+// BlockIndexEntry describes one block of a concatenation of blocks.
+type BlockIndexEntry struct {
+	First  uint32 // first value of the block
+	Last   uint32 // last value of the block
+	Offset int    // byte offset of the block (of the next stored block if inline)
+	Count  int    // number of values in the block
+
+	// Inline holds the values of a block that is stored in the index instead of
+	// the concatenation (see NewInlineBlockIndex), nil otherwise. It aliases the
+	// index and must not be modified.
+	Inline []uint32
+}
+
+// BlockIndex is a skip index over a concatenation of blocks (e.g. a long posting
+// list): it records first value, last value, byte offset and count of every
+// non-empty block, so readers can locate the block holding a value by binary
+// search before touching any payload (see SequenceReader.LoadIndexed).
+//
+// The index is built once with NewBlockIndex, which decodes every block, and can
+// be stored next to the blocks with AppendBinary and restored with ReadBlockIndex.
+type BlockIndex struct {
+	entries []BlockIndexEntry
+	sorted  bool
+	size    int // byte length of the indexed blocks
+}
+
+// NewBlockIndex builds the index of the concatenation of blocks in buf. Every
+// block is decoded once; empty blocks are not indexed. Overflowing delta blocks
+// are indexed with their wrapped values (see UnpackUint32).
+func NewBlockIndex(buf []byte) (*BlockIndex, error) {
+	x := &BlockIndex{sorted: true}
+	var scratch [blockSize]uint32
+	for off, blocks := 0, 1; off < len(buf); blocks++ {
+		if err := checkBlockLimit(blocks); err != nil {
+			return nil, err
+		}
+		values, length, err := UnpackUint32WithLength(scratch[:0], buf[off:])
+		if err != nil {
+			var overflow *ErrOverflow
+			if !errors.As(err, &overflow) {
+				return nil, fmt.Errorf("block at offset %d: %w", off, err)
+			}
+			x.sorted = false
+		}
+		if len(buf)-off < length {
+			return nil, fmt.Errorf("%w: block at offset %d truncated (need %d bytes, got %d)",
+				ErrInvalidBuffer, off, length, len(buf)-off)
+		}
+		if len(values) > 0 {
+			e := BlockIndexEntry{
+				First:  values[0],
+				Last:   values[len(values)-1],
+				Offset: off,
+				Count:  len(values),
+			}
+			if x.sorted && (!isNonDecreasing(values) ||
+				len(x.entries) > 0 && e.First < x.entries[len(x.entries)-1].Last) {
+				x.sorted = false
+			}
+			x.entries = append(x.entries, e)
+		}
+		off += length
+	}
+	x.size = len(buf)
+	return x, nil
+}
+
+// NewInlineBlockIndex builds the index of the concatenation of blocks in buf like
+// NewBlockIndex, but moves the values of blocks with at most MaxInlineValues
+// values into their entries and returns the concatenation without these blocks,
+// which the index describes. For the long tail of tiny lists, this saves the
+// header, payload and decoding of a block per list; SequenceReader.LoadIndexed
+// serves inline blocks straight from the index.
+func NewInlineBlockIndex(buf []byte) (*BlockIndex, []byte, error) {
+	x, err := NewBlockIndex(buf)
+	if err != nil {
+		return nil, nil, err
+	}
+	var scratch [blockSize]uint32
+	out := make([]byte, 0, len(buf))
+	copied, removed := 0, 0
+	for i := range x.entries {
+		e := &x.entries[i]
+		off := e.Offset
+		e.Offset -= removed
+		if e.Count > MaxInlineValues {
+			continue
+		}
+		// NewBlockIndex has validated the block already
+		values, length, err := UnpackUint32WithLength(scratch[:0], buf[off:])
+		var overflow *ErrOverflow
+		if err != nil && !errors.As(err, &overflow) {
+			return nil, nil, err
+		}
+		e.Inline = slices.Clone(values)
+		out = append(out, buf[copied:off]...)
+		copied = off + length
+		removed += length
+	}
+	out = append(out, buf[copied:]...)
+	x.size = len(out)
+	return x, out, nil
+}
+
+// Len returns the number of indexed (non-empty) blocks.
+func (x *BlockIndex) Len() int {
+	return len(x.entries)
+}
+
+// Entry returns the entry of the i-th indexed block.
+func (x *BlockIndex) Entry(i int) BlockIndexEntry {
+	return x.entries[i]
+}
+
+// Size returns the byte length of the indexed concatenation of blocks.
+func (x *BlockIndex) Size() int {
+	return x.size
+}
+
+// IsSorted reports whether all indexed values are non-decreasing across blocks.
+func (x *BlockIndex) IsSorted() bool {
+	return x.sorted
+}
+
+// Search returns the index of the first block whose last value is >= v, or Len()
+// if there is none. The result is only meaningful for sorted indexes.
+func (x *BlockIndex) Search(v uint32) int {
+	return sort.Search(len(x.entries), func(i int) bool {
+		return x.entries[i].Last >= v
+	})
+}
+
+// AppendBinary appends the serialized index to dst:
+//
+//	n       uvarint, number of entries
+//	size    uvarint, byte length of the indexed blocks
+//	flags   1 byte: bit 0 sorted, bit 1 inline entries
+//	entries n times: offset gap to the previous entry (uvarint), count (uvarint),
+//	        first and last value (4 bytes little-endian each)
+//
+// With inline entries, the count is shifted left by one and bit 0 marks an
+// inline entry, whose values between first and last follow (4 bytes
+// little-endian each).
+func (x *BlockIndex) AppendBinary(dst []byte) []byte {
+	flags := byte(b2u(x.sorted))
+	inline := slices.ContainsFunc(x.entries, func(e BlockIndexEntry) bool { return e.Inline != nil })
+	if inline {
+		flags |= blockIndexInline
+	}
+	dst = binary.AppendUvarint(dst, uint64(len(x.entries)))
+	dst = binary.AppendUvarint(dst, uint64(x.size))
+	dst = append(dst, flags)
+	prev := 0
+	for _, e := range x.entries {
+		dst = binary.AppendUvarint(dst, uint64(e.Offset-prev))
+		if inline {
+			dst = binary.AppendUvarint(dst, uint64(e.Count)<<1|uint64(b2u(e.Inline != nil)))
+		} else {
+			dst = binary.AppendUvarint(dst, uint64(e.Count))
+		}
+		dst = bo.AppendUint32(dst, e.First)
+		dst = bo.AppendUint32(dst, e.Last)
+		if e.Inline != nil && e.Count > 2 {
+			for _, v := range e.Inline[1 : e.Count-1] {
+				dst = bo.AppendUint32(dst, v)
+			}
+		}
+		prev = e.Offset
+	}
+	return dst
+}
+
+// ReadBlockIndex restores an index serialized with AppendBinary and returns the
+// number of bytes consumed from buf.
+func ReadBlockIndex(buf []byte) (*BlockIndex, int, error) {
+	errTruncated := fmt.Errorf("%w: block index truncated", ErrInvalidBuffer)
+	n, off := binary.Uvarint(buf)
+	if off <= 0 {
+		return nil, 0, errTruncated
+	}
+	size, k := binary.Uvarint(buf[off:])
+	if k <= 0 || off+k >= len(buf) {
+		return nil, 0, errTruncated
+	}
+	off += k
+	flags := buf[off]
+	off++
+	// Every entry needs at least 10 bytes, reject bogus counts before allocating
+	if flags > 1|blockIndexInline || n > uint64(len(buf)-off)/10 || size > uint64(^uint(0)>>1) {
+		return nil, 0, fmt.Errorf("%w: invalid block index header", ErrInvalidBuffer)
+	}
+	if err := checkBlockLimit(int(n)); err != nil {
+		return nil, 0, err
+	}
+
+	x := &BlockIndex{
+		entries: make([]BlockIndexEntry, n),
+		sorted:  flags&1 != 0,
+		size:    int(size),
+	}
+	prev, stored := 0, -1 // offset of the previous and the previous stored entry
+	for i := range x.entries {
+		gap, k := binary.Uvarint(buf[off:])
+		if k <= 0 {
+			return nil, 0, errTruncated
+		}
+		off += k
+		count, k := binary.Uvarint(buf[off:])
+		if k <= 0 || len(buf)-off-k < 8 {
+			return nil, 0, errTruncated
+		}
+		off += k
+		inline := false
+		if flags&blockIndexInline != 0 {
+			inline = count&1 != 0
+			count >>= 1
+		}
+		offset := uint64(prev) + gap
+		invalid := count == 0 || count > blockSize || offset > size
+		if inline {
+			invalid = invalid || count > MaxInlineValues
+		} else {
+			invalid = invalid || offset+headerBytes > size || int(offset) <= stored
+		}
+		if invalid {
+			return nil, 0, fmt.Errorf("%w: invalid block index entry %d", ErrInvalidBuffer, i)
+		}
+		e := BlockIndexEntry{
+			First:  bo.Uint32(buf[off:]),
+			Last:   bo.Uint32(buf[off+4:]),
+			Offset: int(offset),
+			Count:  int(count),
+		}
+		off += 8
+		if inline {
+			if len(buf)-off < 4*(e.Count-2) {
+				return nil, 0, errTruncated
+			}
+			e.Inline = make([]uint32, 0, e.Count)
+			e.Inline = append(e.Inline, e.First)
+			for range e.Count - 2 {
+				e.Inline = append(e.Inline, bo.Uint32(buf[off:]))
+				off += 4
+			}
+			if e.Count > 1 {
+				e.Inline = append(e.Inline, e.Last)
+			}
+			if e.Count == 1 && e.First != e.Last || x.sorted && !isNonDecreasing(e.Inline) {
+				return nil, 0, fmt.Errorf("%w: invalid block index entry %d", ErrInvalidBuffer, i)
+			}
+		} else {
+			stored = int(offset)
+		}
+		x.entries[i] = e
+		prev = int(offset)
+	}
+	return x, off, nil
+}
@@ -0,0 +1,260 @@
+package fastpfor
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockIndex(t *testing.T) {
+	assert := assert.New(t)
+
+	sorted := genMonotonic(1000)
+	buf := PackDeltaUint32(nil, nil) // empty blocks are not indexed
+	buf = append(buf, sequenceBlocks(sorted, PackDeltaUint32)...)
+
+	idx, err := NewBlockIndex(buf)
+	assert.NoError(err)
+	assert.Equal(8, idx.Len())
+	assert.Equal(len(buf), idx.Size())
+	assert.True(idx.IsSorted())
+	for i := range idx.Len() {
+		e := idx.Entry(i)
+		assert.Equal(sorted[i*blockSize], e.First)
+		assert.Equal(sorted[min((i+1)*blockSize, len(sorted))-1], e.Last)
+		assert.Equal(min(blockSize, len(sorted)-i*blockSize), e.Count)
+		values, err := UnpackUint32(nil, buf[e.Offset:])
+		assert.NoError(err)
+		assert.Equal(e.Count, len(values))
+	}
+	assert.Equal(0, idx.Search(0))
+	assert.Equal(1, idx.Search(idx.Entry(0).Last+1))
+	assert.Equal(idx.Len(), idx.Search(sorted[len(sorted)-1]+1))
+
+	// Round-trip through the serialized form
+	data := idx.AppendBinary([]byte{0xAA})
+	restored, n, err := ReadBlockIndex(append(data[1:], 0xFF))
+	assert.NoError(err)
+	assert.Equal(len(data)-1, n)
+	assert.Equal(idx, restored)
+
+	t.Run("unsorted", func(t *testing.T) {
+		buf := PackDeltaUint32(nil, []uint32{10, 20})
+		buf = PackDeltaUint32(buf, []uint32{15, 30}) // overlaps the previous block
+		idx, err := NewBlockIndex(buf)
+		assert.NoError(err)
+		assert.False(idx.IsSorted())
+
+		idx, err = NewBlockIndex(PackUint32(nil, []uint32{3, 1}))
+		assert.NoError(err)
+		assert.False(idx.IsSorted())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := NewBlockIndex(buf[:len(buf)-1])
+		assert.ErrorIs(err, ErrInvalidBuffer)
+		for _, cut := range []int{0, 1, 2, 3, len(data) - 2} {
+			_, _, err := ReadBlockIndex(data[1:][:cut])
+			assert.ErrorIs(err, ErrInvalidBuffer, "cut=%d", cut)
+		}
+	})
+}
+
+func TestSequenceReaderLoadIndexed(t *testing.T) {
+	assert := assert.New(t)
+	rng := rand.New(rand.NewSource(11))
+
+	for name, values := range map[string][]uint32{
+		"sorted":   genMonotonic(2000),
+		"unsorted": genMixed(500),
+	} {
+		buf := sequenceBlocks(values, PackDeltaUint32)
+		idx, err := NewBlockIndex(buf)
+		assert.NoError(err)
+		r := NewSequenceReader()
+		assert.NoError(r.LoadIndexed(buf, idx), name)
+		assert.Equal(len(values), r.Len())
+		assert.Equal(idx.IsSorted(), r.IsSorted())
+
+		maxV := values[0]
+		for _, v := range values {
+			maxV = max(maxV, v)
+		}
+		for range 50 {
+			r.Reset()
+			from := 0
+			for range 5 {
+				req := uint32(rng.Intn(int(maxV) + 2))
+				wantPos, wantOK := skipToScan(values, from, req)
+				v, pos, ok := r.SkipTo(req)
+				assert.Equal(wantOK, ok, "%s SkipTo(%d) from %d", name, req, from)
+				if !wantOK {
+					break
+				}
+				assert.Equal(wantPos, pos, "%s SkipTo(%d) from %d", name, req, from)
+				assert.Equal(values[wantPos], v)
+				from = pos + 1
+			}
+		}
+	}
+
+	t.Run("mismatch", func(t *testing.T) {
+		buf := sequenceBlocks(genMonotonic(300), PackDeltaUint32)
+		idx, err := NewBlockIndex(buf)
+		assert.NoError(err)
+		r := NewSequenceReader()
+		assert.ErrorIs(r.LoadIndexed(buf[:10], idx), ErrInvalidBuffer)
+
+		other := sequenceBlocks(genMonotonic(200), PackDeltaUint32)
+		other = append(other, make([]byte, len(buf)-len(other))...)
+		assert.NoError(r.LoadIndexed(other, idx))
+		_, err = r.Get(299)
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+}
+
+func TestInlineBlockIndex(t *testing.T) {
+	assert := assert.New(t)
+
+	// Tiny blocks, including single values, between larger ones
+	var values []uint32
+	var buf []byte
+	for _, n := range []int{1, 100, 4, 2, 3, blockSize, 1} {
+		block := genMonotonic(n)
+		for i := range block {
+			block[i] += uint32(len(values)) * 1000
+		}
+		values = append(values, block...)
+		buf = PackDeltaUint32(buf, slices.Clone(block))
+	}
+
+	idx, compact, err := NewInlineBlockIndex(buf)
+	assert.NoError(err)
+	assert.Equal(len(compact), idx.Size())
+	assert.Less(len(compact), len(buf))
+	inline := 0
+	for i := range idx.Len() {
+		e := idx.Entry(i)
+		if e.Count > MaxInlineValues {
+			assert.Nil(e.Inline)
+			decoded, err := UnpackUint32(nil, compact[e.Offset:])
+			assert.NoError(err)
+			assert.Equal(e.Count, len(decoded))
+			continue
+		}
+		inline++
+		assert.Len(e.Inline, e.Count)
+		assert.Equal(e.First, e.Inline[0])
+		assert.Equal(e.Last, e.Inline[e.Count-1])
+	}
+	assert.Equal(5, inline)
+
+	data := idx.AppendBinary(nil)
+	restored, n, err := ReadBlockIndex(data)
+	assert.NoError(err)
+	assert.Equal(len(data), n)
+	assert.Equal(idx, restored)
+
+	r := NewSequenceReader()
+	assert.NoError(r.LoadIndexed(compact, restored))
+	assert.Equal(len(values), r.Len())
+	for pos, want := range values {
+		v, err := r.Get(pos)
+		assert.NoError(err)
+		assert.Equal(want, v, "pos=%d", pos)
+	}
+	r.Reset()
+	for pos, want := range values {
+		v, got, ok := r.Next()
+		assert.True(ok)
+		assert.Equal(pos, got)
+		assert.Equal(want, v)
+	}
+
+	t.Run("onlyInline", func(t *testing.T) {
+		buf := PackUint32(nil, []uint32{7, 3})
+		buf = PackUint32(buf, []uint32{9})
+		idx, compact, err := NewInlineBlockIndex(buf)
+		assert.NoError(err)
+		assert.Empty(compact)
+		restored, _, err := ReadBlockIndex(idx.AppendBinary(nil))
+		assert.NoError(err)
+		assert.NoError(r.LoadIndexed(compact, restored))
+		assert.Equal(3, r.Len())
+		v, pos, ok := r.SkipTo(8)
+		assert.True(ok)
+		assert.Equal(2, pos)
+		assert.Equal(uint32(9), v)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		idx, _, err := NewInlineBlockIndex(PackDeltaUint32(nil, []uint32{1, 2, 3}))
+		assert.NoError(err)
+		data := idx.AppendBinary(nil)
+		// n=1, size=0, flags, gap=0, count=3<<1|1, first, last, middle
+		assert.Equal(byte(1|blockIndexInline), data[2])
+		for name, corrupt := range map[string]func([]byte){
+			"tooMany":  func(b []byte) { b[4] = (MaxInlineValues+1)<<1 | 1 },
+			"unsorted": func(b []byte) { b[13] = 9 },
+			"flags":    func(b []byte) { b[2] = 4 },
+		} {
+			b := slices.Clone(data)
+			corrupt(b)
+			_, _, err := ReadBlockIndex(b)
+			assert.ErrorIs(err, ErrInvalidBuffer, name)
+		}
+		_, _, err = ReadBlockIndex(data[:len(data)-1])
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+}
+
+func BenchmarkSequenceReaderSkipToIndexed(b *testing.B) {
+	values := genMonotonic(64 * blockSize)
+	buf := sequenceBlocks(values, PackDeltaUint32)
+	idx, _ := NewBlockIndex(buf)
+	r := NewSequenceReader()
+	_ = r.LoadIndexed(buf, idx)
+	step := values[len(values)-1] / 32
+	b.ReportAllocs()
+	for range b.N {
+		r.Reset()
+		for req := uint32(0); ; req += step {
+			if _, _, ok := r.SkipTo(req); !ok {
+				break
+			}
+		}
+	}
+}
+
+// FuzzSequenceReaderIndexed mutates serialized indexes together with their
+// blocks, so the index may disagree with the blocks it describes. Reading must
+// never panic and either succeed consistently or return typed errors.
+func FuzzSequenceReaderIndexed(f *testing.F) {
+	for _, buf := range [][]byte{
+		sequenceBlocks(genMonotonic(3*blockSize+5), PackDeltaUint32),
+		sequenceBlocks(genMixed(blockSize+1), PackUint32),
+	} {
+		idx, _ := NewBlockIndex(buf)
+		f.Add(append(idx.AppendBinary(nil), buf...))
+	}
+	buf := PackDeltaUint32(nil, []uint32{1, 2, 3})
+	buf = append(buf, sequenceBlocks(genMonotonic(blockSize+1), PackDeltaUint32)...)
+	idx, compact, _ := NewInlineBlockIndex(buf)
+	f.Add(append(idx.AppendBinary(nil), compact...))
+
+	r := NewSequenceReader()
+	f.Fuzz(func(t *testing.T, data []byte) {
+		idx, n, err := ReadBlockIndex(data)
+		if err != nil {
+			assertTypedError(t, err)
+			return
+		}
+		if err := r.LoadIndexed(data[n:], idx); err != nil {
+			assertTypedError(t, err)
+			return
+		}
+		assertSequenceConsistent(t, r)
+	})
+}
@@ -0,0 +1,114 @@
+package fastpfor
+
+import "fmt"
+
+// ValueRange is the smallest and the largest value of a block. It is stored in
+// an optional 8-byte record (Min and Max as little-endian uint32) between the
+// header (and its extension) and the checksum and provenance records, so readers can skip
+// blocks for range predicates without decoding the payload (see BlockMayContain).
+type ValueRange struct {
+	Min, Max uint32
+}
+
+// SetBlockRange appends a copy of the block at the start of buf to dst, with a
+// range record holding the smallest and the largest decoded value (an existing
+// record is replaced). The block can be produced by any Pack function for
+// unsigned integers; int32 and float blocks return ErrInvalidFlags, as their
+// stored codes do not preserve the value order.
+func SetBlockRange(dst, buf []byte) ([]byte, error) {
+	header, _, _, err := readHeader(buf)
+	if err != nil {
+		return dst, err
+	}
+	if header&(headerSignedFlag|headerFloatFlag|headerFloat64Flag) != 0 {
+		return dst, fmt.Errorf("%w: range records require unsigned values", ErrInvalidFlags)
+	}
+	var scratch [blockSize]uint32
+	values, err := UnpackUint32(scratch[:0], buf)
+	if err != nil {
+		return dst, err
+	}
+	var r ValueRange
+	if len(values) > 0 {
+		r = ValueRange{Min: values[0], Max: values[0]}
+		for _, v := range values[1:] {
+			r.Min = min(r.Min, v)
+			r.Max = max(r.Max, v)
+		}
+	}
+	return rewriteRange(dst, buf, &r)
+}
+
+// StripBlockRange appends a copy of the block at the start of buf to dst without
+// its range record. Blocks without a record are copied as-is.
+func StripBlockRange(dst, buf []byte) ([]byte, error) {
+	return rewriteRange(dst, buf, nil)
+}
+
+// BlockMayContain reports whether the block at the start of buf may hold a value
+// v with lo <= v <= hi. Only the header and the range record are read: blocks
+// without a range record always report true, empty blocks always false.
+func BlockMayContain(buf []byte, lo, hi uint32) (bool, error) {
+	header, count, payloadStart, err := readHeader(buf)
+	if err != nil {
+		return false, err
+	}
+	if count == 0 || lo > hi {
+		return false, nil
+	}
+	if header&headerRangeFlag == 0 {
+		return true, nil
+	}
+	r := decodeRange(buf[rangeStart(header, payloadStart):])
+	return r.Min <= hi && lo <= r.Max, nil
+}
+
+// rewriteRange copies the block in buf to dst, replacing its range record with r,
+// or removing it if r is nil.
+func rewriteRange(dst, buf []byte, r *ValueRange) ([]byte, error) {
+	header, _, payloadStart, err := readHeader(buf)
+	if err != nil {
+		return dst, err
+	}
+	length, err := BlockLength(buf)
+	if err != nil {
+		return dst, err
+	}
+	if len(buf) < length {
+		return dst, fmt.Errorf("%w: buffer truncated (need %d bytes, got %d)",
+			ErrInvalidBuffer, length, len(buf))
+	}
+
+	extEnd := rangeStart(header, payloadStart)
+	recordEnd := extEnd
+	if header&headerRangeFlag != 0 {
+		recordEnd += headerRangeBytes
+	}
+	header &^= headerRangeFlag
+	if r != nil {
+		header |= headerRangeFlag
+	}
+
+	dst = bo.AppendUint32(dst, header)
+	dst = append(dst, buf[headerBytes:extEnd]...)
+	if r != nil {
+		dst = bo.AppendUint32(dst, r.Min)
+		dst = bo.AppendUint32(dst, r.Max)
+	}
+	return append(dst, buf[recordEnd:length]...), nil
+}
+
+// rangeStart returns the offset of the range record, which is also the end of the
+// header extension, given the payload start as returned by readHeader.
+func rangeStart(header uint32, payloadStart int) int {
+	payloadStart = checksumStart(header, payloadStart)
+	if header&headerRangeFlag != 0 {
+		payloadStart -= headerRangeBytes
+	}
+	return payloadStart
+}
+
+// decodeRange decodes an 8-byte range record.
+func decodeRange(record []byte) ValueRange {
+	return ValueRange{Min: bo.Uint32(record[0:4]), Max: bo.Uint32(record[4:8])}
+}
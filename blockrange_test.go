@@ -0,0 +1,146 @@
+package fastpfor
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestBlockRange verifies that range records round-trip and are skipped by decoders.
+func TestBlockRange(t *testing.T) {
+	assert := assert.New(t)
+
+	for name, tc := range map[string]struct {
+		values []uint32
+		buf    []byte
+	}{
+		"plain":      {genSequential(blockSize), PackUint32(nil, genSequential(blockSize))},
+		"exceptions": {genDataWithLargeExceptions(), PackUint32(nil, genDataWithLargeExceptions())},
+		"delta":      {genMixed(blockSize), PackDeltaUint32(nil, genMixed(blockSize))},
+		"wide": {genDataWithSmallExceptions(),
+			packInternal(nil, genDataWithSmallExceptions(), headerTypeUint32Flag|headerWideFlag)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			buf, err := SetBlockRange(nil, tc.buf)
+			assert.NoError(err)
+			assert.Len(buf, len(tc.buf)+headerRangeBytes)
+
+			info, err := ReadBlockInfo(buf)
+			assert.NoError(err)
+			assert.Equal(len(buf), info.Length)
+			if assert.NotNil(info.Range) {
+				assert.Equal(slices.Min(tc.values), info.Range.Min)
+				assert.Equal(slices.Max(tc.values), info.Range.Max)
+			}
+
+			// All decoders skip the record
+			got, err := UnpackUint32(nil, buf)
+			assert.NoError(err)
+			assert.Equal(tc.values, got)
+			slim := NewSlimReader()
+			assert.NoError(slim.Load(buf))
+			assert.Equal(tc.values, slim.Decode(nil))
+			assert.NoError(VerifyBlock(buf))
+
+			// Replacing keeps a single record, stripping restores the block
+			again, err := SetBlockRange(nil, buf)
+			assert.NoError(err)
+			assert.Equal(buf, again)
+			stripped, err := StripBlockRange(nil, buf)
+			assert.NoError(err)
+			assert.Equal(tc.buf, stripped)
+		})
+	}
+
+	t.Run("withProvenance", func(t *testing.T) {
+		values := genDataWithSmallExceptions()
+		p := Provenance{WriterID: 3, CreateTime: time.Unix(0, 1234), SourceOffset: 99}
+		tagged, err := SetProvenance(nil, PackUint32(nil, values), p)
+		assert.NoError(err)
+		buf, err := SetBlockRange(nil, tagged)
+		assert.NoError(err)
+
+		// Both records survive rewriting either one
+		buf, err = SetProvenance(nil, buf, p)
+		assert.NoError(err)
+		info, err := ReadBlockInfo(buf)
+		assert.NoError(err)
+		assert.Equal(&ValueRange{Min: slices.Min(values), Max: slices.Max(values)}, info.Range)
+		assert.Equal(p.SourceOffset, info.Provenance.SourceOffset)
+		got, err := UnpackUint32(nil, buf)
+		assert.NoError(err)
+		assert.Equal(values, got)
+		assert.NoError(VerifyBlock(buf))
+
+		stripped, err := StripBlockRange(nil, buf)
+		assert.NoError(err)
+		assert.Equal(tagged, stripped)
+	})
+
+	t.Run("wrongRange", func(t *testing.T) {
+		buf, err := SetBlockRange(nil, PackUint32(nil, genSequential(blockSize)))
+		assert.NoError(err)
+		bo.PutUint32(buf[headerBytes+4:], 1000)
+		assert.ErrorIs(VerifyBlock(buf), ErrNonCanonical)
+	})
+
+	t.Run("unordered", func(t *testing.T) {
+		for _, buf := range [][]byte{
+			PackInt32(nil, []int32{-1, 0, 1}),
+			PackFloat32(nil, []float32{1.5, -2}),
+		} {
+			_, err := SetBlockRange(nil, buf)
+			assert.ErrorIs(err, ErrInvalidFlags)
+		}
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		buf, err := SetBlockRange(nil, PackUint32(nil, genSequential(blockSize)))
+		assert.NoError(err)
+		_, err = UnpackUint32(nil, buf[:headerBytes+headerRangeBytes-1])
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+}
+
+// TestBlockMayContain verifies range predicate checks against range records.
+func TestBlockMayContain(t *testing.T) {
+	assert := assert.New(t)
+
+	values := []uint32{500, 100, 900, 300}
+	plain := PackUint32(nil, values)
+	ranged, err := SetBlockRange(nil, plain)
+	assert.NoError(err)
+
+	for _, tc := range []struct {
+		lo, hi uint32
+		want   bool
+	}{
+		{0, 99, false},
+		{0, 100, true},
+		{200, 250, true}, // inside the range, although no value matches
+		{900, math.MaxUint32, true},
+		{901, math.MaxUint32, false},
+		{600, 500, false},
+	} {
+		ok, err := BlockMayContain(ranged, tc.lo, tc.hi)
+		assert.NoError(err)
+		assert.Equal(tc.want, ok, "[%d, %d]", tc.lo, tc.hi)
+
+		// Without a record, only empty ranges are excluded
+		ok, err = BlockMayContain(plain, tc.lo, tc.hi)
+		assert.NoError(err)
+		assert.Equal(tc.lo <= tc.hi, ok, "[%d, %d] plain", tc.lo, tc.hi)
+	}
+
+	empty, err := SetBlockRange(nil, PackUint32(nil, nil))
+	assert.NoError(err)
+	ok, err := BlockMayContain(empty, 0, math.MaxUint32)
+	assert.NoError(err)
+	assert.False(ok)
+
+	_, err = BlockMayContain(ranged[:2], 0, 1)
+	assert.ErrorIs(err, ErrInvalidBuffer)
+}
@@ -0,0 +1,34 @@
+//go:build go1.23
+
+package fastpfor
+
+import "iter"
+
+// Blocks returns an iterator over the blocks of buf, a stream of blocks
+// concatenated without framing (e.g. by appending PackUint32 results to a file),
+// and their byte offsets in buf:
+//
+//	for block, off := range fastpfor.Blocks(stream) {
+//	    values, err := fastpfor.UnpackUint32(dst[:0], block)
+//	    ...
+//	}
+//
+// Blocks are delimited with BlockLength, so only their metadata is read. The
+// yielded slices alias buf. If the remaining bytes do not start with a complete
+// block, they are yielded as the last block, so that decoding it (or BlockLength
+// or Validate) reports the problem.
+func Blocks(buf []byte) iter.Seq2[[]byte, int] {
+	return func(yield func([]byte, int) bool) {
+		for off := 0; off < len(buf); {
+			n, err := BlockLength(buf[off:])
+			if err != nil || n > len(buf)-off {
+				yield(buf[off:], off)
+				return
+			}
+			if !yield(buf[off:off+n], off) {
+				return
+			}
+			off += n
+		}
+	}
+}
@@ -0,0 +1,56 @@
+//go:build go1.23
+
+package fastpfor
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestBlocks verifies that Blocks yields the concatenated blocks with their
+// offsets and the undelimitable remainder of a damaged stream.
+func TestBlocks(t *testing.T) {
+	assert := assert.New(t)
+
+	blocks := [][]byte{
+		PackUint32(nil, genDataWithLargeExceptions()),
+		PackDeltaUint32(nil, genMonotonic(77)),
+		PackUint32(nil, nil),
+		PackFORUint32(nil, []uint32{9, 9}),
+	}
+	stream := slices.Concat(blocks...)
+
+	var got [][]byte
+	off := 0
+	for block, o := range Blocks(stream) {
+		assert.Equal(off, o)
+		got = append(got, block)
+		off += len(block)
+	}
+	assert.Equal(blocks, got)
+
+	for range Blocks(nil) {
+		assert.Fail("empty stream yielded")
+	}
+
+	// Stopping early
+	n := 0
+	for range Blocks(stream) {
+		if n++; n == 2 {
+			break
+		}
+	}
+	assert.Equal(2, n)
+
+	// A truncated last block is yielded as the remainder
+	truncated := stream[:len(stream)-2]
+	got = got[:0]
+	for block := range Blocks(truncated) {
+		got = append(got, block)
+	}
+	assert.Len(got, len(blocks))
+	_, err := UnpackUint32(nil, got[len(got)-1])
+	assert.ErrorIs(err, ErrInvalidBuffer)
+}
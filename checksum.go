@@ -0,0 +1,213 @@
+package fastpfor
+
+import (
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"slices"
+)
+
+// ErrChecksumMismatch is returned if the values of a block do not match its
+// checksum record (see VerifyBlockChecksum).
+var ErrChecksumMismatch = errors.New("fastpfor: checksum mismatch")
+
+// castagnoli is the CRC-32C table used for value checksums.
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// ValuesChecksum returns the order-sensitive checksum of values as stored by
+// SetBlockChecksum: the CRC-32C (Castagnoli) of the values as little-endian
+// uint32. int32 and float32 values are hashed as their bit patterns (uint32(v)
+// and math.Float32bits(v)). As the checksum only depends on the decoded values,
+// it verifies transcoding between codecs and layouts end-to-end.
+func ValuesChecksum(values []uint32) uint32 {
+	var buf [4 * blockSize]byte
+	var crc uint32
+	for len(values) > 0 {
+		n := min(len(values), blockSize)
+		for i, v := range values[:n] {
+			bo.PutUint32(buf[4*i:], v)
+		}
+		crc = crc32.Update(crc, castagnoli, buf[:4*n])
+		values = values[n:]
+	}
+	return crc
+}
+
+// SetBlockChecksum appends a copy of the block at the start of buf to dst, with a
+// checksum record holding the ValuesChecksum of its decoded values (an existing
+// record is replaced). Signed and float32 blocks are hashed as their int32 and
+// float32 values; float64 blocks return ErrInvalidFlags, as each block only holds
+// halves of the values.
+func SetBlockChecksum(dst, buf []byte) ([]byte, error) {
+	var scratch [blockSize]uint32
+	values, err := decodeContent(scratch[:0], buf)
+	if err != nil {
+		return dst, err
+	}
+	sum := ValuesChecksum(values)
+	return rewriteChecksum(dst, buf, &sum)
+}
+
+// PackUint32WithChecksum packs values like PackUint32 and stores their
+// ValuesChecksum in the checksum record of the block, without the decoding pass
+// of SetBlockChecksum. Use UnpackUint32Verified or VerifyBlockChecksum to check
+// the block after storage or transfer.
+func PackUint32WithChecksum(dst []byte, values []uint32) []byte {
+	sum := ValuesChecksum(values)
+	start := len(dst)
+	return insertChecksum(PackUint32(dst, values), start, sum)
+}
+
+// PackDeltaUint32WithChecksum packs values like PackDeltaUint32 and stores the
+// ValuesChecksum of the original values in the checksum record of the block.
+func PackDeltaUint32WithChecksum(dst []byte, values []uint32) []byte {
+	sum := ValuesChecksum(values)
+	start := len(dst)
+	return insertChecksum(PackDeltaUint32(dst, values), start, sum)
+}
+
+// UnpackUint32Verified decodes buf like UnpackUint32 and checks the decoded
+// values against the checksum record of the block. Returns ErrChecksumMismatch if
+// they differ and ErrInvalidFlags if the block has no checksum record or holds
+// int32 or float values (use VerifyBlockChecksum for these).
+//
+// Overflowing delta blocks are verified with their wrapped values; a match is
+// returned together with the *ErrOverflow.
+func UnpackUint32Verified(dst []uint32, buf []byte) ([]uint32, error) {
+	header, _, payloadStart, err := readHeader(buf)
+	if err != nil {
+		return nil, err
+	}
+	if header&headerChecksumFlag == 0 {
+		return nil, fmt.Errorf("%w: block has no checksum record", ErrInvalidFlags)
+	}
+	if header&(headerSignedFlag|headerFloatFlag|headerFloat64Flag) != 0 {
+		return nil, fmt.Errorf("%w: block does not hold uint32 values", ErrInvalidFlags)
+	}
+	values, err := UnpackUint32(dst, buf)
+	if err != nil {
+		var overflowErr *ErrOverflow
+		if !errors.As(err, &overflowErr) {
+			return nil, err
+		}
+	}
+	want := bo.Uint32(buf[checksumStart(header, payloadStart):])
+	if got := ValuesChecksum(values); got != want {
+		return nil, fmt.Errorf("%w: values hash to %#08x, record holds %#08x", ErrChecksumMismatch, got, want)
+	}
+	return values, err
+}
+
+// StripBlockChecksum appends a copy of the block at the start of buf to dst
+// without its checksum record. Blocks without a record are copied as-is.
+func StripBlockChecksum(dst, buf []byte) ([]byte, error) {
+	return rewriteChecksum(dst, buf, nil)
+}
+
+// VerifyBlockChecksum decodes the block at the start of buf and checks its values
+// against its checksum record. Returns ErrChecksumMismatch if they differ and
+// ErrInvalidFlags if the block has no checksum record.
+func VerifyBlockChecksum(buf []byte) error {
+	header, _, payloadStart, err := readHeader(buf)
+	if err != nil {
+		return err
+	}
+	if header&headerChecksumFlag == 0 {
+		return fmt.Errorf("%w: block has no checksum record", ErrInvalidFlags)
+	}
+	want := bo.Uint32(buf[checksumStart(header, payloadStart):])
+
+	var scratch [blockSize]uint32
+	values, err := decodeContent(scratch[:0], buf)
+	if err != nil {
+		return err
+	}
+	if got := ValuesChecksum(values); got != want {
+		return fmt.Errorf("%w: values hash to %#08x, record holds %#08x", ErrChecksumMismatch, got, want)
+	}
+	return nil
+}
+
+// decodeContent decodes the block in buf to the bit patterns of its values:
+// uint32 values as-is, int32 values without zigzag and float32 values without
+// XOR encoding. Overflowing delta blocks decode to their wrapped values, like
+// in Reader.
+func decodeContent(dst []uint32, buf []byte) ([]uint32, error) {
+	header, _, _, err := readHeader(buf)
+	if err != nil {
+		return nil, err
+	}
+	if header&headerFloat64Flag != 0 {
+		return nil, fmt.Errorf("%w: float64 blocks only hold halves of the values", ErrInvalidFlags)
+	}
+	values, err := UnpackUint32(dst, buf)
+	if err != nil {
+		var overflowErr *ErrOverflow
+		if !errors.As(err, &overflowErr) {
+			return nil, err
+		}
+	}
+	switch {
+	case header&headerSignedFlag != 0:
+		zigzagDecodeBlock(values)
+	case header&headerFloatFlag != 0:
+		xorDecodeBlock(values)
+	}
+	return values, nil
+}
+
+// rewriteChecksum copies the block in buf to dst, replacing its checksum record
+// with sum, or removing it if sum is nil.
+func rewriteChecksum(dst, buf []byte, sum *uint32) ([]byte, error) {
+	header, _, payloadStart, err := readHeader(buf)
+	if err != nil {
+		return dst, err
+	}
+	length, err := BlockLength(buf)
+	if err != nil {
+		return dst, err
+	}
+	if len(buf) < length {
+		return dst, fmt.Errorf("%w: buffer truncated (need %d bytes, got %d)",
+			ErrInvalidBuffer, length, len(buf))
+	}
+
+	recordStart := checksumStart(header, payloadStart)
+	recordEnd := recordStart
+	if header&headerChecksumFlag != 0 {
+		recordEnd += headerChecksumBytes
+	}
+	header &^= headerChecksumFlag
+	if sum != nil {
+		header |= headerChecksumFlag
+	}
+
+	dst = bo.AppendUint32(dst, header)
+	dst = append(dst, buf[headerBytes:recordStart]...)
+	if sum != nil {
+		dst = bo.AppendUint32(dst, *sum)
+	}
+	return append(dst, buf[recordEnd:length]...), nil
+}
+
+// insertChecksum adds a checksum record holding sum to the block at dst[start:],
+// which must not have one yet.
+func insertChecksum(dst []byte, start int, sum uint32) []byte {
+	header, _, payloadStart, _ := readHeader(dst[start:])
+	bo.PutUint32(dst[start:], header|headerChecksumFlag)
+	var record [headerChecksumBytes]byte
+	bo.PutUint32(record[:], sum)
+	return slices.Insert(dst, start+checksumStart(header, payloadStart), record[:]...)
+}
+
+// checksumStart returns the offset of the checksum record, which is also the end
+// of the range record, given the payload start as returned by readHeader.
+func checksumStart(header uint32, payloadStart int) int {
+	if header&headerProvenanceFlag != 0 {
+		payloadStart -= headerProvenanceBytes
+	}
+	if header&headerChecksumFlag != 0 {
+		payloadStart -= headerChecksumBytes
+	}
+	return payloadStart
+}
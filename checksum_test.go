@@ -0,0 +1,221 @@
+package fastpfor
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestBlockChecksum verifies that checksum records round-trip, are skipped by
+// decoders and only depend on the decoded values.
+func TestBlockChecksum(t *testing.T) {
+	assert := assert.New(t)
+
+	floats := []float32{1.5, -0.0, float32(math.Inf(1)), 3.25}
+	floatBits := make([]uint32, len(floats))
+	for i, f := range floats {
+		floatBits[i] = math.Float32bits(f)
+	}
+	for name, tc := range map[string]struct {
+		values []uint32 // bit patterns of the decoded values
+		buf    []byte
+	}{
+		"plain":      {genSequential(blockSize), PackUint32(nil, genSequential(blockSize))},
+		"exceptions": {genDataWithLargeExceptions(), PackUint32(nil, genDataWithLargeExceptions())},
+		"delta":      {genMixed(blockSize), PackDeltaUint32(nil, genMixed(blockSize))},
+		"empty":      {nil, PackUint32(nil, nil)},
+		"wide": {genDataWithSmallExceptions(),
+			packInternal(nil, genDataWithSmallExceptions(), headerTypeUint32Flag|headerWideFlag)},
+		"int32":   {[]uint32{1, 0xFFFFFFFF, 0x80000000}, PackInt32(nil, []int32{1, -1, math.MinInt32})},
+		"float32": {floatBits, PackFloat32(nil, floats)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			buf, err := SetBlockChecksum(nil, tc.buf)
+			assert.NoError(err)
+			assert.Len(buf, len(tc.buf)+headerChecksumBytes)
+
+			info, err := ReadBlockInfo(buf)
+			assert.NoError(err)
+			assert.Equal(len(buf), info.Length)
+			if assert.NotNil(info.Checksum) {
+				assert.Equal(ValuesChecksum(tc.values), *info.Checksum)
+			}
+			assert.NoError(VerifyBlockChecksum(buf))
+			assert.NoError(VerifyBlock(buf))
+
+			// Decoders skip the record
+			want, err := UnpackUint32(nil, tc.buf)
+			assert.NoError(err)
+			got, err := UnpackUint32(nil, buf)
+			assert.NoError(err)
+			assert.Equal(want, got)
+
+			// Replacing keeps a single record, stripping restores the block
+			again, err := SetBlockChecksum(nil, buf)
+			assert.NoError(err)
+			assert.Equal(buf, again)
+			stripped, err := StripBlockChecksum(nil, buf)
+			assert.NoError(err)
+			assert.Equal(tc.buf, stripped)
+		})
+	}
+
+	t.Run("layoutIndependent", func(t *testing.T) {
+		values := genMixed(blockSize)
+		var sums []uint32
+		for _, buf := range [][]byte{
+			PackUint32(nil, values),
+			PackDeltaUint32(nil, append(make([]uint32, 0, 2*blockSize), values...)),
+			packInternal(nil, values, headerTypeUint32Flag|headerWideFlag),
+		} {
+			buf, err := SetBlockChecksum(nil, buf)
+			assert.NoError(err)
+			info, err := ReadBlockInfo(buf)
+			assert.NoError(err)
+			sums = append(sums, *info.Checksum)
+		}
+		assert.Equal([]uint32{sums[0], sums[0], sums[0]}, sums)
+		assert.Equal(ValuesChecksum(values), sums[0])
+
+		// Order-sensitive
+		values[0], values[1] = values[1], values[0]
+		assert.NotEqual(sums[0], ValuesChecksum(values))
+	})
+
+	t.Run("withRangeAndProvenance", func(t *testing.T) {
+		values := genDataWithSmallExceptions()
+		p := Provenance{WriterID: 3, CreateTime: time.Unix(0, 1234), SourceOffset: 99}
+		buf, err := SetProvenance(nil, PackUint32(nil, values), p)
+		assert.NoError(err)
+		buf, err = SetBlockChecksum(nil, buf)
+		assert.NoError(err)
+		buf, err = SetBlockRange(nil, buf)
+		assert.NoError(err)
+
+		// All records survive rewriting any of them
+		for _, rewrite := range []func([]byte) ([]byte, error){
+			func(b []byte) ([]byte, error) { return SetProvenance(nil, b, p) },
+			func(b []byte) ([]byte, error) { return SetBlockRange(nil, b) },
+			func(b []byte) ([]byte, error) { return SetBlockChecksum(nil, b) },
+		} {
+			buf, err = rewrite(buf)
+			assert.NoError(err)
+			info, err := ReadBlockInfo(buf)
+			assert.NoError(err)
+			assert.NotNil(info.Range)
+			assert.NotNil(info.Provenance)
+			if assert.NotNil(info.Checksum) {
+				assert.Equal(ValuesChecksum(values), *info.Checksum)
+			}
+			got, err := UnpackUint32(nil, buf)
+			assert.NoError(err)
+			assert.Equal(values, got)
+			assert.NoError(VerifyBlockChecksum(buf))
+			assert.NoError(VerifyBlock(buf))
+		}
+	})
+
+	t.Run("mismatch", func(t *testing.T) {
+		buf, err := SetBlockChecksum(nil, PackUint32(nil, genSequential(blockSize)))
+		assert.NoError(err)
+
+		corrupt := append([]byte(nil), buf...)
+		corrupt[headerBytes] ^= 1 // checksum record
+		assert.ErrorIs(VerifyBlockChecksum(corrupt), ErrChecksumMismatch)
+		assert.ErrorIs(VerifyBlock(corrupt), ErrNonCanonical)
+
+		corrupt = append([]byte(nil), buf...)
+		corrupt[headerBytes+headerChecksumBytes] ^= 1 // first payload word
+		assert.ErrorIs(VerifyBlockChecksum(corrupt), ErrChecksumMismatch)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		buf := PackUint32(nil, genSequential(10))
+		assert.ErrorIs(VerifyBlockChecksum(buf), ErrInvalidFlags)
+
+		_, err := SetBlockChecksum(nil, PackFloat64(nil, []float64{1, 2}))
+		assert.ErrorIs(err, ErrInvalidFlags)
+
+		buf, err = SetBlockChecksum(nil, buf)
+		assert.NoError(err)
+		_, err = UnpackUint32(nil, buf[:headerBytes+2])
+		assert.ErrorIs(err, ErrInvalidBuffer)
+		_, err = SetBlockChecksum(nil, buf[:len(buf)-1])
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+
+	t.Run("assembleClearsFlag", func(t *testing.T) {
+		buf, err := SetBlockChecksum(nil, PackUint32(nil, genSequential(10)))
+		assert.NoError(err)
+		header, payload, patch, err := SplitEncoded(buf)
+		assert.NoError(err)
+		assembled, err := AssembleEncoded(header, payload, patch)
+		assert.NoError(err)
+		assert.Equal(PackUint32(nil, genSequential(10)), assembled)
+	})
+}
+
+// TestPackWithChecksum verifies that packing with a checksum matches
+// SetBlockChecksum and that UnpackUint32Verified detects corruption.
+func TestPackWithChecksum(t *testing.T) {
+	assert := assert.New(t)
+
+	for name, values := range map[string][]uint32{
+		"plain":      genSequential(blockSize),
+		"exceptions": genDataWithLargeExceptions(),
+		"mixed":      genMixed(100),
+		"empty":      {},
+	} {
+		for _, delta := range []bool{false, true} {
+			scratch := append(make([]uint32, 0, 2*blockSize), values...)
+			var buf, want []byte
+			if delta {
+				buf = PackDeltaUint32WithChecksum([]byte{0xAA}, scratch)
+				want, _ = SetBlockChecksum([]byte{0xAA}, PackDeltaUint32(nil, slices.Clone(values)))
+			} else {
+				buf = PackUint32WithChecksum([]byte{0xAA}, scratch)
+				want, _ = SetBlockChecksum([]byte{0xAA}, PackUint32(nil, values))
+			}
+			assert.Equal(want, buf, "%s delta=%v", name, delta)
+
+			got, err := UnpackUint32Verified([]uint32{1, 2}, buf[1:])
+			assert.NoError(err, name)
+			assert.Equal(values, got, name)
+		}
+	}
+
+	buf := PackUint32WithChecksum(nil, genSequential(blockSize))
+	corrupt := slices.Clone(buf)
+	corrupt[headerBytes+headerChecksumBytes] ^= 1 // first payload word
+	_, err := UnpackUint32Verified(nil, corrupt)
+	assert.ErrorIs(err, ErrChecksumMismatch)
+
+	// Overflowing blocks are verified with their wrapped values
+	overflow, err := SetBlockChecksum(nil, PackAlreadyDeltaUint32(nil, []uint32{mathMaxUint32, 2}))
+	assert.NoError(err)
+	got, err := UnpackUint32Verified(nil, overflow)
+	var overflowErr *ErrOverflow
+	assert.ErrorAs(err, &overflowErr)
+	assert.Equal([]uint32{mathMaxUint32, 1}, got)
+
+	_, err = UnpackUint32Verified(nil, PackUint32(nil, genSequential(10)))
+	assert.ErrorIs(err, ErrInvalidFlags)
+	signed, err := SetBlockChecksum(nil, PackInt32(nil, []int32{-1, 1}))
+	assert.NoError(err)
+	_, err = UnpackUint32Verified(nil, signed)
+	assert.ErrorIs(err, ErrInvalidFlags)
+	_, err = UnpackUint32Verified(nil, buf[:2])
+	assert.ErrorIs(err, ErrInvalidBuffer)
+}
+
+func BenchmarkVerifyBlockChecksum(b *testing.B) {
+	buf, _ := SetBlockChecksum(nil, PackUint32(nil, genDataWithSmallExceptions()))
+	b.SetBytes(4 * blockSize)
+	b.ReportAllocs()
+	for range b.N {
+		_ = VerifyBlockChecksum(buf)
+	}
+}
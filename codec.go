@@ -0,0 +1,183 @@
+package fastpfor
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+
+	"github.com/mhr3/streamvbyte"
+)
+
+// Codec encodes sequences of uint32 values of any length, so higher-level
+// containers can be parameterized by the integer codec and codecs can be
+// benchmarked interchangeably. Every encoding starts with the number of values
+// as a uvarint, so encodings are self-delimiting and can be concatenated.
+type Codec interface {
+	// Pack encodes values and appends them to dst. The values are not modified.
+	Pack(dst []byte, values []uint32) []byte
+	// Unpack decodes the encoding at the start of buf into dst (which will be
+	// resized as needed) and returns the number of bytes consumed from buf.
+	Unpack(dst []uint32, buf []byte) ([]uint32, int, error)
+	// MaxEncodedLen returns the maximum number of bytes Pack appends for n values.
+	MaxEncodedLen(n int) int
+}
+
+var (
+	_ Codec = PFORCodec{}
+	_ Codec = BitPackingCodec{}
+	_ Codec = StreamVByteCodec{}
+)
+
+// PFORCodec is the FastPFOR Codec: a sequence frame of blocks with patched
+// exceptions (see PackAllUint32). With Delta set, blocks are delta-encoded (see
+// PackAllDeltaUint32), which suits sorted sequences.
+type PFORCodec struct {
+	Delta bool
+}
+
+// Pack implements Codec.
+func (c PFORCodec) Pack(dst []byte, values []uint32) []byte {
+	if c.Delta {
+		return PackAllDeltaUint32(dst, values)
+	}
+	return PackAllUint32(dst, values)
+}
+
+// Unpack implements Codec. Both delta and plain frames are decoded.
+func (PFORCodec) Unpack(dst []uint32, buf []byte) ([]uint32, int, error) {
+	return UnpackAllUint32(dst, buf)
+}
+
+// MaxEncodedLen implements Codec. A block never exceeds its size at bit width 32,
+// as the width selection would choose that width otherwise.
+func (PFORCodec) MaxEncodedLen(n int) int {
+	return uvarintLen(n) + numBlocks(n)*MaxBlockSizeUint32()
+}
+
+// BitPackingCodec is plain binary packing without exceptions: every block of 128
+// values is stored as its bit width (1 byte) followed by the interleaved lanes at
+// the width of its largest value. It trades compression on outliers for the
+// cheapest possible decoding.
+type BitPackingCodec struct{}
+
+// Pack implements Codec.
+func (BitPackingCodec) Pack(dst []byte, values []uint32) []byte {
+	dst = binary.AppendUvarint(dst, uint64(len(values)))
+	for len(values) > 0 {
+		n := min(len(values), blockSize)
+		bitWidth := requiredBitWidthScalar(values[:n])
+		start := len(dst)
+		dst = append(dst, make([]byte, 1+payloadBytes(bitWidth))...)
+		dst[start] = byte(bitWidth)
+		if bitWidth > 0 {
+			packLanes(dst[start+1:], values[:n], bitWidth)
+		}
+		values = values[n:]
+	}
+	return dst
+}
+
+// Unpack implements Codec.
+func (BitPackingCodec) Unpack(dst []uint32, buf []byte) ([]uint32, int, error) {
+	count, off, err := readCodecCount(buf, blockSize) // width 0 blocks take 1 byte
+	if err != nil {
+		return nil, 0, err
+	}
+	blocks := numBlocks(count)
+	dst = ensureUint32Cap(dst, count, blocks*blockSize)
+	for i := 0; i < count; i += blockSize {
+		if off >= len(buf) {
+			return nil, 0, fmt.Errorf("%w: bit-packed block %d truncated", ErrInvalidBuffer, i/blockSize)
+		}
+		bitWidth := int(buf[off])
+		if bitWidth > 32 {
+			return nil, 0, fmt.Errorf("%w: invalid bit width %d", ErrInvalidBuffer, bitWidth)
+		}
+		off++
+		end := off + payloadBytes(bitWidth)
+		if end > len(buf) {
+			return nil, 0, fmt.Errorf("%w: bit-packed block %d truncated (need %d bytes, got %d)",
+				ErrInvalidBuffer, i/blockSize, end, len(buf))
+		}
+		unpackLanes(dst[i:i+blockSize], buf[off:end], min(count-i, blockSize), bitWidth)
+		off = end
+	}
+	return dst[:count], off, nil
+}
+
+// MaxEncodedLen implements Codec.
+func (BitPackingCodec) MaxEncodedLen(n int) int {
+	return uvarintLen(n) + numBlocks(n)*(1+payloadBytes(32))
+}
+
+// StreamVByteCodec stores all values with StreamVByte (1-4 bytes per value plus
+// 2 control bits), without blocks. It compresses little but decodes very fast.
+type StreamVByteCodec struct{}
+
+// Pack implements Codec.
+func (StreamVByteCodec) Pack(dst []byte, values []uint32) []byte {
+	dst = binary.AppendUvarint(dst, uint64(len(values)))
+	if len(values) == 0 {
+		return dst
+	}
+	start := len(dst)
+	dst = append(dst, make([]byte, streamvbyte.MaxEncodedLen(len(values)))...)
+	data := streamvbyte.EncodeUint32(values, &streamvbyte.EncodeOptions[uint32]{
+		Buffer: dst[start:],
+	})
+	return dst[:start+len(data)]
+}
+
+// Unpack implements Codec.
+func (StreamVByteCodec) Unpack(dst []uint32, buf []byte) ([]uint32, int, error) {
+	count, off, err := readCodecCount(buf, 1)
+	if err != nil {
+		return nil, 0, err
+	}
+	if count == 0 {
+		return dst[:0], off, nil
+	}
+	numControlBytes := (count + 3) >> 2
+	if len(buf)-off < numControlBytes {
+		return nil, 0, fmt.Errorf("%w: StreamVByte control bytes truncated", ErrInvalidBuffer)
+	}
+	end := off + numControlBytes + svbDataLen(buf[off:], count)
+	if end > len(buf) {
+		return nil, 0, fmt.Errorf("%w: StreamVByte data truncated (need %d bytes, got %d)",
+			ErrInvalidBuffer, end, len(buf))
+	}
+	dst = ensureUint32Cap(dst, count, count)
+	dst = streamvbyte.DecodeUint32(buf[off:end], count, &streamvbyte.DecodeOptions[uint32]{
+		Buffer: dst,
+	})
+	return dst, end, nil
+}
+
+// MaxEncodedLen implements Codec.
+func (StreamVByteCodec) MaxEncodedLen(n int) int {
+	return uvarintLen(n) + streamvbyte.MaxEncodedLen(n)
+}
+
+// readCodecCount reads the uvarint value count at the start of a Codec encoding.
+// A codec stores at most perByte values per encoded byte, which bounds the count
+// by the buffer length before anything is allocated.
+func readCodecCount(buf []byte, perByte int) (count, off int, err error) {
+	n, off := binary.Uvarint(buf)
+	if off <= 0 {
+		return 0, 0, fmt.Errorf("%w: invalid value count", ErrInvalidBuffer)
+	}
+	if n > uint64(perByte)*uint64(len(buf)-off) {
+		return 0, 0, fmt.Errorf("%w: encoding truncated (%d values announced)", ErrInvalidBuffer, n)
+	}
+	return int(n), off, nil
+}
+
+// numBlocks returns the number of blocks needed for n values.
+func numBlocks(n int) int {
+	return (n + blockSize - 1) / blockSize
+}
+
+// uvarintLen returns the encoded length of n as a uvarint.
+func uvarintLen(n int) int {
+	return (bits.Len64(uint64(n)|1) + 6) / 7
+}
@@ -0,0 +1,134 @@
+package fastpfor
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testCodecs lists the codecs by name for table-driven tests and benchmarks.
+var testCodecs = []struct {
+	name  string
+	codec Codec
+}{
+	{"pfor", PFORCodec{}},
+	{"pforDelta", PFORCodec{Delta: true}},
+	{"bitPacking", BitPackingCodec{}},
+	{"streamVByte", StreamVByteCodec{}},
+	{"pagedPFOR", PagedPFORCodec{}},
+	{"pagedPFORDelta", PagedPFORCodec{Delta: true}},
+}
+
+// TestCodecs verifies round trips, size bounds and error handling of all codecs.
+func TestCodecs(t *testing.T) {
+	assert := assert.New(t)
+
+	inputs := map[string][]uint32{
+		"empty":      nil,
+		"single":     {42},
+		"partial":    genMixed(127),
+		"block":      genMonotonic(blockSize),
+		"blockPlus1": genMixed(blockSize + 1),
+		"many":       genMonotonic(1000),
+		"exceptions": genDataWithLargeExceptions(),
+		"zeros":      make([]uint32, 5*blockSize),
+		"max":        repeatValues([]uint32{mathMaxUint32}, 130),
+	}
+	for _, c := range testCodecs {
+		for name, values := range inputs {
+			input := slices.Clone(values)
+			buf := c.codec.Pack([]byte{0xAA}, input)
+			assert.Equal(values, input, "%s %s: input modified", c.name, name)
+			assert.Equal(byte(0xAA), buf[0])
+			buf = buf[1:]
+			assert.LessOrEqual(len(buf), c.codec.MaxEncodedLen(len(values)), "%s %s", c.name, name)
+
+			// Concatenated encodings are self-delimiting
+			buf = c.codec.Pack(buf, values)
+			got, n, err := c.codec.Unpack(nil, buf)
+			if !assert.NoError(err, "%s %s", c.name, name) {
+				continue
+			}
+			assert.Equal(len(buf)/2, n, "%s %s", c.name, name)
+			assert.Equal(len(values), len(got), "%s %s", c.name, name)
+			if len(values) > 0 {
+				assert.Equal(values, got, "%s %s", c.name, name)
+			}
+			got, m, err := c.codec.Unpack(got, buf[n:])
+			assert.NoError(err)
+			assert.Equal(n, m)
+			assert.Equal(len(values), len(got))
+
+			for cut := range n {
+				_, _, err := c.codec.Unpack(nil, buf[:cut])
+				assert.ErrorIs(err, ErrInvalidBuffer, "%s %s cut %d", c.name, name, cut)
+			}
+		}
+	}
+}
+
+// TestCodecHugeCount verifies that bogus value counts are rejected before allocating.
+func TestCodecHugeCount(t *testing.T) {
+	assert := assert.New(t)
+	buf := []byte{0xFF, 0xFF, 0xFF, 0xFF, 0x0F, 0, 0, 0}
+	for _, c := range testCodecs {
+		_, _, err := c.codec.Unpack(nil, buf)
+		assert.ErrorIs(err, ErrInvalidBuffer, c.name)
+	}
+}
+
+func TestUvarintLen(t *testing.T) {
+	for _, n := range []int{0, 1, 127, 128, 16383, 16384, 1 << 40} {
+		assert.Equal(t, uvarintLen(n), len(binaryAppendUvarint(n)), "%d", n)
+	}
+}
+
+func binaryAppendUvarint(n int) []byte {
+	var buf []byte
+	for v := uint64(n); ; v >>= 7 {
+		if v < 0x80 {
+			return append(buf, byte(v))
+		}
+		buf = append(buf, byte(v)|0x80)
+	}
+}
+
+// repeatValues returns count concatenated copies of values.
+func repeatValues(values []uint32, count int) []uint32 {
+	out := make([]uint32, 0, len(values)*count)
+	for range count {
+		out = append(out, values...)
+	}
+	return out
+}
+
+func BenchmarkCodecs(b *testing.B) {
+	inputs := map[string][]uint32{
+		"sorted":     genMonotonic(64 * blockSize),
+		"exceptions": repeatValues(genDataWithLargeExceptions(), 64),
+	}
+	for _, c := range testCodecs {
+		for name, values := range inputs {
+			buf := c.codec.Pack(nil, values)
+			b.Run(fmt.Sprintf("%s/%s/Pack", c.name, name), func(b *testing.B) {
+				dst := make([]byte, 0, c.codec.MaxEncodedLen(len(values)))
+				b.SetBytes(int64(4 * len(values)))
+				b.ReportAllocs()
+				for range b.N {
+					dst = c.codec.Pack(dst[:0], values)
+				}
+			})
+			b.Run(fmt.Sprintf("%s/%s/Unpack", c.name, name), func(b *testing.B) {
+				dst := make([]uint32, 0, len(values)+blockSize)
+				b.SetBytes(int64(4 * len(values)))
+				b.ReportMetric(float64(8*len(buf))/float64(len(values)), "bits/value")
+				b.ReportAllocs()
+				for range b.N {
+					dst, _, _ = c.codec.Unpack(dst, buf)
+				}
+			})
+		}
+	}
+}
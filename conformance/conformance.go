@@ -0,0 +1,388 @@
+// Package conformance verifies that FastPFOR block streams produced by other
+// implementations decode to the expected values with this package, and reports
+// the compatibility per format feature (delta, zigzag, exceptions, uint16 marker,
+// exception runs, exception bitmap, raw exception high bits).
+//
+// A test vector consists of two files sharing a base name:
+//
+//	<name>.pfor  concatenated encoded blocks
+//	<name>.u32   the expected decoded values as little-endian uint32s
+//
+// Golden vectors produced by this package's reference encoder are stored in
+// testdata/golden and can be regenerated with WriteGolden. Vectors produced by
+// other implementations can be checked with:
+//
+//	go test ./conformance -vectors=/path/to/vectors
+package conformance
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Akron/fastpfor-go"
+)
+
+const (
+	encodedExt = ".pfor"
+	valuesExt  = ".u32"
+)
+
+// Feature is a format feature a block can make use of.
+type Feature int
+
+const (
+	FeaturePlain           Feature = iota // no delta, zigzag, exceptions or uint16 marker
+	FeatureDelta                          // delta-encoded values
+	FeatureZigZag                         // zigzag-encoded deltas
+	FeatureExceptions                     // patched exceptions
+	FeatureUint16                         // IntTypeUint16 marker
+	FeatureExceptionRuns                  // run-coded exception positions
+	FeatureExceptionBitmap                // exception position bitmap
+	FeatureRawHighBits                    // fixed-width exception high bits
+	numFeatures
+)
+
+var featureNames = [numFeatures]string{"plain", "delta", "zigzag", "exceptions", "uint16", "exception runs", "exception bitmap", "raw high bits"}
+
+func (f Feature) String() string {
+	if f < 0 || f >= numFeatures {
+		return fmt.Sprintf("Feature(%d)", int(f))
+	}
+	return featureNames[f]
+}
+
+// Vector is a single test vector: an encoded block stream and its expected values.
+type Vector struct {
+	Name    string
+	Encoded []byte
+	Values  []uint32
+}
+
+// FeatureResult counts the checked blocks that use a feature.
+type FeatureResult struct {
+	Passed int
+	Failed int
+}
+
+// VectorResult is the outcome of checking a single vector.
+type VectorResult struct {
+	Name   string
+	Blocks int   // number of blocks decoded
+	Err    error // first mismatch or decoding error, nil if the vector passed
+}
+
+// Report summarizes the results of a conformance run.
+type Report struct {
+	Vectors  []VectorResult
+	Features [numFeatures]FeatureResult
+}
+
+// OK reports whether all vectors passed.
+func (r *Report) OK() bool {
+	for _, v := range r.Vectors {
+		if v.Err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// String renders the report as a per-feature compatibility table followed by
+// all failed vectors.
+func (r *Report) String() string {
+	var sb strings.Builder
+	for f := Feature(0); f < numFeatures; f++ {
+		res := r.Features[f]
+		status := "ok"
+		switch {
+		case res.Failed > 0:
+			status = "FAIL"
+		case res.Passed == 0:
+			status = "untested"
+		}
+		fmt.Fprintf(&sb, "%-10s %-8s %d passed, %d failed\n", f, status, res.Passed, res.Failed)
+	}
+	for _, v := range r.Vectors {
+		if v.Err != nil {
+			fmt.Fprintf(&sb, "%s: %v\n", v.Name, v.Err)
+		}
+	}
+	return sb.String()
+}
+
+// Run checks all vectors and returns the report.
+func Run(vectors []Vector) *Report {
+	r := &Report{Vectors: make([]VectorResult, 0, len(vectors))}
+	for _, v := range vectors {
+		r.Vectors = append(r.Vectors, r.check(v))
+	}
+	return r
+}
+
+// check decodes the block stream of v block by block, compares the values and
+// records the outcome for every feature used by a block.
+func (r *Report) check(v Vector) VectorResult {
+	res := VectorResult{Name: v.Name}
+	buf, want := v.Encoded, v.Values
+	var dst []uint32
+	for len(buf) > 0 {
+		e, err := fastpfor.Explain(buf)
+		if err != nil {
+			res.Err = fmt.Errorf("block %d: %w", res.Blocks, err)
+			return res
+		}
+		features := blockFeatures(e)
+
+		var n int
+		dst, n, err = fastpfor.UnpackUint32WithLength(dst[:0], buf)
+		if err == nil {
+			err = compareValues(dst, want)
+		}
+		r.record(features, err == nil)
+		if err != nil {
+			res.Err = fmt.Errorf("block %d (%s): %w", res.Blocks, joinFeatures(features), err)
+			return res
+		}
+		buf = buf[n:]
+		want = want[len(dst):]
+		res.Blocks++
+	}
+	if len(want) > 0 {
+		res.Err = fmt.Errorf("stream ended with %d expected values left", len(want))
+	}
+	return res
+}
+
+func (r *Report) record(features []Feature, ok bool) {
+	for _, f := range features {
+		if ok {
+			r.Features[f].Passed++
+		} else {
+			r.Features[f].Failed++
+		}
+	}
+}
+
+// compareValues checks that got is a prefix of want.
+func compareValues(got, want []uint32) error {
+	if len(got) > len(want) {
+		return fmt.Errorf("decoded %d values, only %d expected", len(got), len(want))
+	}
+	for i, v := range got {
+		if v != want[i] {
+			return fmt.Errorf("value %d: got %d, want %d", i, v, want[i])
+		}
+	}
+	return nil
+}
+
+// blockFeatures returns the features used by an explained block.
+func blockFeatures(e fastpfor.BlockExplanation) []Feature {
+	var features []Feature
+	if e.Delta {
+		features = append(features, FeatureDelta)
+	}
+	if e.ZigZag {
+		features = append(features, FeatureZigZag)
+	}
+	if len(e.Exceptions) > 0 {
+		features = append(features, FeatureExceptions)
+	}
+	if e.IntType == fastpfor.IntTypeUint16 {
+		features = append(features, FeatureUint16)
+	}
+	if e.PositionRuns {
+		features = append(features, FeatureExceptionRuns)
+	}
+	if e.PositionBitmap {
+		features = append(features, FeatureExceptionBitmap)
+	}
+	if e.RawHighBits {
+		features = append(features, FeatureRawHighBits)
+	}
+	if len(features) == 0 {
+		features = append(features, FeaturePlain)
+	}
+	return features
+}
+
+func joinFeatures(features []Feature) string {
+	names := make([]string, len(features))
+	for i, f := range features {
+		names[i] = f.String()
+	}
+	return strings.Join(names, ",")
+}
+
+// LoadDir loads all vectors from dir. Every <name>.pfor file must have a
+// matching <name>.u32 file. Vectors are returned sorted by name.
+func LoadDir(dir string) ([]Vector, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*"+encodedExt))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+	vectors := make([]Vector, 0, len(paths))
+	for _, path := range paths {
+		v, err := LoadVector(strings.TrimSuffix(path, encodedExt))
+		if err != nil {
+			return nil, err
+		}
+		vectors = append(vectors, v)
+	}
+	return vectors, nil
+}
+
+// LoadVector loads the vector stored at base+".pfor" and base+".u32".
+func LoadVector(base string) (Vector, error) {
+	encoded, err := os.ReadFile(base + encodedExt)
+	if err != nil {
+		return Vector{}, err
+	}
+	raw, err := os.ReadFile(base + valuesExt)
+	if err != nil {
+		return Vector{}, err
+	}
+	if len(raw)%4 != 0 {
+		return Vector{}, fmt.Errorf("conformance: %s%s: length %d is not a multiple of 4", base, valuesExt, len(raw))
+	}
+	values := make([]uint32, len(raw)/4)
+	if err := binary.Read(bytes.NewReader(raw), binary.LittleEndian, values); err != nil {
+		return Vector{}, err
+	}
+	return Vector{Name: filepath.Base(base), Encoded: encoded, Values: values}, nil
+}
+
+// WriteVector stores v as dir/<v.Name>.pfor and dir/<v.Name>.u32.
+func WriteVector(dir string, v Vector) error {
+	if v.Name == "" {
+		return errors.New("conformance: vector without name")
+	}
+	base := filepath.Join(dir, v.Name)
+	if err := os.WriteFile(base+encodedExt, v.Encoded, 0o644); err != nil {
+		return err
+	}
+	raw := make([]byte, 4*len(v.Values))
+	for i, x := range v.Values {
+		binary.LittleEndian.PutUint32(raw[4*i:], x)
+	}
+	return os.WriteFile(base+valuesExt, raw, 0o644)
+}
+
+// WriteGolden writes the golden vectors (see Golden) to dir.
+func WriteGolden(dir string) error {
+	for _, v := range Golden() {
+		if err := WriteVector(dir, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Golden returns the reference vectors produced by this package's encoder.
+// The inputs are deterministic, so the vectors only change with the format.
+// Together they cover every Feature.
+func Golden() []Vector {
+	var vectors []Vector
+	add := func(name string, values []uint32, pack func(dst []byte, block []uint32) []byte) {
+		var encoded []byte
+		for rest := values; len(rest) > 0; {
+			n := min(len(rest), 128)
+			block := make([]uint32, n, 256) // spare capacity keeps packing allocation-free
+			copy(block, rest[:n])
+			encoded = pack(encoded, block)
+			rest = rest[n:]
+		}
+		vectors = append(vectors, Vector{Name: name, Encoded: encoded, Values: values})
+	}
+
+	// plain: small values, several full blocks plus a partial tail
+	plain := make([]uint32, 300)
+	for i := range plain {
+		plain[i] = uint32(i*7) % 1000
+	}
+	add("plain", plain, fastpfor.PackUint32)
+
+	// exceptions: mostly small values with sparse large outliers
+	exceptions := make([]uint32, 256)
+	for i := range exceptions {
+		exceptions[i] = uint32(i % 16)
+		if i%29 == 3 {
+			exceptions[i] = 1<<31 | uint32(i)
+		}
+	}
+	add("exceptions", exceptions, fastpfor.PackUint32)
+
+	// exceptions_varied: outliers of different magnitudes, so the high bits
+	// are stored with StreamVByte
+	varied := make([]uint32, 256)
+	for i := range varied {
+		varied[i] = uint32(i % 16)
+		if i%29 == 3 {
+			varied[i] = uint32(i) << (8 + i%24)
+		}
+	}
+	add("exceptions_varied", varied, fastpfor.PackUint32)
+
+	// delta: sorted document ids
+	delta := make([]uint32, 384)
+	var id uint32
+	for i := range delta {
+		id += uint32(1 + (i*13)%17)
+		delta[i] = id
+	}
+	add("delta", delta, fastpfor.PackDeltaUint32)
+
+	// zigzag: unsorted values with negative deltas and large jumps
+	zigzag := make([]uint32, 200)
+	for i := range zigzag {
+		zigzag[i] = uint32(10_000 + (i%9)*31 - (i%4)*57)
+		if i%41 == 7 {
+			zigzag[i] += 1 << 24
+		}
+	}
+	add("zigzag", zigzag, fastpfor.PackDeltaUint32)
+
+	// uint16: values packed with the uint16 type marker
+	uint16s := make([]uint32, 150)
+	for i := range uint16s {
+		uint16s[i] = uint32(i*433) & 0xFFFF
+	}
+	add("uint16", uint16s, func(dst []byte, block []uint32) []byte {
+		narrow := make([]uint16, len(block))
+		for i, v := range block {
+			narrow[i] = uint16(v)
+		}
+		return fastpfor.PackUint16(dst, narrow)
+	})
+
+	// exception_runs: bursts of large outliers at consecutive positions
+	bursts := make([]uint32, 200)
+	for i := range bursts {
+		bursts[i] = uint32(i % 8)
+		if i%64 >= 20 && i%64 < 32 {
+			bursts[i] = 1<<20 + uint32(i)
+		}
+	}
+	add("exception_runs", bursts, fastpfor.PackUint32)
+
+	// exception_bitmap: many large outliers at scattered positions
+	scattered := make([]uint32, 200)
+	for i := range scattered {
+		scattered[i] = uint32(i % 8)
+		if i%3 == 1 {
+			scattered[i] = 1<<20 + uint32(i)
+		}
+	}
+	add("exception_bitmap", scattered, fastpfor.PackUint32)
+
+	// empty: a stream consisting of one empty block
+	vectors = append(vectors, Vector{Name: "empty", Encoded: fastpfor.PackUint32(nil, nil), Values: []uint32{}})
+	return vectors
+}
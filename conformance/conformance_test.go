@@ -0,0 +1,115 @@
+package conformance
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Akron/fastpfor-go"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	vectorsDir = flag.String("vectors", "", "directory with externally produced vectors (<name>.pfor + <name>.u32)")
+	update     = flag.Bool("update", false, "rewrite the golden vectors in testdata/golden")
+)
+
+const goldenDir = "testdata/golden"
+
+// TestGolden verifies the stored golden vectors still decode and match the
+// vectors produced by the current encoder.
+func TestGolden(t *testing.T) {
+	assert := assert.New(t)
+
+	if *update {
+		assert.NoError(os.MkdirAll(goldenDir, 0o755))
+		assert.NoError(WriteGolden(goldenDir))
+	}
+
+	stored, err := LoadDir(goldenDir)
+	assert.NoError(err)
+	golden := Golden()
+	assert.Len(stored, len(golden))
+
+	byName := make(map[string]Vector, len(stored))
+	for _, v := range stored {
+		byName[v.Name] = v
+	}
+	for _, v := range golden {
+		s, ok := byName[v.Name]
+		if !assert.True(ok, "missing golden vector %s", v.Name) {
+			continue
+		}
+		assert.Equal(v.Encoded, s.Encoded, "encoding of %s changed (run with -update if intended)", v.Name)
+		assert.Equal(v.Values, s.Values, "values of %s changed", v.Name)
+	}
+
+	report := Run(stored)
+	assert.True(report.OK(), report.String())
+	for f := Feature(0); f < numFeatures; f++ {
+		assert.Greater(report.Features[f].Passed, 0, "feature %s not covered", f)
+		assert.Zero(report.Features[f].Failed)
+	}
+}
+
+// TestExternal checks the vectors in the directory given by -vectors.
+func TestExternal(t *testing.T) {
+	if *vectorsDir == "" {
+		t.Skip("no -vectors directory given")
+	}
+	vectors, err := LoadDir(*vectorsDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vectors) == 0 {
+		t.Fatalf("no vectors found in %s", *vectorsDir)
+	}
+	report := Run(vectors)
+	t.Log("\n" + report.String())
+	if !report.OK() {
+		t.Fail()
+	}
+}
+
+// TestRunReportsMismatch verifies mismatches and corrupt streams are attributed to features.
+func TestRunReportsMismatch(t *testing.T) {
+	assert := assert.New(t)
+
+	values := []uint32{1, 2, 3, 1 << 30}
+	encoded := fastpfor.PackUint32(nil, []uint32{1, 2, 3, 1 << 30})
+
+	report := Run([]Vector{
+		{Name: "ok", Encoded: encoded, Values: values},
+		{Name: "wrongValue", Encoded: encoded, Values: []uint32{1, 2, 4, 1 << 30}},
+		{Name: "tooFewValues", Encoded: encoded, Values: values[:2]},
+		{Name: "tooManyValues", Encoded: encoded, Values: append(values, 5)},
+		{Name: "truncated", Encoded: encoded[:len(encoded)-1], Values: values},
+	})
+	assert.False(report.OK())
+	assert.NoError(report.Vectors[0].Err)
+	assert.Equal(1, report.Vectors[0].Blocks)
+	for _, v := range report.Vectors[1:] {
+		assert.Error(v.Err, v.Name)
+	}
+	assert.Equal(2, report.Features[FeatureExceptions].Passed) // ok and tooManyValues decode fine
+	assert.Equal(2, report.Features[FeatureExceptions].Failed)
+	assert.Contains(report.String(), "wrongValue")
+}
+
+// TestWriteLoadVector verifies vectors round-trip through the file format.
+func TestWriteLoadVector(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+
+	v := Vector{Name: "roundtrip", Encoded: []byte{1, 2, 3}, Values: []uint32{7, 1 << 31}}
+	assert.NoError(WriteVector(dir, v))
+	got, err := LoadVector(filepath.Join(dir, "roundtrip"))
+	assert.NoError(err)
+	assert.Equal(v, got)
+
+	assert.NoError(os.WriteFile(filepath.Join(dir, "bad.pfor"), nil, 0o644))
+	assert.NoError(os.WriteFile(filepath.Join(dir, "bad.u32"), []byte{1, 2, 3}, 0o644))
+	_, err = LoadDir(dir)
+	assert.Error(err)
+}
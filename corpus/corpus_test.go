@@ -0,0 +1,228 @@
+package corpus
+
+import (
+	"bytes"
+	"compress/gzip"
+	"flag"
+	"fmt"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/Akron/fastpfor-go"
+	"github.com/stretchr/testify/assert"
+)
+
+var update = flag.Bool("update", false, "regenerate the bundled datasets in testdata")
+
+// datasetSize is the number of values of each generated dataset.
+const datasetSize = 1 << 16
+
+// generators produce the bundled datasets from the models in their
+// descriptions. The seeds are fixed so -update is reproducible.
+var generators = map[string]func(r *rand.Rand) []uint32{
+	"docids": func(r *rand.Rand) []uint32 {
+		values := make([]uint32, datasetSize)
+		doc := uint32(r.Intn(1000))
+		for i := range values {
+			if r.Intn(20) == 0 {
+				doc += 1 + uint32(r.ExpFloat64()*3000)
+			} else {
+				doc += 1 + uint32(r.ExpFloat64()*5)
+			}
+			values[i] = doc
+		}
+		return values
+	},
+	"timestamps": func(r *rand.Rand) []uint32 {
+		values := make([]uint32, datasetSize)
+		const day = 24 * 60 * 60 * 1000
+		var t float64
+		burst := 0
+		for i := range values {
+			// Mean gap between 20ms (peak) and 180ms (night).
+			mean := 100 - 80*math.Sin(2*math.Pi*math.Mod(t, day)/day)
+			if burst > 0 {
+				mean, burst = 1, burst-1
+			} else if r.Intn(2000) == 0 {
+				burst = 50 + r.Intn(200)
+			}
+			t += math.Floor(r.ExpFloat64() * mean)
+			values[i] = uint32(t)
+		}
+		return values
+	},
+	"counters": func(r *rand.Rand) []uint32 {
+		values := make([]uint32, datasetSize)
+		counter := r.Uint32()
+		for i := range values {
+			if r.Intn(20000) == 0 {
+				counter = 0
+			} else {
+				counter += uint32(math.Exp(11 + 1.5*r.NormFloat64()))
+			}
+			values[i] = counter
+		}
+		return values
+	},
+	"latencies": func(r *rand.Rand) []uint32 {
+		values := make([]uint32, datasetSize)
+		for i := range values {
+			v := math.Exp(math.Log(800) + 0.6*r.NormFloat64())
+			if r.Intn(100) == 0 {
+				// Slow requests: Pareto tail from 10ms.
+				v = 10000 / math.Pow(r.Float64(), 1/1.2)
+			}
+			values[i] = uint32(min(v, 60e6))
+		}
+		return values
+	},
+}
+
+func writeDataset(name string, values []uint32) error {
+	var buf bytes.Buffer
+	zw, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return err
+	}
+	if err := Encode(zw, values); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join("testdata", name+".bin.gz"), buf.Bytes(), 0o644)
+}
+
+// TestDatasets verifies the bundled datasets load, match their generators and
+// have the documented shape.
+func TestDatasets(t *testing.T) {
+	assert := assert.New(t)
+
+	if *update {
+		for i, d := range Datasets() {
+			values := generators[d.Name](rand.New(rand.NewSource(int64(i + 1))))
+			assert.NoError(writeDataset(d.Name, values))
+		}
+	}
+
+	assert.Len(Datasets(), len(generators))
+	for i, d := range Datasets() {
+		values, err := Load(d.Name)
+		if !assert.NoError(err, d.Name) {
+			continue
+		}
+		assert.Len(values, datasetSize, d.Name)
+		assert.Equal(d.Sorted, slices.IsSorted(values), d.Name)
+		generated := generators[d.Name](rand.New(rand.NewSource(int64(i + 1))))
+		assert.Equal(generated, values, "%s changed (run with -update if intended)", d.Name)
+	}
+}
+
+func TestLoadUnknown(t *testing.T) {
+	_, err := Load("nope")
+	assert.ErrorIs(t, err, ErrUnknownDataset)
+}
+
+// TestReadFile reads the clustered sample in the data directory of the repository.
+func TestReadFile(t *testing.T) {
+	assert := assert.New(t)
+
+	plain, err := ReadFile("../data/clustered100K.bin")
+	assert.NoError(err)
+	assert.Len(plain, 100000)
+	assert.True(slices.IsSorted(plain))
+
+	_, err = ReadFile("../data/missing.bin")
+	assert.ErrorIs(err, os.ErrNotExist)
+}
+
+func TestEncodeDecode(t *testing.T) {
+	assert := assert.New(t)
+
+	values := []uint32{0, 1, math.MaxUint32, 42}
+	var buf bytes.Buffer
+	assert.NoError(Encode(&buf, values))
+	assert.Equal(4+4*len(values), buf.Len())
+	encoded := buf.Bytes()
+
+	decoded, err := Decode(bytes.NewReader(encoded))
+	assert.NoError(err)
+	assert.Equal(values, decoded)
+
+	var zipped bytes.Buffer
+	zw := gzip.NewWriter(&zipped)
+	assert.NoError(Encode(zw, values))
+	assert.NoError(zw.Close())
+	decoded, err = Decode(&zipped)
+	assert.NoError(err)
+	assert.Equal(values, decoded)
+
+	empty, err := Decode(bytes.NewReader([]byte{0, 0, 0, 0}))
+	assert.NoError(err)
+	assert.Empty(empty)
+
+	for _, bad := range [][]byte{
+		nil,
+		encoded[:2],
+		encoded[:len(encoded)-1],
+		append(slices.Clone(encoded), 0),
+	} {
+		_, err := Decode(bytes.NewReader(bad))
+		assert.ErrorIs(err, ErrInvalidFile, "% x", bad)
+	}
+}
+
+var resultU32 []uint32
+
+// BenchmarkCodecs packs and unpacks every bundled dataset with each codec and
+// reports the compressed size in bits per value.
+func BenchmarkCodecs(b *testing.B) {
+	codecs := []struct {
+		name  string
+		codec fastpfor.Codec
+	}{
+		{"pfor", fastpfor.PFORCodec{}},
+		{"pfor-delta", fastpfor.PFORCodec{Delta: true}},
+		{"bitpacking", fastpfor.BitPackingCodec{}},
+		{"streamvbyte", fastpfor.StreamVByteCodec{}},
+	}
+	for _, d := range Datasets() {
+		values, err := Load(d.Name)
+		if err != nil {
+			b.Fatal(err)
+		}
+		for _, c := range codecs {
+			if c.name == "pfor-delta" && !d.Sorted {
+				continue
+			}
+			encoded := c.codec.Pack(nil, values)
+			bitsPerValue := float64(8*len(encoded)) / float64(len(values))
+
+			b.Run(fmt.Sprintf("%s/%s/pack", d.Name, c.name), func(b *testing.B) {
+				src := slices.Clone(values)
+				dst := make([]byte, 0, c.codec.MaxEncodedLen(len(values)))
+				b.SetBytes(int64(4 * len(values)))
+				b.ReportAllocs()
+				for range b.N {
+					copy(src, values)
+					dst = c.codec.Pack(dst[:0], src)
+				}
+				b.ReportMetric(bitsPerValue, "bits/value")
+			})
+			b.Run(fmt.Sprintf("%s/%s/unpack", d.Name, c.name), func(b *testing.B) {
+				dst := make([]uint32, 0, len(values))
+				b.SetBytes(int64(4 * len(values)))
+				b.ReportAllocs()
+				for range b.N {
+					dst, _, _ = c.codec.Unpack(dst[:0], encoded)
+				}
+				resultU32 = dst
+				b.ReportMetric(bitsPerValue, "bits/value")
+			})
+		}
+	}
+}
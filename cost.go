@@ -0,0 +1,52 @@
+package fastpfor
+
+// Abstract cost weights of DecodeCost, roughly in units of one scalar operation
+// per value. They were derived from the relative timings of the decode stages
+// (see BenchmarkDecodeCost): unpacking a payload word is cheaper than patching an
+// exception, which needs a StreamVByte decode and a scattered write.
+const (
+	costBlock     = 16 // header parsing and call overhead
+	costValue     = 1  // writing a decoded value
+	costWord      = 1  // reading a packed payload word
+	costException = 12 // decoding and applying an exception
+	costDelta     = 1  // prefix sum per value
+	costZigZag    = 1  // zigzag decoding per value
+	costOverflow  = 1  // overflow detection per value
+)
+
+// DecodeCost returns an abstract estimate of the work needed to decode the block
+// in buf, derived from its header (count, bit width, delta, zigzag and overflow
+// flags) and its exception count, without decoding the payload. Caching layers
+// can use it to weigh evictions by reconstruction cost rather than by size alone.
+//
+// The cost has no unit; it is only meaningful relative to the cost of other
+// blocks and may change between versions as the kernels change.
+func DecodeCost(buf []byte) (int, error) {
+	header, count, payloadStart, err := readHeader(buf)
+	if err != nil {
+		return 0, err
+	}
+	length, err := BlockLength(buf)
+	if err != nil {
+		return 0, err
+	}
+	_, bitWidth, _, hasExceptions, hasDelta, hasZigZag, willOverflow := decodeHeader(header)
+
+	cost := costBlock + count*costValue + payloadBytes(bitWidth)/4*costWord
+	if hasExceptions {
+		payloadEnd := payloadStart + payloadBytes(bitWidth)
+		if payloadEnd < length {
+			cost += int(buf[payloadEnd]) * costException
+		}
+	}
+	if hasDelta {
+		cost += count * costDelta
+		if hasZigZag {
+			cost += count * costZigZag
+		}
+		if willOverflow {
+			cost += count * costOverflow
+		}
+	}
+	return cost, nil
+}
@@ -0,0 +1,74 @@
+package fastpfor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeCost(t *testing.T) {
+	assert := assert.New(t)
+
+	cost := func(buf []byte) int {
+		c, err := DecodeCost(buf)
+		assert.NoError(err)
+		return c
+	}
+
+	empty := cost(PackUint32(nil, nil))
+	assert.Equal(costBlock, empty)
+
+	narrow := cost(PackUint32(nil, genValuesForBitWidth(4)))
+	wide := cost(PackUint32(nil, genValuesForBitWidth(20)))
+	assert.Greater(narrow, empty)
+	assert.Greater(wide, narrow)
+
+	seq := genSequential(blockSize)
+	assert.Less(cost(PackUint32(nil, seq[:32])), cost(PackUint32(nil, seq)))
+
+	withExc := cost(PackUint32(nil, genDataWithLargeExceptions()))
+	assert.Greater(withExc, cost(PackUint32(nil, genSequential(blockSize))))
+
+	// Delta blocks cost a prefix sum (and zigzag decoding) on top of unpacking the deltas
+	deltas := make([]uint32, blockSize)
+	monotonic := genMonotonic(blockSize)
+	deltaEncodeScalar(deltas, monotonic)
+	delta := cost(PackDeltaUint32(nil, monotonic))
+	assert.Equal(cost(PackUint32(nil, deltas))+blockSize*costDelta, delta)
+	mixed := genMixed(blockSize)
+	deltaEncodeScalar(deltas, mixed)
+	zigzag := cost(PackDeltaUint32(nil, mixed))
+	assert.Equal(cost(PackUint32(nil, deltas))+blockSize*(costDelta+costZigZag), zigzag)
+
+	overflow := cost(PackAlreadyDeltaUint32(nil, []uint32{0xFFFFFFF0, 0x20, 5}))
+	noOverflow := cost(PackAlreadyDeltaUint32(nil, []uint32{0x7FFFFFF0, 0x20, 5}))
+	assert.Greater(overflow, noOverflow)
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := DecodeCost([]byte{1})
+		assert.ErrorIs(err, ErrInvalidBuffer)
+		buf := PackUint32(nil, genDataWithLargeExceptions())
+		_, payload, _, _ := SplitEncoded(buf)
+		_, err = DecodeCost(buf[:headerBytes+len(payload)+1])
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+}
+
+func BenchmarkDecodeCost(b *testing.B) {
+	for name, buf := range map[string][]byte{
+		"width8":     PackUint32(nil, genValuesForBitWidth(8)),
+		"width24":    PackUint32(nil, genValuesForBitWidth(24)),
+		"exceptions": PackUint32(nil, genDataWithLargeExceptions()),
+		"delta":      PackDeltaUint32(nil, genMonotonic(blockSize)),
+		"zigzag":     PackDeltaUint32(nil, genMixed(blockSize)),
+	} {
+		cost, _ := DecodeCost(buf)
+		b.Run(name, func(b *testing.B) {
+			dst := make([]uint32, 0, blockSize)
+			b.ReportMetric(float64(cost), "cost")
+			for range b.N {
+				dst, _ = UnpackUint32(dst[:0], buf)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package fastpfor
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrSIMDUnavailable is returned by RequireSIMD when only the scalar kernels are active.
+var ErrSIMDUnavailable = errors.New("fastpfor: SIMD kernels unavailable")
+
+// CPUFeatures is a set of CPU features used to select the pack/unpack kernels.
+type CPUFeatures uint32
+
+// CPU features known to the kernel selection.
+const (
+	CPUFeatureSSE2   CPUFeatures = 1 << iota // SSE2 kernels (amd64 without the noasm tag)
+	CPUFeatureAVX2                           // AVX2 pack/unpack kernels and gathers for batched random access
+	CPUFeatureAVX512                         // AVX-512 pack/unpack, delta decode and zigzag kernels
+)
+
+// detectedFeatures holds the features detected at init.
+var detectedFeatures CPUFeatures
+
+// String returns the feature names separated by "+", or "scalar" for no features.
+func (f CPUFeatures) String() string {
+	var names []string
+	if f&CPUFeatureSSE2 != 0 {
+		names = append(names, "sse2")
+	}
+	if f&CPUFeatureAVX2 != 0 {
+		names = append(names, "avx2")
+	}
+	if f&CPUFeatureAVX512 != 0 {
+		names = append(names, "avx512")
+	}
+	if len(names) == 0 {
+		return "scalar"
+	}
+	return strings.Join(names, "+")
+}
+
+// DetectedCPUFeatures returns the CPU features detected at init, independent of
+// SetCPUFeatures. Builds without assembly (other architectures or the noasm tag)
+// detect no features.
+func DetectedCPUFeatures() CPUFeatures {
+	return detectedFeatures
+}
+
+// SetCPUFeatures re-selects the kernels as if the CPU only supported features,
+// and returns the previously active set. Features that were not detected are
+// ignored, so the result is always safe to run. This is a test hook: it lets
+// integration tests cover every dispatch path (e.g. scalar only) on a single
+// machine:
+//
+//	defer fastpfor.SetCPUFeatures(fastpfor.SetCPUFeatures(0)) // force scalar kernels
+//
+// SetCPUFeatures must not be called concurrently with packing or unpacking.
+func SetCPUFeatures(features CPUFeatures) CPUFeatures {
+	prev := activeFeatures
+	selectKernels(features & detectedFeatures)
+	return prev
+}
+
+// KernelOption configures the kernel selection of ConfigureKernels.
+type KernelOption func(*CPUFeatures)
+
+// WithScalarOnly pins the scalar kernels, e.g. to benchmark or reproduce results
+// independent of the CPU. Encoded blocks are byte-identical either way.
+func WithScalarOnly() KernelOption {
+	return func(f *CPUFeatures) { *f = 0 }
+}
+
+// ConfigureKernels selects the kernels for all detected CPU features, restricted
+// by opts, and returns the active set. Call it once at startup; like
+// SetCPUFeatures, it must not be called concurrently with packing or unpacking:
+//
+//	fastpfor.ConfigureKernels(fastpfor.WithScalarOnly())
+func ConfigureKernels(opts ...KernelOption) CPUFeatures {
+	features := detectedFeatures
+	for _, opt := range opts {
+		opt(&features)
+	}
+	selectKernels(features & detectedFeatures)
+	return activeFeatures
+}
+
+// RequireSIMD returns an error wrapping ErrSIMDUnavailable if the SIMD kernels
+// are not active, because the CPU lacks them, the build uses the noasm tag or
+// the scalar kernels were pinned. Deployments that depend on the acceleration
+// can check it at startup instead of running several times slower:
+//
+//	if err := fastpfor.RequireSIMD(); err != nil {
+//		log.Fatal(err)
+//	}
+func RequireSIMD() error {
+	if simdAvailable {
+		return nil
+	}
+	return fmt.Errorf("%w (active %s, detected %s)", ErrSIMDUnavailable, activeFeatures, detectedFeatures)
+}
+
+// activeFeatures holds the features of the installed kernels.
+var activeFeatures CPUFeatures
+
+// selectKernels installs the scalar kernels, then the SIMD kernels supported by
+// features.
+func selectKernels(features CPUFeatures) {
+	packLanes = packLanesScalar
+	packLanesExceptions = packLanesExceptionsScalar
+	unpackLanes = unpackLanesScalar
+	unpackDeltaLanes = unpackDeltaLanesSeparate
+	deltaEncode = deltaEncodeScalar
+	deltaDecode = deltaDecodeScalar
+	deltaDecodeWithOverflow = deltaDecodeWithOverflowScalar
+	deltaDecodeD4 = deltaDecodeD4Scalar
+	collectExceptions = collectExceptionsDirect
+	zigzagEncodeBlock = zigzagEncodeScalar
+	zigzagDecodeBlock = zigzagDecodeScalar
+	gatherLanes = gatherLanesScalar
+	simdAvailable = false
+
+	initSIMDSelection(features)
+	activeFeatures = features
+}
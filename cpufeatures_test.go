@@ -0,0 +1,79 @@
+package fastpfor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSetCPUFeatures runs round trips through every kernel selection.
+func TestSetCPUFeatures(t *testing.T) {
+	assert := assert.New(t)
+	detected := DetectedCPUFeatures()
+	defer SetCPUFeatures(SetCPUFeatures(detected))
+
+	inputs := map[string][]uint32{
+		"exceptions": genDataWithLargeExceptions(),
+		"monotonic":  genMonotonic(blockSize),
+		"mixed":      genMixed(blockSize),
+		// Sorted, crossing 2^31: the signed SIMD compare must not pick zigzag
+		"sortedLarge":        sortedRandom(blockSize),
+		"sortedLargePartial": sortedRandom(10),
+	}
+	var reference map[string][]byte
+	for _, features := range []CPUFeatures{0, CPUFeatureSSE2, CPUFeatureSSE2 | CPUFeatureAVX2, CPUFeatureSSE2 | CPUFeatureAVX2 | CPUFeatureAVX512} {
+		SetCPUFeatures(features)
+		assert.Equal(features&detected != 0, IsSIMDavailable(), features.String())
+
+		encoded := make(map[string][]byte)
+		for name, values := range inputs {
+			buf := PackUint32(nil, values)
+			got, err := UnpackUint32(nil, buf)
+			assert.NoError(err)
+			assert.Equal(values, got, "%s %s", features, name)
+
+			dbuf := PackDeltaUint32(nil, append(make([]uint32, 0, 2*blockSize), values...))
+			got, err = UnpackUint32(nil, dbuf)
+			assert.NoError(err)
+			assert.Equal(values, got, "%s %s delta", features, name)
+			encoded[name] = append(buf, dbuf...)
+		}
+		// All kernel selections must produce the same encoding
+		if reference == nil {
+			reference = encoded
+		} else {
+			assert.Equal(reference, encoded, features.String())
+		}
+	}
+
+	t.Run("undetectedIgnored", func(t *testing.T) {
+		SetCPUFeatures(^CPUFeatures(0))
+		assert.Equal(detected != 0, IsSIMDavailable())
+	})
+
+	t.Run("string", func(t *testing.T) {
+		assert.Equal("scalar", CPUFeatures(0).String())
+		assert.Equal("sse2", CPUFeatureSSE2.String())
+		assert.Equal("sse2+avx2", (CPUFeatureSSE2 | CPUFeatureAVX2).String())
+		assert.Equal("sse2+avx2+avx512", (CPUFeatureSSE2 | CPUFeatureAVX2 | CPUFeatureAVX512).String())
+	})
+}
+
+// TestRequireSIMD checks RequireSIMD against scalar-only and default selections.
+func TestRequireSIMD(t *testing.T) {
+	assert := assert.New(t)
+	defer SetCPUFeatures(SetCPUFeatures(DetectedCPUFeatures()))
+
+	assert.Equal(CPUFeatures(0), ConfigureKernels(WithScalarOnly()))
+	assert.False(IsSIMDavailable())
+	err := RequireSIMD()
+	assert.ErrorIs(err, ErrSIMDUnavailable)
+	assert.Contains(err.Error(), "active scalar")
+
+	assert.Equal(DetectedCPUFeatures(), ConfigureKernels())
+	if DetectedCPUFeatures() != 0 {
+		assert.NoError(RequireSIMD())
+	} else {
+		assert.ErrorIs(RequireSIMD(), ErrSIMDUnavailable)
+	}
+}
@@ -0,0 +1,28 @@
+package fastpfor
+
+// Decoder unpacks blocks like UnpackUint32, using its own scratch space for the
+// exceptions and, if dst cannot hold a full block, its own output block.
+// Successful decoding is allocation-free whatever the capacity of dst.
+//
+// The zero value is ready to use. A Decoder is not safe for concurrent use;
+// reuse one per goroutine:
+//
+//	var dec fastpfor.Decoder
+//	for _, block := range blocks {
+//		values, err := dec.Unpack(nil, block) // valid until the next Unpack
+//		...
+//	}
+type Decoder struct {
+	storage [2*blockSize + 4]uint32 // output block (aligned for the SIMD kernels) and exception scratch
+}
+
+// Unpack decodes buf like UnpackUint32. If cap(dst) is below BlockSize, the
+// values are decoded into the output block of the Decoder instead, which the
+// next call to Unpack overwrites.
+func (d *Decoder) Unpack(dst []uint32, buf []byte) ([]uint32, error) {
+	storage := alignedScratch(&d.storage)
+	if cap(dst) < blockSize {
+		dst = storage[:0:blockSize]
+	}
+	return UnpackUint32WithBuffer(dst, storage[blockSize:], buf)
+}
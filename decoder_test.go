@@ -0,0 +1,46 @@
+package fastpfor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecoder(t *testing.T) {
+	assert := assert.New(t)
+
+	var dec Decoder
+	inputs := map[string][]byte{
+		"exceptions": PackUint32(nil, genDataWithLargeExceptions()),
+		"delta":      PackDeltaUint32(nil, genMonotonic(blockSize)),
+		"zigzag":     PackDeltaUint32(nil, genMixed(blockSize)[:77]),
+	}
+	for name, buf := range inputs {
+		want, err := UnpackUint32(nil, buf)
+		assert.NoError(err)
+
+		got, err := dec.Unpack(nil, buf)
+		assert.NoError(err)
+		assert.Equal(want, got, name)
+
+		dst := make([]uint32, 3, blockSize)
+		got, err = dec.Unpack(dst, buf)
+		assert.NoError(err)
+		assert.Equal(want, got, name)
+		assert.Same(&dst[0], &got[0], "%s: dst not used", name)
+	}
+
+	t.Run("allocationFree", func(t *testing.T) {
+		buf := PackDeltaUint32(nil, genDataWithLargeExceptions())
+		small := make([]uint32, 0, 16)
+		assert.Zero(testing.AllocsPerRun(10, func() {
+			_, _ = dec.Unpack(nil, buf)
+			_, _ = dec.Unpack(small, buf)
+		}))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := dec.Unpack(nil, []byte{1})
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+}
@@ -24,10 +24,10 @@ TEXT ·deltaEncodeSIMDAsm(SB), NOSPLIT, $0-28
 delta_encode_unroll_loop:
 	CMPQ    SI, R9
 	JAE     delta_encode_unroll_done
-	MOVO    (CX)(SI*4), X2
-	MOVO    16(CX)(SI*4), X4
-	MOVO    32(CX)(SI*4), X5
-	MOVO    48(CX)(SI*4), X6
+	MOVOU   (CX)(SI*4), X2
+	MOVOU   16(CX)(SI*4), X4
+	MOVOU   32(CX)(SI*4), X5
+	MOVOU   48(CX)(SI*4), X6
 	MOVO    X2, X3
 	PSLLDQ  $0x04, X3
 	POR     X0, X3
@@ -76,7 +76,7 @@ delta_encode_unroll_done:
 delta_encode_vec_loop:
 	CMPQ    SI, BX
 	JAE     delta_encode_vec_done
-	MOVO    (CX)(SI*4), X2
+	MOVOU   (CX)(SI*4), X2
 	MOVO    X2, X2
 	MOVO    X2, X3
 	PSLLDQ  $0x04, X3
@@ -464,3 +464,50 @@ delta_decode_ovf_no_simd:
 delta_decode_ovf_return:
 	MOVB CL, ret+24(FP)
 	RET
+
+// func deltaDecodeD4SIMDAsm(dst *uint32, src *uint32, n int)
+// Requires: SSE2
+TEXT ·deltaDecodeD4SIMDAsm(SB), NOSPLIT, $0-24
+	MOVQ dst+0(FP), AX
+	MOVQ src+8(FP), CX
+	MOVQ n+16(FP), DX
+	XORQ BX, BX
+	PXOR X0, X0
+	MOVQ DX, SI
+	ANDQ $0xfffffff0, SI
+
+delta_decode_d4_unroll_loop:
+	CMPQ  BX, SI
+	JAE   delta_decode_d4_unroll_done
+	MOVOU (CX)(BX*4), X1
+	MOVOU 16(CX)(BX*4), X2
+	MOVOU 32(CX)(BX*4), X3
+	MOVOU 48(CX)(BX*4), X4
+	PADDL X0, X1
+	MOVOU X1, (AX)(BX*4)
+	MOVO  X1, X0
+	PADDL X0, X2
+	MOVOU X2, 16(AX)(BX*4)
+	MOVO  X2, X0
+	PADDL X0, X3
+	MOVOU X3, 32(AX)(BX*4)
+	MOVO  X3, X0
+	PADDL X0, X4
+	MOVOU X4, 48(AX)(BX*4)
+	MOVO  X4, X0
+	ADDQ  $0x10, BX
+	JMP   delta_decode_d4_unroll_loop
+
+delta_decode_d4_unroll_done:
+delta_decode_d4_vec_loop:
+	CMPQ  BX, DX
+	JAE   delta_decode_d4_done
+	MOVOU (CX)(BX*4), X1
+	PADDL X0, X1
+	MOVOU X1, (AX)(BX*4)
+	MOVO  X1, X0
+	ADDQ  $0x04, BX
+	JMP   delta_decode_d4_vec_loop
+
+delta_decode_d4_done:
+	RET
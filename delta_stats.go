@@ -0,0 +1,54 @@
+package fastpfor
+
+import "math/bits"
+
+// DeltaStats describes the first-order deltas of a block as PackDeltaUint32 would
+// compute them (the first value is the delta from an implicit 0). It lets callers
+// decide before packing whether delta coding pays off for a block, whether zigzag
+// is needed, or whether negative deltas are rare enough for a separate sign plane.
+type DeltaStats struct {
+	Count       int    // number of deltas (= number of values)
+	Negative    int    // number of negative deltas (values[i] < values[i-1])
+	MaxNegative uint32 // largest magnitude of a negative delta (0 if there is none)
+	MaxPositive uint32 // largest non-negative delta
+	ZigZag      bool   // whether PackDeltaUint32 zigzag-encodes the deltas (Negative > 0)
+
+	// DeltaBitWidth is the bit width needed to store all deltas as PackDeltaUint32
+	// would (zigzag-encoded if ZigZag is set), ignoring exceptions.
+	DeltaBitWidth int
+	// ValueBitWidth is the bit width needed to store the values without delta coding.
+	ValueBitWidth int
+}
+
+// AnalyzeDeltas computes the delta statistics of values without modifying them.
+// Values longer than a block are analyzed as a whole.
+func AnalyzeDeltas(values []uint32) DeltaStats {
+	s := DeltaStats{Count: len(values)}
+	if len(values) == 0 {
+		return s
+	}
+
+	var prev, orValues, orPlain, orZigZag uint32
+	for _, v := range values {
+		delta := v - prev
+		if v < prev {
+			s.Negative++
+			s.MaxNegative = max(s.MaxNegative, prev-v)
+		} else {
+			s.MaxPositive = max(s.MaxPositive, delta)
+		}
+		orValues |= v
+		orPlain |= delta
+		orZigZag |= zigzagEncode32(int32(delta))
+		prev = v
+	}
+
+	s.ZigZag = s.Negative > 0
+	s.ValueBitWidth = bits.Len32(orValues)
+	if s.ZigZag {
+		s.DeltaBitWidth = bits.Len32(orZigZag)
+	} else {
+		s.DeltaBitWidth = bits.Len32(orPlain)
+	}
+	return s
+}
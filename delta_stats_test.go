@@ -0,0 +1,64 @@
+package fastpfor
+
+import (
+	"math/bits"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAnalyzeDeltas verifies the statistics against the actual delta encoding.
+func TestAnalyzeDeltas(t *testing.T) {
+	assert := assert.New(t)
+
+	for name, values := range map[string][]uint32{
+		"empty":      nil,
+		"single":     {42},
+		"monotonic":  genMonotonic(blockSize),
+		"sequential": genSequential(blockSize),
+		"mixed":      genMixed(blockSize),
+		"negative":   {10, 8, 20, 5, 5, 100},
+		"wrap":       {0, 0xFFFFFFFF, 0},
+	} {
+		t.Run(name, func(t *testing.T) {
+			orig := slices.Clone(values)
+			s := AnalyzeDeltas(values)
+			assert.Equal(orig, values, "AnalyzeDeltas must not modify its input")
+			assert.Equal(len(values), s.Count)
+
+			deltas := slices.Clone(values)
+			var zigzag bool
+			if len(deltas) > 0 {
+				zigzag = deltaEncode(deltas, deltas)
+			}
+			assert.Equal(zigzag, s.ZigZag)
+			assert.Equal(requiredBitWidthScalar(deltas), s.DeltaBitWidth)
+			assert.Equal(requiredBitWidthScalar(values), s.ValueBitWidth)
+		})
+	}
+
+	t.Run("negatives", func(t *testing.T) {
+		s := AnalyzeDeltas([]uint32{10, 8, 20, 5, 5, 100})
+		assert.Equal(2, s.Negative)
+		assert.Equal(uint32(15), s.MaxNegative)
+		assert.Equal(uint32(95), s.MaxPositive)
+		assert.Equal(bits.Len32(zigzagEncode32(95)), s.DeltaBitWidth)
+	})
+
+	t.Run("sorted", func(t *testing.T) {
+		s := AnalyzeDeltas([]uint32{100, 101, 103, 110})
+		assert.Zero(s.Negative)
+		assert.Zero(s.MaxNegative)
+		assert.Equal(uint32(100), s.MaxPositive) // first value is the delta from 0
+		assert.False(s.ZigZag)
+	})
+}
+
+func BenchmarkAnalyzeDeltas(b *testing.B) {
+	values := genMixed(blockSize)
+	b.ReportAllocs()
+	for range b.N {
+		_ = AnalyzeDeltas(values)
+	}
+}
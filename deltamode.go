@@ -0,0 +1,145 @@
+package fastpfor
+
+import "fmt"
+
+// DeltaMode selects which earlier value each value of a delta block is coded
+// against (see PackDeltaUint32Mode). The mode is recorded in the block header,
+// so UnpackUint32 and the readers decode every mode transparently.
+type DeltaMode uint8
+
+const (
+	// DeltaD1 codes each value against its predecessor (δi = xi − xi−1). It
+	// gives the smallest deltas and is the mode of PackDeltaUint32.
+	DeltaD1 DeltaMode = iota
+	// DeltaD4 codes each value against the value four positions before
+	// (δi = xi − xi−4). The deltas of sorted data are about four times larger,
+	// but decoding is one vector addition per four values instead of a prefix
+	// sum within the vector.
+	DeltaD4
+	// DeltaDM codes each group of four values against the last value of the
+	// previous group (δi = xi − x4⌊i/4⌋−1), between D1 and D4 in size and speed.
+	DeltaDM
+	// DeltaDD applies delta coding twice (δi = (xi − xi−1) − (xi−1 − xi−2)), so
+	// values are coded against the linear prediction 2xi−1 − xi−2. Evenly spaced
+	// values such as timestamps have second-order deltas near zero; decoding runs
+	// the prefix sum twice.
+	DeltaDD
+
+	numDeltaModes = 4
+)
+
+// String returns the name of the mode ("D1", "D4", "DM" or "DD").
+func (m DeltaMode) String() string {
+	switch m {
+	case DeltaD1:
+		return "D1"
+	case DeltaD4:
+		return "D4"
+	case DeltaDM:
+		return "DM"
+	case DeltaDD:
+		return "DD"
+	}
+	return fmt.Sprintf("DeltaMode(%d)", uint8(m))
+}
+
+// headerDeltaMode returns the delta mode recorded in header.
+func headerDeltaMode(header uint32) DeltaMode {
+	return DeltaMode(header >> headerDeltaModeShift & headerDeltaModeMask)
+}
+
+// deltaBase returns the value that values[i] is coded against in mode m.
+func deltaBase(values []uint32, i int, m DeltaMode) uint32 {
+	switch {
+	case m == DeltaD1 && i > 0:
+		return values[i-1]
+	case m == DeltaD4 && i >= 4:
+		return values[i-4]
+	case m == DeltaDM && i >= 4:
+		return values[i&^3-1]
+	case m == DeltaDD && i >= 2:
+		return 2*values[i-1] - values[i-2]
+	case m == DeltaDD && i == 1:
+		return values[0]
+	}
+	return 0
+}
+
+// deltaEncodeMode computes the deltas of src in mode m into dst (which may
+// alias src), zigzag-encoded if any of them is negative. Returns true if
+// zigzag encoding was applied.
+func deltaEncodeMode(dst, src []uint32, m DeltaMode) bool {
+	if m == DeltaD1 {
+		return deltaEncode(dst, src)
+	}
+	needZigZag := false
+	for i := range src {
+		base := deltaBase(src, i, m)
+		negative := src[i] < base
+		if m == DeltaDD {
+			// The prediction may wrap around, so the sign is taken from the delta
+			negative = i > 0 && int32(src[i]-base) < 0
+		}
+		if negative {
+			needZigZag = true
+			break
+		}
+	}
+	// Backwards, so every base is read before it is overwritten
+	for i := len(src) - 1; i >= 0; i-- {
+		delta := src[i] - deltaBase(src, i, m)
+		if needZigZag {
+			delta = zigzagEncode32(int32(delta))
+		}
+		dst[i] = delta
+	}
+	return needZigZag
+}
+
+// deltaDecodeMode reconstructs the values from the deltas in buf in place.
+func deltaDecodeMode(buf []uint32, m DeltaMode, useZigZag bool) {
+	if m == DeltaD1 {
+		deltaDecode(buf, buf, useZigZag)
+		return
+	}
+	if useZigZag {
+		zigzagDecodeBlock(buf)
+	}
+	switch m {
+	case DeltaD4:
+		deltaDecodeD4(buf)
+		return
+	case DeltaDD:
+		// The first-order deltas start at position 1, as position 0 holds x0
+		if len(buf) > 1 {
+			deltaDecode(buf[1:], buf[1:], false)
+		}
+		deltaDecode(buf, buf, false)
+		return
+	}
+	// DM: every group adds the last value of the previous group
+	var base uint32
+	i := 0
+	for ; i+4 <= len(buf); i += 4 {
+		group := buf[i : i+4 : i+4]
+		group[0] += base
+		group[1] += base
+		group[2] += base
+		group[3] += base
+		base = group[3]
+	}
+	for ; i < len(buf); i++ {
+		buf[i] += base
+	}
+}
+
+// deltaDecodeD4 reconstructs D4-coded values in place. It is replaced by a
+// SIMD kernel where available.
+var deltaDecodeD4 func(buf []uint32) = deltaDecodeD4Scalar
+
+// deltaDecodeD4Scalar adds to every value the value four positions before.
+func deltaDecodeD4Scalar(buf []uint32) {
+	for i := 4; i < len(buf); i++ {
+		buf[i] += buf[i-4]
+	}
+}
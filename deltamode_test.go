@@ -0,0 +1,203 @@
+package fastpfor
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// deltaModeInputs returns mostly sorted, unsorted and overflowing inputs.
+func deltaModeInputs() map[string][]uint32 {
+	return map[string][]uint32{
+		"sequential":   genSequential(blockSize),
+		"partial":      genSequential(61),
+		"three":        {5, 9, 12},
+		"mixed":        genMixed(blockSize),
+		"wrap":         {0xFFFFFFF0, 0xFFFFFFF8, 3, 0xFFFFFFFF, 1, 2, 0, 0xFFFFFFFE, 4},
+		"sortedLarge":  genLargeSorted(blockSize),
+		"singleValue":  {42},
+		"manyOutliers": genDataWithSmallExceptions(),
+		"timestamps":   genTimestamps(blockSize),
+	}
+}
+
+func genLargeSorted(n int) []uint32 {
+	out := make([]uint32, n)
+	v := uint32(1000)
+	for i := range out {
+		v += uint32(i%7) + uint32(i%3)*100
+		out[i] = v
+	}
+	return out
+}
+
+// genTimestamps returns seconds-spaced millisecond timestamps with jitter.
+func genTimestamps(n int) []uint32 {
+	out := make([]uint32, n)
+	for i := range out {
+		out[i] = 1_700_000_000 + uint32(i)*1000 + uint32(i*37%11)
+	}
+	return out
+}
+
+// TestDeltaModes verifies that every delta mode round-trips through all
+// decoders and the readers.
+func TestDeltaModes(t *testing.T) {
+	for _, mode := range []DeltaMode{DeltaD1, DeltaD4, DeltaDM, DeltaDD} {
+		for name, values := range deltaModeInputs() {
+			t.Run(fmt.Sprintf("%s/%s", mode, name), func(t *testing.T) {
+				assert := assert.New(t)
+				input := append([]uint32(nil), values...)
+				buf := PackDeltaUint32Mode(nil, input, mode)
+				assert.Equal(values, input, "input must not be modified")
+
+				got, err := UnpackUint32(nil, buf)
+				assert.NoError(err)
+				assert.Equal(values, got)
+
+				got, err = UnpackUint32WithBuffer(nil, make([]uint32, blockSize), buf)
+				assert.NoError(err)
+				assert.Equal(values, got)
+
+				first, err := UnpackFirstN(nil, buf, len(values)/2+1)
+				assert.NoError(err)
+				assert.Equal(values[:len(values)/2+1], first)
+
+				r := NewReader()
+				assert.NoError(r.Load(buf))
+				for i, v := range values {
+					got, err := r.Get(i)
+					assert.NoError(err)
+					assert.Equal(v, got, "Reader.Get(%d)", i)
+				}
+
+				s := NewSlimReader()
+				assert.NoError(s.Load(buf))
+				for i, v := range values {
+					got, err := s.Get(i)
+					assert.NoError(err)
+					assert.Equal(v, got, "SlimReader.Get(%d)", i)
+				}
+				for i, v := range values {
+					got, pos, ok := s.Next()
+					assert.True(ok)
+					assert.Equal(uint8(i), pos)
+					assert.Equal(v, got, "SlimReader.Next at %d", i)
+				}
+				s.Reset()
+				for i, v := range values {
+					got, _, ok := s.Next()
+					assert.True(ok)
+					assert.Equal(v, got, "SlimReader.Next at %d after Reset", i)
+				}
+				assert.Equal(values, s.Decode(nil))
+				lo, hi := len(values)/3, len(values)-len(values)/4
+				part, err := s.DecodeRange(nil, lo, hi)
+				assert.NoError(err)
+				assert.Equal(values[lo:hi], part)
+
+				sorted := isNonDecreasing(values)
+				assert.Equal(sorted, r.IsSorted())
+				monotonic, err := IsMonotonic(buf)
+				assert.NoError(err)
+				assert.Equal(sorted, monotonic)
+
+				e, err := Explain(buf)
+				assert.NoError(err)
+				assert.Equal(mode, e.DeltaMode)
+			})
+		}
+	}
+}
+
+// TestDeltaModeHeader verifies that the delta mode bits are validated even
+// with relaxed header checking.
+func TestDeltaModeHeader(t *testing.T) {
+	assert := assert.New(t)
+	t.Cleanup(func() { SetRelaxedHeaders(false) })
+
+	for _, relaxed := range []bool{false, true} {
+		SetRelaxedHeaders(relaxed)
+
+		buf := PackDeltaUint32Mode(nil, genSequential(blockSize), DeltaD4)
+		bo.PutUint32(buf, bo.Uint32(buf)|headerWillOverflowFlag)
+		_, err := UnpackUint32(nil, buf)
+		assert.ErrorIs(err, ErrInvalidFlags)
+		assert.ErrorIs(NewSlimReader().Load(buf), ErrInvalidFlags)
+
+		buf = PackUint32(nil, genSequential(blockSize))
+		bo.PutUint32(buf, bo.Uint32(buf)|uint32(DeltaD4)<<headerDeltaModeShift)
+		_, err = UnpackUint32(nil, buf)
+		assert.ErrorIs(err, ErrInvalidFlags)
+		_, err = BlockLength(buf)
+		assert.ErrorIs(err, ErrInvalidFlags)
+	}
+
+	assert.Panics(func() { PackDeltaUint32Mode(nil, []uint32{1}, numDeltaModes) })
+	assert.Equal("DD", DeltaDD.String())
+	assert.Equal("DeltaMode(7)", DeltaMode(7).String())
+}
+
+// TestDeltaDecodeD4Kernels verifies the D4 decode kernel of every tier against
+// the scalar reference, for all lengths up to a block.
+func TestDeltaDecodeD4Kernels(t *testing.T) {
+	assert := assert.New(t)
+	t.Cleanup(func() { ConfigureKernels() })
+
+	src := genMixed(blockSize)
+	for _, scalarOnly := range []bool{false, true} {
+		if scalarOnly {
+			ConfigureKernels(WithScalarOnly())
+		} else {
+			ConfigureKernels()
+		}
+		for n := 0; n <= blockSize; n++ {
+			want := append([]uint32(nil), src[:n]...)
+			deltaDecodeD4Scalar(want)
+			got := append([]uint32(nil), src[:n]...)
+			deltaDecodeD4(got)
+			assert.Equal(want, got, "n=%d scalarOnly=%t", n, scalarOnly)
+		}
+	}
+}
+
+// TestDeltaDDTimestamps verifies that second-order deltas shrink evenly
+// spaced timestamps well below the first-order deltas.
+func TestDeltaDDTimestamps(t *testing.T) {
+	assert := assert.New(t)
+
+	values := genTimestamps(blockSize)
+	d1 := PackDeltaUint32(nil, values)
+	dd := PackDeltaUint32Mode(nil, values, DeltaDD)
+	assert.Less(len(dd), len(d1)*3/4)
+
+	e, err := Explain(dd)
+	assert.NoError(err)
+	assert.True(e.ZigZag)
+	assert.Equal(DeltaDD, e.DeltaMode)
+
+	// Constant spacing leaves only the first two positions non-zero
+	linear := make([]uint32, blockSize)
+	for i := range linear {
+		linear[i] = 5000 + uint32(i)*250
+	}
+	deltas := make([]uint32, blockSize)
+	assert.False(deltaEncodeMode(deltas, linear, DeltaDD))
+	assert.Equal([]uint32{5000, 250}, deltas[:2])
+	assert.Equal(make([]uint32, blockSize-2), deltas[2:])
+}
+
+func BenchmarkDeltaModeUnpack(b *testing.B) {
+	values := genLargeSorted(blockSize)
+	dst := make([]uint32, blockSize)
+	for _, mode := range []DeltaMode{DeltaD1, DeltaD4, DeltaDM, DeltaDD} {
+		buf := PackDeltaUint32Mode(nil, values, mode)
+		b.Run(mode.String(), func(b *testing.B) {
+			b.SetBytes(int64(len(buf)))
+			for i := 0; i < b.N; i++ {
+				dst, _ = UnpackUint32(dst[:0], buf)
+			}
+		})
+	}
+}
@@ -0,0 +1,349 @@
+//go:build amd64 && !noasm
+
+#include "textflag.h"
+
+// Kernel tables of the SSE2 bit packing kernels in pack_amd64.s and
+// unpack_amd64.s, indexed by bit width. Entry 0 is unused.
+
+DATA ·pack32TableSSE2+8(SB)/8, $·pack32_1(SB)
+DATA ·pack32TableSSE2+16(SB)/8, $·pack32_2(SB)
+DATA ·pack32TableSSE2+24(SB)/8, $·pack32_3(SB)
+DATA ·pack32TableSSE2+32(SB)/8, $·pack32_4(SB)
+DATA ·pack32TableSSE2+40(SB)/8, $·pack32_5(SB)
+DATA ·pack32TableSSE2+48(SB)/8, $·pack32_6(SB)
+DATA ·pack32TableSSE2+56(SB)/8, $·pack32_7(SB)
+DATA ·pack32TableSSE2+64(SB)/8, $·pack32_8(SB)
+DATA ·pack32TableSSE2+72(SB)/8, $·pack32_9(SB)
+DATA ·pack32TableSSE2+80(SB)/8, $·pack32_10(SB)
+DATA ·pack32TableSSE2+88(SB)/8, $·pack32_11(SB)
+DATA ·pack32TableSSE2+96(SB)/8, $·pack32_12(SB)
+DATA ·pack32TableSSE2+104(SB)/8, $·pack32_13(SB)
+DATA ·pack32TableSSE2+112(SB)/8, $·pack32_14(SB)
+DATA ·pack32TableSSE2+120(SB)/8, $·pack32_15(SB)
+DATA ·pack32TableSSE2+128(SB)/8, $·pack32_16(SB)
+DATA ·pack32TableSSE2+136(SB)/8, $·pack32_17(SB)
+DATA ·pack32TableSSE2+144(SB)/8, $·pack32_18(SB)
+DATA ·pack32TableSSE2+152(SB)/8, $·pack32_19(SB)
+DATA ·pack32TableSSE2+160(SB)/8, $·pack32_20(SB)
+DATA ·pack32TableSSE2+168(SB)/8, $·pack32_21(SB)
+DATA ·pack32TableSSE2+176(SB)/8, $·pack32_22(SB)
+DATA ·pack32TableSSE2+184(SB)/8, $·pack32_23(SB)
+DATA ·pack32TableSSE2+192(SB)/8, $·pack32_24(SB)
+DATA ·pack32TableSSE2+200(SB)/8, $·pack32_25(SB)
+DATA ·pack32TableSSE2+208(SB)/8, $·pack32_26(SB)
+DATA ·pack32TableSSE2+216(SB)/8, $·pack32_27(SB)
+DATA ·pack32TableSSE2+224(SB)/8, $·pack32_28(SB)
+DATA ·pack32TableSSE2+232(SB)/8, $·pack32_29(SB)
+DATA ·pack32TableSSE2+240(SB)/8, $·pack32_30(SB)
+DATA ·pack32TableSSE2+248(SB)/8, $·pack32_31(SB)
+DATA ·pack32TableSSE2+256(SB)/8, $·pack32_32(SB)
+GLOBL ·pack32TableSSE2(SB), RODATA|NOPTR, $264
+
+DATA ·unpack32TableSSE2+8(SB)/8, $·unpack32_1(SB)
+DATA ·unpack32TableSSE2+16(SB)/8, $·unpack32_2(SB)
+DATA ·unpack32TableSSE2+24(SB)/8, $·unpack32_3(SB)
+DATA ·unpack32TableSSE2+32(SB)/8, $·unpack32_4(SB)
+DATA ·unpack32TableSSE2+40(SB)/8, $·unpack32_5(SB)
+DATA ·unpack32TableSSE2+48(SB)/8, $·unpack32_6(SB)
+DATA ·unpack32TableSSE2+56(SB)/8, $·unpack32_7(SB)
+DATA ·unpack32TableSSE2+64(SB)/8, $·unpack32_8(SB)
+DATA ·unpack32TableSSE2+72(SB)/8, $·unpack32_9(SB)
+DATA ·unpack32TableSSE2+80(SB)/8, $·unpack32_10(SB)
+DATA ·unpack32TableSSE2+88(SB)/8, $·unpack32_11(SB)
+DATA ·unpack32TableSSE2+96(SB)/8, $·unpack32_12(SB)
+DATA ·unpack32TableSSE2+104(SB)/8, $·unpack32_13(SB)
+DATA ·unpack32TableSSE2+112(SB)/8, $·unpack32_14(SB)
+DATA ·unpack32TableSSE2+120(SB)/8, $·unpack32_15(SB)
+DATA ·unpack32TableSSE2+128(SB)/8, $·unpack32_16(SB)
+DATA ·unpack32TableSSE2+136(SB)/8, $·unpack32_17(SB)
+DATA ·unpack32TableSSE2+144(SB)/8, $·unpack32_18(SB)
+DATA ·unpack32TableSSE2+152(SB)/8, $·unpack32_19(SB)
+DATA ·unpack32TableSSE2+160(SB)/8, $·unpack32_20(SB)
+DATA ·unpack32TableSSE2+168(SB)/8, $·unpack32_21(SB)
+DATA ·unpack32TableSSE2+176(SB)/8, $·unpack32_22(SB)
+DATA ·unpack32TableSSE2+184(SB)/8, $·unpack32_23(SB)
+DATA ·unpack32TableSSE2+192(SB)/8, $·unpack32_24(SB)
+DATA ·unpack32TableSSE2+200(SB)/8, $·unpack32_25(SB)
+DATA ·unpack32TableSSE2+208(SB)/8, $·unpack32_26(SB)
+DATA ·unpack32TableSSE2+216(SB)/8, $·unpack32_27(SB)
+DATA ·unpack32TableSSE2+224(SB)/8, $·unpack32_28(SB)
+DATA ·unpack32TableSSE2+232(SB)/8, $·unpack32_29(SB)
+DATA ·unpack32TableSSE2+240(SB)/8, $·unpack32_30(SB)
+DATA ·unpack32TableSSE2+248(SB)/8, $·unpack32_31(SB)
+DATA ·unpack32TableSSE2+256(SB)/8, $·unpack32_32(SB)
+GLOBL ·unpack32TableSSE2(SB), RODATA|NOPTR, $264
+
+// Kernel tables of the AVX2 bit packing kernels in packavx2_amd64.s. They
+// write the same payload as the SSE2 kernels.
+
+DATA ·pack32TableAVX2+8(SB)/8, $·pack32AVX2_1(SB)
+DATA ·pack32TableAVX2+16(SB)/8, $·pack32AVX2_2(SB)
+DATA ·pack32TableAVX2+24(SB)/8, $·pack32AVX2_3(SB)
+DATA ·pack32TableAVX2+32(SB)/8, $·pack32AVX2_4(SB)
+DATA ·pack32TableAVX2+40(SB)/8, $·pack32AVX2_5(SB)
+DATA ·pack32TableAVX2+48(SB)/8, $·pack32AVX2_6(SB)
+DATA ·pack32TableAVX2+56(SB)/8, $·pack32AVX2_7(SB)
+DATA ·pack32TableAVX2+64(SB)/8, $·pack32AVX2_8(SB)
+DATA ·pack32TableAVX2+72(SB)/8, $·pack32AVX2_9(SB)
+DATA ·pack32TableAVX2+80(SB)/8, $·pack32AVX2_10(SB)
+DATA ·pack32TableAVX2+88(SB)/8, $·pack32AVX2_11(SB)
+DATA ·pack32TableAVX2+96(SB)/8, $·pack32AVX2_12(SB)
+DATA ·pack32TableAVX2+104(SB)/8, $·pack32AVX2_13(SB)
+DATA ·pack32TableAVX2+112(SB)/8, $·pack32AVX2_14(SB)
+DATA ·pack32TableAVX2+120(SB)/8, $·pack32AVX2_15(SB)
+DATA ·pack32TableAVX2+128(SB)/8, $·pack32AVX2_16(SB)
+DATA ·pack32TableAVX2+136(SB)/8, $·pack32AVX2_17(SB)
+DATA ·pack32TableAVX2+144(SB)/8, $·pack32AVX2_18(SB)
+DATA ·pack32TableAVX2+152(SB)/8, $·pack32AVX2_19(SB)
+DATA ·pack32TableAVX2+160(SB)/8, $·pack32AVX2_20(SB)
+DATA ·pack32TableAVX2+168(SB)/8, $·pack32AVX2_21(SB)
+DATA ·pack32TableAVX2+176(SB)/8, $·pack32AVX2_22(SB)
+DATA ·pack32TableAVX2+184(SB)/8, $·pack32AVX2_23(SB)
+DATA ·pack32TableAVX2+192(SB)/8, $·pack32AVX2_24(SB)
+DATA ·pack32TableAVX2+200(SB)/8, $·pack32AVX2_25(SB)
+DATA ·pack32TableAVX2+208(SB)/8, $·pack32AVX2_26(SB)
+DATA ·pack32TableAVX2+216(SB)/8, $·pack32AVX2_27(SB)
+DATA ·pack32TableAVX2+224(SB)/8, $·pack32AVX2_28(SB)
+DATA ·pack32TableAVX2+232(SB)/8, $·pack32AVX2_29(SB)
+DATA ·pack32TableAVX2+240(SB)/8, $·pack32AVX2_30(SB)
+DATA ·pack32TableAVX2+248(SB)/8, $·pack32AVX2_31(SB)
+DATA ·pack32TableAVX2+256(SB)/8, $·pack32AVX2_32(SB)
+GLOBL ·pack32TableAVX2(SB), RODATA|NOPTR, $264
+
+DATA ·unpack32TableAVX2+8(SB)/8, $·unpack32AVX2_1(SB)
+DATA ·unpack32TableAVX2+16(SB)/8, $·unpack32AVX2_2(SB)
+DATA ·unpack32TableAVX2+24(SB)/8, $·unpack32AVX2_3(SB)
+DATA ·unpack32TableAVX2+32(SB)/8, $·unpack32AVX2_4(SB)
+DATA ·unpack32TableAVX2+40(SB)/8, $·unpack32AVX2_5(SB)
+DATA ·unpack32TableAVX2+48(SB)/8, $·unpack32AVX2_6(SB)
+DATA ·unpack32TableAVX2+56(SB)/8, $·unpack32AVX2_7(SB)
+DATA ·unpack32TableAVX2+64(SB)/8, $·unpack32AVX2_8(SB)
+DATA ·unpack32TableAVX2+72(SB)/8, $·unpack32AVX2_9(SB)
+DATA ·unpack32TableAVX2+80(SB)/8, $·unpack32AVX2_10(SB)
+DATA ·unpack32TableAVX2+88(SB)/8, $·unpack32AVX2_11(SB)
+DATA ·unpack32TableAVX2+96(SB)/8, $·unpack32AVX2_12(SB)
+DATA ·unpack32TableAVX2+104(SB)/8, $·unpack32AVX2_13(SB)
+DATA ·unpack32TableAVX2+112(SB)/8, $·unpack32AVX2_14(SB)
+DATA ·unpack32TableAVX2+120(SB)/8, $·unpack32AVX2_15(SB)
+DATA ·unpack32TableAVX2+128(SB)/8, $·unpack32AVX2_16(SB)
+DATA ·unpack32TableAVX2+136(SB)/8, $·unpack32AVX2_17(SB)
+DATA ·unpack32TableAVX2+144(SB)/8, $·unpack32AVX2_18(SB)
+DATA ·unpack32TableAVX2+152(SB)/8, $·unpack32AVX2_19(SB)
+DATA ·unpack32TableAVX2+160(SB)/8, $·unpack32AVX2_20(SB)
+DATA ·unpack32TableAVX2+168(SB)/8, $·unpack32AVX2_21(SB)
+DATA ·unpack32TableAVX2+176(SB)/8, $·unpack32AVX2_22(SB)
+DATA ·unpack32TableAVX2+184(SB)/8, $·unpack32AVX2_23(SB)
+DATA ·unpack32TableAVX2+192(SB)/8, $·unpack32AVX2_24(SB)
+DATA ·unpack32TableAVX2+200(SB)/8, $·unpack32AVX2_25(SB)
+DATA ·unpack32TableAVX2+208(SB)/8, $·unpack32AVX2_26(SB)
+DATA ·unpack32TableAVX2+216(SB)/8, $·unpack32AVX2_27(SB)
+DATA ·unpack32TableAVX2+224(SB)/8, $·unpack32AVX2_28(SB)
+DATA ·unpack32TableAVX2+232(SB)/8, $·unpack32AVX2_29(SB)
+DATA ·unpack32TableAVX2+240(SB)/8, $·unpack32AVX2_30(SB)
+DATA ·unpack32TableAVX2+248(SB)/8, $·unpack32AVX2_31(SB)
+DATA ·unpack32TableAVX2+256(SB)/8, $·unpack32AVX2_32(SB)
+GLOBL ·unpack32TableAVX2(SB), RODATA|NOPTR, $264
+
+// Kernel tables of the fused unpack and delta decode kernels, which store the
+// prefix sums of the unpacked D1 deltas.
+
+DATA ·unpackDelta32TableAVX2+8(SB)/8, $·unpackDelta32AVX2_1(SB)
+DATA ·unpackDelta32TableAVX2+16(SB)/8, $·unpackDelta32AVX2_2(SB)
+DATA ·unpackDelta32TableAVX2+24(SB)/8, $·unpackDelta32AVX2_3(SB)
+DATA ·unpackDelta32TableAVX2+32(SB)/8, $·unpackDelta32AVX2_4(SB)
+DATA ·unpackDelta32TableAVX2+40(SB)/8, $·unpackDelta32AVX2_5(SB)
+DATA ·unpackDelta32TableAVX2+48(SB)/8, $·unpackDelta32AVX2_6(SB)
+DATA ·unpackDelta32TableAVX2+56(SB)/8, $·unpackDelta32AVX2_7(SB)
+DATA ·unpackDelta32TableAVX2+64(SB)/8, $·unpackDelta32AVX2_8(SB)
+DATA ·unpackDelta32TableAVX2+72(SB)/8, $·unpackDelta32AVX2_9(SB)
+DATA ·unpackDelta32TableAVX2+80(SB)/8, $·unpackDelta32AVX2_10(SB)
+DATA ·unpackDelta32TableAVX2+88(SB)/8, $·unpackDelta32AVX2_11(SB)
+DATA ·unpackDelta32TableAVX2+96(SB)/8, $·unpackDelta32AVX2_12(SB)
+DATA ·unpackDelta32TableAVX2+104(SB)/8, $·unpackDelta32AVX2_13(SB)
+DATA ·unpackDelta32TableAVX2+112(SB)/8, $·unpackDelta32AVX2_14(SB)
+DATA ·unpackDelta32TableAVX2+120(SB)/8, $·unpackDelta32AVX2_15(SB)
+DATA ·unpackDelta32TableAVX2+128(SB)/8, $·unpackDelta32AVX2_16(SB)
+DATA ·unpackDelta32TableAVX2+136(SB)/8, $·unpackDelta32AVX2_17(SB)
+DATA ·unpackDelta32TableAVX2+144(SB)/8, $·unpackDelta32AVX2_18(SB)
+DATA ·unpackDelta32TableAVX2+152(SB)/8, $·unpackDelta32AVX2_19(SB)
+DATA ·unpackDelta32TableAVX2+160(SB)/8, $·unpackDelta32AVX2_20(SB)
+DATA ·unpackDelta32TableAVX2+168(SB)/8, $·unpackDelta32AVX2_21(SB)
+DATA ·unpackDelta32TableAVX2+176(SB)/8, $·unpackDelta32AVX2_22(SB)
+DATA ·unpackDelta32TableAVX2+184(SB)/8, $·unpackDelta32AVX2_23(SB)
+DATA ·unpackDelta32TableAVX2+192(SB)/8, $·unpackDelta32AVX2_24(SB)
+DATA ·unpackDelta32TableAVX2+200(SB)/8, $·unpackDelta32AVX2_25(SB)
+DATA ·unpackDelta32TableAVX2+208(SB)/8, $·unpackDelta32AVX2_26(SB)
+DATA ·unpackDelta32TableAVX2+216(SB)/8, $·unpackDelta32AVX2_27(SB)
+DATA ·unpackDelta32TableAVX2+224(SB)/8, $·unpackDelta32AVX2_28(SB)
+DATA ·unpackDelta32TableAVX2+232(SB)/8, $·unpackDelta32AVX2_29(SB)
+DATA ·unpackDelta32TableAVX2+240(SB)/8, $·unpackDelta32AVX2_30(SB)
+DATA ·unpackDelta32TableAVX2+248(SB)/8, $·unpackDelta32AVX2_31(SB)
+DATA ·unpackDelta32TableAVX2+256(SB)/8, $·unpackDelta32AVX2_32(SB)
+GLOBL ·unpackDelta32TableAVX2(SB), RODATA|NOPTR, $264
+
+// Kernel tables of the AVX-512 bit packing kernels in avx512_amd64.s. They
+// write the same payload as the SSE2 kernels.
+
+DATA ·pack32TableAVX512+8(SB)/8, $·pack32AVX512_1(SB)
+DATA ·pack32TableAVX512+16(SB)/8, $·pack32AVX512_2(SB)
+DATA ·pack32TableAVX512+24(SB)/8, $·pack32AVX512_3(SB)
+DATA ·pack32TableAVX512+32(SB)/8, $·pack32AVX512_4(SB)
+DATA ·pack32TableAVX512+40(SB)/8, $·pack32AVX512_5(SB)
+DATA ·pack32TableAVX512+48(SB)/8, $·pack32AVX512_6(SB)
+DATA ·pack32TableAVX512+56(SB)/8, $·pack32AVX512_7(SB)
+DATA ·pack32TableAVX512+64(SB)/8, $·pack32AVX512_8(SB)
+DATA ·pack32TableAVX512+72(SB)/8, $·pack32AVX512_9(SB)
+DATA ·pack32TableAVX512+80(SB)/8, $·pack32AVX512_10(SB)
+DATA ·pack32TableAVX512+88(SB)/8, $·pack32AVX512_11(SB)
+DATA ·pack32TableAVX512+96(SB)/8, $·pack32AVX512_12(SB)
+DATA ·pack32TableAVX512+104(SB)/8, $·pack32AVX512_13(SB)
+DATA ·pack32TableAVX512+112(SB)/8, $·pack32AVX512_14(SB)
+DATA ·pack32TableAVX512+120(SB)/8, $·pack32AVX512_15(SB)
+DATA ·pack32TableAVX512+128(SB)/8, $·pack32AVX512_16(SB)
+DATA ·pack32TableAVX512+136(SB)/8, $·pack32AVX512_17(SB)
+DATA ·pack32TableAVX512+144(SB)/8, $·pack32AVX512_18(SB)
+DATA ·pack32TableAVX512+152(SB)/8, $·pack32AVX512_19(SB)
+DATA ·pack32TableAVX512+160(SB)/8, $·pack32AVX512_20(SB)
+DATA ·pack32TableAVX512+168(SB)/8, $·pack32AVX512_21(SB)
+DATA ·pack32TableAVX512+176(SB)/8, $·pack32AVX512_22(SB)
+DATA ·pack32TableAVX512+184(SB)/8, $·pack32AVX512_23(SB)
+DATA ·pack32TableAVX512+192(SB)/8, $·pack32AVX512_24(SB)
+DATA ·pack32TableAVX512+200(SB)/8, $·pack32AVX512_25(SB)
+DATA ·pack32TableAVX512+208(SB)/8, $·pack32AVX512_26(SB)
+DATA ·pack32TableAVX512+216(SB)/8, $·pack32AVX512_27(SB)
+DATA ·pack32TableAVX512+224(SB)/8, $·pack32AVX512_28(SB)
+DATA ·pack32TableAVX512+232(SB)/8, $·pack32AVX512_29(SB)
+DATA ·pack32TableAVX512+240(SB)/8, $·pack32AVX512_30(SB)
+DATA ·pack32TableAVX512+248(SB)/8, $·pack32AVX512_31(SB)
+DATA ·pack32TableAVX512+256(SB)/8, $·pack32AVX512_32(SB)
+GLOBL ·pack32TableAVX512(SB), RODATA|NOPTR, $264
+
+DATA ·unpack32TableAVX512+8(SB)/8, $·unpack32AVX512_1(SB)
+DATA ·unpack32TableAVX512+16(SB)/8, $·unpack32AVX512_2(SB)
+DATA ·unpack32TableAVX512+24(SB)/8, $·unpack32AVX512_3(SB)
+DATA ·unpack32TableAVX512+32(SB)/8, $·unpack32AVX512_4(SB)
+DATA ·unpack32TableAVX512+40(SB)/8, $·unpack32AVX512_5(SB)
+DATA ·unpack32TableAVX512+48(SB)/8, $·unpack32AVX512_6(SB)
+DATA ·unpack32TableAVX512+56(SB)/8, $·unpack32AVX512_7(SB)
+DATA ·unpack32TableAVX512+64(SB)/8, $·unpack32AVX512_8(SB)
+DATA ·unpack32TableAVX512+72(SB)/8, $·unpack32AVX512_9(SB)
+DATA ·unpack32TableAVX512+80(SB)/8, $·unpack32AVX512_10(SB)
+DATA ·unpack32TableAVX512+88(SB)/8, $·unpack32AVX512_11(SB)
+DATA ·unpack32TableAVX512+96(SB)/8, $·unpack32AVX512_12(SB)
+DATA ·unpack32TableAVX512+104(SB)/8, $·unpack32AVX512_13(SB)
+DATA ·unpack32TableAVX512+112(SB)/8, $·unpack32AVX512_14(SB)
+DATA ·unpack32TableAVX512+120(SB)/8, $·unpack32AVX512_15(SB)
+DATA ·unpack32TableAVX512+128(SB)/8, $·unpack32AVX512_16(SB)
+DATA ·unpack32TableAVX512+136(SB)/8, $·unpack32AVX512_17(SB)
+DATA ·unpack32TableAVX512+144(SB)/8, $·unpack32AVX512_18(SB)
+DATA ·unpack32TableAVX512+152(SB)/8, $·unpack32AVX512_19(SB)
+DATA ·unpack32TableAVX512+160(SB)/8, $·unpack32AVX512_20(SB)
+DATA ·unpack32TableAVX512+168(SB)/8, $·unpack32AVX512_21(SB)
+DATA ·unpack32TableAVX512+176(SB)/8, $·unpack32AVX512_22(SB)
+DATA ·unpack32TableAVX512+184(SB)/8, $·unpack32AVX512_23(SB)
+DATA ·unpack32TableAVX512+192(SB)/8, $·unpack32AVX512_24(SB)
+DATA ·unpack32TableAVX512+200(SB)/8, $·unpack32AVX512_25(SB)
+DATA ·unpack32TableAVX512+208(SB)/8, $·unpack32AVX512_26(SB)
+DATA ·unpack32TableAVX512+216(SB)/8, $·unpack32AVX512_27(SB)
+DATA ·unpack32TableAVX512+224(SB)/8, $·unpack32AVX512_28(SB)
+DATA ·unpack32TableAVX512+232(SB)/8, $·unpack32AVX512_29(SB)
+DATA ·unpack32TableAVX512+240(SB)/8, $·unpack32AVX512_30(SB)
+DATA ·unpack32TableAVX512+248(SB)/8, $·unpack32AVX512_31(SB)
+DATA ·unpack32TableAVX512+256(SB)/8, $·unpack32AVX512_32(SB)
+GLOBL ·unpack32TableAVX512(SB), RODATA|NOPTR, $264
+
+DATA ·unpackDelta32TableAVX512+8(SB)/8, $·unpackDelta32AVX512_1(SB)
+DATA ·unpackDelta32TableAVX512+16(SB)/8, $·unpackDelta32AVX512_2(SB)
+DATA ·unpackDelta32TableAVX512+24(SB)/8, $·unpackDelta32AVX512_3(SB)
+DATA ·unpackDelta32TableAVX512+32(SB)/8, $·unpackDelta32AVX512_4(SB)
+DATA ·unpackDelta32TableAVX512+40(SB)/8, $·unpackDelta32AVX512_5(SB)
+DATA ·unpackDelta32TableAVX512+48(SB)/8, $·unpackDelta32AVX512_6(SB)
+DATA ·unpackDelta32TableAVX512+56(SB)/8, $·unpackDelta32AVX512_7(SB)
+DATA ·unpackDelta32TableAVX512+64(SB)/8, $·unpackDelta32AVX512_8(SB)
+DATA ·unpackDelta32TableAVX512+72(SB)/8, $·unpackDelta32AVX512_9(SB)
+DATA ·unpackDelta32TableAVX512+80(SB)/8, $·unpackDelta32AVX512_10(SB)
+DATA ·unpackDelta32TableAVX512+88(SB)/8, $·unpackDelta32AVX512_11(SB)
+DATA ·unpackDelta32TableAVX512+96(SB)/8, $·unpackDelta32AVX512_12(SB)
+DATA ·unpackDelta32TableAVX512+104(SB)/8, $·unpackDelta32AVX512_13(SB)
+DATA ·unpackDelta32TableAVX512+112(SB)/8, $·unpackDelta32AVX512_14(SB)
+DATA ·unpackDelta32TableAVX512+120(SB)/8, $·unpackDelta32AVX512_15(SB)
+DATA ·unpackDelta32TableAVX512+128(SB)/8, $·unpackDelta32AVX512_16(SB)
+DATA ·unpackDelta32TableAVX512+136(SB)/8, $·unpackDelta32AVX512_17(SB)
+DATA ·unpackDelta32TableAVX512+144(SB)/8, $·unpackDelta32AVX512_18(SB)
+DATA ·unpackDelta32TableAVX512+152(SB)/8, $·unpackDelta32AVX512_19(SB)
+DATA ·unpackDelta32TableAVX512+160(SB)/8, $·unpackDelta32AVX512_20(SB)
+DATA ·unpackDelta32TableAVX512+168(SB)/8, $·unpackDelta32AVX512_21(SB)
+DATA ·unpackDelta32TableAVX512+176(SB)/8, $·unpackDelta32AVX512_22(SB)
+DATA ·unpackDelta32TableAVX512+184(SB)/8, $·unpackDelta32AVX512_23(SB)
+DATA ·unpackDelta32TableAVX512+192(SB)/8, $·unpackDelta32AVX512_24(SB)
+DATA ·unpackDelta32TableAVX512+200(SB)/8, $·unpackDelta32AVX512_25(SB)
+DATA ·unpackDelta32TableAVX512+208(SB)/8, $·unpackDelta32AVX512_26(SB)
+DATA ·unpackDelta32TableAVX512+216(SB)/8, $·unpackDelta32AVX512_27(SB)
+DATA ·unpackDelta32TableAVX512+224(SB)/8, $·unpackDelta32AVX512_28(SB)
+DATA ·unpackDelta32TableAVX512+232(SB)/8, $·unpackDelta32AVX512_29(SB)
+DATA ·unpackDelta32TableAVX512+240(SB)/8, $·unpackDelta32AVX512_30(SB)
+DATA ·unpackDelta32TableAVX512+248(SB)/8, $·unpackDelta32AVX512_31(SB)
+DATA ·unpackDelta32TableAVX512+256(SB)/8, $·unpackDelta32AVX512_32(SB)
+GLOBL ·unpackDelta32TableAVX512(SB), RODATA|NOPTR, $264
+
+// func packKernelsSSE2() *[33]uintptr
+TEXT ·packKernelsSSE2(SB), NOSPLIT, $0-8
+	LEAQ ·pack32TableSSE2(SB), AX
+	MOVQ AX, ret+0(FP)
+	RET
+
+// func unpackKernelsSSE2() *[33]uintptr
+TEXT ·unpackKernelsSSE2(SB), NOSPLIT, $0-8
+	LEAQ ·unpack32TableSSE2(SB), AX
+	MOVQ AX, ret+0(FP)
+	RET
+
+// func packKernelsAVX2() *[33]uintptr
+TEXT ·packKernelsAVX2(SB), NOSPLIT, $0-8
+	LEAQ ·pack32TableAVX2(SB), AX
+	MOVQ AX, ret+0(FP)
+	RET
+
+// func unpackKernelsAVX2() *[33]uintptr
+TEXT ·unpackKernelsAVX2(SB), NOSPLIT, $0-8
+	LEAQ ·unpack32TableAVX2(SB), AX
+	MOVQ AX, ret+0(FP)
+	RET
+
+// func unpackDeltaKernelsAVX2() *[33]uintptr
+TEXT ·unpackDeltaKernelsAVX2(SB), NOSPLIT, $0-8
+	LEAQ ·unpackDelta32TableAVX2(SB), AX
+	MOVQ AX, ret+0(FP)
+	RET
+
+// func packKernelsAVX512() *[33]uintptr
+TEXT ·packKernelsAVX512(SB), NOSPLIT, $0-8
+	LEAQ ·pack32TableAVX512(SB), AX
+	MOVQ AX, ret+0(FP)
+	RET
+
+// func unpackKernelsAVX512() *[33]uintptr
+TEXT ·unpackKernelsAVX512(SB), NOSPLIT, $0-8
+	LEAQ ·unpack32TableAVX512(SB), AX
+	MOVQ AX, ret+0(FP)
+	RET
+
+// func unpackDeltaKernelsAVX512() *[33]uintptr
+TEXT ·unpackDeltaKernelsAVX512(SB), NOSPLIT, $0-8
+	LEAQ ·unpackDelta32TableAVX512(SB), AX
+	MOVQ AX, ret+0(FP)
+	RET
+
+// The trampolines jump to kernel with their own argument frame, which matches
+// the kernel arguments followed by the kernel address. The kernels return
+// directly to the caller.
+
+// func pack32Call(in uintptr, out *byte, offset int, seed *byte, kernel uintptr)
+TEXT ·pack32Call(SB), NOSPLIT, $0-40
+	MOVQ kernel+32(FP), AX
+	JMP  AX
+
+// func unpack32Call(in *byte, out uintptr, offset int, seed *byte, kernel uintptr)
+TEXT ·unpack32Call(SB), NOSPLIT, $0-40
+	MOVQ kernel+32(FP), AX
+	JMP  AX
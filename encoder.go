@@ -0,0 +1,191 @@
+package fastpfor
+
+import "fmt"
+
+// Encoder packs blocks like PackUint32, PackDeltaUint32 and PackFORUint32,
+// using its own scratch space for the deltas and exceptions. Encoding is
+// allocation-free whatever the capacity of the values slice, and values are
+// never mutated.
+//
+// The zero value is ready to use and chooses the bit widths like the package
+// functions. An Encoder is not safe for concurrent use; reuse one per goroutine:
+//
+//	var enc fastpfor.Encoder
+//	for _, block := range blocks {
+//		dst = enc.PackDelta(dst, block)
+//	}
+type Encoder struct {
+	// Options controls the bit width selection of all blocks packed by the Encoder.
+	Options EncodeOptions
+
+	scratch [2*blockSize + 4]uint32 // deltas (aligned for the SIMD kernels) and exception high bits
+	tuner   exceptionTuner          // learned exception cost for Options.Adaptive
+	tuned   EncodeOptions           // Options with the learned exception cost
+}
+
+// EncodeOptions controls how the bit width of a block is selected. By default
+// the width minimizing the block size is chosen, which may be a low width with
+// many exceptions (up to width 0 with all values patched). Patching is slower to
+// decode than unpacking, so the options trade size for decode speed. The blocks
+// are decoded like any other, but VerifyBlock reports ErrNonCanonical for blocks
+// whose width differs from the default choice.
+type EncodeOptions struct {
+	// MaxExceptions limits the exceptions of a block; widths that would need more
+	// are not considered. 0 means no limit.
+	MaxExceptions int
+
+	// ExceptionCost is added to the estimated size in bytes for every exception,
+	// which biases the selection toward wider widths with fewer exceptions. 0
+	// minimizes the size.
+	ExceptionCost int
+
+	// NoExceptions packs every block at the width of its largest value, so that
+	// blocks never have exceptions.
+	NoExceptions bool
+
+	// ExactSize compares the widths by the exact size of their exception area
+	// instead of an upper bound that assumes 4 bytes for the high bits of every
+	// exception. This finds smaller blocks with more exceptions but takes longer
+	// to encode.
+	ExactSize bool
+
+	// Adaptive corrects the upper bound by the bytes per exception of the blocks
+	// the Encoder packed before, which approaches the sizes of ExactSize without
+	// its cost when the blocks resemble each other, such as the blocks of a
+	// column. The correction follows columns whose distribution drifts; call
+	// Reset before packing an unrelated column. Adaptive is ignored with
+	// ExactSize.
+	Adaptive bool
+}
+
+// Profile is a preset of EncodeOptions for a common use:
+//
+//	enc := fastpfor.Encoder{Options: fastpfor.ProfileStorage.Options()}
+type Profile uint8
+
+const (
+	// ProfileBalanced is the zero EncodeOptions, the behavior of the package functions.
+	ProfileBalanced Profile = iota
+	// ProfileStorage minimizes the block size using ExactSize, at the cost of
+	// encoding speed and of more exceptions to patch when decoding.
+	ProfileStorage
+	// ProfileLatency minimizes the decoding time using NoExceptions: blocks are
+	// never patched, and DeltaD1 blocks of non-decreasing values are decoded by
+	// the fused unpack and prefix sum kernels.
+	ProfileLatency
+)
+
+// Options returns the EncodeOptions of the profile. It panics if p is not a
+// valid Profile.
+func (p Profile) Options() EncodeOptions {
+	switch p {
+	case ProfileBalanced:
+		return EncodeOptions{}
+	case ProfileStorage:
+		return EncodeOptions{ExactSize: true}
+	case ProfileLatency:
+		return EncodeOptions{NoExceptions: true}
+	}
+	panic(fmt.Sprintf("fastpfor: invalid profile %d", p))
+}
+
+// Reset forgets the exception cost learned with Options.Adaptive.
+func (e *Encoder) Reset() {
+	e.tuner = exceptionTuner{}
+}
+
+// Pack encodes up to BlockSize values like PackUint32 and appends the block to dst.
+func (e *Encoder) Pack(dst []byte, values []uint32) []byte {
+	start := len(dst)
+	dst = packInternalScratch(dst, values, headerTypeUint32Flag, alignedScratch(&e.scratch)[blockSize:], e.options())
+	return e.observe(dst, start)
+}
+
+// PackDelta delta-encodes up to BlockSize values like PackDeltaUint32 and
+// appends the block to dst.
+func (e *Encoder) PackDelta(dst []byte, values []uint32) []byte {
+	return e.PackDeltaMode(dst, values, DeltaD1)
+}
+
+// PackDeltaMode delta-encodes up to BlockSize values in mode like
+// PackDeltaUint32Mode and appends the block to dst.
+func (e *Encoder) PackDeltaMode(dst []byte, values []uint32, mode DeltaMode) []byte {
+	if mode >= numDeltaModes {
+		panic(fmt.Sprintf("fastpfor: PackDeltaMode: invalid delta mode %d", mode))
+	}
+	scratch := alignedScratch(&e.scratch)
+	deltas := scratch[:len(values)]
+	var useZigZag bool
+	if len(values) > 0 {
+		useZigZag = deltaEncodeMode(deltas, values, mode)
+	}
+	// Always set type and delta flags
+	flags := headerTypeUint32Flag | headerDeltaFlag | uint32(mode)<<headerDeltaModeShift
+	if useZigZag {
+		flags |= headerZigZagFlag
+	}
+	start := len(dst)
+	dst = packInternalScratch(dst, deltas, flags, scratch[blockSize:], e.options())
+	return e.observe(dst, start)
+}
+
+// PackFOR packs up to BlockSize values in frame-of-reference form like
+// PackFORUint32 and appends the block to dst.
+func (e *Encoder) PackFOR(dst []byte, values []uint32) []byte {
+	scratch := alignedScratch(&e.scratch)
+	offsets := scratch[:len(values)]
+	copy(offsets, values)
+	start := len(dst)
+	dst = packFrame(dst, offsets, headerTypeUint32Flag, scratch[blockSize:], e.options())
+	return e.observe(dst, start)
+}
+
+// options returns the options to pack the next block with.
+func (e *Encoder) options() *EncodeOptions {
+	if !e.Options.adaptive() {
+		return &e.Options
+	}
+	e.tuned = e.Options
+	e.tuned.ExceptionCost += e.tuner.cost()
+	return &e.tuned
+}
+
+// observe feeds the block packed at dst[start:] to the tuner and returns dst.
+func (e *Encoder) observe(dst []byte, start int) []byte {
+	if e.Options.adaptive() {
+		e.tuner.observe(dst[start:])
+	}
+	return dst
+}
+
+// adaptive returns whether the width selection is tuned by the Encoder.
+func (o *EncodeOptions) adaptive() bool {
+	return o.Adaptive && !o.ExactSize && !o.NoExceptions
+}
+
+// exceptionTuner learns how much the exception areas of the packed blocks differ
+// from the patchBytesMax estimate of the width selection, which assumes 4 bytes
+// for the high bits of every exception and plain positions. The difference per
+// exception is kept as an exponential moving average, so that it follows drifting
+// distributions.
+type exceptionTuner struct {
+	diff int // average difference per exception in 1/16 bytes, <= 0
+}
+
+// observe updates the average with the exception area of block.
+func (t *exceptionTuner) observe(block []byte) {
+	header, _, payloadStart, err := readHeader(block)
+	if err != nil || header&headerExceptionFlag == 0 {
+		return
+	}
+	payloadEnd := payloadStart + payloadBytes(int(header>>headerWidthShift)&headerWidthMask)
+	excCount := int(block[payloadEnd])
+	diff := 16 * (len(block) - payloadEnd - patchBytesMax(excCount)) / excCount
+	t.diff += (diff - t.diff) / 4
+}
+
+// cost returns the average difference per exception rounded to bytes, which
+// corrects the ExceptionCost of the next block.
+func (t *exceptionTuner) cost() int {
+	return (t.diff + 8) >> 4
+}
@@ -0,0 +1,226 @@
+package fastpfor
+
+import (
+	"math/bits"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncoder(t *testing.T) {
+	assert := assert.New(t)
+
+	var enc Encoder
+	inputs := map[string][]uint32{
+		"empty":      nil,
+		"exceptions": genDataWithLargeExceptions(),
+		"monotonic":  genMonotonic(blockSize),
+		"mixed":      genMixed(blockSize),
+		"partial":    genMixed(blockSize)[:77],
+	}
+	for name, src := range inputs {
+		values := slices.Clip(slices.Clone(src)) // no extra capacity
+		assert.Equal(PackUint32(nil, slices.Clone(src)), enc.Pack(nil, values), name)
+		assert.Equal(PackDeltaUint32(nil, slices.Clone(src)), enc.PackDelta(nil, values), name)
+		assert.Equal(src, values, "%s: input mutated", name)
+
+		got, err := UnpackUint32(nil, enc.PackDelta(nil, values))
+		assert.NoError(err)
+		assert.Equal(len(src), len(got), name)
+		if len(src) > 0 {
+			assert.Equal(src, got, name)
+		}
+	}
+
+	t.Run("allocationFree", func(t *testing.T) {
+		values := slices.Clip(genDataWithLargeExceptions())
+		dst := make([]byte, 0, MaxBlockSizeUint32())
+		assert.Zero(testing.AllocsPerRun(10, func() {
+			dst = enc.Pack(dst[:0], values)
+			dst = enc.PackDelta(dst[:0], values)
+		}))
+		adaptive := Encoder{Options: EncodeOptions{Adaptive: true}}
+		assert.Zero(testing.AllocsPerRun(10, func() {
+			dst = adaptive.Pack(dst[:0], values)
+		}))
+		// The package functions borrow a pooled Encoder
+		assert.Zero(testing.AllocsPerRun(10, func() {
+			dst = PackDeltaUint32(dst[:0], values)
+			dst = PackDeltaUint32Mode(dst[:0], values, DeltaD4)
+		}))
+	})
+}
+
+// TestEncodeOptions verifies that the options restrict and bias the bit width
+// selection, and that the blocks decode like any other.
+func TestEncodeOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	// Width 0 with 96 exceptions is estimated smaller than width 32
+	values := make([]uint32, blockSize)
+	for i := range 96 {
+		values[i*blockSize/96] = mathMaxUint32 - uint32(i)
+	}
+
+	tests := []struct {
+		name       string
+		opts       EncodeOptions
+		bitWidth   int
+		exceptions int
+	}{
+		{"default", EncodeOptions{}, 0, 96},
+		{"maxExceptions", EncodeOptions{MaxExceptions: 16}, 32, 0},
+		{"maxExceptionsAbove", EncodeOptions{MaxExceptions: 96}, 0, 96},
+		{"exceptionCost", EncodeOptions{ExceptionCost: 1}, 32, 0},
+		{"noExceptions", EncodeOptions{NoExceptions: true}, 32, 0},
+	}
+	for _, tt := range tests {
+		enc := Encoder{Options: tt.opts}
+		buf := enc.Pack(nil, values)
+		assert.Equal(tt.bitWidth, getBitWidth(buf), tt.name)
+		assert.Equal(tt.exceptions, getExceptionCount(buf), tt.name)
+		got, err := UnpackUint32(nil, buf)
+		assert.NoError(err, tt.name)
+		assert.Equal(values, got, tt.name)
+	}
+
+	// The options apply to all block kinds
+	enc := Encoder{Options: EncodeOptions{NoExceptions: true}}
+	src := genDataWithLargeExceptions()
+	for _, buf := range [][]byte{enc.Pack(nil, src), enc.PackDelta(nil, src), enc.PackFOR(nil, src)} {
+		assert.Zero(getExceptionCount(buf))
+		got, err := UnpackUint32(nil, buf)
+		assert.NoError(err)
+		assert.Equal(src, got)
+	}
+	assert.ErrorIs(VerifyBlock(enc.Pack(nil, src)), ErrNonCanonical)
+}
+
+// TestEncodeOptionsExactSize verifies that patchBytesExact matches the written
+// exception areas, so that ExactSize selects the smallest block of all widths.
+func TestEncodeOptionsExactSize(t *testing.T) {
+	assert := assert.New(t)
+
+	inputs := map[string][]uint32{
+		"bursty":    genBurstyExceptions(),
+		"scattered": genScatteredExceptions(20),
+		"small":     genDataWithSmallExceptions(),
+		"large":     genDataWithLargeExceptions(),
+		"outliers":  genOutliers(blockSize),
+		"mixed":     genMixed(blockSize),
+		"partial":   genMixed(blockSize)[:77],
+	}
+	enc := Encoder{Options: ProfileStorage.Options()}
+	for name, values := range inputs {
+		maxWidth := requiredBitWidthScalar(values)
+		smallest := headerBytes + payloadBytes(maxWidth)
+		for width := range maxWidth {
+			mask := packLanesExceptionsScalar(make([]byte, payloadBytes(width)), values, width)
+			excCount := bits.OnesCount64(mask[0]) + bits.OnesCount64(mask[1])
+			patch := make([]byte, patchBytesMax(excCount))
+			n := writeExceptionsDirect(patch, values, width, mask, make([]uint32, excCount))
+			assert.Equal(n, patchBytesExact(values, width, excCount), "%s: width %d", name, width)
+			smallest = min(smallest, headerBytes+payloadBytes(width)+n)
+		}
+
+		buf := enc.Pack(nil, values)
+		assert.Equal(smallest, len(buf), name)
+		assert.LessOrEqual(len(buf), len(PackUint32(nil, slices.Clone(values))), name)
+		got, err := UnpackUint32(nil, buf)
+		assert.NoError(err, name)
+		assert.Equal(values, got, name)
+	}
+}
+
+// TestEncodeOptionsAdaptive verifies that the Encoder learns the exception cost
+// of a column, approaching the sizes of ExactSize.
+func TestEncodeOptionsAdaptive(t *testing.T) {
+	assert := assert.New(t)
+
+	var balanced Encoder
+	adaptive := Encoder{Options: EncodeOptions{Adaptive: true}}
+	exact := Encoder{Options: EncodeOptions{ExactSize: true}}
+	var sizes [3]int
+	for range 16 {
+		values := genScatteredExceptions(20)
+		for i, enc := range []*Encoder{&balanced, &adaptive, &exact} {
+			buf := enc.Pack(nil, values)
+			sizes[i] += len(buf)
+			got, err := UnpackUint32(nil, buf)
+			assert.NoError(err)
+			assert.Equal(values, got)
+		}
+	}
+	assert.Less(sizes[1], sizes[0])
+	assert.GreaterOrEqual(sizes[1], sizes[2])
+	assert.Less(adaptive.tuner.cost(), 0)
+	assert.Zero(exact.tuner, "ExactSize is not tuned")
+
+	// The first block after Reset is packed like without Adaptive
+	values := genScatteredExceptions(20)
+	adaptive.Reset()
+	assert.Equal(balanced.Pack(nil, values), adaptive.Pack(nil, values))
+}
+
+func TestProfile(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(EncodeOptions{}, ProfileBalanced.Options())
+	assert.True(ProfileStorage.Options().ExactSize)
+	assert.True(ProfileLatency.Options().NoExceptions)
+	assert.Panics(func() { Profile(3).Options() })
+
+	src := genScatteredExceptions(20)
+	sizes := make(map[Profile]int)
+	for _, p := range []Profile{ProfileBalanced, ProfileStorage, ProfileLatency} {
+		enc := Encoder{Options: p.Options()}
+		buf := enc.Pack(nil, src)
+		sizes[p] = len(buf)
+		got, err := UnpackUint32(nil, buf)
+		assert.NoError(err)
+		assert.Equal(src, got)
+	}
+	assert.Less(sizes[ProfileStorage], sizes[ProfileBalanced])
+	assert.LessOrEqual(sizes[ProfileBalanced], sizes[ProfileLatency])
+}
+
+func BenchmarkEncoderPack(b *testing.B) {
+	var enc Encoder
+	values := slices.Clip(genDataWithLargeExceptions())
+	dst := make([]byte, 0, MaxBlockSizeUint32())
+	b.ReportAllocs()
+	for range b.N {
+		dst = enc.Pack(dst[:0], values)
+	}
+	resultBytes = dst
+}
+
+// BenchmarkEncoderPackDelta compares an Encoder owned by the caller with the
+// pooled Encoder of PackDeltaUint32 and with one on the stack, which escapes
+// through the kernel function variables and costs an allocation per block.
+func BenchmarkEncoderPackDelta(b *testing.B) {
+	values := slices.Clip(genDataWithLargeExceptions())
+	dst := make([]byte, 0, MaxBlockSizeUint32())
+	b.Run("Encoder", func(b *testing.B) {
+		var enc Encoder
+		b.ReportAllocs()
+		for range b.N {
+			dst = enc.PackDelta(dst[:0], values)
+		}
+	})
+	b.Run("PackDeltaUint32", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			dst = PackDeltaUint32(dst[:0], values)
+		}
+	})
+	b.Run("stack", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			var enc Encoder
+			dst = enc.PackDelta(dst[:0], values)
+		}
+	})
+	resultBytes = dst
+}
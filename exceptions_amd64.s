@@ -0,0 +1,65 @@
+// Code generated by command: go run main.go -component=exceptions -out=../../exceptions_amd64.s. DO NOT EDIT.
+
+//go:build amd64 && !noasm
+
+#include "textflag.h"
+
+// func exceptionMaskSIMDAsm(values *uint32, n int, limit uint32) (lo uint64, hi uint64)
+// Requires: SSE, SSE2
+TEXT ·exceptionMaskSIMDAsm(SB), NOSPLIT, $0-40
+	MOVQ   values+0(FP), AX
+	MOVQ   n+8(FP), DX
+	MOVL   limit+16(FP), BX
+	XORL   $0x80000000, BX
+	MOVD   BX, X0
+	PSHUFD $0x00, X0, X0
+	MOVL   $0x80000000, SI
+	MOVD   SI, X1
+	PSHUFD $0x00, X1, X1
+	SHRQ   $0x02, DX
+	XORQ   DI, DI
+	XORQ   R8, R8
+	XORQ   CX, CX
+
+exception_mask_lo_loop:
+	TESTQ    DX, DX
+	JZ       exception_mask_done
+	CMPQ     CX, $0x40
+	JAE      exception_mask_lo_done
+	MOVOU    (AX), X2
+	PXOR     X1, X2
+	PCMPGTL  X0, X2
+	MOVMSKPS X2, R9
+	SHLQ     CL, R9
+	ORQ      R9, DI
+	ADDQ     $0x10, AX
+	ADDQ     $0x04, CX
+	DECQ     DX
+	JMP      exception_mask_lo_loop
+
+exception_mask_lo_done:
+	XORQ CX, CX
+
+exception_mask_hi_loop:
+	TESTQ    DX, DX
+	JZ       exception_mask_done
+	CMPQ     CX, $0x40
+	JAE      exception_mask_hi_done
+	MOVOU    (AX), X2
+	PXOR     X1, X2
+	PCMPGTL  X0, X2
+	MOVMSKPS X2, R9
+	SHLQ     CL, R9
+	ORQ      R9, R8
+	ADDQ     $0x10, AX
+	ADDQ     $0x04, CX
+	DECQ     DX
+	JMP      exception_mask_hi_loop
+
+exception_mask_hi_done:
+	XORQ CX, CX
+
+exception_mask_done:
+	MOVQ DI, lo+24(FP)
+	MOVQ R8, hi+32(FP)
+	RET
@@ -0,0 +1,234 @@
+package fastpfor
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// BlockExplanation is a structured record of the decisions taken when a block was
+// encoded: all bit width candidates with their estimated costs, the chosen width,
+// the exceptions and the final byte layout. It answers questions like
+// "why is this block 300 bytes?" and is not meant for hot paths.
+type BlockExplanation struct {
+	Count        int       // number of values in the block
+	IntType      int       // integer type marker (IntTypeUint16, IntTypeUint32, ...)
+	Delta        bool      // values are delta-encoded
+	DeltaMode    DeltaMode // delta base of delta-encoded blocks
+	ZigZag       bool      // deltas are zigzag-encoded
+	WillOverflow bool      // delta decoding overflows uint32
+	FOR          bool      // values are stored as differences to Base (frame of reference)
+	Constant     bool      // all values equal Base, there is no payload
+	Base         uint32    // base of frame-of-reference blocks, value of constant blocks
+
+	BitWidth    int // chosen bit width of the packed lanes
+	MaxBitWidth int // bit width needed to store all values without exceptions
+
+	// Candidates lists every evaluated bit width (0..MaxBitWidth) with the
+	// estimated block size used by the width selection.
+	Candidates []WidthCandidate
+
+	// Exceptions lists the patched positions in the order they are stored.
+	Exceptions []ExceptionInfo
+
+	// Layout offsets and sizes in bytes, relative to the start of the block.
+	HeaderBytes     int // header including optional extensions
+	PayloadOffset   int
+	PayloadBytes    int
+	PatchOffset     int  // offset of the exception area (equals TotalBytes without exceptions)
+	PatchBytes      int  // exception area: count(1) + svbLen(2) + positions + StreamVByte data
+	PositionsOffset int  // offset of the positions (run codes or bitmap if PositionRuns or PositionBitmap is set)
+	PositionRuns    bool // positions are run-coded
+	PositionBitmap  bool // positions are a bitmap
+	RawHighBits     bool // high bits are stored fixed-width at SVBOffset instead of StreamVByte
+	SVBOffset       int
+	SVBBytes        int
+	TotalBytes      int
+}
+
+// WidthCandidate describes one evaluated bit width.
+type WidthCandidate struct {
+	BitWidth       int
+	Exceptions     int  // number of values that do not fit into BitWidth bits
+	EstimatedBytes int  // size estimate used for selection (worst-case StreamVByte length)
+	Chosen         bool // whether this candidate was selected
+}
+
+// ExceptionInfo describes a single exception.
+type ExceptionInfo struct {
+	Position int    // index in the block
+	HighBits uint32 // bits above BitWidth, stored in the StreamVByte area
+	SVBBytes int    // StreamVByte data bytes (or raw width) used for HighBits (1-4)
+}
+
+// ExplainBlock explains how PackUint32 encodes values. The values slice is not modified.
+func ExplainBlock(values []uint32) (BlockExplanation, error) {
+	if err := validateBlockLength(len(values)); err != nil {
+		return BlockExplanation{}, err
+	}
+	var scratch [2 * blockSize]uint32
+	n := copy(scratch[:], values)
+	buf := PackUint32(nil, scratch[:n])
+	return Explain(buf)
+}
+
+// Explain decodes the structure of an encoded block. The stored (packed) values are
+// reconstructed to recompute all width candidates; for delta blocks these are the
+// deltas and for frame-of-reference blocks the differences to the base, as seen
+// by the width selection.
+func Explain(buf []byte) (BlockExplanation, error) {
+	var e BlockExplanation
+
+	header, count, payloadStart, err := readHeader(buf)
+	if err != nil {
+		return e, err
+	}
+	_, bitWidth, intType, hasExceptions, hasDelta, hasZigZag, willOverflow := decodeHeader(header)
+	e.Count = count
+	e.IntType = intType
+	e.Delta = hasDelta
+	e.DeltaMode = headerDeltaMode(header)
+	e.ZigZag = hasZigZag
+	e.WillOverflow = willOverflow
+	e.FOR = blockCodec(buf, header) == codecFOR
+	e.Constant = blockCodec(buf, header) == codecConstant
+	e.Base = frameBase(buf, header)
+	e.BitWidth = bitWidth
+
+	e.HeaderBytes = payloadStart
+	e.PayloadOffset = payloadStart
+	e.PayloadBytes = payloadBytes(bitWidth)
+	e.PatchOffset = payloadStart + e.PayloadBytes
+	if len(buf) < e.PatchOffset {
+		return e, fmt.Errorf("%w: buffer truncated (need %d bytes, got %d)",
+			ErrInvalidBuffer, e.PatchOffset, len(buf))
+	}
+
+	// Reconstruct the stored values (before any delta decoding)
+	var stored [blockSize]uint32
+	if bitWidth > 0 && count > 0 {
+		unpackLanes(stored[:count], buf[payloadStart:e.PatchOffset], count, bitWidth)
+	}
+	e.TotalBytes = e.PatchOffset
+
+	if hasExceptions {
+		total, err := BlockLength(buf)
+		if err != nil {
+			return e, err
+		}
+		if len(buf) < total {
+			return e, fmt.Errorf("%w: buffer truncated (need %d bytes, got %d)",
+				ErrInvalidBuffer, total, len(buf))
+		}
+		var scratch [blockSize]uint32
+		if _, err := applyExceptions(stored[:count], buf, e.PatchOffset, count, bitWidth, scratch[:]); err != nil {
+			return e, patchError(err)
+		}
+
+		patch := buf[e.PatchOffset:total]
+		l, _ := readPatchLayout(patch) // validated by applyExceptions
+		var runScratch [blockSize]byte
+		positions, _ := l.positions(patch, &runScratch)
+		excCount := l.excCount
+		e.PatchBytes = total - e.PatchOffset
+		e.PositionRuns = l.runs
+		e.PositionBitmap = l.bitmap
+		e.RawHighBits = l.rawWidth > 0
+		e.PositionsOffset = e.PatchOffset + l.posOff
+		e.SVBOffset = e.PatchOffset + l.svbOff()
+		e.SVBBytes = total - e.SVBOffset
+		e.TotalBytes = total
+
+		highBits := l.decodeHighBits(buf[e.SVBOffset:total], scratch[:])
+		controls := buf[e.SVBOffset:]
+		e.Exceptions = make([]ExceptionInfo, excCount)
+		for i, pos := range positions {
+			size := l.rawWidth
+			if size == 0 {
+				size = int((controls[i>>2]>>((i&3)*2))&0x03) + 1
+			}
+			e.Exceptions[i] = ExceptionInfo{
+				Position: int(pos),
+				HighBits: highBits[i],
+				SVBBytes: size,
+			}
+		}
+	}
+
+	e.Candidates, e.MaxBitWidth = widthCandidates(stored[:count], bitWidth)
+	return e, nil
+}
+
+// widthCandidates recomputes the cost model of selectBitWidth for all widths up to
+// the maximum width and marks the chosen one.
+func widthCandidates(values []uint32, chosen int) ([]WidthCandidate, int) {
+	var freqs [33]int
+	var orAll uint32
+	for _, v := range values {
+		freqs[bits.Len32(v)]++
+		orAll |= v
+	}
+	maxWidth := bits.Len32(orAll)
+
+	candidates := make([]WidthCandidate, 0, maxWidth+1)
+	excCount := len(values)
+	for width := 0; width <= maxWidth; width++ {
+		excCount -= freqs[width]
+		candidates = append(candidates, WidthCandidate{
+			BitWidth:       width,
+			Exceptions:     excCount,
+			EstimatedBytes: headerBytes + payloadBytes(width) + patchBytesMax(excCount),
+			Chosen:         width == chosen,
+		})
+	}
+	return candidates, maxWidth
+}
+
+// String renders the explanation as a human-readable multi-line report.
+func (e BlockExplanation) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "block: %d values, %d bytes\n", e.Count, e.TotalBytes)
+	fmt.Fprintf(&sb, "flags: intType=%d delta=%t mode=%s zigzag=%t willOverflow=%t",
+		e.IntType, e.Delta, e.DeltaMode, e.ZigZag, e.WillOverflow)
+	switch {
+	case e.FOR:
+		fmt.Fprintf(&sb, " base=%d", e.Base)
+	case e.Constant:
+		fmt.Fprintf(&sb, " constant=%d", e.Base)
+	}
+	sb.WriteByte('\n')
+	fmt.Fprintf(&sb, "width: %d (max %d)\n", e.BitWidth, e.MaxBitWidth)
+	for _, c := range e.Candidates {
+		marker := " "
+		if c.Chosen {
+			marker = "*"
+		}
+		fmt.Fprintf(&sb, "  %s width %2d: %3d exceptions, ~%d bytes\n", marker, c.BitWidth, c.Exceptions, c.EstimatedBytes)
+	}
+	fmt.Fprintf(&sb, "layout: header [0,%d) payload [%d,%d)", e.HeaderBytes, e.PayloadOffset, e.PayloadOffset+e.PayloadBytes)
+	if e.PatchBytes > 0 {
+		positions := "positions"
+		switch {
+		case e.PositionRuns:
+			positions = "position runs"
+		case e.PositionBitmap:
+			positions = "position bitmap"
+		}
+		fmt.Fprintf(&sb, " patch [%d,%d) %s [%d,%d) %s [%d,%d)",
+			e.PatchOffset, e.PatchOffset+e.PatchBytes, positions,
+			e.PositionsOffset, e.SVBOffset, highBitsForm(e.RawHighBits), e.SVBOffset, e.SVBOffset+e.SVBBytes)
+	}
+	sb.WriteByte('\n')
+	for _, x := range e.Exceptions {
+		fmt.Fprintf(&sb, "  exception at %3d: high bits %d (%d %s bytes)\n", x.Position, x.HighBits, x.SVBBytes, highBitsForm(e.RawHighBits))
+	}
+	return sb.String()
+}
+
+// highBitsForm returns the name of the storage form of exception high bits.
+func highBitsForm(raw bool) string {
+	if raw {
+		return "raw"
+	}
+	return "svb"
+}
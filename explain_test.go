@@ -0,0 +1,96 @@
+package fastpfor
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestExplainBlock verifies the explanation matches the actual encoding.
+func TestExplainBlock(t *testing.T) {
+	assert := assert.New(t)
+
+	inputs := map[string][]uint32{
+		"empty":           nil,
+		"sequential":      genSequential(blockSize),
+		"smallExceptions": genDataWithSmallExceptions(),
+		"largeExceptions": genDataWithLargeExceptions(),
+		"mixed":           genMixed(blockSize),
+		"width32":         genValuesForBitWidth(32),
+	}
+	for name, values := range inputs {
+		t.Run(name, func(t *testing.T) {
+			orig := slices.Clone(values)
+			e, err := ExplainBlock(values)
+			assert.NoError(err)
+			assert.Equal(orig, values, "ExplainBlock must not modify its input")
+
+			buf := PackUint32(nil, slices.Clone(values))
+			assert.Equal(len(buf), e.TotalBytes)
+			assert.Equal(len(values), e.Count)
+			assert.Equal(getBitWidth(buf), e.BitWidth)
+			assert.Equal(getExceptionCount(buf), len(e.Exceptions))
+			assert.Equal(IntTypeUint32, e.IntType)
+			assert.Equal(headerBytes, e.HeaderBytes)
+			assert.Equal(e.PayloadOffset+e.PayloadBytes, e.PatchOffset)
+			assert.Equal(e.TotalBytes, e.PatchOffset+e.PatchBytes)
+
+			// The chosen candidate must be the one selectBitWidth picks.
+			width, excCount := selectBitWidth(values)
+			chosen := 0
+			for _, c := range e.Candidates {
+				if c.Chosen {
+					chosen++
+					assert.Equal(width, c.BitWidth)
+					assert.Equal(excCount, c.Exceptions)
+				}
+				assert.GreaterOrEqual(c.EstimatedBytes, 0)
+			}
+			if len(values) > 0 {
+				assert.Equal(1, chosen)
+				assert.Len(e.Candidates, e.MaxBitWidth+1)
+			}
+
+			// Exceptions reconstruct the original values.
+			for _, x := range e.Exceptions {
+				assert.Equal(values[x.Position]>>e.BitWidth, x.HighBits)
+				assert.GreaterOrEqual(x.SVBBytes, 1)
+				assert.LessOrEqual(x.SVBBytes, 4)
+			}
+
+			assert.Contains(e.String(), "block:")
+		})
+	}
+
+	t.Run("tooLong", func(t *testing.T) {
+		_, err := ExplainBlock(make([]uint32, blockSize+1))
+		assert.ErrorIs(err, ErrInvalidBlockLength)
+	})
+}
+
+// TestExplainDelta verifies delta blocks are explained on their stored deltas.
+func TestExplainDelta(t *testing.T) {
+	assert := assert.New(t)
+	values := genMonotonic(blockSize)
+	buf := PackDeltaUint32(nil, slices.Clone(values))
+	e, err := Explain(buf)
+	assert.NoError(err)
+	assert.True(e.Delta)
+	assert.False(e.ZigZag)
+	assert.Equal(3, e.MaxBitWidth) // deltas are 1..7
+	assert.Equal(len(buf), e.TotalBytes)
+}
+
+// TestExplainInvalid verifies malformed buffers are rejected.
+func TestExplainInvalid(t *testing.T) {
+	assert := assert.New(t)
+	_, err := Explain([]byte{1})
+	assert.ErrorIs(err, ErrInvalidBuffer)
+
+	buf := PackUint32(nil, genDataWithSmallExceptions())
+	_, err = Explain(buf[:len(buf)-1])
+	assert.ErrorIs(err, ErrInvalidBuffer)
+	_, err = Explain(buf[:headerBytes+2])
+	assert.ErrorIs(err, ErrInvalidBuffer)
+}
@@ -0,0 +1,272 @@
+package fastpfor
+
+import (
+	"bufio"
+	"container/heap"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+)
+
+// maxEncodedBlockBytes is the largest block readBlock accepts: the wide header (the
+// largest header form) plus a 32-bit payload (selectBitWidth never chooses a layout
+// that is larger than packing all values at the maximum width).
+const maxEncodedBlockBytes = headerBytes + headerWideBytes + blockSize*4
+
+// ExternalSorter sorts an arbitrarily long stream of uint32 values while keeping
+// at most budget raw values in memory, and emits the result as a sequence of
+// concatenated delta blocks (each holding up to 128 values, see PackDeltaUint32).
+//
+// Values are collected into an in-memory chunk. Whenever the chunk is full it is
+// sorted and spilled as a run of delta blocks to a temporary file, so spilled
+// runs are stored compressed. WriteTo merges all runs (k-way, one decoded block
+// per run in memory) and writes the final blocks to the destination.
+// If all values fit into the budget, no temporary file is created.
+//
+// Duplicates are preserved. An ExternalSorter is not safe for concurrent use
+// and must be closed to remove its temporary file.
+type ExternalSorter struct {
+	chunk   []uint32
+	budget  int
+	tempDir string
+	spill   *os.File
+	spillW  *bufio.Writer
+	runs    []sortRun
+	spilled int64
+	block   []byte
+}
+
+// sortRun describes a spilled sorted run in the temporary file.
+type sortRun struct {
+	off, n int64
+}
+
+// NewExternalSorter creates a sorter that buffers at most budget values in memory
+// before spilling a sorted run to a temporary file in tempDir (os.TempDir if empty).
+// Budgets below one block are raised to 128 values.
+func NewExternalSorter(budget int, tempDir string) *ExternalSorter {
+	budget = max(budget, blockSize)
+	return &ExternalSorter{
+		chunk:   make([]uint32, 0, budget),
+		budget:  budget,
+		tempDir: tempDir,
+	}
+}
+
+// Add appends values to the stream to be sorted.
+func (s *ExternalSorter) Add(values ...uint32) error {
+	for len(values) > 0 {
+		n := min(len(values), s.budget-len(s.chunk))
+		s.chunk = append(s.chunk, values[:n]...)
+		values = values[n:]
+		if len(s.chunk) == s.budget {
+			if err := s.spillChunk(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// spillChunk sorts the current chunk and writes it as a run of delta blocks.
+func (s *ExternalSorter) spillChunk() error {
+	if s.spill == nil {
+		f, err := os.CreateTemp(s.tempDir, "fastpfor-sort-*")
+		if err != nil {
+			return fmt.Errorf("fastpfor: creating sort spill file: %w", err)
+		}
+		s.spill = f
+		s.spillW = bufio.NewWriter(f)
+	}
+	slices.Sort(s.chunk)
+	n, err := s.writeBlocks(s.spillW, s.chunk)
+	if err != nil {
+		return err
+	}
+	s.runs = append(s.runs, sortRun{off: s.spilled, n: n})
+	s.spilled += n
+	s.chunk = s.chunk[:0]
+	return nil
+}
+
+// writeBlocks delta-encodes sorted values in chunks of 128 and writes the blocks to w.
+// The values slice is left unmodified.
+func (s *ExternalSorter) writeBlocks(w io.Writer, values []uint32) (int64, error) {
+	var written int64
+	for len(values) > 0 {
+		n := min(len(values), blockSize)
+		s.block = PackDeltaUint32(s.block[:0], values[:n])
+		values = values[n:]
+		m, err := w.Write(s.block)
+		written += int64(m)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// WriteTo writes the sorted stream to w as concatenated delta blocks and returns
+// the number of bytes written. All values added so far are consumed; the sorter
+// can be reused afterwards.
+func (s *ExternalSorter) WriteTo(w io.Writer) (int64, error) {
+	if len(s.runs) == 0 {
+		slices.Sort(s.chunk)
+		n, err := s.writeBlocks(w, s.chunk)
+		s.chunk = s.chunk[:0]
+		return n, err
+	}
+
+	if len(s.chunk) > 0 {
+		if err := s.spillChunk(); err != nil {
+			return 0, err
+		}
+	}
+	if err := s.spillW.Flush(); err != nil {
+		return 0, err
+	}
+
+	h := make(runHeap, 0, len(s.runs))
+	for _, run := range s.runs {
+		c := &runCursor{r: bufio.NewReader(io.NewSectionReader(s.spill, run.off, run.n))}
+		ok, err := c.fill()
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			h = append(h, c)
+		}
+	}
+	heap.Init(&h)
+
+	var written int64
+	var out [blockSize]uint32
+	n := 0
+	for len(h) > 0 {
+		c := h[0]
+		out[n] = c.values[c.pos]
+		n++
+		if n == blockSize {
+			m, err := s.writeBlocks(w, out[:n])
+			written += m
+			if err != nil {
+				return written, err
+			}
+			n = 0
+		}
+		c.pos++
+		if c.pos == len(c.values) {
+			ok, err := c.fill()
+			if err != nil {
+				return written, err
+			}
+			if !ok {
+				heap.Pop(&h)
+				continue
+			}
+		}
+		heap.Fix(&h, 0)
+	}
+	m, err := s.writeBlocks(w, out[:n])
+	written += m
+	if err != nil {
+		return written, err
+	}
+
+	// Reset the spill file for reuse.
+	s.runs = s.runs[:0]
+	s.spilled = 0
+	if err := s.spill.Truncate(0); err != nil {
+		return written, err
+	}
+	if _, err := s.spill.Seek(0, io.SeekStart); err != nil {
+		return written, err
+	}
+	s.spillW.Reset(s.spill)
+	return written, nil
+}
+
+// Close removes the temporary spill file, if any.
+func (s *ExternalSorter) Close() error {
+	if s.spill == nil {
+		return nil
+	}
+	name := s.spill.Name()
+	err := s.spill.Close()
+	s.spill = nil
+	s.spillW = nil
+	return errors.Join(err, os.Remove(name))
+}
+
+// runCursor iterates over the decoded values of a spilled run, one block at a time.
+type runCursor struct {
+	r      *bufio.Reader
+	buf    [blockSize]uint32
+	values []uint32
+	pos    int
+}
+
+// fill decodes the next block of the run. Returns false at the end of the run.
+func (c *runCursor) fill() (bool, error) {
+	for {
+		block, err := readBlock(c.r)
+		if err == io.EOF {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		c.values, err = UnpackUint32(c.buf[:0], block)
+		if err != nil {
+			return false, err
+		}
+		c.pos = 0
+		if len(c.values) > 0 {
+			return true, nil
+		}
+	}
+}
+
+// runHeap is a min-heap of run cursors ordered by their current value.
+type runHeap []*runCursor
+
+func (h runHeap) Len() int           { return len(h) }
+func (h runHeap) Less(i, j int) bool { return h[i].values[h[i].pos] < h[j].values[h[j].pos] }
+func (h runHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *runHeap) Push(x any)        { *h = append(*h, x.(*runCursor)) }
+func (h *runHeap) Pop() any {
+	old := *h
+	c := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return c
+}
+
+// readBlock returns the next encoded block from r without copying it.
+// The returned slice is only valid until the next read from r.
+// Returns io.EOF if r is exhausted at a block boundary.
+func readBlock(r *bufio.Reader) ([]byte, error) {
+	peek, err := r.Peek(maxEncodedBlockBytes)
+	if len(peek) == 0 {
+		if err == nil || err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, err
+	}
+	n, lerr := BlockLength(peek)
+	if lerr != nil {
+		return nil, lerr
+	}
+	if n > len(peek) {
+		if n > maxEncodedBlockBytes {
+			return nil, fmt.Errorf("%w: block length %d exceeds maximum %d", ErrInvalidBuffer, n, maxEncodedBlockBytes)
+		}
+		return nil, fmt.Errorf("%w: buffer truncated (need %d bytes, got %d)", ErrInvalidBuffer, n, len(peek))
+	}
+	block := peek[:n]
+	if _, err := r.Discard(n); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
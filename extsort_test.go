@@ -0,0 +1,171 @@
+package fastpfor
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"math/rand"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// decodeBlockStream decodes a stream of concatenated blocks into a single slice.
+func decodeBlockStream(t *testing.T, buf []byte) []uint32 {
+	t.Helper()
+	var out []uint32
+	for len(buf) > 0 {
+		values, n, err := UnpackUint32WithLength(nil, buf)
+		assert.NoError(t, err)
+		if err != nil {
+			return out
+		}
+		out = append(out, values...)
+		buf = buf[n:]
+	}
+	return out
+}
+
+// TestExternalSorter verifies sorting with and without spilled runs.
+func TestExternalSorter(t *testing.T) {
+	assert := assert.New(t)
+	rng := rand.New(rand.NewSource(42))
+
+	for _, tt := range []struct {
+		name   string
+		n      int
+		budget int
+	}{
+		{"empty", 0, 1000},
+		{"inMemory", 777, 1000},
+		{"singleSpill", 1000, 1000},
+		{"manyRuns", 10_000, 300},
+		{"tinyBudget", 1_000, 1},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			s := NewExternalSorter(tt.budget, dir)
+			want := make([]uint32, tt.n)
+			for i := range want {
+				want[i] = uint32(rng.Intn(5000)) // plenty of duplicates
+			}
+			// Add in uneven batches
+			for rest := want; len(rest) > 0; {
+				k := min(len(rest), 1+rng.Intn(257))
+				assert.NoError(s.Add(rest[:k]...))
+				rest = rest[k:]
+			}
+
+			var out bytes.Buffer
+			n, err := s.WriteTo(&out)
+			assert.NoError(err)
+			assert.Equal(int64(out.Len()), n)
+
+			slices.Sort(want)
+			got := decodeBlockStream(t, out.Bytes())
+			if len(want) == 0 {
+				assert.Empty(got)
+			} else {
+				assert.Equal(want, got)
+			}
+
+			// Every block is a sorted delta block
+			for buf := out.Bytes(); len(buf) > 0; {
+				l, err := BlockLength(buf)
+				assert.NoError(err)
+				sorted, err := IsMonotonic(buf[:l])
+				assert.NoError(err)
+				assert.True(sorted)
+				buf = buf[l:]
+			}
+
+			assert.NoError(s.Close())
+			entries, err := os.ReadDir(dir)
+			assert.NoError(err)
+			assert.Empty(entries, "spill file must be removed on Close")
+		})
+	}
+}
+
+// TestExternalSorterReuse verifies the sorter can be reused after WriteTo.
+func TestExternalSorterReuse(t *testing.T) {
+	assert := assert.New(t)
+	s := NewExternalSorter(blockSize, t.TempDir())
+	defer s.Close()
+
+	for round := range 3 {
+		var want []uint32
+		for i := range 500 {
+			want = append(want, uint32((i*7919+round)%1000))
+		}
+		assert.NoError(s.Add(want...))
+		var out bytes.Buffer
+		_, err := s.WriteTo(&out)
+		assert.NoError(err)
+		slices.Sort(want)
+		assert.Equal(want, decodeBlockStream(t, out.Bytes()))
+	}
+}
+
+// TestExternalSorterInMemoryNoSpill verifies no temporary file is created within budget.
+func TestExternalSorterInMemoryNoSpill(t *testing.T) {
+	assert := assert.New(t)
+	s := NewExternalSorter(1000, t.TempDir())
+	assert.NoError(s.Add(3, 1, 2))
+	var out bytes.Buffer
+	_, err := s.WriteTo(&out)
+	assert.NoError(err)
+	assert.Nil(s.spill)
+	assert.Equal([]uint32{1, 2, 3}, decodeBlockStream(t, out.Bytes()))
+	assert.NoError(s.Close())
+}
+
+// TestReadBlock verifies block-wise reading from a stream of concatenated blocks.
+func TestReadBlock(t *testing.T) {
+	assert := assert.New(t)
+
+	var stream []byte
+	stream = PackUint32(stream, genSequential(blockSize))
+	stream = PackUint32(stream, genDataWithSmallExceptions())
+	stream = PackUint32(stream, nil)
+	stream = PackDeltaUint32(stream, genMonotonic(5))
+
+	r := bufio.NewReader(bytes.NewReader(stream))
+	total := 0
+	for {
+		block, err := readBlock(r)
+		if err != nil {
+			assert.ErrorIs(err, io.EOF)
+			break
+		}
+		n, err := BlockLength(block)
+		assert.NoError(err)
+		assert.Equal(len(block), n)
+		total += n
+	}
+	assert.Equal(len(stream), total)
+
+	t.Run("truncated", func(t *testing.T) {
+		buf := PackUint32(nil, genSequential(blockSize))
+		_, err := readBlock(bufio.NewReader(bytes.NewReader(buf[:len(buf)-1])))
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+}
+
+func BenchmarkExternalSorter(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	values := make([]uint32, 100_000)
+	for i := range values {
+		values[i] = rng.Uint32()
+	}
+	dir := b.TempDir()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s := NewExternalSorter(10_000, dir)
+		_ = s.Add(values...)
+		_, _ = s.WriteTo(&bytes.Buffer{})
+		_ = s.Close()
+	}
+}
@@ -9,7 +9,23 @@
 // scratch buffers. For maximum performance in high-throughput scenarios, use
 // UnpackUint32WithBuffer with a reused scratch buffer to avoid any allocation overhead.
 //
-// The package maintains no global mutable state.
+// Decoding is deterministic: the payload is unpacked first, then the exceptions
+// are applied in ascending position order (blocks with unsorted or duplicate
+// positions are rejected), and delta and zigzag decoding run last. Decoding the
+// same buffer concurrently into different destinations therefore yields
+// bitwise identical values, whichever kernels the CPU selects.
+//
+// A few process-wide settings apply to all encoders and decoders:
+//
+//   - SetCPUFeatures and ConfigureKernels select the pack/unpack kernels. They
+//     swap function variables without synchronization and must not be called
+//     concurrently with packing or unpacking; call them once at startup or in
+//     tests.
+//   - SetRelaxedHeaders, SetDecodeLimits and SetDecodeSampler are stored
+//     atomically and may be called concurrently with decoding; decodes running
+//     at that moment may see either setting.
+//
+// All other state is held by the encoders, readers and buffers of the caller.
 package fastpfor
 
 import (
@@ -18,6 +34,8 @@ import (
 	"fmt"
 	"math/bits"
 	"slices"
+	"sync/atomic"
+	"time"
 
 	"github.com/mhr3/streamvbyte"
 )
@@ -41,6 +59,11 @@ func (e *ErrOverflow) Error() string {
 // ErrInvalidFlags is returned when the header contains an invalid flag combination.
 var ErrInvalidFlags = errors.New("fastpfor: invalid header flags")
 
+// ErrUnsupportedFeature is returned when a block header sets a format version or
+// a reserved bit that this version does not understand (e.g. a block written by a
+// newer version). Use SetRelaxedHeaders to ignore them instead.
+var ErrUnsupportedFeature = errors.New("fastpfor: unsupported header feature")
+
 // ErrInvalidBlockLength is returned when the block length is negative or exceeds the maximum.
 var ErrInvalidBlockLength = errors.New("fastpfor: invalid block length")
 
@@ -63,7 +86,16 @@ const (
 	//	Bits  0-7:   element count (0–128)
 	//	Bits  8-13:  bit width for packed values (0–32)
 	//	Bits 14-15:  integer type (00=uint8, 01=uint16, 10=uint32, 11=uint64)
-	//	Bits 16-27:  reserved (must be 0)
+	//	Bit  16:     reserved (must be 0, see headerReservedMask)
+	//	Bit  17:     wide-header flag (1 = a 32-bit extension follows the header)
+	//	Bit  18:     provenance flag (1 = a provenance record precedes the payload)
+	//	Bit  19:     signed flag (1 = values are zigzag-encoded int32)
+	//	Bit  20:     float flag (1 = values are XOR-encoded float32 bit patterns)
+	//	Bit  21:     float64 flag (1 = values are one half of XOR-encoded float64s)
+	//	Bit  22:     range flag (1 = a min/max record precedes the payload)
+	//	Bit  23:     checksum flag (1 = a checksum record of the values precedes the payload)
+	//	Bits 24-25:  format version (0 = current layout, see FormatVersion)
+	//	Bits 26-27:  delta mode (0 = D1, 1 = D4, 2 = DM, 3 = DD, see DeltaMode; 0 without delta flag)
 	//	Bit  28:     will-overflow flag (1 = delta decode WILL overflow uint32)
 	//	Bit  29:     delta flag (1 = values are delta-encoded)
 	//	Bit  30:     zigzag flag (1 = deltas are zigzag-encoded)
@@ -92,6 +124,80 @@ const (
 	headerTypeUint32Flag = uint32(IntTypeUint32) << headerTypeShift // 0x8000 - default
 	headerTypeUint64Flag = uint32(IntTypeUint64) << headerTypeShift // 0xC000 - reserved
 
+	// Reserved bits (bit 16). Decoders reject blocks that set them with
+	// ErrUnsupportedFeature unless relaxed header checking is enabled, so a flag
+	// that a later version assigns to them is not silently ignored.
+	headerReservedMask = uint32(1 << 16)
+
+	// Wide header form (bit 17). When set, a little-endian uint32 extension directly
+	// follows the 32-bit header, making the header 8 bytes in total. The extension
+	// carries a 24-bit element count (bits 0-23) and a codec id (bits 24-31), so
+	// the format can grow without squeezing everything into the original 32 bits;
+	// the reserved header bits remain available for further flags (e.g. min/max).
+	headerWideFlag       = uint32(1 << 17)
+	headerWideBytes      = 4
+	headerWideCountMask  = (1 << 24) - 1
+	headerWideCodecShift = 24
+
+	// Provenance form (bit 18). When set, a 20-byte provenance record (see
+	// Provenance) follows the header and its optional extension, directly before
+	// the payload.
+	headerProvenanceFlag  = uint32(1 << 18)
+	headerProvenanceBytes = 20
+
+	// Signed marker (bit 19). The block holds int32 values that were zigzag-encoded
+	// before packing (see PackInt32). The integer type field stays IntTypeUint32,
+	// as the packed values are the uint32 zigzag codes.
+	headerSignedFlag = uint32(1 << 19)
+
+	// Float marker (bit 20). The block holds float32 bit patterns, each XORed
+	// with the previous one (see PackFloat32). The integer type field stays
+	// IntTypeUint32, as the packed values are the uint32 XOR codes.
+	headerFloatFlag = uint32(1 << 20)
+
+	// Float64 marker (bit 21). The block holds the high or the low 32-bit halves
+	// of XOR-encoded float64 bit patterns (see PackFloat64). PackFloat64 writes two
+	// such blocks back to back, the high halves first.
+	headerFloat64Flag = uint32(1 << 21)
+
+	// Range form (bit 22). When set, an 8-byte record holding the smallest and the
+	// largest value of the block (little-endian uint32 each, see SetBlockRange)
+	// follows the header and its optional extension, before the provenance record.
+	headerRangeFlag  = uint32(1 << 22)
+	headerRangeBytes = 8
+
+	// Checksum form (bit 23). When set, a 4-byte record holding the checksum of
+	// the decoded values (little-endian uint32, see SetBlockChecksum) follows the
+	// range record, before the provenance record.
+	headerChecksumFlag  = uint32(1 << 23)
+	headerChecksumBytes = 4
+
+	// Format version (bits 24-25). Encoders stamp FormatVersion; decoders reject
+	// newer versions unless relaxed header checking is enabled. The bits were
+	// reserved before, so blocks of earlier releases are version 0.
+	headerVersionBits  = 2
+	headerVersionMask  = (1 << headerVersionBits) - 1
+	headerVersionShift = 24
+
+	// Delta mode (bits 26-27, see DeltaMode). The bits were reserved before, so
+	// delta blocks of earlier releases are D1. Other modes require the delta flag
+	// and exclude the will-overflow flag.
+	headerDeltaModeMask  = (1 << 2) - 1
+	headerDeltaModeShift = 26
+
+	// codecFastPFOR is the codec id of the FastPFOR block layout in the wide header.
+	codecFastPFOR = 0
+	// codecFOR is the codec id of frame-of-reference blocks (see PackFORUint32):
+	// the FastPFOR layout holding the differences of the values to a base, which
+	// is stored in a 4-byte record (little-endian uint32) directly after the wide
+	// extension, before the range record. Delta coding is not combined with it.
+	codecFOR        = 1
+	headerBaseBytes = 4
+	// codecConstant is the codec id of constant blocks: all values equal the
+	// base, so the block has neither payload (bit width 0) nor exceptions and
+	// decoders just fill in the base.
+	codecConstant = 2
+
 	// Flag bits in the header
 	headerWillOverflowFlag = uint32(1 << 28) // delta decode WILL overflow uint32 (checked at pack time)
 	headerDeltaFlag        = uint32(1 << 29)
@@ -113,6 +219,22 @@ var payloadBytesLUT = [33]int{
 // lane independently. Missing tail values (len < 128) are treated as zeros.
 var packLanes func(dst []byte, values []uint32, bitWidth int) = packLanesScalar
 
+// packLanesExceptions packs like packLanes and returns the bitmap of the
+// positions whose values exceed bitWidth, detected in the same pass over the
+// values (bit i&63 of word i>>6 is set for position i).
+var packLanesExceptions func(dst []byte, values []uint32, bitWidth int) [2]uint64 = packLanesExceptionsScalar
+
+// unpackDeltaLanes unpacks the D1 deltas of a delta block without zigzag like
+// unpackLanes and replaces them with their prefix sums, in one pass where a
+// fused kernel is available.
+var unpackDeltaLanes func(dst []uint32, payload []byte, count, bitWidth int) = unpackDeltaLanesSeparate
+
+// unpackDeltaLanesSeparate unpacks and delta-decodes in two passes.
+func unpackDeltaLanesSeparate(dst []uint32, payload []byte, count, bitWidth int) {
+	unpackLanes(dst[:count], payload, count, bitWidth)
+	deltaDecode(dst[:count], dst[:count], false)
+}
+
 // unpackLanes performs the inverse of packLanes, up to the logical element
 // count (tail values outside count retain their previous contents).
 var unpackLanes func(dst []uint32, payload []byte, count, bitWidth int) = unpackLanesScalar
@@ -121,14 +243,42 @@ var deltaEncode func(dst, src []uint32) bool = deltaEncodeScalar
 var deltaDecode func(dst, deltas []uint32, useZigZag bool) = deltaDecodeScalar
 var deltaDecodeWithOverflow func(dst, deltas []uint32, useZigZag bool) uint8 = deltaDecodeWithOverflowScalar
 
+// zigzagEncodeBlock and zigzagDecodeBlock convert a block between int32 values
+// (stored as uint32) and their zigzag codes in place.
+var zigzagEncodeBlock func(buf []uint32) = zigzagEncodeScalar
+var zigzagDecodeBlock func(buf []uint32) = zigzagDecodeScalar
+
+// collectExceptions writes the positions and high bits of all values exceeding
+// bitWidth (see collectExceptionsDirect).
+var collectExceptions func(values []uint32, bitWidth int, dst []byte, highBits []uint32) int = collectExceptionsDirect
+
+// gatherLanes extracts the values at positions from a bit-packed payload into
+// dst (see gatherLanesScalar).
+var gatherLanes func(dst []uint32, payload []byte, positions []int, bitWidth int) = gatherLanesScalar
+
 var (
 	simdAvailable bool
 	bo            = binary.LittleEndian
+
+	// relaxedHeaders disables the format version check in readHeader.
+	relaxedHeaders atomic.Bool
 )
 
 // Initialize SIMD path if available
 func init() {
-	initSIMDSelection()
+	detectedFeatures = detectCPUFeatures()
+	selectKernels(detectedFeatures)
+}
+
+// SetRelaxedHeaders controls whether decoders ignore format versions newer than
+// FormatVersion and set reserved header bits (bit 16) instead of failing with
+// ErrUnsupportedFeature. Relaxed decoding restores the behavior of earlier
+// versions; blocks using features unknown to this version may then decode to
+// wrong values. All other header bits are assigned: the former reserved bits
+// 26-27 now hold the delta mode and are validated regardless. The setting
+// applies to all decoders.
+func SetRelaxedHeaders(relaxed bool) {
+	relaxedHeaders.Store(relaxed)
 }
 
 // IsSIMDavailable reports whether SIMD-accelerated pack/unpack paths are active.
@@ -142,45 +292,210 @@ func MaxBlockSizeUint32() int {
 }
 
 // blockBytesConsumed computes the total encoded block size.
-// payloadEnd must be headerBytes + payloadBytes(bitWidth).
-// For exception blocks, reads the exception count and StreamVByte length
-// from buf[payloadEnd:]. Caller must have validated that buf is long enough.
+// payloadEnd must be the payload start (see readHeader) + payloadBytes(bitWidth).
+// For exception blocks, reads the exception area metadata from buf[payloadEnd:].
+// Caller must have validated the metadata (see readPatchLayout).
 func blockBytesConsumed(buf []byte, payloadEnd int) int {
-	excCount := int(buf[payloadEnd])
-	svbLen := int(bo.Uint16(buf[payloadEnd+1 : payloadEnd+3]))
-	return payloadEnd + 1 + 2 + excCount + svbLen
+	l, _ := readPatchLayout(buf[payloadEnd:])
+	return payloadEnd + l.size()
 }
 
 // BlockLength returns the total number of bytes for a single encoded block.
 // It validates the header and exception metadata without decoding the payload.
+// The length is exact (header and its extensions and records, payload and
+// exception area), so blocks concatenated without framing can be iterated by
+// advancing buf by it, and a stream ends on a block boundary if the lengths
+// add up to its size. Only the block metadata must be present in buf.
 func BlockLength(buf []byte) (int, error) {
-	if len(buf) < headerBytes {
-		return 0, fmt.Errorf("%w: buffer too small for header (need %d bytes, got %d)",
-			ErrInvalidBuffer, headerBytes, len(buf))
-	}
-	count, bitWidth, _, hasExceptions, _, _, _ := decodeHeader(bo.Uint32(buf[:headerBytes]))
-	if count > blockSize {
-		return 0, fmt.Errorf("%w: invalid element count %d", ErrInvalidBuffer, count)
+	header, _, payloadStart, err := readHeader(buf)
+	if err != nil {
+		return 0, err
 	}
+	_, bitWidth, _, hasExceptions, _, _, _ := decodeHeader(header)
 
-	payloadEnd := headerBytes + payloadBytes(bitWidth)
+	payloadEnd := payloadStart + payloadBytes(bitWidth)
 
 	if !hasExceptions {
 		return payloadEnd, nil
 	}
 
-	minExcMeta := payloadEnd + 1 + 2 // count + svb_len
+	minExcMeta := payloadEnd + patchMetaBytes // count + svb_len
 	if len(buf) < minExcMeta {
 		return 0, fmt.Errorf("%w: buffer truncated (need %d bytes, got %d)",
 			ErrInvalidBuffer, minExcMeta, len(buf))
 	}
-	excCount := int(buf[payloadEnd]) // positions array size
-	if excCount > blockSize {
-		return 0, fmt.Errorf("%w: invalid exception count %d", ErrInvalidBuffer, excCount)
+	if _, err := readPatchLayout(buf[payloadEnd:]); err != nil {
+		return 0, patchError(err)
 	}
 	return blockBytesConsumed(buf, payloadEnd), nil
 }
 
+// Validate checks that buf holds exactly one structurally valid block without
+// decoding it: the header and its extensions, the payload length, the exception
+// area (count, ascending positions within the block, StreamVByte or raw high bits
+// length) and that no bytes follow the block. Returns ErrInvalidBuffer,
+// ErrInvalidFlags or ErrUnsupportedFeature describing the first problem found.
+//
+// Validate does not check the values themselves; use VerifyBlock to also check
+// the canonical form, and the checksum record (see VerifyBlockChecksum) to
+// detect corrupted payload bits.
+func Validate(buf []byte) error {
+	header, count, payloadStart, err := readHeader(buf)
+	if err != nil {
+		return err
+	}
+	_, bitWidth, _, hasExceptions, _, _, _ := decodeHeader(header)
+	payloadEnd := payloadStart + payloadBytes(bitWidth)
+	if len(buf) < payloadEnd {
+		return fmt.Errorf("%w: payload truncated (need %d bytes, got %d)", ErrInvalidBuffer, payloadEnd, len(buf))
+	}
+	if !hasExceptions {
+		if len(buf) != payloadEnd {
+			return fmt.Errorf("%w: %d trailing bytes after the block", ErrInvalidBuffer, len(buf)-payloadEnd)
+		}
+		return nil
+	}
+	total, err := BlockLength(buf)
+	if err != nil {
+		return err
+	}
+	if len(buf) < total {
+		return fmt.Errorf("%w: exception area truncated (need %d bytes, got %d)", ErrInvalidBuffer, total, len(buf))
+	}
+	if len(buf) != total {
+		return fmt.Errorf("%w: %d trailing bytes after the block", ErrInvalidBuffer, len(buf)-total)
+	}
+	return validatePatch(buf[payloadEnd:], count)
+}
+
+// SplitEncoded splits a single encoded block into its raw header word, the bit-packed
+// payload and the exception area (patch), so the pieces can be stored in custom page
+// layouts (e.g. with patches in a cold region). The patch is nil if the block has no
+// exceptions. The returned slices alias buf.
+//
+// For the wide header form, the header extension is not part of the pieces. As blocks hold at most 128 values, the count field of the header word
+// still carries the full count and AssembleEncoded re-derives the extension from it.
+// Range and provenance records are not part of the pieces either (see
+// SetBlockRange and SetProvenance). Frame-of-reference and constant blocks (see
+// PackFORUint32) are rejected with ErrInvalidFlags, as their base could not be restored.
+func SplitEncoded(buf []byte) (header uint32, payload []byte, patch []byte, err error) {
+	header, _, payloadStart, err := readHeader(buf)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	if hasBaseRecord(buf, header) {
+		return 0, nil, nil, fmt.Errorf("%w: cannot split a frame-of-reference block", ErrInvalidFlags)
+	}
+	total, err := BlockLength(buf)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	if len(buf) < total {
+		return 0, nil, nil, fmt.Errorf("%w: buffer truncated (need %d bytes, got %d)",
+			ErrInvalidBuffer, total, len(buf))
+	}
+	_, bitWidth, _, hasExceptions, _, _, _ := decodeHeader(header)
+	payloadEnd := payloadStart + payloadBytes(bitWidth)
+	payload = buf[payloadStart:payloadEnd:payloadEnd]
+	if hasExceptions {
+		patch = buf[payloadEnd:total:total]
+	}
+	return header, payload, patch, nil
+}
+
+// AssembleEncoded is the inverse of SplitEncoded: it validates the pieces and
+// concatenates them into a new encoded block. A header extension indicated by the
+// header flags is re-derived from the count field. The range, checksum and
+// provenance flags are cleared, as the records are not part of the pieces.
+//
+// Returns ErrInvalidBuffer if the pieces are inconsistent with the header (count,
+// bit width, payload length, exception flag or exception area layout) and
+// ErrInvalidFlags for contradicting header flags.
+func AssembleEncoded(header uint32, payload []byte, patch []byte) ([]byte, error) {
+	header &^= headerRangeFlag | headerChecksumFlag | headerProvenanceFlag
+	count, bitWidth, _, hasExceptions, _, _, _ := decodeHeader(header)
+	if count > blockSize {
+		return nil, fmt.Errorf("%w: invalid element count %d", ErrInvalidBuffer, count)
+	}
+	if bitWidth > 32 {
+		return nil, fmt.Errorf("%w: invalid bit width %d", ErrInvalidBuffer, bitWidth)
+	}
+	if len(payload) != payloadBytes(bitWidth) {
+		return nil, fmt.Errorf("%w: payload length %d does not match bit width %d (need %d bytes)",
+			ErrInvalidBuffer, len(payload), bitWidth, payloadBytes(bitWidth))
+	}
+	if hasExceptions != (patch != nil) {
+		return nil, fmt.Errorf("%w: exception flag does not match patch presence", ErrInvalidBuffer)
+	}
+	if hasExceptions {
+		if err := validatePatch(patch, count); err != nil {
+			return nil, err
+		}
+	}
+
+	headerLen := headerBytes
+	if header&headerWideFlag != 0 {
+		headerLen += headerWideBytes
+	}
+
+	buf := make([]byte, headerLen, headerLen+len(payload)+len(patch))
+	bo.PutUint32(buf, header)
+	if header&headerWideFlag != 0 {
+		bo.PutUint32(buf[headerBytes:], uint32(count)|codecFastPFOR<<headerWideCodecShift)
+	}
+	buf = append(buf, payload...)
+	buf = append(buf, patch...)
+	return buf, nil
+}
+
+// validatePatch checks that patch is exactly one well-formed exception area for a
+// block of count values: count(1) + svbLen(2) + positions + StreamVByte data, with
+// strictly ascending positions below count (plain or run-coded, see patchRunsFlag)
+// and StreamVByte control bytes that account for exactly svbLen bytes.
+func validatePatch(patch []byte, count int) error {
+	if len(patch) < 3 {
+		return fmt.Errorf("%w: patch too small (need at least 3 bytes, got %d)", ErrInvalidBuffer, len(patch))
+	}
+	l, err := readPatchLayout(patch)
+	if err != nil {
+		return patchError(err)
+	}
+	excCount, svbLen := l.excCount, l.svbLen
+	if excCount == 0 || excCount > count {
+		return fmt.Errorf("%w: invalid exception count %d", ErrInvalidBuffer, excCount)
+	}
+	if want := l.size(); len(patch) != want {
+		return fmt.Errorf("%w: patch length %d does not match its metadata (need %d bytes)",
+			ErrInvalidBuffer, len(patch), want)
+	}
+	var scratch [blockSize]byte
+	positions, err := l.positions(patch, &scratch)
+	if err != nil {
+		return patchError(err)
+	}
+	prev := -1
+	for _, pos := range positions {
+		if int(pos) <= prev || int(pos) >= count {
+			return fmt.Errorf("%w: invalid exception position %d", ErrInvalidBuffer, pos)
+		}
+		prev = int(pos)
+	}
+	if l.rawWidth > 0 {
+		return nil // the length is checked by readPatchLayout
+	}
+	svb := patch[l.svbOff():]
+	numControlBytes := (excCount + 3) >> 2
+	if len(svb) < numControlBytes {
+		return fmt.Errorf("%w: truncated StreamVByte control bytes", ErrInvalidBuffer)
+	}
+	dataLen := svbDataLen(svb, excCount)
+	if numControlBytes+dataLen != svbLen {
+		return fmt.Errorf("%w: StreamVByte length %d does not match control bytes (need %d bytes)",
+			ErrInvalidBuffer, svbLen, numControlBytes+dataLen)
+	}
+	return nil
+}
+
 // PackUint32 encodes up to BlockSize uint32 values into the FastPFOR block format.
 // The function appends the block to dst so the caller can reuse buffers and
 // avoid per-block allocations. Callers must not reuse the same dst slice across
@@ -192,7 +507,10 @@ func BlockLength(buf []byte) (int, error) {
 //
 // For zero-allocation operation when data contains exceptions, provide a values
 // slice with cap >= 256. The extra capacity (positions 128-255) is used as scratch
-// space for exception handling.
+// space for exception handling. An Encoder brings its own scratch space instead.
+//
+// The number of values is not checked; use TryPackUint32 for input of unchecked
+// length.
 func PackUint32(dst []byte, values []uint32) []byte {
 	return packInternal(dst, values, headerTypeUint32Flag)
 }
@@ -202,13 +520,38 @@ func PackUint32(dst []byte, values []uint32) []byte {
 //
 // The extraFlags parameter can include integer type flags (headerTypeUint16Flag, etc.)
 // as well as delta/zigzag flags. If no type flag is set, IntTypeUint32 is used.
+// If headerWideFlag is set, the 32-bit wide extension (count and codec id) is
+// written directly after the header.
 func packInternal(dst []byte, values []uint32, extraFlags uint32) []byte {
+	return packInternalScratch(dst, values, extraFlags, nil, nil)
+}
+
+// packInternalScratch is packInternal with scratch space of at least blockSize
+// values for the exception high bits and the width selection options opts (nil
+// for the defaults). Without scratch, the capacity of values beyond blockSize is
+// used if it suffices, else the space is allocated.
+func packInternalScratch(dst []byte, values []uint32, extraFlags uint32, scratch []uint32, opts *EncodeOptions) []byte {
+	return packInternalCodec(dst, values, extraFlags, scratch, opts, codecFastPFOR, 0)
+}
+
+// packInternalCodec is packInternalScratch with the codec id of the wide
+// extension. For codecFOR and codecConstant, which require headerWideFlag in
+// extraFlags, the base record holding base is written after the extension.
+func packInternalCodec(dst []byte, values []uint32, extraFlags uint32, scratch []uint32, opts *EncodeOptions, codec, base uint32) []byte {
 	// Select the bit width that minimizes the serialized size.
-	bitWidth, excCount := selectBitWidth(values)
+	bitWidth, excCount := selectBitWidthOptions(values, opts)
 	// Calculate the length of the payload
 	payloadLen := payloadBytes(bitWidth)
+	// Calculate the length of the header including the optional wide extension
+	headerLen := headerBytes
+	if extraFlags&headerWideFlag != 0 {
+		headerLen += headerWideBytes
+		if codec != codecFastPFOR {
+			headerLen += headerBaseBytes
+		}
+	}
 	// Calculate the maximum length of the block (actual may be smaller due to StreamVByte)
-	maxTotal := headerBytes + payloadLen + patchBytesMax(excCount)
+	maxTotal := headerLen + payloadLen + patchBytesMax(excCount)
 
 	start := len(dst)
 	dst = slices.Grow(dst, maxTotal)
@@ -219,28 +562,40 @@ func packInternal(dst []byte, values []uint32, extraFlags uint32) []byte {
 	}
 	header := encodeHeader(len(values), bitWidth, flags)
 	bo.PutUint32(dst[start:start+headerBytes], header)
+	if extraFlags&headerWideFlag != 0 {
+		ext := uint32(len(values)) | codec<<headerWideCodecShift
+		bo.PutUint32(dst[start+headerBytes:], ext)
+		if codec != codecFastPFOR {
+			bo.PutUint32(dst[start+headerBytes+headerWideBytes:], base)
+		}
+	}
 
-	payloadStart := start + headerBytes
+	payloadStart := start + headerLen
 	payloadEnd := payloadStart + payloadLen
-	if payloadLen > 0 {
+	var excMask [2]uint64
+	if excCount > 0 {
+		excMask = packLanesExceptions(dst[payloadStart:payloadEnd], values, bitWidth)
+	} else if payloadLen > 0 {
 		packLanes(dst[payloadStart:payloadEnd], values, bitWidth)
 	}
 
-	// Write exceptions directly, using values[blockSize:] as scratch for high bits
+	// Write exceptions directly, using scratch or values[blockSize:] for high bits
 	actualPatchLen := 0
 	if excCount > 0 {
-		// Ensure values has scratch space (cap >= 256)
 		var highBits []uint32
-		if cap(values) >= 2*blockSize {
+		switch {
+		case scratch != nil:
+			highBits = scratch[:excCount]
+		case cap(values) >= 2*blockSize:
 			highBits = values[blockSize : blockSize+excCount]
-		} else {
+		default:
 			highBits = make([]uint32, excCount)
 		}
-		actualPatchLen = writeExceptionsDirect(dst[payloadEnd:], values, bitWidth, highBits)
+		actualPatchLen = writeExceptionsDirect(dst[payloadEnd:], values, bitWidth, excMask, highBits)
 	}
 
 	// Trim to actual size
-	actualTotal := headerBytes + payloadLen + actualPatchLen
+	actualTotal := headerLen + payloadLen + actualPatchLen
 	return dst[:start+actualTotal]
 }
 
@@ -261,14 +616,24 @@ func packInternal(dst []byte, values []uint32, extraFlags uint32) []byte {
 //	    // Handle overflow at overflow.Position
 //	}
 func UnpackUint32(dst []uint32, buf []byte) ([]uint32, error) {
-	if len(buf) < headerBytes {
-		return nil, fmt.Errorf("%w: buffer too small for header (need %d bytes, got %d)",
-			ErrInvalidBuffer, headerBytes, len(buf))
+	if s := decodeSampler.Load(); s != nil && s.due() {
+		start := time.Now()
+		values, err := unpackUint32(dst, buf)
+		s.record(buf, time.Since(start))
+		return values, err
+	}
+	return unpackUint32(dst, buf)
+}
+
+func unpackUint32(dst []uint32, buf []byte) ([]uint32, error) {
+	header, count, payloadStart, err := readHeader(buf)
+	if err != nil {
+		return nil, err
 	}
-	count, bitWidth, _, hasExceptions, hasDelta, hasZigZag, willOverflow := decodeHeader(bo.Uint32(buf[:headerBytes]))
+	_, bitWidth, _, hasExceptions, hasDelta, hasZigZag, willOverflow := decodeHeader(header)
 
 	payloadLen := payloadBytes(bitWidth)
-	minNeeded := headerBytes + payloadLen
+	minNeeded := payloadStart + payloadLen
 	if len(buf) < minNeeded {
 		return nil, fmt.Errorf("%w: buffer truncated (need %d bytes, got %d)",
 			ErrInvalidBuffer, minNeeded, len(buf))
@@ -284,22 +649,34 @@ func UnpackUint32(dst []uint32, buf []byte) ([]uint32, error) {
 
 	// Ensure capacity for the output values
 	dst = ensureUint32Cap(dst, count, blockSize)
-	if bitWidth == 0 {
+	// D1 blocks without exceptions, zigzag and overflow are decoded in one pass
+	fused := hasDelta && !hasExceptions && !hasZigZag && !willOverflow && headerDeltaMode(header) == DeltaD1
+	switch {
+	case blockCodec(buf, header) == codecConstant:
+		fillValues(dst[:count], frameBase(buf, header))
+		return dst[:count], nil
+	case bitWidth == 0:
 		clear(dst[:count])
-	} else {
-		unpackLanes(dst[:count], buf[headerBytes:minNeeded], count, bitWidth)
+	case fused:
+		unpackDeltaLanes(dst[:count], buf[payloadStart:minNeeded], count, bitWidth)
+	default:
+		unpackLanes(dst[:count], buf[payloadStart:minNeeded], count, bitWidth)
 	}
 
 	// Handle exceptions (StreamVByte format), using a stack scratch buffer
 	if hasExceptions {
 		var scratch [blockSize]uint32
 		if _, err := applyExceptions(dst[:count], buf, minNeeded, count, bitWidth, scratch[:]); err != nil {
-			return nil, fmt.Errorf("%w: %v", ErrInvalidBuffer, err)
+			return nil, patchError(err)
 		}
 	}
 
+	if base := frameBase(buf, header); base != 0 {
+		addBase(dst[:count], base)
+	}
+
 	// Apply delta decoding if the data was delta-encoded
-	if hasDelta {
+	if hasDelta && !fused {
 		if willOverflow {
 			// Overflow-detecting path for PackAlreadyDeltaUint32 blocks
 			overflowPos := deltaDecodeWithOverflow(dst[:count], dst[:count], hasZigZag)
@@ -309,7 +686,7 @@ func UnpackUint32(dst []uint32, buf []byte) ([]uint32, error) {
 			return dst[:count], nil
 		}
 		// Fast path for PackDeltaUint32 blocks (no overflow possible)
-		deltaDecode(dst[:count], dst[:count], hasZigZag)
+		deltaDecodeMode(dst[:count], headerDeltaMode(header), hasZigZag)
 	}
 
 	return dst[:count], nil
@@ -323,17 +700,27 @@ func UnpackUint32(dst []uint32, buf []byte) ([]uint32, error) {
 // The scratch buffer must have cap(scratch) >= 128. For optimal performance, reuse the
 // same scratch buffer across multiple calls.
 func UnpackUint32WithBuffer(dst []uint32, scratch []uint32, buf []byte) ([]uint32, error) {
+	if s := decodeSampler.Load(); s != nil && s.due() {
+		start := time.Now()
+		values, err := unpackUint32WithBuffer(dst, scratch, buf)
+		s.record(buf, time.Since(start))
+		return values, err
+	}
+	return unpackUint32WithBuffer(dst, scratch, buf)
+}
+
+func unpackUint32WithBuffer(dst []uint32, scratch []uint32, buf []byte) ([]uint32, error) {
 	if cap(scratch) < blockSize {
 		return nil, fmt.Errorf("fastpfor: scratch capacity too small (need %d, got %d)", blockSize, cap(scratch))
 	}
-	if len(buf) < headerBytes {
-		return nil, fmt.Errorf("%w: buffer too small for header (need %d bytes, got %d)",
-			ErrInvalidBuffer, headerBytes, len(buf))
+	header, count, payloadStart, err := readHeader(buf)
+	if err != nil {
+		return nil, err
 	}
-	count, bitWidth, _, hasExceptions, hasDelta, hasZigZag, willOverflow := decodeHeader(bo.Uint32(buf[:headerBytes]))
+	_, bitWidth, _, hasExceptions, hasDelta, hasZigZag, willOverflow := decodeHeader(header)
 
 	payloadLen := payloadBytes(bitWidth)
-	minNeeded := headerBytes + payloadLen
+	minNeeded := payloadStart + payloadLen
 	if len(buf) < minNeeded {
 		return nil, fmt.Errorf("%w: buffer truncated (need %d bytes, got %d)",
 			ErrInvalidBuffer, minNeeded, len(buf))
@@ -349,22 +736,34 @@ func UnpackUint32WithBuffer(dst []uint32, scratch []uint32, buf []byte) ([]uint3
 
 	// Ensure capacity for the output values
 	dst = ensureUint32Cap(dst, count, blockSize)
-	if bitWidth == 0 {
+	// D1 blocks without exceptions, zigzag and overflow are decoded in one pass
+	fused := hasDelta && !hasExceptions && !hasZigZag && !willOverflow && headerDeltaMode(header) == DeltaD1
+	switch {
+	case blockCodec(buf, header) == codecConstant:
+		fillValues(dst[:count], frameBase(buf, header))
+		return dst[:count], nil
+	case bitWidth == 0:
 		clear(dst[:count])
-	} else {
-		unpackLanes(dst[:count], buf[headerBytes:minNeeded], count, bitWidth)
+	case fused:
+		unpackDeltaLanes(dst[:count], buf[payloadStart:minNeeded], count, bitWidth)
+	default:
+		unpackLanes(dst[:count], buf[payloadStart:minNeeded], count, bitWidth)
 	}
 
 	// Handle exceptions (StreamVByte format), using caller-provided scratch buffer
 	if hasExceptions {
 		scratch = scratch[:blockSize]
 		if _, err := applyExceptions(dst[:count], buf, minNeeded, count, bitWidth, scratch); err != nil {
-			return nil, fmt.Errorf("%w: %v", ErrInvalidBuffer, err)
+			return nil, patchError(err)
 		}
 	}
 
+	if base := frameBase(buf, header); base != 0 {
+		addBase(dst[:count], base)
+	}
+
 	// Apply delta decoding if the data was delta-encoded
-	if hasDelta {
+	if hasDelta && !fused {
 		if willOverflow {
 			// Overflow-detecting path for PackAlreadyDeltaUint32 blocks
 			overflowPos := deltaDecodeWithOverflow(dst[:count], dst[:count], hasZigZag)
@@ -374,7 +773,7 @@ func UnpackUint32WithBuffer(dst []uint32, scratch []uint32, buf []byte) ([]uint3
 			return dst[:count], nil
 		}
 		// Fast path for PackDeltaUint32 blocks (no overflow possible)
-		deltaDecode(dst[:count], dst[:count], hasZigZag)
+		deltaDecodeMode(dst[:count], headerDeltaMode(header), hasZigZag)
 	}
 
 	return dst[:count], nil
@@ -404,17 +803,27 @@ func UnpackUint32WithLength(dst []uint32, buf []byte) ([]uint32, int, error) {
 // The scratch buffer must have cap(scratch) >= 128. For optimal performance, reuse the
 // same scratch buffer across multiple calls.
 func UnpackUint32WithBufferAndLength(dst []uint32, scratch []uint32, buf []byte) ([]uint32, int, error) {
+	if s := decodeSampler.Load(); s != nil && s.due() {
+		start := time.Now()
+		values, n, err := unpackUint32WithBufferAndLength(dst, scratch, buf)
+		s.record(buf, time.Since(start))
+		return values, n, err
+	}
+	return unpackUint32WithBufferAndLength(dst, scratch, buf)
+}
+
+func unpackUint32WithBufferAndLength(dst []uint32, scratch []uint32, buf []byte) ([]uint32, int, error) {
 	if cap(scratch) < blockSize {
 		return nil, 0, fmt.Errorf("fastpfor: scratch capacity too small (need %d, got %d)", blockSize, cap(scratch))
 	}
 
-	if len(buf) < headerBytes {
-		return nil, 0, fmt.Errorf("%w: buffer too small for header (need %d bytes, got %d)",
-			ErrInvalidBuffer, headerBytes, len(buf))
+	header, count, payloadStart, err := readHeader(buf)
+	if err != nil {
+		return nil, 0, err
 	}
-	count, bitWidth, _, hasExceptions, hasDelta, hasZigZag, willOverflow := decodeHeader(bo.Uint32(buf[:headerBytes]))
+	_, bitWidth, _, hasExceptions, hasDelta, hasZigZag, willOverflow := decodeHeader(header)
 
-	payloadEnd := headerBytes + payloadBytes(bitWidth)
+	payloadEnd := payloadStart + payloadBytes(bitWidth)
 	if len(buf) < payloadEnd {
 		return nil, 0, fmt.Errorf("%w: buffer truncated (need %d bytes, got %d)",
 			ErrInvalidBuffer, payloadEnd, len(buf))
@@ -431,23 +840,35 @@ func UnpackUint32WithBufferAndLength(dst []uint32, scratch []uint32, buf []byte)
 
 	// Ensure capacity for the output values.
 	dst = ensureUint32Cap(dst, count, blockSize)
-	if bitWidth == 0 {
+	// D1 blocks without exceptions, zigzag and overflow are decoded in one pass
+	fused := hasDelta && !hasExceptions && !hasZigZag && !willOverflow && headerDeltaMode(header) == DeltaD1
+	switch {
+	case blockCodec(buf, header) == codecConstant:
+		fillValues(dst[:count], frameBase(buf, header))
+		return dst[:count], bytesConsumed, nil
+	case bitWidth == 0:
 		clear(dst[:count])
-	} else {
-		unpackLanes(dst[:count], buf[headerBytes:payloadEnd], count, bitWidth)
+	case fused:
+		unpackDeltaLanes(dst[:count], buf[payloadStart:payloadEnd], count, bitWidth)
+	default:
+		unpackLanes(dst[:count], buf[payloadStart:payloadEnd], count, bitWidth)
 	}
 
 	// Handle exceptions (StreamVByte format).
 	if hasExceptions {
 		patchBytes, err := applyExceptions(dst[:count], buf, payloadEnd, count, bitWidth, scratch)
 		if err != nil {
-			return nil, 0, fmt.Errorf("%w: %v", ErrInvalidBuffer, err)
+			return nil, 0, patchError(err)
 		}
 		bytesConsumed = payloadEnd + patchBytes
 	}
 
+	if base := frameBase(buf, header); base != 0 {
+		addBase(dst[:count], base)
+	}
+
 	// Apply delta decoding if the data was delta-encoded.
-	if hasDelta {
+	if hasDelta && !fused {
 		if willOverflow {
 			overflowPos := deltaDecodeWithOverflow(dst[:count], dst[:count], hasZigZag)
 			if overflowPos > 0 {
@@ -455,30 +876,172 @@ func UnpackUint32WithBufferAndLength(dst []uint32, scratch []uint32, buf []byte)
 			}
 			return dst[:count], bytesConsumed, nil
 		}
-		deltaDecode(dst[:count], dst[:count], hasZigZag)
+		deltaDecodeMode(dst[:count], headerDeltaMode(header), hasZigZag)
 	}
 
 	return dst[:count], bytesConsumed, nil
 }
 
-// PackDeltaUint32 delta-encodes values in-place prior to calling PackUint32.
-// WARNING: This function mutates the values slice. If you need to preserve
-// the original values, make a copy before calling PackDeltaUint32.
-// The delta flag is set in the header so UnpackUint32 can auto-detect and decode.
+// prefixUnpackMax is the largest prefix for which UnpackFirstN reads the needed lane
+// words with the scalar kernel. Beyond one value per lane, a full SIMD unpack of the
+// payload is faster (the scalar kernel is used anyway without SIMD support).
+const prefixUnpackMax = laneCount
+
+// UnpackFirstN decodes only the first n logical values of a block into dst (which will
+// be resized as needed). Only the lane words holding these values are unpacked, only
+// exceptions at positions below n are applied, and delta decoding stops at n, which
+// makes it suitable for top-N previews and early-exit query operators.
+// If n exceeds the number of values in the block, all values are decoded.
 //
-// For zero-allocation operation when data contains exceptions, provide a values
-// slice with cap >= 256. The extra capacity (positions 128-255) is used as scratch
-// space for exception handling.
-func PackDeltaUint32(dst []byte, values []uint32) []byte {
-	var useZigZag bool
-	if len(values) > 0 {
-		useZigZag = deltaEncode(values, values) // in-place
+// Returns an error if n is negative or the buffer is invalid. For blocks packed with
+// PackAlreadyDeltaUint32, *ErrOverflow is returned if delta decoding of the prefix
+// overflows (see UnpackUint32).
+func UnpackFirstN(dst []uint32, buf []byte, n int) ([]uint32, error) {
+	if n < 0 {
+		return nil, fmt.Errorf("%w: %d values requested", ErrInvalidBlockLength, n)
 	}
-	flags := headerTypeUint32Flag | headerDeltaFlag // Always set type and delta flags
-	if useZigZag {
-		flags |= headerZigZagFlag
+	header, count, payloadStart, err := readHeader(buf)
+	if err != nil {
+		return nil, err
+	}
+	_, bitWidth, _, hasExceptions, hasDelta, hasZigZag, willOverflow := decodeHeader(header)
+
+	payloadEnd := payloadStart + payloadBytes(bitWidth)
+	if len(buf) < payloadEnd {
+		return nil, fmt.Errorf("%w: buffer truncated (need %d bytes, got %d)",
+			ErrInvalidBuffer, payloadEnd, len(buf))
+	}
+
+	n = min(n, count)
+	if n == 0 {
+		if dst == nil {
+			return nil, nil
+		}
+		return dst[:0], nil
+	}
+
+	dst = ensureUint32Cap(dst, n, blockSize)
+	if n <= prefixUnpackMax {
+		unpackLanesScalar(dst[:n], buf[payloadStart:payloadEnd], n, bitWidth)
+	} else {
+		unpackLanes(dst[:n], buf[payloadStart:payloadEnd], n, bitWidth)
+	}
+
+	if hasExceptions {
+		if err := applyExceptionsRange(dst[:n], buf[payloadEnd:], 0, n, bitWidth); err != nil {
+			return nil, patchError(err)
+		}
+	}
+
+	if base := frameBase(buf, header); base != 0 {
+		addBase(dst[:n], base)
+	}
+	if hasDelta {
+		if willOverflow {
+			overflowPos := deltaDecodeWithOverflow(dst[:n], dst[:n], hasZigZag)
+			if overflowPos > 0 {
+				return dst[:n], &ErrOverflow{Position: overflowPos}
+			}
+			return dst[:n], nil
+		}
+		deltaDecodeMode(dst[:n], headerDeltaMode(header), hasZigZag)
+	}
+	return dst[:n], nil
+}
+
+// GetAt returns the value at position pos of a non-delta block in constant time,
+// without constructing a reader: lane layout and bit width determine the word(s)
+// holding the value, and an exception is resolved by decoding only its own high
+// bits (see SlimReader.Get). The base of frame-of-reference blocks is added; for
+// signed and float blocks the raw code is returned, as UnpackUint32 would.
+//
+// Returns ErrInvalidFlags for delta blocks, whose values depend on all preceding
+// deltas, ErrPositionOutOfRange if pos is outside the block and ErrInvalidBuffer
+// if the buffer is truncated.
+func GetAt(buf []byte, pos int) (uint32, error) {
+	header, count, payloadStart, err := readHeader(buf)
+	if err != nil {
+		return 0, err
+	}
+	_, bitWidth, _, hasExceptions, hasDelta, _, _ := decodeHeader(header)
+	if hasDelta {
+		return 0, fmt.Errorf("%w: random access to a delta block", ErrInvalidFlags)
+	}
+	if pos < 0 || pos >= count {
+		return 0, ErrPositionOutOfRange
+	}
+	length, err := BlockLength(buf)
+	if err != nil {
+		return 0, err
+	}
+	if len(buf) < length {
+		return 0, fmt.Errorf("%w: buffer truncated (need %d bytes, got %d)",
+			ErrInvalidBuffer, length, len(buf))
+	}
+
+	value, err := rawValueAt(buf[:length], payloadStart, bitWidth, hasExceptions, pos)
+	if err != nil {
+		return 0, err
 	}
-	return packInternal(dst, values, flags)
+	return value + frameBase(buf, header), nil
+}
+
+// rawValueAt returns the packed value at pos of the block buf, whose length must
+// be checked (including its exception, before any delta decoding) in constant
+// time. Returns ErrInvalidBuffer if the exception area is malformed.
+func rawValueAt(buf []byte, payloadStart, bitWidth int, hasExceptions bool, pos int) (uint32, error) {
+	payloadEnd := payloadStart + payloadBytes(bitWidth)
+	var value uint32
+	if bitWidth > 0 {
+		value = extractLaneValue(buf[payloadStart:payloadEnd], uint32(pos), bitWidth)
+	}
+	if hasExceptions {
+		var err error
+		if value, err = applyExceptionAt(buf[payloadEnd:], uint32(pos), value, bitWidth); err != nil {
+			return 0, patchError(err)
+		}
+	}
+	return value, nil
+}
+
+// TryPackUint32 is PackUint32 for values of unchecked length, e.g. from untrusted
+// sources. It returns ErrInvalidBlockLength and dst unchanged if there are more
+// than BlockSize values, which PackUint32 does not check.
+func TryPackUint32(dst []byte, values []uint32) ([]byte, error) {
+	if err := validateBlockLength(len(values)); err != nil {
+		return dst, err
+	}
+	return PackUint32(dst, values), nil
+}
+
+// TryPackDeltaUint32 is PackDeltaUint32 for values of unchecked length. It
+// returns ErrInvalidBlockLength and dst unchanged if there are more than
+// BlockSize values.
+func TryPackDeltaUint32(dst []byte, values []uint32) ([]byte, error) {
+	if err := validateBlockLength(len(values)); err != nil {
+		return dst, err
+	}
+	return PackDeltaUint32(dst, values), nil
+}
+
+// PackDeltaUint32 delta-encodes values and packs the deltas like PackUint32.
+// The deltas are computed into a scratch block and packed from there, so the
+// values slice is not mutated and no extra capacity is needed for exceptions.
+// The delta flag is set in the header so UnpackUint32 can auto-detect and decode.
+func PackDeltaUint32(dst []byte, values []uint32) []byte {
+	e := encoderPool.Get().(*Encoder)
+	defer encoderPool.Put(e)
+	return e.PackDelta(dst, values)
+}
+
+// PackDeltaUint32Mode is like PackDeltaUint32, but codes the values in the
+// given delta mode (see DeltaMode). DeltaD4 suits mostly sorted data that is
+// decoded far more often than it is encoded: its blocks are slightly larger,
+// but decode faster. Panics on an unknown mode.
+func PackDeltaUint32Mode(dst []byte, values []uint32, mode DeltaMode) []byte {
+	e := encoderPool.Get().(*Encoder)
+	defer encoderPool.Put(e)
+	return e.PackDeltaMode(dst, values, mode)
 }
 
 // PackAlreadyDeltaUint32 packs pre-computed delta values (does NOT compute deltas itself).
@@ -560,14 +1123,130 @@ func patchBytesMax(exceptionCount int) int {
 	return 1 + exceptionCount + 2 + streamvbyte.MaxEncodedLen(exceptionCount)
 }
 
+// patchBytesExact returns the number of bytes writeExceptionsDirect writes for the
+// excCount exceptions of values at bitWidth, taking the position form and the
+// high bit encoding into account that patchBytesMax has to assume the worst of.
+func patchBytesExact(values []uint32, bitWidth, excCount int) int {
+	if excCount == 0 {
+		return 0
+	}
+	var positions [blockSize]byte
+	var orHigh uint32
+	n, svbLen := 0, (excCount+3)/4 // control bytes
+	for i, v := range values {
+		if bits.Len32(v) <= bitWidth {
+			continue
+		}
+		positions[n] = byte(i)
+		n++
+		h := v >> bitWidth
+		orHigh |= h
+		svbLen += max(1, (bits.Len32(h)+7)/8)
+	}
+	if width := (bits.Len32(orHigh) + 7) / 8; width*excCount < svbLen {
+		svbLen = width * excCount
+	}
+	var runs [blockSize]byte
+	posLen := excCount
+	switch r := encodeRuns(runs[:], positions[:n]); {
+	case patchBitmapBytes < min(excCount, r+1):
+		posLen = patchBitmapBytes
+	case r+1 < excCount:
+		posLen = r + 1
+	}
+	return patchMetaBytes + posLen + svbLen
+}
+
 // encodeHeader encodes the header for a block. It combines the count, bit width, and flags.
 // The flags parameter should include the integer type (headerTypeUint16Flag, etc.).
 func encodeHeader(count, bitWidth int, flags uint32) uint32 {
 	return uint32(count&headerCountMask) |
 		(uint32(bitWidth&headerWidthMask) << headerWidthShift) |
+		FormatVersion<<headerVersionShift |
 		flags
 }
 
+// readHeader reads the block header at the start of buf, including the optional
+// wide header extension (with the base record of
+// frame-of-reference blocks) and the range and provenance records. It returns the raw header word,
+// the element count and the offset at which the payload begins.
+func readHeader(buf []byte) (header uint32, count, payloadStart int, err error) {
+	if len(buf) < headerBytes {
+		return 0, 0, 0, fmt.Errorf("%w: buffer too small for header (need %d bytes, got %d)",
+			ErrInvalidBuffer, headerBytes, len(buf))
+	}
+	header = bo.Uint32(buf[:headerBytes])
+	if mode := headerDeltaMode(header); mode != DeltaD1 {
+		if header&headerDeltaFlag == 0 || header&headerWillOverflowFlag != 0 {
+			return 0, 0, 0, fmt.Errorf("%w: delta mode %s with header flags %#x", ErrInvalidFlags, mode, header)
+		}
+	}
+	if !relaxedHeaders.Load() {
+		if version := headerVersion(header); version > FormatVersion {
+			return 0, 0, 0, fmt.Errorf("%w: format version %d is newer than %d", ErrUnsupportedFeature, version, FormatVersion)
+		}
+		if reserved := header & headerReservedMask; reserved != 0 {
+			return 0, 0, 0, fmt.Errorf("%w: reserved header bits %#x", ErrUnsupportedFeature, reserved)
+		}
+	}
+	count = int(header & headerCountMask)
+	payloadStart = headerBytes
+	if header&headerWideFlag != 0 {
+		payloadStart += headerWideBytes
+		if len(buf) < payloadStart {
+			return 0, 0, 0, fmt.Errorf("%w: buffer too small for wide header (need %d bytes, got %d)",
+				ErrInvalidBuffer, payloadStart, len(buf))
+		}
+		ext := bo.Uint32(buf[headerBytes:payloadStart])
+		switch codec := ext >> headerWideCodecShift; codec {
+		case codecFastPFOR:
+		case codecFOR, codecConstant:
+			if header&headerDeltaFlag != 0 {
+				return 0, 0, 0, fmt.Errorf("%w: frame-of-reference block with delta flag", ErrInvalidFlags)
+			}
+			if codec == codecConstant && header&(headerExceptionFlag|headerWidthMask<<headerWidthShift) != 0 {
+				return 0, 0, 0, fmt.Errorf("%w: constant block with payload", ErrInvalidFlags)
+			}
+			payloadStart += headerBaseBytes
+			if len(buf) < payloadStart {
+				return 0, 0, 0, fmt.Errorf("%w: buffer too small for base record (need %d bytes, got %d)",
+					ErrInvalidBuffer, payloadStart, len(buf))
+			}
+		default:
+			return 0, 0, 0, fmt.Errorf("%w: unknown codec id %d", ErrInvalidBuffer, codec)
+		}
+		count = int(ext & headerWideCountMask)
+	}
+	if header&headerRangeFlag != 0 {
+		payloadStart += headerRangeBytes
+		if len(buf) < payloadStart {
+			return 0, 0, 0, fmt.Errorf("%w: buffer too small for range record (need %d bytes, got %d)",
+				ErrInvalidBuffer, payloadStart, len(buf))
+		}
+	}
+	if header&headerChecksumFlag != 0 {
+		payloadStart += headerChecksumBytes
+		if len(buf) < payloadStart {
+			return 0, 0, 0, fmt.Errorf("%w: buffer too small for checksum record (need %d bytes, got %d)",
+				ErrInvalidBuffer, payloadStart, len(buf))
+		}
+	}
+	if header&headerProvenanceFlag != 0 {
+		payloadStart += headerProvenanceBytes
+		if len(buf) < payloadStart {
+			return 0, 0, 0, fmt.Errorf("%w: buffer too small for provenance record (need %d bytes, got %d)",
+				ErrInvalidBuffer, payloadStart, len(buf))
+		}
+	}
+	if count > blockSize {
+		return 0, 0, 0, fmt.Errorf("%w: invalid element count %d", ErrInvalidBuffer, count)
+	}
+	if bitWidth := (header >> headerWidthShift) & headerWidthMask; bitWidth > 32 {
+		return 0, 0, 0, fmt.Errorf("%w: invalid bit width %d", ErrInvalidBuffer, bitWidth)
+	}
+	return header, count, payloadStart, nil
+}
+
 // decodeHeader decodes the header for a block. It extracts count, bit width, integer type, and flags.
 func decodeHeader(header uint32) (count, bitWidth, intType int, hasExceptions, hasDelta, hasZigZag, willOverflow bool) {
 	count = int(header & headerCountMask)
@@ -597,10 +1276,33 @@ func packLanesScalar(dst []byte, values []uint32, bitWidth int) {
 	}
 }
 
+// packLanesExceptionsScalar packs the lanes like packLanesScalar and merges the
+// exception masks of the lanes into the position bitmap.
+func packLanesExceptionsScalar(dst []byte, values []uint32, bitWidth int) [2]uint64 {
+	var positions [2]uint64
+	for lane := range laneCount {
+		var laneMask uint32
+		if bitWidth == 0 {
+			for i := lane; i < len(values); i += laneCount {
+				laneMask |= b2u(values[i] != 0) << (i / laneCount)
+			}
+		} else {
+			laneMask = packLaneInterleaved(dst, values, lane, bitWidth)
+		}
+		// Value i of the lane is at position 4*i+lane
+		for ; laneMask != 0; laneMask &= laneMask - 1 {
+			pos := laneCount*bits.TrailingZeros32(laneMask) + lane
+			positions[pos>>6] |= 1 << (pos & 63)
+		}
+	}
+	return positions
+}
+
 // packLaneInterleaved packs 32 integers from the specified lane (indices lane, lane+4, …)
 // directly into the interleaved output format using a streaming 64-bit accumulator.
 // Output words are written at byte offsets: lane*4, lane*4+16, lane*4+32, ... (stride 16 bytes).
-func packLaneInterleaved(dst []byte, values []uint32, lane, bitWidth int) {
+// It returns the lane mask of the values exceeding bitWidth (bit i for index lane+4*i).
+func packLaneInterleaved(dst []byte, values []uint32, lane, bitWidth int) uint32 {
 	// Precompute mask outside the loop to avoid repeated conditional checks
 	var mask uint64
 	if bitWidth >= 32 {
@@ -611,6 +1313,7 @@ func packLaneInterleaved(dst []byte, values []uint32, lane, bitWidth int) {
 
 	var acc uint64
 	var bitsInAcc int
+	var exceptions uint32
 	outByteIdx := lane * 4 // Start at lane's first word position
 
 	// Rough C++ equivalent (FastPFor.cpp::fastpackwithoutmask):
@@ -627,6 +1330,7 @@ func packLaneInterleaved(dst []byte, values []uint32, lane, bitWidth int) {
 		if idx < len(values) {
 			v = values[idx]
 		}
+		exceptions |= b2u(uint64(v) > mask) << i
 		acc |= (uint64(v) & mask) << bitsInAcc
 		bitsInAcc += bitWidth
 		for bitsInAcc >= 32 {
@@ -639,6 +1343,7 @@ func packLaneInterleaved(dst []byte, values []uint32, lane, bitWidth int) {
 	if bitsInAcc > 0 {
 		bo.PutUint32(dst[outByteIdx:], uint32(acc))
 	}
+	return exceptions
 }
 
 // unpackLanesScalar unpacks the values from the payload into the destination buffer using a scalar implementation.
@@ -677,6 +1382,10 @@ func unpackLaneInterleaved(dst []uint32, payload []byte, lane, bitWidth, count i
 	inByteIdx := lane * 4 // Start at lane's first word position
 
 	for i := range laneLength {
+		idx := lane + i*laneCount
+		if idx >= count {
+			break // remaining lane values are beyond count, skip their words
+		}
 		for bitsInAcc < bitWidth {
 			if inByteIdx+4 > len(payload) {
 				bitsInAcc = bitWidth // force exit
@@ -686,13 +1395,9 @@ func unpackLaneInterleaved(dst []uint32, payload []byte, lane, bitWidth, count i
 			inByteIdx += 16 // Skip to next word position for this lane (4 lanes × 4 bytes)
 			bitsInAcc += 32
 		}
-		value := uint32(acc) & mask
+		dst[idx] = uint32(acc) & mask
 		acc >>= bitWidth
 		bitsInAcc -= bitWidth
-		idx := lane + i*laneCount
-		if idx < count {
-			dst[idx] = value
-		}
 	}
 }
 
@@ -702,6 +1407,12 @@ func unpackLaneInterleaved(dst []uint32, payload []byte, lane, bitWidth, count i
 // that histogram, and only materializing the exception list for the winning
 // width.
 func selectBitWidth(values []uint32) (width int, excCount int) {
+	return selectBitWidthOptions(values, nil)
+}
+
+// selectBitWidthOptions is selectBitWidth restricted and biased by opts, which
+// may be nil for the size-minimizing default.
+func selectBitWidthOptions(values []uint32, opts *EncodeOptions) (width int, excCount int) {
 
 	/*
 	   void getBestBFromData(const IntType *in, uint8_t &bestb, uint8_t &bestcexcept,
@@ -747,6 +1458,9 @@ func selectBitWidth(values []uint32) (width int, excCount int) {
 	bestWidth := maxWidth
 	bestSize := headerBytes + payloadBytesLUT[maxWidth]
 	bestExcCount := 0
+	if opts != nil && opts.NoExceptions {
+		return bestWidth, bestExcCount
+	}
 
 	// Build cumulative "greater than" counts from the histogram
 	var greater [uint32Bits + 1]int
@@ -762,7 +1476,17 @@ func selectBitWidth(values []uint32) (width int, excCount int) {
 		if excCount == 0 {
 			continue
 		}
-		size := headerBytes + payloadBytesLUT[candidate] + patchBytesMax(excCount)
+		patchLen := patchBytesMax(excCount)
+		if opts != nil && opts.ExactSize {
+			patchLen = patchBytesExact(values, candidate, excCount)
+		}
+		size := headerBytes + payloadBytesLUT[candidate] + patchLen
+		if opts != nil {
+			if opts.MaxExceptions > 0 && excCount > opts.MaxExceptions {
+				continue
+			}
+			size += opts.ExceptionCost * excCount
+		}
 		if size < bestSize || (size == bestSize && candidate < bestWidth) {
 			bestSize = size
 			bestWidth = candidate
@@ -790,9 +1514,26 @@ func collectExceptionsDirect(values []uint32, bitWidth int, dst []byte, highBits
 	return excIdx
 }
 
+// exceptionsFromMask writes the positions set in the bitmap mask (see
+// packLanesExceptions) to dst and the high bits of their values to highBits, and
+// returns the number of exceptions. Only the actual exceptions are visited.
+func exceptionsFromMask(values []uint32, bitWidth int, mask [2]uint64, dst []byte, highBits []uint32) int {
+	excIdx := 0
+	for w, word := range mask {
+		for word != 0 {
+			i := w<<6 + bits.TrailingZeros64(word)
+			word &= word - 1
+			dst[excIdx] = byte(i)
+			highBits[excIdx] = values[i] >> bitWidth
+			excIdx++
+		}
+	}
+	return excIdx
+}
+
 // writeExceptionsDirect serializes exception positions and high bits directly.
-// It collects exceptions from values into dst (positions) and highBits buffer,
-// then encodes the high bits with StreamVByte.
+// It collects the exceptions of the bitmap mask from values into dst (positions)
+// and highBits buffer, then encodes the high bits with StreamVByte.
 // Returns the actual number of bytes written.
 // Layout:
 //
@@ -800,9 +1541,13 @@ func collectExceptionsDirect(values []uint32, bitWidth int, dst []byte, highBits
 //	dst[1:3]      : uint16 length of StreamVByte data (little-endian)
 //	dst[3:3+n]    : byte indices (lane order) of the exceptions
 //	dst[3+n:]     : StreamVByte-encoded high bits
-func writeExceptionsDirect(dst []byte, values []uint32, bitWidth int, highBits []uint32) int {
+//
+// If they are smaller, the positions are run-coded (see patchRunsFlag) or stored
+// as a bitmap (see patchBitmapFlag) instead, and the high bits are stored
+// fixed-width (see patchRawFlag).
+func writeExceptionsDirect(dst []byte, values []uint32, bitWidth int, mask [2]uint64, highBits []uint32) int {
 	// Collect exception positions to dst[3:] and high bits to highBits
-	excCount := collectExceptionsDirect(values, bitWidth, dst[3:], highBits)
+	excCount := exceptionsFromMask(values, bitWidth, mask, dst[3:], highBits)
 	if excCount == 0 {
 		return 0
 	}
@@ -810,15 +1555,44 @@ func writeExceptionsDirect(dst []byte, values []uint32, bitWidth int, highBits [
 	// Write exception count
 	dst[0] = byte(excCount)
 
-	// Encode high bits with StreamVByte
+	// Run-code the positions or store them as a bitmap if that saves space,
+	// including the length byte of the runs
 	pos := 3 + excCount
+	var flags uint16
+	var runs [blockSize]byte
+	n := encodeRuns(runs[:], dst[3:pos])
+	switch {
+	case patchBitmapBytes < min(excCount, n+1):
+		bo.PutUint64(dst[3:], mask[0])
+		bo.PutUint64(dst[11:], mask[1])
+		pos = 3 + patchBitmapBytes
+		flags = patchBitmapFlag
+	case n+1 < excCount:
+		dst[3] = byte(n)
+		copy(dst[4:], runs[:n])
+		pos = 4 + n
+		flags = patchRunsFlag
+	}
+
+	// Encode high bits with StreamVByte
 	svbData := streamvbyte.EncodeUint32(highBits[:excCount], &streamvbyte.EncodeOptions[uint32]{
 		Buffer: dst[pos:],
 	})
+	svbLen := len(svbData)
+
+	// Store the high bits fixed-width instead if that saves the control bytes
+	var orHigh uint32
+	for _, h := range highBits[:excCount] {
+		orHigh |= h
+	}
+	if width := (bits.Len32(orHigh) + 7) / 8; width*excCount < svbLen {
+		putRawHighBits(dst[pos:], highBits[:excCount], width)
+		svbLen = width * excCount
+		flags |= patchRawFlag
+	}
 
 	// Write the StreamVByte data length
-	svbLen := len(svbData)
-	bo.PutUint16(dst[1:], uint16(svbLen))
+	bo.PutUint16(dst[1:], uint16(svbLen)|flags)
 
 	return pos + svbLen
 }
@@ -826,50 +1600,195 @@ func writeExceptionsDirect(dst []byte, values []uint32, bitWidth int, highBits [
 // applyExceptions reads exception data from buf at the given offset and applies
 // them to dst by reinserting the high parts that were spilled into the exception table.
 // The scratch slice is used for StreamVByte decoding to avoid allocations.
-// Returns the total number of patch bytes consumed (1+2+excCount+svbLen) and
-// an error if the buffer is malformed.
-// Layout: count(1) + svb_len(2) + positions(N) + StreamVByte(M)
+// Returns the total number of patch bytes consumed (1+2+excCount+svbLen for plain
+// positions) and an error if the buffer is malformed.
+// Layout: count(1) + svb_len(2) + positions(N) + StreamVByte(M), see patchRunsFlag
+// for run-coded positions.
 func applyExceptions(dst []uint32, buf []byte, offset, count, bitWidth int, scratch []uint32) (int, error) {
 	if len(buf) < offset+1 {
 		return 0, fmt.Errorf("fastpfor: missing exception count byte at offset %d", offset)
 	}
 
 	patch := buf[offset:]
-	excCount := int(patch[0])
-	patch = patch[1:]
+	if len(patch) < 3 {
+		return 0, fmt.Errorf("fastpfor: missing StreamVByte length (need 2 bytes, got %d)", len(patch)-1)
+	}
+
+	l, err := readPatchLayout(patch)
+	if err != nil {
+		return 0, err
+	}
+	return applyPatch(dst, patch, l, count, bitWidth, scratch)
+}
 
+// applyPatch applies the exception area patch with layout l to dst like
+// applyExceptions, without reading the layout again.
+func applyPatch(dst []uint32, patch []byte, l patchLayout, count, bitWidth int, scratch []uint32) (int, error) {
+	excCount := l.excCount
 	if len(scratch) < excCount {
 		return 0, fmt.Errorf("fastpfor: scratch buffer too small (need %d, got %d)", excCount, len(scratch))
 	}
-	if len(patch) < 2 {
-		return 0, fmt.Errorf("fastpfor: missing StreamVByte length (need 2 bytes, got %d)", len(patch))
+	if len(patch) < l.svbOff() {
+		return 0, fmt.Errorf("fastpfor: truncated exception positions (need %d bytes, got %d)", l.posLen, len(patch)-l.posOff)
 	}
-
-	svbLen := int(bo.Uint16(patch[:2]))
-	patch = patch[2:]
-
-	if len(patch) < excCount {
-		return 0, fmt.Errorf("fastpfor: truncated exception positions (need %d bytes, got %d)", excCount, len(patch))
+	positions := patch[l.posOff:l.svbOff()]
+	var err error
+	var bitmap [2]uint64
+	if l.bitmap {
+		bitmap = readPositionBitmap(positions)
+		if n := bits.OnesCount64(bitmap[0]) + bits.OnesCount64(bitmap[1]); n != excCount {
+			return 0, fmt.Errorf("fastpfor: exception bitmap holds %d positions, want %d", n, excCount)
+		}
+		if !bitmapWithin(bitmap, count) {
+			return 0, fmt.Errorf("fastpfor: exception bitmap exceeds %d values", count)
+		}
 	}
-
-	positions := patch[:excCount]
-	patch = patch[excCount:]
+	if l.runs {
+		var runScratch [blockSize]byte
+		if positions, err = l.positions(patch, &runScratch); err != nil {
+			return 0, err
+		}
+	}
+	svbLen := l.svbLen
+	patch = patch[l.svbOff():]
 
 	if len(patch) < svbLen {
 		return 0, fmt.Errorf("fastpfor: truncated StreamVByte data (need %d bytes, got %d)", svbLen, len(patch))
 	}
+	if numControlBytes := (excCount + 3) >> 2; l.rawWidth == 0 && (svbLen < numControlBytes ||
+		svbLen < numControlBytes+svbDataLen(patch[:svbLen], excCount)) {
+		return 0, fmt.Errorf("fastpfor: StreamVByte data too short for %d exceptions (got %d bytes)", excCount, svbLen)
+	}
 
-	// Decode high bits from StreamVByte into scratch buffer (avoids allocation)
-	highBits := streamvbyte.DecodeUint32(patch[:svbLen], excCount, &streamvbyte.DecodeOptions[uint32]{
-		Buffer: scratch[:excCount],
-	})
+	// Decode high bits into scratch buffer (avoids allocation)
+	highBits := l.decodeHighBits(patch[:svbLen], scratch)
+	if l.bitmap {
+		patchBitmap(dst, bitmap, highBits, bitWidth)
+		return l.size(), nil
+	}
+	// Positions must be strictly ascending, so every value is patched at most
+	// once and in the same order as by applyExceptionsRange
+	prev := -1
 	for i, idx := range positions {
 		if int(idx) >= count {
 			return 0, fmt.Errorf("fastpfor: exception index %d out of range (max %d)", int(idx), count-1)
 		}
+		if int(idx) <= prev {
+			return 0, fmt.Errorf("fastpfor: exception positions not sorted at index %d", i)
+		}
+		prev = int(idx)
 		dst[int(idx)] |= highBits[i] << bitWidth
 	}
-	return 1 + 2 + excCount + svbLen, nil
+	return l.size(), nil
+}
+
+// patchBitmap applies the high bits of the exceptions in the position bitmap,
+// which must hold len(highBits) positions below len(dst), to dst. The bitmap is
+// consumed word by word, so there are no position bytes to load and bounds-check
+// and the loop has no data-dependent branches besides the end of each word.
+func patchBitmap(dst []uint32, bitmap [2]uint64, highBits []uint32, bitWidth int) {
+	k := 0
+	for w, word := range bitmap {
+		base := w << 6
+		for ; word != 0; word &= word - 1 {
+			dst[base+bits.TrailingZeros64(word)] |= highBits[k] << bitWidth
+			k++
+		}
+	}
+}
+
+// applyExceptionsRange applies the exceptions with positions in [start, end) to dst,
+// where dst[0] corresponds to position start. patch is the exception area of the block.
+// High bits are decoded one by one with a highBitsCursor, skipping the StreamVByte data of
+// all exceptions before the range, so the cost is proportional to the range.
+func applyExceptionsRange(dst []uint32, patch []byte, start, end, bitWidth int) error {
+	l, err := readPatchLayout(patch)
+	if err != nil {
+		return err
+	}
+	if len(patch) < l.size() {
+		return fmt.Errorf("fastpfor: truncated exception area (need %d bytes, got %d)", l.size(), len(patch))
+	}
+	excCount, svbLen := l.excCount, l.svbLen
+	if excCount == 0 {
+		return nil
+	}
+	positions := patch[l.posOff:l.svbOff()]
+	if l.runs || l.bitmap {
+		var runScratch [blockSize]byte
+		if positions, err = l.positions(patch, &runScratch); err != nil {
+			return err
+		}
+	}
+
+	// Positions are sorted ascending: find the first exception in range
+	first := 0
+	for first < excCount && int(positions[first]) < start {
+		first++
+	}
+	if first == excCount || int(positions[first]) >= end {
+		return nil
+	}
+
+	svbData := patch[l.svbOff():l.size()]
+	numControlBytes := (excCount + 3) >> 2
+	if l.rawWidth == 0 && (svbLen < numControlBytes || svbLen < numControlBytes+svbDataLen(svbData, excCount)) {
+		return fmt.Errorf("fastpfor: truncated StreamVByte data (got %d bytes)", svbLen)
+	}
+	cursor := l.highBitsCursor(svbData)
+	cursor.seekTo(first)
+	for i := first; i < excCount; i++ {
+		pos := int(positions[i])
+		if pos >= end {
+			break
+		}
+		if pos < start {
+			return fmt.Errorf("fastpfor: exception positions not sorted at index %d", i)
+		}
+		dst[pos-start] |= cursor.readCurrent() << bitWidth
+		cursor.advance()
+	}
+	return nil
+}
+
+// applyExceptionsLane applies the exceptions of one lane (positions lane, lane+4, ...)
+// from the exception area patch to dst, which holds the values of the whole block.
+func applyExceptionsLane(dst []uint32, patch []byte, lane, bitWidth int) error {
+	l, err := readPatchLayout(patch)
+	if err != nil {
+		return err
+	}
+	if len(patch) < l.size() {
+		return fmt.Errorf("fastpfor: truncated exception area (need %d bytes, got %d)", l.size(), len(patch))
+	}
+	excCount, svbLen := l.excCount, l.svbLen
+	if excCount == 0 {
+		return nil
+	}
+	positions := patch[l.posOff:l.svbOff()]
+	if l.runs || l.bitmap {
+		var runScratch [blockSize]byte
+		if positions, err = l.positions(patch, &runScratch); err != nil {
+			return err
+		}
+	}
+
+	svbData := patch[l.svbOff():l.size()]
+	numControlBytes := (excCount + 3) >> 2
+	if l.rawWidth == 0 && (svbLen < numControlBytes || svbLen < numControlBytes+svbDataLen(svbData, excCount)) {
+		return fmt.Errorf("fastpfor: truncated StreamVByte data (got %d bytes)", svbLen)
+	}
+	cursor := l.highBitsCursor(svbData)
+	for _, pos := range positions {
+		if int(pos)&3 == lane {
+			if int(pos) >= len(dst) {
+				return fmt.Errorf("fastpfor: exception position %d out of range", pos)
+			}
+			dst[pos] |= cursor.readCurrent() << bitWidth
+		}
+		cursor.advance()
+	}
+	return nil
 }
 
 // deltaEncodeScalar computes first-order deltas in-place (dst may alias src).
@@ -961,6 +1880,20 @@ func deltaDecodeWithOverflowScalar(dst, deltas []uint32, useZigZag bool) uint8 {
 	return overflowPos
 }
 
+// zigzagEncodeScalar zigzag-encodes the int32 values stored in buf in place.
+func zigzagEncodeScalar(buf []uint32) {
+	for i, v := range buf {
+		buf[i] = zigzagEncode32(int32(v))
+	}
+}
+
+// zigzagDecodeScalar decodes the zigzag codes in buf in place.
+func zigzagDecodeScalar(buf []uint32) {
+	for i, v := range buf {
+		buf[i] = uint32(zigzagDecode32(v))
+	}
+}
+
 // zigzagEncode32 encodes a 32-bit integer as a zigzag integer.
 func zigzagEncode32(v int32) uint32 {
 	return uint32(v<<1) ^ uint32(v>>31)
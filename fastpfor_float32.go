@@ -0,0 +1,78 @@
+package fastpfor
+
+import (
+	"fmt"
+	"math"
+	"unsafe"
+)
+
+// PackFloat32 encodes up to BlockSize float32 values into the FastPFOR block
+// format. Each value is reinterpreted as its IEEE 754 bit pattern and XORed with
+// the bit pattern of the previous value (Gorilla-style predictor), so slowly
+// changing series such as sensor telemetry share sign, exponent and leading
+// mantissa bits and pack into small codes. Outliers are handled by the regular
+// exception machinery. The header carries the float marker, so UnpackFloat32 can
+// restore the values bit-exactly (including NaN payloads and signed zeros).
+//
+// The input slice is not mutated; values are copied to an internal buffer.
+// UnpackUint32 decodes the block to the raw XOR codes.
+func PackFloat32(dst []byte, values []float32) []byte {
+	var buf [2 * blockSize]uint32 // scratch space for conversion + exceptions
+	for i, v := range values {
+		buf[i] = math.Float32bits(v)
+	}
+	n := len(values)
+	xorEncodeBlock(buf[:n])
+	return packInternal(dst, buf[:n], headerTypeUint32Flag|headerFloatFlag)
+}
+
+// UnpackFloat32 decodes a block produced by PackFloat32 into float32 values,
+// writing into the supplied dst slice (which will be resized as needed). The XOR
+// codes are decoded directly into dst and resolved in place.
+//
+// Returns ErrInvalidBuffer if the block does not carry the float marker.
+func UnpackFloat32(dst []float32, buf []byte) ([]float32, error) {
+	header, count, _, err := readHeader(buf)
+	if err != nil {
+		return nil, err
+	}
+	if header&headerFloatFlag == 0 {
+		return nil, fmt.Errorf("%w: block does not hold float values", ErrInvalidBuffer)
+	}
+	if count == 0 {
+		if dst == nil {
+			return nil, nil
+		}
+		return dst[:0], nil
+	}
+
+	if cap(dst) < blockSize {
+		dst = make([]float32, 0, blockSize)
+	}
+	// float32 and uint32 share size and alignment, so dst can be viewed as []uint32
+	raw := unsafe.Slice((*uint32)(unsafe.Pointer(unsafe.SliceData(dst))), cap(dst))
+	decoded, err := UnpackUint32(raw[:0], buf)
+	if err != nil {
+		return nil, err
+	}
+	xorDecodeBlock(decoded)
+	return dst[:len(decoded)], nil
+}
+
+// xorEncodeBlock replaces every value with its XOR against the previous value.
+func xorEncodeBlock(values []uint32) {
+	var prev uint32
+	for i, v := range values {
+		values[i] = v ^ prev
+		prev = v
+	}
+}
+
+// xorDecodeBlock reverses xorEncodeBlock in place (prefix XOR).
+func xorDecodeBlock(values []uint32) {
+	var prev uint32
+	for i, v := range values {
+		prev ^= v
+		values[i] = prev
+	}
+}
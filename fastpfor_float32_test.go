@@ -0,0 +1,120 @@
+package fastpfor
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// float32Bits returns the bit patterns of values, so NaNs compare bit-exactly.
+func float32Bits(values []float32) []uint32 {
+	bits := make([]uint32, len(values))
+	for i, v := range values {
+		bits[i] = math.Float32bits(v)
+	}
+	return bits
+}
+
+func TestPackFloat32(t *testing.T) {
+	assert := assert.New(t)
+
+	telemetry := make([]float32, blockSize)
+	temp := float32(21.5)
+	for i := range telemetry {
+		temp += float32(rand.IntN(3)-1) * 0.25
+		telemetry[i] = temp
+	}
+	random := make([]float32, blockSize)
+	for i := range random {
+		random[i] = rand.Float32()*2e6 - 1e6
+	}
+
+	for name, values := range map[string][]float32{
+		"small":     {1.5, 1.5, 1.75, -2, 0},
+		"telemetry": telemetry,
+		"random":    random,
+		"special": {
+			float32(math.Inf(1)), float32(math.Inf(-1)), float32(math.NaN()),
+			float32(math.Copysign(0, -1)), math.MaxFloat32, math.SmallestNonzeroFloat32,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			orig := slices.Clone(values)
+			buf := PackFloat32(nil, values)
+			assert.Equal(float32Bits(orig), float32Bits(values), "input should not be mutated")
+
+			info, err := ReadBlockInfo(buf)
+			assert.NoError(err)
+			assert.True(info.Float)
+			assert.Equal(IntTypeUint32, info.IntType)
+
+			got, err := UnpackFloat32(nil, buf)
+			assert.NoError(err)
+			assert.Equal(float32Bits(values), float32Bits(got))
+
+			// UnpackUint32 returns the XOR codes
+			codes, err := UnpackUint32(nil, buf)
+			assert.NoError(err)
+			xorDecodeBlock(codes)
+			assert.Equal(float32Bits(values), codes)
+			assert.NoError(VerifyBlock(buf))
+		})
+	}
+
+	t.Run("constantUsesZeroBits", func(t *testing.T) {
+		values := make([]float32, blockSize)
+		for i := range values {
+			values[i] = 3.25
+		}
+		buf := PackFloat32(nil, values)
+		info, err := ReadBlockInfo(buf)
+		assert.NoError(err)
+		// The first value is an exception, all XOR codes after it are zero
+		assert.Equal(0, info.BitWidth)
+		assert.True(info.Exceptions)
+		assert.Less(len(buf), MaxBlockSizeUint32()/10)
+	})
+
+	t.Run("reuseDst", func(t *testing.T) {
+		dst := make([]float32, 0, blockSize)
+		got, err := UnpackFloat32(dst, PackFloat32(nil, []float32{-7.5, 7.5}))
+		assert.NoError(err)
+		assert.Equal([]float32{-7.5, 7.5}, got)
+		assert.Same(&dst[:1][0], &got[0])
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		got, err := UnpackFloat32(nil, PackFloat32(nil, nil))
+		assert.NoError(err)
+		assert.Empty(got)
+	})
+
+	t.Run("notFloat", func(t *testing.T) {
+		_, err := UnpackFloat32(nil, PackUint32(nil, []uint32{1, 2, 3}))
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		buf := PackFloat32(nil, random)
+		_, err := UnpackFloat32(nil, buf[:len(buf)-1])
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+}
+
+func BenchmarkUnpackFloat32(b *testing.B) {
+	values := make([]float32, blockSize)
+	v := float32(100)
+	for i := range values {
+		v += float32(rand.IntN(5)-2) * 0.5
+		values[i] = v
+	}
+	buf := PackFloat32(nil, values)
+	dst := make([]float32, 0, blockSize)
+	b.ReportAllocs()
+	for range b.N {
+		dst, _ = UnpackFloat32(dst[:0], buf)
+	}
+}
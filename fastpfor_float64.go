@@ -0,0 +1,85 @@
+package fastpfor
+
+import (
+	"fmt"
+	"math"
+)
+
+// PackFloat64 encodes up to BlockSize float64 values. Each value is
+// reinterpreted as its IEEE 754 bit pattern and XORed with the bit pattern of
+// the previous value (Gorilla-style predictor). The 64-bit XOR codes are split
+// into their high and low 32-bit halves, which are packed as two consecutive
+// blocks (high halves first), each with its own bit width and exceptions: for
+// slowly changing series the high halves (sign, exponent, leading mantissa bits)
+// pack into few bits, while the noisy low halves do not widen them.
+//
+// Both halves carry the float64 marker and are regular blocks for BlockLength,
+// so a stream walker sees two blocks. UnpackFloat64 decodes the pair.
+// The input slice is not mutated.
+func PackFloat64(dst []byte, values []float64) []byte {
+	var hi, lo [2 * blockSize]uint32 // scratch space for halves + exceptions
+	var prev uint64
+	for i, v := range values {
+		bits := math.Float64bits(v)
+		code := bits ^ prev
+		prev = bits
+		hi[i] = uint32(code >> 32)
+		lo[i] = uint32(code)
+	}
+	n := len(values)
+	dst = packInternal(dst, hi[:n], headerTypeUint32Flag|headerFloat64Flag)
+	return packInternal(dst, lo[:n], headerTypeUint32Flag|headerFloat64Flag)
+}
+
+// UnpackFloat64 decodes a block pair produced by PackFloat64 into float64
+// values, writing into the supplied dst slice (which will be resized as needed).
+// It also returns the number of bytes consumed by both blocks, so a stream of
+// pairs can be walked without calling BlockLength twice.
+//
+// Returns ErrInvalidBuffer if a block does not carry the float64 marker or the
+// halves differ in length.
+func UnpackFloat64(dst []float64, buf []byte) ([]float64, int, error) {
+	var hiScratch, loScratch [blockSize]uint32
+	hi, hiLen, err := unpackFloat64Half(hiScratch[:0], buf)
+	if err != nil {
+		return nil, 0, err
+	}
+	lo, loLen, err := unpackFloat64Half(loScratch[:0], buf[hiLen:])
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(hi) != len(lo) {
+		return nil, 0, fmt.Errorf("%w: float64 halves hold %d and %d values",
+			ErrInvalidBuffer, len(hi), len(lo))
+	}
+	if len(hi) == 0 {
+		if dst == nil {
+			return nil, hiLen + loLen, nil
+		}
+		return dst[:0], hiLen + loLen, nil
+	}
+
+	if cap(dst) < len(hi) {
+		dst = make([]float64, len(hi), blockSize)
+	}
+	dst = dst[:len(hi)]
+	var prev uint64
+	for i := range hi {
+		prev ^= uint64(hi[i])<<32 | uint64(lo[i])
+		dst[i] = math.Float64frombits(prev)
+	}
+	return dst, hiLen + loLen, nil
+}
+
+// unpackFloat64Half decodes one half of a float64 block pair and returns the
+// XOR code halves and the block length.
+func unpackFloat64Half(dst []uint32, buf []byte) ([]uint32, int, error) {
+	header, _, _, err := readHeader(buf)
+	if err != nil {
+		return nil, 0, err
+	}
+	if header&headerFloat64Flag == 0 {
+		return nil, 0, fmt.Errorf("%w: block does not hold float64 values", ErrInvalidBuffer)
+	}
+	return UnpackUint32WithLength(dst, buf)
+}
@@ -0,0 +1,137 @@
+package fastpfor
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// float64Bits returns the bit patterns of values, so NaNs compare bit-exactly.
+func float64Bits(values []float64) []uint64 {
+	bits := make([]uint64, len(values))
+	for i, v := range values {
+		bits[i] = math.Float64bits(v)
+	}
+	return bits
+}
+
+func TestPackFloat64(t *testing.T) {
+	assert := assert.New(t)
+
+	telemetry := make([]float64, blockSize)
+	temp := 21.5
+	for i := range telemetry {
+		temp += float64(rand.IntN(3)-1) * 0.25
+		telemetry[i] = temp
+	}
+	random := make([]float64, blockSize)
+	for i := range random {
+		random[i] = rand.NormFloat64() * 1e9
+	}
+
+	for name, values := range map[string][]float64{
+		"small":     {1.5, 1.5, 1.75, -2, 0},
+		"telemetry": telemetry,
+		"random":    random,
+		"special": {
+			math.Inf(1), math.Inf(-1), math.NaN(), math.Copysign(0, -1),
+			math.MaxFloat64, math.SmallestNonzeroFloat64,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			orig := slices.Clone(values)
+			buf := PackFloat64(nil, values)
+			assert.Equal(float64Bits(orig), float64Bits(values), "input should not be mutated")
+
+			got, n, err := UnpackFloat64(nil, append(buf, 0xFF))
+			assert.NoError(err)
+			assert.Equal(len(buf), n)
+			assert.Equal(float64Bits(values), float64Bits(got))
+
+			// Both halves are regular blocks
+			hiLen, err := BlockLength(buf)
+			assert.NoError(err)
+			for _, half := range [][]byte{buf[:hiLen], buf[hiLen:]} {
+				info, err := ReadBlockInfo(half)
+				assert.NoError(err)
+				assert.True(info.Float64)
+				assert.Equal(len(values), info.Count)
+				assert.NoError(VerifyBlock(half))
+			}
+		})
+	}
+
+	t.Run("constantHighHalves", func(t *testing.T) {
+		values := make([]float64, blockSize)
+		for i := range values {
+			values[i] = 1000 + float64(i)*1e-9 // only low mantissa bits change
+		}
+		buf := PackFloat64(nil, values)
+		info, err := ReadBlockInfo(buf)
+		assert.NoError(err)
+		assert.Equal(0, info.BitWidth)
+	})
+
+	t.Run("reuseDst", func(t *testing.T) {
+		dst := make([]float64, 0, blockSize)
+		got, _, err := UnpackFloat64(dst, PackFloat64(nil, []float64{-7.5, 7.5}))
+		assert.NoError(err)
+		assert.Equal([]float64{-7.5, 7.5}, got)
+		assert.Same(&dst[:1][0], &got[0])
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		buf := PackFloat64(nil, nil)
+		got, n, err := UnpackFloat64(nil, buf)
+		assert.NoError(err)
+		assert.Empty(got)
+		assert.Equal(len(buf), n)
+	})
+
+	t.Run("notFloat64", func(t *testing.T) {
+		_, _, err := UnpackFloat64(nil, PackFloat32(nil, []float32{1, 2}))
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+
+	t.Run("mismatchedHalves", func(t *testing.T) {
+		buf := PackFloat64(nil, []float64{1, 2, 3})
+		hiLen, err := BlockLength(buf)
+		assert.NoError(err)
+		other := PackFloat64(nil, []float64{1, 2})
+		otherHiLen, err := BlockLength(other)
+		assert.NoError(err)
+		_, _, err = UnpackFloat64(nil, append(buf[:hiLen:hiLen], other[otherHiLen:]...))
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		buf := PackFloat64(nil, random)
+		_, _, err := UnpackFloat64(nil, buf[:len(buf)-1])
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+
+	t.Run("transcodeRejected", func(t *testing.T) {
+		_, err := TranscodeMap(nil, PackFloat64(nil, []float64{1}), func(v uint32) uint32 { return v })
+		assert.ErrorIs(err, ErrInvalidFlags)
+		_, err = SortBlock(PackFloat64(nil, []float64{1}))
+		assert.ErrorIs(err, ErrInvalidFlags)
+	})
+}
+
+func BenchmarkUnpackFloat64(b *testing.B) {
+	values := make([]float64, blockSize)
+	v := 100.0
+	for i := range values {
+		v += float64(rand.IntN(5)-2) * 0.5
+		values[i] = v
+	}
+	buf := PackFloat64(nil, values)
+	dst := make([]float64, 0, blockSize)
+	b.ReportAllocs()
+	for range b.N {
+		dst, _, _ = UnpackFloat64(dst[:0], buf)
+	}
+}
@@ -0,0 +1,56 @@
+package fastpfor
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// PackInt32 encodes up to BlockSize int32 values into the FastPFOR block format.
+// The values are zigzag-encoded before bit-packing, so small negative values need
+// as few bits as small positive ones. The header carries the signed marker, so
+// UnpackInt32 can restore the signed values.
+//
+// The input slice is not mutated; values are copied to an internal buffer.
+// UnpackUint32 decodes the block to the raw zigzag codes.
+func PackInt32(dst []byte, values []int32) []byte {
+	var buf [2 * blockSize]uint32 // scratch space for conversion + exceptions
+	for i, v := range values {
+		buf[i] = uint32(v)
+	}
+	n := len(values)
+	zigzagEncodeBlock(buf[:n])
+	return packInternal(dst, buf[:n], headerTypeUint32Flag|headerSignedFlag)
+}
+
+// UnpackInt32 decodes a block produced by PackInt32 into int32 values, writing
+// into the supplied dst slice (which will be resized as needed). The values are
+// decoded directly into dst and zigzag-decoded in place.
+//
+// Returns ErrInvalidBuffer if the block does not carry the signed marker.
+func UnpackInt32(dst []int32, buf []byte) ([]int32, error) {
+	header, count, _, err := readHeader(buf)
+	if err != nil {
+		return nil, err
+	}
+	if header&headerSignedFlag == 0 {
+		return nil, fmt.Errorf("%w: block does not hold signed values", ErrInvalidBuffer)
+	}
+	if count == 0 {
+		if dst == nil {
+			return nil, nil
+		}
+		return dst[:0], nil
+	}
+
+	if cap(dst) < blockSize {
+		dst = make([]int32, 0, blockSize)
+	}
+	// int32 and uint32 share size and alignment, so dst can be viewed as []uint32
+	raw := unsafe.Slice((*uint32)(unsafe.Pointer(unsafe.SliceData(dst))), cap(dst))
+	decoded, err := UnpackUint32(raw[:0], buf)
+	if err != nil {
+		return nil, err
+	}
+	zigzagDecodeBlock(decoded)
+	return dst[:len(decoded)], nil
+}
@@ -0,0 +1,102 @@
+package fastpfor
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPackInt32(t *testing.T) {
+	assert := assert.New(t)
+
+	random := make([]int32, blockSize)
+	for i := range random {
+		random[i] = rand.Int32N(2000) - 1000
+	}
+	outliers := make([]int32, blockSize)
+	for i := range outliers {
+		outliers[i] = int32(i%8) - 4
+	}
+	outliers[5], outliers[90] = math.MinInt32, math.MaxInt32
+
+	for name, values := range map[string][]int32{
+		"small":    {-1, 0, 1, -2, 2},
+		"random":   random,
+		"outliers": outliers,
+		"extremes": {math.MinInt32, math.MaxInt32, 0, -1},
+	} {
+		t.Run(name, func(t *testing.T) {
+			orig := slices.Clone(values)
+			buf := PackInt32(nil, values)
+			assert.Equal(orig, values, "input should not be mutated")
+
+			info, err := ReadBlockInfo(buf)
+			assert.NoError(err)
+			assert.True(info.Signed)
+			assert.Equal(IntTypeUint32, info.IntType)
+
+			got, err := UnpackInt32(nil, buf)
+			assert.NoError(err)
+			assert.Equal(values, got)
+
+			// UnpackUint32 returns the zigzag codes
+			codes, err := UnpackUint32(nil, buf)
+			assert.NoError(err)
+			for i, v := range values {
+				assert.Equal(zigzagEncode32(v), codes[i])
+			}
+			assert.NoError(VerifyBlock(buf))
+		})
+	}
+
+	t.Run("smallMagnitudeUsesFewBits", func(t *testing.T) {
+		values := make([]int32, blockSize)
+		for i := range values {
+			values[i] = int32(i%16) - 8 // -8..7, zigzag codes fit into 4 bits
+		}
+		info, err := ReadBlockInfo(PackInt32(nil, values))
+		assert.NoError(err)
+		assert.Equal(4, info.BitWidth)
+	})
+
+	t.Run("reuseDst", func(t *testing.T) {
+		dst := make([]int32, 0, blockSize)
+		got, err := UnpackInt32(dst, PackInt32(nil, []int32{-7, 7}))
+		assert.NoError(err)
+		assert.Equal([]int32{-7, 7}, got)
+		assert.Same(&dst[:1][0], &got[0])
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		got, err := UnpackInt32(nil, PackInt32(nil, nil))
+		assert.NoError(err)
+		assert.Empty(got)
+	})
+
+	t.Run("notSigned", func(t *testing.T) {
+		_, err := UnpackInt32(nil, PackUint32(nil, []uint32{1, 2, 3}))
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		buf := PackInt32(nil, random)
+		_, err := UnpackInt32(nil, buf[:len(buf)-1])
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+}
+
+func BenchmarkUnpackInt32(b *testing.B) {
+	values := make([]int32, blockSize)
+	for i := range values {
+		values[i] = rand.Int32N(4096) - 2048
+	}
+	buf := PackInt32(nil, values)
+	dst := make([]int32, 0, blockSize)
+	b.ReportAllocs()
+	for range b.N {
+		dst, _ = UnpackInt32(dst[:0], buf)
+	}
+}
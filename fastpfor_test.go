@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/rand"
 	"slices"
+	"sync"
 	"testing"
 
 	"github.com/mhr3/streamvbyte"
@@ -41,6 +42,27 @@ func TestBlockLength(t *testing.T) {
 		assert.Equal(len(buf), got)
 	})
 
+	t.Run("concatenated", func(t *testing.T) {
+		withRange, err := SetBlockRange(nil, PackUint32(nil, genMixed(blockSize)))
+		assert.NoError(err)
+		blocks := [][]byte{
+			PackUint32(nil, genDataWithLargeExceptions()),
+			PackDeltaUint32(nil, genMonotonic(77)),
+			PackFORUint32(nil, []uint32{9, 9}),
+			PackUint32(nil, nil),
+			PackUint32(nil, genScatteredExceptions(blockSize)),
+			withRange,
+		}
+		stream := slices.Concat(blocks...)
+		for i, block := range blocks {
+			n, err := BlockLength(stream)
+			assert.NoError(err)
+			assert.Equal(block, stream[:n], "block %d", i)
+			stream = stream[n:]
+		}
+		assert.Empty(stream)
+	})
+
 	t.Run("truncatedHeader", func(t *testing.T) {
 		_, err := BlockLength([]byte{0x00, 0x01})
 		assert.Error(err)
@@ -59,6 +81,229 @@ func TestBlockLength(t *testing.T) {
 	})
 }
 
+// TestBlockLengthHeaderForms verifies that BlockLength is the exact length of
+// blocks of every header form, with and without the optional records.
+func TestBlockLengthHeaderForms(t *testing.T) {
+	assert := assert.New(t)
+
+	blocks := map[string][]byte{
+		"empty":         PackUint32(nil, nil),
+		"plain":         PackUint32(nil, genSequential(blockSize)),
+		"exceptions":    PackUint32(nil, genDataWithSmallExceptions()),
+		"rawHighBits":   PackUint32(nil, genDataWithLargeExceptions()),
+		"exceptionRuns": PackUint32(nil, genBurstyExceptions()),
+		"bitmap":        PackUint32(nil, genScatteredExceptions(blockSize)),
+		"delta":         PackDeltaUint32(nil, genMixed(blockSize)),
+		"deltaD4":       PackDeltaUint32Mode(nil, genMonotonic(blockSize), DeltaD4),
+		"uint16":        PackUint16(nil, []uint16{1, 65535, 3}),
+		"int32":         PackInt32(nil, []int32{-5, 7, -1 << 30}),
+		"float32":       PackFloat32(nil, []float32{1.5, -2.25, 3}),
+		"wide":          packInternal(nil, genDataWithSmallExceptions(), headerTypeUint32Flag|headerWideFlag),
+		"frameOfRef":    PackFORUint32(nil, []uint32{1000, 1003, 1 << 30}),
+		"constant":      PackFORUint32(nil, []uint32{9, 9, 9}),
+		"partial":       PackUint32(nil, genMixed(77)),
+	}
+	// Range records require unsigned values
+	noRange := map[string]bool{"int32": true, "float32": true}
+	records := map[string]func(buf []byte) ([]byte, error){
+		"none":     func(buf []byte) ([]byte, error) { return buf, nil },
+		"range":    func(buf []byte) ([]byte, error) { return SetBlockRange(nil, buf) },
+		"checksum": func(buf []byte) ([]byte, error) { return SetBlockChecksum(nil, buf) },
+		"provenance": func(buf []byte) ([]byte, error) {
+			return SetProvenance(nil, buf, Provenance{WriterID: 7, SourceOffset: 1 << 40})
+		},
+		"all": func(buf []byte) ([]byte, error) {
+			buf, err := SetBlockRange(nil, buf)
+			if err == nil {
+				buf, err = SetBlockChecksum(nil, buf)
+			}
+			if err == nil {
+				buf, err = SetProvenance(nil, buf, Provenance{WriterID: 7})
+			}
+			return buf, err
+		},
+	}
+	for name, block := range blocks {
+		for record, set := range records {
+			if noRange[name] && (record == "range" || record == "all") {
+				continue
+			}
+			buf, err := set(block)
+			assert.NoError(err, "%s/%s", name, record)
+			n, err := BlockLength(buf)
+			assert.NoError(err, "%s/%s", name, record)
+			assert.Equal(len(buf), n, "%s/%s", name, record)
+			// Trailing bytes of the next block are not counted
+			n, err = BlockLength(append(slices.Clip(buf), block...))
+			assert.NoError(err, "%s/%s", name, record)
+			assert.Equal(len(buf), n, "%s/%s", name, record)
+		}
+	}
+}
+
+// TestValidate verifies that Validate accepts all block kinds and rejects
+// truncated, padded and inconsistent blocks.
+func TestValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	withChecksum, err := SetBlockChecksum(nil, PackUint32(nil, genDataWithLargeExceptions()))
+	assert.NoError(err)
+	blocks := map[string][]byte{
+		"empty":      PackUint32(nil, nil),
+		"plain":      PackUint32(nil, genSequential(blockSize)),
+		"delta":      PackDeltaUint32(nil, genMixed(blockSize)),
+		"exceptions": PackUint32(nil, genDataWithLargeExceptions()),
+		"bitmap":     PackUint32(nil, genScatteredExceptions(blockSize)),
+		"frame":      PackFORUint32(nil, genMixed(77)),
+		"constant":   PackFORUint32(nil, []uint32{7, 7, 7}),
+		"checksum":   withChecksum,
+	}
+	for name, buf := range blocks {
+		assert.NoError(Validate(buf), name)
+		for cut := range len(buf) {
+			assert.ErrorIs(Validate(buf[:cut]), ErrInvalidBuffer, "%s cut %d", name, cut)
+		}
+		assert.ErrorIs(Validate(append(slices.Clip(buf), 0)), ErrInvalidBuffer, name)
+	}
+
+	// Descending exception positions
+	values := make([]uint32, blockSize)
+	values[5], values[50], values[100] = 1<<30, 1<<29, 1<<28
+	buf := PackUint32(nil, values)
+	patch := headerBytes + payloadBytes(getBitWidth(buf))
+	buf[patch+patchMetaBytes], buf[patch+patchMetaBytes+1] = buf[patch+patchMetaBytes+1], buf[patch+patchMetaBytes]
+	assert.ErrorIs(Validate(buf), ErrInvalidBuffer)
+	_, err = UnpackUint32(nil, buf)
+	assert.Error(err)
+}
+
+// TestSplitEncoded verifies blocks are split into header, payload and patch.
+func TestSplitEncoded(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, tt := range []struct {
+		name  string
+		buf   []byte
+		extra int // header extension bytes
+	}{
+		{"empty", PackUint32(nil, nil), 0},
+		{"noExceptions", PackUint32(nil, genSequential(blockSize)), 0},
+		{"withExceptions", PackUint32(nil, genDataWithSmallExceptions()), 0},
+		{"delta", PackDeltaUint32(nil, genMonotonic(blockSize)), 0},
+		{"wide", packInternal(nil, genDataWithSmallExceptions(), headerTypeUint32Flag|headerWideFlag), headerWideBytes},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			header, payload, patch, err := SplitEncoded(tt.buf)
+			assert.NoError(err)
+			assert.Equal(bo.Uint32(tt.buf), header)
+			_, bitWidth, _, hasExceptions, _, _, _ := decodeHeader(header)
+			assert.Len(payload, payloadBytes(bitWidth))
+			assert.Equal(hasExceptions, patch != nil)
+
+			// Reassembling the pieces restores the block
+			joined := bo.AppendUint32(nil, header)
+			joined = append(joined, tt.buf[headerBytes:headerBytes+tt.extra]...)
+			joined = append(joined, payload...)
+			joined = append(joined, patch...)
+			assert.Equal(tt.buf, joined)
+		})
+	}
+
+	t.Run("trailingBytes", func(t *testing.T) {
+		buf := PackUint32(nil, genDataWithSmallExceptions())
+		n := len(buf)
+		buf = append(buf, 0xFF, 0xFF)
+		_, payload, patch, err := SplitEncoded(buf)
+		assert.NoError(err)
+		assert.Equal(n, headerBytes+len(payload)+len(patch))
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		buf := PackUint32(nil, genDataWithSmallExceptions())
+		_, _, _, err := SplitEncoded(buf[:len(buf)-1])
+		assert.ErrorIs(err, ErrInvalidBuffer)
+		_, _, _, err = SplitEncoded(buf[:2])
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+}
+
+// TestAssembleEncoded verifies AssembleEncoded restores split blocks and validates the pieces.
+func TestAssembleEncoded(t *testing.T) {
+	assert := assert.New(t)
+
+	for name, buf := range map[string][]byte{
+		"empty":          PackUint32(nil, nil),
+		"noExceptions":   PackUint32(nil, genSequential(blockSize)),
+		"withExceptions": PackUint32(nil, genDataWithSmallExceptions()),
+		"large":          PackUint32(nil, genDataWithLargeExceptions()),
+		"delta":          PackDeltaUint32(nil, genMonotonic(77)),
+		"wide":           packInternal(nil, genDataWithSmallExceptions(), headerTypeUint32Flag|headerWideFlag),
+	} {
+		t.Run(name, func(t *testing.T) {
+			header, payload, patch, err := SplitEncoded(buf)
+			assert.NoError(err)
+			got, err := AssembleEncoded(header, payload, patch)
+			assert.NoError(err)
+			assert.Equal(buf, got)
+		})
+	}
+
+	// High bits of different byte lengths, so they are stored with StreamVByte
+	values := genSequential(blockSize)
+	for i := range 8 {
+		values[i*16+3] = 1<<(24+i) | uint32(i)
+	}
+	header, payload, patch, err := SplitEncoded(PackUint32(nil, values))
+	assert.NoError(err)
+	assert.NotEmpty(payload)
+	assert.NotNil(patch)
+
+	t.Run("payloadLength", func(t *testing.T) {
+		_, err := AssembleEncoded(header, payload[:len(payload)-1], patch)
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+
+	t.Run("missingPatch", func(t *testing.T) {
+		_, err := AssembleEncoded(header, payload, nil)
+		assert.ErrorIs(err, ErrInvalidBuffer)
+		_, err = AssembleEncoded(header&^headerExceptionFlag, payload, patch)
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+
+	t.Run("invalidCount", func(t *testing.T) {
+		_, err := AssembleEncoded(header|0xFF, payload, patch)
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+
+	t.Run("invalidBitWidth", func(t *testing.T) {
+		h := header&^(headerWidthMask<<headerWidthShift) | 33<<headerWidthShift
+		_, err := AssembleEncoded(h, payload, patch)
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+
+	t.Run("corruptPatch", func(t *testing.T) {
+		excCount := int(patch[0])
+		for name, mutate := range map[string]func(p []byte) []byte{
+			"truncated":     func(p []byte) []byte { return p[:len(p)-1] },
+			"trailing":      func(p []byte) []byte { return append(p, 0) },
+			"tooShort":      func(p []byte) []byte { return p[:2] },
+			"zeroCount":     func(p []byte) []byte { p[0] = 0; return p },
+			"unsorted":      func(p []byte) []byte { p[3], p[4] = p[4], p[3]; return p },
+			"positionRange": func(p []byte) []byte { p[2+excCount] = blockSize; return p },
+			"svbLength": func(p []byte) []byte {
+				p[3+excCount] ^= 0x03 // change the byte length of the first exception
+				return p
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				p := mutate(slices.Clone(patch))
+				_, err := AssembleEncoded(header, payload, p)
+				assert.ErrorIs(err, ErrInvalidBuffer)
+			})
+		}
+	})
+}
+
 // TestIntTypeConstants verifies the integer type constants have expected values.
 func TestIntTypeConstants(t *testing.T) {
 	assert := assert.New(t)
@@ -89,6 +334,109 @@ func TestEncodeDecodeHeader(t *testing.T) {
 	assert.False(willOverflow, "expected no will-overflow flag")
 }
 
+// TestReservedHeaderBits verifies that reserved header bits are rejected
+// unless relaxed header checking is enabled.
+func TestReservedHeaderBits(t *testing.T) {
+	assert := assert.New(t)
+	t.Cleanup(func() { SetRelaxedHeaders(false) })
+
+	values := genDataWithSmallExceptions()
+	for _, bit := range []int{16, 24, 25} {
+		buf := PackUint32(nil, values)
+		bo.PutUint32(buf, bo.Uint32(buf)|1<<bit)
+
+		_, err := UnpackUint32(nil, buf)
+		assert.ErrorIs(err, ErrUnsupportedFeature, "bit %d", bit)
+		_, err = BlockLength(buf)
+		assert.ErrorIs(err, ErrUnsupportedFeature, "bit %d", bit)
+		assert.ErrorIs(NewReader().Load(buf), ErrUnsupportedFeature, "bit %d", bit)
+		assert.ErrorIs(NewSlimReader().Load(buf), ErrUnsupportedFeature, "bit %d", bit)
+
+		SetRelaxedHeaders(true)
+		got, err := UnpackUint32(nil, buf)
+		assert.NoError(err, "bit %d", bit)
+		assert.Equal(values, got, "bit %d", bit)
+		SetRelaxedHeaders(false)
+	}
+
+	// Known flags are not affected
+	for _, buf := range [][]byte{
+		PackDeltaUint32(nil, genMixed(blockSize)),
+		PackAlreadyDeltaUint32(nil, []uint32{0xFFFFFFFF, 1}),
+		packInternal(nil, genSequential(blockSize), headerTypeUint32Flag|headerWideFlag),
+	} {
+		_, err := BlockLength(buf)
+		assert.NoError(err)
+	}
+}
+
+// TestWideHeader verifies the 8-byte wide header form round-trips through all decoders.
+func TestWideHeader(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, src := range [][]uint32{
+		{},
+		{7},
+		genSequential(blockSize),
+		genDataWithSmallExceptions(),
+	} {
+		buf := packInternal(nil, slices.Clone(src), headerTypeUint32Flag|headerWideFlag)
+		header := bo.Uint32(buf[:headerBytes])
+		assert.NotZero(header & headerWideFlag)
+		ext := bo.Uint32(buf[headerBytes:])
+		assert.Equal(uint32(len(src)), ext&headerWideCountMask)
+		assert.Equal(uint32(codecFastPFOR), ext>>headerWideCodecShift)
+
+		n, err := BlockLength(buf)
+		assert.NoError(err)
+		assert.Equal(len(buf), n)
+
+		got, consumed, err := UnpackUint32WithLength(nil, buf)
+		assert.NoError(err)
+		assert.Equal(len(buf), consumed)
+		assert.Equal(len(src), len(got))
+		if len(src) > 0 {
+			assert.Equal(src, got)
+		}
+
+		reader := NewReader()
+		assert.NoError(reader.Load(buf))
+		assert.Equal(len(src), reader.Len())
+
+		slim := NewSlimReader()
+		assert.NoError(slim.Load(buf))
+		for i, want := range src {
+			v, err := slim.Get(i)
+			assert.NoError(err)
+			assert.Equal(want, v)
+		}
+
+		e, err := Explain(buf)
+		assert.NoError(err)
+		assert.Equal(headerBytes+headerWideBytes, e.HeaderBytes)
+	}
+
+	t.Run("truncatedExtension", func(t *testing.T) {
+		buf := packInternal(nil, []uint32{1, 2, 3}, headerTypeUint32Flag|headerWideFlag)
+		_, err := BlockLength(buf[:headerBytes+3])
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+
+	t.Run("unknownCodec", func(t *testing.T) {
+		buf := packInternal(nil, []uint32{1, 2, 3}, headerTypeUint32Flag|headerWideFlag)
+		buf[headerBytes+3] = 1
+		_, err := UnpackUint32(nil, buf)
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+
+	t.Run("countExceedsBlockSize", func(t *testing.T) {
+		buf := packInternal(nil, []uint32{1, 2, 3}, headerTypeUint32Flag|headerWideFlag)
+		bo.PutUint32(buf[headerBytes:], 1<<20)
+		_, err := UnpackUint32(nil, buf)
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+}
+
 // TestPackUint32HasCorrectIntType verifies PackUint32 uses IntTypeUint32 in header.
 func TestPackUint32HasCorrectIntType(t *testing.T) {
 	assert := assert.New(t)
@@ -109,6 +457,30 @@ func TestPackLengthValidation(t *testing.T) {
 	assert.NotNil(buf, "should still produce output even with oversized input")
 }
 
+// TestTryPack verifies that the error-returning variants reject oversized input
+// and otherwise match PackUint32 and PackDeltaUint32.
+func TestTryPack(t *testing.T) {
+	assert := assert.New(t)
+
+	dst := []byte{0xAA}
+	for _, n := range []int{blockSize + 1, 10 * blockSize} {
+		buf, err := TryPackUint32(dst, make([]uint32, n))
+		assert.ErrorIs(err, ErrInvalidBlockLength)
+		assert.Equal(dst, buf)
+		buf, err = TryPackDeltaUint32(dst, make([]uint32, n))
+		assert.ErrorIs(err, ErrInvalidBlockLength)
+		assert.Equal(dst, buf)
+	}
+
+	values := genMixed(blockSize)
+	buf, err := TryPackUint32(nil, values)
+	assert.NoError(err)
+	assert.Equal(PackUint32(nil, values), buf)
+	buf, err = TryPackDeltaUint32(nil, values)
+	assert.NoError(err)
+	assert.Equal(PackDeltaUint32(nil, values), buf)
+}
+
 // TestPackUnpackEmpty verifies we can round-trip an empty slice.
 func TestPackUnpackEmpty(t *testing.T) {
 	assertRoundTrip(t, nil)
@@ -745,6 +1117,44 @@ func TestSIMDPackDirectly(t *testing.T) {
 	}
 }
 
+// TestPackLanesExceptions verifies that packing with exception detection writes
+// the payload of packLanesScalar and the exceptions of collectExceptionsDirect.
+func TestPackLanesExceptions(t *testing.T) {
+	assert := assert.New(t)
+	inputs := [][]uint32{
+		genDataWithSmallExceptions(),
+		genDataWithLargeExceptions(),
+		genMixed(blockSize),
+		genMixed(77),
+		genSequential(3),
+		{0x80000000, 0x7FFFFFFF, 0xFFFFFFFF, 0, 1},
+	}
+	for name, pack := range map[string]func([]byte, []uint32, int) [2]uint64{
+		"scalar":    packLanesExceptionsScalar,
+		"installed": packLanesExceptions,
+	} {
+		for _, values := range inputs {
+			for width := 0; width <= 32; width++ {
+				want := make([]byte, payloadBytes(width))
+				packLanesScalar(want, values, width)
+				var wantPos [blockSize]byte
+				var wantHigh [blockSize]uint32
+				n := collectExceptionsDirect(values, width, wantPos[:], wantHigh[:])
+
+				payload := make([]byte, payloadBytes(width))
+				mask := pack(payload, slices.Clone(values), width)
+				assert.Equal(want, payload, "%s len=%d width=%d payload", name, len(values), width)
+				var pos [blockSize]byte
+				var high [blockSize]uint32
+				assert.Equal(n, exceptionsFromMask(values, width, mask, pos[:], high[:]),
+					"%s len=%d width=%d", name, len(values), width)
+				assert.Equal(wantPos[:n], pos[:n], "%s len=%d width=%d positions", name, len(values), width)
+				assert.Equal(wantHigh[:n], high[:n], "%s len=%d width=%d high bits", name, len(values), width)
+			}
+		}
+	}
+}
+
 // TestPackUnpackLanesScalar covers the scalar lane helpers regardless of SIMD availability.
 func TestPackUnpackLanesScalar(t *testing.T) {
 	t.Run("zeroWidthNoop", func(t *testing.T) {
@@ -820,6 +1230,55 @@ func TestApplyExceptionsBehavior(t *testing.T) {
 		assert.Error(err)
 		assert.Contains(err.Error(), "missing exception count byte")
 	})
+
+	for name, positions := range map[string][]byte{
+		"errorOnUnsorted":  {3, 1},
+		"errorOnDuplicate": {2, 2},
+	} {
+		t.Run(name, func(t *testing.T) {
+			dst := make([]uint32, 4)
+			scratch := make([]uint32, blockSize)
+			buf := buildExceptionBuf(positions, []uint32{1, 2})
+			_, err := applyExceptions(dst, buf, 0, len(dst), 5, scratch)
+			assert.Error(err)
+			assert.Contains(err.Error(), "exception positions not sorted at index 1")
+		})
+	}
+}
+
+// TestConcurrentDecodeDeterministic verifies that concurrent decodes of the same
+// buffer with exceptions, delta and zigzag coding are bitwise identical.
+func TestConcurrentDecodeDeterministic(t *testing.T) {
+	assert := assert.New(t)
+	values := make([]uint32, blockSize)
+	for i := range values {
+		values[i] = uint32(1000 - i*i%97)
+	}
+	values[5] = 1 << 30
+	values[77] = 1<<31 + 3
+	buf := PackDeltaUint32(nil, slices.Clone(values))
+	header := binary.LittleEndian.Uint32(buf)
+	assert.NotZero(header&headerExceptionFlag, "expected exceptions")
+
+	results := make([][]uint32, 8)
+	var wg sync.WaitGroup
+	for g := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				dst, err := UnpackUint32(results[g], buf)
+				if err != nil {
+					return
+				}
+				results[g] = dst
+			}
+		}()
+	}
+	wg.Wait()
+	for _, got := range results {
+		assert.Equal(values, got)
+	}
 }
 
 // TestUnpackUint32WithBuffer validates the caller-provided scratch buffer path.
@@ -1100,6 +1559,118 @@ func TestWithLengthDeltaEncoded(t *testing.T) {
 	})
 }
 
+// TestUnpackFirstN verifies prefix decoding against a full decode.
+func TestUnpackFirstN(t *testing.T) {
+	assert := assert.New(t)
+
+	zigzagExc := make([]uint32, blockSize)
+	for i := range zigzagExc {
+		zigzagExc[i] = uint32(5000 + (i%7)*13 - (i%3)*29)
+		if i%19 == 4 {
+			zigzagExc[i] += 1 << 25
+		}
+	}
+	blocks := map[string][]byte{
+		"sequential":      PackUint32(nil, genSequential(blockSize)),
+		"smallExceptions": PackUint32(nil, genDataWithSmallExceptions()),
+		"largeExceptions": PackUint32(nil, genDataWithLargeExceptions()),
+		"width32":         PackUint32(nil, genValuesForBitWidth(32)),
+		"partial":         PackUint32(nil, genMixed(50)),
+		"delta":           PackDeltaUint32(nil, genMonotonic(blockSize)),
+		"deltaZigZagExc":  PackDeltaUint32(nil, zigzagExc),
+		"uint16":          PackUint16(nil, []uint16{1, 65535, 3, 4, 5}),
+	}
+	for name, buf := range blocks {
+		t.Run(name, func(t *testing.T) {
+			want, err := UnpackUint32(nil, buf)
+			assert.NoError(err)
+			for n := 0; n <= blockSize+1; n++ {
+				got, err := UnpackFirstN(nil, buf, n)
+				assert.NoError(err)
+				k := min(n, len(want))
+				if k == 0 {
+					assert.Empty(got)
+					continue
+				}
+				assert.Equal(want[:k], got, "n=%d", n)
+			}
+		})
+	}
+
+	t.Run("reusesDst", func(t *testing.T) {
+		dst := make([]uint32, 0, blockSize)
+		got, err := UnpackFirstN(dst, blocks["smallExceptions"], 10)
+		assert.NoError(err)
+		assert.Len(got, 10)
+		assert.Same(&dst[:1][0], &got[0])
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		_, err := UnpackFirstN(nil, blocks["sequential"], -1)
+		assert.ErrorIs(err, ErrInvalidBlockLength)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		buf := blocks["largeExceptions"]
+		_, err := UnpackFirstN(nil, buf[:len(buf)-1], 10)
+		assert.ErrorIs(err, ErrInvalidBuffer)
+		_, err = UnpackFirstN(nil, buf[:headerBytes+1], 1)
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		buf := PackAlreadyDeltaUint32(nil, []uint32{1, 2, 0xFFFFFFFF, 1})
+		got, err := UnpackFirstN(nil, buf, 2)
+		assert.NoError(err)
+		assert.Equal([]uint32{1, 3}, got)
+
+		_, err = UnpackFirstN(nil, buf, 3)
+		var overflow *ErrOverflow
+		assert.True(errors.As(err, &overflow))
+		assert.Equal(uint8(2), overflow.Position)
+	})
+}
+
+// TestGetAt verifies constant-time point lookups against full decoding.
+func TestGetAt(t *testing.T) {
+	assert := assert.New(t)
+
+	for name, buf := range map[string][]byte{
+		"sequential":      PackUint32(nil, genSequential(blockSize)),
+		"smallExceptions": PackUint32(nil, genDataWithSmallExceptions()),
+		"largeExceptions": PackUint32(nil, genDataWithLargeExceptions()),
+		"width32":         PackUint32(nil, genValuesForBitWidth(32)),
+		"partial":         PackUint32(nil, genMixed(50)),
+		"zeros":           PackUint32(nil, make([]uint32, 17)),
+		"uint16":          PackUint16(nil, []uint16{1, 65535, 3, 4, 5}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			want, err := UnpackUint32(nil, buf)
+			assert.NoError(err)
+			for pos, v := range want {
+				got, err := GetAt(buf, pos)
+				assert.NoError(err)
+				assert.Equal(v, got, "pos=%d", pos)
+			}
+			_, err = GetAt(buf, len(want))
+			assert.ErrorIs(err, ErrPositionOutOfRange)
+			_, err = GetAt(buf, -1)
+			assert.ErrorIs(err, ErrPositionOutOfRange)
+		})
+	}
+
+	t.Run("delta", func(t *testing.T) {
+		_, err := GetAt(PackDeltaUint32(nil, genMonotonic(10)), 3)
+		assert.ErrorIs(err, ErrInvalidFlags)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		buf := PackUint32(nil, genDataWithLargeExceptions())
+		_, err := GetAt(buf[:len(buf)-1], 0)
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+}
+
 // TestWithLengthMultiBlock demonstrates iterating over consecutive blocks
 // using the WithLength variants (the primary use case from Issue #1).
 func TestWithLengthMultiBlock(t *testing.T) {
@@ -1975,12 +2546,10 @@ func BenchmarkUnpackUint32(b *testing.B) {
 }
 
 func BenchmarkPackDeltaUint32(b *testing.B) {
-	source := genMonotonic(blockSize)
-	data := make([]uint32, blockSize)
+	data := genMonotonic(blockSize)
 	dst := make([]byte, 0, headerBytes+payloadBytes(16))
 	b.ReportAllocs()
 	for range b.N {
-		copy(data, source)
 		dst = PackDeltaUint32(dst[:0], data)
 	}
 	resultBytes = dst
@@ -1998,12 +2567,10 @@ func BenchmarkUnpackDeltaUint32(b *testing.B) {
 }
 
 func BenchmarkPackDeltaMixed(b *testing.B) {
-	source := genMixed(blockSize)
-	data := make([]uint32, blockSize, 2*blockSize) // cap >= 256 for zero-alloc
+	data := genMixed(blockSize)
 	dst := make([]byte, 0, headerBytes+payloadBytes(16))
 	b.ReportAllocs()
 	for range b.N {
-		copy(data, source)
 		dst = PackDeltaUint32(dst[:0], data)
 	}
 	resultBytes = dst
@@ -2064,6 +2631,29 @@ func BenchmarkUnpackWithLargeExceptions(b *testing.B) {
 	resultU32 = dst
 }
 
+// BenchmarkUnpackFirstN measures prefix decoding against a full decode.
+func BenchmarkUnpackFirstN(b *testing.B) {
+	buf := PackDeltaUint32(nil, genMonotonic(blockSize))
+	for _, n := range []int{4, 32, blockSize} {
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) {
+			dst := make([]uint32, 0, blockSize)
+			b.ReportAllocs()
+			for range b.N {
+				dst, _ = UnpackFirstN(dst[:0], buf, n)
+			}
+			resultU32 = dst
+		})
+	}
+}
+
+func BenchmarkGetAt(b *testing.B) {
+	buf := PackUint32(nil, genDataWithSmallExceptions())
+	b.ReportAllocs()
+	for i := range b.N {
+		_, _ = GetAt(buf, i&(blockSize-1))
+	}
+}
+
 // BenchmarkUnpackStackVsHeapBuffer compares stack allocation vs heap buffer reuse.
 func BenchmarkUnpackStackVsHeapBuffer(b *testing.B) {
 	b.Run("WithExceptions", func(b *testing.B) {
@@ -2334,7 +2924,16 @@ func blockLengthInlineForBench(buf []byte) (int, error) {
 		return 0, ErrInvalidBuffer
 	}
 	svbLen := int(bo.Uint16(buf[payloadEnd+1 : payloadEnd+3]))
-	return payloadEnd + 1 + 2 + excCount + svbLen, nil
+	if svbLen&patchRunsFlag != 0 {
+		if len(buf) < minExcMeta+1 {
+			return 0, ErrInvalidBuffer
+		}
+		return payloadEnd + 1 + 2 + 1 + int(buf[minExcMeta]) + svbLen&patchSVBLenMask, nil
+	}
+	if svbLen&patchBitmapFlag != 0 {
+		return payloadEnd + 1 + 2 + patchBitmapBytes + svbLen&patchSVBLenMask, nil
+	}
+	return payloadEnd + 1 + 2 + excCount + svbLen&patchSVBLenMask, nil
 }
 
 // BenchmarkBlockLengthHelperOverhead compares BlockLength against an inlined
@@ -2544,9 +3143,9 @@ func assertRoundTrip(t *testing.T, src []uint32) []byte {
 // Check for a roundtrip pack delta <-> unpack delta to ensure, data is equal
 func assertDeltaRoundTrip(t *testing.T, src []uint32) []byte {
 	t.Helper()
-	// Copy src since PackDelta mutates its input
 	data := slices.Clone(src)
 	buf := PackDeltaUint32(nil, data)
+	assert.Equal(t, src, data, "input mutated")
 	got, err := UnpackUint32(nil, buf)
 	assert.NoError(t, err)
 	assert.Equal(t, len(src), len(got), "length mismatch")
@@ -2563,6 +3162,10 @@ func assertCompressionBelowRaw(t *testing.T, buf []byte, rawBytes int) {
 // Check that the encoded buffer is valid
 func assertValidEncoding(t *testing.T, buf []byte) {
 	t.Helper()
+	if err := Validate(buf); err != nil {
+		t.Fatalf("invalid encoding: %v", err)
+	}
+	// Independent layout check, so the encoder is not only checked against Validate
 	if len(buf) < headerBytes {
 		t.Fatalf("encoded buffer too small: %d", len(buf))
 	}
@@ -2592,12 +3195,37 @@ func assertValidEncoding(t *testing.T, buf []byte) {
 	}
 	// Check minimum size for exception area
 	minExcLen := 1 + 2 + excCount // count + svb_len + positions
+	svbLen := int(binary.LittleEndian.Uint16(buf[minLen+1:]))
+	if svbLen&patchRunsFlag != 0 {
+		// count + svb_len + runs length + run codes
+		if len(buf) < minLen+4 {
+			t.Fatalf("missing exception runs length byte")
+		}
+		minExcLen = 1 + 2 + 1 + int(buf[minLen+3])
+		if minExcLen >= 1+2+excCount {
+			t.Fatalf("run-coded positions (%d bytes) not smaller than plain positions (%d bytes)", minExcLen-3, excCount)
+		}
+		svbLen &^= patchRunsFlag
+	}
+	if svbLen&patchBitmapFlag != 0 {
+		// count + svb_len + bitmap
+		minExcLen = 1 + 2 + patchBitmapBytes
+		if minExcLen >= 1+2+excCount {
+			t.Fatalf("position bitmap not smaller than plain positions (%d bytes)", excCount)
+		}
+		svbLen &^= patchBitmapFlag
+	}
+	if svbLen&patchRawFlag != 0 {
+		svbLen &^= patchRawFlag
+		if svbLen%excCount != 0 || svbLen > 4*excCount {
+			t.Fatalf("raw high bits of %d bytes do not fit %d exceptions", svbLen, excCount)
+		}
+	}
 	if len(buf) < minLen+minExcLen {
 		t.Fatalf("exception area too small: got %d, need at least %d", len(buf)-minLen, minExcLen)
 	}
-	// Read StreamVByte length and verify total size
-	svbLen := int(binary.LittleEndian.Uint16(buf[minLen+1:]))
-	want := minLen + 1 + 2 + excCount + svbLen
+	// Verify total size
+	want := minLen + minExcLen + svbLen
 	if len(buf) != want {
 		t.Fatalf("exception payload mismatch: got %d want %d (count=%d, svbLen=%d)", len(buf), want, excCount, svbLen)
 	}
@@ -1,5 +1,7 @@
 package fastpfor
 
+import "fmt"
+
 // PackUint16 encodes up to BlockSize uint16 values into the FastPFOR block format.
 // The encoding uses the same bit-packing as PackUint32 but marks the header with
 // IntTypeUint16 for future native uint16 support. Since bit-width selection is
@@ -9,7 +11,10 @@ package fastpfor
 // slice with cap >= 256. The extra capacity (positions 128-255) is used as scratch
 // space for exception handling.
 //
-// This currently does not natively pack Uint16 - and is just a wrapper.
+// The values are widened to uint32 for packing. This does not cost space: the
+// payload size only depends on the selected bit width (at most 16 for uint16
+// values), so a dedicated 16-bit lane layout would not be smaller.
+// Use UnpackUint16 to decode the block back into uint16 values.
 func PackUint16(dst []byte, values []uint16) []byte {
 	var buf [2 * blockSize]uint32 // scratch space for conversion + exceptions
 	for i, v := range values {
@@ -18,9 +23,8 @@ func PackUint16(dst []byte, values []uint16) []byte {
 	return packInternal(dst, buf[:len(values)], headerTypeUint16Flag)
 }
 
-// PackDeltaUint16 delta-encodes and packs uint16 values.
-// Unlike PackDeltaUint32, this does NOT mutate the input slice since the values
-// are copied to an internal buffer for conversion to uint32.
+// PackDeltaUint16 delta-encodes and packs uint16 values. The input slice is not
+// mutated; the values are copied to an internal buffer for conversion to uint32.
 //
 // The delta flag is set in the header so UnpackUint32 can auto-detect and decode.
 // The IntTypeUint16 marker indicates the original values were uint16.
@@ -29,7 +33,7 @@ func PackUint16(dst []byte, values []uint16) []byte {
 // slice with cap >= 256. The extra capacity (positions 128-255) is used as scratch
 // space for exception handling.
 //
-// This currently does not natively pack Uint16 - and is just a wrapper.
+// Like PackUint16, the values are widened to uint32 for packing.
 func PackDeltaUint16(dst []byte, values []uint16) []byte {
 	var buf [2 * blockSize]uint32 // scratch space for conversion + exceptions
 	for i, v := range values {
@@ -55,3 +59,72 @@ func PackDeltaUint16(dst []byte, values []uint16) []byte {
 func MaxBlockSizeUint16() int {
 	return MaxBlockSizeUint32()
 }
+
+// UnpackUint16 decodes a block produced by PackUint16 or PackDeltaUint16 into
+// uint16 values, writing into the supplied dst slice (which will be resized as
+// needed). Delta-encoded blocks are automatically delta-decoded.
+//
+// Returns ErrInvalidBuffer if the block is not marked as IntTypeUint16 or
+// decodes to values that do not fit into uint16.
+//
+// Use UnpackUint16WithBuffer in tight loops to avoid allocating the internal
+// uint32 decode buffer on every call.
+func UnpackUint16(dst []uint16, buf []byte) ([]uint16, error) {
+	var scratch [2 * blockSize]uint32
+	return UnpackUint16WithBuffer(dst, scratch[:], buf)
+}
+
+// UnpackUint16WithBuffer decodes like UnpackUint16 but uses a caller-provided
+// scratch buffer for the intermediate uint32 values and exception handling.
+//
+// The scratch buffer must have cap(scratch) >= 256. For optimal performance, reuse
+// the same scratch buffer across multiple calls.
+func UnpackUint16WithBuffer(dst []uint16, scratch []uint32, buf []byte) ([]uint16, error) {
+	if cap(scratch) < 2*blockSize {
+		return nil, fmt.Errorf("fastpfor: scratch capacity too small (need %d, got %d)", 2*blockSize, cap(scratch))
+	}
+	header, count, _, err := readHeader(buf)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, intType, _, _, _, _ := decodeHeader(header); intType != IntTypeUint16 {
+		return nil, errNotUint16(intType)
+	}
+
+	scratch = scratch[:2*blockSize]
+	decoded, err := UnpackUint32WithBuffer(scratch[:0:blockSize], scratch[blockSize:], buf)
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		if dst == nil {
+			return nil, nil
+		}
+		return dst[:0], nil
+	}
+
+	return narrowUint16(dst, decoded)
+}
+
+// errNotUint16 is returned when a block of integer type intType is decoded as uint16.
+func errNotUint16(intType int) error {
+	return fmt.Errorf("%w: block has integer type %d, not uint16", ErrInvalidBuffer, intType)
+}
+
+// narrowUint16 converts values to uint16 into dst (which will be resized as needed).
+// Returns ErrInvalidBuffer if a value does not fit into uint16.
+func narrowUint16(dst []uint16, values []uint32) ([]uint16, error) {
+	if cap(dst) < len(values) {
+		dst = make([]uint16, len(values), blockSize)
+	}
+	dst = dst[:len(values)]
+	var orAll uint32
+	for i, v := range values {
+		orAll |= v
+		dst[i] = uint16(v)
+	}
+	if orAll > 0xFFFF {
+		return nil, fmt.Errorf("%w: decoded value exceeds uint16", ErrInvalidBuffer)
+	}
+	return dst, nil
+}
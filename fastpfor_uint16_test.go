@@ -42,7 +42,7 @@ func TestPackDeltaUint16Basic(t *testing.T) {
 
 	buf := PackDeltaUint16(nil, values)
 
-	// Verify original values are NOT mutated (unlike PackDeltaUint32)
+	// Verify original values are NOT mutated
 	assert.Equal(original, values, "input should not be mutated")
 
 	// Verify the header has IntTypeUint16 and delta flag
@@ -143,3 +143,158 @@ func TestPackDeltaUint16Empty(t *testing.T) {
 	hasDelta := header&headerDeltaFlag != 0
 	assert.True(hasDelta, "expected delta flag even for empty input")
 }
+
+func TestUnpackUint16(t *testing.T) {
+	assert := assert.New(t)
+
+	random := make([]uint16, blockSize)
+	for i := range random {
+		random[i] = uint16(rand.IntN(65536))
+	}
+	outliers := make([]uint16, blockSize)
+	for i := range outliers {
+		outliers[i] = uint16(i % 8)
+	}
+	outliers[17], outliers[99] = 65535, 40000
+
+	for name, values := range map[string][]uint16{
+		"small":    {1, 2, 3, 65535},
+		"random":   random,
+		"outliers": outliers,
+		"zigzag":   {500, 400, 600, 300, 700, 200, 0, 65535, 0},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := UnpackUint16(nil, PackUint16(nil, values))
+			assert.NoError(err)
+			assert.Equal(values, got)
+
+			got, err = UnpackUint16(got[:0], PackDeltaUint16(nil, values))
+			assert.NoError(err)
+			assert.Equal(values, got)
+		})
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		got, err := UnpackUint16(nil, PackUint16(nil, nil))
+		assert.NoError(err)
+		assert.Empty(got)
+	})
+
+	t.Run("notUint16", func(t *testing.T) {
+		_, err := UnpackUint16(nil, PackUint32(nil, []uint32{1, 2, 3}))
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+
+	t.Run("valueTooLarge", func(t *testing.T) {
+		buf := packInternal(nil, []uint32{1, 1 << 16}, headerTypeUint16Flag)
+		_, err := UnpackUint16(nil, buf)
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		buf := PackUint16(nil, random)
+		_, err := UnpackUint16(nil, buf[:len(buf)-1])
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+
+	t.Run("withBuffer", func(t *testing.T) {
+		scratch := make([]uint32, 2*blockSize)
+		got, err := UnpackUint16WithBuffer(nil, scratch, PackDeltaUint16(nil, outliers))
+		assert.NoError(err)
+		assert.Equal(outliers, got)
+
+		_, err = UnpackUint16WithBuffer(nil, scratch[:blockSize:blockSize], PackUint16(nil, outliers))
+		assert.Error(err)
+	})
+}
+
+// TestReaderUint16 verifies that Reader and SlimReader expose the values of
+// uint16 blocks as uint16 and reject blocks of other integer types.
+func TestReaderUint16(t *testing.T) {
+	assert := assert.New(t)
+
+	values := make([]uint16, blockSize)
+	for i := range values {
+		values[i] = uint16(i * 500)
+	}
+	values[17] = 65535 // exception
+	sorted := make([]uint16, 100)
+	for i := range sorted {
+		sorted[i] = uint16(3 * i)
+	}
+
+	for name, tc := range map[string]struct {
+		buf    []byte
+		values []uint16
+	}{
+		"plain": {PackUint16(nil, values), values},
+		"delta": {PackDeltaUint16(nil, sorted), sorted},
+	} {
+		reader := NewReader()
+		lazy := NewReader()
+		slim := NewSlimReader()
+		assert.NoError(reader.Load(tc.buf))
+		assert.NoError(lazy.LoadLazy(tc.buf))
+		assert.NoError(slim.Load(tc.buf))
+
+		for _, r := range []interface {
+			IntType() int
+			GetUint16(int) (uint16, error)
+			DecodeUint16([]uint16) ([]uint16, error)
+		}{reader, lazy, slim} {
+			assert.Equal(IntTypeUint16, r.IntType(), name)
+			for _, pos := range []int{0, 17, len(tc.values) - 1} {
+				v, err := r.GetUint16(pos)
+				assert.NoError(err)
+				assert.Equal(tc.values[pos], v, "%s pos %d", name, pos)
+			}
+			_, err := r.GetUint16(len(tc.values))
+			assert.ErrorIs(err, ErrPositionOutOfRange)
+
+			decoded, err := r.DecodeUint16(make([]uint16, 0, 1))
+			assert.NoError(err)
+			assert.Equal(tc.values, decoded, name)
+		}
+	}
+
+	// Blocks of other integer types are not narrowed
+	buf := PackUint32(nil, []uint32{1, 2, 3})
+	reader := NewReader()
+	slim := NewSlimReader()
+	assert.NoError(reader.Load(buf))
+	assert.NoError(slim.Load(buf))
+	assert.Equal(IntTypeUint32, reader.IntType())
+	assert.Equal(IntTypeUint32, slim.IntType())
+	_, err := reader.GetUint16(0)
+	assert.ErrorIs(err, ErrInvalidBuffer)
+	_, err = slim.DecodeUint16(nil)
+	assert.ErrorIs(err, ErrInvalidBuffer)
+	assert.Equal(IntTypeUint32, NewReaderFromValues([]uint32{1}, false).IntType())
+
+	_, err = NewReader().DecodeUint16(nil)
+	assert.ErrorIs(err, ErrNotLoaded)
+	_, err = NewSlimReader().GetUint16(0)
+	assert.ErrorIs(err, ErrNotLoaded)
+}
+
+func BenchmarkUnpackUint16(b *testing.B) {
+	values := make([]uint16, blockSize)
+	for i := range values {
+		values[i] = uint16(rand.IntN(4096))
+	}
+	buf := PackUint16(nil, values)
+	dst := make([]uint16, 0, blockSize)
+	b.Run("UnpackUint16", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			dst, _ = UnpackUint16(dst[:0], buf)
+		}
+	})
+	b.Run("UnpackUint16WithBuffer", func(b *testing.B) {
+		scratch := make([]uint32, 2*blockSize)
+		b.ReportAllocs()
+		for range b.N {
+			dst, _ = UnpackUint16WithBuffer(dst[:0], scratch, buf)
+		}
+	})
+}
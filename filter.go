@@ -0,0 +1,50 @@
+package fastpfor
+
+import "slices"
+
+// FilterByBitmap decodes the block in buf and appends to dst all values v whose
+// bit v-base is set in bitmap (bit i is bitmap[i/64] & (1 << (i%64)), as in
+// roaring/bitset containers). Values below base or beyond the end of the bitmap
+// are dropped. This combines a FastPFOR posting list with a bitset filter
+// without materializing the unfiltered values on the caller side.
+//
+// The block is typically sorted (delta-encoded); for sorted blocks the values
+// below base are skipped with a binary search and the scan stops at the first
+// value beyond the bitmap. Unsorted blocks are scanned entirely, the output
+// keeps the block order.
+//
+// Returns the unmodified dst and an error if buf is invalid or delta decoding
+// overflows (see ErrOverflow).
+func FilterByBitmap(buf []byte, bitmap []uint64, base uint32, dst []uint32) ([]uint32, error) {
+	var scratch [blockSize]uint32
+	values, sorted, err := UnpackUint32Monotonic(scratch[:0], buf)
+	if err != nil {
+		return dst, err
+	}
+
+	limit := uint64(len(bitmap)) * 64 // number of bits in the bitmap
+	if sorted {
+		start, _ := slices.BinarySearch(values, base)
+		for _, v := range values[start:] {
+			idx := uint64(v - base)
+			if idx >= limit {
+				break
+			}
+			if bitmap[idx>>6]&(1<<(idx&63)) != 0 {
+				dst = append(dst, v)
+			}
+		}
+		return dst, nil
+	}
+
+	for _, v := range values {
+		if v < base {
+			continue
+		}
+		idx := uint64(v - base)
+		if idx < limit && bitmap[idx>>6]&(1<<(idx&63)) != 0 {
+			dst = append(dst, v)
+		}
+	}
+	return dst, nil
+}
@@ -0,0 +1,85 @@
+package fastpfor
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// filterByBitmapScan is the reference implementation of FilterByBitmap.
+func filterByBitmapScan(values []uint32, bitmap []uint64, base uint32) []uint32 {
+	var out []uint32
+	for _, v := range values {
+		if v < base {
+			continue
+		}
+		idx := uint64(v - base)
+		if idx/64 < uint64(len(bitmap)) && bitmap[idx/64]>>(idx%64)&1 == 1 {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func TestFilterByBitmap(t *testing.T) {
+	assert := assert.New(t)
+
+	bitmap := make([]uint64, 8) // 512 bits
+	for i := range bitmap {
+		bitmap[i] = rand.Uint64()
+	}
+	sorted := genMonotonic(blockSize) // values up to ~512, partially beyond the bitmap for base > 0
+
+	for name, values := range map[string][]uint32{
+		"sorted":   sorted,
+		"unsorted": genMixed(blockSize),
+		"partial":  sorted[:33],
+	} {
+		for _, base := range []uint32{0, 100, 500, 1 << 30} {
+			want := filterByBitmapScan(values, bitmap, base)
+			for kind, buf := range map[string][]byte{
+				"plain": PackUint32(nil, values),
+				"delta": PackDeltaUint32(nil, append([]uint32(nil), values...)),
+			} {
+				got, err := FilterByBitmap(buf, bitmap, base, nil)
+				assert.NoError(err)
+				assert.Equal(want, got, "%s/%s base %d", name, kind, base)
+			}
+		}
+	}
+
+	t.Run("appendsToDst", func(t *testing.T) {
+		buf := PackDeltaUint32(nil, []uint32{1, 3, 64, 65, 200})
+		got, err := FilterByBitmap(buf, []uint64{1 << 3, 1 << 1}, 0, []uint32{42})
+		assert.NoError(err)
+		assert.Equal([]uint32{42, 3, 65}, got)
+	})
+
+	t.Run("emptyBitmap", func(t *testing.T) {
+		got, err := FilterByBitmap(PackUint32(nil, []uint32{1, 2}), nil, 0, nil)
+		assert.NoError(err)
+		assert.Empty(got)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		dst := []uint32{1}
+		got, err := FilterByBitmap([]byte{1}, []uint64{1}, 0, dst)
+		assert.ErrorIs(err, ErrInvalidBuffer)
+		assert.Equal(dst, got)
+	})
+}
+
+func BenchmarkFilterByBitmap(b *testing.B) {
+	values := genMonotonic(blockSize)
+	buf := PackDeltaUint32(nil, append([]uint32(nil), values...))
+	bitmap := make([]uint64, int(values[blockSize-1])/64+1)
+	for i := range bitmap {
+		bitmap[i] = 0x5555555555555555
+	}
+	dst := make([]uint32, 0, blockSize)
+	b.ReportAllocs()
+	for range b.N {
+		dst, _ = FilterByBitmap(buf, bitmap, 0, dst[:0])
+	}
+}
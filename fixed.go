@@ -0,0 +1,55 @@
+package fastpfor
+
+import "fmt"
+
+// PackFixed packs up to BlockSize values at the given bit width (0-32) in the
+// interleaved bp128 lane layout and appends the 16*bitWidth payload bytes to dst.
+// There is no header, no width selection and no exception table: the caller
+// controls the value range and must pass the count and bit width to UnpackFixed.
+// Partial blocks take as much space as full ones. The input slice is not mutated.
+//
+// Returns ErrInvalidBlockLength if there are more than BlockSize values and
+// ErrValueOutOfRange if a value does not fit into bitWidth bits. It panics if
+// bitWidth is outside 0-32.
+func PackFixed(dst []byte, values []uint32, bitWidth int) ([]byte, error) {
+	checkFixedBitWidth("PackFixed", bitWidth)
+	if len(values) > blockSize {
+		return dst, fmt.Errorf("%w: fixed blocks hold up to %d values, got %d", ErrInvalidBlockLength, blockSize, len(values))
+	}
+	if required := requiredBitWidthScalar(values); required > bitWidth {
+		return dst, fmt.Errorf("%w: values need %d bits, bit width is %d", ErrValueOutOfRange, required, bitWidth)
+	}
+	start := len(dst)
+	dst = append(dst, make([]byte, payloadBytes(bitWidth))...)
+	if bitWidth > 0 {
+		packLanes(dst[start:], values, bitWidth)
+	}
+	return dst, nil
+}
+
+// UnpackFixed decodes count values packed with PackFixed at bitWidth from the
+// start of buf into dst (which will be resized as needed) and returns the number
+// of bytes consumed from buf.
+//
+// Returns ErrInvalidBuffer if buf is shorter than the payload. It panics if count
+// is outside 0-BlockSize or bitWidth is outside 0-32.
+func UnpackFixed(dst []uint32, buf []byte, count, bitWidth int) ([]uint32, int, error) {
+	checkFixedBitWidth("UnpackFixed", bitWidth)
+	if count < 0 || count > blockSize {
+		panic(fmt.Sprintf("fastpfor: UnpackFixed: invalid count %d", count))
+	}
+	n := payloadBytes(bitWidth)
+	if len(buf) < n {
+		return nil, 0, fmt.Errorf("%w: fixed block truncated (need %d bytes, got %d)", ErrInvalidBuffer, n, len(buf))
+	}
+	dst = ensureUint32Cap(dst, count, blockSize)
+	unpackLanes(dst[:blockSize], buf[:n], count, bitWidth)
+	return dst[:count], n, nil
+}
+
+// checkFixedBitWidth panics if bitWidth is not a valid width for fn.
+func checkFixedBitWidth(fn string, bitWidth int) {
+	if bitWidth < 0 || bitWidth > 32 {
+		panic(fmt.Sprintf("fastpfor: %s: invalid bit width %d", fn, bitWidth))
+	}
+}
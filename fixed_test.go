@@ -0,0 +1,65 @@
+package fastpfor
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPackFixed verifies the round trip at every bit width and that the layout
+// matches the payload of PackUint32.
+func TestPackFixed(t *testing.T) {
+	assert := assert.New(t)
+
+	rng := rand.New(rand.NewSource(5))
+	for bitWidth := 0; bitWidth <= 32; bitWidth++ {
+		for _, count := range []int{0, 1, 77, blockSize} {
+			values := make([]uint32, count)
+			for i := range values {
+				values[i] = uint32(rng.Uint64() & (1<<bitWidth - 1))
+			}
+			input := slices.Clone(values)
+			buf, err := PackFixed([]byte{0xAA}, input, bitWidth)
+			if !assert.NoError(err, "width %d", bitWidth) {
+				continue
+			}
+			assert.Equal(values, input)
+			assert.Len(buf, 1+16*bitWidth)
+
+			got, n, err := UnpackFixed(nil, buf[1:], count, bitWidth)
+			assert.NoError(err)
+			assert.Equal(16*bitWidth, n)
+			assert.Equal(values, got, "width %d, count %d", bitWidth, count)
+		}
+	}
+
+	// Without exceptions, the payload of a block is the fixed encoding
+	values := make([]uint32, blockSize)
+	for i := range values {
+		values[i] = uint32(i) | 1<<9
+	}
+	buf, err := PackFixed(nil, values, 10)
+	assert.NoError(err)
+	block := PackUint32(nil, values)
+	assert.Equal(block[headerBytes:], buf)
+}
+
+// TestPackFixedErrors verifies the validation of the arguments.
+func TestPackFixedErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := PackFixed(nil, []uint32{1, 8, 2}, 3)
+	assert.ErrorIs(err, ErrValueOutOfRange)
+	_, err = PackFixed(nil, make([]uint32, blockSize+1), 1)
+	assert.ErrorIs(err, ErrInvalidBlockLength)
+	assert.Panics(func() { PackFixed(nil, nil, 33) })
+
+	buf, err := PackFixed(nil, []uint32{1, 2, 3}, 2)
+	assert.NoError(err)
+	_, _, err = UnpackFixed(nil, buf[:len(buf)-1], 3, 2)
+	assert.ErrorIs(err, ErrInvalidBuffer)
+	assert.Panics(func() { UnpackFixed(nil, buf, blockSize+1, 2) })
+	assert.Panics(func() { UnpackFixed(nil, buf, 3, -1) })
+}
@@ -0,0 +1,81 @@
+package fastpfor
+
+import "slices"
+
+// PackFORUint32 packs up to BlockSize values in frame-of-reference form: the
+// smallest value is stored once as the base of the block and only the
+// differences to it are bit-packed. Values clustered far from zero (e.g.
+// around 1e9) then pack like small values without having to be sorted, for 8
+// bytes more than PackUint32 (the wide header extension and the base record).
+//
+// Blocks of identical values, such as heartbeat counters or columns holding a
+// default value, are stored as constant blocks of 12 bytes without payload,
+// which decoders fill with the value directly.
+//
+// The values slice is not mutated. UnpackUint32 and the readers add the base
+// back transparently.
+func PackFORUint32(dst []byte, values []uint32) []byte {
+	e := encoderPool.Get().(*Encoder)
+	defer encoderPool.Put(e)
+	return e.PackFOR(dst, values)
+}
+
+// packFrame subtracts the smallest value from all values in place and packs the
+// differences as a frame-of-reference block with the smallest value as base.
+// Blocks of identical values are packed as constant blocks. opts is passed on to
+// the width selection.
+func packFrame(dst []byte, values []uint32, extraFlags uint32, scratch []uint32, opts *EncodeOptions) []byte {
+	var base uint32
+	codec := uint32(codecFOR)
+	if len(values) > 0 {
+		base = slices.Min(values)
+		var orAll uint32
+		for i := range values {
+			values[i] -= base
+			orAll |= values[i]
+		}
+		if orAll == 0 {
+			codec = codecConstant
+		}
+	}
+	return packInternalCodec(dst, values, extraFlags|headerWideFlag, scratch, opts, codec, base)
+}
+
+// blockCodec returns the codec id of the block in buf, which must have been
+// validated by readHeader. Blocks without the wide header form are FastPFOR blocks.
+func blockCodec(buf []byte, header uint32) uint32 {
+	if header&headerWideFlag == 0 {
+		return codecFastPFOR
+	}
+	return bo.Uint32(buf[headerBytes:]) >> headerWideCodecShift
+}
+
+// hasBaseRecord reports whether the block in buf, which must have been validated
+// by readHeader, is a frame-of-reference or constant block with a base record.
+func hasBaseRecord(buf []byte, header uint32) bool {
+	codec := blockCodec(buf, header)
+	return codec == codecFOR || codec == codecConstant
+}
+
+// frameBase returns the base of a frame-of-reference block validated by
+// readHeader, the value of a constant block, or 0 for all other blocks.
+func frameBase(buf []byte, header uint32) uint32 {
+	if !hasBaseRecord(buf, header) {
+		return 0
+	}
+	return bo.Uint32(buf[headerBytes+headerWideBytes:])
+}
+
+// fillValues sets all values to v.
+func fillValues(values []uint32, v uint32) {
+	for i := range values {
+		values[i] = v
+	}
+}
+
+// addBase adds base to all values.
+func addBase(values []uint32, base uint32) {
+	for i := range values {
+		values[i] += base
+	}
+}
@@ -0,0 +1,212 @@
+package fastpfor
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// genClustered returns unsorted values within a small window around 1e9, with
+// a few outliers that become exceptions.
+func genClustered(n int) []uint32 {
+	rng := rand.New(rand.NewSource(7))
+	out := make([]uint32, n)
+	for i := range out {
+		out[i] = 1_000_000_000 + uint32(rng.Intn(1000))
+		if i%41 == 17 {
+			out[i] += 1 << 20
+		}
+	}
+	return out
+}
+
+// TestPackFOR verifies that frame-of-reference blocks round-trip through all
+// decoders and the readers.
+func TestPackFOR(t *testing.T) {
+	inputs := map[string][]uint32{
+		"clustered": genClustered(blockSize),
+		"partial":   genClustered(77),
+		"constant":  {7, 7, 7, 7, 7},
+		"single":    {1 << 31},
+		"zeroBase":  {0, 5, 3, 9},
+		"wide":      {0, mathMaxUint32, 12},
+	}
+	for name, values := range inputs {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			input := append([]uint32(nil), values...)
+			buf := PackFORUint32(nil, input)
+			assert.Equal(values, input, "input must not be modified")
+
+			got, err := UnpackUint32(nil, buf)
+			assert.NoError(err)
+			assert.Equal(values, got)
+
+			got, n, err := UnpackUint32WithLength(nil, buf)
+			assert.NoError(err)
+			assert.Equal(values, got)
+			assert.Equal(len(buf), n)
+
+			var dec Decoder
+			got, err = dec.Unpack(nil, buf)
+			assert.NoError(err)
+			assert.Equal(values, got)
+
+			first, err := UnpackFirstN(nil, buf, len(values)/2+1)
+			assert.NoError(err)
+			assert.Equal(values[:len(values)/2+1], first)
+
+			r := NewReader()
+			assert.NoError(r.LoadLazy(buf))
+			for i, v := range values {
+				got, err := r.Get(i)
+				assert.NoError(err)
+				assert.Equal(v, got, "Reader.Get(%d)", i)
+
+				got, err = GetAt(buf, i)
+				assert.NoError(err)
+				assert.Equal(v, got, "GetAt(%d)", i)
+			}
+
+			s := NewSlimReader()
+			assert.NoError(s.Load(buf))
+			for i, v := range values {
+				got, err := s.Get(i)
+				assert.NoError(err)
+				assert.Equal(v, got, "SlimReader.Get(%d)", i)
+			}
+			for i, v := range values {
+				got, _, ok := s.Next()
+				assert.True(ok)
+				assert.Equal(v, got, "SlimReader.Next at %d", i)
+			}
+			assert.Equal(values, s.Decode(nil))
+			lo, hi := len(values)/3, len(values)-len(values)/4
+			part, err := s.DecodeRange(nil, lo, hi)
+			assert.NoError(err)
+			assert.Equal(values[lo:hi], part)
+			many, err := s.GetMany([]int{len(values) - 1, 0}, nil)
+			assert.NoError(err)
+			assert.Equal([]uint32{values[len(values)-1], values[0]}, many)
+
+			e, err := Explain(buf)
+			assert.NoError(err)
+			assert.Equal(slices.Min(values) == slices.Max(values), e.Constant)
+			assert.Equal(!e.Constant, e.FOR)
+			assert.NoError(VerifyBlock(buf))
+		})
+	}
+}
+
+// TestPackFORSize verifies that clustered values pack far smaller than with
+// PackUint32 and that constant blocks need no payload.
+func TestPackFORSize(t *testing.T) {
+	assert := assert.New(t)
+
+	values := genClustered(blockSize)
+	buf := PackFORUint32(nil, values)
+	assert.Less(len(buf), len(PackUint32(nil, values))/2)
+
+	e, err := Explain(buf)
+	assert.NoError(err)
+	assert.Equal(slices.Min(values), e.Base)
+	assert.Equal(10, e.BitWidth)
+
+	constant := make([]uint32, blockSize)
+	for i := range constant {
+		constant[i] = 123456789
+	}
+	buf = PackFORUint32(nil, constant)
+	assert.Len(buf, headerBytes+headerWideBytes+headerBaseBytes)
+}
+
+// TestPackConstant verifies that blocks of identical values become constant
+// blocks and that their header is validated.
+func TestPackConstant(t *testing.T) {
+	assert := assert.New(t)
+
+	values := make([]uint32, blockSize)
+	for i := range values {
+		values[i] = 123456789
+	}
+	buf := PackFORUint32(nil, values)
+	e, err := Explain(buf)
+	assert.NoError(err)
+	assert.True(e.Constant)
+	assert.Equal(uint32(123456789), e.Base)
+	assert.Contains(e.String(), "constant=123456789")
+
+	dst := make([]uint32, blockSize)
+	got, n, err := UnpackUint32WithLength(dst, buf)
+	assert.NoError(err)
+	assert.Equal(values, got)
+	assert.Equal(len(buf), n)
+
+	upper, ok := blockUpperBound(buf, bo.Uint32(buf), len(buf), 0, false)
+	assert.True(ok)
+	assert.Equal(uint32(123456789), upper)
+
+	// Constant blocks have no payload
+	corrupt := append([]byte(nil), buf...)
+	bo.PutUint32(corrupt, bo.Uint32(corrupt)|1<<headerWidthShift)
+	_, err = UnpackUint32(nil, corrupt)
+	assert.ErrorIs(err, ErrInvalidFlags)
+
+	// Identical values in a frame-of-reference block are not canonical
+	buf = packInternalCodec(nil, []uint32{0, 0, 0}, headerTypeUint32Flag|headerWideFlag, nil, nil, codecFOR, 9)
+	got, err = UnpackUint32(nil, buf)
+	assert.NoError(err)
+	assert.Equal([]uint32{9, 9, 9}, got)
+	assert.ErrorIs(VerifyBlock(buf), ErrNonCanonical)
+}
+
+// TestPackFORTranscode verifies that re-encoding keeps the frame-of-reference
+// form with a new base.
+func TestPackFORTranscode(t *testing.T) {
+	assert := assert.New(t)
+
+	values := genClustered(blockSize)
+	buf := PackFORUint32(nil, values)
+
+	mapped, err := TranscodeMap(nil, buf, func(v uint32) uint32 { return v + 5000 })
+	assert.NoError(err)
+	e, err := Explain(mapped)
+	assert.NoError(err)
+	assert.True(e.FOR)
+	assert.Equal(slices.Min(values)+5000, e.Base)
+
+	rest, err := DropFirstN(buf, 100)
+	assert.NoError(err)
+	got, err := UnpackUint32(nil, rest)
+	assert.NoError(err)
+	assert.Equal(values[100:], got)
+	e, err = Explain(rest)
+	assert.NoError(err)
+	assert.True(e.FOR)
+}
+
+// TestPackFORHeader verifies the validation of the base record.
+func TestPackFORHeader(t *testing.T) {
+	assert := assert.New(t)
+
+	buf := PackFORUint32(nil, genClustered(blockSize))
+	_, err := UnpackUint32(nil, buf[:headerBytes+headerWideBytes+2])
+	assert.ErrorIs(err, ErrInvalidBuffer)
+
+	_, _, _, err = SplitEncoded(buf)
+	assert.ErrorIs(err, ErrInvalidFlags)
+
+	corrupt := append([]byte(nil), buf...)
+	bo.PutUint32(corrupt, bo.Uint32(corrupt)|headerDeltaFlag)
+	_, err = UnpackUint32(nil, corrupt)
+	assert.ErrorIs(err, ErrInvalidFlags)
+
+	// A base below the smallest value decodes, but is not canonical
+	buf = packInternalCodec(nil, []uint32{5, 6}, headerTypeUint32Flag|headerWideFlag, nil, nil, codecFOR, 10)
+	got, err := UnpackUint32(nil, buf)
+	assert.NoError(err)
+	assert.Equal([]uint32{15, 16}, got)
+	assert.ErrorIs(VerifyBlock(buf), ErrNonCanonical)
+}
@@ -0,0 +1,75 @@
+package fastpfor
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrValueOutOfRange is returned when a value does not fit into the target
+// representation (e.g. a uint64 above math.MaxUint32 for Pack).
+var ErrValueOutOfRange = errors.New("fastpfor: value out of range")
+
+// Unsigned is the set of unsigned integer types supported by Pack and Unpack.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Pack encodes up to BlockSize values of any unsigned integer type and appends the
+// block to dst. Types of at most 16 bits are stored as uint16 blocks (see
+// PackUint16), wider types as uint32 blocks. Values of 64-bit types must fit into
+// 32 bits, as the block format packs at most 32 bits per value.
+//
+// Returns ErrInvalidBlockLength for more than BlockSize values and
+// ErrValueOutOfRange if a value exceeds 32 bits. The input slice is not mutated.
+func Pack[T Unsigned](dst []byte, values []T) ([]byte, error) {
+	if err := validateBlockLength(len(values)); err != nil {
+		return dst, err
+	}
+	var buf [2 * blockSize]uint32 // scratch space for conversion + exceptions
+	for i, v := range values {
+		if uint64(v) > uint64(mathMaxUint32) {
+			return dst, fmt.Errorf("%w: value %d at position %d exceeds 32 bits", ErrValueOutOfRange, uint64(v), i)
+		}
+		buf[i] = uint32(v)
+	}
+	flags := headerTypeUint32Flag
+	if uint64(^T(0)) <= 0xFFFF {
+		flags = headerTypeUint16Flag
+	}
+	return packInternal(dst, buf[:len(values)], flags), nil
+}
+
+// Unpack decodes a block into values of type T, writing into the supplied dst
+// slice (which will be resized as needed). Any block that UnpackUint32 accepts
+// can be decoded, as long as all values fit into T; delta-encoded blocks are
+// automatically delta-decoded.
+//
+// Returns ErrValueOutOfRange if a decoded value does not fit into T.
+func Unpack[T Unsigned](dst []T, buf []byte) ([]T, error) {
+	var scratch [blockSize]uint32
+	values, err := UnpackUint32(scratch[:0], buf)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		if dst == nil {
+			return nil, nil
+		}
+		return dst[:0], nil
+	}
+
+	limit := uint64(^T(0))
+	if cap(dst) < len(values) {
+		dst = make([]T, len(values), blockSize)
+	}
+	dst = dst[:len(values)]
+	var orAll uint32
+	for i, v := range values {
+		orAll |= v
+		dst[i] = T(v)
+	}
+	if uint64(orAll) > limit {
+		return nil, fmt.Errorf("%w: decoded values exceed %d", ErrValueOutOfRange, limit)
+	}
+	return dst, nil
+}
@@ -0,0 +1,101 @@
+package fastpfor
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type docID uint32
+
+// testPackUnpack round-trips values of type T through Pack and Unpack.
+func testPackUnpack[T Unsigned](t *testing.T, values []T, wantIntType int) {
+	t.Helper()
+	assert := assert.New(t)
+
+	buf, err := Pack(nil, values)
+	assert.NoError(err)
+	info, err := ReadBlockInfo(buf)
+	assert.NoError(err)
+	assert.Equal(wantIntType, info.IntType)
+
+	got, err := Unpack[T](nil, buf)
+	assert.NoError(err)
+	assert.Equal(len(values), len(got))
+	if len(values) > 0 {
+		assert.Equal(values, got)
+	}
+}
+
+// TestPackUnpackGeneric verifies the generic front-end for all unsigned types.
+func TestPackUnpackGeneric(t *testing.T) {
+	assert := assert.New(t)
+
+	t.Run("uint8", func(t *testing.T) {
+		testPackUnpack(t, []uint8{0, 1, 255, 17}, IntTypeUint16)
+	})
+	t.Run("uint16", func(t *testing.T) {
+		testPackUnpack(t, []uint16{0, 1, math.MaxUint16}, IntTypeUint16)
+	})
+	t.Run("uint32", func(t *testing.T) {
+		testPackUnpack(t, genDataWithLargeExceptions(), IntTypeUint32)
+	})
+	t.Run("uint64", func(t *testing.T) {
+		testPackUnpack(t, []uint64{0, 7, math.MaxUint32}, IntTypeUint32)
+	})
+	t.Run("uint", func(t *testing.T) {
+		testPackUnpack(t, []uint{3, 1, 4, 1, 5}, IntTypeUint32)
+	})
+	t.Run("named", func(t *testing.T) {
+		testPackUnpack(t, []docID{10, 20, 30}, IntTypeUint32)
+	})
+	t.Run("empty", func(t *testing.T) {
+		testPackUnpack(t, []uint16(nil), IntTypeUint16)
+	})
+
+	t.Run("uint64OutOfRange", func(t *testing.T) {
+		dst := []byte{1}
+		out, err := Pack(dst, []uint64{1, math.MaxUint32 + 1})
+		assert.ErrorIs(err, ErrValueOutOfRange)
+		assert.Equal(dst, out)
+	})
+
+	t.Run("tooManyValues", func(t *testing.T) {
+		_, err := Pack(nil, make([]uint16, blockSize+1))
+		assert.ErrorIs(err, ErrInvalidBlockLength)
+	})
+
+	t.Run("narrowingUnpack", func(t *testing.T) {
+		buf := PackUint32(nil, []uint32{1, 256})
+		_, err := Unpack[uint8](nil, buf)
+		assert.ErrorIs(err, ErrValueOutOfRange)
+
+		got, err := Unpack[uint16](nil, buf)
+		assert.NoError(err)
+		assert.Equal([]uint16{1, 256}, got)
+	})
+
+	t.Run("deltaBlock", func(t *testing.T) {
+		got, err := Unpack[uint64](nil, PackDeltaUint32(nil, []uint32{5, 3, 9}))
+		assert.NoError(err)
+		assert.Equal([]uint64{5, 3, 9}, got)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := Unpack[uint32](nil, []byte{1})
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+}
+
+func BenchmarkPackGeneric(b *testing.B) {
+	values := make([]uint64, blockSize)
+	for i := range values {
+		values[i] = uint64(i * 31)
+	}
+	dst := make([]byte, 0, MaxBlockSizeUint32())
+	b.ReportAllocs()
+	for range b.N {
+		dst, _ = Pack(dst[:0], values)
+	}
+}
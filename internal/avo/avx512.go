@@ -0,0 +1,273 @@
+//go:build avogen
+// +build avogen
+
+package main
+
+import (
+	. "github.com/mmcloughlin/avo/build"
+	op "github.com/mmcloughlin/avo/operand"
+	"github.com/mmcloughlin/avo/reg"
+)
+
+// This file generates the AVX-512 kernels. They only use AVX-512F instructions
+// and work on 16 integers per register; the tail of a slice is loaded and stored
+// with a mask, so they need neither alignment nor a scalar tail loop.
+//
+// The delta decoder computes the prefix sum of 16 deltas with four shifted
+// additions (VALIGND shifts in zeros by 1, 2, 4 and 8 elements) and adds the
+// last value of the previous register, broadcast with VPERMD.
+
+var isaAVX512 = vecISA{
+	name:   "AVX512",
+	chunks: 4,
+	vec:    ZMM,
+	mov:    func(src, dst op.Op) { VMOVDQU32(src, dst) },
+	store: func(v reg.VecVirtual, rows int, m op.Mem) {
+		tmp := GP32()
+		MOVL(op.U32(uint32(1)<<(4*rows)-1), tmp)
+		k := K()
+		KMOVW(tmp, k)
+		VMOVDQU32(v, k, m)
+	},
+	bcast:  func(m op.Mem, dst reg.VecVirtual) { VBROADCASTI32X4(m, dst) },
+	insert: func(chunk int, m op.Mem, v reg.VecVirtual) { VINSERTI32X4(op.Imm(uint64(chunk)), m, v, v) },
+	select_: func(sel []int, src, dst reg.VecVirtual) {
+		imm := 0
+		for k, c := range sel {
+			imm |= c << (2 * k)
+		}
+		VSHUFI32X4(op.Imm(uint64(imm)), src, src, dst)
+	},
+	or:  func(a, b, dst op.Op) { VPORD(a, b, dst) },
+	and: func(a, b, dst op.Op) { VPANDD(a, b, dst) },
+	xor: func(a, b, dst op.Op) { VPXORD(a, b, dst) },
+	prefix: func(v, carry, last reg.VecVirtual) {
+		zero := ZMM()
+		VPXORD(zero, zero, zero)
+		prefixSum(v, zero, carry)
+		VPERMD(v, last, carry)
+	},
+}
+
+func genAVX512Kernels() {
+	for width := 1; width <= 32; width++ {
+		isaAVX512.genPack(width)
+	}
+	for width := 1; width <= 32; width++ {
+		isaAVX512.genUnpack(width, false)
+	}
+	for width := 1; width <= 32; width++ {
+		isaAVX512.genUnpack(width, true)
+	}
+	genDeltaDecodeAVX512()
+	genDeltaDecodeWithOverflowAVX512()
+	genZigZagEncodeAVX512()
+	genZigZagDecodeAVX512()
+}
+
+// elementIndexesData holds 0..15 once emitted.
+var elementIndexesData *op.Mem
+
+// elementIndexes returns a register holding 0..15.
+func elementIndexes() reg.VecVirtual {
+	if elementIndexesData == nil {
+		m := GLOBL("elementIndexes", RODATA|NOPTR)
+		for i := 0; i < 16; i++ {
+			DATA(4*i, op.U32(i))
+		}
+		elementIndexesData = &m
+	}
+	m := *elementIndexesData
+	v := ZMM()
+	VMOVDQU32(m, v)
+	return v
+}
+
+// tailMask sets k to the first n (< 16) elements.
+func tailMask(indexes reg.VecVirtual, n reg.GPVirtual, k reg.Register) {
+	limit := ZMM()
+	VPBROADCASTD(n.As32(), limit)
+	VPCMPUD(op.Imm(1), limit, indexes, k) // indexes < n
+}
+
+// prefixSum replaces v with its inclusive prefix sum plus carry.
+func prefixSum(v, zero, carry reg.VecVirtual) {
+	for k := 1; k < 16; k *= 2 {
+		shifted := ZMM()
+		VALIGND(op.Imm(uint64(16-k)), zero, v, shifted)
+		VPADDD(shifted, v, v)
+	}
+	VPADDD(carry, v, v)
+}
+
+// vectorLoop emits a loop over the 16-element registers of n elements followed
+// by the masked tail. body gets the memory offset of the register and the mask
+// (nil for full registers).
+func vectorLoop(name string, n reg.GPVirtual, body func(index reg.GPVirtual, mask reg.Register)) {
+	index := GP64()
+	XORQ(index, index)
+	indexes := elementIndexes()
+
+	Label(name + "_loop")
+	CMPQ(n, op.Imm(16))
+	JL(op.LabelRef(name + "_tail"))
+	body(index, nil)
+	ADDQ(op.Imm(16), index)
+	SUBQ(op.Imm(16), n)
+	JMP(op.LabelRef(name + "_loop"))
+
+	Label(name + "_tail")
+	TESTQ(n, n)
+	JZ(op.LabelRef(name + "_done"))
+	mask := K()
+	tailMask(indexes, n, mask)
+	body(index, mask)
+
+	Label(name + "_done")
+	VZEROUPPER()
+}
+
+// loadMasked loads the register at index, zeroing the elements outside mask.
+func loadMasked(m op.Mem, mask reg.Register, v reg.VecVirtual) {
+	if mask == nil {
+		VMOVDQU32(m, v)
+	} else {
+		VMOVDQU32_Z(m, mask, v)
+	}
+}
+
+// storeMasked stores the elements of v inside mask.
+func storeMasked(v reg.VecVirtual, mask reg.Register, m op.Mem) {
+	if mask == nil {
+		VMOVDQU32(v, m)
+	} else {
+		VMOVDQU32(v, mask, m)
+	}
+}
+
+func genDeltaDecodeAVX512() {
+	TEXT("deltaDecodeAVX512", NOSPLIT, "func(dst *uint32, src *uint32, n int)")
+	Doc("deltaDecodeAVX512 decodes the D1 deltas of src[:n] into dst (see deltaDecodeSIMDAsm).")
+
+	dst := Load(Param("dst"), GP64())
+	src := Load(Param("src"), GP64())
+	n := Load(Param("n"), GP64()).(reg.GPVirtual)
+
+	zero := ZMM()
+	VPXORD(zero, zero, zero)
+	carry := ZMM()
+	VPXORD(carry, carry, carry)
+	last := broadcastConst(15)
+
+	vectorLoop("delta_decode_avx512", n, func(index reg.GPVirtual, mask reg.Register) {
+		v := ZMM()
+		loadMasked(op.Mem{Base: src, Index: index, Scale: 4}, mask, v)
+		prefixSum(v, zero, carry)
+		storeMasked(v, mask, op.Mem{Base: dst, Index: index, Scale: 4})
+		VPERMD(v, last, carry)
+	})
+	RET()
+}
+
+func genDeltaDecodeWithOverflowAVX512() {
+	TEXT("deltaDecodeWithOverflowAVX512", NOSPLIT, "func(dst *uint32, src *uint32, n int) uint8")
+	Doc("deltaDecodeWithOverflowAVX512 decodes the D1 deltas of src[:n] into dst and returns the index of")
+	Doc("the first value that overflowed, or 0 (see deltaDecodeWithOverflowSIMDAsm).")
+
+	dst := Load(Param("dst"), GP64())
+	src := Load(Param("src"), GP64())
+	n := Load(Param("n"), GP64()).(reg.GPVirtual)
+
+	zero := ZMM()
+	VPXORD(zero, zero, zero)
+	carry := ZMM()
+	VPXORD(carry, carry, carry)
+	last := broadcastConst(15)
+	overflow := GP64()
+	XORQ(overflow, overflow)
+
+	vectorLoop("delta_overflow_avx512", n, func(index reg.GPVirtual, mask reg.Register) {
+		v := ZMM()
+		loadMasked(op.Mem{Base: src, Index: index, Scale: 4}, mask, v)
+		prefixSum(v, zero, carry)
+		storeMasked(v, mask, op.Mem{Base: dst, Index: index, Scale: 4})
+
+		// A value below its predecessor wrapped around
+		prev := ZMM()
+		VALIGND(op.Imm(15), carry, v, prev)
+		wrapped := K()
+		if mask == nil {
+			VPCMPUD(op.Imm(1), prev, v, wrapped) // v < prev
+		} else {
+			VPCMPUD(op.Imm(1), prev, v, mask, wrapped)
+		}
+		VPERMD(v, last, carry)
+
+		name := "delta_overflow_avx512_checked"
+		if mask != nil {
+			name = "delta_overflow_avx512_tail_checked"
+		}
+		bits := GP32()
+		KMOVW(wrapped, bits)
+		TESTQ(overflow, overflow)
+		JNZ(op.LabelRef(name))
+		TESTL(bits, bits)
+		JZ(op.LabelRef(name))
+		BSFL(bits, bits)
+		MOVQ(index, overflow)
+		ADDQ(bits.As64(), overflow)
+		Label(name)
+	})
+	Store(overflow.As8(), ReturnIndex(0))
+	RET()
+}
+
+// broadcastConst returns a register holding c in all elements.
+func broadcastConst(c uint32) reg.VecVirtual {
+	tmp := GP32()
+	MOVL(op.U32(c), tmp)
+	v := ZMM()
+	VPBROADCASTD(tmp, v)
+	return v
+}
+
+func genZigZagEncodeAVX512() {
+	TEXT("zigzagEncodeAVX512", NOSPLIT, "func(buf *uint32, n int)")
+	Doc("zigzagEncodeAVX512 zigzag-encodes buf[:n] in place (see zigzagEncodeSIMDAsm).")
+
+	buf := Load(Param("buf"), GP64())
+	n := Load(Param("n"), GP64()).(reg.GPVirtual)
+
+	vectorLoop("zigzag_encode_avx512", n, func(index reg.GPVirtual, mask reg.Register) {
+		m := op.Mem{Base: buf, Index: index, Scale: 4}
+		v, sign := ZMM(), ZMM()
+		loadMasked(m, mask, v)
+		// (v << 1) ^ (v >> 31)
+		VPSRAD(op.Imm(31), v, sign)
+		VPSLLD(op.Imm(1), v, v)
+		VPXORD(sign, v, v)
+		storeMasked(v, mask, m)
+	})
+	RET()
+}
+
+func genZigZagDecodeAVX512() {
+	TEXT("zigzagDecodeAVX512", NOSPLIT, "func(buf *uint32, n int)")
+	Doc("zigzagDecodeAVX512 decodes the zigzag codes in buf[:n] in place (see zigzagDecodeSIMDAsm).")
+
+	buf := Load(Param("buf"), GP64())
+	n := Load(Param("n"), GP64()).(reg.GPVirtual)
+
+	vectorLoop("zigzag_decode_avx512", n, func(index reg.GPVirtual, mask reg.Register) {
+		m := op.Mem{Base: buf, Index: index, Scale: 4}
+		v, low := ZMM(), ZMM()
+		loadMasked(m, mask, v)
+		// (v >> 1) ^ -(v & 1)
+		VPSLLD(op.Imm(31), v, low)
+		VPSRAD(op.Imm(31), low, low)
+		VPSRLD(op.Imm(1), v, v)
+		VPXORD(low, v, v)
+		storeMasked(v, mask, m)
+	})
+	RET()
+}
@@ -30,7 +30,8 @@ func genDeltaEncodeKernel() {
 	TEXT("deltaEncodeSIMDAsm", NOSPLIT, "func(dst *uint32, src *uint32, n int) uint32")
 	Doc("deltaEncodeSIMDAsm encodes a slice of uint32 using delta encoding (D1).")
 	Doc("It returns a mask where bits are set if the corresponding delta was negative.")
-	Doc("n must be >= 0.")
+	Doc("n must be >= 0. src may be unaligned; dst must be 16-byte aligned and")
+	Doc("must not overlap src.")
 
 	// Load parameters
 	dstParam := Load(Param("dst"), GP64())
@@ -99,7 +100,7 @@ func genDeltaEncodeKernel() {
 	// Load 4 vectors (16 uint32s)
 	// Utilizing multiple load ports if available.
 	for i := 0; i < 4; i++ {
-		MOVO(op.Mem{Base: srcBase, Index: index, Scale: 4, Disp: i * 16}, currUnroll[i])
+		MOVOU(op.Mem{Base: srcBase, Index: index, Scale: 4, Disp: i * 16}, currUnroll[i])
 	}
 
 	for i := 0; i < 4; i++ {
@@ -150,7 +151,7 @@ func genDeltaEncodeKernel() {
 	blockSrc := op.Mem{Base: srcBase, Index: index, Scale: 4}
 	blockDst := op.Mem{Base: dstBase, Index: index, Scale: 4}
 
-	MOVO(blockSrc, curr)
+	MOVOU(blockSrc, curr)
 	MOVO(curr, currCopy)
 
 	// Shift values left by one lane (D1 alignment)
@@ -697,3 +698,60 @@ func genDeltaDecodeWithOverflowKernel() {
 	Store(overflowPos.As8(), ReturnIndex(0))
 	RET()
 }
+
+// genDeltaDecodeD4Kernel emits the D4 decoder (δi = xi − xi−4). Each row of four
+// values only depends on the previous row, so decoding is a single vector add
+// per row instead of the in-register prefix sum of D1.
+func genDeltaDecodeD4Kernel() {
+	TEXT("deltaDecodeD4SIMDAsm", NOSPLIT, "func(dst *uint32, src *uint32, n int)")
+	Doc("deltaDecodeD4SIMDAsm decodes the D4 deltas of src into dst (prefix sums")
+	Doc("with a stride of four). n must be a multiple of 4; dst and src may be")
+	Doc("unaligned and may alias.")
+
+	dstBase := Load(Param("dst"), GP64()).(reg.GPVirtual)
+	srcBase := Load(Param("src"), GP64()).(reg.GPVirtual)
+	n := Load(Param("n"), GP64())
+
+	index := GP64()
+	XORQ(index, index)
+
+	prevVec := XMM()
+	PXOR(prevVec, prevVec) // Row x(i-4..i-1), zero before the first row
+
+	// Unrolled loop for 4 rows (16 integers)
+	unrollLimit := GP64()
+	MOVQ(n, unrollLimit)
+	ANDQ(op.Imm(0xfffffff0), unrollLimit)
+
+	Label("delta_decode_d4_unroll_loop")
+	CMPQ(index, unrollLimit)
+	JAE(op.LabelRef("delta_decode_d4_unroll_done"))
+
+	var v [4]reg.VecVirtual
+	for i := range v {
+		v[i] = XMM()
+		MOVOU(op.Mem{Base: srcBase, Index: index, Scale: 4, Disp: i * 16}, v[i])
+	}
+	for i := range v {
+		PADDL(prevVec, v[i])
+		MOVOU(v[i], op.Mem{Base: dstBase, Index: index, Scale: 4, Disp: i * 16})
+		MOVO(v[i], prevVec)
+	}
+	ADDQ(op.Imm(16), index)
+	JMP(op.LabelRef("delta_decode_d4_unroll_loop"))
+
+	Label("delta_decode_d4_unroll_done")
+	Label("delta_decode_d4_vec_loop")
+	CMPQ(index, n)
+	JAE(op.LabelRef("delta_decode_d4_done"))
+	row := XMM()
+	MOVOU(op.Mem{Base: srcBase, Index: index, Scale: 4}, row)
+	PADDL(prevVec, row)
+	MOVOU(row, op.Mem{Base: dstBase, Index: index, Scale: 4})
+	MOVO(row, prevVec)
+	ADDQ(op.Imm(4), index)
+	JMP(op.LabelRef("delta_decode_d4_vec_loop"))
+
+	Label("delta_decode_d4_done")
+	RET()
+}
@@ -0,0 +1,80 @@
+//go:build avogen
+// +build avogen
+
+package main
+
+import (
+	. "github.com/mmcloughlin/avo/build"
+	op "github.com/mmcloughlin/avo/operand"
+	"github.com/mmcloughlin/avo/reg"
+)
+
+// This file generates the SSE2 exception mask kernel used while packing.
+//
+// For a candidate bit width, every value above limit = (1<<width)-1 becomes an
+// exception. SSE2 only offers a signed 32-bit compare (PCMPGTD), so both sides
+// are biased by 0x80000000 first, which maps the unsigned order onto the signed
+// one. MOVMSKPS then condenses each compared vector into 4 bits, which are
+// accumulated into a 128-bit position bitmap (two 64-bit words).
+
+func genExceptionMaskKernel() {
+	TEXT("exceptionMaskSIMDAsm", NOSPLIT, "func(values *uint32, n int, limit uint32) (lo uint64, hi uint64)")
+	Doc("exceptionMaskSIMDAsm sets bit i of the returned 128-bit mask (lo, hi) if values[i] > limit.")
+	Doc("Only the first n&^3 values are inspected, n must be <= 128.")
+
+	values := Load(Param("values"), GP64())
+	n := Load(Param("n"), GP64())
+	limit := Load(Param("limit"), GP32())
+
+	// Biased limit and bias vectors
+	XORL(op.U32(0x80000000), limit)
+	limitVec := XMM()
+	MOVD(limit, limitVec)
+	PSHUFD(op.Imm(0), limitVec, limitVec)
+
+	bias := GP32()
+	MOVL(op.U32(0x80000000), bias)
+	biasVec := XMM()
+	MOVD(bias, biasVec)
+	PSHUFD(op.Imm(0), biasVec, biasVec)
+
+	// Number of full vectors
+	SHRQ(op.Imm(2), n)
+
+	lo := GP64()
+	XORQ(lo, lo)
+	hi := GP64()
+	XORQ(hi, hi)
+	shift := reg.RCX // variable shift counts must be in CL
+	XORQ(shift, shift)
+
+	v := XMM()
+	bitsReg := GP64()
+
+	emit := func(acc op.Op, loop, done string) {
+		Label(loop)
+		TESTQ(n, n)
+		JZ(op.LabelRef("exception_mask_done"))
+		CMPQ(shift, op.Imm(64))
+		JAE(op.LabelRef(done))
+		MOVOU(op.Mem{Base: values}, v)
+		PXOR(biasVec, v)
+		PCMPGTL(limitVec, v)
+		MOVMSKPS(v, bitsReg.As32())
+		SHLQ(reg.CL, bitsReg)
+		ORQ(bitsReg, acc)
+		ADDQ(op.Imm(16), values)
+		ADDQ(op.Imm(4), shift)
+		DECQ(n)
+		JMP(op.LabelRef(loop))
+		Label(done)
+		XORQ(shift, shift)
+	}
+	emit(lo, "exception_mask_lo_loop", "exception_mask_lo_done")
+	emit(hi, "exception_mask_hi_loop", "exception_mask_hi_done")
+
+	Label("exception_mask_done")
+	Store(lo, ReturnIndex(0))
+	Store(hi, ReturnIndex(1))
+	RET()
+}
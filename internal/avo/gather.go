@@ -0,0 +1,105 @@
+//go:build avogen
+// +build avogen
+
+package main
+
+import (
+	. "github.com/mmcloughlin/avo/build"
+	op "github.com/mmcloughlin/avo/operand"
+	"github.com/mmcloughlin/avo/reg"
+)
+
+// This file generates the AVX2 lane gather kernel used for batched random access.
+//
+// Value p of a block lives in lane p&3 at bit (p>>2)*width of that lane, and
+// word w of a lane is stored at dword 4*w+lane of the payload. For 8 positions
+// at once the kernel computes these dword indexes and bit offsets, gathers the
+// word holding the low bits and (masked to the values crossing a word boundary)
+// the next word of the lane, and merges both with variable shifts.
+
+func genGatherLanesKernel() {
+	TEXT("gatherLanesAVX2", NOSPLIT, "func(payload *byte, positions *int, n int, bitWidth uint32, mask uint32, out *uint32)")
+	Doc("gatherLanesAVX2 extracts the values at positions[:n] from an interleaved bit-packed payload into out.")
+	Doc("n must be a multiple of 8 and all positions must be valid block positions.")
+
+	payload := Load(Param("payload"), GP64())
+	positions := Load(Param("positions"), GP64())
+	n := Load(Param("n"), GP64())
+	width := Load(Param("bitWidth"), GP32())
+	mask := Load(Param("mask"), GP32())
+	out := Load(Param("out"), GP64())
+
+	broadcast := func(r reg.Register) reg.VecVirtual {
+		x := XMM()
+		VMOVD(r, x)
+		y := YMM()
+		VPBROADCASTD(x, y)
+		return y
+	}
+	widthVec := broadcast(width)
+	maskVec := broadcast(mask)
+	tmp := GP32()
+	MOVL(op.U32(3), tmp)
+	laneMask := broadcast(tmp)
+	MOVL(op.U32(31), tmp)
+	offMask := broadcast(tmp)
+	MOVL(op.U32(32), tmp)
+	wordBits := broadcast(tmp)
+
+	// Number of 8-position groups
+	SHRQ(op.Imm(3), n)
+
+	Label("gather_loop")
+	TESTQ(n, n)
+	JZ(op.LabelRef("gather_done"))
+
+	// Narrow 8 int positions to 32 bits
+	pos, hi := YMM(), YMM()
+	VMOVDQU(op.Mem{Base: positions}, pos)
+	VMOVDQU(op.Mem{Base: positions, Disp: 32}, hi)
+	VPSHUFD(op.Imm(0x08), pos, pos)
+	VPERMQ(op.Imm(0x08), pos, pos)
+	VPSHUFD(op.Imm(0x08), hi, hi)
+	VPERMQ(op.Imm(0x08), hi, hi)
+	VINSERTI128(op.Imm(1), hi.AsX(), pos, pos)
+
+	// Dword index 4*word+lane and bit offset within the word
+	lane, off := YMM(), YMM()
+	VPAND(laneMask, pos, lane)
+	VPSRLD(op.Imm(2), pos, pos)
+	VPMULLD(widthVec, pos, pos)
+	VPAND(offMask, pos, off)
+	VPSRLD(op.Imm(5), pos, pos)
+	VPSLLD(op.Imm(2), pos, pos)
+	VPADDD(lane, pos, pos)
+
+	// Low words for all positions
+	all, lo := YMM(), YMM()
+	VPCMPEQD(all, all, all)
+	VPXOR(lo, lo, lo)
+	VPGATHERDD(all, op.Mem{Base: payload, Index: pos, Scale: 4}, lo)
+
+	// Next lane words for values with off+width > 32
+	span, next := YMM(), YMM()
+	VPADDD(widthVec, off, span)
+	VPCMPGTD(wordBits, span, span)
+	VPXOR(next, next, next)
+	VPGATHERDD(span, op.Mem{Base: payload, Disp: 16, Index: pos, Scale: 4}, next)
+
+	// (lo >> off | next << (32-off)) & mask
+	VPSRLVD(off, lo, lo)
+	VPSUBD(off, wordBits, off)
+	VPSLLVD(off, next, next)
+	VPOR(next, lo, lo)
+	VPAND(maskVec, lo, lo)
+	VMOVDQU(lo, op.Mem{Base: out})
+
+	ADDQ(op.Imm(64), positions)
+	ADDQ(op.Imm(32), out)
+	DECQ(n)
+	JMP(op.LabelRef("gather_loop"))
+
+	Label("gather_done")
+	VZEROUPPER()
+	RET()
+}
@@ -2,3 +2,7 @@ package main
 
 //go:generate go run -tags avogen . -component=delta -out=../../delta_amd64.s
 //go:generate go run -tags avogen . -component=zigzag -out=../../zigzag_amd64.s
+//go:generate go run -tags avogen . -component=exceptions -out=../../exceptions_amd64.s
+//go:generate go run -tags avogen . -component=gather -out=../../gather_amd64.s
+//go:generate go run -tags avogen . -component=pack -out=../../packavx2_amd64.s
+//go:generate go run -tags avogen . -component=avx512 -out=../../avx512_amd64.s
@@ -14,7 +14,7 @@ var (
 	component = flag.String("component", "all", "component to generate")
 )
 
-// main emits both the delta and zigzag kernels so go:generate stays simple.
+// main emits the delta, zigzag, exception, gather, AVX2 packing and AVX-512 kernels so go:generate stays simple.
 func main() {
 	flag.Parse()
 
@@ -28,6 +28,7 @@ func main() {
 		genDeltaEncodeKernel()
 		genDeltaDecodeKernel()
 		genDeltaDecodeWithOverflowKernel()
+		genDeltaDecodeD4Kernel()
 	}
 
 	if comp == "zigzag" || comp == "all" {
@@ -35,5 +36,21 @@ func main() {
 		genZigZagDecodeKernel()
 	}
 
+	if comp == "exceptions" || comp == "all" {
+		genExceptionMaskKernel()
+	}
+
+	if comp == "gather" || comp == "all" {
+		genGatherLanesKernel()
+	}
+
+	if comp == "pack" || comp == "all" {
+		genPackKernelsAVX2()
+	}
+
+	if comp == "avx512" || comp == "all" {
+		genAVX512Kernels()
+	}
+
 	Generate()
 }
@@ -0,0 +1,308 @@
+//go:build avogen
+// +build avogen
+
+package main
+
+import (
+	"fmt"
+
+	. "github.com/mmcloughlin/avo/build"
+	op "github.com/mmcloughlin/avo/operand"
+	"github.com/mmcloughlin/avo/reg"
+)
+
+// This file generates the wide bit packing kernels. They produce the same
+// payload as the SSE2 kernels in pack_amd64.s and unpack_amd64.s, where word w
+// of lane l (l = 0..3) is stored at dword 4*w+l (payload row w) and value i of
+// lane l is value 4*i+l of the block (value row i).
+//
+// A register covers a window of consecutive rows, one 128-bit chunk per row, so
+// the payload is stored (pack) and the values are stored (unpack) with one
+// instruction per window. The chunks of a window start at different bit
+// offsets, which the variable shifts VPSLLVD and VPSRLVD handle with per-chunk
+// counts from constant vectors. A count of 32 clears a chunk.
+//
+// The kernels neither read nor write outside the bitWidth payload rows and the
+// 32 value rows, so they can work on the caller's slices directly, and they
+// need no alignment.
+
+// maxRow is the last value row.
+const maxRow = 31
+
+// vecISA describes the registers and instructions of a kernel family.
+type vecISA struct {
+	name    string // suffix of the kernel names
+	chunks  int    // 128-bit chunks (rows) per register
+	vec     func() reg.VecVirtual
+	mov     func(src, dst op.Op)                        // full register load or store
+	store   func(v reg.VecVirtual, rows int, m op.Mem)  // store of the first rows chunks (less than chunks)
+	bcast   func(m op.Mem, dst reg.VecVirtual)          // one row into all chunks
+	insert  func(chunk int, m op.Mem, v reg.VecVirtual) // one row into chunk
+	select_ func(sel []int, src, dst reg.VecVirtual)    // chunk k of dst = chunk sel[k] of src, nil if unsupported
+	or      func(a, b, dst op.Op)
+	and     func(a, b, dst op.Op)
+	xor     func(a, b, dst op.Op)
+	prefix  func(v, carry, last reg.VecVirtual) // v = prefix sum of v plus carry, carry = last element of v in all dwords (last holds its index)
+}
+
+var isaAVX2 = vecISA{
+	name:   "AVX2",
+	chunks: 2,
+	vec:    YMM,
+	mov:    func(src, dst op.Op) { VMOVDQU(src, dst) },
+	store:  func(v reg.VecVirtual, rows int, m op.Mem) { VMOVDQU(v.AsX(), m) },
+	bcast:  func(m op.Mem, dst reg.VecVirtual) { VBROADCASTI128(m, dst) },
+	insert: func(chunk int, m op.Mem, v reg.VecVirtual) { VINSERTI128(op.Imm(uint64(chunk)), m, v, v) },
+	or:     func(a, b, dst op.Op) { VPOR(a, b, dst) },
+	and:    func(a, b, dst op.Op) { VPAND(a, b, dst) },
+	xor:    func(a, b, dst op.Op) { VPXOR(a, b, dst) },
+	prefix: func(v, carry, last reg.VecVirtual) {
+		// Prefix sums of both 128-bit chunks, then the last sum of the
+		// low chunk is added to the high chunk
+		t := YMM()
+		VPSLLDQ(op.Imm(4), v, t)
+		VPADDD(t, v, v)
+		VPSLLDQ(op.Imm(8), v, t)
+		VPADDD(t, v, v)
+		VPSHUFD(op.Imm(0xFF), v, t)
+		VPERM2I128(op.Imm(0x08), t, t, t)
+		VPADDD(t, v, v)
+		VPADDD(carry, v, v)
+		VPERMD(v, last, carry)
+	},
+}
+
+// rowMem returns the operand of row (16 bytes) of a payload or value array.
+func rowMem(base reg.Register, row int) op.Mem {
+	return op.Mem{Base: base, Disp: 16 * row}
+}
+
+// countVectors holds the emitted shift count constants by their counts.
+var countVectors = map[string]op.Mem{}
+
+// countVector returns a constant vector holding counts[k] in the dwords of chunk k.
+func countVector(counts []int) op.Mem {
+	key := fmt.Sprint(counts)
+	if m, ok := countVectors[key]; ok {
+		return m
+	}
+	m := GLOBL(fmt.Sprintf("shiftCounts%d", len(countVectors)), RODATA|NOPTR)
+	for k, c := range counts {
+		for l := 0; l < 4; l++ {
+			DATA(16*k+4*l, op.U32(c))
+		}
+	}
+	countVectors[key] = m
+	return m
+}
+
+// broadcastMask returns a register holding mask in all dwords.
+func (s vecISA) broadcastMask(mask uint32) reg.VecVirtual {
+	tmp := GP32()
+	MOVL(op.U32(mask), tmp)
+	x := XMM()
+	VMOVD(tmp, x)
+	v := s.vec()
+	VPBROADCASTD(x, v)
+	return v
+}
+
+// loadRows loads row rows[k] of base into chunk k of a new register, reading
+// no row beyond last. Chunks with row -1 are not needed and hold arbitrary rows.
+func (s vecISA) loadRows(base reg.Register, rows []int, last int) reg.VecVirtual {
+	lo, hi := last, 0
+	for _, r := range rows {
+		if r >= 0 {
+			lo, hi = min(lo, r), max(hi, r)
+		}
+	}
+	v := s.vec()
+	if lo == hi {
+		s.bcast(rowMem(base, lo), v)
+		return v
+	}
+
+	// A window of consecutive rows is loaded at once
+	start := min(lo, last-s.chunks+1)
+	consecutive := start >= 0
+	for k, r := range rows {
+		if r >= 0 && r != start+k {
+			consecutive = false
+		}
+	}
+	if consecutive {
+		s.mov(rowMem(base, start), v)
+		return v
+	}
+	if s.select_ != nil && start >= 0 && hi-start < s.chunks {
+		sel := make([]int, len(rows))
+		for k, r := range rows {
+			if r >= 0 {
+				sel[k] = r - start
+			}
+		}
+		s.mov(rowMem(base, start), v)
+		s.select_(sel, v, v)
+		return v
+	}
+
+	s.bcast(rowMem(base, lo), v)
+	for k, r := range rows {
+		if r >= 0 && r != lo {
+			s.insert(k, rowMem(base, r), v)
+		}
+	}
+	return v
+}
+
+// shift shifts chunk k of v left or right by counts[k] in place.
+func (s vecISA) shift(left bool, v reg.VecVirtual, counts []int) {
+	uniform := true
+	for _, c := range counts {
+		uniform = uniform && c == counts[0]
+	}
+	switch {
+	case uniform && counts[0] == 0:
+	case uniform && counts[0] < 32 && left:
+		VPSLLD(op.Imm(uint64(counts[0])), v, v)
+	case uniform && counts[0] < 32:
+		VPSRLD(op.Imm(uint64(counts[0])), v, v)
+	case left:
+		VPSLLVD(countVector(counts), v, v)
+	default:
+		VPSRLVD(countVector(counts), v, v)
+	}
+}
+
+func genPackKernelsAVX2() {
+	for width := 1; width <= 32; width++ {
+		isaAVX2.genPack(width)
+	}
+	for width := 1; width <= 32; width++ {
+		isaAVX2.genUnpack(width, false)
+	}
+	for width := 1; width <= 32; width++ {
+		isaAVX2.genUnpack(width, true)
+	}
+}
+
+// genPack emits a kernel that computes every payload window from the values
+// overlapping its rows. Step t shifts the t-th of these values of every row into
+// place; the first one may start in the previous row.
+func (s vecISA) genPack(width int) {
+	TEXT(fmt.Sprintf("pack32%s_%d", s.name, width), NOSPLIT, "func(in uintptr, out *byte, offset int, seed *byte)")
+	Doc(fmt.Sprintf("pack32%s_%d packs 128 masked values at bit width %d into the SSE2 payload layout.", s.name, width, width))
+
+	in := Load(Param("in"), GP64())
+	out := Load(Param("out"), GP64())
+	offset := Load(Param("offset"), GP64())
+	SHLQ(op.Imm(2), offset)
+	ADDQ(offset, in)
+
+	for first := 0; first < width; first += s.chunks {
+		var acc reg.VecVirtual
+		for t := 0; ; t++ {
+			rows := make([]int, s.chunks)
+			counts := make([]int, s.chunks)
+			needed := false
+			for k := range rows {
+				row := first + k
+				value := 32*row/width + t
+				if row >= width || value > maxRow || value*width >= 32*(row+1) {
+					rows[k], counts[k] = -1, 32
+					continue
+				}
+				needed = true
+				rows[k] = value
+				counts[k] = value*width - 32*row
+				if t == 0 {
+					counts[k] = -counts[k]
+				}
+			}
+			if !needed {
+				break
+			}
+			v := s.loadRows(in, rows, maxRow)
+			s.shift(t > 0, v, counts)
+			if acc == nil {
+				acc = v
+			} else {
+				s.or(v, acc, acc)
+			}
+		}
+		if rows := width - first; rows < s.chunks {
+			s.store(acc, rows, rowMem(out, first))
+		} else {
+			s.mov(acc, rowMem(out, first))
+		}
+	}
+
+	VZEROUPPER()
+	RET()
+}
+
+// genUnpack emits a kernel that computes every value window from the payload
+// rows holding its values, the second one only for values crossing a word. The
+// delta kernels store the prefix sums of the windows instead (D1 deltas), so
+// delta blocks are decoded in a single pass.
+func (s vecISA) genUnpack(width int, delta bool) {
+	if delta {
+		TEXT(fmt.Sprintf("unpackDelta32%s_%d", s.name, width), NOSPLIT, "func(in *byte, out uintptr, offset int, seed *byte)")
+		Doc(fmt.Sprintf("unpackDelta32%s_%d unpacks 128 D1 deltas at bit width %d from the SSE2 payload layout and", s.name, width, width),
+			"stores their prefix sums.")
+	} else {
+		TEXT(fmt.Sprintf("unpack32%s_%d", s.name, width), NOSPLIT, "func(in *byte, out uintptr, offset int, seed *byte)")
+		Doc(fmt.Sprintf("unpack32%s_%d unpacks 128 values at bit width %d from the SSE2 payload layout.", s.name, width, width))
+	}
+
+	in := Load(Param("in"), GP64())
+	out := Load(Param("out"), GP64())
+	offset := Load(Param("offset"), GP64())
+	SHLQ(op.Imm(2), offset)
+	ADDQ(offset, out)
+
+	var mask reg.VecVirtual
+	if width < 32 {
+		mask = s.broadcastMask(uint32(1)<<width - 1)
+	}
+	var carry, last reg.VecVirtual
+	if delta {
+		carry = s.vec()
+		s.xor(carry, carry, carry)
+		last = s.broadcastMask(uint32(4*s.chunks - 1))
+	}
+
+	for first := 0; first < 32; first += s.chunks {
+		rows := make([]int, s.chunks)
+		shifts := make([]int, s.chunks)
+		next := make([]int, s.chunks)
+		carries := make([]int, s.chunks)
+		crossing := false
+		for k := range rows {
+			bit := (first + k) * width
+			rows[k], shifts[k] = bit/32, bit%32
+			next[k], carries[k] = -1, 32
+			if shifts[k]+width > 32 {
+				next[k], carries[k] = rows[k]+1, 32-shifts[k]
+				crossing = true
+			}
+		}
+		v := s.loadRows(in, rows, width-1)
+		s.shift(false, v, shifts)
+		if crossing {
+			high := s.loadRows(in, next, width-1)
+			s.shift(true, high, carries)
+			s.or(high, v, v)
+		}
+		if width < 32 {
+			s.and(mask, v, v)
+		}
+		if delta {
+			s.prefix(v, carry, last)
+		}
+		s.mov(v, rowMem(out, first))
+	}
+
+	VZEROUPPER()
+	RET()
+}
@@ -0,0 +1,240 @@
+package fastpfor
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// Intersect appends the values contained in both a and b, which must hold
+// non-decreasing values such as the docIDs of two posting list blocks, to dst and
+// returns it. Values occurring several times in both are appended as often as in
+// the side with fewer occurrences.
+//
+// The readers leapfrog with SkipTo from their first values, so the smaller side
+// determines the number of searches and neither side is copied. Both readers are
+// reset first and exhausted afterwards. Readers that are not known to be sorted
+// (see IsSorted), such as plain blocks, are checked with a scan over their values.
+// Returns ErrNotLoaded if a reader is not loaded or ErrNotSorted if its values
+// decrease somewhere; dst is returned unchanged then.
+func Intersect(a, b *Reader, dst []uint32) ([]uint32, error) {
+	for _, r := range [2]*Reader{a, b} {
+		if !r.loaded {
+			return dst, ErrNotLoaded
+		}
+		r.ensureDecoded()
+		if !r.isSorted && !isNonDecreasing(r.values[:r.count]) {
+			return dst, fmt.Errorf("%w: cannot intersect unsorted blocks", ErrNotSorted)
+		}
+	}
+	a.Reset()
+	b.Reset()
+	return intersectSorted(dst, a.Next, a.skipToGallop, b.skipToGallop), nil
+}
+
+// IntersectSequences is Intersect for two SequenceReaders, such as posting lists
+// of many blocks. For sorted sequences (see SequenceReader.IsSorted) only the
+// blocks that SkipTo lands in are decoded, so blocks of the longer list between
+// two values of the shorter one are passed over. Other sequences, such as those
+// with overlapping blocks, are decoded and sorted into a copy first. Returns
+// ErrNotLoaded if a reader is not loaded or the error of a block that cannot be
+// decoded; dst is returned unchanged then.
+func IntersectSequences(a, b *SequenceReader, dst []uint32) ([]uint32, error) {
+	cursors, err := sequenceCursors(a, b)
+	if err != nil {
+		return dst, err
+	}
+	return intersectSorted(dst, cursors[0].next, cursors[0].skipTo, cursors[1].skipTo), nil
+}
+
+// IntersectContainers appends the values contained in both a and b to dst as
+// concatenated blocks with range records (see SetBlockRange), all but the last
+// holding 128 values, and returns it. The blocks are packed like PackDeltaUint32,
+// so the result loads as a sorted SequenceReader and can be intersected again.
+// Values occurring several times are treated like in Intersect.
+//
+// For sorted sequences (see SequenceReader.IsSorted), the value ranges of the
+// blocks of a and b, as recorded by Load or taken from a BlockIndex by
+// LoadIndexed, are intersected first. The readers then leapfrog with SkipTo only
+// within the ranges covered by blocks of both sides, so blocks without an
+// overlapping block on the other side are never decoded. Other sequences are
+// intersected like in IntersectSequences. Returns ErrNotLoaded if a reader is
+// not loaded or the error of a block that cannot be decoded; dst is returned
+// unchanged then.
+func IntersectContainers(dst []byte, a, b *SequenceReader) ([]byte, error) {
+	cursors, err := sequenceCursors(a, b)
+	if err != nil {
+		return dst, err
+	}
+	windows := [][2]uint32{{0, mathMaxUint32}}
+	if a.sorted && b.sorted {
+		windows = overlapWindows(a, b)
+	}
+	if len(windows) == 0 {
+		return dst, nil
+	}
+
+	enc := GetEncoder()
+	defer PutEncoder(enc)
+	var matches [blockSize]uint32
+	var block []byte
+	n := 0
+	flush := func() {
+		block = enc.PackDelta(block[:0], matches[:n])
+		// Set the range record from the sorted matches instead of decoding the
+		// block like SetBlockRange; rewriting a valid block cannot fail
+		dst, _ = rewriteRange(dst, block, &ValueRange{Min: matches[0], Max: matches[n-1]})
+		n = 0
+	}
+
+	ca, cb := cursors[0], cursors[1]
+	va, _, okA := ca.skipTo(windows[0][0])
+	vb, _, okB := cb.skipTo(windows[0][0])
+	for _, w := range windows {
+		if okA && va < w[0] {
+			va, _, okA = ca.skipTo(w[0])
+		}
+		if okB && vb < w[0] {
+			vb, _, okB = cb.skipTo(w[0])
+		}
+		for okA && okB && va <= w[1] && vb <= w[1] {
+			switch {
+			case va == vb:
+				matches[n] = va
+				if n++; n == blockSize {
+					flush()
+				}
+				va, _, okA = ca.next()
+				vb, _, okB = cb.next()
+			case va < vb:
+				va, _, okA = ca.skipTo(vb)
+			default:
+				vb, _, okB = cb.skipTo(va)
+			}
+		}
+	}
+	if n > 0 {
+		flush()
+	}
+	return dst, nil
+}
+
+// overlapWindows returns the ascending, disjoint value ranges covered by blocks
+// of both sorted sequences a and b. Runs of blocks of one side that end before
+// the current block of the other side begins are passed over by binary search.
+func overlapWindows(a, b *SequenceReader) [][2]uint32 {
+	var windows [][2]uint32
+	i, j := 0, 0
+	for i < len(a.lasts) && j < len(b.lasts) {
+		switch {
+		case a.lasts[i] < b.firsts[j]:
+			i += sort.Search(len(a.lasts)-i, func(k int) bool { return a.lasts[i+k] >= b.firsts[j] })
+			continue
+		case b.lasts[j] < a.firsts[i]:
+			j += sort.Search(len(b.lasts)-j, func(k int) bool { return b.lasts[j+k] >= a.firsts[i] })
+			continue
+		}
+		lo, hi := max(a.firsts[i], b.firsts[j]), min(a.lasts[i], b.lasts[j])
+		if n := len(windows); n > 0 && lo <= windows[n-1][1] {
+			windows[n-1][1] = max(windows[n-1][1], hi)
+		} else {
+			windows = append(windows, [2]uint32{lo, hi})
+		}
+		if a.lasts[i] < b.lasts[j] {
+			i++
+		} else {
+			j++
+		}
+	}
+	return windows
+}
+
+// sequenceCursors resets a and b and returns their sorted cursors. Unsorted
+// sequences are decoded and sorted into a copy.
+func sequenceCursors(a, b *SequenceReader) ([2]sortedCursor, error) {
+	var cursors [2]sortedCursor
+	for i, r := range [2]*SequenceReader{a, b} {
+		if !r.loaded {
+			return cursors, ErrNotLoaded
+		}
+		r.Reset()
+		if r.sorted {
+			cursors[i] = sortedCursor{next: r.Next, skipTo: r.SkipTo}
+			continue
+		}
+		values, err := r.sortedValues()
+		if err != nil {
+			return cursors, err
+		}
+		c := &sliceCursor{values: values}
+		cursors[i] = sortedCursor{next: c.Next, skipTo: c.SkipTo}
+	}
+	return cursors, nil
+}
+
+// sortedCursor holds the Next and SkipTo methods of a sorted sequence.
+type sortedCursor struct {
+	next   func() (uint32, int, bool)
+	skipTo func(uint32) (uint32, int, bool)
+}
+
+// sortedValues decodes all blocks of r and returns their values sorted.
+func (r *SequenceReader) sortedValues() ([]uint32, error) {
+	values := make([]uint32, 0, r.Len())
+	for i := range r.NumBlocks() {
+		if err := r.loadBlock(i); err != nil {
+			return nil, err
+		}
+		values = append(values, r.reader.values[:r.reader.count]...)
+	}
+	slices.Sort(values)
+	return values, nil
+}
+
+// sliceCursor iterates sorted values like a SequenceReader.
+type sliceCursor struct {
+	values []uint32
+	pos    int
+}
+
+// Next returns the next value and its position.
+func (c *sliceCursor) Next() (uint32, int, bool) {
+	if c.pos >= len(c.values) {
+		return 0, 0, false
+	}
+	c.pos++
+	return c.values[c.pos-1], c.pos - 1, true
+}
+
+// SkipTo advances to and returns the first value >= req at or after the
+// current position.
+func (c *sliceCursor) SkipTo(req uint32) (uint32, int, bool) {
+	idx, _ := slices.BinarySearch(c.values[c.pos:], req)
+	c.pos += idx
+	return c.Next()
+}
+
+// intersectSorted appends the common values of two sorted cursors to dst,
+// skipping the side with the smaller current value to the other one. SkipTo
+// consumes the value it returns, so both current values are held until matched.
+func intersectSorted[P any](dst []uint32, nextA func() (uint32, P, bool), skipA, skipB func(uint32) (uint32, P, bool)) []uint32 {
+	va, _, ok := nextA()
+	if !ok {
+		return dst
+	}
+	vb, _, ok := skipB(va)
+	for ok {
+		switch {
+		case va == vb:
+			dst = append(dst, va)
+			if va, _, ok = nextA(); ok {
+				vb, _, ok = skipB(va)
+			}
+		case va < vb:
+			va, _, ok = skipA(vb)
+		default:
+			vb, _, ok = skipB(va)
+		}
+	}
+	return dst
+}
@@ -0,0 +1,230 @@
+package fastpfor
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// genPostings returns n sorted values with gaps of up to maxGap, starting at 0.
+func genPostings(rng *rand.Rand, n int, maxGap uint32) []uint32 {
+	values := make([]uint32, n)
+	var v uint32
+	for i := range values {
+		v += uint32(rng.Intn(int(maxGap) + 1))
+		values[i] = v
+	}
+	return values
+}
+
+// intersectNaive returns the multiset intersection of two sorted slices.
+func intersectNaive(a, b []uint32) []uint32 {
+	var out []uint32
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			out = append(out, a[i])
+			i++
+			j++
+		}
+	}
+	return out
+}
+
+func TestIntersect(t *testing.T) {
+	assert := assert.New(t)
+	rng := rand.New(rand.NewSource(4056))
+
+	for round := range 50 {
+		a := genPostings(rng, rng.Intn(blockSize+1), uint32(1+rng.Intn(8)))
+		b := genPostings(rng, rng.Intn(blockSize+1), uint32(1+rng.Intn(8)))
+		want := intersectNaive(a, b)
+
+		// Delta blocks are known to be sorted, plain blocks are checked
+		for _, pack := range []func([]byte, []uint32) []byte{PackDeltaUint32, PackUint32} {
+			ra, err := loadReader(pack(nil, a))
+			assert.NoError(err)
+			rb, err := loadReader(pack(nil, b))
+			assert.NoError(err)
+			ra.Next() // Intersect starts from the beginning
+			got, err := Intersect(ra, rb, []uint32{7})
+			assert.NoError(err)
+			assert.Equal(append([]uint32{7}, want...), got, "round %d", round)
+
+			got, err = Intersect(rb, ra, nil)
+			assert.NoError(err)
+			assert.Equal(len(want), len(got), "round %d", round)
+		}
+	}
+
+	got, err := Intersect(NewReaderFromValues([]uint32{1, 1, 1, 4, 9}, true), NewReaderFromValues([]uint32{1, 1, 9, 9}, true), nil)
+	assert.NoError(err)
+	assert.Equal([]uint32{1, 1, 9}, got)
+	// A match found by skipping a
+	got, err = Intersect(NewReaderFromValues([]uint32{0, 31, 62}, true), NewReaderFromValues([]uint32{31, 60, 62}, true), nil)
+	assert.NoError(err)
+	assert.Equal([]uint32{31, 62}, got)
+
+	sorted, err := loadReader(PackUint32(nil, []uint32{1, 2, 3}))
+	assert.NoError(err)
+	unsorted, err := loadReader(PackUint32(nil, []uint32{3, 2, 1}))
+	assert.NoError(err)
+	dst := []uint32{7}
+	got, err = Intersect(sorted, unsorted, dst)
+	assert.ErrorIs(err, ErrNotSorted)
+	assert.Equal(dst, got)
+	_, err = Intersect(NewReader(), sorted, nil)
+	assert.ErrorIs(err, ErrNotLoaded)
+}
+
+func TestIntersectSequences(t *testing.T) {
+	assert := assert.New(t)
+	rng := rand.New(rand.NewSource(4056))
+
+	pack := func(values []uint32) *SequenceReader {
+		var buf []byte
+		for off := 0; off < len(values); off += blockSize {
+			buf = PackDeltaUint32(buf, values[off:min(off+blockSize, len(values))])
+		}
+		r := NewSequenceReader()
+		assert.NoError(r.Load(buf))
+		return r
+	}
+	for round := range 20 {
+		a := genPostings(rng, rng.Intn(20*blockSize), uint32(1+rng.Intn(40)))
+		b := genPostings(rng, rng.Intn(5*blockSize), uint32(1+rng.Intn(200)))
+		got, err := IntersectSequences(pack(a), pack(b), nil)
+		assert.NoError(err)
+		assert.Equal(intersectNaive(a, b), got, "round %d", round)
+	}
+
+	// Unsorted sequences are intersected as sets of their values
+	unsorted := NewSequenceReader()
+	assert.NoError(unsorted.Load(PackUint32(nil, []uint32{3, 2, 1})))
+	got, err := IntersectSequences(pack([]uint32{1, 2}), unsorted, nil)
+	assert.NoError(err)
+	assert.Equal([]uint32{1, 2}, got)
+
+	// Blocks with increasing first values that overlap
+	overlapping := NewSequenceReader()
+	assert.NoError(overlapping.Load(PackDeltaUint32(PackDeltaUint32(nil, []uint32{1, 100}), []uint32{50, 60})))
+	got, err = IntersectSequences(overlapping, pack([]uint32{100}), nil)
+	assert.NoError(err)
+	assert.Equal([]uint32{100}, got)
+	got, err = IntersectSequences(pack([]uint32{60, 100}), overlapping, nil)
+	assert.NoError(err)
+	assert.Equal([]uint32{60, 100}, got)
+
+	_, err = IntersectSequences(NewSequenceReader(), unsorted, nil)
+	assert.ErrorIs(err, ErrNotLoaded)
+}
+
+func TestIntersectContainers(t *testing.T) {
+	assert := assert.New(t)
+	rng := rand.New(rand.NewSource(3977))
+
+	pack := func(values []uint32) []byte {
+		var buf []byte
+		for off := 0; off < len(values); off += blockSize {
+			buf = PackDeltaUint32(buf, values[off:min(off+blockSize, len(values))])
+		}
+		return buf
+	}
+	load := func(buf []byte) *SequenceReader {
+		r := NewSequenceReader()
+		assert.NoError(r.Load(buf))
+		return r
+	}
+	loadIndexed := func(buf []byte) *SequenceReader {
+		idx, err := NewBlockIndex(buf)
+		assert.NoError(err)
+		r := NewSequenceReader()
+		assert.NoError(r.LoadIndexed(buf, idx))
+		return r
+	}
+	unpack := func(buf []byte) []uint32 {
+		r := load(buf)
+		assert.True(r.IsSorted())
+		var values []uint32
+		for off := 0; off < len(buf); {
+			header, _, _, err := readHeader(buf[off:])
+			assert.NoError(err)
+			assert.NotZero(header&headerRangeFlag, "range record")
+			n, err := BlockLength(buf[off:])
+			assert.NoError(err)
+			assert.NoError(VerifyBlock(buf[off : off+n]))
+			off += n
+		}
+		for v, _, ok := r.Next(); ok; v, _, ok = r.Next() {
+			values = append(values, v)
+		}
+		return values
+	}
+	for round := range 20 {
+		a := genPostings(rng, rng.Intn(20*blockSize), uint32(1+rng.Intn(40)))
+		b := genPostings(rng, rng.Intn(5*blockSize), uint32(1+rng.Intn(200)))
+		// Shift b to overlap only a part of a
+		shift := uint32(rng.Intn(10 * blockSize))
+		for i := range b {
+			b[i] += shift
+		}
+		want := intersectNaive(a, b)
+		for _, r := range [][2]*SequenceReader{
+			{load(pack(a)), load(pack(b))},
+			{loadIndexed(pack(a)), loadIndexed(pack(b))},
+			{load(pack(b)), loadIndexed(pack(a))},
+		} {
+			got, err := IntersectContainers(nil, r[0], r[1])
+			assert.NoError(err)
+			if len(want) == 0 {
+				assert.Empty(got, "round %d", round)
+			} else {
+				assert.Equal(want, unpack(got), "round %d", round)
+			}
+		}
+	}
+
+	// Duplicates at block boundaries
+	a := make([]uint32, blockSize+2)
+	for i := range a {
+		a[i] = 5
+	}
+	a[blockSize+1] = 7
+	got, err := IntersectContainers(nil, load(pack(a)), load(pack([]uint32{5, 5, 7, 8})))
+	assert.NoError(err)
+	assert.Equal([]uint32{5, 5, 7}, unpack(got))
+
+	// Blocks without an overlapping block on the other side are never decoded
+	left := load(pack(genPostings(rng, 4*blockSize, 10)))
+	right := load(pack([]uint32{100000, 100001}))
+	got, err = IntersectContainers([]byte{1}, left, right)
+	assert.NoError(err)
+	assert.Equal([]byte{1}, got)
+	assert.Equal(-1, left.block)
+	assert.Equal(-1, right.block)
+
+	// Unsorted sequences are intersected as sets of their values
+	unsorted := load(PackUint32(nil, []uint32{3, 2, 1}))
+	got, err = IntersectContainers(nil, load(pack([]uint32{1, 2})), unsorted)
+	assert.NoError(err)
+	assert.Equal([]uint32{1, 2}, unpack(got))
+
+	_, err = IntersectContainers(nil, NewSequenceReader(), unsorted)
+	assert.ErrorIs(err, ErrNotLoaded)
+}
+
+func BenchmarkIntersect(b *testing.B) {
+	rng := rand.New(rand.NewSource(4056))
+	ra, _ := loadReader(PackDeltaUint32(nil, genPostings(rng, blockSize, 2)))
+	rb, _ := loadReader(PackDeltaUint32(nil, genPostings(rng, 16, 16)))
+	dst := make([]uint32, 0, blockSize)
+	b.ReportAllocs()
+	for range b.N {
+		dst, _ = Intersect(rb, ra, dst[:0])
+	}
+}
@@ -0,0 +1,403 @@
+package fastpfor
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+)
+
+// JavaFastPFOR reads and writes the integer layout of JavaFastPFOR's
+// Composition(FastPFOR, VariableByte), the codec combination JVM search systems
+// use for posting lists, so both sides can exchange compressed lists without
+// re-encoding. The encoding is a sequence of 32-bit words (a Java int[]):
+//
+//	count   number of values in FastPFOR blocks (a multiple of the block size),
+//	        0 if there are none
+//	pages   one page per 65536 values of the blocks
+//	tail    the remaining values in VariableByte, padded to whole words
+//
+// Each page is:
+//
+//	offset      words from this word to the metadata
+//	packed      per block, the values at its bit width b in 32-value groups of
+//	            b words (value i at bits i*b, least significant bit first)
+//	byteSize    number of metadata bytes
+//	metadata    per block b, exception count, and if there are exceptions their
+//	            maximum bit width and positions (one byte each), packed
+//	            little-endian into words
+//	bitmap      bit w-1 is set if there are exceptions of maximum width w
+//	exceptions  per set bit w: their count and their high bits packed at width
+//	            w-b, in the order of the blocks
+//
+// Exceptions with maximum width b+1 only store their position. The bit widths
+// and exceptions are chosen with JavaFastPFOR's cost model, so encodings match
+// the ones written by JavaFastPFOR. The total number of values is not part of
+// the layout; as in JavaFastPFOR, the decoder needs the number of words.
+//
+// Unlike the block format of this package, the encoding carries no header flags:
+// both sides must agree on the block size and on delta coding.
+type JavaFastPFOR struct {
+	// Block128 selects 128-value blocks (FastPFOR128) instead of 256-value
+	// blocks (FastPFOR, the JavaFastPFOR default).
+	Block128 bool
+	// Delta stores differences to the preceding value (as computed by
+	// JavaFastPFOR's Delta.delta before compressing), which suits sorted values.
+	Delta bool
+}
+
+const (
+	javaPageSize        = 65536
+	javaExceptionCost   = 8 // bits per exception position
+	javaMaxBitWidthCost = 8 // bits for the maximum width of a block with exceptions
+)
+
+func (j JavaFastPFOR) blockSize() int {
+	if j.Block128 {
+		return 128
+	}
+	return 256
+}
+
+// Encode encodes values and appends the words to dst. The values are not modified.
+func (j JavaFastPFOR) Encode(dst []uint32, values []uint32) []uint32 {
+	if len(values) == 0 {
+		return dst
+	}
+	if j.Delta {
+		deltas := make([]uint32, len(values))
+		var prev uint32
+		for i, v := range values {
+			deltas[i], prev = v-prev, v
+		}
+		values = deltas
+	}
+
+	size := j.blockSize()
+	blocked := len(values) / size * size
+	dst = append(dst, uint32(blocked))
+	for off := 0; off < blocked; off += javaPageSize {
+		dst = j.encodePage(dst, values[off:min(off+javaPageSize, blocked)])
+	}
+	return appendJavaVariableByte(dst, values[blocked:])
+}
+
+// encodePage appends the page of the blocks in values.
+func (j JavaFastPFOR) encodePage(dst []uint32, values []uint32) []uint32 {
+	size := j.blockSize()
+	headerPos := len(dst)
+	dst = append(dst, 0)
+
+	var meta []byte
+	var high [33][]uint32 // exception high bits by maximum width minus bit width
+	for off := 0; off < len(values); off += size {
+		block := values[off : off+size]
+		b, exceptions, maxBits := javaBestBitWidth(block)
+		meta = append(meta, byte(b), byte(exceptions))
+		if exceptions > 0 {
+			meta = append(meta, byte(maxBits))
+			for i, v := range block {
+				if v>>b != 0 {
+					meta = append(meta, byte(i))
+					high[maxBits-b] = append(high[maxBits-b], v>>b)
+				}
+			}
+		}
+		for i := 0; i < size; i += 32 {
+			dst = appendJavaPacked(dst, block[i:i+32], b)
+		}
+	}
+
+	dst[headerPos] = uint32(len(dst) - headerPos)
+	dst = append(dst, uint32(len(meta)))
+	for len(meta)%4 != 0 {
+		meta = append(meta, 0)
+	}
+	for i := 0; i < len(meta); i += 4 {
+		dst = append(dst, binary.LittleEndian.Uint32(meta[i:]))
+	}
+
+	var bitmap uint32
+	for w := 2; w <= 32; w++ {
+		if len(high[w]) > 0 {
+			bitmap |= 1 << (w - 1)
+		}
+	}
+	dst = append(dst, bitmap)
+	for w := 2; w <= 32; w++ {
+		if len(high[w]) == 0 {
+			continue
+		}
+		dst = append(dst, uint32(len(high[w])))
+		var group [32]uint32
+		for i := 0; i < len(high[w]); i += 32 {
+			n := copy(group[:], high[w][i:])
+			clear(group[n:])
+			start := len(dst)
+			dst = appendJavaPacked(dst, group[:], w)
+			dst = dst[:start+(n*w+31)/32] // the last group only keeps the words it uses
+		}
+	}
+	return dst
+}
+
+// javaBestBitWidth selects the bit width of a block like JavaFastPFOR: the
+// width minimizing the packed size plus the cost of the exceptions. It returns
+// the width, the number of exceptions and the width of the largest value.
+func javaBestBitWidth(block []uint32) (b, exceptions, maxBits int) {
+	var freqs [33]int
+	for _, v := range block {
+		freqs[bits.Len32(v)]++
+	}
+	maxBits = 32
+	for freqs[maxBits] == 0 {
+		maxBits--
+	}
+	b = maxBits
+	bestCost := maxBits * len(block)
+	count := 0
+	for w := maxBits - 1; w >= 0; w-- {
+		count += freqs[w+1]
+		if count == len(block) {
+			break
+		}
+		cost := count*javaExceptionCost + count*(maxBits-w) + w*len(block) + javaMaxBitWidthCost
+		if maxBits-w == 1 {
+			cost -= count // only the positions are stored
+		}
+		if cost < bestCost {
+			bestCost, b, exceptions = cost, w, count
+		}
+	}
+	return b, exceptions, maxBits
+}
+
+// appendJavaPacked appends the 32 values packed at bitWidth into bitWidth words.
+// Value i occupies bits i*bitWidth onwards, least significant bit first.
+func appendJavaPacked(dst []uint32, values []uint32, bitWidth int) []uint32 {
+	start := len(dst)
+	dst = append(dst, make([]uint32, bitWidth)...)
+	if bitWidth == 0 {
+		return dst
+	}
+	out := dst[start:]
+	mask := uint32(1<<bitWidth - 1)
+	for i, v := range values {
+		v &= mask
+		pos := i * bitWidth
+		word, shift := pos>>5, pos&31
+		out[word] |= v << shift
+		if shift+bitWidth > 32 {
+			out[word+1] |= v >> (32 - shift)
+		}
+	}
+	return dst
+}
+
+// unpackJavaPacked is the inverse of appendJavaPacked for the first len(dst)
+// values. words must hold the (len(dst)*bitWidth+31)/32 words used by them.
+func unpackJavaPacked(dst []uint32, words []uint32, bitWidth int) {
+	if bitWidth == 0 {
+		clear(dst)
+		return
+	}
+	mask := uint32(1<<bitWidth - 1)
+	for i := range dst {
+		pos := i * bitWidth
+		word, shift := pos>>5, pos&31
+		v := words[word] >> shift
+		if shift+bitWidth > 32 {
+			v |= words[word+1] << (32 - shift)
+		}
+		dst[i] = v & mask
+	}
+}
+
+// appendJavaVariableByte appends values in JavaFastPFOR's VariableByte format:
+// 7 bits per byte, least significant group first, with the high bit set on the
+// last byte of every value. The bytes are packed little-endian into words.
+func appendJavaVariableByte(dst []uint32, values []uint32) []uint32 {
+	var buf []byte
+	for _, v := range values {
+		for v >= 0x80 {
+			buf = append(buf, byte(v&0x7f))
+			v >>= 7
+		}
+		buf = append(buf, byte(v)|0x80)
+	}
+	for len(buf)%4 != 0 {
+		buf = append(buf, 0)
+	}
+	for i := 0; i < len(buf); i += 4 {
+		dst = append(dst, binary.LittleEndian.Uint32(buf[i:]))
+	}
+	return dst
+}
+
+// Decode decodes the words of an encoding into dst (which will be resized as
+// needed). All of words is decoded, as the layout does not delimit the
+// VariableByte tail.
+//
+// Returns ErrInvalidBuffer if the words are not a valid encoding.
+func (j JavaFastPFOR) Decode(dst []uint32, words []uint32) ([]uint32, error) {
+	dst = dst[:0]
+	if len(words) == 0 {
+		return dst, nil
+	}
+	size := j.blockSize()
+	blocked := int(words[0])
+	// Every block takes at least two metadata bytes, reject bogus counts before allocating
+	if blocked%size != 0 || blocked/size > 2*len(words) {
+		return nil, fmt.Errorf("%w: invalid JavaFastPFOR value count %d", ErrInvalidBuffer, blocked)
+	}
+	if cap(dst) < blocked {
+		dst = make([]uint32, 0, blocked+size)
+	}
+	off := 1
+	for len(dst) < blocked {
+		var err error
+		n := min(javaPageSize, blocked-len(dst))
+		dst, off, err = j.decodePage(dst, words, off, n)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dst, err := decodeJavaVariableByte(dst, words[off:])
+	if err != nil {
+		return nil, err
+	}
+	if j.Delta {
+		var prev uint32
+		for i, d := range dst {
+			prev += d
+			dst[i] = prev
+		}
+	}
+	return dst, nil
+}
+
+// decodePage appends the n values of the page at words[off:] to dst and returns
+// the offset after the page.
+func (j JavaFastPFOR) decodePage(dst []uint32, words []uint32, off, n int) ([]uint32, int, error) {
+	size := j.blockSize()
+	if off >= len(words) || words[off] == 0 || uint64(words[off]) > uint64(len(words)-off-1) {
+		return nil, 0, fmt.Errorf("%w: JavaFastPFOR page truncated", ErrInvalidBuffer)
+	}
+	packed := words[off+1 : off+int(words[off])]
+	pos := off + int(words[off])
+
+	byteSize := int(words[pos])
+	pos++
+	metaWords := (byteSize + 3) / 4
+	if metaWords > len(words)-pos {
+		return nil, 0, fmt.Errorf("%w: JavaFastPFOR metadata truncated (%d bytes)", ErrInvalidBuffer, byteSize)
+	}
+	meta := make([]byte, 4*metaWords)
+	for i, w := range words[pos : pos+metaWords] {
+		binary.LittleEndian.PutUint32(meta[4*i:], w)
+	}
+	meta = meta[:byteSize]
+	pos += metaWords
+
+	if pos >= len(words) {
+		return nil, 0, fmt.Errorf("%w: JavaFastPFOR exception bitmap missing", ErrInvalidBuffer)
+	}
+	bitmap := words[pos]
+	pos++
+	var high [33][]uint32
+	for w := 2; w <= 32; w++ {
+		if bitmap&(1<<(w-1)) == 0 {
+			continue
+		}
+		if pos >= len(words) {
+			return nil, 0, fmt.Errorf("%w: JavaFastPFOR exceptions truncated", ErrInvalidBuffer)
+		}
+		count := int(words[pos])
+		pos++
+		used := int((uint64(count)*uint64(w) + 31) / 32)
+		if count > n || used > len(words)-pos {
+			return nil, 0, fmt.Errorf("%w: JavaFastPFOR exceptions of width %d truncated", ErrInvalidBuffer, w)
+		}
+		high[w] = make([]uint32, count)
+		unpackJavaPacked(high[w], words[pos:pos+used], w)
+		pos += used
+	}
+
+	start := len(dst)
+	dst = append(dst, make([]uint32, n)...)
+	var next [33]int // next exception of each width
+	for blockStart := start; blockStart < start+n; blockStart += size {
+		if len(meta) < 2 {
+			return nil, 0, fmt.Errorf("%w: JavaFastPFOR metadata truncated", ErrInvalidBuffer)
+		}
+		b, exceptions := int(meta[0]), int(meta[1])
+		meta = meta[2:]
+		if b > 32 {
+			return nil, 0, fmt.Errorf("%w: invalid bit width %d", ErrInvalidBuffer, b)
+		}
+		if b*size/32 > len(packed) {
+			return nil, 0, fmt.Errorf("%w: JavaFastPFOR packed values truncated", ErrInvalidBuffer)
+		}
+		unpackJavaPacked(dst[blockStart:blockStart+size], packed, b)
+		packed = packed[b*size/32:]
+		if exceptions == 0 {
+			continue
+		}
+
+		if len(meta) < 1+exceptions {
+			return nil, 0, fmt.Errorf("%w: JavaFastPFOR exception positions truncated", ErrInvalidBuffer)
+		}
+		maxBits := int(meta[0])
+		positions := meta[1 : 1+exceptions]
+		meta = meta[1+exceptions:]
+		if maxBits <= b || maxBits > 32 {
+			return nil, 0, fmt.Errorf("%w: invalid exception width %d for bit width %d",
+				ErrInvalidBuffer, maxBits, b)
+		}
+		index := maxBits - b
+		for _, p := range positions {
+			if int(p) >= size {
+				return nil, 0, fmt.Errorf("%w: exception position %d out of range", ErrInvalidBuffer, p)
+			}
+			if index == 1 {
+				dst[blockStart+int(p)] |= 1 << b
+				continue
+			}
+			if next[index] >= len(high[index]) {
+				return nil, 0, fmt.Errorf("%w: JavaFastPFOR exceptions of width %d exhausted", ErrInvalidBuffer, index)
+			}
+			dst[blockStart+int(p)] |= high[index][next[index]] << b
+			next[index]++
+		}
+	}
+	if len(packed) != 0 {
+		return nil, 0, fmt.Errorf("%w: JavaFastPFOR page has %d unused words", ErrInvalidBuffer, len(packed))
+	}
+	return dst, pos, nil
+}
+
+// decodeJavaVariableByte appends the values of the VariableByte words to dst.
+func decodeJavaVariableByte(dst []uint32, words []uint32) ([]uint32, error) {
+	var v uint32
+	shift := 0
+	for _, w := range words {
+		for range 4 {
+			c := byte(w)
+			w >>= 8
+			if shift > 28 || shift == 28 && c&0x70 != 0 {
+				return nil, fmt.Errorf("%w: VariableByte value overflows uint32", ErrInvalidBuffer)
+			}
+			v |= uint32(c&0x7f) << shift
+			if c&0x80 != 0 {
+				dst = append(dst, v)
+				v, shift = 0, 0
+			} else {
+				shift += 7
+			}
+		}
+	}
+	if v != 0 {
+		return nil, fmt.Errorf("%w: VariableByte value truncated", ErrInvalidBuffer)
+	}
+	return dst, nil
+}
@@ -0,0 +1,181 @@
+package fastpfor
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestJavaFastPFORLayout checks the words of a small encoding against the
+// JavaFastPFOR layout.
+func TestJavaFastPFORLayout(t *testing.T) {
+	assert := assert.New(t)
+
+	values := make([]uint32, 256+3)
+	for i := range 256 {
+		values[i] = uint32(i)
+	}
+	values[256], values[257], values[258] = 1, 300, 0
+
+	words := JavaFastPFOR{}.Encode(nil, values)
+	assert.Len(words, 1+1+64+1+1+1+1)
+	assert.Equal(uint32(256), words[0]) // values in blocks
+	assert.Equal(uint32(65), words[1])  // offset to the metadata
+	assert.Equal(uint32(0x03020100), words[2])
+	assert.Equal(uint32(0xfffefdfc), words[65])
+	assert.Equal(uint32(2), words[66])          // metadata bytes
+	assert.Equal(uint32(0x00000008), words[67]) // b=8, no exceptions
+	assert.Equal(uint32(0), words[68])          // exception bitmap
+	// VariableByte: 0x81 | 0x2c 0x82 | 0x80
+	assert.Equal([]uint32{0x80822c81}, words[69:])
+
+	decoded, err := JavaFastPFOR{}.Decode(nil, words)
+	assert.NoError(err)
+	assert.Equal(values, decoded)
+
+	// Fewer values than a block are stored in VariableByte only
+	assert.Equal([]uint32{0, 0x8281}, JavaFastPFOR{Block128: true}.Encode(nil, []uint32{1, 2}))
+	assert.Empty(JavaFastPFOR{}.Encode(nil, nil))
+}
+
+// TestJavaFastPFORExceptions verifies exceptions are patched at the selected
+// width, including exceptions one bit above it that only store positions.
+func TestJavaFastPFORExceptions(t *testing.T) {
+	assert := assert.New(t)
+
+	block := make([]uint32, 128)
+	for i := range block {
+		block[i] = uint32(i % 8)
+	}
+	block[5], block[77] = 1<<30, mathMaxUint32
+	b, exceptions, maxBits := javaBestBitWidth(block)
+	assert.Equal(3, b)
+	assert.Equal(2, exceptions)
+	assert.Equal(32, maxBits)
+
+	block[5], block[77] = 8, 15
+	b, exceptions, maxBits = javaBestBitWidth(block)
+	assert.Equal(3, b)
+	assert.Equal(2, exceptions)
+	assert.Equal(4, maxBits)
+
+	j := JavaFastPFOR{Block128: true}
+	words := j.Encode(nil, block)
+	// count, offset, 12 packed words, metadata size, 2 metadata words, empty bitmap
+	assert.Len(words, 1+1+12+1+2+1)
+	assert.Equal(uint32(5), words[14]) // b, count, max width, 2 positions
+	assert.Equal(uint32(0), words[17])
+	decoded, err := j.Decode(nil, words)
+	assert.NoError(err)
+	assert.Equal(block, decoded)
+
+	zeros := make([]uint32, 128)
+	b, exceptions, maxBits = javaBestBitWidth(zeros)
+	assert.Equal(0, b)
+	assert.Equal(0, exceptions)
+	assert.Equal(0, maxBits)
+
+	zeros[9] = 1 << 20
+	b, exceptions, _ = javaBestBitWidth(zeros)
+	assert.Equal(0, b)
+	assert.Equal(1, exceptions)
+}
+
+// TestJavaFastPFORRoundTrip covers both block sizes, delta coding, pages and the
+// VariableByte tail.
+func TestJavaFastPFORRoundTrip(t *testing.T) {
+	assert := assert.New(t)
+
+	rng := rand.New(rand.NewSource(7))
+	outliers := make([]uint32, 3000)
+	for i := range outliers {
+		outliers[i] = uint32(rng.Intn(64))
+		if rng.Intn(30) == 0 {
+			outliers[i] = rng.Uint32() >> rng.Intn(28)
+		}
+	}
+	sorted := slices.Clone(outliers)
+	slices.Sort(sorted)
+	inputs := map[string][]uint32{
+		"single":   {42},
+		"block":    genMixed(256),
+		"outliers": outliers,
+		"sorted":   sorted,
+		"zeros":    make([]uint32, 1000),
+		"max":      repeatValues([]uint32{mathMaxUint32}, 300),
+		"pages":    genMonotonic(2*javaPageSize + 700),
+	}
+	for _, j := range []JavaFastPFOR{{}, {Block128: true}, {Delta: true}, {Block128: true, Delta: true}} {
+		for name, values := range inputs {
+			input := slices.Clone(values)
+			words := j.Encode([]uint32{0xAA}, input)
+			assert.Equal(values, input, "%s %+v: input modified", name, j)
+			assert.Equal(uint32(0xAA), words[0])
+
+			decoded, err := j.Decode([]uint32{1, 2, 3}, words[1:])
+			if assert.NoError(err, "%s %+v", name, j) {
+				assert.Equal(values, decoded, "%s %+v", name, j)
+			}
+		}
+	}
+
+	// Delta coding pays off for sorted values
+	plain := JavaFastPFOR{}.Encode(nil, sorted)
+	delta := JavaFastPFOR{Delta: true}.Encode(nil, sorted)
+	assert.Less(len(delta), len(plain))
+}
+
+// TestJavaFastPFORInvalid verifies corrupt encodings are rejected without panics.
+func TestJavaFastPFORInvalid(t *testing.T) {
+	assert := assert.New(t)
+
+	decoded, err := JavaFastPFOR{}.Decode(nil, nil)
+	assert.NoError(err)
+	assert.Empty(decoded)
+
+	for _, words := range [][]uint32{
+		{100},                 // count not a multiple of the block size
+		{1 << 30, 1, 2},       // count exceeding the words
+		{256},                 // missing page
+		{256, 0},              // zero offset
+		{256, 5, 0},           // offset beyond the words
+		{0, 0x7f7f7f7f, 0xff}, // VariableByte overflow
+		{0, 0x7f},             // VariableByte truncated
+	} {
+		_, err := JavaFastPFOR{}.Decode(nil, words)
+		assert.ErrorIs(err, ErrInvalidBuffer, "%x", words)
+	}
+
+	rng := rand.New(rand.NewSource(3))
+	values := make([]uint32, 1000)
+	for i := range values {
+		values[i] = rng.Uint32() >> rng.Intn(32)
+	}
+	for _, j := range []JavaFastPFOR{{}, {Block128: true}} {
+		words := j.Encode(nil, values)
+		tail := appendJavaVariableByte(nil, values[len(values)/j.blockSize()*j.blockSize():])
+		for range 2000 {
+			corrupt := slices.Clone(words)
+			corrupt[rng.Intn(len(corrupt))] ^= 1 << rng.Intn(32)
+			_, _ = j.Decode(nil, corrupt[:rng.Intn(len(corrupt)+1)])
+		}
+		// Truncating the VariableByte tail cannot be detected
+		for n := 1; n < len(words)-len(tail); n++ {
+			_, err := j.Decode(nil, words[:n])
+			assert.Error(err, "%+v truncated to %d words", j, n)
+		}
+	}
+}
+
+func BenchmarkJavaFastPFORDecode(b *testing.B) {
+	values := genMixed(8192)
+	words := JavaFastPFOR{}.Encode(nil, values)
+	dst := make([]uint32, 0, len(values))
+	b.ReportAllocs()
+	for range b.N {
+		dst, _ = JavaFastPFOR{}.Decode(dst, words)
+	}
+	resultU32 = dst
+}
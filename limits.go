@@ -0,0 +1,91 @@
+package fastpfor
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// DecodeLimits bound the work decoders spend on a single buffer, so services
+// decoding untrusted input can cap the worst-case CPU and memory per request.
+// A zero field leaves the corresponding quantity limited by the format only.
+type DecodeLimits struct {
+	// MaxExceptions is the largest exception count honored per block.
+	MaxExceptions int
+	// MaxSVBLen is the largest length in bytes of the StreamVByte data of the
+	// exception area of a block.
+	MaxSVBLen int
+	// MaxBlocks is the largest number of blocks of a concatenation or frame
+	// (UnpackAllUint32, SequenceReader, NewBlockIndex, ReadBlockIndex and
+	// NewSequence64), checked before the blocks are decoded or allocated for.
+	MaxBlocks int
+}
+
+// ErrLimitExceeded is returned when a buffer exceeds one of the DecodeLimits.
+// Use errors.As to find out which limit was hit:
+//
+//	var limit *ErrLimitExceeded
+//	if errors.As(err, &limit) {
+//	    fmt.Printf("%s: %d > %d\n", limit.Limit, limit.Value, limit.Max)
+//	}
+type ErrLimitExceeded struct {
+	Limit string // name of the DecodeLimits field
+	Value int    // value found in the buffer
+	Max   int    // configured limit
+}
+
+func (e *ErrLimitExceeded) Error() string {
+	return fmt.Sprintf("fastpfor: decode limit %s exceeded (%d > %d)", e.Limit, e.Value, e.Max)
+}
+
+// decodeLimits holds the active limits, or nil if there are none.
+var decodeLimits atomic.Pointer[DecodeLimits]
+
+// SetDecodeLimits installs limits for all decoders and returns the previously
+// active ones. The zero DecodeLimits removes all limits:
+//
+//	defer fastpfor.SetDecodeLimits(fastpfor.SetDecodeLimits(limits))
+func SetDecodeLimits(limits DecodeLimits) DecodeLimits {
+	next := &limits
+	if limits == (DecodeLimits{}) {
+		next = nil
+	}
+	if prev := decodeLimits.Swap(next); prev != nil {
+		return *prev
+	}
+	return DecodeLimits{}
+}
+
+// checkPatchLimits checks the exception area layout l against the active limits.
+func checkPatchLimits(l patchLayout) error {
+	limits := decodeLimits.Load()
+	if limits == nil {
+		return nil
+	}
+	if limits.MaxExceptions > 0 && l.excCount > limits.MaxExceptions {
+		return &ErrLimitExceeded{Limit: "MaxExceptions", Value: l.excCount, Max: limits.MaxExceptions}
+	}
+	if limits.MaxSVBLen > 0 && l.svbLen > limits.MaxSVBLen {
+		return &ErrLimitExceeded{Limit: "MaxSVBLen", Value: l.svbLen, Max: limits.MaxSVBLen}
+	}
+	return nil
+}
+
+// checkBlockLimit checks the number of blocks of a concatenation or frame against
+// the active limits.
+func checkBlockLimit(numBlocks int) error {
+	if limits := decodeLimits.Load(); limits != nil && limits.MaxBlocks > 0 && numBlocks > limits.MaxBlocks {
+		return &ErrLimitExceeded{Limit: "MaxBlocks", Value: numBlocks, Max: limits.MaxBlocks}
+	}
+	return nil
+}
+
+// patchError wraps an error from reading the exception area as ErrInvalidBuffer,
+// passing *ErrLimitExceeded through unchanged.
+func patchError(err error) error {
+	var limitErr *ErrLimitExceeded
+	if errors.As(err, &limitErr) {
+		return err
+	}
+	return fmt.Errorf("%w: %v", ErrInvalidBuffer, err)
+}
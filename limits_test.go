@@ -0,0 +1,98 @@
+package fastpfor
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDecodeLimits verifies that all decoders report exceeded limits with
+// *ErrLimitExceeded and decode normally within the limits.
+func TestDecodeLimits(t *testing.T) {
+	assert := assert.New(t)
+	t.Cleanup(func() { SetDecodeLimits(DecodeLimits{}) })
+
+	assert.Equal(DecodeLimits{}, SetDecodeLimits(DecodeLimits{MaxBlocks: 3}))
+	assert.Equal(DecodeLimits{MaxBlocks: 3}, SetDecodeLimits(DecodeLimits{}))
+	assert.Nil(decodeLimits.Load())
+
+	assertLimit := func(err error, limit string, msgAndArgs ...any) {
+		var limitErr *ErrLimitExceeded
+		if assert.True(errors.As(err, &limitErr), msgAndArgs...) {
+			assert.Equal(limit, limitErr.Limit, msgAndArgs...)
+		}
+		assert.NotErrorIs(err, ErrInvalidBuffer, msgAndArgs...)
+	}
+
+	values := genDataWithLargeExceptions()
+	buf := PackUint32(nil, values)
+	_, _, patch, err := SplitEncoded(buf)
+	assert.NoError(err)
+	excCount := int(patch[0])
+	svbLen := int(bo.Uint16(patch[1:3]) & patchSVBLenMask)
+
+	for _, tc := range []struct {
+		limits DecodeLimits
+		name   string
+	}{
+		{DecodeLimits{MaxExceptions: excCount - 1}, "MaxExceptions"},
+		{DecodeLimits{MaxSVBLen: svbLen - 1}, "MaxSVBLen"},
+	} {
+		SetDecodeLimits(tc.limits)
+		_, err := UnpackUint32(nil, buf)
+		assertLimit(err, tc.name, "UnpackUint32")
+		_, _, err = UnpackUint32WithLength(nil, buf)
+		assertLimit(err, tc.name, "UnpackUint32WithLength")
+		_, err = UnpackFirstN(nil, buf, 10)
+		assertLimit(err, tc.name, "UnpackFirstN")
+		_, err = BlockLength(buf)
+		assertLimit(err, tc.name, "BlockLength")
+		assertLimit(NewReader().Load(buf), tc.name, "Reader.Load")
+		assertLimit(NewReader().LoadLazy(buf), tc.name, "Reader.LoadLazy")
+		assertLimit(NewSlimReader().Load(buf), tc.name, "SlimReader.Load")
+		assertLimit(VerifyBlock(buf), tc.name, "VerifyBlock")
+	}
+
+	// At the limits
+	SetDecodeLimits(DecodeLimits{MaxExceptions: excCount, MaxSVBLen: svbLen, MaxBlocks: 2})
+	decoded, err := UnpackUint32(nil, buf)
+	assert.NoError(err)
+	assert.Equal(values, decoded)
+
+	// Blocks per concatenation or frame
+	stream := genMonotonic(3 * blockSize)
+	frame := PackAllUint32(nil, stream)
+	_, _, err = UnpackAllUint32(nil, frame)
+	assertLimit(err, "MaxBlocks", "UnpackAllUint32")
+	blocks := sequenceBlocks(stream, PackDeltaUint32)
+	assertLimit(NewSequenceReader().Load(blocks), "MaxBlocks", "SequenceReader.Load")
+	_, err = NewBlockIndex(blocks)
+	assertLimit(err, "MaxBlocks", "NewBlockIndex")
+	wide := make([]uint64, 3*blockSize)
+	for i := range wide {
+		wide[i] = uint64(i) << 20
+	}
+	seq64, err := PackSequence64(nil, wide)
+	assert.NoError(err)
+	_, err = NewSequence64(seq64)
+	assertLimit(err, "MaxBlocks", "NewSequence64")
+
+	SetDecodeLimits(DecodeLimits{})
+	idx, err := NewBlockIndex(blocks)
+	assert.NoError(err)
+	stored := idx.AppendBinary(nil)
+	SetDecodeLimits(DecodeLimits{MaxBlocks: 2})
+	_, _, err = ReadBlockIndex(stored)
+	assertLimit(err, "MaxBlocks", "ReadBlockIndex")
+	assertLimit(NewSequenceReader().LoadIndexed(blocks, idx), "MaxBlocks", "SequenceReader.LoadIndexed")
+
+	SetDecodeLimits(DecodeLimits{MaxBlocks: 3})
+	decoded, _, err = UnpackAllUint32(nil, frame)
+	assert.NoError(err)
+	assert.Equal(stream, decoded)
+	assert.NoError(NewSequenceReader().Load(blocks))
+
+	assert.Equal("fastpfor: decode limit MaxBlocks exceeded (4 > 3)",
+		(&ErrLimitExceeded{Limit: "MaxBlocks", Value: 4, Max: 3}).Error())
+}
@@ -0,0 +1,294 @@
+package fastpfor
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+)
+
+// Lucene blocks follow the postings format of Lucene 9 (Lucene90 up to
+// Lucene99), which encodes blocks of 128 values with ForUtil and PForUtil:
+//
+//	PForUtil     token    byte, exception count (3 bits) << 5 | bit width (5 bits)
+//	             payload  ForUtil payload at the bit width, or a vlong holding
+//	                      all values if the bit width is 0
+//	             patches  per exception its position and the bits above the bit
+//	                      width (one byte each)
+//	ForDeltaUtil width    byte, bit width of the deltas, 0 if all deltas are 1
+//	             payload  ForUtil payload of the deltas at the bit width
+//
+// The ForUtil payload holds 128 values at b bits in 2*b little-endian longs. The
+// values are split into lanes of the smallest primitive (8, 16 or 32 bits) that
+// holds b bits: value i goes to lane i/(2p) of row i%(2p) for primitive p, lane
+// 0 being the most significant. Within each lane, rows are packed from the top
+// of the longs in groups of 2*b values; the bits left over at the bottom of
+// the longs hold the remaining values as a bit stream, most significant bit first.
+
+// lucenePFORMaxExceptions is the maximum number of exceptions of a PForUtil block.
+const lucenePFORMaxExceptions = 7
+
+// PackLucenePFOR encodes exactly 128 values like Lucene's PForUtil (as used for
+// term frequencies and positions) and appends the block to dst. Up to 7 values
+// are patched with at most 8 bits above the bit width. The input slice is not mutated.
+//
+// Returns ErrInvalidBlockLength unless there are 128 values and ErrValueOutOfRange
+// if more than 7 values need 32 bits, as the token stores bit widths up to 31.
+func PackLucenePFOR(dst []byte, values []uint32) ([]byte, error) {
+	if len(values) != blockSize {
+		return dst, fmt.Errorf("%w: Lucene blocks hold %d values, got %d", ErrInvalidBlockLength, blockSize, len(values))
+	}
+
+	// The 8 largest values in descending order
+	var top [lucenePFORMaxExceptions + 1]uint32
+	for _, v := range values {
+		if v <= top[len(top)-1] {
+			continue
+		}
+		i := len(top) - 1
+		for ; i > 0 && top[i-1] < v; i-- {
+			top[i] = top[i-1]
+		}
+		top[i] = v
+	}
+	maxBits := luceneBitsRequired(top[0])
+	// The patches are bytes, so they cover at most 8 bits
+	bitWidth := max(luceneBitsRequired(top[len(top)-1]), maxBits-8)
+	if bitWidth > 31 {
+		return dst, fmt.Errorf("%w: more than %d values need 32 bits", ErrValueOutOfRange, lucenePFORMaxExceptions)
+	}
+
+	var block [blockSize]uint32
+	copy(block[:], values)
+	var patches []byte
+	maxUnpatched := uint32(1)<<bitWidth - 1
+	for i, v := range block {
+		if v > maxUnpatched {
+			patches = append(patches, byte(i), byte(v>>bitWidth))
+			block[i] &= maxUnpatched
+		}
+	}
+	numExceptions := len(patches) / 2
+
+	allEqual := true
+	for _, v := range block[1:] {
+		allEqual = allEqual && v == block[0]
+	}
+	if allEqual && maxBits <= 8 {
+		// All values fit into a byte: the patches hold the exceptions unshifted
+		for i := 1; i < len(patches); i += 2 {
+			patches[i] <<= bitWidth
+		}
+		dst = append(dst, byte(numExceptions<<5))
+		dst = binary.AppendUvarint(dst, uint64(block[0]))
+	} else {
+		dst = append(dst, byte(numExceptions<<5|bitWidth))
+		dst = appendLuceneForUtil(dst, &block, bitWidth)
+	}
+	return append(dst, patches...), nil
+}
+
+// UnpackLucenePFOR decodes a block written by Lucene's PForUtil (or
+// PackLucenePFOR) at the start of buf into dst (which will be resized as needed)
+// and returns the number of bytes consumed from buf.
+//
+// Returns ErrInvalidBuffer if the block is truncated or a patched value exceeds 32 bits.
+func UnpackLucenePFOR(dst []uint32, buf []byte) ([]uint32, int, error) {
+	if len(buf) == 0 {
+		return nil, 0, fmt.Errorf("%w: Lucene block truncated", ErrInvalidBuffer)
+	}
+	token := buf[0]
+	bitWidth, numExceptions := int(token&0x1f), int(token>>5)
+	off := 1
+	dst = ensureUint32Cap(dst, blockSize, blockSize)
+	if bitWidth == 0 {
+		v, n := binary.Uvarint(buf[off:])
+		if n <= 0 || v > uint64(mathMaxUint32) {
+			return nil, 0, fmt.Errorf("%w: invalid Lucene block value", ErrInvalidBuffer)
+		}
+		off += n
+		for i := range dst {
+			dst[i] = uint32(v)
+		}
+	} else {
+		n, err := unpackLuceneForUtil(dst, buf[off:], bitWidth)
+		if err != nil {
+			return nil, 0, err
+		}
+		off += n
+	}
+
+	if len(buf)-off < 2*numExceptions {
+		return nil, 0, fmt.Errorf("%w: Lucene block patches truncated", ErrInvalidBuffer)
+	}
+	for range numExceptions {
+		pos, high := int(buf[off]), uint64(buf[off+1])<<bitWidth
+		if pos >= blockSize || high > uint64(mathMaxUint32) {
+			return nil, 0, fmt.Errorf("%w: invalid Lucene patch at position %d", ErrInvalidBuffer, pos)
+		}
+		dst[pos] |= uint32(high)
+		off += 2
+	}
+	return dst, off, nil
+}
+
+// PackLuceneForDelta encodes exactly 128 non-decreasing values like Lucene's
+// ForDeltaUtil (as used for docIDs) and appends the block to dst. The deltas are
+// taken to the preceding value, starting with base, which is usually the last
+// value of the previous block. The input slice is not mutated.
+//
+// Returns ErrInvalidBlockLength unless there are 128 values and ErrValueOutOfRange
+// if a value is smaller than its predecessor.
+func PackLuceneForDelta(dst []byte, base uint32, values []uint32) ([]byte, error) {
+	if len(values) != blockSize {
+		return dst, fmt.Errorf("%w: Lucene blocks hold %d values, got %d", ErrInvalidBlockLength, blockSize, len(values))
+	}
+	var deltas [blockSize]uint32
+	var orAll uint32
+	allOnes := true
+	prev := base
+	for i, v := range values {
+		if v < prev {
+			return dst, fmt.Errorf("%w: value %d at position %d is smaller than its predecessor",
+				ErrValueOutOfRange, v, i)
+		}
+		deltas[i], prev = v-prev, v
+		orAll |= deltas[i]
+		allOnes = allOnes && deltas[i] == 1
+	}
+	if allOnes {
+		return append(dst, 0), nil
+	}
+	bitWidth := luceneBitsRequired(orAll)
+	dst = append(dst, byte(bitWidth))
+	return appendLuceneForUtil(dst, &deltas, bitWidth), nil
+}
+
+// UnpackLuceneForDelta decodes a block written by Lucene's ForDeltaUtil (or
+// PackLuceneForDelta) at the start of buf into dst (which will be resized as
+// needed), adding the deltas up from base, and returns the number of bytes
+// consumed from buf.
+//
+// Returns ErrInvalidBuffer if the block is truncated and ErrOverflow if the
+// values exceed uint32.
+func UnpackLuceneForDelta(dst []uint32, buf []byte, base uint32) ([]uint32, int, error) {
+	if len(buf) == 0 {
+		return nil, 0, fmt.Errorf("%w: Lucene block truncated", ErrInvalidBuffer)
+	}
+	bitWidth := int(buf[0])
+	off := 1
+	dst = ensureUint32Cap(dst, blockSize, blockSize)
+	if bitWidth == 0 {
+		for i := range dst {
+			dst[i] = 1
+		}
+	} else {
+		if bitWidth > 32 {
+			return nil, 0, fmt.Errorf("%w: invalid bit width %d", ErrInvalidBuffer, bitWidth)
+		}
+		n, err := unpackLuceneForUtil(dst, buf[off:], bitWidth)
+		if err != nil {
+			return nil, 0, err
+		}
+		off += n
+	}
+	sum := uint64(base)
+	for i, d := range dst {
+		sum += uint64(d)
+		if sum > uint64(mathMaxUint32) {
+			return nil, 0, &ErrOverflow{Position: uint8(i)}
+		}
+		dst[i] = uint32(sum)
+	}
+	return dst, off, nil
+}
+
+// luceneBitsRequired returns the number of bits of v like Lucene's
+// PackedInts.bitsRequired, which is at least 1.
+func luceneBitsRequired(v uint32) int {
+	return max(1, bits.Len32(v))
+}
+
+// lucenePrimitive returns the lane width ForUtil uses for bitWidth.
+func lucenePrimitive(bitWidth int) int {
+	switch {
+	case bitWidth <= 8:
+		return 8
+	case bitWidth <= 16:
+		return 16
+	}
+	return 32
+}
+
+// appendLuceneForUtil appends the ForUtil payload of values at bitWidth
+// (1-32). The values must fit into bitWidth bits.
+func appendLuceneForUtil(dst []byte, values *[blockSize]uint32, bitWidth int) []byte {
+	p := lucenePrimitive(bitWidth)
+	rows := 2 * bitWidth // longs in the payload
+	groups := p / bitWidth
+	rest := p - groups*bitWidth // bits per lane left for the bit stream
+	var longs [64]uint64
+	for lane := range 64 / p {
+		laneValues := values[lane*2*p : (lane+1)*2*p]
+		laneShift := 64 - (lane+1)*p
+		var acc uint64
+		accBits, next := 0, groups*rows
+		for r := range rows {
+			var w uint64
+			for g := range groups {
+				w |= uint64(laneValues[g*rows+r]) << (p - (g+1)*bitWidth)
+			}
+			if rest > 0 {
+				for accBits < rest {
+					acc = acc<<bitWidth | uint64(laneValues[next])
+					accBits += bitWidth
+					next++
+				}
+				accBits -= rest
+				w |= acc >> accBits
+				acc &= 1<<accBits - 1
+			}
+			longs[r] |= w << laneShift
+		}
+	}
+	for _, l := range longs[:rows] {
+		dst = binary.LittleEndian.AppendUint64(dst, l)
+	}
+	return dst
+}
+
+// unpackLuceneForUtil decodes the ForUtil payload at bitWidth (1-32) at the
+// start of buf into dst[:128] and returns the number of bytes consumed.
+func unpackLuceneForUtil(dst []uint32, buf []byte, bitWidth int) (int, error) {
+	p := lucenePrimitive(bitWidth)
+	rows := 2 * bitWidth
+	if len(buf) < 8*rows {
+		return 0, fmt.Errorf("%w: Lucene payload truncated (need %d bytes, got %d)", ErrInvalidBuffer, 8*rows, len(buf))
+	}
+	groups := p / bitWidth
+	rest := p - groups*bitWidth
+	valueMask := uint64(1)<<bitWidth - 1
+	laneMask := uint64(1)<<p - 1
+	for lane := range 64 / p {
+		laneValues := dst[lane*2*p : (lane+1)*2*p]
+		laneShift := 64 - (lane+1)*p
+		var acc uint64
+		accBits, next := 0, groups*rows
+		for r := range rows {
+			w := binary.LittleEndian.Uint64(buf[8*r:]) >> laneShift & laneMask
+			for g := range groups {
+				laneValues[g*rows+r] = uint32(w >> (p - (g+1)*bitWidth) & valueMask)
+			}
+			if rest > 0 {
+				acc = acc<<rest | w&(1<<rest-1)
+				accBits += rest
+				for accBits >= bitWidth {
+					accBits -= bitWidth
+					laneValues[next] = uint32(acc >> accBits)
+					acc &= 1<<accBits - 1
+					next++
+				}
+			}
+		}
+	}
+	return 8 * rows, nil
+}
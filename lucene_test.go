@@ -0,0 +1,256 @@
+package fastpfor
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestLucenePFORLayout checks small blocks against the PForUtil layout.
+func TestLucenePFORLayout(t *testing.T) {
+	assert := assert.New(t)
+
+	// One bit per value: value 0 is the top bit of the first long
+	values := make([]uint32, blockSize)
+	values[0] = 1
+	buf, err := PackLucenePFOR(nil, values)
+	assert.NoError(err)
+	expected := make([]byte, 1+16)
+	expected[0], expected[8] = 1, 0x80
+	assert.Equal(expected, buf)
+
+	// Equal values are stored as a single vlong
+	buf, err = PackLucenePFOR(nil, repeatValues([]uint32{5}, blockSize))
+	assert.NoError(err)
+	assert.Equal([]byte{0x00, 0x05}, buf)
+
+	// An outlier is patched above the bit width
+	values = repeatValues([]uint32{1}, blockSize)
+	values[3] = 1000
+	buf, err = PackLucenePFOR(nil, values)
+	assert.NoError(err)
+	assert.Len(buf, 1+32+2)
+	assert.Equal(byte(1<<5|2), buf[0])
+	assert.Equal([]byte{3, 1000 >> 2}, buf[33:])
+	decoded, n, err := UnpackLucenePFOR(nil, buf)
+	assert.NoError(err)
+	assert.Equal(len(buf), n)
+	assert.Equal(values, decoded)
+
+	// Equal values with an outlier of at most 8 bits: the patch is unshifted
+	values = repeatValues([]uint32{2}, blockSize)
+	values[7] = 202 // same low bits as the other values
+	buf, err = PackLucenePFOR(nil, values)
+	assert.NoError(err)
+	assert.Equal([]byte{1 << 5, 0x02, 7, 202 &^ 3}, buf)
+	decoded, _, err = UnpackLucenePFOR(nil, buf)
+	assert.NoError(err)
+	assert.Equal(values, decoded)
+}
+
+// TestLucenePFORRoundTrip covers every bit width with and without exceptions.
+func TestLucenePFORRoundTrip(t *testing.T) {
+	assert := assert.New(t)
+
+	rng := rand.New(rand.NewSource(11))
+	for bitWidth := 1; bitWidth <= 31; bitWidth++ {
+		for _, outliers := range []int{0, 3, 7} {
+			for _, outlierBits := range []int{min(bitWidth+5, 31), 32} {
+				values := make([]uint32, blockSize)
+				for i := range values {
+					values[i] = rng.Uint32() >> (32 - bitWidth)
+				}
+				for range outliers {
+					values[rng.Intn(blockSize)] = rng.Uint32()>>(32-outlierBits) | 1<<(outlierBits-1)
+				}
+				input := slices.Clone(values)
+				buf, err := PackLucenePFOR([]byte{0xAA}, input)
+				if !assert.NoError(err, "width %d", bitWidth) {
+					continue
+				}
+				assert.Equal(values, input)
+				assert.LessOrEqual(int(buf[1]>>5), outliers)
+
+				decoded, n, err := UnpackLucenePFOR(make([]uint32, 3), buf[1:])
+				assert.NoError(err)
+				assert.Equal(len(buf)-1, n)
+				assert.Equal(values, decoded, "width %d, %d outliers of %d bits", bitWidth, outliers, outlierBits)
+			}
+		}
+	}
+
+	_, err := PackLucenePFOR(nil, make([]uint32, 127))
+	assert.ErrorIs(err, ErrInvalidBlockLength)
+	// More than 7 values need 32 bits
+	values := make([]uint32, blockSize)
+	copy(values[50:], repeatValues([]uint32{mathMaxUint32}, lucenePFORMaxExceptions+1))
+	_, err = PackLucenePFOR(nil, values)
+	assert.ErrorIs(err, ErrValueOutOfRange)
+	values[50] = 0
+	_, err = PackLucenePFOR(nil, values)
+	assert.NoError(err)
+}
+
+// TestLuceneForDelta verifies ForDeltaUtil blocks for dense and sparse postings.
+func TestLuceneForDelta(t *testing.T) {
+	assert := assert.New(t)
+
+	dense := make([]uint32, blockSize)
+	for i := range dense {
+		dense[i] = 1001 + uint32(i)
+	}
+	buf, err := PackLuceneForDelta(nil, 1000, dense)
+	assert.NoError(err)
+	assert.Equal([]byte{0}, buf)
+	decoded, n, err := UnpackLuceneForDelta(nil, buf, 1000)
+	assert.NoError(err)
+	assert.Equal(1, n)
+	assert.Equal(dense, decoded)
+
+	rng := rand.New(rand.NewSource(5))
+	for _, maxGap := range []int{1, 2, 100, 1 << 20, 1 << 25} {
+		values := make([]uint32, blockSize)
+		doc := uint32(rng.Intn(1000))
+		base := doc
+		for i := range values {
+			doc += uint32(rng.Intn(maxGap))
+			values[i] = doc
+		}
+		buf, err := PackLuceneForDelta([]byte{0xAA}, base, values)
+		assert.NoError(err)
+		decoded, n, err := UnpackLuceneForDelta(nil, buf[1:], base)
+		assert.NoError(err)
+		assert.Equal(len(buf)-1, n)
+		assert.Equal(values, decoded, "max gap %d", maxGap)
+	}
+
+	_, err = PackLuceneForDelta(nil, 10, repeatValues([]uint32{5}, blockSize))
+	assert.ErrorIs(err, ErrValueOutOfRange)
+	_, err = PackLuceneForDelta(nil, 0, nil)
+	assert.ErrorIs(err, ErrInvalidBlockLength)
+
+	var overflow *ErrOverflow
+	_, _, err = UnpackLuceneForDelta(nil, []byte{0}, mathMaxUint32-10)
+	assert.True(errors.As(err, &overflow))
+	assert.Equal(uint8(10), overflow.Position)
+}
+
+// TestLuceneInvalid verifies truncated and corrupt blocks are rejected.
+func TestLuceneInvalid(t *testing.T) {
+	assert := assert.New(t)
+
+	values := make([]uint32, blockSize)
+	for i := range values {
+		values[i] = uint32(i * 7)
+	}
+	values[9] = 1 << 20
+	buf, err := PackLucenePFOR(nil, values)
+	assert.NoError(err)
+	for n := range len(buf) {
+		_, _, err := UnpackLucenePFOR(nil, buf[:n])
+		assert.ErrorIs(err, ErrInvalidBuffer, "truncated to %d bytes", n)
+	}
+	_, _, err = UnpackLuceneForDelta(nil, nil, 0)
+	assert.ErrorIs(err, ErrInvalidBuffer)
+	_, _, err = UnpackLuceneForDelta(nil, []byte{33}, 0)
+	assert.ErrorIs(err, ErrInvalidBuffer)
+
+	// Patches above 32 bits
+	_, _, err = UnpackLucenePFOR(nil, append([]byte{1<<5 | 30}, append(make([]byte, 8*60), 0, 0xff)...))
+	assert.ErrorIs(err, ErrInvalidBuffer)
+}
+
+// TestLuceneForUtilReference compares the ForUtil payload with a literal port of
+// Lucene's ForUtil.encode, which collapses the values into longs and packs them
+// with lane masks.
+func TestLuceneForUtilReference(t *testing.T) {
+	assert := assert.New(t)
+
+	rng := rand.New(rand.NewSource(2))
+	for bitWidth := 1; bitWidth <= 32; bitWidth++ {
+		var values [blockSize]uint32
+		for i := range values {
+			values[i] = uint32(rng.Uint64() >> (64 - bitWidth))
+		}
+		buf := appendLuceneForUtil(nil, &values, bitWidth)
+		assert.Equal(luceneForUtilReference(values, bitWidth), buf, "width %d", bitWidth)
+
+		decoded := make([]uint32, blockSize)
+		n, err := unpackLuceneForUtil(decoded, buf, bitWidth)
+		assert.NoError(err)
+		assert.Equal(8*2*bitWidth, n)
+		assert.Equal(values[:], decoded, "width %d", bitWidth)
+	}
+}
+
+func luceneForUtilReference(values [blockSize]uint32, bitsPerValue int) []byte {
+	var longs [blockSize]uint64
+	for i, v := range values {
+		longs[i] = uint64(v)
+	}
+	mask := func(p, bits int) uint64 {
+		var m uint64
+		for lane := 0; lane < 64; lane += p {
+			m |= (1<<bits - 1) << lane
+		}
+		return m
+	}
+	nextPrimitive := lucenePrimitive(bitsPerValue)
+	numLongs := blockSize * nextPrimitive / 64
+	lanes := 64 / nextPrimitive
+	for i := range numLongs { // collapse8/16/32
+		var l uint64
+		for j := range lanes {
+			l |= longs[j*numLongs+i] << (64 - (j+1)*nextPrimitive)
+		}
+		longs[i] = l
+	}
+
+	numLongsPerShift := bitsPerValue * 2
+	var tmp [64]uint64
+	idx := 0
+	shift := nextPrimitive - bitsPerValue
+	for i := range numLongsPerShift {
+		tmp[i] = longs[idx] << shift
+		idx++
+	}
+	for shift = shift - bitsPerValue; shift >= 0; shift -= bitsPerValue {
+		for i := range numLongsPerShift {
+			tmp[i] |= longs[idx] << shift
+			idx++
+		}
+	}
+	remainingBitsPerLong := shift + bitsPerValue
+	maskRemainingBitsPerLong := mask(nextPrimitive, remainingBitsPerLong)
+	tmpIdx := 0
+	remainingBitsPerValue := bitsPerValue
+	for idx < numLongs {
+		if remainingBitsPerValue >= remainingBitsPerLong {
+			remainingBitsPerValue -= remainingBitsPerLong
+			tmp[tmpIdx] |= (longs[idx] >> remainingBitsPerValue) & maskRemainingBitsPerLong
+			tmpIdx++
+			if remainingBitsPerValue == 0 {
+				idx++
+				remainingBitsPerValue = bitsPerValue
+			}
+		} else {
+			mask1 := mask(nextPrimitive, remainingBitsPerValue)
+			mask2 := mask(nextPrimitive, remainingBitsPerLong-remainingBitsPerValue)
+			tmp[tmpIdx] |= (longs[idx] & mask1) << (remainingBitsPerLong - remainingBitsPerValue)
+			idx++
+			remainingBitsPerValue = bitsPerValue - remainingBitsPerLong + remainingBitsPerValue
+			tmp[tmpIdx] |= (longs[idx] >> remainingBitsPerValue) & mask2
+			tmpIdx++
+		}
+	}
+
+	var out []byte
+	for _, l := range tmp[:numLongsPerShift] {
+		out = binary.LittleEndian.AppendUint64(out, l)
+	}
+	return out
+}
@@ -0,0 +1,77 @@
+package fastpfor
+
+import (
+	"bufio"
+	"bytes"
+	"container/heap"
+	"fmt"
+	"io"
+)
+
+// Merge k-way merges sorted streams of concatenated blocks (such as the posting
+// lists of several index segments, written by a delta StreamWriter or an
+// ExternalSorter) and writes the merged values to w as delta blocks, like a
+// StreamWriter with delta coding. It returns the number of bytes written.
+// Duplicates are preserved; Union drops them.
+//
+// Only one decoded block per input is held in memory at a time. The blocks of an
+// input may differ in their flags, but their values must be non-decreasing across
+// the whole input; otherwise ErrNotSorted is returned once the merge reaches the
+// decreasing value, with the blocks before it already written.
+func Merge(w io.Writer, inputs ...[]byte) (int64, error) {
+	return mergeStreams(w, inputs, false)
+}
+
+// Union is Merge without duplicates: every value occurring in any input is
+// written once, as for the union of posting lists.
+func Union(w io.Writer, inputs ...[]byte) (int64, error) {
+	return mergeStreams(w, inputs, true)
+}
+
+// mergeStreams merges the inputs into a delta StreamWriter, skipping repeated
+// values if unique is set.
+func mergeStreams(w io.Writer, inputs [][]byte, unique bool) (int64, error) {
+	h := make(runHeap, 0, len(inputs))
+	for i, in := range inputs {
+		c := &runCursor{r: bufio.NewReader(bytes.NewReader(in))}
+		ok, err := c.fill()
+		if err != nil {
+			return 0, fmt.Errorf("input %d: %w", i, err)
+		}
+		if ok {
+			h = append(h, c)
+		}
+	}
+	heap.Init(&h)
+
+	sw := NewStreamWriter(w, true)
+	var last uint32
+	emitted := false
+	for len(h) > 0 {
+		c := h[0]
+		v := c.values[c.pos]
+		switch {
+		case emitted && v < last:
+			return sw.Written(), fmt.Errorf("%w: merge input decreases from %d to %d", ErrNotSorted, last, v)
+		case !emitted || v != last || !unique:
+			if err := sw.Add(v); err != nil {
+				return sw.Written(), err
+			}
+			last, emitted = v, true
+		}
+		c.pos++
+		if c.pos == len(c.values) {
+			ok, err := c.fill()
+			if err != nil {
+				return sw.Written(), err
+			}
+			if !ok {
+				heap.Pop(&h)
+				continue
+			}
+		}
+		heap.Fix(&h, 0)
+	}
+	err := sw.Close()
+	return sw.Written(), err
+}
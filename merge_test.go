@@ -0,0 +1,60 @@
+package fastpfor
+
+import (
+	"bytes"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMerge(t *testing.T) {
+	assert := assert.New(t)
+	rng := rand.New(rand.NewSource(4057))
+
+	var inputs [][]byte
+	var all []uint32
+	for _, n := range []int{0, 1, 77, 3 * blockSize, 10*blockSize + 5} {
+		values := genPostings(rng, n, 20)
+		inputs = append(inputs, sequenceBlocks(values, PackDeltaUint32))
+		all = append(all, values...)
+	}
+	// A plain-coded input is merged as well
+	inputs = append(inputs, PackUint32(nil, []uint32{0, 5, 5, 1 << 30}))
+	all = append(all, 0, 5, 5, 1<<30)
+	slices.Sort(all)
+
+	var out bytes.Buffer
+	n, err := Merge(&out, inputs...)
+	assert.NoError(err)
+	assert.Equal(int64(out.Len()), n)
+	assert.Equal(sequenceBlocks(all, PackDeltaUint32), out.Bytes())
+
+	out.Reset()
+	n, err = Union(&out, inputs...)
+	assert.NoError(err)
+	assert.Equal(int64(out.Len()), n)
+	assert.Equal(slices.Compact(all), decodeBlockStream(t, out.Bytes()))
+
+	out.Reset()
+	n, err = Merge(&out)
+	assert.NoError(err)
+	assert.Zero(n)
+}
+
+func TestMergeErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	var out bytes.Buffer
+	_, err := Merge(&out, sequenceBlocks([]uint32{1, 2}, PackDeltaUint32), PackUint32(nil, []uint32{4, 3}))
+	assert.ErrorIs(err, ErrNotSorted)
+
+	// Decreasing across block boundaries
+	_, err = Union(&out, slices.Concat(sequenceBlocks([]uint32{5, 6}, PackDeltaUint32), sequenceBlocks([]uint32{1}, PackDeltaUint32)))
+	assert.ErrorIs(err, ErrNotSorted)
+
+	block := sequenceBlocks([]uint32{1, 2, 3}, PackDeltaUint32)
+	_, err = Merge(&out, block, block[:len(block)-1])
+	assert.ErrorIs(err, ErrInvalidBuffer)
+}
@@ -0,0 +1,94 @@
+package fastpfor
+
+import "errors"
+
+// IsMonotonic reports whether the block in buf holds non-decreasing values.
+//
+// For D1 delta blocks without zigzag and will-overflow flags the answer is
+// derived from the header alone: their deltas are non-negative by construction.
+// All other blocks are decoded into a stack buffer and scanned. The zigzag flag
+// does not prove a decrease, as it only records the choice of the encoder
+// (earlier SIMD encoders zigzagged sorted values crossing 2^31), and neither do
+// plain blocks, D4 or DM blocks, whose deltas only order values four positions
+// or a group apart, or DD blocks, whose second-order deltas are negative
+// whenever the spacing shrinks.
+//
+// Use UnpackUint32Monotonic if the decoded values are needed as well,
+// to avoid decoding the block twice.
+func IsMonotonic(buf []byte) (bool, error) {
+	header, _, _, err := readHeader(buf)
+	if err != nil {
+		return false, err
+	}
+	if provesMonotonic(header) {
+		return true, nil
+	}
+
+	var values [blockSize]uint32
+	decoded, err := UnpackUint32(values[:0], buf)
+	var overflow *ErrOverflow
+	if errors.As(err, &overflow) {
+		// The prefix sum wraps around, so the true values exceed the uint32 range
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return isNonDecreasing(decoded), nil
+}
+
+// UnpackUint32Monotonic decodes buf like UnpackUint32 and additionally reports
+// whether the decoded values are non-decreasing. Delta-encoded blocks are
+// answered from the header flags where they prove the order (see IsMonotonic),
+// other blocks are checked with a single scan over the freshly decoded (and therefore
+// cache-hot) values.
+//
+// On *ErrOverflow the decoded values are returned together with false.
+func UnpackUint32Monotonic(dst []uint32, buf []byte) ([]uint32, bool, error) {
+	dst, err := UnpackUint32(dst, buf)
+	if err != nil {
+		return dst, false, err
+	}
+	header := bo.Uint32(buf[:headerBytes])
+	if provesMonotonic(header) {
+		return dst, true, nil
+	}
+	return dst, isNonDecreasing(dst), nil
+}
+
+// provesMonotonic reports whether the header flags guarantee non-decreasing
+// values: D1 deltas without zigzag and without wrapping prefix sums.
+func provesMonotonic(header uint32) bool {
+	_, _, _, _, hasDelta, hasZigZag, willOverflow := decodeHeader(header)
+	return hasDelta && !hasZigZag && !willOverflow && headerDeltaMode(header) == DeltaD1
+}
+
+// isNonDecreasing reports whether values[i-1] <= values[i] for all i.
+// The comparison is unrolled by four and accumulated without branches,
+// so the compiler can keep the loop free of early exits.
+func isNonDecreasing(values []uint32) bool {
+	n := len(values)
+	if n < 2 {
+		return true
+	}
+	var violation uint32
+	i := 1
+	for ; i+3 < n; i += 4 {
+		violation |= b2u(values[i] < values[i-1]) |
+			b2u(values[i+1] < values[i]) |
+			b2u(values[i+2] < values[i+1]) |
+			b2u(values[i+3] < values[i+2])
+	}
+	for ; i < n; i++ {
+		violation |= b2u(values[i] < values[i-1])
+	}
+	return violation == 0
+}
+
+// b2u converts a boolean to 0 or 1 (compiled to SETcc on amd64).
+func b2u(b bool) uint32 {
+	if b {
+		return 1
+	}
+	return 0
+}
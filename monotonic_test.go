@@ -0,0 +1,97 @@
+package fastpfor
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestIsMonotonic verifies sortedness detection for plain and delta blocks.
+func TestIsMonotonic(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		name   string
+		buf    []byte
+		sorted bool
+	}{
+		{"empty", PackUint32(nil, nil), true},
+		{"single", PackUint32(nil, []uint32{42}), true},
+		{"plainSorted", PackUint32(nil, genMonotonic(blockSize)), true},
+		{"plainEqual", PackUint32(nil, []uint32{5, 5, 5, 5, 5, 5}), true},
+		{"plainUnsorted", PackUint32(nil, []uint32{1, 2, 3, 2, 4}), false},
+		{"plainUnsortedTail", PackUint32(nil, append(genSequential(blockSize-1), 0)), false},
+		{"plainExceptions", PackUint32(nil, genDataWithSmallExceptions()), false},
+		{"deltaSorted", PackDeltaUint32(nil, genMonotonic(blockSize)), true},
+		{"deltaZigZag", PackDeltaUint32(nil, []uint32{10, 5, 20}), false},
+		{"alreadyDeltaOverflow", PackAlreadyDeltaUint32(nil, []uint32{0xFFFFFFFF, 1}), false},
+		{"alreadyDeltaNoOverflow", PackAlreadyDeltaUint32(nil, []uint32{1, 2, 3}), true},
+		{"deltaSortedAbove2p31", PackDeltaUint32(nil, sortedRandom(10)), true},
+		{"deltaSortedAbove2p31Full", PackDeltaUint32(nil, sortedRandom(blockSize)), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := IsMonotonic(tt.buf)
+			assert.NoError(err)
+			assert.Equal(tt.sorted, got)
+
+			values, sorted, err := UnpackUint32Monotonic(nil, tt.buf)
+			if err == nil {
+				assert.Equal(tt.sorted, sorted)
+				assert.Equal(isNonDecreasing(values), sorted)
+			} else {
+				var overflow *ErrOverflow
+				assert.ErrorAs(err, &overflow)
+				assert.False(sorted)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := IsMonotonic([]byte{1, 2})
+		assert.ErrorIs(err, ErrInvalidBuffer)
+		_, _, err = UnpackUint32Monotonic(nil, []byte{1, 2})
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+}
+
+// sortedRandom returns n sorted random values, most of them above 2^31, with
+// deltas crossing 2^31.
+func sortedRandom(n int) []uint32 {
+	rng := rand.New(rand.NewSource(3976))
+	values := make([]uint32, n)
+	for i := range values {
+		values[i] = rng.Uint32()
+	}
+	values[0] = 1
+	slices.Sort(values)
+	return values
+}
+
+// TestIsNonDecreasing checks the unrolled scan against a straightforward loop.
+func TestIsNonDecreasing(t *testing.T) {
+	assert := assert.New(t)
+	for n := range 12 {
+		values := genSequential(n)
+		assert.True(isNonDecreasing(values))
+		for i := 1; i < n; i++ {
+			broken := slices.Clone(values)
+			broken[i] = 0
+			if i == 1 {
+				broken[0] = 1
+			}
+			assert.False(isNonDecreasing(broken), "n=%d i=%d", n, i)
+		}
+	}
+}
+
+func BenchmarkIsMonotonicPlain(b *testing.B) {
+	buf := PackUint32(nil, genMonotonic(blockSize))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = IsMonotonic(buf)
+	}
+}
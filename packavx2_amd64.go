@@ -0,0 +1,295 @@
+//go:build amd64 && !noasm
+
+package fastpfor
+
+// AVX2 entry points provided by packavx2_amd64.s (generated by internal/avo).
+// They are only called through the tables returned by packKernelsAVX2,
+// unpackKernelsAVX2 and unpackDeltaKernelsAVX2.
+
+//go:noescape
+func pack32AVX2_1(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_2(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_3(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_4(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_5(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_6(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_7(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_8(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_9(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_10(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_11(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_12(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_13(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_14(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_15(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_16(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_17(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_18(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_19(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_20(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_21(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_22(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_23(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_24(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_25(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_26(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_27(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_28(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_29(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_30(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_31(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func pack32AVX2_32(in uintptr, out *byte, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_1(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_2(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_3(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_4(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_5(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_6(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_7(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_8(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_9(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_10(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_11(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_12(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_13(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_14(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_15(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_16(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_17(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_18(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_19(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_20(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_21(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_22(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_23(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_24(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_25(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_26(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_27(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_28(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_29(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_30(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_31(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpack32AVX2_32(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_1(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_2(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_3(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_4(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_5(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_6(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_7(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_8(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_9(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_10(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_11(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_12(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_13(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_14(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_15(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_16(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_17(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_18(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_19(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_20(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_21(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_22(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_23(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_24(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_25(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_26(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_27(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_28(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_29(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_30(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_31(in *byte, out uintptr, offset int, seed *byte)
+
+//go:noescape
+func unpackDelta32AVX2_32(in *byte, out uintptr, offset int, seed *byte)
@@ -0,0 +1,20818 @@
+// Code generated by command: go run main.go -component=pack -out=../../packavx2_amd64.s. DO NOT EDIT.
+
+//go:build amd64 && !noasm
+
+#include "textflag.h"
+
+// func pack32AVX2_1(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_1(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VBROADCASTI128 (AX), Y0
+	VPSRLVD        shiftCounts0<>+0(SB), Y0, Y0
+	VBROADCASTI128 16(AX), Y1
+	VPSLLVD        shiftCounts1<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 32(AX), Y1
+	VPSLLVD        shiftCounts2<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 48(AX), Y1
+	VPSLLVD        shiftCounts3<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 64(AX), Y1
+	VPSLLVD        shiftCounts4<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 80(AX), Y1
+	VPSLLVD        shiftCounts5<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 96(AX), Y1
+	VPSLLVD        shiftCounts6<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 112(AX), Y1
+	VPSLLVD        shiftCounts7<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 128(AX), Y1
+	VPSLLVD        shiftCounts8<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 144(AX), Y1
+	VPSLLVD        shiftCounts9<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 160(AX), Y1
+	VPSLLVD        shiftCounts10<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 176(AX), Y1
+	VPSLLVD        shiftCounts11<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 192(AX), Y1
+	VPSLLVD        shiftCounts12<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 208(AX), Y1
+	VPSLLVD        shiftCounts13<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 224(AX), Y1
+	VPSLLVD        shiftCounts14<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 240(AX), Y1
+	VPSLLVD        shiftCounts15<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 256(AX), Y1
+	VPSLLVD        shiftCounts16<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 272(AX), Y1
+	VPSLLVD        shiftCounts17<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 288(AX), Y1
+	VPSLLVD        shiftCounts18<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 304(AX), Y1
+	VPSLLVD        shiftCounts19<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 320(AX), Y1
+	VPSLLVD        shiftCounts20<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 336(AX), Y1
+	VPSLLVD        shiftCounts21<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 352(AX), Y1
+	VPSLLVD        shiftCounts22<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 368(AX), Y1
+	VPSLLVD        shiftCounts23<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 384(AX), Y1
+	VPSLLVD        shiftCounts24<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 400(AX), Y1
+	VPSLLVD        shiftCounts25<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 416(AX), Y1
+	VPSLLVD        shiftCounts26<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 432(AX), Y1
+	VPSLLVD        shiftCounts27<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 448(AX), Y1
+	VPSLLVD        shiftCounts28<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 464(AX), Y1
+	VPSLLVD        shiftCounts29<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 480(AX), Y1
+	VPSLLVD        shiftCounts30<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 496(AX), Y1
+	VPSLLVD        shiftCounts31<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        X0, (CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts0<>+0(SB)/4, $0x00000000
+DATA shiftCounts0<>+4(SB)/4, $0x00000000
+DATA shiftCounts0<>+8(SB)/4, $0x00000000
+DATA shiftCounts0<>+12(SB)/4, $0x00000000
+DATA shiftCounts0<>+16(SB)/4, $0x00000020
+DATA shiftCounts0<>+20(SB)/4, $0x00000020
+DATA shiftCounts0<>+24(SB)/4, $0x00000020
+DATA shiftCounts0<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts0<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts1<>+0(SB)/4, $0x00000001
+DATA shiftCounts1<>+4(SB)/4, $0x00000001
+DATA shiftCounts1<>+8(SB)/4, $0x00000001
+DATA shiftCounts1<>+12(SB)/4, $0x00000001
+DATA shiftCounts1<>+16(SB)/4, $0x00000020
+DATA shiftCounts1<>+20(SB)/4, $0x00000020
+DATA shiftCounts1<>+24(SB)/4, $0x00000020
+DATA shiftCounts1<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts1<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts2<>+0(SB)/4, $0x00000002
+DATA shiftCounts2<>+4(SB)/4, $0x00000002
+DATA shiftCounts2<>+8(SB)/4, $0x00000002
+DATA shiftCounts2<>+12(SB)/4, $0x00000002
+DATA shiftCounts2<>+16(SB)/4, $0x00000020
+DATA shiftCounts2<>+20(SB)/4, $0x00000020
+DATA shiftCounts2<>+24(SB)/4, $0x00000020
+DATA shiftCounts2<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts2<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts3<>+0(SB)/4, $0x00000003
+DATA shiftCounts3<>+4(SB)/4, $0x00000003
+DATA shiftCounts3<>+8(SB)/4, $0x00000003
+DATA shiftCounts3<>+12(SB)/4, $0x00000003
+DATA shiftCounts3<>+16(SB)/4, $0x00000020
+DATA shiftCounts3<>+20(SB)/4, $0x00000020
+DATA shiftCounts3<>+24(SB)/4, $0x00000020
+DATA shiftCounts3<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts3<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts4<>+0(SB)/4, $0x00000004
+DATA shiftCounts4<>+4(SB)/4, $0x00000004
+DATA shiftCounts4<>+8(SB)/4, $0x00000004
+DATA shiftCounts4<>+12(SB)/4, $0x00000004
+DATA shiftCounts4<>+16(SB)/4, $0x00000020
+DATA shiftCounts4<>+20(SB)/4, $0x00000020
+DATA shiftCounts4<>+24(SB)/4, $0x00000020
+DATA shiftCounts4<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts4<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts5<>+0(SB)/4, $0x00000005
+DATA shiftCounts5<>+4(SB)/4, $0x00000005
+DATA shiftCounts5<>+8(SB)/4, $0x00000005
+DATA shiftCounts5<>+12(SB)/4, $0x00000005
+DATA shiftCounts5<>+16(SB)/4, $0x00000020
+DATA shiftCounts5<>+20(SB)/4, $0x00000020
+DATA shiftCounts5<>+24(SB)/4, $0x00000020
+DATA shiftCounts5<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts5<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts6<>+0(SB)/4, $0x00000006
+DATA shiftCounts6<>+4(SB)/4, $0x00000006
+DATA shiftCounts6<>+8(SB)/4, $0x00000006
+DATA shiftCounts6<>+12(SB)/4, $0x00000006
+DATA shiftCounts6<>+16(SB)/4, $0x00000020
+DATA shiftCounts6<>+20(SB)/4, $0x00000020
+DATA shiftCounts6<>+24(SB)/4, $0x00000020
+DATA shiftCounts6<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts6<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts7<>+0(SB)/4, $0x00000007
+DATA shiftCounts7<>+4(SB)/4, $0x00000007
+DATA shiftCounts7<>+8(SB)/4, $0x00000007
+DATA shiftCounts7<>+12(SB)/4, $0x00000007
+DATA shiftCounts7<>+16(SB)/4, $0x00000020
+DATA shiftCounts7<>+20(SB)/4, $0x00000020
+DATA shiftCounts7<>+24(SB)/4, $0x00000020
+DATA shiftCounts7<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts7<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts8<>+0(SB)/4, $0x00000008
+DATA shiftCounts8<>+4(SB)/4, $0x00000008
+DATA shiftCounts8<>+8(SB)/4, $0x00000008
+DATA shiftCounts8<>+12(SB)/4, $0x00000008
+DATA shiftCounts8<>+16(SB)/4, $0x00000020
+DATA shiftCounts8<>+20(SB)/4, $0x00000020
+DATA shiftCounts8<>+24(SB)/4, $0x00000020
+DATA shiftCounts8<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts8<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts9<>+0(SB)/4, $0x00000009
+DATA shiftCounts9<>+4(SB)/4, $0x00000009
+DATA shiftCounts9<>+8(SB)/4, $0x00000009
+DATA shiftCounts9<>+12(SB)/4, $0x00000009
+DATA shiftCounts9<>+16(SB)/4, $0x00000020
+DATA shiftCounts9<>+20(SB)/4, $0x00000020
+DATA shiftCounts9<>+24(SB)/4, $0x00000020
+DATA shiftCounts9<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts9<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts10<>+0(SB)/4, $0x0000000a
+DATA shiftCounts10<>+4(SB)/4, $0x0000000a
+DATA shiftCounts10<>+8(SB)/4, $0x0000000a
+DATA shiftCounts10<>+12(SB)/4, $0x0000000a
+DATA shiftCounts10<>+16(SB)/4, $0x00000020
+DATA shiftCounts10<>+20(SB)/4, $0x00000020
+DATA shiftCounts10<>+24(SB)/4, $0x00000020
+DATA shiftCounts10<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts10<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts11<>+0(SB)/4, $0x0000000b
+DATA shiftCounts11<>+4(SB)/4, $0x0000000b
+DATA shiftCounts11<>+8(SB)/4, $0x0000000b
+DATA shiftCounts11<>+12(SB)/4, $0x0000000b
+DATA shiftCounts11<>+16(SB)/4, $0x00000020
+DATA shiftCounts11<>+20(SB)/4, $0x00000020
+DATA shiftCounts11<>+24(SB)/4, $0x00000020
+DATA shiftCounts11<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts11<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts12<>+0(SB)/4, $0x0000000c
+DATA shiftCounts12<>+4(SB)/4, $0x0000000c
+DATA shiftCounts12<>+8(SB)/4, $0x0000000c
+DATA shiftCounts12<>+12(SB)/4, $0x0000000c
+DATA shiftCounts12<>+16(SB)/4, $0x00000020
+DATA shiftCounts12<>+20(SB)/4, $0x00000020
+DATA shiftCounts12<>+24(SB)/4, $0x00000020
+DATA shiftCounts12<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts12<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts13<>+0(SB)/4, $0x0000000d
+DATA shiftCounts13<>+4(SB)/4, $0x0000000d
+DATA shiftCounts13<>+8(SB)/4, $0x0000000d
+DATA shiftCounts13<>+12(SB)/4, $0x0000000d
+DATA shiftCounts13<>+16(SB)/4, $0x00000020
+DATA shiftCounts13<>+20(SB)/4, $0x00000020
+DATA shiftCounts13<>+24(SB)/4, $0x00000020
+DATA shiftCounts13<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts13<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts14<>+0(SB)/4, $0x0000000e
+DATA shiftCounts14<>+4(SB)/4, $0x0000000e
+DATA shiftCounts14<>+8(SB)/4, $0x0000000e
+DATA shiftCounts14<>+12(SB)/4, $0x0000000e
+DATA shiftCounts14<>+16(SB)/4, $0x00000020
+DATA shiftCounts14<>+20(SB)/4, $0x00000020
+DATA shiftCounts14<>+24(SB)/4, $0x00000020
+DATA shiftCounts14<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts14<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts15<>+0(SB)/4, $0x0000000f
+DATA shiftCounts15<>+4(SB)/4, $0x0000000f
+DATA shiftCounts15<>+8(SB)/4, $0x0000000f
+DATA shiftCounts15<>+12(SB)/4, $0x0000000f
+DATA shiftCounts15<>+16(SB)/4, $0x00000020
+DATA shiftCounts15<>+20(SB)/4, $0x00000020
+DATA shiftCounts15<>+24(SB)/4, $0x00000020
+DATA shiftCounts15<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts15<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts16<>+0(SB)/4, $0x00000010
+DATA shiftCounts16<>+4(SB)/4, $0x00000010
+DATA shiftCounts16<>+8(SB)/4, $0x00000010
+DATA shiftCounts16<>+12(SB)/4, $0x00000010
+DATA shiftCounts16<>+16(SB)/4, $0x00000020
+DATA shiftCounts16<>+20(SB)/4, $0x00000020
+DATA shiftCounts16<>+24(SB)/4, $0x00000020
+DATA shiftCounts16<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts16<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts17<>+0(SB)/4, $0x00000011
+DATA shiftCounts17<>+4(SB)/4, $0x00000011
+DATA shiftCounts17<>+8(SB)/4, $0x00000011
+DATA shiftCounts17<>+12(SB)/4, $0x00000011
+DATA shiftCounts17<>+16(SB)/4, $0x00000020
+DATA shiftCounts17<>+20(SB)/4, $0x00000020
+DATA shiftCounts17<>+24(SB)/4, $0x00000020
+DATA shiftCounts17<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts17<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts18<>+0(SB)/4, $0x00000012
+DATA shiftCounts18<>+4(SB)/4, $0x00000012
+DATA shiftCounts18<>+8(SB)/4, $0x00000012
+DATA shiftCounts18<>+12(SB)/4, $0x00000012
+DATA shiftCounts18<>+16(SB)/4, $0x00000020
+DATA shiftCounts18<>+20(SB)/4, $0x00000020
+DATA shiftCounts18<>+24(SB)/4, $0x00000020
+DATA shiftCounts18<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts18<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts19<>+0(SB)/4, $0x00000013
+DATA shiftCounts19<>+4(SB)/4, $0x00000013
+DATA shiftCounts19<>+8(SB)/4, $0x00000013
+DATA shiftCounts19<>+12(SB)/4, $0x00000013
+DATA shiftCounts19<>+16(SB)/4, $0x00000020
+DATA shiftCounts19<>+20(SB)/4, $0x00000020
+DATA shiftCounts19<>+24(SB)/4, $0x00000020
+DATA shiftCounts19<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts19<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts20<>+0(SB)/4, $0x00000014
+DATA shiftCounts20<>+4(SB)/4, $0x00000014
+DATA shiftCounts20<>+8(SB)/4, $0x00000014
+DATA shiftCounts20<>+12(SB)/4, $0x00000014
+DATA shiftCounts20<>+16(SB)/4, $0x00000020
+DATA shiftCounts20<>+20(SB)/4, $0x00000020
+DATA shiftCounts20<>+24(SB)/4, $0x00000020
+DATA shiftCounts20<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts20<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts21<>+0(SB)/4, $0x00000015
+DATA shiftCounts21<>+4(SB)/4, $0x00000015
+DATA shiftCounts21<>+8(SB)/4, $0x00000015
+DATA shiftCounts21<>+12(SB)/4, $0x00000015
+DATA shiftCounts21<>+16(SB)/4, $0x00000020
+DATA shiftCounts21<>+20(SB)/4, $0x00000020
+DATA shiftCounts21<>+24(SB)/4, $0x00000020
+DATA shiftCounts21<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts21<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts22<>+0(SB)/4, $0x00000016
+DATA shiftCounts22<>+4(SB)/4, $0x00000016
+DATA shiftCounts22<>+8(SB)/4, $0x00000016
+DATA shiftCounts22<>+12(SB)/4, $0x00000016
+DATA shiftCounts22<>+16(SB)/4, $0x00000020
+DATA shiftCounts22<>+20(SB)/4, $0x00000020
+DATA shiftCounts22<>+24(SB)/4, $0x00000020
+DATA shiftCounts22<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts22<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts23<>+0(SB)/4, $0x00000017
+DATA shiftCounts23<>+4(SB)/4, $0x00000017
+DATA shiftCounts23<>+8(SB)/4, $0x00000017
+DATA shiftCounts23<>+12(SB)/4, $0x00000017
+DATA shiftCounts23<>+16(SB)/4, $0x00000020
+DATA shiftCounts23<>+20(SB)/4, $0x00000020
+DATA shiftCounts23<>+24(SB)/4, $0x00000020
+DATA shiftCounts23<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts23<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts24<>+0(SB)/4, $0x00000018
+DATA shiftCounts24<>+4(SB)/4, $0x00000018
+DATA shiftCounts24<>+8(SB)/4, $0x00000018
+DATA shiftCounts24<>+12(SB)/4, $0x00000018
+DATA shiftCounts24<>+16(SB)/4, $0x00000020
+DATA shiftCounts24<>+20(SB)/4, $0x00000020
+DATA shiftCounts24<>+24(SB)/4, $0x00000020
+DATA shiftCounts24<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts24<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts25<>+0(SB)/4, $0x00000019
+DATA shiftCounts25<>+4(SB)/4, $0x00000019
+DATA shiftCounts25<>+8(SB)/4, $0x00000019
+DATA shiftCounts25<>+12(SB)/4, $0x00000019
+DATA shiftCounts25<>+16(SB)/4, $0x00000020
+DATA shiftCounts25<>+20(SB)/4, $0x00000020
+DATA shiftCounts25<>+24(SB)/4, $0x00000020
+DATA shiftCounts25<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts25<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts26<>+0(SB)/4, $0x0000001a
+DATA shiftCounts26<>+4(SB)/4, $0x0000001a
+DATA shiftCounts26<>+8(SB)/4, $0x0000001a
+DATA shiftCounts26<>+12(SB)/4, $0x0000001a
+DATA shiftCounts26<>+16(SB)/4, $0x00000020
+DATA shiftCounts26<>+20(SB)/4, $0x00000020
+DATA shiftCounts26<>+24(SB)/4, $0x00000020
+DATA shiftCounts26<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts26<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts27<>+0(SB)/4, $0x0000001b
+DATA shiftCounts27<>+4(SB)/4, $0x0000001b
+DATA shiftCounts27<>+8(SB)/4, $0x0000001b
+DATA shiftCounts27<>+12(SB)/4, $0x0000001b
+DATA shiftCounts27<>+16(SB)/4, $0x00000020
+DATA shiftCounts27<>+20(SB)/4, $0x00000020
+DATA shiftCounts27<>+24(SB)/4, $0x00000020
+DATA shiftCounts27<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts27<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts28<>+0(SB)/4, $0x0000001c
+DATA shiftCounts28<>+4(SB)/4, $0x0000001c
+DATA shiftCounts28<>+8(SB)/4, $0x0000001c
+DATA shiftCounts28<>+12(SB)/4, $0x0000001c
+DATA shiftCounts28<>+16(SB)/4, $0x00000020
+DATA shiftCounts28<>+20(SB)/4, $0x00000020
+DATA shiftCounts28<>+24(SB)/4, $0x00000020
+DATA shiftCounts28<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts28<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts29<>+0(SB)/4, $0x0000001d
+DATA shiftCounts29<>+4(SB)/4, $0x0000001d
+DATA shiftCounts29<>+8(SB)/4, $0x0000001d
+DATA shiftCounts29<>+12(SB)/4, $0x0000001d
+DATA shiftCounts29<>+16(SB)/4, $0x00000020
+DATA shiftCounts29<>+20(SB)/4, $0x00000020
+DATA shiftCounts29<>+24(SB)/4, $0x00000020
+DATA shiftCounts29<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts29<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts30<>+0(SB)/4, $0x0000001e
+DATA shiftCounts30<>+4(SB)/4, $0x0000001e
+DATA shiftCounts30<>+8(SB)/4, $0x0000001e
+DATA shiftCounts30<>+12(SB)/4, $0x0000001e
+DATA shiftCounts30<>+16(SB)/4, $0x00000020
+DATA shiftCounts30<>+20(SB)/4, $0x00000020
+DATA shiftCounts30<>+24(SB)/4, $0x00000020
+DATA shiftCounts30<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts30<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts31<>+0(SB)/4, $0x0000001f
+DATA shiftCounts31<>+4(SB)/4, $0x0000001f
+DATA shiftCounts31<>+8(SB)/4, $0x0000001f
+DATA shiftCounts31<>+12(SB)/4, $0x0000001f
+DATA shiftCounts31<>+16(SB)/4, $0x00000020
+DATA shiftCounts31<>+20(SB)/4, $0x00000020
+DATA shiftCounts31<>+24(SB)/4, $0x00000020
+DATA shiftCounts31<>+28(SB)/4, $0x00000020
+GLOBL shiftCounts31<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_2(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_2(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VBROADCASTI128 (AX), Y0
+	VINSERTI128    $0x01, 256(AX), Y0, Y0
+	VBROADCASTI128 16(AX), Y1
+	VINSERTI128    $0x01, 272(AX), Y1, Y1
+	VPSLLD         $0x02, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 32(AX), Y1
+	VINSERTI128    $0x01, 288(AX), Y1, Y1
+	VPSLLD         $0x04, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 48(AX), Y1
+	VINSERTI128    $0x01, 304(AX), Y1, Y1
+	VPSLLD         $0x06, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 64(AX), Y1
+	VINSERTI128    $0x01, 320(AX), Y1, Y1
+	VPSLLD         $0x08, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 80(AX), Y1
+	VINSERTI128    $0x01, 336(AX), Y1, Y1
+	VPSLLD         $0x0a, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 96(AX), Y1
+	VINSERTI128    $0x01, 352(AX), Y1, Y1
+	VPSLLD         $0x0c, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 112(AX), Y1
+	VINSERTI128    $0x01, 368(AX), Y1, Y1
+	VPSLLD         $0x0e, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 128(AX), Y1
+	VINSERTI128    $0x01, 384(AX), Y1, Y1
+	VPSLLD         $0x10, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 144(AX), Y1
+	VINSERTI128    $0x01, 400(AX), Y1, Y1
+	VPSLLD         $0x12, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 160(AX), Y1
+	VINSERTI128    $0x01, 416(AX), Y1, Y1
+	VPSLLD         $0x14, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 176(AX), Y1
+	VINSERTI128    $0x01, 432(AX), Y1, Y1
+	VPSLLD         $0x16, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 192(AX), Y1
+	VINSERTI128    $0x01, 448(AX), Y1, Y1
+	VPSLLD         $0x18, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 208(AX), Y1
+	VINSERTI128    $0x01, 464(AX), Y1, Y1
+	VPSLLD         $0x1a, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 224(AX), Y1
+	VINSERTI128    $0x01, 480(AX), Y1, Y1
+	VPSLLD         $0x1c, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 240(AX), Y1
+	VINSERTI128    $0x01, 496(AX), Y1, Y1
+	VPSLLD         $0x1e, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VZEROUPPER
+	RET
+
+// func pack32AVX2_3(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_3(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VBROADCASTI128 (AX), Y0
+	VINSERTI128    $0x01, 160(AX), Y0, Y0
+	VPSRLVD        shiftCounts32<>+0(SB), Y0, Y0
+	VBROADCASTI128 16(AX), Y1
+	VINSERTI128    $0x01, 176(AX), Y1, Y1
+	VPSLLVD        shiftCounts33<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 32(AX), Y1
+	VINSERTI128    $0x01, 192(AX), Y1, Y1
+	VPSLLVD        shiftCounts34<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 48(AX), Y1
+	VINSERTI128    $0x01, 208(AX), Y1, Y1
+	VPSLLVD        shiftCounts35<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 64(AX), Y1
+	VINSERTI128    $0x01, 224(AX), Y1, Y1
+	VPSLLVD        shiftCounts36<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 80(AX), Y1
+	VINSERTI128    $0x01, 240(AX), Y1, Y1
+	VPSLLVD        shiftCounts37<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 96(AX), Y1
+	VINSERTI128    $0x01, 256(AX), Y1, Y1
+	VPSLLVD        shiftCounts38<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 112(AX), Y1
+	VINSERTI128    $0x01, 272(AX), Y1, Y1
+	VPSLLVD        shiftCounts39<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 128(AX), Y1
+	VINSERTI128    $0x01, 288(AX), Y1, Y1
+	VPSLLVD        shiftCounts40<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 144(AX), Y1
+	VINSERTI128    $0x01, 304(AX), Y1, Y1
+	VPSLLVD        shiftCounts41<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 160(AX), Y1
+	VINSERTI128    $0x01, 320(AX), Y1, Y1
+	VPSLLVD        shiftCounts42<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 336(AX), Y1
+	VPSLLVD        shiftCounts43<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VBROADCASTI128 336(AX), Y0
+	VPSRLVD        shiftCounts1<>+0(SB), Y0, Y0
+	VBROADCASTI128 352(AX), Y1
+	VPSLLVD        shiftCounts2<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 368(AX), Y1
+	VPSLLVD        shiftCounts5<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 384(AX), Y1
+	VPSLLVD        shiftCounts8<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 400(AX), Y1
+	VPSLLVD        shiftCounts11<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 416(AX), Y1
+	VPSLLVD        shiftCounts14<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 432(AX), Y1
+	VPSLLVD        shiftCounts17<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 448(AX), Y1
+	VPSLLVD        shiftCounts20<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 464(AX), Y1
+	VPSLLVD        shiftCounts23<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 480(AX), Y1
+	VPSLLVD        shiftCounts26<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 496(AX), Y1
+	VPSLLVD        shiftCounts29<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        X0, 32(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts32<>+0(SB)/4, $0x00000000
+DATA shiftCounts32<>+4(SB)/4, $0x00000000
+DATA shiftCounts32<>+8(SB)/4, $0x00000000
+DATA shiftCounts32<>+12(SB)/4, $0x00000000
+DATA shiftCounts32<>+16(SB)/4, $0x00000002
+DATA shiftCounts32<>+20(SB)/4, $0x00000002
+DATA shiftCounts32<>+24(SB)/4, $0x00000002
+DATA shiftCounts32<>+28(SB)/4, $0x00000002
+GLOBL shiftCounts32<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts33<>+0(SB)/4, $0x00000003
+DATA shiftCounts33<>+4(SB)/4, $0x00000003
+DATA shiftCounts33<>+8(SB)/4, $0x00000003
+DATA shiftCounts33<>+12(SB)/4, $0x00000003
+DATA shiftCounts33<>+16(SB)/4, $0x00000001
+DATA shiftCounts33<>+20(SB)/4, $0x00000001
+DATA shiftCounts33<>+24(SB)/4, $0x00000001
+DATA shiftCounts33<>+28(SB)/4, $0x00000001
+GLOBL shiftCounts33<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts34<>+0(SB)/4, $0x00000006
+DATA shiftCounts34<>+4(SB)/4, $0x00000006
+DATA shiftCounts34<>+8(SB)/4, $0x00000006
+DATA shiftCounts34<>+12(SB)/4, $0x00000006
+DATA shiftCounts34<>+16(SB)/4, $0x00000004
+DATA shiftCounts34<>+20(SB)/4, $0x00000004
+DATA shiftCounts34<>+24(SB)/4, $0x00000004
+DATA shiftCounts34<>+28(SB)/4, $0x00000004
+GLOBL shiftCounts34<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts35<>+0(SB)/4, $0x00000009
+DATA shiftCounts35<>+4(SB)/4, $0x00000009
+DATA shiftCounts35<>+8(SB)/4, $0x00000009
+DATA shiftCounts35<>+12(SB)/4, $0x00000009
+DATA shiftCounts35<>+16(SB)/4, $0x00000007
+DATA shiftCounts35<>+20(SB)/4, $0x00000007
+DATA shiftCounts35<>+24(SB)/4, $0x00000007
+DATA shiftCounts35<>+28(SB)/4, $0x00000007
+GLOBL shiftCounts35<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts36<>+0(SB)/4, $0x0000000c
+DATA shiftCounts36<>+4(SB)/4, $0x0000000c
+DATA shiftCounts36<>+8(SB)/4, $0x0000000c
+DATA shiftCounts36<>+12(SB)/4, $0x0000000c
+DATA shiftCounts36<>+16(SB)/4, $0x0000000a
+DATA shiftCounts36<>+20(SB)/4, $0x0000000a
+DATA shiftCounts36<>+24(SB)/4, $0x0000000a
+DATA shiftCounts36<>+28(SB)/4, $0x0000000a
+GLOBL shiftCounts36<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts37<>+0(SB)/4, $0x0000000f
+DATA shiftCounts37<>+4(SB)/4, $0x0000000f
+DATA shiftCounts37<>+8(SB)/4, $0x0000000f
+DATA shiftCounts37<>+12(SB)/4, $0x0000000f
+DATA shiftCounts37<>+16(SB)/4, $0x0000000d
+DATA shiftCounts37<>+20(SB)/4, $0x0000000d
+DATA shiftCounts37<>+24(SB)/4, $0x0000000d
+DATA shiftCounts37<>+28(SB)/4, $0x0000000d
+GLOBL shiftCounts37<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts38<>+0(SB)/4, $0x00000012
+DATA shiftCounts38<>+4(SB)/4, $0x00000012
+DATA shiftCounts38<>+8(SB)/4, $0x00000012
+DATA shiftCounts38<>+12(SB)/4, $0x00000012
+DATA shiftCounts38<>+16(SB)/4, $0x00000010
+DATA shiftCounts38<>+20(SB)/4, $0x00000010
+DATA shiftCounts38<>+24(SB)/4, $0x00000010
+DATA shiftCounts38<>+28(SB)/4, $0x00000010
+GLOBL shiftCounts38<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts39<>+0(SB)/4, $0x00000015
+DATA shiftCounts39<>+4(SB)/4, $0x00000015
+DATA shiftCounts39<>+8(SB)/4, $0x00000015
+DATA shiftCounts39<>+12(SB)/4, $0x00000015
+DATA shiftCounts39<>+16(SB)/4, $0x00000013
+DATA shiftCounts39<>+20(SB)/4, $0x00000013
+DATA shiftCounts39<>+24(SB)/4, $0x00000013
+DATA shiftCounts39<>+28(SB)/4, $0x00000013
+GLOBL shiftCounts39<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts40<>+0(SB)/4, $0x00000018
+DATA shiftCounts40<>+4(SB)/4, $0x00000018
+DATA shiftCounts40<>+8(SB)/4, $0x00000018
+DATA shiftCounts40<>+12(SB)/4, $0x00000018
+DATA shiftCounts40<>+16(SB)/4, $0x00000016
+DATA shiftCounts40<>+20(SB)/4, $0x00000016
+DATA shiftCounts40<>+24(SB)/4, $0x00000016
+DATA shiftCounts40<>+28(SB)/4, $0x00000016
+GLOBL shiftCounts40<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts41<>+0(SB)/4, $0x0000001b
+DATA shiftCounts41<>+4(SB)/4, $0x0000001b
+DATA shiftCounts41<>+8(SB)/4, $0x0000001b
+DATA shiftCounts41<>+12(SB)/4, $0x0000001b
+DATA shiftCounts41<>+16(SB)/4, $0x00000019
+DATA shiftCounts41<>+20(SB)/4, $0x00000019
+DATA shiftCounts41<>+24(SB)/4, $0x00000019
+DATA shiftCounts41<>+28(SB)/4, $0x00000019
+GLOBL shiftCounts41<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts42<>+0(SB)/4, $0x0000001e
+DATA shiftCounts42<>+4(SB)/4, $0x0000001e
+DATA shiftCounts42<>+8(SB)/4, $0x0000001e
+DATA shiftCounts42<>+12(SB)/4, $0x0000001e
+DATA shiftCounts42<>+16(SB)/4, $0x0000001c
+DATA shiftCounts42<>+20(SB)/4, $0x0000001c
+DATA shiftCounts42<>+24(SB)/4, $0x0000001c
+DATA shiftCounts42<>+28(SB)/4, $0x0000001c
+GLOBL shiftCounts42<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts43<>+0(SB)/4, $0x00000020
+DATA shiftCounts43<>+4(SB)/4, $0x00000020
+DATA shiftCounts43<>+8(SB)/4, $0x00000020
+DATA shiftCounts43<>+12(SB)/4, $0x00000020
+DATA shiftCounts43<>+16(SB)/4, $0x0000001f
+DATA shiftCounts43<>+20(SB)/4, $0x0000001f
+DATA shiftCounts43<>+24(SB)/4, $0x0000001f
+DATA shiftCounts43<>+28(SB)/4, $0x0000001f
+GLOBL shiftCounts43<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_4(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_4(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VBROADCASTI128 (AX), Y0
+	VINSERTI128    $0x01, 128(AX), Y0, Y0
+	VBROADCASTI128 16(AX), Y1
+	VINSERTI128    $0x01, 144(AX), Y1, Y1
+	VPSLLD         $0x04, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 32(AX), Y1
+	VINSERTI128    $0x01, 160(AX), Y1, Y1
+	VPSLLD         $0x08, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 48(AX), Y1
+	VINSERTI128    $0x01, 176(AX), Y1, Y1
+	VPSLLD         $0x0c, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 64(AX), Y1
+	VINSERTI128    $0x01, 192(AX), Y1, Y1
+	VPSLLD         $0x10, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 80(AX), Y1
+	VINSERTI128    $0x01, 208(AX), Y1, Y1
+	VPSLLD         $0x14, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 96(AX), Y1
+	VINSERTI128    $0x01, 224(AX), Y1, Y1
+	VPSLLD         $0x18, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 112(AX), Y1
+	VINSERTI128    $0x01, 240(AX), Y1, Y1
+	VPSLLD         $0x1c, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VBROADCASTI128 256(AX), Y0
+	VINSERTI128    $0x01, 384(AX), Y0, Y0
+	VBROADCASTI128 272(AX), Y1
+	VINSERTI128    $0x01, 400(AX), Y1, Y1
+	VPSLLD         $0x04, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 288(AX), Y1
+	VINSERTI128    $0x01, 416(AX), Y1, Y1
+	VPSLLD         $0x08, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 304(AX), Y1
+	VINSERTI128    $0x01, 432(AX), Y1, Y1
+	VPSLLD         $0x0c, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 320(AX), Y1
+	VINSERTI128    $0x01, 448(AX), Y1, Y1
+	VPSLLD         $0x10, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 336(AX), Y1
+	VINSERTI128    $0x01, 464(AX), Y1, Y1
+	VPSLLD         $0x14, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 352(AX), Y1
+	VINSERTI128    $0x01, 480(AX), Y1, Y1
+	VPSLLD         $0x18, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 368(AX), Y1
+	VINSERTI128    $0x01, 496(AX), Y1, Y1
+	VPSLLD         $0x1c, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VZEROUPPER
+	RET
+
+// func pack32AVX2_5(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_5(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VBROADCASTI128 (AX), Y0
+	VINSERTI128    $0x01, 96(AX), Y0, Y0
+	VPSRLVD        shiftCounts32<>+0(SB), Y0, Y0
+	VBROADCASTI128 16(AX), Y1
+	VINSERTI128    $0x01, 112(AX), Y1, Y1
+	VPSLLVD        shiftCounts44<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 32(AX), Y1
+	VINSERTI128    $0x01, 128(AX), Y1, Y1
+	VPSLLVD        shiftCounts45<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 48(AX), Y1
+	VINSERTI128    $0x01, 144(AX), Y1, Y1
+	VPSLLVD        shiftCounts37<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 64(AX), Y1
+	VINSERTI128    $0x01, 160(AX), Y1, Y1
+	VPSLLVD        shiftCounts46<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 80(AX), Y1
+	VINSERTI128    $0x01, 176(AX), Y1, Y1
+	VPSLLVD        shiftCounts47<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 96(AX), Y1
+	VINSERTI128    $0x01, 192(AX), Y1, Y1
+	VPSLLVD        shiftCounts42<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VBROADCASTI128 192(AX), Y0
+	VINSERTI128    $0x01, 304(AX), Y0, Y0
+	VPSRLVD        shiftCounts48<>+0(SB), Y0, Y0
+	VBROADCASTI128 208(AX), Y1
+	VINSERTI128    $0x01, 320(AX), Y1, Y1
+	VPSLLVD        shiftCounts49<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 224(AX), Y1
+	VINSERTI128    $0x01, 336(AX), Y1, Y1
+	VPSLLVD        shiftCounts50<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 240(AX), Y1
+	VINSERTI128    $0x01, 352(AX), Y1, Y1
+	VPSLLVD        shiftCounts51<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 256(AX), Y1
+	VINSERTI128    $0x01, 368(AX), Y1, Y1
+	VPSLLVD        shiftCounts52<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 272(AX), Y1
+	VINSERTI128    $0x01, 384(AX), Y1, Y1
+	VPSLLVD        shiftCounts53<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 288(AX), Y1
+	VINSERTI128    $0x01, 400(AX), Y1, Y1
+	VPSLLVD        shiftCounts54<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 304(AX), Y1
+	VPSLLVD        shiftCounts31<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VBROADCASTI128 400(AX), Y0
+	VPSRLVD        shiftCounts3<>+0(SB), Y0, Y0
+	VBROADCASTI128 416(AX), Y1
+	VPSLLVD        shiftCounts2<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 432(AX), Y1
+	VPSLLVD        shiftCounts7<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 448(AX), Y1
+	VPSLLVD        shiftCounts12<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 464(AX), Y1
+	VPSLLVD        shiftCounts17<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 480(AX), Y1
+	VPSLLVD        shiftCounts22<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 496(AX), Y1
+	VPSLLVD        shiftCounts27<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        X0, 64(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts44<>+0(SB)/4, $0x00000005
+DATA shiftCounts44<>+4(SB)/4, $0x00000005
+DATA shiftCounts44<>+8(SB)/4, $0x00000005
+DATA shiftCounts44<>+12(SB)/4, $0x00000005
+DATA shiftCounts44<>+16(SB)/4, $0x00000003
+DATA shiftCounts44<>+20(SB)/4, $0x00000003
+DATA shiftCounts44<>+24(SB)/4, $0x00000003
+DATA shiftCounts44<>+28(SB)/4, $0x00000003
+GLOBL shiftCounts44<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts45<>+0(SB)/4, $0x0000000a
+DATA shiftCounts45<>+4(SB)/4, $0x0000000a
+DATA shiftCounts45<>+8(SB)/4, $0x0000000a
+DATA shiftCounts45<>+12(SB)/4, $0x0000000a
+DATA shiftCounts45<>+16(SB)/4, $0x00000008
+DATA shiftCounts45<>+20(SB)/4, $0x00000008
+DATA shiftCounts45<>+24(SB)/4, $0x00000008
+DATA shiftCounts45<>+28(SB)/4, $0x00000008
+GLOBL shiftCounts45<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts46<>+0(SB)/4, $0x00000014
+DATA shiftCounts46<>+4(SB)/4, $0x00000014
+DATA shiftCounts46<>+8(SB)/4, $0x00000014
+DATA shiftCounts46<>+12(SB)/4, $0x00000014
+DATA shiftCounts46<>+16(SB)/4, $0x00000012
+DATA shiftCounts46<>+20(SB)/4, $0x00000012
+DATA shiftCounts46<>+24(SB)/4, $0x00000012
+DATA shiftCounts46<>+28(SB)/4, $0x00000012
+GLOBL shiftCounts46<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts47<>+0(SB)/4, $0x00000019
+DATA shiftCounts47<>+4(SB)/4, $0x00000019
+DATA shiftCounts47<>+8(SB)/4, $0x00000019
+DATA shiftCounts47<>+12(SB)/4, $0x00000019
+DATA shiftCounts47<>+16(SB)/4, $0x00000017
+DATA shiftCounts47<>+20(SB)/4, $0x00000017
+DATA shiftCounts47<>+24(SB)/4, $0x00000017
+DATA shiftCounts47<>+28(SB)/4, $0x00000017
+GLOBL shiftCounts47<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts48<>+0(SB)/4, $0x00000004
+DATA shiftCounts48<>+4(SB)/4, $0x00000004
+DATA shiftCounts48<>+8(SB)/4, $0x00000004
+DATA shiftCounts48<>+12(SB)/4, $0x00000004
+DATA shiftCounts48<>+16(SB)/4, $0x00000001
+DATA shiftCounts48<>+20(SB)/4, $0x00000001
+DATA shiftCounts48<>+24(SB)/4, $0x00000001
+DATA shiftCounts48<>+28(SB)/4, $0x00000001
+GLOBL shiftCounts48<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts49<>+0(SB)/4, $0x00000001
+DATA shiftCounts49<>+4(SB)/4, $0x00000001
+DATA shiftCounts49<>+8(SB)/4, $0x00000001
+DATA shiftCounts49<>+12(SB)/4, $0x00000001
+DATA shiftCounts49<>+16(SB)/4, $0x00000004
+DATA shiftCounts49<>+20(SB)/4, $0x00000004
+DATA shiftCounts49<>+24(SB)/4, $0x00000004
+DATA shiftCounts49<>+28(SB)/4, $0x00000004
+GLOBL shiftCounts49<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts50<>+0(SB)/4, $0x00000006
+DATA shiftCounts50<>+4(SB)/4, $0x00000006
+DATA shiftCounts50<>+8(SB)/4, $0x00000006
+DATA shiftCounts50<>+12(SB)/4, $0x00000006
+DATA shiftCounts50<>+16(SB)/4, $0x00000009
+DATA shiftCounts50<>+20(SB)/4, $0x00000009
+DATA shiftCounts50<>+24(SB)/4, $0x00000009
+DATA shiftCounts50<>+28(SB)/4, $0x00000009
+GLOBL shiftCounts50<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts51<>+0(SB)/4, $0x0000000b
+DATA shiftCounts51<>+4(SB)/4, $0x0000000b
+DATA shiftCounts51<>+8(SB)/4, $0x0000000b
+DATA shiftCounts51<>+12(SB)/4, $0x0000000b
+DATA shiftCounts51<>+16(SB)/4, $0x0000000e
+DATA shiftCounts51<>+20(SB)/4, $0x0000000e
+DATA shiftCounts51<>+24(SB)/4, $0x0000000e
+DATA shiftCounts51<>+28(SB)/4, $0x0000000e
+GLOBL shiftCounts51<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts52<>+0(SB)/4, $0x00000010
+DATA shiftCounts52<>+4(SB)/4, $0x00000010
+DATA shiftCounts52<>+8(SB)/4, $0x00000010
+DATA shiftCounts52<>+12(SB)/4, $0x00000010
+DATA shiftCounts52<>+16(SB)/4, $0x00000013
+DATA shiftCounts52<>+20(SB)/4, $0x00000013
+DATA shiftCounts52<>+24(SB)/4, $0x00000013
+DATA shiftCounts52<>+28(SB)/4, $0x00000013
+GLOBL shiftCounts52<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts53<>+0(SB)/4, $0x00000015
+DATA shiftCounts53<>+4(SB)/4, $0x00000015
+DATA shiftCounts53<>+8(SB)/4, $0x00000015
+DATA shiftCounts53<>+12(SB)/4, $0x00000015
+DATA shiftCounts53<>+16(SB)/4, $0x00000018
+DATA shiftCounts53<>+20(SB)/4, $0x00000018
+DATA shiftCounts53<>+24(SB)/4, $0x00000018
+DATA shiftCounts53<>+28(SB)/4, $0x00000018
+GLOBL shiftCounts53<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts54<>+0(SB)/4, $0x0000001a
+DATA shiftCounts54<>+4(SB)/4, $0x0000001a
+DATA shiftCounts54<>+8(SB)/4, $0x0000001a
+DATA shiftCounts54<>+12(SB)/4, $0x0000001a
+DATA shiftCounts54<>+16(SB)/4, $0x0000001d
+DATA shiftCounts54<>+20(SB)/4, $0x0000001d
+DATA shiftCounts54<>+24(SB)/4, $0x0000001d
+DATA shiftCounts54<>+28(SB)/4, $0x0000001d
+GLOBL shiftCounts54<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_6(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_6(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VBROADCASTI128 (AX), Y0
+	VINSERTI128    $0x01, 80(AX), Y0, Y0
+	VPSRLVD        shiftCounts32<>+0(SB), Y0, Y0
+	VBROADCASTI128 16(AX), Y1
+	VINSERTI128    $0x01, 96(AX), Y1, Y1
+	VPSLLVD        shiftCounts34<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 32(AX), Y1
+	VINSERTI128    $0x01, 112(AX), Y1, Y1
+	VPSLLVD        shiftCounts36<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 48(AX), Y1
+	VINSERTI128    $0x01, 128(AX), Y1, Y1
+	VPSLLVD        shiftCounts38<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 64(AX), Y1
+	VINSERTI128    $0x01, 144(AX), Y1, Y1
+	VPSLLVD        shiftCounts40<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 80(AX), Y1
+	VINSERTI128    $0x01, 160(AX), Y1, Y1
+	VPSLLVD        shiftCounts42<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VBROADCASTI128 160(AX), Y0
+	VINSERTI128    $0x01, 256(AX), Y0, Y0
+	VPSRLVD        shiftCounts55<>+0(SB), Y0, Y0
+	VBROADCASTI128 176(AX), Y1
+	VINSERTI128    $0x01, 272(AX), Y1, Y1
+	VPSLLVD        shiftCounts56<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 192(AX), Y1
+	VINSERTI128    $0x01, 288(AX), Y1, Y1
+	VPSLLVD        shiftCounts57<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 208(AX), Y1
+	VINSERTI128    $0x01, 304(AX), Y1, Y1
+	VPSLLVD        shiftCounts58<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 224(AX), Y1
+	VINSERTI128    $0x01, 320(AX), Y1, Y1
+	VPSLLVD        shiftCounts59<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 240(AX), Y1
+	VINSERTI128    $0x01, 336(AX), Y1, Y1
+	VPSLLVD        shiftCounts60<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VBROADCASTI128 336(AX), Y0
+	VINSERTI128    $0x01, 416(AX), Y0, Y0
+	VPSRLVD        shiftCounts61<>+0(SB), Y0, Y0
+	VBROADCASTI128 352(AX), Y1
+	VINSERTI128    $0x01, 432(AX), Y1, Y1
+	VPSLLVD        shiftCounts62<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 368(AX), Y1
+	VINSERTI128    $0x01, 448(AX), Y1, Y1
+	VPSLLVD        shiftCounts45<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 384(AX), Y1
+	VINSERTI128    $0x01, 464(AX), Y1, Y1
+	VPSLLVD        shiftCounts63<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 400(AX), Y1
+	VINSERTI128    $0x01, 480(AX), Y1, Y1
+	VPSLLVD        shiftCounts64<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 416(AX), Y1
+	VINSERTI128    $0x01, 496(AX), Y1, Y1
+	VPSLLVD        shiftCounts65<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts55<>+0(SB)/4, $0x00000004
+DATA shiftCounts55<>+4(SB)/4, $0x00000004
+DATA shiftCounts55<>+8(SB)/4, $0x00000004
+DATA shiftCounts55<>+12(SB)/4, $0x00000004
+DATA shiftCounts55<>+16(SB)/4, $0x00000000
+DATA shiftCounts55<>+20(SB)/4, $0x00000000
+DATA shiftCounts55<>+24(SB)/4, $0x00000000
+DATA shiftCounts55<>+28(SB)/4, $0x00000000
+GLOBL shiftCounts55<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts56<>+0(SB)/4, $0x00000002
+DATA shiftCounts56<>+4(SB)/4, $0x00000002
+DATA shiftCounts56<>+8(SB)/4, $0x00000002
+DATA shiftCounts56<>+12(SB)/4, $0x00000002
+DATA shiftCounts56<>+16(SB)/4, $0x00000006
+DATA shiftCounts56<>+20(SB)/4, $0x00000006
+DATA shiftCounts56<>+24(SB)/4, $0x00000006
+DATA shiftCounts56<>+28(SB)/4, $0x00000006
+GLOBL shiftCounts56<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts57<>+0(SB)/4, $0x00000008
+DATA shiftCounts57<>+4(SB)/4, $0x00000008
+DATA shiftCounts57<>+8(SB)/4, $0x00000008
+DATA shiftCounts57<>+12(SB)/4, $0x00000008
+DATA shiftCounts57<>+16(SB)/4, $0x0000000c
+DATA shiftCounts57<>+20(SB)/4, $0x0000000c
+DATA shiftCounts57<>+24(SB)/4, $0x0000000c
+DATA shiftCounts57<>+28(SB)/4, $0x0000000c
+GLOBL shiftCounts57<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts58<>+0(SB)/4, $0x0000000e
+DATA shiftCounts58<>+4(SB)/4, $0x0000000e
+DATA shiftCounts58<>+8(SB)/4, $0x0000000e
+DATA shiftCounts58<>+12(SB)/4, $0x0000000e
+DATA shiftCounts58<>+16(SB)/4, $0x00000012
+DATA shiftCounts58<>+20(SB)/4, $0x00000012
+DATA shiftCounts58<>+24(SB)/4, $0x00000012
+DATA shiftCounts58<>+28(SB)/4, $0x00000012
+GLOBL shiftCounts58<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts59<>+0(SB)/4, $0x00000014
+DATA shiftCounts59<>+4(SB)/4, $0x00000014
+DATA shiftCounts59<>+8(SB)/4, $0x00000014
+DATA shiftCounts59<>+12(SB)/4, $0x00000014
+DATA shiftCounts59<>+16(SB)/4, $0x00000018
+DATA shiftCounts59<>+20(SB)/4, $0x00000018
+DATA shiftCounts59<>+24(SB)/4, $0x00000018
+DATA shiftCounts59<>+28(SB)/4, $0x00000018
+GLOBL shiftCounts59<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts60<>+0(SB)/4, $0x0000001a
+DATA shiftCounts60<>+4(SB)/4, $0x0000001a
+DATA shiftCounts60<>+8(SB)/4, $0x0000001a
+DATA shiftCounts60<>+12(SB)/4, $0x0000001a
+DATA shiftCounts60<>+16(SB)/4, $0x0000001e
+DATA shiftCounts60<>+20(SB)/4, $0x0000001e
+DATA shiftCounts60<>+24(SB)/4, $0x0000001e
+DATA shiftCounts60<>+28(SB)/4, $0x0000001e
+GLOBL shiftCounts60<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts61<>+0(SB)/4, $0x00000002
+DATA shiftCounts61<>+4(SB)/4, $0x00000002
+DATA shiftCounts61<>+8(SB)/4, $0x00000002
+DATA shiftCounts61<>+12(SB)/4, $0x00000002
+DATA shiftCounts61<>+16(SB)/4, $0x00000004
+DATA shiftCounts61<>+20(SB)/4, $0x00000004
+DATA shiftCounts61<>+24(SB)/4, $0x00000004
+DATA shiftCounts61<>+28(SB)/4, $0x00000004
+GLOBL shiftCounts61<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts62<>+0(SB)/4, $0x00000004
+DATA shiftCounts62<>+4(SB)/4, $0x00000004
+DATA shiftCounts62<>+8(SB)/4, $0x00000004
+DATA shiftCounts62<>+12(SB)/4, $0x00000004
+DATA shiftCounts62<>+16(SB)/4, $0x00000002
+DATA shiftCounts62<>+20(SB)/4, $0x00000002
+DATA shiftCounts62<>+24(SB)/4, $0x00000002
+DATA shiftCounts62<>+28(SB)/4, $0x00000002
+GLOBL shiftCounts62<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts63<>+0(SB)/4, $0x00000010
+DATA shiftCounts63<>+4(SB)/4, $0x00000010
+DATA shiftCounts63<>+8(SB)/4, $0x00000010
+DATA shiftCounts63<>+12(SB)/4, $0x00000010
+DATA shiftCounts63<>+16(SB)/4, $0x0000000e
+DATA shiftCounts63<>+20(SB)/4, $0x0000000e
+DATA shiftCounts63<>+24(SB)/4, $0x0000000e
+DATA shiftCounts63<>+28(SB)/4, $0x0000000e
+GLOBL shiftCounts63<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts64<>+0(SB)/4, $0x00000016
+DATA shiftCounts64<>+4(SB)/4, $0x00000016
+DATA shiftCounts64<>+8(SB)/4, $0x00000016
+DATA shiftCounts64<>+12(SB)/4, $0x00000016
+DATA shiftCounts64<>+16(SB)/4, $0x00000014
+DATA shiftCounts64<>+20(SB)/4, $0x00000014
+DATA shiftCounts64<>+24(SB)/4, $0x00000014
+DATA shiftCounts64<>+28(SB)/4, $0x00000014
+GLOBL shiftCounts64<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts65<>+0(SB)/4, $0x0000001c
+DATA shiftCounts65<>+4(SB)/4, $0x0000001c
+DATA shiftCounts65<>+8(SB)/4, $0x0000001c
+DATA shiftCounts65<>+12(SB)/4, $0x0000001c
+DATA shiftCounts65<>+16(SB)/4, $0x0000001a
+DATA shiftCounts65<>+20(SB)/4, $0x0000001a
+DATA shiftCounts65<>+24(SB)/4, $0x0000001a
+DATA shiftCounts65<>+28(SB)/4, $0x0000001a
+GLOBL shiftCounts65<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_7(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_7(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VBROADCASTI128 (AX), Y0
+	VINSERTI128    $0x01, 64(AX), Y0, Y0
+	VPSRLVD        shiftCounts66<>+0(SB), Y0, Y0
+	VBROADCASTI128 16(AX), Y1
+	VINSERTI128    $0x01, 80(AX), Y1, Y1
+	VPSLLVD        shiftCounts67<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 32(AX), Y1
+	VINSERTI128    $0x01, 96(AX), Y1, Y1
+	VPSLLVD        shiftCounts68<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 48(AX), Y1
+	VINSERTI128    $0x01, 112(AX), Y1, Y1
+	VPSLLVD        shiftCounts69<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 64(AX), Y1
+	VINSERTI128    $0x01, 128(AX), Y1, Y1
+	VPSLLVD        shiftCounts70<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 144(AX), Y1
+	VPSLLVD        shiftCounts43<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VBROADCASTI128 144(AX), Y0
+	VINSERTI128    $0x01, 208(AX), Y0, Y0
+	VPSRLVD        shiftCounts71<>+0(SB), Y0, Y0
+	VBROADCASTI128 160(AX), Y1
+	VINSERTI128    $0x01, 224(AX), Y1, Y1
+	VPSLLVD        shiftCounts72<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 176(AX), Y1
+	VINSERTI128    $0x01, 240(AX), Y1, Y1
+	VPSLLVD        shiftCounts73<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 192(AX), Y1
+	VINSERTI128    $0x01, 256(AX), Y1, Y1
+	VPSLLVD        shiftCounts74<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 208(AX), Y1
+	VINSERTI128    $0x01, 272(AX), Y1, Y1
+	VPSLLVD        shiftCounts75<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 288(AX), Y1
+	VPSLLVD        shiftCounts76<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VBROADCASTI128 288(AX), Y0
+	VINSERTI128    $0x01, 352(AX), Y0, Y0
+	VPSRLVD        shiftCounts56<>+0(SB), Y0, Y0
+	VBROADCASTI128 304(AX), Y1
+	VINSERTI128    $0x01, 368(AX), Y1, Y1
+	VPSLLVD        shiftCounts77<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 320(AX), Y1
+	VINSERTI128    $0x01, 384(AX), Y1, Y1
+	VPSLLVD        shiftCounts78<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 336(AX), Y1
+	VINSERTI128    $0x01, 400(AX), Y1, Y1
+	VPSLLVD        shiftCounts79<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 352(AX), Y1
+	VINSERTI128    $0x01, 416(AX), Y1, Y1
+	VPSLLVD        shiftCounts80<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 432(AX), Y1
+	VPSLLVD        shiftCounts81<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VBROADCASTI128 432(AX), Y0
+	VPSRLVD        shiftCounts3<>+0(SB), Y0, Y0
+	VBROADCASTI128 448(AX), Y1
+	VPSLLVD        shiftCounts4<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 464(AX), Y1
+	VPSLLVD        shiftCounts11<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 480(AX), Y1
+	VPSLLVD        shiftCounts18<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 496(AX), Y1
+	VPSLLVD        shiftCounts25<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        X0, 96(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts66<>+0(SB)/4, $0x00000000
+DATA shiftCounts66<>+4(SB)/4, $0x00000000
+DATA shiftCounts66<>+8(SB)/4, $0x00000000
+DATA shiftCounts66<>+12(SB)/4, $0x00000000
+DATA shiftCounts66<>+16(SB)/4, $0x00000004
+DATA shiftCounts66<>+20(SB)/4, $0x00000004
+DATA shiftCounts66<>+24(SB)/4, $0x00000004
+DATA shiftCounts66<>+28(SB)/4, $0x00000004
+GLOBL shiftCounts66<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts67<>+0(SB)/4, $0x00000007
+DATA shiftCounts67<>+4(SB)/4, $0x00000007
+DATA shiftCounts67<>+8(SB)/4, $0x00000007
+DATA shiftCounts67<>+12(SB)/4, $0x00000007
+DATA shiftCounts67<>+16(SB)/4, $0x00000003
+DATA shiftCounts67<>+20(SB)/4, $0x00000003
+DATA shiftCounts67<>+24(SB)/4, $0x00000003
+DATA shiftCounts67<>+28(SB)/4, $0x00000003
+GLOBL shiftCounts67<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts68<>+0(SB)/4, $0x0000000e
+DATA shiftCounts68<>+4(SB)/4, $0x0000000e
+DATA shiftCounts68<>+8(SB)/4, $0x0000000e
+DATA shiftCounts68<>+12(SB)/4, $0x0000000e
+DATA shiftCounts68<>+16(SB)/4, $0x0000000a
+DATA shiftCounts68<>+20(SB)/4, $0x0000000a
+DATA shiftCounts68<>+24(SB)/4, $0x0000000a
+DATA shiftCounts68<>+28(SB)/4, $0x0000000a
+GLOBL shiftCounts68<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts69<>+0(SB)/4, $0x00000015
+DATA shiftCounts69<>+4(SB)/4, $0x00000015
+DATA shiftCounts69<>+8(SB)/4, $0x00000015
+DATA shiftCounts69<>+12(SB)/4, $0x00000015
+DATA shiftCounts69<>+16(SB)/4, $0x00000011
+DATA shiftCounts69<>+20(SB)/4, $0x00000011
+DATA shiftCounts69<>+24(SB)/4, $0x00000011
+DATA shiftCounts69<>+28(SB)/4, $0x00000011
+GLOBL shiftCounts69<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts70<>+0(SB)/4, $0x0000001c
+DATA shiftCounts70<>+4(SB)/4, $0x0000001c
+DATA shiftCounts70<>+8(SB)/4, $0x0000001c
+DATA shiftCounts70<>+12(SB)/4, $0x0000001c
+DATA shiftCounts70<>+16(SB)/4, $0x00000018
+DATA shiftCounts70<>+20(SB)/4, $0x00000018
+DATA shiftCounts70<>+24(SB)/4, $0x00000018
+DATA shiftCounts70<>+28(SB)/4, $0x00000018
+GLOBL shiftCounts70<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts71<>+0(SB)/4, $0x00000001
+DATA shiftCounts71<>+4(SB)/4, $0x00000001
+DATA shiftCounts71<>+8(SB)/4, $0x00000001
+DATA shiftCounts71<>+12(SB)/4, $0x00000001
+DATA shiftCounts71<>+16(SB)/4, $0x00000005
+DATA shiftCounts71<>+20(SB)/4, $0x00000005
+DATA shiftCounts71<>+24(SB)/4, $0x00000005
+DATA shiftCounts71<>+28(SB)/4, $0x00000005
+GLOBL shiftCounts71<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts72<>+0(SB)/4, $0x00000006
+DATA shiftCounts72<>+4(SB)/4, $0x00000006
+DATA shiftCounts72<>+8(SB)/4, $0x00000006
+DATA shiftCounts72<>+12(SB)/4, $0x00000006
+DATA shiftCounts72<>+16(SB)/4, $0x00000002
+DATA shiftCounts72<>+20(SB)/4, $0x00000002
+DATA shiftCounts72<>+24(SB)/4, $0x00000002
+DATA shiftCounts72<>+28(SB)/4, $0x00000002
+GLOBL shiftCounts72<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts73<>+0(SB)/4, $0x0000000d
+DATA shiftCounts73<>+4(SB)/4, $0x0000000d
+DATA shiftCounts73<>+8(SB)/4, $0x0000000d
+DATA shiftCounts73<>+12(SB)/4, $0x0000000d
+DATA shiftCounts73<>+16(SB)/4, $0x00000009
+DATA shiftCounts73<>+20(SB)/4, $0x00000009
+DATA shiftCounts73<>+24(SB)/4, $0x00000009
+DATA shiftCounts73<>+28(SB)/4, $0x00000009
+GLOBL shiftCounts73<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts74<>+0(SB)/4, $0x00000014
+DATA shiftCounts74<>+4(SB)/4, $0x00000014
+DATA shiftCounts74<>+8(SB)/4, $0x00000014
+DATA shiftCounts74<>+12(SB)/4, $0x00000014
+DATA shiftCounts74<>+16(SB)/4, $0x00000010
+DATA shiftCounts74<>+20(SB)/4, $0x00000010
+DATA shiftCounts74<>+24(SB)/4, $0x00000010
+DATA shiftCounts74<>+28(SB)/4, $0x00000010
+GLOBL shiftCounts74<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts75<>+0(SB)/4, $0x0000001b
+DATA shiftCounts75<>+4(SB)/4, $0x0000001b
+DATA shiftCounts75<>+8(SB)/4, $0x0000001b
+DATA shiftCounts75<>+12(SB)/4, $0x0000001b
+DATA shiftCounts75<>+16(SB)/4, $0x00000017
+DATA shiftCounts75<>+20(SB)/4, $0x00000017
+DATA shiftCounts75<>+24(SB)/4, $0x00000017
+DATA shiftCounts75<>+28(SB)/4, $0x00000017
+GLOBL shiftCounts75<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts76<>+0(SB)/4, $0x00000020
+DATA shiftCounts76<>+4(SB)/4, $0x00000020
+DATA shiftCounts76<>+8(SB)/4, $0x00000020
+DATA shiftCounts76<>+12(SB)/4, $0x00000020
+DATA shiftCounts76<>+16(SB)/4, $0x0000001e
+DATA shiftCounts76<>+20(SB)/4, $0x0000001e
+DATA shiftCounts76<>+24(SB)/4, $0x0000001e
+DATA shiftCounts76<>+28(SB)/4, $0x0000001e
+GLOBL shiftCounts76<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts77<>+0(SB)/4, $0x00000005
+DATA shiftCounts77<>+4(SB)/4, $0x00000005
+DATA shiftCounts77<>+8(SB)/4, $0x00000005
+DATA shiftCounts77<>+12(SB)/4, $0x00000005
+DATA shiftCounts77<>+16(SB)/4, $0x00000001
+DATA shiftCounts77<>+20(SB)/4, $0x00000001
+DATA shiftCounts77<>+24(SB)/4, $0x00000001
+DATA shiftCounts77<>+28(SB)/4, $0x00000001
+GLOBL shiftCounts77<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts78<>+0(SB)/4, $0x0000000c
+DATA shiftCounts78<>+4(SB)/4, $0x0000000c
+DATA shiftCounts78<>+8(SB)/4, $0x0000000c
+DATA shiftCounts78<>+12(SB)/4, $0x0000000c
+DATA shiftCounts78<>+16(SB)/4, $0x00000008
+DATA shiftCounts78<>+20(SB)/4, $0x00000008
+DATA shiftCounts78<>+24(SB)/4, $0x00000008
+DATA shiftCounts78<>+28(SB)/4, $0x00000008
+GLOBL shiftCounts78<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts79<>+0(SB)/4, $0x00000013
+DATA shiftCounts79<>+4(SB)/4, $0x00000013
+DATA shiftCounts79<>+8(SB)/4, $0x00000013
+DATA shiftCounts79<>+12(SB)/4, $0x00000013
+DATA shiftCounts79<>+16(SB)/4, $0x0000000f
+DATA shiftCounts79<>+20(SB)/4, $0x0000000f
+DATA shiftCounts79<>+24(SB)/4, $0x0000000f
+DATA shiftCounts79<>+28(SB)/4, $0x0000000f
+GLOBL shiftCounts79<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts80<>+0(SB)/4, $0x0000001a
+DATA shiftCounts80<>+4(SB)/4, $0x0000001a
+DATA shiftCounts80<>+8(SB)/4, $0x0000001a
+DATA shiftCounts80<>+12(SB)/4, $0x0000001a
+DATA shiftCounts80<>+16(SB)/4, $0x00000016
+DATA shiftCounts80<>+20(SB)/4, $0x00000016
+DATA shiftCounts80<>+24(SB)/4, $0x00000016
+DATA shiftCounts80<>+28(SB)/4, $0x00000016
+GLOBL shiftCounts80<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts81<>+0(SB)/4, $0x00000020
+DATA shiftCounts81<>+4(SB)/4, $0x00000020
+DATA shiftCounts81<>+8(SB)/4, $0x00000020
+DATA shiftCounts81<>+12(SB)/4, $0x00000020
+DATA shiftCounts81<>+16(SB)/4, $0x0000001d
+DATA shiftCounts81<>+20(SB)/4, $0x0000001d
+DATA shiftCounts81<>+24(SB)/4, $0x0000001d
+DATA shiftCounts81<>+28(SB)/4, $0x0000001d
+GLOBL shiftCounts81<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_8(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_8(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VBROADCASTI128 (AX), Y0
+	VINSERTI128    $0x01, 64(AX), Y0, Y0
+	VBROADCASTI128 16(AX), Y1
+	VINSERTI128    $0x01, 80(AX), Y1, Y1
+	VPSLLD         $0x08, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 32(AX), Y1
+	VINSERTI128    $0x01, 96(AX), Y1, Y1
+	VPSLLD         $0x10, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 48(AX), Y1
+	VINSERTI128    $0x01, 112(AX), Y1, Y1
+	VPSLLD         $0x18, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VBROADCASTI128 128(AX), Y0
+	VINSERTI128    $0x01, 192(AX), Y0, Y0
+	VBROADCASTI128 144(AX), Y1
+	VINSERTI128    $0x01, 208(AX), Y1, Y1
+	VPSLLD         $0x08, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 160(AX), Y1
+	VINSERTI128    $0x01, 224(AX), Y1, Y1
+	VPSLLD         $0x10, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 176(AX), Y1
+	VINSERTI128    $0x01, 240(AX), Y1, Y1
+	VPSLLD         $0x18, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VBROADCASTI128 256(AX), Y0
+	VINSERTI128    $0x01, 320(AX), Y0, Y0
+	VBROADCASTI128 272(AX), Y1
+	VINSERTI128    $0x01, 336(AX), Y1, Y1
+	VPSLLD         $0x08, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 288(AX), Y1
+	VINSERTI128    $0x01, 352(AX), Y1, Y1
+	VPSLLD         $0x10, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 304(AX), Y1
+	VINSERTI128    $0x01, 368(AX), Y1, Y1
+	VPSLLD         $0x18, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VBROADCASTI128 384(AX), Y0
+	VINSERTI128    $0x01, 448(AX), Y0, Y0
+	VBROADCASTI128 400(AX), Y1
+	VINSERTI128    $0x01, 464(AX), Y1, Y1
+	VPSLLD         $0x08, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 416(AX), Y1
+	VINSERTI128    $0x01, 480(AX), Y1, Y1
+	VPSLLD         $0x10, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 432(AX), Y1
+	VINSERTI128    $0x01, 496(AX), Y1, Y1
+	VPSLLD         $0x18, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VZEROUPPER
+	RET
+
+// func pack32AVX2_9(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_9(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VBROADCASTI128 (AX), Y0
+	VINSERTI128    $0x01, 48(AX), Y0, Y0
+	VPSRLVD        shiftCounts82<>+0(SB), Y0, Y0
+	VBROADCASTI128 16(AX), Y1
+	VINSERTI128    $0x01, 64(AX), Y1, Y1
+	VPSLLVD        shiftCounts83<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 32(AX), Y1
+	VINSERTI128    $0x01, 80(AX), Y1, Y1
+	VPSLLVD        shiftCounts84<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 48(AX), Y1
+	VINSERTI128    $0x01, 96(AX), Y1, Y1
+	VPSLLVD        shiftCounts85<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 112(AX), Y1
+	VPSLLVD        shiftCounts43<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VBROADCASTI128 112(AX), Y0
+	VINSERTI128    $0x01, 160(AX), Y0, Y0
+	VPSRLVD        shiftCounts86<>+0(SB), Y0, Y0
+	VBROADCASTI128 128(AX), Y1
+	VINSERTI128    $0x01, 176(AX), Y1, Y1
+	VPSLLVD        shiftCounts87<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 144(AX), Y1
+	VINSERTI128    $0x01, 192(AX), Y1, Y1
+	VPSLLVD        shiftCounts88<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 160(AX), Y1
+	VINSERTI128    $0x01, 208(AX), Y1, Y1
+	VPSLLVD        shiftCounts89<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 224(AX), Y1
+	VPSLLVD        shiftCounts76<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VBROADCASTI128 224(AX), Y0
+	VINSERTI128    $0x01, 272(AX), Y0, Y0
+	VPSRLVD        shiftCounts90<>+0(SB), Y0, Y0
+	VBROADCASTI128 240(AX), Y1
+	VINSERTI128    $0x01, 288(AX), Y1, Y1
+	VPSLLVD        shiftCounts91<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 256(AX), Y1
+	VINSERTI128    $0x01, 304(AX), Y1, Y1
+	VPSLLVD        shiftCounts92<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 272(AX), Y1
+	VINSERTI128    $0x01, 320(AX), Y1, Y1
+	VPSLLVD        shiftCounts93<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 336(AX), Y1
+	VPSLLVD        shiftCounts81<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VBROADCASTI128 336(AX), Y0
+	VINSERTI128    $0x01, 384(AX), Y0, Y0
+	VPSRLVD        shiftCounts94<>+0(SB), Y0, Y0
+	VBROADCASTI128 352(AX), Y1
+	VINSERTI128    $0x01, 400(AX), Y1, Y1
+	VPSLLVD        shiftCounts95<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 368(AX), Y1
+	VINSERTI128    $0x01, 416(AX), Y1, Y1
+	VPSLLVD        shiftCounts96<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 384(AX), Y1
+	VINSERTI128    $0x01, 432(AX), Y1, Y1
+	VPSLLVD        shiftCounts97<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 448(AX), Y1
+	VPSLLVD        shiftCounts98<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VBROADCASTI128 448(AX), Y0
+	VPSRLVD        shiftCounts4<>+0(SB), Y0, Y0
+	VBROADCASTI128 464(AX), Y1
+	VPSLLVD        shiftCounts5<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 480(AX), Y1
+	VPSLLVD        shiftCounts14<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 496(AX), Y1
+	VPSLLVD        shiftCounts23<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        X0, 128(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts82<>+0(SB)/4, $0x00000000
+DATA shiftCounts82<>+4(SB)/4, $0x00000000
+DATA shiftCounts82<>+8(SB)/4, $0x00000000
+DATA shiftCounts82<>+12(SB)/4, $0x00000000
+DATA shiftCounts82<>+16(SB)/4, $0x00000005
+DATA shiftCounts82<>+20(SB)/4, $0x00000005
+DATA shiftCounts82<>+24(SB)/4, $0x00000005
+DATA shiftCounts82<>+28(SB)/4, $0x00000005
+GLOBL shiftCounts82<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts83<>+0(SB)/4, $0x00000009
+DATA shiftCounts83<>+4(SB)/4, $0x00000009
+DATA shiftCounts83<>+8(SB)/4, $0x00000009
+DATA shiftCounts83<>+12(SB)/4, $0x00000009
+DATA shiftCounts83<>+16(SB)/4, $0x00000004
+DATA shiftCounts83<>+20(SB)/4, $0x00000004
+DATA shiftCounts83<>+24(SB)/4, $0x00000004
+DATA shiftCounts83<>+28(SB)/4, $0x00000004
+GLOBL shiftCounts83<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts84<>+0(SB)/4, $0x00000012
+DATA shiftCounts84<>+4(SB)/4, $0x00000012
+DATA shiftCounts84<>+8(SB)/4, $0x00000012
+DATA shiftCounts84<>+12(SB)/4, $0x00000012
+DATA shiftCounts84<>+16(SB)/4, $0x0000000d
+DATA shiftCounts84<>+20(SB)/4, $0x0000000d
+DATA shiftCounts84<>+24(SB)/4, $0x0000000d
+DATA shiftCounts84<>+28(SB)/4, $0x0000000d
+GLOBL shiftCounts84<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts85<>+0(SB)/4, $0x0000001b
+DATA shiftCounts85<>+4(SB)/4, $0x0000001b
+DATA shiftCounts85<>+8(SB)/4, $0x0000001b
+DATA shiftCounts85<>+12(SB)/4, $0x0000001b
+DATA shiftCounts85<>+16(SB)/4, $0x00000016
+DATA shiftCounts85<>+20(SB)/4, $0x00000016
+DATA shiftCounts85<>+24(SB)/4, $0x00000016
+DATA shiftCounts85<>+28(SB)/4, $0x00000016
+GLOBL shiftCounts85<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts86<>+0(SB)/4, $0x00000001
+DATA shiftCounts86<>+4(SB)/4, $0x00000001
+DATA shiftCounts86<>+8(SB)/4, $0x00000001
+DATA shiftCounts86<>+12(SB)/4, $0x00000001
+DATA shiftCounts86<>+16(SB)/4, $0x00000006
+DATA shiftCounts86<>+20(SB)/4, $0x00000006
+DATA shiftCounts86<>+24(SB)/4, $0x00000006
+DATA shiftCounts86<>+28(SB)/4, $0x00000006
+GLOBL shiftCounts86<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts87<>+0(SB)/4, $0x00000008
+DATA shiftCounts87<>+4(SB)/4, $0x00000008
+DATA shiftCounts87<>+8(SB)/4, $0x00000008
+DATA shiftCounts87<>+12(SB)/4, $0x00000008
+DATA shiftCounts87<>+16(SB)/4, $0x00000003
+DATA shiftCounts87<>+20(SB)/4, $0x00000003
+DATA shiftCounts87<>+24(SB)/4, $0x00000003
+DATA shiftCounts87<>+28(SB)/4, $0x00000003
+GLOBL shiftCounts87<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts88<>+0(SB)/4, $0x00000011
+DATA shiftCounts88<>+4(SB)/4, $0x00000011
+DATA shiftCounts88<>+8(SB)/4, $0x00000011
+DATA shiftCounts88<>+12(SB)/4, $0x00000011
+DATA shiftCounts88<>+16(SB)/4, $0x0000000c
+DATA shiftCounts88<>+20(SB)/4, $0x0000000c
+DATA shiftCounts88<>+24(SB)/4, $0x0000000c
+DATA shiftCounts88<>+28(SB)/4, $0x0000000c
+GLOBL shiftCounts88<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts89<>+0(SB)/4, $0x0000001a
+DATA shiftCounts89<>+4(SB)/4, $0x0000001a
+DATA shiftCounts89<>+8(SB)/4, $0x0000001a
+DATA shiftCounts89<>+12(SB)/4, $0x0000001a
+DATA shiftCounts89<>+16(SB)/4, $0x00000015
+DATA shiftCounts89<>+20(SB)/4, $0x00000015
+DATA shiftCounts89<>+24(SB)/4, $0x00000015
+DATA shiftCounts89<>+28(SB)/4, $0x00000015
+GLOBL shiftCounts89<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts90<>+0(SB)/4, $0x00000002
+DATA shiftCounts90<>+4(SB)/4, $0x00000002
+DATA shiftCounts90<>+8(SB)/4, $0x00000002
+DATA shiftCounts90<>+12(SB)/4, $0x00000002
+DATA shiftCounts90<>+16(SB)/4, $0x00000007
+DATA shiftCounts90<>+20(SB)/4, $0x00000007
+DATA shiftCounts90<>+24(SB)/4, $0x00000007
+DATA shiftCounts90<>+28(SB)/4, $0x00000007
+GLOBL shiftCounts90<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts91<>+0(SB)/4, $0x00000007
+DATA shiftCounts91<>+4(SB)/4, $0x00000007
+DATA shiftCounts91<>+8(SB)/4, $0x00000007
+DATA shiftCounts91<>+12(SB)/4, $0x00000007
+DATA shiftCounts91<>+16(SB)/4, $0x00000002
+DATA shiftCounts91<>+20(SB)/4, $0x00000002
+DATA shiftCounts91<>+24(SB)/4, $0x00000002
+DATA shiftCounts91<>+28(SB)/4, $0x00000002
+GLOBL shiftCounts91<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts92<>+0(SB)/4, $0x00000010
+DATA shiftCounts92<>+4(SB)/4, $0x00000010
+DATA shiftCounts92<>+8(SB)/4, $0x00000010
+DATA shiftCounts92<>+12(SB)/4, $0x00000010
+DATA shiftCounts92<>+16(SB)/4, $0x0000000b
+DATA shiftCounts92<>+20(SB)/4, $0x0000000b
+DATA shiftCounts92<>+24(SB)/4, $0x0000000b
+DATA shiftCounts92<>+28(SB)/4, $0x0000000b
+GLOBL shiftCounts92<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts93<>+0(SB)/4, $0x00000019
+DATA shiftCounts93<>+4(SB)/4, $0x00000019
+DATA shiftCounts93<>+8(SB)/4, $0x00000019
+DATA shiftCounts93<>+12(SB)/4, $0x00000019
+DATA shiftCounts93<>+16(SB)/4, $0x00000014
+DATA shiftCounts93<>+20(SB)/4, $0x00000014
+DATA shiftCounts93<>+24(SB)/4, $0x00000014
+DATA shiftCounts93<>+28(SB)/4, $0x00000014
+GLOBL shiftCounts93<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts94<>+0(SB)/4, $0x00000003
+DATA shiftCounts94<>+4(SB)/4, $0x00000003
+DATA shiftCounts94<>+8(SB)/4, $0x00000003
+DATA shiftCounts94<>+12(SB)/4, $0x00000003
+DATA shiftCounts94<>+16(SB)/4, $0x00000008
+DATA shiftCounts94<>+20(SB)/4, $0x00000008
+DATA shiftCounts94<>+24(SB)/4, $0x00000008
+DATA shiftCounts94<>+28(SB)/4, $0x00000008
+GLOBL shiftCounts94<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts95<>+0(SB)/4, $0x00000006
+DATA shiftCounts95<>+4(SB)/4, $0x00000006
+DATA shiftCounts95<>+8(SB)/4, $0x00000006
+DATA shiftCounts95<>+12(SB)/4, $0x00000006
+DATA shiftCounts95<>+16(SB)/4, $0x00000001
+DATA shiftCounts95<>+20(SB)/4, $0x00000001
+DATA shiftCounts95<>+24(SB)/4, $0x00000001
+DATA shiftCounts95<>+28(SB)/4, $0x00000001
+GLOBL shiftCounts95<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts96<>+0(SB)/4, $0x0000000f
+DATA shiftCounts96<>+4(SB)/4, $0x0000000f
+DATA shiftCounts96<>+8(SB)/4, $0x0000000f
+DATA shiftCounts96<>+12(SB)/4, $0x0000000f
+DATA shiftCounts96<>+16(SB)/4, $0x0000000a
+DATA shiftCounts96<>+20(SB)/4, $0x0000000a
+DATA shiftCounts96<>+24(SB)/4, $0x0000000a
+DATA shiftCounts96<>+28(SB)/4, $0x0000000a
+GLOBL shiftCounts96<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts97<>+0(SB)/4, $0x00000018
+DATA shiftCounts97<>+4(SB)/4, $0x00000018
+DATA shiftCounts97<>+8(SB)/4, $0x00000018
+DATA shiftCounts97<>+12(SB)/4, $0x00000018
+DATA shiftCounts97<>+16(SB)/4, $0x00000013
+DATA shiftCounts97<>+20(SB)/4, $0x00000013
+DATA shiftCounts97<>+24(SB)/4, $0x00000013
+DATA shiftCounts97<>+28(SB)/4, $0x00000013
+GLOBL shiftCounts97<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts98<>+0(SB)/4, $0x00000020
+DATA shiftCounts98<>+4(SB)/4, $0x00000020
+DATA shiftCounts98<>+8(SB)/4, $0x00000020
+DATA shiftCounts98<>+12(SB)/4, $0x00000020
+DATA shiftCounts98<>+16(SB)/4, $0x0000001c
+DATA shiftCounts98<>+20(SB)/4, $0x0000001c
+DATA shiftCounts98<>+24(SB)/4, $0x0000001c
+DATA shiftCounts98<>+28(SB)/4, $0x0000001c
+GLOBL shiftCounts98<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_10(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_10(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VBROADCASTI128 (AX), Y0
+	VINSERTI128    $0x01, 48(AX), Y0, Y0
+	VPSRLVD        shiftCounts32<>+0(SB), Y0, Y0
+	VBROADCASTI128 16(AX), Y1
+	VINSERTI128    $0x01, 64(AX), Y1, Y1
+	VPSLLVD        shiftCounts45<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 32(AX), Y1
+	VINSERTI128    $0x01, 80(AX), Y1, Y1
+	VPSLLVD        shiftCounts46<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 48(AX), Y1
+	VINSERTI128    $0x01, 96(AX), Y1, Y1
+	VPSLLVD        shiftCounts42<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VBROADCASTI128 96(AX), Y0
+	VINSERTI128    $0x01, 144(AX), Y0, Y0
+	VPSRLVD        shiftCounts99<>+0(SB), Y0, Y0
+	VBROADCASTI128 112(AX), Y1
+	VINSERTI128    $0x01, 160(AX), Y1, Y1
+	VPSLLVD        shiftCounts34<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 128(AX), Y1
+	VINSERTI128    $0x01, 176(AX), Y1, Y1
+	VPSLLVD        shiftCounts63<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 144(AX), Y1
+	VINSERTI128    $0x01, 192(AX), Y1, Y1
+	VPSLLVD        shiftCounts100<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VBROADCASTI128 192(AX), Y0
+	VINSERTI128    $0x01, 256(AX), Y0, Y0
+	VPSRLVD        shiftCounts101<>+0(SB), Y0, Y0
+	VBROADCASTI128 208(AX), Y1
+	VINSERTI128    $0x01, 272(AX), Y1, Y1
+	VPSLLVD        shiftCounts102<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 224(AX), Y1
+	VINSERTI128    $0x01, 288(AX), Y1, Y1
+	VPSLLVD        shiftCounts103<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 240(AX), Y1
+	VINSERTI128    $0x01, 304(AX), Y1, Y1
+	VPSLLVD        shiftCounts104<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VBROADCASTI128 304(AX), Y0
+	VINSERTI128    $0x01, 352(AX), Y0, Y0
+	VPSRLVD        shiftCounts61<>+0(SB), Y0, Y0
+	VBROADCASTI128 320(AX), Y1
+	VINSERTI128    $0x01, 368(AX), Y1, Y1
+	VPSLLVD        shiftCounts105<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 336(AX), Y1
+	VINSERTI128    $0x01, 384(AX), Y1, Y1
+	VPSLLVD        shiftCounts38<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 352(AX), Y1
+	VINSERTI128    $0x01, 400(AX), Y1, Y1
+	VPSLLVD        shiftCounts65<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VBROADCASTI128 400(AX), Y0
+	VINSERTI128    $0x01, 448(AX), Y0, Y0
+	VPSRLVD        shiftCounts106<>+0(SB), Y0, Y0
+	VBROADCASTI128 416(AX), Y1
+	VINSERTI128    $0x01, 464(AX), Y1, Y1
+	VPSLLVD        shiftCounts62<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 432(AX), Y1
+	VINSERTI128    $0x01, 480(AX), Y1, Y1
+	VPSLLVD        shiftCounts107<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 448(AX), Y1
+	VINSERTI128    $0x01, 496(AX), Y1, Y1
+	VPSLLVD        shiftCounts40<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 128(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts99<>+0(SB)/4, $0x00000004
+DATA shiftCounts99<>+4(SB)/4, $0x00000004
+DATA shiftCounts99<>+8(SB)/4, $0x00000004
+DATA shiftCounts99<>+12(SB)/4, $0x00000004
+DATA shiftCounts99<>+16(SB)/4, $0x00000006
+DATA shiftCounts99<>+20(SB)/4, $0x00000006
+DATA shiftCounts99<>+24(SB)/4, $0x00000006
+DATA shiftCounts99<>+28(SB)/4, $0x00000006
+GLOBL shiftCounts99<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts100<>+0(SB)/4, $0x0000001a
+DATA shiftCounts100<>+4(SB)/4, $0x0000001a
+DATA shiftCounts100<>+8(SB)/4, $0x0000001a
+DATA shiftCounts100<>+12(SB)/4, $0x0000001a
+DATA shiftCounts100<>+16(SB)/4, $0x00000018
+DATA shiftCounts100<>+20(SB)/4, $0x00000018
+DATA shiftCounts100<>+24(SB)/4, $0x00000018
+DATA shiftCounts100<>+28(SB)/4, $0x00000018
+GLOBL shiftCounts100<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts101<>+0(SB)/4, $0x00000008
+DATA shiftCounts101<>+4(SB)/4, $0x00000008
+DATA shiftCounts101<>+8(SB)/4, $0x00000008
+DATA shiftCounts101<>+12(SB)/4, $0x00000008
+DATA shiftCounts101<>+16(SB)/4, $0x00000000
+DATA shiftCounts101<>+20(SB)/4, $0x00000000
+DATA shiftCounts101<>+24(SB)/4, $0x00000000
+DATA shiftCounts101<>+28(SB)/4, $0x00000000
+GLOBL shiftCounts101<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts102<>+0(SB)/4, $0x00000002
+DATA shiftCounts102<>+4(SB)/4, $0x00000002
+DATA shiftCounts102<>+8(SB)/4, $0x00000002
+DATA shiftCounts102<>+12(SB)/4, $0x00000002
+DATA shiftCounts102<>+16(SB)/4, $0x0000000a
+DATA shiftCounts102<>+20(SB)/4, $0x0000000a
+DATA shiftCounts102<>+24(SB)/4, $0x0000000a
+DATA shiftCounts102<>+28(SB)/4, $0x0000000a
+GLOBL shiftCounts102<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts103<>+0(SB)/4, $0x0000000c
+DATA shiftCounts103<>+4(SB)/4, $0x0000000c
+DATA shiftCounts103<>+8(SB)/4, $0x0000000c
+DATA shiftCounts103<>+12(SB)/4, $0x0000000c
+DATA shiftCounts103<>+16(SB)/4, $0x00000014
+DATA shiftCounts103<>+20(SB)/4, $0x00000014
+DATA shiftCounts103<>+24(SB)/4, $0x00000014
+DATA shiftCounts103<>+28(SB)/4, $0x00000014
+GLOBL shiftCounts103<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts104<>+0(SB)/4, $0x00000016
+DATA shiftCounts104<>+4(SB)/4, $0x00000016
+DATA shiftCounts104<>+8(SB)/4, $0x00000016
+DATA shiftCounts104<>+12(SB)/4, $0x00000016
+DATA shiftCounts104<>+16(SB)/4, $0x0000001e
+DATA shiftCounts104<>+20(SB)/4, $0x0000001e
+DATA shiftCounts104<>+24(SB)/4, $0x0000001e
+DATA shiftCounts104<>+28(SB)/4, $0x0000001e
+GLOBL shiftCounts104<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts105<>+0(SB)/4, $0x00000008
+DATA shiftCounts105<>+4(SB)/4, $0x00000008
+DATA shiftCounts105<>+8(SB)/4, $0x00000008
+DATA shiftCounts105<>+12(SB)/4, $0x00000008
+DATA shiftCounts105<>+16(SB)/4, $0x00000006
+DATA shiftCounts105<>+20(SB)/4, $0x00000006
+DATA shiftCounts105<>+24(SB)/4, $0x00000006
+DATA shiftCounts105<>+28(SB)/4, $0x00000006
+GLOBL shiftCounts105<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts106<>+0(SB)/4, $0x00000006
+DATA shiftCounts106<>+4(SB)/4, $0x00000006
+DATA shiftCounts106<>+8(SB)/4, $0x00000006
+DATA shiftCounts106<>+12(SB)/4, $0x00000006
+DATA shiftCounts106<>+16(SB)/4, $0x00000008
+DATA shiftCounts106<>+20(SB)/4, $0x00000008
+DATA shiftCounts106<>+24(SB)/4, $0x00000008
+DATA shiftCounts106<>+28(SB)/4, $0x00000008
+GLOBL shiftCounts106<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts107<>+0(SB)/4, $0x0000000e
+DATA shiftCounts107<>+4(SB)/4, $0x0000000e
+DATA shiftCounts107<>+8(SB)/4, $0x0000000e
+DATA shiftCounts107<>+12(SB)/4, $0x0000000e
+DATA shiftCounts107<>+16(SB)/4, $0x0000000c
+DATA shiftCounts107<>+20(SB)/4, $0x0000000c
+DATA shiftCounts107<>+24(SB)/4, $0x0000000c
+DATA shiftCounts107<>+28(SB)/4, $0x0000000c
+GLOBL shiftCounts107<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_11(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_11(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VBROADCASTI128 (AX), Y0
+	VINSERTI128    $0x01, 32(AX), Y0, Y0
+	VPSRLVD        shiftCounts108<>+0(SB), Y0, Y0
+	VBROADCASTI128 16(AX), Y1
+	VINSERTI128    $0x01, 48(AX), Y1, Y1
+	VPSLLVD        shiftCounts109<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 32(AX), Y1
+	VINSERTI128    $0x01, 64(AX), Y1, Y1
+	VPSLLVD        shiftCounts110<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 80(AX), Y1
+	VPSLLVD        shiftCounts111<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VBROADCASTI128 80(AX), Y0
+	VINSERTI128    $0x01, 128(AX), Y0, Y0
+	VPSRLVD        shiftCounts112<>+0(SB), Y0, Y0
+	VBROADCASTI128 96(AX), Y1
+	VINSERTI128    $0x01, 144(AX), Y1, Y1
+	VPSLLVD        shiftCounts113<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 112(AX), Y1
+	VINSERTI128    $0x01, 160(AX), Y1, Y1
+	VPSLLVD        shiftCounts114<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 128(AX), Y1
+	VINSERTI128    $0x01, 176(AX), Y1, Y1
+	VPSLLVD        shiftCounts115<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VBROADCASTI128 176(AX), Y0
+	VINSERTI128    $0x01, 224(AX), Y0, Y0
+	VPSRLVD        shiftCounts116<>+0(SB), Y0, Y0
+	VBROADCASTI128 192(AX), Y1
+	VINSERTI128    $0x01, 240(AX), Y1, Y1
+	VPSLLVD        shiftCounts117<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 208(AX), Y1
+	VINSERTI128    $0x01, 256(AX), Y1, Y1
+	VPSLLVD        shiftCounts118<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 224(AX), Y1
+	VINSERTI128    $0x01, 272(AX), Y1, Y1
+	VPSLLVD        shiftCounts119<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VBROADCASTI128 272(AX), Y0
+	VINSERTI128    $0x01, 320(AX), Y0, Y0
+	VPSRLVD        shiftCounts120<>+0(SB), Y0, Y0
+	VBROADCASTI128 288(AX), Y1
+	VINSERTI128    $0x01, 336(AX), Y1, Y1
+	VPSLLVD        shiftCounts121<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 304(AX), Y1
+	VINSERTI128    $0x01, 352(AX), Y1, Y1
+	VPSLLVD        shiftCounts122<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 320(AX), Y1
+	VINSERTI128    $0x01, 368(AX), Y1, Y1
+	VPSLLVD        shiftCounts123<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VBROADCASTI128 368(AX), Y0
+	VINSERTI128    $0x01, 416(AX), Y0, Y0
+	VPSRLVD        shiftCounts124<>+0(SB), Y0, Y0
+	VBROADCASTI128 384(AX), Y1
+	VINSERTI128    $0x01, 432(AX), Y1, Y1
+	VPSLLVD        shiftCounts125<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 400(AX), Y1
+	VINSERTI128    $0x01, 448(AX), Y1, Y1
+	VPSLLVD        shiftCounts126<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 416(AX), Y1
+	VINSERTI128    $0x01, 464(AX), Y1, Y1
+	VPSLLVD        shiftCounts127<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 128(CX)
+	VBROADCASTI128 464(AX), Y0
+	VPSRLVD        shiftCounts1<>+0(SB), Y0, Y0
+	VBROADCASTI128 480(AX), Y1
+	VPSLLVD        shiftCounts10<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 496(AX), Y1
+	VPSLLVD        shiftCounts21<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        X0, 160(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts108<>+0(SB)/4, $0x00000000
+DATA shiftCounts108<>+4(SB)/4, $0x00000000
+DATA shiftCounts108<>+8(SB)/4, $0x00000000
+DATA shiftCounts108<>+12(SB)/4, $0x00000000
+DATA shiftCounts108<>+16(SB)/4, $0x0000000a
+DATA shiftCounts108<>+20(SB)/4, $0x0000000a
+DATA shiftCounts108<>+24(SB)/4, $0x0000000a
+DATA shiftCounts108<>+28(SB)/4, $0x0000000a
+GLOBL shiftCounts108<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts109<>+0(SB)/4, $0x0000000b
+DATA shiftCounts109<>+4(SB)/4, $0x0000000b
+DATA shiftCounts109<>+8(SB)/4, $0x0000000b
+DATA shiftCounts109<>+12(SB)/4, $0x0000000b
+DATA shiftCounts109<>+16(SB)/4, $0x00000001
+DATA shiftCounts109<>+20(SB)/4, $0x00000001
+DATA shiftCounts109<>+24(SB)/4, $0x00000001
+DATA shiftCounts109<>+28(SB)/4, $0x00000001
+GLOBL shiftCounts109<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts110<>+0(SB)/4, $0x00000016
+DATA shiftCounts110<>+4(SB)/4, $0x00000016
+DATA shiftCounts110<>+8(SB)/4, $0x00000016
+DATA shiftCounts110<>+12(SB)/4, $0x00000016
+DATA shiftCounts110<>+16(SB)/4, $0x0000000c
+DATA shiftCounts110<>+20(SB)/4, $0x0000000c
+DATA shiftCounts110<>+24(SB)/4, $0x0000000c
+DATA shiftCounts110<>+28(SB)/4, $0x0000000c
+GLOBL shiftCounts110<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts111<>+0(SB)/4, $0x00000020
+DATA shiftCounts111<>+4(SB)/4, $0x00000020
+DATA shiftCounts111<>+8(SB)/4, $0x00000020
+DATA shiftCounts111<>+12(SB)/4, $0x00000020
+DATA shiftCounts111<>+16(SB)/4, $0x00000017
+DATA shiftCounts111<>+20(SB)/4, $0x00000017
+DATA shiftCounts111<>+24(SB)/4, $0x00000017
+DATA shiftCounts111<>+28(SB)/4, $0x00000017
+GLOBL shiftCounts111<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts112<>+0(SB)/4, $0x00000009
+DATA shiftCounts112<>+4(SB)/4, $0x00000009
+DATA shiftCounts112<>+8(SB)/4, $0x00000009
+DATA shiftCounts112<>+12(SB)/4, $0x00000009
+DATA shiftCounts112<>+16(SB)/4, $0x00000008
+DATA shiftCounts112<>+20(SB)/4, $0x00000008
+DATA shiftCounts112<>+24(SB)/4, $0x00000008
+DATA shiftCounts112<>+28(SB)/4, $0x00000008
+GLOBL shiftCounts112<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts113<>+0(SB)/4, $0x00000002
+DATA shiftCounts113<>+4(SB)/4, $0x00000002
+DATA shiftCounts113<>+8(SB)/4, $0x00000002
+DATA shiftCounts113<>+12(SB)/4, $0x00000002
+DATA shiftCounts113<>+16(SB)/4, $0x00000003
+DATA shiftCounts113<>+20(SB)/4, $0x00000003
+DATA shiftCounts113<>+24(SB)/4, $0x00000003
+DATA shiftCounts113<>+28(SB)/4, $0x00000003
+GLOBL shiftCounts113<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts114<>+0(SB)/4, $0x0000000d
+DATA shiftCounts114<>+4(SB)/4, $0x0000000d
+DATA shiftCounts114<>+8(SB)/4, $0x0000000d
+DATA shiftCounts114<>+12(SB)/4, $0x0000000d
+DATA shiftCounts114<>+16(SB)/4, $0x0000000e
+DATA shiftCounts114<>+20(SB)/4, $0x0000000e
+DATA shiftCounts114<>+24(SB)/4, $0x0000000e
+DATA shiftCounts114<>+28(SB)/4, $0x0000000e
+GLOBL shiftCounts114<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts115<>+0(SB)/4, $0x00000018
+DATA shiftCounts115<>+4(SB)/4, $0x00000018
+DATA shiftCounts115<>+8(SB)/4, $0x00000018
+DATA shiftCounts115<>+12(SB)/4, $0x00000018
+DATA shiftCounts115<>+16(SB)/4, $0x00000019
+DATA shiftCounts115<>+20(SB)/4, $0x00000019
+DATA shiftCounts115<>+24(SB)/4, $0x00000019
+DATA shiftCounts115<>+28(SB)/4, $0x00000019
+GLOBL shiftCounts115<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts116<>+0(SB)/4, $0x00000007
+DATA shiftCounts116<>+4(SB)/4, $0x00000007
+DATA shiftCounts116<>+8(SB)/4, $0x00000007
+DATA shiftCounts116<>+12(SB)/4, $0x00000007
+DATA shiftCounts116<>+16(SB)/4, $0x00000006
+DATA shiftCounts116<>+20(SB)/4, $0x00000006
+DATA shiftCounts116<>+24(SB)/4, $0x00000006
+DATA shiftCounts116<>+28(SB)/4, $0x00000006
+GLOBL shiftCounts116<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts117<>+0(SB)/4, $0x00000004
+DATA shiftCounts117<>+4(SB)/4, $0x00000004
+DATA shiftCounts117<>+8(SB)/4, $0x00000004
+DATA shiftCounts117<>+12(SB)/4, $0x00000004
+DATA shiftCounts117<>+16(SB)/4, $0x00000005
+DATA shiftCounts117<>+20(SB)/4, $0x00000005
+DATA shiftCounts117<>+24(SB)/4, $0x00000005
+DATA shiftCounts117<>+28(SB)/4, $0x00000005
+GLOBL shiftCounts117<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts118<>+0(SB)/4, $0x0000000f
+DATA shiftCounts118<>+4(SB)/4, $0x0000000f
+DATA shiftCounts118<>+8(SB)/4, $0x0000000f
+DATA shiftCounts118<>+12(SB)/4, $0x0000000f
+DATA shiftCounts118<>+16(SB)/4, $0x00000010
+DATA shiftCounts118<>+20(SB)/4, $0x00000010
+DATA shiftCounts118<>+24(SB)/4, $0x00000010
+DATA shiftCounts118<>+28(SB)/4, $0x00000010
+GLOBL shiftCounts118<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts119<>+0(SB)/4, $0x0000001a
+DATA shiftCounts119<>+4(SB)/4, $0x0000001a
+DATA shiftCounts119<>+8(SB)/4, $0x0000001a
+DATA shiftCounts119<>+12(SB)/4, $0x0000001a
+DATA shiftCounts119<>+16(SB)/4, $0x0000001b
+DATA shiftCounts119<>+20(SB)/4, $0x0000001b
+DATA shiftCounts119<>+24(SB)/4, $0x0000001b
+DATA shiftCounts119<>+28(SB)/4, $0x0000001b
+GLOBL shiftCounts119<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts120<>+0(SB)/4, $0x00000005
+DATA shiftCounts120<>+4(SB)/4, $0x00000005
+DATA shiftCounts120<>+8(SB)/4, $0x00000005
+DATA shiftCounts120<>+12(SB)/4, $0x00000005
+DATA shiftCounts120<>+16(SB)/4, $0x00000004
+DATA shiftCounts120<>+20(SB)/4, $0x00000004
+DATA shiftCounts120<>+24(SB)/4, $0x00000004
+DATA shiftCounts120<>+28(SB)/4, $0x00000004
+GLOBL shiftCounts120<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts121<>+0(SB)/4, $0x00000006
+DATA shiftCounts121<>+4(SB)/4, $0x00000006
+DATA shiftCounts121<>+8(SB)/4, $0x00000006
+DATA shiftCounts121<>+12(SB)/4, $0x00000006
+DATA shiftCounts121<>+16(SB)/4, $0x00000007
+DATA shiftCounts121<>+20(SB)/4, $0x00000007
+DATA shiftCounts121<>+24(SB)/4, $0x00000007
+DATA shiftCounts121<>+28(SB)/4, $0x00000007
+GLOBL shiftCounts121<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts122<>+0(SB)/4, $0x00000011
+DATA shiftCounts122<>+4(SB)/4, $0x00000011
+DATA shiftCounts122<>+8(SB)/4, $0x00000011
+DATA shiftCounts122<>+12(SB)/4, $0x00000011
+DATA shiftCounts122<>+16(SB)/4, $0x00000012
+DATA shiftCounts122<>+20(SB)/4, $0x00000012
+DATA shiftCounts122<>+24(SB)/4, $0x00000012
+DATA shiftCounts122<>+28(SB)/4, $0x00000012
+GLOBL shiftCounts122<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts123<>+0(SB)/4, $0x0000001c
+DATA shiftCounts123<>+4(SB)/4, $0x0000001c
+DATA shiftCounts123<>+8(SB)/4, $0x0000001c
+DATA shiftCounts123<>+12(SB)/4, $0x0000001c
+DATA shiftCounts123<>+16(SB)/4, $0x0000001d
+DATA shiftCounts123<>+20(SB)/4, $0x0000001d
+DATA shiftCounts123<>+24(SB)/4, $0x0000001d
+DATA shiftCounts123<>+28(SB)/4, $0x0000001d
+GLOBL shiftCounts123<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts124<>+0(SB)/4, $0x00000003
+DATA shiftCounts124<>+4(SB)/4, $0x00000003
+DATA shiftCounts124<>+8(SB)/4, $0x00000003
+DATA shiftCounts124<>+12(SB)/4, $0x00000003
+DATA shiftCounts124<>+16(SB)/4, $0x00000002
+DATA shiftCounts124<>+20(SB)/4, $0x00000002
+DATA shiftCounts124<>+24(SB)/4, $0x00000002
+DATA shiftCounts124<>+28(SB)/4, $0x00000002
+GLOBL shiftCounts124<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts125<>+0(SB)/4, $0x00000008
+DATA shiftCounts125<>+4(SB)/4, $0x00000008
+DATA shiftCounts125<>+8(SB)/4, $0x00000008
+DATA shiftCounts125<>+12(SB)/4, $0x00000008
+DATA shiftCounts125<>+16(SB)/4, $0x00000009
+DATA shiftCounts125<>+20(SB)/4, $0x00000009
+DATA shiftCounts125<>+24(SB)/4, $0x00000009
+DATA shiftCounts125<>+28(SB)/4, $0x00000009
+GLOBL shiftCounts125<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts126<>+0(SB)/4, $0x00000013
+DATA shiftCounts126<>+4(SB)/4, $0x00000013
+DATA shiftCounts126<>+8(SB)/4, $0x00000013
+DATA shiftCounts126<>+12(SB)/4, $0x00000013
+DATA shiftCounts126<>+16(SB)/4, $0x00000014
+DATA shiftCounts126<>+20(SB)/4, $0x00000014
+DATA shiftCounts126<>+24(SB)/4, $0x00000014
+DATA shiftCounts126<>+28(SB)/4, $0x00000014
+GLOBL shiftCounts126<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts127<>+0(SB)/4, $0x0000001e
+DATA shiftCounts127<>+4(SB)/4, $0x0000001e
+DATA shiftCounts127<>+8(SB)/4, $0x0000001e
+DATA shiftCounts127<>+12(SB)/4, $0x0000001e
+DATA shiftCounts127<>+16(SB)/4, $0x0000001f
+DATA shiftCounts127<>+20(SB)/4, $0x0000001f
+DATA shiftCounts127<>+24(SB)/4, $0x0000001f
+DATA shiftCounts127<>+28(SB)/4, $0x0000001f
+GLOBL shiftCounts127<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_12(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_12(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VBROADCASTI128 (AX), Y0
+	VINSERTI128    $0x01, 32(AX), Y0, Y0
+	VPSRLVD        shiftCounts128<>+0(SB), Y0, Y0
+	VBROADCASTI128 16(AX), Y1
+	VINSERTI128    $0x01, 48(AX), Y1, Y1
+	VPSLLVD        shiftCounts129<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 32(AX), Y1
+	VINSERTI128    $0x01, 64(AX), Y1, Y1
+	VPSLLVD        shiftCounts130<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 80(AX), Y1
+	VPSLLVD        shiftCounts98<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VBROADCASTI128 80(AX), Y0
+	VINSERTI128    $0x01, 128(AX), Y0, Y0
+	VPSRLVD        shiftCounts55<>+0(SB), Y0, Y0
+	VBROADCASTI128 96(AX), Y1
+	VINSERTI128    $0x01, 144(AX), Y1, Y1
+	VPSLLVD        shiftCounts57<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 112(AX), Y1
+	VINSERTI128    $0x01, 160(AX), Y1, Y1
+	VPSLLVD        shiftCounts59<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VBROADCASTI128 160(AX), Y0
+	VINSERTI128    $0x01, 208(AX), Y0, Y0
+	VPSRLVD        shiftCounts131<>+0(SB), Y0, Y0
+	VBROADCASTI128 176(AX), Y1
+	VINSERTI128    $0x01, 224(AX), Y1, Y1
+	VPSLLVD        shiftCounts132<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 192(AX), Y1
+	VINSERTI128    $0x01, 240(AX), Y1, Y1
+	VPSLLVD        shiftCounts133<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 208(AX), Y1
+	VPSLLVD        shiftCounts28<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VBROADCASTI128 256(AX), Y0
+	VINSERTI128    $0x01, 288(AX), Y0, Y0
+	VPSRLVD        shiftCounts128<>+0(SB), Y0, Y0
+	VBROADCASTI128 272(AX), Y1
+	VINSERTI128    $0x01, 304(AX), Y1, Y1
+	VPSLLVD        shiftCounts129<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 288(AX), Y1
+	VINSERTI128    $0x01, 320(AX), Y1, Y1
+	VPSLLVD        shiftCounts130<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 336(AX), Y1
+	VPSLLVD        shiftCounts98<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VBROADCASTI128 336(AX), Y0
+	VINSERTI128    $0x01, 384(AX), Y0, Y0
+	VPSRLVD        shiftCounts55<>+0(SB), Y0, Y0
+	VBROADCASTI128 352(AX), Y1
+	VINSERTI128    $0x01, 400(AX), Y1, Y1
+	VPSLLVD        shiftCounts57<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 368(AX), Y1
+	VINSERTI128    $0x01, 416(AX), Y1, Y1
+	VPSLLVD        shiftCounts59<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 128(CX)
+	VBROADCASTI128 416(AX), Y0
+	VINSERTI128    $0x01, 464(AX), Y0, Y0
+	VPSRLVD        shiftCounts131<>+0(SB), Y0, Y0
+	VBROADCASTI128 432(AX), Y1
+	VINSERTI128    $0x01, 480(AX), Y1, Y1
+	VPSLLVD        shiftCounts132<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 448(AX), Y1
+	VINSERTI128    $0x01, 496(AX), Y1, Y1
+	VPSLLVD        shiftCounts133<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 464(AX), Y1
+	VPSLLVD        shiftCounts28<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 160(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts128<>+0(SB)/4, $0x00000000
+DATA shiftCounts128<>+4(SB)/4, $0x00000000
+DATA shiftCounts128<>+8(SB)/4, $0x00000000
+DATA shiftCounts128<>+12(SB)/4, $0x00000000
+DATA shiftCounts128<>+16(SB)/4, $0x00000008
+DATA shiftCounts128<>+20(SB)/4, $0x00000008
+DATA shiftCounts128<>+24(SB)/4, $0x00000008
+DATA shiftCounts128<>+28(SB)/4, $0x00000008
+GLOBL shiftCounts128<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts129<>+0(SB)/4, $0x0000000c
+DATA shiftCounts129<>+4(SB)/4, $0x0000000c
+DATA shiftCounts129<>+8(SB)/4, $0x0000000c
+DATA shiftCounts129<>+12(SB)/4, $0x0000000c
+DATA shiftCounts129<>+16(SB)/4, $0x00000004
+DATA shiftCounts129<>+20(SB)/4, $0x00000004
+DATA shiftCounts129<>+24(SB)/4, $0x00000004
+DATA shiftCounts129<>+28(SB)/4, $0x00000004
+GLOBL shiftCounts129<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts130<>+0(SB)/4, $0x00000018
+DATA shiftCounts130<>+4(SB)/4, $0x00000018
+DATA shiftCounts130<>+8(SB)/4, $0x00000018
+DATA shiftCounts130<>+12(SB)/4, $0x00000018
+DATA shiftCounts130<>+16(SB)/4, $0x00000010
+DATA shiftCounts130<>+20(SB)/4, $0x00000010
+DATA shiftCounts130<>+24(SB)/4, $0x00000010
+DATA shiftCounts130<>+28(SB)/4, $0x00000010
+GLOBL shiftCounts130<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts131<>+0(SB)/4, $0x00000008
+DATA shiftCounts131<>+4(SB)/4, $0x00000008
+DATA shiftCounts131<>+8(SB)/4, $0x00000008
+DATA shiftCounts131<>+12(SB)/4, $0x00000008
+DATA shiftCounts131<>+16(SB)/4, $0x00000004
+DATA shiftCounts131<>+20(SB)/4, $0x00000004
+DATA shiftCounts131<>+24(SB)/4, $0x00000004
+DATA shiftCounts131<>+28(SB)/4, $0x00000004
+GLOBL shiftCounts131<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts132<>+0(SB)/4, $0x00000004
+DATA shiftCounts132<>+4(SB)/4, $0x00000004
+DATA shiftCounts132<>+8(SB)/4, $0x00000004
+DATA shiftCounts132<>+12(SB)/4, $0x00000004
+DATA shiftCounts132<>+16(SB)/4, $0x00000008
+DATA shiftCounts132<>+20(SB)/4, $0x00000008
+DATA shiftCounts132<>+24(SB)/4, $0x00000008
+DATA shiftCounts132<>+28(SB)/4, $0x00000008
+GLOBL shiftCounts132<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts133<>+0(SB)/4, $0x00000010
+DATA shiftCounts133<>+4(SB)/4, $0x00000010
+DATA shiftCounts133<>+8(SB)/4, $0x00000010
+DATA shiftCounts133<>+12(SB)/4, $0x00000010
+DATA shiftCounts133<>+16(SB)/4, $0x00000014
+DATA shiftCounts133<>+20(SB)/4, $0x00000014
+DATA shiftCounts133<>+24(SB)/4, $0x00000014
+DATA shiftCounts133<>+28(SB)/4, $0x00000014
+GLOBL shiftCounts133<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_13(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_13(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VBROADCASTI128 (AX), Y0
+	VINSERTI128    $0x01, 32(AX), Y0, Y0
+	VPSRLVD        shiftCounts134<>+0(SB), Y0, Y0
+	VBROADCASTI128 16(AX), Y1
+	VINSERTI128    $0x01, 48(AX), Y1, Y1
+	VPSLLVD        shiftCounts135<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 32(AX), Y1
+	VINSERTI128    $0x01, 64(AX), Y1, Y1
+	VPSLLVD        shiftCounts136<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VBROADCASTI128 64(AX), Y0
+	VINSERTI128    $0x01, 112(AX), Y0, Y0
+	VPSRLVD        shiftCounts137<>+0(SB), Y0, Y0
+	VBROADCASTI128 80(AX), Y1
+	VINSERTI128    $0x01, 128(AX), Y1, Y1
+	VPSLLVD        shiftCounts138<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 96(AX), Y1
+	VINSERTI128    $0x01, 144(AX), Y1, Y1
+	VPSLLVD        shiftCounts139<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 112(AX), Y1
+	VPSLLVD        shiftCounts27<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VBROADCASTI128 144(AX), Y0
+	VINSERTI128    $0x01, 192(AX), Y0, Y0
+	VPSRLVD        shiftCounts140<>+0(SB), Y0, Y0
+	VBROADCASTI128 160(AX), Y1
+	VINSERTI128    $0x01, 208(AX), Y1, Y1
+	VPSLLVD        shiftCounts141<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 176(AX), Y1
+	VINSERTI128    $0x01, 224(AX), Y1, Y1
+	VPSLLVD        shiftCounts142<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 192(AX), Y1
+	VPSLLVD        shiftCounts28<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VBROADCASTI128 224(AX), Y0
+	VINSERTI128    $0x01, 272(AX), Y0, Y0
+	VPSRLVD        shiftCounts143<>+0(SB), Y0, Y0
+	VBROADCASTI128 240(AX), Y1
+	VINSERTI128    $0x01, 288(AX), Y1, Y1
+	VPSLLVD        shiftCounts144<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 256(AX), Y1
+	VINSERTI128    $0x01, 304(AX), Y1, Y1
+	VPSLLVD        shiftCounts145<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 272(AX), Y1
+	VPSLLVD        shiftCounts29<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VBROADCASTI128 304(AX), Y0
+	VINSERTI128    $0x01, 352(AX), Y0, Y0
+	VPSRLVD        shiftCounts146<>+0(SB), Y0, Y0
+	VBROADCASTI128 320(AX), Y1
+	VINSERTI128    $0x01, 368(AX), Y1, Y1
+	VPSLLVD        shiftCounts147<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 336(AX), Y1
+	VINSERTI128    $0x01, 384(AX), Y1, Y1
+	VPSLLVD        shiftCounts148<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 352(AX), Y1
+	VPSLLVD        shiftCounts30<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 128(CX)
+	VBROADCASTI128 384(AX), Y0
+	VINSERTI128    $0x01, 432(AX), Y0, Y0
+	VPSRLVD        shiftCounts149<>+0(SB), Y0, Y0
+	VBROADCASTI128 400(AX), Y1
+	VINSERTI128    $0x01, 448(AX), Y1, Y1
+	VPSLLVD        shiftCounts150<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 416(AX), Y1
+	VINSERTI128    $0x01, 464(AX), Y1, Y1
+	VPSLLVD        shiftCounts151<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 432(AX), Y1
+	VPSLLVD        shiftCounts31<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 160(CX)
+	VBROADCASTI128 464(AX), Y0
+	VPSRLVD        shiftCounts7<>+0(SB), Y0, Y0
+	VBROADCASTI128 480(AX), Y1
+	VPSLLVD        shiftCounts6<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 496(AX), Y1
+	VPSLLVD        shiftCounts19<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        X0, 192(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts134<>+0(SB)/4, $0x00000000
+DATA shiftCounts134<>+4(SB)/4, $0x00000000
+DATA shiftCounts134<>+8(SB)/4, $0x00000000
+DATA shiftCounts134<>+12(SB)/4, $0x00000000
+DATA shiftCounts134<>+16(SB)/4, $0x00000006
+DATA shiftCounts134<>+20(SB)/4, $0x00000006
+DATA shiftCounts134<>+24(SB)/4, $0x00000006
+DATA shiftCounts134<>+28(SB)/4, $0x00000006
+GLOBL shiftCounts134<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts135<>+0(SB)/4, $0x0000000d
+DATA shiftCounts135<>+4(SB)/4, $0x0000000d
+DATA shiftCounts135<>+8(SB)/4, $0x0000000d
+DATA shiftCounts135<>+12(SB)/4, $0x0000000d
+DATA shiftCounts135<>+16(SB)/4, $0x00000007
+DATA shiftCounts135<>+20(SB)/4, $0x00000007
+DATA shiftCounts135<>+24(SB)/4, $0x00000007
+DATA shiftCounts135<>+28(SB)/4, $0x00000007
+GLOBL shiftCounts135<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts136<>+0(SB)/4, $0x0000001a
+DATA shiftCounts136<>+4(SB)/4, $0x0000001a
+DATA shiftCounts136<>+8(SB)/4, $0x0000001a
+DATA shiftCounts136<>+12(SB)/4, $0x0000001a
+DATA shiftCounts136<>+16(SB)/4, $0x00000014
+DATA shiftCounts136<>+20(SB)/4, $0x00000014
+DATA shiftCounts136<>+24(SB)/4, $0x00000014
+DATA shiftCounts136<>+28(SB)/4, $0x00000014
+GLOBL shiftCounts136<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts137<>+0(SB)/4, $0x0000000c
+DATA shiftCounts137<>+4(SB)/4, $0x0000000c
+DATA shiftCounts137<>+8(SB)/4, $0x0000000c
+DATA shiftCounts137<>+12(SB)/4, $0x0000000c
+DATA shiftCounts137<>+16(SB)/4, $0x00000005
+DATA shiftCounts137<>+20(SB)/4, $0x00000005
+DATA shiftCounts137<>+24(SB)/4, $0x00000005
+DATA shiftCounts137<>+28(SB)/4, $0x00000005
+GLOBL shiftCounts137<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts138<>+0(SB)/4, $0x00000001
+DATA shiftCounts138<>+4(SB)/4, $0x00000001
+DATA shiftCounts138<>+8(SB)/4, $0x00000001
+DATA shiftCounts138<>+12(SB)/4, $0x00000001
+DATA shiftCounts138<>+16(SB)/4, $0x00000008
+DATA shiftCounts138<>+20(SB)/4, $0x00000008
+DATA shiftCounts138<>+24(SB)/4, $0x00000008
+DATA shiftCounts138<>+28(SB)/4, $0x00000008
+GLOBL shiftCounts138<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts139<>+0(SB)/4, $0x0000000e
+DATA shiftCounts139<>+4(SB)/4, $0x0000000e
+DATA shiftCounts139<>+8(SB)/4, $0x0000000e
+DATA shiftCounts139<>+12(SB)/4, $0x0000000e
+DATA shiftCounts139<>+16(SB)/4, $0x00000015
+DATA shiftCounts139<>+20(SB)/4, $0x00000015
+DATA shiftCounts139<>+24(SB)/4, $0x00000015
+DATA shiftCounts139<>+28(SB)/4, $0x00000015
+GLOBL shiftCounts139<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts140<>+0(SB)/4, $0x0000000b
+DATA shiftCounts140<>+4(SB)/4, $0x0000000b
+DATA shiftCounts140<>+8(SB)/4, $0x0000000b
+DATA shiftCounts140<>+12(SB)/4, $0x0000000b
+DATA shiftCounts140<>+16(SB)/4, $0x00000004
+DATA shiftCounts140<>+20(SB)/4, $0x00000004
+DATA shiftCounts140<>+24(SB)/4, $0x00000004
+DATA shiftCounts140<>+28(SB)/4, $0x00000004
+GLOBL shiftCounts140<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts141<>+0(SB)/4, $0x00000002
+DATA shiftCounts141<>+4(SB)/4, $0x00000002
+DATA shiftCounts141<>+8(SB)/4, $0x00000002
+DATA shiftCounts141<>+12(SB)/4, $0x00000002
+DATA shiftCounts141<>+16(SB)/4, $0x00000009
+DATA shiftCounts141<>+20(SB)/4, $0x00000009
+DATA shiftCounts141<>+24(SB)/4, $0x00000009
+DATA shiftCounts141<>+28(SB)/4, $0x00000009
+GLOBL shiftCounts141<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts142<>+0(SB)/4, $0x0000000f
+DATA shiftCounts142<>+4(SB)/4, $0x0000000f
+DATA shiftCounts142<>+8(SB)/4, $0x0000000f
+DATA shiftCounts142<>+12(SB)/4, $0x0000000f
+DATA shiftCounts142<>+16(SB)/4, $0x00000016
+DATA shiftCounts142<>+20(SB)/4, $0x00000016
+DATA shiftCounts142<>+24(SB)/4, $0x00000016
+DATA shiftCounts142<>+28(SB)/4, $0x00000016
+GLOBL shiftCounts142<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts143<>+0(SB)/4, $0x0000000a
+DATA shiftCounts143<>+4(SB)/4, $0x0000000a
+DATA shiftCounts143<>+8(SB)/4, $0x0000000a
+DATA shiftCounts143<>+12(SB)/4, $0x0000000a
+DATA shiftCounts143<>+16(SB)/4, $0x00000003
+DATA shiftCounts143<>+20(SB)/4, $0x00000003
+DATA shiftCounts143<>+24(SB)/4, $0x00000003
+DATA shiftCounts143<>+28(SB)/4, $0x00000003
+GLOBL shiftCounts143<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts144<>+0(SB)/4, $0x00000003
+DATA shiftCounts144<>+4(SB)/4, $0x00000003
+DATA shiftCounts144<>+8(SB)/4, $0x00000003
+DATA shiftCounts144<>+12(SB)/4, $0x00000003
+DATA shiftCounts144<>+16(SB)/4, $0x0000000a
+DATA shiftCounts144<>+20(SB)/4, $0x0000000a
+DATA shiftCounts144<>+24(SB)/4, $0x0000000a
+DATA shiftCounts144<>+28(SB)/4, $0x0000000a
+GLOBL shiftCounts144<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts145<>+0(SB)/4, $0x00000010
+DATA shiftCounts145<>+4(SB)/4, $0x00000010
+DATA shiftCounts145<>+8(SB)/4, $0x00000010
+DATA shiftCounts145<>+12(SB)/4, $0x00000010
+DATA shiftCounts145<>+16(SB)/4, $0x00000017
+DATA shiftCounts145<>+20(SB)/4, $0x00000017
+DATA shiftCounts145<>+24(SB)/4, $0x00000017
+DATA shiftCounts145<>+28(SB)/4, $0x00000017
+GLOBL shiftCounts145<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts146<>+0(SB)/4, $0x00000009
+DATA shiftCounts146<>+4(SB)/4, $0x00000009
+DATA shiftCounts146<>+8(SB)/4, $0x00000009
+DATA shiftCounts146<>+12(SB)/4, $0x00000009
+DATA shiftCounts146<>+16(SB)/4, $0x00000002
+DATA shiftCounts146<>+20(SB)/4, $0x00000002
+DATA shiftCounts146<>+24(SB)/4, $0x00000002
+DATA shiftCounts146<>+28(SB)/4, $0x00000002
+GLOBL shiftCounts146<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts147<>+0(SB)/4, $0x00000004
+DATA shiftCounts147<>+4(SB)/4, $0x00000004
+DATA shiftCounts147<>+8(SB)/4, $0x00000004
+DATA shiftCounts147<>+12(SB)/4, $0x00000004
+DATA shiftCounts147<>+16(SB)/4, $0x0000000b
+DATA shiftCounts147<>+20(SB)/4, $0x0000000b
+DATA shiftCounts147<>+24(SB)/4, $0x0000000b
+DATA shiftCounts147<>+28(SB)/4, $0x0000000b
+GLOBL shiftCounts147<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts148<>+0(SB)/4, $0x00000011
+DATA shiftCounts148<>+4(SB)/4, $0x00000011
+DATA shiftCounts148<>+8(SB)/4, $0x00000011
+DATA shiftCounts148<>+12(SB)/4, $0x00000011
+DATA shiftCounts148<>+16(SB)/4, $0x00000018
+DATA shiftCounts148<>+20(SB)/4, $0x00000018
+DATA shiftCounts148<>+24(SB)/4, $0x00000018
+DATA shiftCounts148<>+28(SB)/4, $0x00000018
+GLOBL shiftCounts148<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts149<>+0(SB)/4, $0x00000008
+DATA shiftCounts149<>+4(SB)/4, $0x00000008
+DATA shiftCounts149<>+8(SB)/4, $0x00000008
+DATA shiftCounts149<>+12(SB)/4, $0x00000008
+DATA shiftCounts149<>+16(SB)/4, $0x00000001
+DATA shiftCounts149<>+20(SB)/4, $0x00000001
+DATA shiftCounts149<>+24(SB)/4, $0x00000001
+DATA shiftCounts149<>+28(SB)/4, $0x00000001
+GLOBL shiftCounts149<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts150<>+0(SB)/4, $0x00000005
+DATA shiftCounts150<>+4(SB)/4, $0x00000005
+DATA shiftCounts150<>+8(SB)/4, $0x00000005
+DATA shiftCounts150<>+12(SB)/4, $0x00000005
+DATA shiftCounts150<>+16(SB)/4, $0x0000000c
+DATA shiftCounts150<>+20(SB)/4, $0x0000000c
+DATA shiftCounts150<>+24(SB)/4, $0x0000000c
+DATA shiftCounts150<>+28(SB)/4, $0x0000000c
+GLOBL shiftCounts150<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts151<>+0(SB)/4, $0x00000012
+DATA shiftCounts151<>+4(SB)/4, $0x00000012
+DATA shiftCounts151<>+8(SB)/4, $0x00000012
+DATA shiftCounts151<>+12(SB)/4, $0x00000012
+DATA shiftCounts151<>+16(SB)/4, $0x00000019
+DATA shiftCounts151<>+20(SB)/4, $0x00000019
+DATA shiftCounts151<>+24(SB)/4, $0x00000019
+DATA shiftCounts151<>+28(SB)/4, $0x00000019
+GLOBL shiftCounts151<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_14(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_14(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VBROADCASTI128 (AX), Y0
+	VINSERTI128    $0x01, 32(AX), Y0, Y0
+	VPSRLVD        shiftCounts66<>+0(SB), Y0, Y0
+	VBROADCASTI128 16(AX), Y1
+	VINSERTI128    $0x01, 48(AX), Y1, Y1
+	VPSLLVD        shiftCounts68<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 32(AX), Y1
+	VINSERTI128    $0x01, 64(AX), Y1, Y1
+	VPSLLVD        shiftCounts70<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VBROADCASTI128 64(AX), Y0
+	VINSERTI128    $0x01, 96(AX), Y0, Y0
+	VPSRLVD        shiftCounts57<>+0(SB), Y0, Y0
+	VBROADCASTI128 80(AX), Y1
+	VINSERTI128    $0x01, 112(AX), Y1, Y1
+	VPSLLVD        shiftCounts72<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 96(AX), Y1
+	VINSERTI128    $0x01, 128(AX), Y1, Y1
+	VPSLLVD        shiftCounts74<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 144(AX), Y1
+	VPSLLVD        shiftCounts76<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VBROADCASTI128 144(AX), Y0
+	VINSERTI128    $0x01, 176(AX), Y0, Y0
+	VPSRLVD        shiftCounts56<>+0(SB), Y0, Y0
+	VBROADCASTI128 160(AX), Y1
+	VINSERTI128    $0x01, 192(AX), Y1, Y1
+	VPSLLVD        shiftCounts78<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 176(AX), Y1
+	VINSERTI128    $0x01, 208(AX), Y1, Y1
+	VPSLLVD        shiftCounts80<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VBROADCASTI128 208(AX), Y0
+	VINSERTI128    $0x01, 256(AX), Y0, Y0
+	VPSRLVD        shiftCounts152<>+0(SB), Y0, Y0
+	VBROADCASTI128 224(AX), Y1
+	VINSERTI128    $0x01, 272(AX), Y1, Y1
+	VPSLLVD        shiftCounts153<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 240(AX), Y1
+	VINSERTI128    $0x01, 288(AX), Y1, Y1
+	VPSLLVD        shiftCounts154<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VBROADCASTI128 288(AX), Y0
+	VINSERTI128    $0x01, 320(AX), Y0, Y0
+	VPSRLVD        shiftCounts132<>+0(SB), Y0, Y0
+	VBROADCASTI128 304(AX), Y1
+	VINSERTI128    $0x01, 336(AX), Y1, Y1
+	VPSLLVD        shiftCounts155<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 320(AX), Y1
+	VINSERTI128    $0x01, 352(AX), Y1, Y1
+	VPSLLVD        shiftCounts156<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 128(CX)
+	VBROADCASTI128 352(AX), Y0
+	VINSERTI128    $0x01, 400(AX), Y0, Y0
+	VPSRLVD        shiftCounts157<>+0(SB), Y0, Y0
+	VBROADCASTI128 368(AX), Y1
+	VINSERTI128    $0x01, 416(AX), Y1, Y1
+	VPSLLVD        shiftCounts158<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 384(AX), Y1
+	VINSERTI128    $0x01, 432(AX), Y1, Y1
+	VPSLLVD        shiftCounts159<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 400(AX), Y1
+	VPSLLVD        shiftCounts30<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 160(CX)
+	VBROADCASTI128 432(AX), Y0
+	VINSERTI128    $0x01, 464(AX), Y0, Y0
+	VPSRLVD        shiftCounts160<>+0(SB), Y0, Y0
+	VBROADCASTI128 448(AX), Y1
+	VINSERTI128    $0x01, 480(AX), Y1, Y1
+	VPSLLVD        shiftCounts131<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 464(AX), Y1
+	VINSERTI128    $0x01, 496(AX), Y1, Y1
+	VPSLLVD        shiftCounts161<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 192(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts152<>+0(SB)/4, $0x0000000a
+DATA shiftCounts152<>+4(SB)/4, $0x0000000a
+DATA shiftCounts152<>+8(SB)/4, $0x0000000a
+DATA shiftCounts152<>+12(SB)/4, $0x0000000a
+DATA shiftCounts152<>+16(SB)/4, $0x00000000
+DATA shiftCounts152<>+20(SB)/4, $0x00000000
+DATA shiftCounts152<>+24(SB)/4, $0x00000000
+DATA shiftCounts152<>+28(SB)/4, $0x00000000
+GLOBL shiftCounts152<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts153<>+0(SB)/4, $0x00000004
+DATA shiftCounts153<>+4(SB)/4, $0x00000004
+DATA shiftCounts153<>+8(SB)/4, $0x00000004
+DATA shiftCounts153<>+12(SB)/4, $0x00000004
+DATA shiftCounts153<>+16(SB)/4, $0x0000000e
+DATA shiftCounts153<>+20(SB)/4, $0x0000000e
+DATA shiftCounts153<>+24(SB)/4, $0x0000000e
+DATA shiftCounts153<>+28(SB)/4, $0x0000000e
+GLOBL shiftCounts153<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts154<>+0(SB)/4, $0x00000012
+DATA shiftCounts154<>+4(SB)/4, $0x00000012
+DATA shiftCounts154<>+8(SB)/4, $0x00000012
+DATA shiftCounts154<>+12(SB)/4, $0x00000012
+DATA shiftCounts154<>+16(SB)/4, $0x0000001c
+DATA shiftCounts154<>+20(SB)/4, $0x0000001c
+DATA shiftCounts154<>+24(SB)/4, $0x0000001c
+DATA shiftCounts154<>+28(SB)/4, $0x0000001c
+GLOBL shiftCounts154<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts155<>+0(SB)/4, $0x0000000a
+DATA shiftCounts155<>+4(SB)/4, $0x0000000a
+DATA shiftCounts155<>+8(SB)/4, $0x0000000a
+DATA shiftCounts155<>+12(SB)/4, $0x0000000a
+DATA shiftCounts155<>+16(SB)/4, $0x00000006
+DATA shiftCounts155<>+20(SB)/4, $0x00000006
+DATA shiftCounts155<>+24(SB)/4, $0x00000006
+DATA shiftCounts155<>+28(SB)/4, $0x00000006
+GLOBL shiftCounts155<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts156<>+0(SB)/4, $0x00000018
+DATA shiftCounts156<>+4(SB)/4, $0x00000018
+DATA shiftCounts156<>+8(SB)/4, $0x00000018
+DATA shiftCounts156<>+12(SB)/4, $0x00000018
+DATA shiftCounts156<>+16(SB)/4, $0x00000014
+DATA shiftCounts156<>+20(SB)/4, $0x00000014
+DATA shiftCounts156<>+24(SB)/4, $0x00000014
+DATA shiftCounts156<>+28(SB)/4, $0x00000014
+GLOBL shiftCounts156<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts157<>+0(SB)/4, $0x0000000c
+DATA shiftCounts157<>+4(SB)/4, $0x0000000c
+DATA shiftCounts157<>+8(SB)/4, $0x0000000c
+DATA shiftCounts157<>+12(SB)/4, $0x0000000c
+DATA shiftCounts157<>+16(SB)/4, $0x00000002
+DATA shiftCounts157<>+20(SB)/4, $0x00000002
+DATA shiftCounts157<>+24(SB)/4, $0x00000002
+DATA shiftCounts157<>+28(SB)/4, $0x00000002
+GLOBL shiftCounts157<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts158<>+0(SB)/4, $0x00000002
+DATA shiftCounts158<>+4(SB)/4, $0x00000002
+DATA shiftCounts158<>+8(SB)/4, $0x00000002
+DATA shiftCounts158<>+12(SB)/4, $0x00000002
+DATA shiftCounts158<>+16(SB)/4, $0x0000000c
+DATA shiftCounts158<>+20(SB)/4, $0x0000000c
+DATA shiftCounts158<>+24(SB)/4, $0x0000000c
+DATA shiftCounts158<>+28(SB)/4, $0x0000000c
+GLOBL shiftCounts158<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts159<>+0(SB)/4, $0x00000010
+DATA shiftCounts159<>+4(SB)/4, $0x00000010
+DATA shiftCounts159<>+8(SB)/4, $0x00000010
+DATA shiftCounts159<>+12(SB)/4, $0x00000010
+DATA shiftCounts159<>+16(SB)/4, $0x0000001a
+DATA shiftCounts159<>+20(SB)/4, $0x0000001a
+DATA shiftCounts159<>+24(SB)/4, $0x0000001a
+DATA shiftCounts159<>+28(SB)/4, $0x0000001a
+GLOBL shiftCounts159<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts160<>+0(SB)/4, $0x00000006
+DATA shiftCounts160<>+4(SB)/4, $0x00000006
+DATA shiftCounts160<>+8(SB)/4, $0x00000006
+DATA shiftCounts160<>+12(SB)/4, $0x00000006
+DATA shiftCounts160<>+16(SB)/4, $0x0000000a
+DATA shiftCounts160<>+20(SB)/4, $0x0000000a
+DATA shiftCounts160<>+24(SB)/4, $0x0000000a
+DATA shiftCounts160<>+28(SB)/4, $0x0000000a
+GLOBL shiftCounts160<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts161<>+0(SB)/4, $0x00000016
+DATA shiftCounts161<>+4(SB)/4, $0x00000016
+DATA shiftCounts161<>+8(SB)/4, $0x00000016
+DATA shiftCounts161<>+12(SB)/4, $0x00000016
+DATA shiftCounts161<>+16(SB)/4, $0x00000012
+DATA shiftCounts161<>+20(SB)/4, $0x00000012
+DATA shiftCounts161<>+24(SB)/4, $0x00000012
+DATA shiftCounts161<>+28(SB)/4, $0x00000012
+GLOBL shiftCounts161<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_15(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_15(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VBROADCASTI128 (AX), Y0
+	VINSERTI128    $0x01, 32(AX), Y0, Y0
+	VPSRLVD        shiftCounts32<>+0(SB), Y0, Y0
+	VBROADCASTI128 16(AX), Y1
+	VINSERTI128    $0x01, 48(AX), Y1, Y1
+	VPSLLVD        shiftCounts37<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 32(AX), Y1
+	VINSERTI128    $0x01, 64(AX), Y1, Y1
+	VPSLLVD        shiftCounts42<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VBROADCASTI128 64(AX), Y0
+	VINSERTI128    $0x01, 96(AX), Y0, Y0
+	VPSRLVD        shiftCounts99<>+0(SB), Y0, Y0
+	VBROADCASTI128 80(AX), Y1
+	VINSERTI128    $0x01, 112(AX), Y1, Y1
+	VPSLLVD        shiftCounts162<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 96(AX), Y1
+	VINSERTI128    $0x01, 128(AX), Y1, Y1
+	VPSLLVD        shiftCounts100<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VBROADCASTI128 128(AX), Y0
+	VINSERTI128    $0x01, 160(AX), Y0, Y0
+	VPSRLVD        shiftCounts163<>+0(SB), Y0, Y0
+	VBROADCASTI128 144(AX), Y1
+	VINSERTI128    $0x01, 176(AX), Y1, Y1
+	VPSLLVD        shiftCounts164<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 160(AX), Y1
+	VINSERTI128    $0x01, 192(AX), Y1, Y1
+	VPSLLVD        shiftCounts64<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VBROADCASTI128 192(AX), Y0
+	VINSERTI128    $0x01, 224(AX), Y0, Y0
+	VPSRLVD        shiftCounts165<>+0(SB), Y0, Y0
+	VBROADCASTI128 208(AX), Y1
+	VINSERTI128    $0x01, 240(AX), Y1, Y1
+	VPSLLVD        shiftCounts33<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 224(AX), Y1
+	VINSERTI128    $0x01, 256(AX), Y1, Y1
+	VPSLLVD        shiftCounts38<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 272(AX), Y1
+	VPSLLVD        shiftCounts43<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VBROADCASTI128 272(AX), Y0
+	VINSERTI128    $0x01, 304(AX), Y0, Y0
+	VPSRLVD        shiftCounts166<>+0(SB), Y0, Y0
+	VBROADCASTI128 288(AX), Y1
+	VINSERTI128    $0x01, 320(AX), Y1, Y1
+	VPSLLVD        shiftCounts107<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 304(AX), Y1
+	VINSERTI128    $0x01, 336(AX), Y1, Y1
+	VPSLLVD        shiftCounts167<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 128(CX)
+	VBROADCASTI128 336(AX), Y0
+	VINSERTI128    $0x01, 368(AX), Y0, Y0
+	VPSRLVD        shiftCounts168<>+0(SB), Y0, Y0
+	VBROADCASTI128 352(AX), Y1
+	VINSERTI128    $0x01, 384(AX), Y1, Y1
+	VPSLLVD        shiftCounts45<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 368(AX), Y1
+	VINSERTI128    $0x01, 400(AX), Y1, Y1
+	VPSLLVD        shiftCounts47<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 160(CX)
+	VBROADCASTI128 400(AX), Y0
+	VINSERTI128    $0x01, 432(AX), Y0, Y0
+	VPSRLVD        shiftCounts169<>+0(SB), Y0, Y0
+	VBROADCASTI128 416(AX), Y1
+	VINSERTI128    $0x01, 448(AX), Y1, Y1
+	VPSLLVD        shiftCounts34<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 432(AX), Y1
+	VINSERTI128    $0x01, 464(AX), Y1, Y1
+	VPSLLVD        shiftCounts39<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 192(CX)
+	VBROADCASTI128 464(AX), Y0
+	VPSRLVD        shiftCounts13<>+0(SB), Y0, Y0
+	VBROADCASTI128 480(AX), Y1
+	VPSLLVD        shiftCounts2<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 496(AX), Y1
+	VPSLLVD        shiftCounts17<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        X0, 224(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts162<>+0(SB)/4, $0x0000000b
+DATA shiftCounts162<>+4(SB)/4, $0x0000000b
+DATA shiftCounts162<>+8(SB)/4, $0x0000000b
+DATA shiftCounts162<>+12(SB)/4, $0x0000000b
+DATA shiftCounts162<>+16(SB)/4, $0x00000009
+DATA shiftCounts162<>+20(SB)/4, $0x00000009
+DATA shiftCounts162<>+24(SB)/4, $0x00000009
+DATA shiftCounts162<>+28(SB)/4, $0x00000009
+GLOBL shiftCounts162<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts163<>+0(SB)/4, $0x00000008
+DATA shiftCounts163<>+4(SB)/4, $0x00000008
+DATA shiftCounts163<>+8(SB)/4, $0x00000008
+DATA shiftCounts163<>+12(SB)/4, $0x00000008
+DATA shiftCounts163<>+16(SB)/4, $0x0000000a
+DATA shiftCounts163<>+20(SB)/4, $0x0000000a
+DATA shiftCounts163<>+24(SB)/4, $0x0000000a
+DATA shiftCounts163<>+28(SB)/4, $0x0000000a
+GLOBL shiftCounts163<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts164<>+0(SB)/4, $0x00000007
+DATA shiftCounts164<>+4(SB)/4, $0x00000007
+DATA shiftCounts164<>+8(SB)/4, $0x00000007
+DATA shiftCounts164<>+12(SB)/4, $0x00000007
+DATA shiftCounts164<>+16(SB)/4, $0x00000005
+DATA shiftCounts164<>+20(SB)/4, $0x00000005
+DATA shiftCounts164<>+24(SB)/4, $0x00000005
+DATA shiftCounts164<>+28(SB)/4, $0x00000005
+GLOBL shiftCounts164<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts165<>+0(SB)/4, $0x0000000c
+DATA shiftCounts165<>+4(SB)/4, $0x0000000c
+DATA shiftCounts165<>+8(SB)/4, $0x0000000c
+DATA shiftCounts165<>+12(SB)/4, $0x0000000c
+DATA shiftCounts165<>+16(SB)/4, $0x0000000e
+DATA shiftCounts165<>+20(SB)/4, $0x0000000e
+DATA shiftCounts165<>+24(SB)/4, $0x0000000e
+DATA shiftCounts165<>+28(SB)/4, $0x0000000e
+GLOBL shiftCounts165<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts166<>+0(SB)/4, $0x00000001
+DATA shiftCounts166<>+4(SB)/4, $0x00000001
+DATA shiftCounts166<>+8(SB)/4, $0x00000001
+DATA shiftCounts166<>+12(SB)/4, $0x00000001
+DATA shiftCounts166<>+16(SB)/4, $0x00000003
+DATA shiftCounts166<>+20(SB)/4, $0x00000003
+DATA shiftCounts166<>+24(SB)/4, $0x00000003
+DATA shiftCounts166<>+28(SB)/4, $0x00000003
+GLOBL shiftCounts166<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts167<>+0(SB)/4, $0x0000001d
+DATA shiftCounts167<>+4(SB)/4, $0x0000001d
+DATA shiftCounts167<>+8(SB)/4, $0x0000001d
+DATA shiftCounts167<>+12(SB)/4, $0x0000001d
+DATA shiftCounts167<>+16(SB)/4, $0x0000001b
+DATA shiftCounts167<>+20(SB)/4, $0x0000001b
+DATA shiftCounts167<>+24(SB)/4, $0x0000001b
+DATA shiftCounts167<>+28(SB)/4, $0x0000001b
+GLOBL shiftCounts167<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts168<>+0(SB)/4, $0x00000005
+DATA shiftCounts168<>+4(SB)/4, $0x00000005
+DATA shiftCounts168<>+8(SB)/4, $0x00000005
+DATA shiftCounts168<>+12(SB)/4, $0x00000005
+DATA shiftCounts168<>+16(SB)/4, $0x00000007
+DATA shiftCounts168<>+20(SB)/4, $0x00000007
+DATA shiftCounts168<>+24(SB)/4, $0x00000007
+DATA shiftCounts168<>+28(SB)/4, $0x00000007
+GLOBL shiftCounts168<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts169<>+0(SB)/4, $0x00000009
+DATA shiftCounts169<>+4(SB)/4, $0x00000009
+DATA shiftCounts169<>+8(SB)/4, $0x00000009
+DATA shiftCounts169<>+12(SB)/4, $0x00000009
+DATA shiftCounts169<>+16(SB)/4, $0x0000000b
+DATA shiftCounts169<>+20(SB)/4, $0x0000000b
+DATA shiftCounts169<>+24(SB)/4, $0x0000000b
+DATA shiftCounts169<>+28(SB)/4, $0x0000000b
+GLOBL shiftCounts169<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_16(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_16(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VBROADCASTI128 (AX), Y0
+	VINSERTI128    $0x01, 32(AX), Y0, Y0
+	VBROADCASTI128 16(AX), Y1
+	VINSERTI128    $0x01, 48(AX), Y1, Y1
+	VPSLLD         $0x10, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VBROADCASTI128 64(AX), Y0
+	VINSERTI128    $0x01, 96(AX), Y0, Y0
+	VBROADCASTI128 80(AX), Y1
+	VINSERTI128    $0x01, 112(AX), Y1, Y1
+	VPSLLD         $0x10, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VBROADCASTI128 128(AX), Y0
+	VINSERTI128    $0x01, 160(AX), Y0, Y0
+	VBROADCASTI128 144(AX), Y1
+	VINSERTI128    $0x01, 176(AX), Y1, Y1
+	VPSLLD         $0x10, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VBROADCASTI128 192(AX), Y0
+	VINSERTI128    $0x01, 224(AX), Y0, Y0
+	VBROADCASTI128 208(AX), Y1
+	VINSERTI128    $0x01, 240(AX), Y1, Y1
+	VPSLLD         $0x10, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VBROADCASTI128 256(AX), Y0
+	VINSERTI128    $0x01, 288(AX), Y0, Y0
+	VBROADCASTI128 272(AX), Y1
+	VINSERTI128    $0x01, 304(AX), Y1, Y1
+	VPSLLD         $0x10, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 128(CX)
+	VBROADCASTI128 320(AX), Y0
+	VINSERTI128    $0x01, 352(AX), Y0, Y0
+	VBROADCASTI128 336(AX), Y1
+	VINSERTI128    $0x01, 368(AX), Y1, Y1
+	VPSLLD         $0x10, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 160(CX)
+	VBROADCASTI128 384(AX), Y0
+	VINSERTI128    $0x01, 416(AX), Y0, Y0
+	VBROADCASTI128 400(AX), Y1
+	VINSERTI128    $0x01, 432(AX), Y1, Y1
+	VPSLLD         $0x10, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 192(CX)
+	VBROADCASTI128 448(AX), Y0
+	VINSERTI128    $0x01, 480(AX), Y0, Y0
+	VBROADCASTI128 464(AX), Y1
+	VINSERTI128    $0x01, 496(AX), Y1, Y1
+	VPSLLD         $0x10, Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 224(CX)
+	VZEROUPPER
+	RET
+
+// func pack32AVX2_17(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_17(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VMOVDQU        (AX), Y0
+	VPSRLVD        shiftCounts170<>+0(SB), Y0, Y0
+	VMOVDQU        16(AX), Y1
+	VPSLLVD        shiftCounts171<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 48(AX), Y1
+	VPSLLVD        shiftCounts172<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VBROADCASTI128 48(AX), Y0
+	VINSERTI128    $0x01, 80(AX), Y0, Y0
+	VPSRLVD        shiftCounts173<>+0(SB), Y0, Y0
+	VBROADCASTI128 64(AX), Y1
+	VINSERTI128    $0x01, 96(AX), Y1, Y1
+	VPSLLVD        shiftCounts99<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 80(AX), Y1
+	VINSERTI128    $0x01, 112(AX), Y1, Y1
+	VPSLLVD        shiftCounts174<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VBROADCASTI128 112(AX), Y0
+	VINSERTI128    $0x01, 144(AX), Y0, Y0
+	VPSRLVD        shiftCounts35<>+0(SB), Y0, Y0
+	VBROADCASTI128 128(AX), Y1
+	VINSERTI128    $0x01, 160(AX), Y1, Y1
+	VPSLLVD        shiftCounts163<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 144(AX), Y1
+	VINSERTI128    $0x01, 176(AX), Y1, Y1
+	VPSLLVD        shiftCounts175<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VBROADCASTI128 176(AX), Y0
+	VINSERTI128    $0x01, 208(AX), Y0, Y0
+	VPSRLVD        shiftCounts44<>+0(SB), Y0, Y0
+	VBROADCASTI128 192(AX), Y1
+	VINSERTI128    $0x01, 224(AX), Y1, Y1
+	VPSLLVD        shiftCounts165<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 208(AX), Y1
+	VINSERTI128    $0x01, 240(AX), Y1, Y1
+	VPSLLVD        shiftCounts176<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VMOVDQU        240(AX), Y0
+	VPSRLVD        shiftCounts177<>+0(SB), Y0, Y0
+	VMOVDQU        256(AX), Y1
+	VPSLLVD        shiftCounts178<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 288(AX), Y1
+	VPSLLVD        shiftCounts179<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 128(CX)
+	VBROADCASTI128 288(AX), Y0
+	VINSERTI128    $0x01, 320(AX), Y0, Y0
+	VPSRLVD        shiftCounts107<>+0(SB), Y0, Y0
+	VBROADCASTI128 304(AX), Y1
+	VINSERTI128    $0x01, 336(AX), Y1, Y1
+	VPSLLVD        shiftCounts180<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 320(AX), Y1
+	VINSERTI128    $0x01, 352(AX), Y1, Y1
+	VPSLLVD        shiftCounts181<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 160(CX)
+	VBROADCASTI128 352(AX), Y0
+	VINSERTI128    $0x01, 384(AX), Y0, Y0
+	VPSRLVD        shiftCounts45<>+0(SB), Y0, Y0
+	VBROADCASTI128 368(AX), Y1
+	VINSERTI128    $0x01, 400(AX), Y1, Y1
+	VPSLLVD        shiftCounts182<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 384(AX), Y1
+	VINSERTI128    $0x01, 416(AX), Y1, Y1
+	VPSLLVD        shiftCounts183<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 192(CX)
+	VBROADCASTI128 416(AX), Y0
+	VINSERTI128    $0x01, 448(AX), Y0, Y0
+	VPSRLVD        shiftCounts34<>+0(SB), Y0, Y0
+	VBROADCASTI128 432(AX), Y1
+	VINSERTI128    $0x01, 464(AX), Y1, Y1
+	VPSLLVD        shiftCounts184<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 448(AX), Y1
+	VINSERTI128    $0x01, 480(AX), Y1, Y1
+	VPSLLVD        shiftCounts185<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 224(CX)
+	VBROADCASTI128 480(AX), Y0
+	VPSRLVD        shiftCounts2<>+0(SB), Y0, Y0
+	VBROADCASTI128 496(AX), Y1
+	VPSLLVD        shiftCounts15<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        X0, 256(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts170<>+0(SB)/4, $0x00000000
+DATA shiftCounts170<>+4(SB)/4, $0x00000000
+DATA shiftCounts170<>+8(SB)/4, $0x00000000
+DATA shiftCounts170<>+12(SB)/4, $0x00000000
+DATA shiftCounts170<>+16(SB)/4, $0x0000000f
+DATA shiftCounts170<>+20(SB)/4, $0x0000000f
+DATA shiftCounts170<>+24(SB)/4, $0x0000000f
+DATA shiftCounts170<>+28(SB)/4, $0x0000000f
+GLOBL shiftCounts170<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts171<>+0(SB)/4, $0x00000011
+DATA shiftCounts171<>+4(SB)/4, $0x00000011
+DATA shiftCounts171<>+8(SB)/4, $0x00000011
+DATA shiftCounts171<>+12(SB)/4, $0x00000011
+DATA shiftCounts171<>+16(SB)/4, $0x00000002
+DATA shiftCounts171<>+20(SB)/4, $0x00000002
+DATA shiftCounts171<>+24(SB)/4, $0x00000002
+DATA shiftCounts171<>+28(SB)/4, $0x00000002
+GLOBL shiftCounts171<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts172<>+0(SB)/4, $0x00000020
+DATA shiftCounts172<>+4(SB)/4, $0x00000020
+DATA shiftCounts172<>+8(SB)/4, $0x00000020
+DATA shiftCounts172<>+12(SB)/4, $0x00000020
+DATA shiftCounts172<>+16(SB)/4, $0x00000013
+DATA shiftCounts172<>+20(SB)/4, $0x00000013
+DATA shiftCounts172<>+24(SB)/4, $0x00000013
+DATA shiftCounts172<>+28(SB)/4, $0x00000013
+GLOBL shiftCounts172<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts173<>+0(SB)/4, $0x0000000d
+DATA shiftCounts173<>+4(SB)/4, $0x0000000d
+DATA shiftCounts173<>+8(SB)/4, $0x0000000d
+DATA shiftCounts173<>+12(SB)/4, $0x0000000d
+DATA shiftCounts173<>+16(SB)/4, $0x0000000b
+DATA shiftCounts173<>+20(SB)/4, $0x0000000b
+DATA shiftCounts173<>+24(SB)/4, $0x0000000b
+DATA shiftCounts173<>+28(SB)/4, $0x0000000b
+GLOBL shiftCounts173<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts174<>+0(SB)/4, $0x00000015
+DATA shiftCounts174<>+4(SB)/4, $0x00000015
+DATA shiftCounts174<>+8(SB)/4, $0x00000015
+DATA shiftCounts174<>+12(SB)/4, $0x00000015
+DATA shiftCounts174<>+16(SB)/4, $0x00000017
+DATA shiftCounts174<>+20(SB)/4, $0x00000017
+DATA shiftCounts174<>+24(SB)/4, $0x00000017
+DATA shiftCounts174<>+28(SB)/4, $0x00000017
+GLOBL shiftCounts174<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts175<>+0(SB)/4, $0x00000019
+DATA shiftCounts175<>+4(SB)/4, $0x00000019
+DATA shiftCounts175<>+8(SB)/4, $0x00000019
+DATA shiftCounts175<>+12(SB)/4, $0x00000019
+DATA shiftCounts175<>+16(SB)/4, $0x0000001b
+DATA shiftCounts175<>+20(SB)/4, $0x0000001b
+DATA shiftCounts175<>+24(SB)/4, $0x0000001b
+DATA shiftCounts175<>+28(SB)/4, $0x0000001b
+GLOBL shiftCounts175<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts176<>+0(SB)/4, $0x0000001d
+DATA shiftCounts176<>+4(SB)/4, $0x0000001d
+DATA shiftCounts176<>+8(SB)/4, $0x0000001d
+DATA shiftCounts176<>+12(SB)/4, $0x0000001d
+DATA shiftCounts176<>+16(SB)/4, $0x0000001f
+DATA shiftCounts176<>+20(SB)/4, $0x0000001f
+DATA shiftCounts176<>+24(SB)/4, $0x0000001f
+DATA shiftCounts176<>+28(SB)/4, $0x0000001f
+GLOBL shiftCounts176<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts177<>+0(SB)/4, $0x00000001
+DATA shiftCounts177<>+4(SB)/4, $0x00000001
+DATA shiftCounts177<>+8(SB)/4, $0x00000001
+DATA shiftCounts177<>+12(SB)/4, $0x00000001
+DATA shiftCounts177<>+16(SB)/4, $0x00000010
+DATA shiftCounts177<>+20(SB)/4, $0x00000010
+DATA shiftCounts177<>+24(SB)/4, $0x00000010
+DATA shiftCounts177<>+28(SB)/4, $0x00000010
+GLOBL shiftCounts177<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts178<>+0(SB)/4, $0x00000010
+DATA shiftCounts178<>+4(SB)/4, $0x00000010
+DATA shiftCounts178<>+8(SB)/4, $0x00000010
+DATA shiftCounts178<>+12(SB)/4, $0x00000010
+DATA shiftCounts178<>+16(SB)/4, $0x00000001
+DATA shiftCounts178<>+20(SB)/4, $0x00000001
+DATA shiftCounts178<>+24(SB)/4, $0x00000001
+DATA shiftCounts178<>+28(SB)/4, $0x00000001
+GLOBL shiftCounts178<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts179<>+0(SB)/4, $0x00000020
+DATA shiftCounts179<>+4(SB)/4, $0x00000020
+DATA shiftCounts179<>+8(SB)/4, $0x00000020
+DATA shiftCounts179<>+12(SB)/4, $0x00000020
+DATA shiftCounts179<>+16(SB)/4, $0x00000012
+DATA shiftCounts179<>+20(SB)/4, $0x00000012
+DATA shiftCounts179<>+24(SB)/4, $0x00000012
+DATA shiftCounts179<>+28(SB)/4, $0x00000012
+GLOBL shiftCounts179<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts180<>+0(SB)/4, $0x00000003
+DATA shiftCounts180<>+4(SB)/4, $0x00000003
+DATA shiftCounts180<>+8(SB)/4, $0x00000003
+DATA shiftCounts180<>+12(SB)/4, $0x00000003
+DATA shiftCounts180<>+16(SB)/4, $0x00000005
+DATA shiftCounts180<>+20(SB)/4, $0x00000005
+DATA shiftCounts180<>+24(SB)/4, $0x00000005
+DATA shiftCounts180<>+28(SB)/4, $0x00000005
+GLOBL shiftCounts180<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts181<>+0(SB)/4, $0x00000014
+DATA shiftCounts181<>+4(SB)/4, $0x00000014
+DATA shiftCounts181<>+8(SB)/4, $0x00000014
+DATA shiftCounts181<>+12(SB)/4, $0x00000014
+DATA shiftCounts181<>+16(SB)/4, $0x00000016
+DATA shiftCounts181<>+20(SB)/4, $0x00000016
+DATA shiftCounts181<>+24(SB)/4, $0x00000016
+DATA shiftCounts181<>+28(SB)/4, $0x00000016
+GLOBL shiftCounts181<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts182<>+0(SB)/4, $0x00000007
+DATA shiftCounts182<>+4(SB)/4, $0x00000007
+DATA shiftCounts182<>+8(SB)/4, $0x00000007
+DATA shiftCounts182<>+12(SB)/4, $0x00000007
+DATA shiftCounts182<>+16(SB)/4, $0x00000009
+DATA shiftCounts182<>+20(SB)/4, $0x00000009
+DATA shiftCounts182<>+24(SB)/4, $0x00000009
+DATA shiftCounts182<>+28(SB)/4, $0x00000009
+GLOBL shiftCounts182<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts183<>+0(SB)/4, $0x00000018
+DATA shiftCounts183<>+4(SB)/4, $0x00000018
+DATA shiftCounts183<>+8(SB)/4, $0x00000018
+DATA shiftCounts183<>+12(SB)/4, $0x00000018
+DATA shiftCounts183<>+16(SB)/4, $0x0000001a
+DATA shiftCounts183<>+20(SB)/4, $0x0000001a
+DATA shiftCounts183<>+24(SB)/4, $0x0000001a
+DATA shiftCounts183<>+28(SB)/4, $0x0000001a
+GLOBL shiftCounts183<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts184<>+0(SB)/4, $0x0000000b
+DATA shiftCounts184<>+4(SB)/4, $0x0000000b
+DATA shiftCounts184<>+8(SB)/4, $0x0000000b
+DATA shiftCounts184<>+12(SB)/4, $0x0000000b
+DATA shiftCounts184<>+16(SB)/4, $0x0000000d
+DATA shiftCounts184<>+20(SB)/4, $0x0000000d
+DATA shiftCounts184<>+24(SB)/4, $0x0000000d
+DATA shiftCounts184<>+28(SB)/4, $0x0000000d
+GLOBL shiftCounts184<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts185<>+0(SB)/4, $0x0000001c
+DATA shiftCounts185<>+4(SB)/4, $0x0000001c
+DATA shiftCounts185<>+8(SB)/4, $0x0000001c
+DATA shiftCounts185<>+12(SB)/4, $0x0000001c
+DATA shiftCounts185<>+16(SB)/4, $0x0000001e
+DATA shiftCounts185<>+20(SB)/4, $0x0000001e
+DATA shiftCounts185<>+24(SB)/4, $0x0000001e
+DATA shiftCounts185<>+28(SB)/4, $0x0000001e
+GLOBL shiftCounts185<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_18(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_18(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VMOVDQU        (AX), Y0
+	VPSRLVD        shiftCounts186<>+0(SB), Y0, Y0
+	VMOVDQU        16(AX), Y1
+	VPSLLVD        shiftCounts187<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 48(AX), Y1
+	VPSLLVD        shiftCounts188<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VBROADCASTI128 48(AX), Y0
+	VINSERTI128    $0x01, 80(AX), Y0, Y0
+	VPSRLVD        shiftCounts155<>+0(SB), Y0, Y0
+	VBROADCASTI128 64(AX), Y1
+	VINSERTI128    $0x01, 96(AX), Y1, Y1
+	VPSLLVD        shiftCounts57<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 80(AX), Y1
+	VINSERTI128    $0x01, 112(AX), Y1, Y1
+	VPSLLVD        shiftCounts60<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VMOVDQU        112(AX), Y0
+	VPSRLVD        shiftCounts189<>+0(SB), Y0, Y0
+	VMOVDQU        128(AX), Y1
+	VPSLLVD        shiftCounts190<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 160(AX), Y1
+	VPSLLVD        shiftCounts191<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VBROADCASTI128 160(AX), Y0
+	VINSERTI128    $0x01, 192(AX), Y0, Y0
+	VPSRLVD        shiftCounts78<>+0(SB), Y0, Y0
+	VBROADCASTI128 176(AX), Y1
+	VINSERTI128    $0x01, 208(AX), Y1, Y1
+	VPSLLVD        shiftCounts160<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 192(AX), Y1
+	VINSERTI128    $0x01, 224(AX), Y1, Y1
+	VPSLLVD        shiftCounts192<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VBROADCASTI128 224(AX), Y0
+	VINSERTI128    $0x01, 256(AX), Y0, Y0
+	VPSRLVD        shiftCounts55<>+0(SB), Y0, Y0
+	VBROADCASTI128 240(AX), Y1
+	VINSERTI128    $0x01, 272(AX), Y1, Y1
+	VPSLLVD        shiftCounts58<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 128(CX)
+	VBROADCASTI128 272(AX), Y0
+	VINSERTI128    $0x01, 304(AX), Y0, Y0
+	VPSRLVD        shiftCounts68<>+0(SB), Y0, Y0
+	VBROADCASTI128 288(AX), Y1
+	VINSERTI128    $0x01, 320(AX), Y1, Y1
+	VPSLLVD        shiftCounts132<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 304(AX), Y1
+	VINSERTI128    $0x01, 336(AX), Y1, Y1
+	VPSLLVD        shiftCounts193<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 160(CX)
+	VBROADCASTI128 336(AX), Y0
+	VINSERTI128    $0x01, 368(AX), Y0, Y0
+	VPSRLVD        shiftCounts72<>+0(SB), Y0, Y0
+	VBROADCASTI128 352(AX), Y1
+	VINSERTI128    $0x01, 384(AX), Y1, Y1
+	VPSLLVD        shiftCounts194<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 368(AX), Y1
+	VPSLLVD        shiftCounts30<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 192(CX)
+	VBROADCASTI128 384(AX), Y0
+	VINSERTI128    $0x01, 416(AX), Y0, Y0
+	VPSRLVD        shiftCounts195<>+0(SB), Y0, Y0
+	VBROADCASTI128 400(AX), Y1
+	VINSERTI128    $0x01, 432(AX), Y1, Y1
+	VPSLLVD        shiftCounts56<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 416(AX), Y1
+	VINSERTI128    $0x01, 448(AX), Y1, Y1
+	VPSLLVD        shiftCounts59<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 224(CX)
+	VBROADCASTI128 448(AX), Y0
+	VINSERTI128    $0x01, 480(AX), Y0, Y0
+	VPSRLVD        shiftCounts131<>+0(SB), Y0, Y0
+	VBROADCASTI128 464(AX), Y1
+	VINSERTI128    $0x01, 496(AX), Y1, Y1
+	VPSLLVD        shiftCounts196<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 480(AX), Y1
+	VPSLLVD        shiftCounts28<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 256(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts186<>+0(SB)/4, $0x00000000
+DATA shiftCounts186<>+4(SB)/4, $0x00000000
+DATA shiftCounts186<>+8(SB)/4, $0x00000000
+DATA shiftCounts186<>+12(SB)/4, $0x00000000
+DATA shiftCounts186<>+16(SB)/4, $0x0000000e
+DATA shiftCounts186<>+20(SB)/4, $0x0000000e
+DATA shiftCounts186<>+24(SB)/4, $0x0000000e
+DATA shiftCounts186<>+28(SB)/4, $0x0000000e
+GLOBL shiftCounts186<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts187<>+0(SB)/4, $0x00000012
+DATA shiftCounts187<>+4(SB)/4, $0x00000012
+DATA shiftCounts187<>+8(SB)/4, $0x00000012
+DATA shiftCounts187<>+12(SB)/4, $0x00000012
+DATA shiftCounts187<>+16(SB)/4, $0x00000004
+DATA shiftCounts187<>+20(SB)/4, $0x00000004
+DATA shiftCounts187<>+24(SB)/4, $0x00000004
+DATA shiftCounts187<>+28(SB)/4, $0x00000004
+GLOBL shiftCounts187<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts188<>+0(SB)/4, $0x00000020
+DATA shiftCounts188<>+4(SB)/4, $0x00000020
+DATA shiftCounts188<>+8(SB)/4, $0x00000020
+DATA shiftCounts188<>+12(SB)/4, $0x00000020
+DATA shiftCounts188<>+16(SB)/4, $0x00000016
+DATA shiftCounts188<>+20(SB)/4, $0x00000016
+DATA shiftCounts188<>+24(SB)/4, $0x00000016
+DATA shiftCounts188<>+28(SB)/4, $0x00000016
+GLOBL shiftCounts188<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts189<>+0(SB)/4, $0x00000002
+DATA shiftCounts189<>+4(SB)/4, $0x00000002
+DATA shiftCounts189<>+8(SB)/4, $0x00000002
+DATA shiftCounts189<>+12(SB)/4, $0x00000002
+DATA shiftCounts189<>+16(SB)/4, $0x00000010
+DATA shiftCounts189<>+20(SB)/4, $0x00000010
+DATA shiftCounts189<>+24(SB)/4, $0x00000010
+DATA shiftCounts189<>+28(SB)/4, $0x00000010
+GLOBL shiftCounts189<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts190<>+0(SB)/4, $0x00000010
+DATA shiftCounts190<>+4(SB)/4, $0x00000010
+DATA shiftCounts190<>+8(SB)/4, $0x00000010
+DATA shiftCounts190<>+12(SB)/4, $0x00000010
+DATA shiftCounts190<>+16(SB)/4, $0x00000002
+DATA shiftCounts190<>+20(SB)/4, $0x00000002
+DATA shiftCounts190<>+24(SB)/4, $0x00000002
+DATA shiftCounts190<>+28(SB)/4, $0x00000002
+GLOBL shiftCounts190<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts191<>+0(SB)/4, $0x00000020
+DATA shiftCounts191<>+4(SB)/4, $0x00000020
+DATA shiftCounts191<>+8(SB)/4, $0x00000020
+DATA shiftCounts191<>+12(SB)/4, $0x00000020
+DATA shiftCounts191<>+16(SB)/4, $0x00000014
+DATA shiftCounts191<>+20(SB)/4, $0x00000014
+DATA shiftCounts191<>+24(SB)/4, $0x00000014
+DATA shiftCounts191<>+28(SB)/4, $0x00000014
+GLOBL shiftCounts191<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts192<>+0(SB)/4, $0x00000018
+DATA shiftCounts192<>+4(SB)/4, $0x00000018
+DATA shiftCounts192<>+8(SB)/4, $0x00000018
+DATA shiftCounts192<>+12(SB)/4, $0x00000018
+DATA shiftCounts192<>+16(SB)/4, $0x0000001c
+DATA shiftCounts192<>+20(SB)/4, $0x0000001c
+DATA shiftCounts192<>+24(SB)/4, $0x0000001c
+DATA shiftCounts192<>+28(SB)/4, $0x0000001c
+GLOBL shiftCounts192<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts193<>+0(SB)/4, $0x00000016
+DATA shiftCounts193<>+4(SB)/4, $0x00000016
+DATA shiftCounts193<>+8(SB)/4, $0x00000016
+DATA shiftCounts193<>+12(SB)/4, $0x00000016
+DATA shiftCounts193<>+16(SB)/4, $0x0000001a
+DATA shiftCounts193<>+20(SB)/4, $0x0000001a
+DATA shiftCounts193<>+24(SB)/4, $0x0000001a
+DATA shiftCounts193<>+28(SB)/4, $0x0000001a
+GLOBL shiftCounts193<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts194<>+0(SB)/4, $0x0000000c
+DATA shiftCounts194<>+4(SB)/4, $0x0000000c
+DATA shiftCounts194<>+8(SB)/4, $0x0000000c
+DATA shiftCounts194<>+12(SB)/4, $0x0000000c
+DATA shiftCounts194<>+16(SB)/4, $0x00000010
+DATA shiftCounts194<>+20(SB)/4, $0x00000010
+DATA shiftCounts194<>+24(SB)/4, $0x00000010
+DATA shiftCounts194<>+28(SB)/4, $0x00000010
+GLOBL shiftCounts194<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts195<>+0(SB)/4, $0x00000010
+DATA shiftCounts195<>+4(SB)/4, $0x00000010
+DATA shiftCounts195<>+8(SB)/4, $0x00000010
+DATA shiftCounts195<>+12(SB)/4, $0x00000010
+DATA shiftCounts195<>+16(SB)/4, $0x0000000c
+DATA shiftCounts195<>+20(SB)/4, $0x0000000c
+DATA shiftCounts195<>+24(SB)/4, $0x0000000c
+DATA shiftCounts195<>+28(SB)/4, $0x0000000c
+GLOBL shiftCounts195<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts196<>+0(SB)/4, $0x0000000a
+DATA shiftCounts196<>+4(SB)/4, $0x0000000a
+DATA shiftCounts196<>+8(SB)/4, $0x0000000a
+DATA shiftCounts196<>+12(SB)/4, $0x0000000a
+DATA shiftCounts196<>+16(SB)/4, $0x0000000e
+DATA shiftCounts196<>+20(SB)/4, $0x0000000e
+DATA shiftCounts196<>+24(SB)/4, $0x0000000e
+DATA shiftCounts196<>+28(SB)/4, $0x0000000e
+GLOBL shiftCounts196<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_19(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_19(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VMOVDQU        (AX), Y0
+	VPSRLVD        shiftCounts197<>+0(SB), Y0, Y0
+	VMOVDQU        16(AX), Y1
+	VPSLLVD        shiftCounts198<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 48(AX), Y1
+	VPSLLVD        shiftCounts199<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VBROADCASTI128 48(AX), Y0
+	VINSERTI128    $0x01, 80(AX), Y0, Y0
+	VPSRLVD        shiftCounts200<>+0(SB), Y0, Y0
+	VBROADCASTI128 64(AX), Y1
+	VINSERTI128    $0x01, 96(AX), Y1, Y1
+	VPSLLVD        shiftCounts201<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 80(AX), Y1
+	VPSLLVD        shiftCounts31<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VBROADCASTI128 96(AX), Y0
+	VINSERTI128    $0x01, 128(AX), Y0, Y0
+	VPSRLVD        shiftCounts202<>+0(SB), Y0, Y0
+	VBROADCASTI128 112(AX), Y1
+	VINSERTI128    $0x01, 144(AX), Y1, Y1
+	VPSLLVD        shiftCounts203<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 128(AX), Y1
+	VINSERTI128    $0x01, 160(AX), Y1, Y1
+	VPSLLVD        shiftCounts204<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VMOVDQU        160(AX), Y0
+	VPSRLVD        shiftCounts205<>+0(SB), Y0, Y0
+	VMOVDQU        176(AX), Y1
+	VPSLLVD        shiftCounts206<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 208(AX), Y1
+	VPSLLVD        shiftCounts111<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VBROADCASTI128 208(AX), Y0
+	VINSERTI128    $0x01, 240(AX), Y0, Y0
+	VPSRLVD        shiftCounts207<>+0(SB), Y0, Y0
+	VBROADCASTI128 224(AX), Y1
+	VINSERTI128    $0x01, 256(AX), Y1, Y1
+	VPSLLVD        shiftCounts208<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 240(AX), Y1
+	VPSLLVD        shiftCounts29<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 128(CX)
+	VBROADCASTI128 256(AX), Y0
+	VINSERTI128    $0x01, 288(AX), Y0, Y0
+	VPSRLVD        shiftCounts209<>+0(SB), Y0, Y0
+	VBROADCASTI128 272(AX), Y1
+	VINSERTI128    $0x01, 304(AX), Y1, Y1
+	VPSLLVD        shiftCounts210<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 288(AX), Y1
+	VINSERTI128    $0x01, 320(AX), Y1, Y1
+	VPSLLVD        shiftCounts211<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 160(CX)
+	VMOVDQU        320(AX), Y0
+	VPSRLVD        shiftCounts212<>+0(SB), Y0, Y0
+	VMOVDQU        336(AX), Y1
+	VPSLLVD        shiftCounts213<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 368(AX), Y1
+	VPSLLVD        shiftCounts214<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 192(CX)
+	VBROADCASTI128 368(AX), Y0
+	VINSERTI128    $0x01, 400(AX), Y0, Y0
+	VPSRLVD        shiftCounts215<>+0(SB), Y0, Y0
+	VBROADCASTI128 384(AX), Y1
+	VINSERTI128    $0x01, 416(AX), Y1, Y1
+	VPSLLVD        shiftCounts216<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 400(AX), Y1
+	VPSLLVD        shiftCounts27<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 224(CX)
+	VBROADCASTI128 416(AX), Y0
+	VINSERTI128    $0x01, 448(AX), Y0, Y0
+	VPSRLVD        shiftCounts217<>+0(SB), Y0, Y0
+	VBROADCASTI128 432(AX), Y1
+	VINSERTI128    $0x01, 464(AX), Y1, Y1
+	VPSLLVD        shiftCounts218<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 448(AX), Y1
+	VINSERTI128    $0x01, 480(AX), Y1, Y1
+	VPSLLVD        shiftCounts219<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 256(CX)
+	VBROADCASTI128 480(AX), Y0
+	VPSRLVD        shiftCounts6<>+0(SB), Y0, Y0
+	VBROADCASTI128 496(AX), Y1
+	VPSLLVD        shiftCounts13<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        X0, 288(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts197<>+0(SB)/4, $0x00000000
+DATA shiftCounts197<>+4(SB)/4, $0x00000000
+DATA shiftCounts197<>+8(SB)/4, $0x00000000
+DATA shiftCounts197<>+12(SB)/4, $0x00000000
+DATA shiftCounts197<>+16(SB)/4, $0x0000000d
+DATA shiftCounts197<>+20(SB)/4, $0x0000000d
+DATA shiftCounts197<>+24(SB)/4, $0x0000000d
+DATA shiftCounts197<>+28(SB)/4, $0x0000000d
+GLOBL shiftCounts197<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts198<>+0(SB)/4, $0x00000013
+DATA shiftCounts198<>+4(SB)/4, $0x00000013
+DATA shiftCounts198<>+8(SB)/4, $0x00000013
+DATA shiftCounts198<>+12(SB)/4, $0x00000013
+DATA shiftCounts198<>+16(SB)/4, $0x00000006
+DATA shiftCounts198<>+20(SB)/4, $0x00000006
+DATA shiftCounts198<>+24(SB)/4, $0x00000006
+DATA shiftCounts198<>+28(SB)/4, $0x00000006
+GLOBL shiftCounts198<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts199<>+0(SB)/4, $0x00000020
+DATA shiftCounts199<>+4(SB)/4, $0x00000020
+DATA shiftCounts199<>+8(SB)/4, $0x00000020
+DATA shiftCounts199<>+12(SB)/4, $0x00000020
+DATA shiftCounts199<>+16(SB)/4, $0x00000019
+DATA shiftCounts199<>+20(SB)/4, $0x00000019
+DATA shiftCounts199<>+24(SB)/4, $0x00000019
+DATA shiftCounts199<>+28(SB)/4, $0x00000019
+GLOBL shiftCounts199<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts200<>+0(SB)/4, $0x00000007
+DATA shiftCounts200<>+4(SB)/4, $0x00000007
+DATA shiftCounts200<>+8(SB)/4, $0x00000007
+DATA shiftCounts200<>+12(SB)/4, $0x00000007
+DATA shiftCounts200<>+16(SB)/4, $0x00000001
+DATA shiftCounts200<>+20(SB)/4, $0x00000001
+DATA shiftCounts200<>+24(SB)/4, $0x00000001
+DATA shiftCounts200<>+28(SB)/4, $0x00000001
+GLOBL shiftCounts200<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts201<>+0(SB)/4, $0x0000000c
+DATA shiftCounts201<>+4(SB)/4, $0x0000000c
+DATA shiftCounts201<>+8(SB)/4, $0x0000000c
+DATA shiftCounts201<>+12(SB)/4, $0x0000000c
+DATA shiftCounts201<>+16(SB)/4, $0x00000012
+DATA shiftCounts201<>+20(SB)/4, $0x00000012
+DATA shiftCounts201<>+24(SB)/4, $0x00000012
+DATA shiftCounts201<>+28(SB)/4, $0x00000012
+GLOBL shiftCounts201<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts202<>+0(SB)/4, $0x0000000e
+DATA shiftCounts202<>+4(SB)/4, $0x0000000e
+DATA shiftCounts202<>+8(SB)/4, $0x0000000e
+DATA shiftCounts202<>+12(SB)/4, $0x0000000e
+DATA shiftCounts202<>+16(SB)/4, $0x00000008
+DATA shiftCounts202<>+20(SB)/4, $0x00000008
+DATA shiftCounts202<>+24(SB)/4, $0x00000008
+DATA shiftCounts202<>+28(SB)/4, $0x00000008
+GLOBL shiftCounts202<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts203<>+0(SB)/4, $0x00000005
+DATA shiftCounts203<>+4(SB)/4, $0x00000005
+DATA shiftCounts203<>+8(SB)/4, $0x00000005
+DATA shiftCounts203<>+12(SB)/4, $0x00000005
+DATA shiftCounts203<>+16(SB)/4, $0x0000000b
+DATA shiftCounts203<>+20(SB)/4, $0x0000000b
+DATA shiftCounts203<>+24(SB)/4, $0x0000000b
+DATA shiftCounts203<>+28(SB)/4, $0x0000000b
+GLOBL shiftCounts203<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts204<>+0(SB)/4, $0x00000018
+DATA shiftCounts204<>+4(SB)/4, $0x00000018
+DATA shiftCounts204<>+8(SB)/4, $0x00000018
+DATA shiftCounts204<>+12(SB)/4, $0x00000018
+DATA shiftCounts204<>+16(SB)/4, $0x0000001e
+DATA shiftCounts204<>+20(SB)/4, $0x0000001e
+DATA shiftCounts204<>+24(SB)/4, $0x0000001e
+DATA shiftCounts204<>+28(SB)/4, $0x0000001e
+GLOBL shiftCounts204<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts205<>+0(SB)/4, $0x00000002
+DATA shiftCounts205<>+4(SB)/4, $0x00000002
+DATA shiftCounts205<>+8(SB)/4, $0x00000002
+DATA shiftCounts205<>+12(SB)/4, $0x00000002
+DATA shiftCounts205<>+16(SB)/4, $0x0000000f
+DATA shiftCounts205<>+20(SB)/4, $0x0000000f
+DATA shiftCounts205<>+24(SB)/4, $0x0000000f
+DATA shiftCounts205<>+28(SB)/4, $0x0000000f
+GLOBL shiftCounts205<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts206<>+0(SB)/4, $0x00000011
+DATA shiftCounts206<>+4(SB)/4, $0x00000011
+DATA shiftCounts206<>+8(SB)/4, $0x00000011
+DATA shiftCounts206<>+12(SB)/4, $0x00000011
+DATA shiftCounts206<>+16(SB)/4, $0x00000004
+DATA shiftCounts206<>+20(SB)/4, $0x00000004
+DATA shiftCounts206<>+24(SB)/4, $0x00000004
+DATA shiftCounts206<>+28(SB)/4, $0x00000004
+GLOBL shiftCounts206<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts207<>+0(SB)/4, $0x00000009
+DATA shiftCounts207<>+4(SB)/4, $0x00000009
+DATA shiftCounts207<>+8(SB)/4, $0x00000009
+DATA shiftCounts207<>+12(SB)/4, $0x00000009
+DATA shiftCounts207<>+16(SB)/4, $0x00000003
+DATA shiftCounts207<>+20(SB)/4, $0x00000003
+DATA shiftCounts207<>+24(SB)/4, $0x00000003
+DATA shiftCounts207<>+28(SB)/4, $0x00000003
+GLOBL shiftCounts207<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts208<>+0(SB)/4, $0x0000000a
+DATA shiftCounts208<>+4(SB)/4, $0x0000000a
+DATA shiftCounts208<>+8(SB)/4, $0x0000000a
+DATA shiftCounts208<>+12(SB)/4, $0x0000000a
+DATA shiftCounts208<>+16(SB)/4, $0x00000010
+DATA shiftCounts208<>+20(SB)/4, $0x00000010
+DATA shiftCounts208<>+24(SB)/4, $0x00000010
+DATA shiftCounts208<>+28(SB)/4, $0x00000010
+GLOBL shiftCounts208<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts209<>+0(SB)/4, $0x00000010
+DATA shiftCounts209<>+4(SB)/4, $0x00000010
+DATA shiftCounts209<>+8(SB)/4, $0x00000010
+DATA shiftCounts209<>+12(SB)/4, $0x00000010
+DATA shiftCounts209<>+16(SB)/4, $0x0000000a
+DATA shiftCounts209<>+20(SB)/4, $0x0000000a
+DATA shiftCounts209<>+24(SB)/4, $0x0000000a
+DATA shiftCounts209<>+28(SB)/4, $0x0000000a
+GLOBL shiftCounts209<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts210<>+0(SB)/4, $0x00000003
+DATA shiftCounts210<>+4(SB)/4, $0x00000003
+DATA shiftCounts210<>+8(SB)/4, $0x00000003
+DATA shiftCounts210<>+12(SB)/4, $0x00000003
+DATA shiftCounts210<>+16(SB)/4, $0x00000009
+DATA shiftCounts210<>+20(SB)/4, $0x00000009
+DATA shiftCounts210<>+24(SB)/4, $0x00000009
+DATA shiftCounts210<>+28(SB)/4, $0x00000009
+GLOBL shiftCounts210<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts211<>+0(SB)/4, $0x00000016
+DATA shiftCounts211<>+4(SB)/4, $0x00000016
+DATA shiftCounts211<>+8(SB)/4, $0x00000016
+DATA shiftCounts211<>+12(SB)/4, $0x00000016
+DATA shiftCounts211<>+16(SB)/4, $0x0000001c
+DATA shiftCounts211<>+20(SB)/4, $0x0000001c
+DATA shiftCounts211<>+24(SB)/4, $0x0000001c
+DATA shiftCounts211<>+28(SB)/4, $0x0000001c
+GLOBL shiftCounts211<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts212<>+0(SB)/4, $0x00000004
+DATA shiftCounts212<>+4(SB)/4, $0x00000004
+DATA shiftCounts212<>+8(SB)/4, $0x00000004
+DATA shiftCounts212<>+12(SB)/4, $0x00000004
+DATA shiftCounts212<>+16(SB)/4, $0x00000011
+DATA shiftCounts212<>+20(SB)/4, $0x00000011
+DATA shiftCounts212<>+24(SB)/4, $0x00000011
+DATA shiftCounts212<>+28(SB)/4, $0x00000011
+GLOBL shiftCounts212<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts213<>+0(SB)/4, $0x0000000f
+DATA shiftCounts213<>+4(SB)/4, $0x0000000f
+DATA shiftCounts213<>+8(SB)/4, $0x0000000f
+DATA shiftCounts213<>+12(SB)/4, $0x0000000f
+DATA shiftCounts213<>+16(SB)/4, $0x00000002
+DATA shiftCounts213<>+20(SB)/4, $0x00000002
+DATA shiftCounts213<>+24(SB)/4, $0x00000002
+DATA shiftCounts213<>+28(SB)/4, $0x00000002
+GLOBL shiftCounts213<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts214<>+0(SB)/4, $0x00000020
+DATA shiftCounts214<>+4(SB)/4, $0x00000020
+DATA shiftCounts214<>+8(SB)/4, $0x00000020
+DATA shiftCounts214<>+12(SB)/4, $0x00000020
+DATA shiftCounts214<>+16(SB)/4, $0x00000015
+DATA shiftCounts214<>+20(SB)/4, $0x00000015
+DATA shiftCounts214<>+24(SB)/4, $0x00000015
+DATA shiftCounts214<>+28(SB)/4, $0x00000015
+GLOBL shiftCounts214<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts215<>+0(SB)/4, $0x0000000b
+DATA shiftCounts215<>+4(SB)/4, $0x0000000b
+DATA shiftCounts215<>+8(SB)/4, $0x0000000b
+DATA shiftCounts215<>+12(SB)/4, $0x0000000b
+DATA shiftCounts215<>+16(SB)/4, $0x00000005
+DATA shiftCounts215<>+20(SB)/4, $0x00000005
+DATA shiftCounts215<>+24(SB)/4, $0x00000005
+DATA shiftCounts215<>+28(SB)/4, $0x00000005
+GLOBL shiftCounts215<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts216<>+0(SB)/4, $0x00000008
+DATA shiftCounts216<>+4(SB)/4, $0x00000008
+DATA shiftCounts216<>+8(SB)/4, $0x00000008
+DATA shiftCounts216<>+12(SB)/4, $0x00000008
+DATA shiftCounts216<>+16(SB)/4, $0x0000000e
+DATA shiftCounts216<>+20(SB)/4, $0x0000000e
+DATA shiftCounts216<>+24(SB)/4, $0x0000000e
+DATA shiftCounts216<>+28(SB)/4, $0x0000000e
+GLOBL shiftCounts216<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts217<>+0(SB)/4, $0x00000012
+DATA shiftCounts217<>+4(SB)/4, $0x00000012
+DATA shiftCounts217<>+8(SB)/4, $0x00000012
+DATA shiftCounts217<>+12(SB)/4, $0x00000012
+DATA shiftCounts217<>+16(SB)/4, $0x0000000c
+DATA shiftCounts217<>+20(SB)/4, $0x0000000c
+DATA shiftCounts217<>+24(SB)/4, $0x0000000c
+DATA shiftCounts217<>+28(SB)/4, $0x0000000c
+GLOBL shiftCounts217<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts218<>+0(SB)/4, $0x00000001
+DATA shiftCounts218<>+4(SB)/4, $0x00000001
+DATA shiftCounts218<>+8(SB)/4, $0x00000001
+DATA shiftCounts218<>+12(SB)/4, $0x00000001
+DATA shiftCounts218<>+16(SB)/4, $0x00000007
+DATA shiftCounts218<>+20(SB)/4, $0x00000007
+DATA shiftCounts218<>+24(SB)/4, $0x00000007
+DATA shiftCounts218<>+28(SB)/4, $0x00000007
+GLOBL shiftCounts218<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts219<>+0(SB)/4, $0x00000014
+DATA shiftCounts219<>+4(SB)/4, $0x00000014
+DATA shiftCounts219<>+8(SB)/4, $0x00000014
+DATA shiftCounts219<>+12(SB)/4, $0x00000014
+DATA shiftCounts219<>+16(SB)/4, $0x0000001a
+DATA shiftCounts219<>+20(SB)/4, $0x0000001a
+DATA shiftCounts219<>+24(SB)/4, $0x0000001a
+DATA shiftCounts219<>+28(SB)/4, $0x0000001a
+GLOBL shiftCounts219<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_20(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_20(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VMOVDQU        (AX), Y0
+	VPSRLVD        shiftCounts220<>+0(SB), Y0, Y0
+	VMOVDQU        16(AX), Y1
+	VPSLLVD        shiftCounts221<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 48(AX), Y1
+	VPSLLVD        shiftCounts98<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VMOVDQU        48(AX), Y0
+	VPSRLVD        shiftCounts222<>+0(SB), Y0, Y0
+	VMOVDQU        64(AX), Y1
+	VPSLLVD        shiftCounts223<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 96(AX), Y1
+	VPSLLVD        shiftCounts224<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VBROADCASTI128 96(AX), Y0
+	VINSERTI128    $0x01, 128(AX), Y0, Y0
+	VPSRLVD        shiftCounts101<>+0(SB), Y0, Y0
+	VBROADCASTI128 112(AX), Y1
+	VINSERTI128    $0x01, 144(AX), Y1, Y1
+	VPSLLVD        shiftCounts103<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VBROADCASTI128 144(AX), Y0
+	VINSERTI128    $0x01, 176(AX), Y0, Y0
+	VPSRLVD        shiftCounts129<>+0(SB), Y0, Y0
+	VBROADCASTI128 160(AX), Y1
+	VINSERTI128    $0x01, 192(AX), Y1, Y1
+	VPSLLVD        shiftCounts225<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 176(AX), Y1
+	VPSLLVD        shiftCounts28<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VBROADCASTI128 192(AX), Y0
+	VINSERTI128    $0x01, 224(AX), Y0, Y0
+	VPSRLVD        shiftCounts226<>+0(SB), Y0, Y0
+	VBROADCASTI128 208(AX), Y1
+	VINSERTI128    $0x01, 240(AX), Y1, Y1
+	VPSLLVD        shiftCounts227<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 224(AX), Y1
+	VPSLLVD        shiftCounts24<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 128(CX)
+	VMOVDQU        256(AX), Y0
+	VPSRLVD        shiftCounts220<>+0(SB), Y0, Y0
+	VMOVDQU        272(AX), Y1
+	VPSLLVD        shiftCounts221<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 304(AX), Y1
+	VPSLLVD        shiftCounts98<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 160(CX)
+	VMOVDQU        304(AX), Y0
+	VPSRLVD        shiftCounts222<>+0(SB), Y0, Y0
+	VMOVDQU        320(AX), Y1
+	VPSLLVD        shiftCounts223<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 352(AX), Y1
+	VPSLLVD        shiftCounts224<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 192(CX)
+	VBROADCASTI128 352(AX), Y0
+	VINSERTI128    $0x01, 384(AX), Y0, Y0
+	VPSRLVD        shiftCounts101<>+0(SB), Y0, Y0
+	VBROADCASTI128 368(AX), Y1
+	VINSERTI128    $0x01, 400(AX), Y1, Y1
+	VPSLLVD        shiftCounts103<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 224(CX)
+	VBROADCASTI128 400(AX), Y0
+	VINSERTI128    $0x01, 432(AX), Y0, Y0
+	VPSRLVD        shiftCounts129<>+0(SB), Y0, Y0
+	VBROADCASTI128 416(AX), Y1
+	VINSERTI128    $0x01, 448(AX), Y1, Y1
+	VPSLLVD        shiftCounts225<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 432(AX), Y1
+	VPSLLVD        shiftCounts28<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 256(CX)
+	VBROADCASTI128 448(AX), Y0
+	VINSERTI128    $0x01, 480(AX), Y0, Y0
+	VPSRLVD        shiftCounts226<>+0(SB), Y0, Y0
+	VBROADCASTI128 464(AX), Y1
+	VINSERTI128    $0x01, 496(AX), Y1, Y1
+	VPSLLVD        shiftCounts227<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 480(AX), Y1
+	VPSLLVD        shiftCounts24<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 288(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts220<>+0(SB)/4, $0x00000000
+DATA shiftCounts220<>+4(SB)/4, $0x00000000
+DATA shiftCounts220<>+8(SB)/4, $0x00000000
+DATA shiftCounts220<>+12(SB)/4, $0x00000000
+DATA shiftCounts220<>+16(SB)/4, $0x0000000c
+DATA shiftCounts220<>+20(SB)/4, $0x0000000c
+DATA shiftCounts220<>+24(SB)/4, $0x0000000c
+DATA shiftCounts220<>+28(SB)/4, $0x0000000c
+GLOBL shiftCounts220<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts221<>+0(SB)/4, $0x00000014
+DATA shiftCounts221<>+4(SB)/4, $0x00000014
+DATA shiftCounts221<>+8(SB)/4, $0x00000014
+DATA shiftCounts221<>+12(SB)/4, $0x00000014
+DATA shiftCounts221<>+16(SB)/4, $0x00000008
+DATA shiftCounts221<>+20(SB)/4, $0x00000008
+DATA shiftCounts221<>+24(SB)/4, $0x00000008
+DATA shiftCounts221<>+28(SB)/4, $0x00000008
+GLOBL shiftCounts221<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts222<>+0(SB)/4, $0x00000004
+DATA shiftCounts222<>+4(SB)/4, $0x00000004
+DATA shiftCounts222<>+8(SB)/4, $0x00000004
+DATA shiftCounts222<>+12(SB)/4, $0x00000004
+DATA shiftCounts222<>+16(SB)/4, $0x00000010
+DATA shiftCounts222<>+20(SB)/4, $0x00000010
+DATA shiftCounts222<>+24(SB)/4, $0x00000010
+DATA shiftCounts222<>+28(SB)/4, $0x00000010
+GLOBL shiftCounts222<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts223<>+0(SB)/4, $0x00000010
+DATA shiftCounts223<>+4(SB)/4, $0x00000010
+DATA shiftCounts223<>+8(SB)/4, $0x00000010
+DATA shiftCounts223<>+12(SB)/4, $0x00000010
+DATA shiftCounts223<>+16(SB)/4, $0x00000004
+DATA shiftCounts223<>+20(SB)/4, $0x00000004
+DATA shiftCounts223<>+24(SB)/4, $0x00000004
+DATA shiftCounts223<>+28(SB)/4, $0x00000004
+GLOBL shiftCounts223<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts224<>+0(SB)/4, $0x00000020
+DATA shiftCounts224<>+4(SB)/4, $0x00000020
+DATA shiftCounts224<>+8(SB)/4, $0x00000020
+DATA shiftCounts224<>+12(SB)/4, $0x00000020
+DATA shiftCounts224<>+16(SB)/4, $0x00000018
+DATA shiftCounts224<>+20(SB)/4, $0x00000018
+DATA shiftCounts224<>+24(SB)/4, $0x00000018
+DATA shiftCounts224<>+28(SB)/4, $0x00000018
+GLOBL shiftCounts224<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts225<>+0(SB)/4, $0x00000008
+DATA shiftCounts225<>+4(SB)/4, $0x00000008
+DATA shiftCounts225<>+8(SB)/4, $0x00000008
+DATA shiftCounts225<>+12(SB)/4, $0x00000008
+DATA shiftCounts225<>+16(SB)/4, $0x00000010
+DATA shiftCounts225<>+20(SB)/4, $0x00000010
+DATA shiftCounts225<>+24(SB)/4, $0x00000010
+DATA shiftCounts225<>+28(SB)/4, $0x00000010
+GLOBL shiftCounts225<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts226<>+0(SB)/4, $0x00000010
+DATA shiftCounts226<>+4(SB)/4, $0x00000010
+DATA shiftCounts226<>+8(SB)/4, $0x00000010
+DATA shiftCounts226<>+12(SB)/4, $0x00000010
+DATA shiftCounts226<>+16(SB)/4, $0x00000008
+DATA shiftCounts226<>+20(SB)/4, $0x00000008
+DATA shiftCounts226<>+24(SB)/4, $0x00000008
+DATA shiftCounts226<>+28(SB)/4, $0x00000008
+GLOBL shiftCounts226<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts227<>+0(SB)/4, $0x00000004
+DATA shiftCounts227<>+4(SB)/4, $0x00000004
+DATA shiftCounts227<>+8(SB)/4, $0x00000004
+DATA shiftCounts227<>+12(SB)/4, $0x00000004
+DATA shiftCounts227<>+16(SB)/4, $0x0000000c
+DATA shiftCounts227<>+20(SB)/4, $0x0000000c
+DATA shiftCounts227<>+24(SB)/4, $0x0000000c
+DATA shiftCounts227<>+28(SB)/4, $0x0000000c
+GLOBL shiftCounts227<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_21(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_21(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VMOVDQU        (AX), Y0
+	VPSRLVD        shiftCounts228<>+0(SB), Y0, Y0
+	VMOVDQU        16(AX), Y1
+	VPSLLVD        shiftCounts229<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 48(AX), Y1
+	VPSLLVD        shiftCounts43<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VMOVDQU        48(AX), Y0
+	VPSRLVD        shiftCounts230<>+0(SB), Y0, Y0
+	VMOVDQU        64(AX), Y1
+	VPSLLVD        shiftCounts231<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 96(AX), Y1
+	VPSLLVD        shiftCounts76<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VMOVDQU        96(AX), Y0
+	VPSRLVD        shiftCounts232<>+0(SB), Y0, Y0
+	VMOVDQU        112(AX), Y1
+	VPSLLVD        shiftCounts233<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 144(AX), Y1
+	VPSLLVD        shiftCounts81<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VMOVDQU        144(AX), Y0
+	VPSRLVD        shiftCounts234<>+0(SB), Y0, Y0
+	VMOVDQU        160(AX), Y1
+	VPSLLVD        shiftCounts235<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 192(AX), Y1
+	VPSLLVD        shiftCounts98<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VMOVDQU        192(AX), Y0
+	VPSRLVD        shiftCounts236<>+0(SB), Y0, Y0
+	VMOVDQU        208(AX), Y1
+	VPSLLVD        shiftCounts237<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 240(AX), Y1
+	VPSLLVD        shiftCounts238<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 128(CX)
+	VMOVDQU        240(AX), Y0
+	VPSRLVD        shiftCounts239<>+0(SB), Y0, Y0
+	VMOVDQU        256(AX), Y1
+	VPSLLVD        shiftCounts240<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 288(AX), Y1
+	VPSLLVD        shiftCounts241<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 160(CX)
+	VMOVDQU        288(AX), Y0
+	VPSRLVD        shiftCounts242<>+0(SB), Y0, Y0
+	VMOVDQU        304(AX), Y1
+	VPSLLVD        shiftCounts243<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 336(AX), Y1
+	VPSLLVD        shiftCounts199<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 192(CX)
+	VMOVDQU        336(AX), Y0
+	VPSRLVD        shiftCounts244<>+0(SB), Y0, Y0
+	VMOVDQU        352(AX), Y1
+	VPSLLVD        shiftCounts245<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 384(AX), Y1
+	VPSLLVD        shiftCounts224<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 224(CX)
+	VMOVDQU        384(AX), Y0
+	VPSRLVD        shiftCounts246<>+0(SB), Y0, Y0
+	VMOVDQU        400(AX), Y1
+	VPSLLVD        shiftCounts247<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 432(AX), Y1
+	VPSLLVD        shiftCounts111<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 256(CX)
+	VMOVDQU        432(AX), Y0
+	VPSRLVD        shiftCounts248<>+0(SB), Y0, Y0
+	VMOVDQU        448(AX), Y1
+	VPSLLVD        shiftCounts249<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 480(AX), Y1
+	VPSLLVD        shiftCounts188<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 288(CX)
+	VBROADCASTI128 480(AX), Y0
+	VPSRLVD        shiftCounts10<>+0(SB), Y0, Y0
+	VBROADCASTI128 496(AX), Y1
+	VPSLLVD        shiftCounts11<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        X0, 320(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts228<>+0(SB)/4, $0x00000000
+DATA shiftCounts228<>+4(SB)/4, $0x00000000
+DATA shiftCounts228<>+8(SB)/4, $0x00000000
+DATA shiftCounts228<>+12(SB)/4, $0x00000000
+DATA shiftCounts228<>+16(SB)/4, $0x0000000b
+DATA shiftCounts228<>+20(SB)/4, $0x0000000b
+DATA shiftCounts228<>+24(SB)/4, $0x0000000b
+DATA shiftCounts228<>+28(SB)/4, $0x0000000b
+GLOBL shiftCounts228<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts229<>+0(SB)/4, $0x00000015
+DATA shiftCounts229<>+4(SB)/4, $0x00000015
+DATA shiftCounts229<>+8(SB)/4, $0x00000015
+DATA shiftCounts229<>+12(SB)/4, $0x00000015
+DATA shiftCounts229<>+16(SB)/4, $0x0000000a
+DATA shiftCounts229<>+20(SB)/4, $0x0000000a
+DATA shiftCounts229<>+24(SB)/4, $0x0000000a
+DATA shiftCounts229<>+28(SB)/4, $0x0000000a
+GLOBL shiftCounts229<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts230<>+0(SB)/4, $0x00000001
+DATA shiftCounts230<>+4(SB)/4, $0x00000001
+DATA shiftCounts230<>+8(SB)/4, $0x00000001
+DATA shiftCounts230<>+12(SB)/4, $0x00000001
+DATA shiftCounts230<>+16(SB)/4, $0x0000000c
+DATA shiftCounts230<>+20(SB)/4, $0x0000000c
+DATA shiftCounts230<>+24(SB)/4, $0x0000000c
+DATA shiftCounts230<>+28(SB)/4, $0x0000000c
+GLOBL shiftCounts230<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts231<>+0(SB)/4, $0x00000014
+DATA shiftCounts231<>+4(SB)/4, $0x00000014
+DATA shiftCounts231<>+8(SB)/4, $0x00000014
+DATA shiftCounts231<>+12(SB)/4, $0x00000014
+DATA shiftCounts231<>+16(SB)/4, $0x00000009
+DATA shiftCounts231<>+20(SB)/4, $0x00000009
+DATA shiftCounts231<>+24(SB)/4, $0x00000009
+DATA shiftCounts231<>+28(SB)/4, $0x00000009
+GLOBL shiftCounts231<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts232<>+0(SB)/4, $0x00000002
+DATA shiftCounts232<>+4(SB)/4, $0x00000002
+DATA shiftCounts232<>+8(SB)/4, $0x00000002
+DATA shiftCounts232<>+12(SB)/4, $0x00000002
+DATA shiftCounts232<>+16(SB)/4, $0x0000000d
+DATA shiftCounts232<>+20(SB)/4, $0x0000000d
+DATA shiftCounts232<>+24(SB)/4, $0x0000000d
+DATA shiftCounts232<>+28(SB)/4, $0x0000000d
+GLOBL shiftCounts232<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts233<>+0(SB)/4, $0x00000013
+DATA shiftCounts233<>+4(SB)/4, $0x00000013
+DATA shiftCounts233<>+8(SB)/4, $0x00000013
+DATA shiftCounts233<>+12(SB)/4, $0x00000013
+DATA shiftCounts233<>+16(SB)/4, $0x00000008
+DATA shiftCounts233<>+20(SB)/4, $0x00000008
+DATA shiftCounts233<>+24(SB)/4, $0x00000008
+DATA shiftCounts233<>+28(SB)/4, $0x00000008
+GLOBL shiftCounts233<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts234<>+0(SB)/4, $0x00000003
+DATA shiftCounts234<>+4(SB)/4, $0x00000003
+DATA shiftCounts234<>+8(SB)/4, $0x00000003
+DATA shiftCounts234<>+12(SB)/4, $0x00000003
+DATA shiftCounts234<>+16(SB)/4, $0x0000000e
+DATA shiftCounts234<>+20(SB)/4, $0x0000000e
+DATA shiftCounts234<>+24(SB)/4, $0x0000000e
+DATA shiftCounts234<>+28(SB)/4, $0x0000000e
+GLOBL shiftCounts234<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts235<>+0(SB)/4, $0x00000012
+DATA shiftCounts235<>+4(SB)/4, $0x00000012
+DATA shiftCounts235<>+8(SB)/4, $0x00000012
+DATA shiftCounts235<>+12(SB)/4, $0x00000012
+DATA shiftCounts235<>+16(SB)/4, $0x00000007
+DATA shiftCounts235<>+20(SB)/4, $0x00000007
+DATA shiftCounts235<>+24(SB)/4, $0x00000007
+DATA shiftCounts235<>+28(SB)/4, $0x00000007
+GLOBL shiftCounts235<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts236<>+0(SB)/4, $0x00000004
+DATA shiftCounts236<>+4(SB)/4, $0x00000004
+DATA shiftCounts236<>+8(SB)/4, $0x00000004
+DATA shiftCounts236<>+12(SB)/4, $0x00000004
+DATA shiftCounts236<>+16(SB)/4, $0x0000000f
+DATA shiftCounts236<>+20(SB)/4, $0x0000000f
+DATA shiftCounts236<>+24(SB)/4, $0x0000000f
+DATA shiftCounts236<>+28(SB)/4, $0x0000000f
+GLOBL shiftCounts236<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts237<>+0(SB)/4, $0x00000011
+DATA shiftCounts237<>+4(SB)/4, $0x00000011
+DATA shiftCounts237<>+8(SB)/4, $0x00000011
+DATA shiftCounts237<>+12(SB)/4, $0x00000011
+DATA shiftCounts237<>+16(SB)/4, $0x00000006
+DATA shiftCounts237<>+20(SB)/4, $0x00000006
+DATA shiftCounts237<>+24(SB)/4, $0x00000006
+DATA shiftCounts237<>+28(SB)/4, $0x00000006
+GLOBL shiftCounts237<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts238<>+0(SB)/4, $0x00000020
+DATA shiftCounts238<>+4(SB)/4, $0x00000020
+DATA shiftCounts238<>+8(SB)/4, $0x00000020
+DATA shiftCounts238<>+12(SB)/4, $0x00000020
+DATA shiftCounts238<>+16(SB)/4, $0x0000001b
+DATA shiftCounts238<>+20(SB)/4, $0x0000001b
+DATA shiftCounts238<>+24(SB)/4, $0x0000001b
+DATA shiftCounts238<>+28(SB)/4, $0x0000001b
+GLOBL shiftCounts238<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts239<>+0(SB)/4, $0x00000005
+DATA shiftCounts239<>+4(SB)/4, $0x00000005
+DATA shiftCounts239<>+8(SB)/4, $0x00000005
+DATA shiftCounts239<>+12(SB)/4, $0x00000005
+DATA shiftCounts239<>+16(SB)/4, $0x00000010
+DATA shiftCounts239<>+20(SB)/4, $0x00000010
+DATA shiftCounts239<>+24(SB)/4, $0x00000010
+DATA shiftCounts239<>+28(SB)/4, $0x00000010
+GLOBL shiftCounts239<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts240<>+0(SB)/4, $0x00000010
+DATA shiftCounts240<>+4(SB)/4, $0x00000010
+DATA shiftCounts240<>+8(SB)/4, $0x00000010
+DATA shiftCounts240<>+12(SB)/4, $0x00000010
+DATA shiftCounts240<>+16(SB)/4, $0x00000005
+DATA shiftCounts240<>+20(SB)/4, $0x00000005
+DATA shiftCounts240<>+24(SB)/4, $0x00000005
+DATA shiftCounts240<>+28(SB)/4, $0x00000005
+GLOBL shiftCounts240<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts241<>+0(SB)/4, $0x00000020
+DATA shiftCounts241<>+4(SB)/4, $0x00000020
+DATA shiftCounts241<>+8(SB)/4, $0x00000020
+DATA shiftCounts241<>+12(SB)/4, $0x00000020
+DATA shiftCounts241<>+16(SB)/4, $0x0000001a
+DATA shiftCounts241<>+20(SB)/4, $0x0000001a
+DATA shiftCounts241<>+24(SB)/4, $0x0000001a
+DATA shiftCounts241<>+28(SB)/4, $0x0000001a
+GLOBL shiftCounts241<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts242<>+0(SB)/4, $0x00000006
+DATA shiftCounts242<>+4(SB)/4, $0x00000006
+DATA shiftCounts242<>+8(SB)/4, $0x00000006
+DATA shiftCounts242<>+12(SB)/4, $0x00000006
+DATA shiftCounts242<>+16(SB)/4, $0x00000011
+DATA shiftCounts242<>+20(SB)/4, $0x00000011
+DATA shiftCounts242<>+24(SB)/4, $0x00000011
+DATA shiftCounts242<>+28(SB)/4, $0x00000011
+GLOBL shiftCounts242<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts243<>+0(SB)/4, $0x0000000f
+DATA shiftCounts243<>+4(SB)/4, $0x0000000f
+DATA shiftCounts243<>+8(SB)/4, $0x0000000f
+DATA shiftCounts243<>+12(SB)/4, $0x0000000f
+DATA shiftCounts243<>+16(SB)/4, $0x00000004
+DATA shiftCounts243<>+20(SB)/4, $0x00000004
+DATA shiftCounts243<>+24(SB)/4, $0x00000004
+DATA shiftCounts243<>+28(SB)/4, $0x00000004
+GLOBL shiftCounts243<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts244<>+0(SB)/4, $0x00000007
+DATA shiftCounts244<>+4(SB)/4, $0x00000007
+DATA shiftCounts244<>+8(SB)/4, $0x00000007
+DATA shiftCounts244<>+12(SB)/4, $0x00000007
+DATA shiftCounts244<>+16(SB)/4, $0x00000012
+DATA shiftCounts244<>+20(SB)/4, $0x00000012
+DATA shiftCounts244<>+24(SB)/4, $0x00000012
+DATA shiftCounts244<>+28(SB)/4, $0x00000012
+GLOBL shiftCounts244<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts245<>+0(SB)/4, $0x0000000e
+DATA shiftCounts245<>+4(SB)/4, $0x0000000e
+DATA shiftCounts245<>+8(SB)/4, $0x0000000e
+DATA shiftCounts245<>+12(SB)/4, $0x0000000e
+DATA shiftCounts245<>+16(SB)/4, $0x00000003
+DATA shiftCounts245<>+20(SB)/4, $0x00000003
+DATA shiftCounts245<>+24(SB)/4, $0x00000003
+DATA shiftCounts245<>+28(SB)/4, $0x00000003
+GLOBL shiftCounts245<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts246<>+0(SB)/4, $0x00000008
+DATA shiftCounts246<>+4(SB)/4, $0x00000008
+DATA shiftCounts246<>+8(SB)/4, $0x00000008
+DATA shiftCounts246<>+12(SB)/4, $0x00000008
+DATA shiftCounts246<>+16(SB)/4, $0x00000013
+DATA shiftCounts246<>+20(SB)/4, $0x00000013
+DATA shiftCounts246<>+24(SB)/4, $0x00000013
+DATA shiftCounts246<>+28(SB)/4, $0x00000013
+GLOBL shiftCounts246<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts247<>+0(SB)/4, $0x0000000d
+DATA shiftCounts247<>+4(SB)/4, $0x0000000d
+DATA shiftCounts247<>+8(SB)/4, $0x0000000d
+DATA shiftCounts247<>+12(SB)/4, $0x0000000d
+DATA shiftCounts247<>+16(SB)/4, $0x00000002
+DATA shiftCounts247<>+20(SB)/4, $0x00000002
+DATA shiftCounts247<>+24(SB)/4, $0x00000002
+DATA shiftCounts247<>+28(SB)/4, $0x00000002
+GLOBL shiftCounts247<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts248<>+0(SB)/4, $0x00000009
+DATA shiftCounts248<>+4(SB)/4, $0x00000009
+DATA shiftCounts248<>+8(SB)/4, $0x00000009
+DATA shiftCounts248<>+12(SB)/4, $0x00000009
+DATA shiftCounts248<>+16(SB)/4, $0x00000014
+DATA shiftCounts248<>+20(SB)/4, $0x00000014
+DATA shiftCounts248<>+24(SB)/4, $0x00000014
+DATA shiftCounts248<>+28(SB)/4, $0x00000014
+GLOBL shiftCounts248<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts249<>+0(SB)/4, $0x0000000c
+DATA shiftCounts249<>+4(SB)/4, $0x0000000c
+DATA shiftCounts249<>+8(SB)/4, $0x0000000c
+DATA shiftCounts249<>+12(SB)/4, $0x0000000c
+DATA shiftCounts249<>+16(SB)/4, $0x00000001
+DATA shiftCounts249<>+20(SB)/4, $0x00000001
+DATA shiftCounts249<>+24(SB)/4, $0x00000001
+DATA shiftCounts249<>+28(SB)/4, $0x00000001
+GLOBL shiftCounts249<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_22(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_22(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VMOVDQU        (AX), Y0
+	VPSRLVD        shiftCounts108<>+0(SB), Y0, Y0
+	VMOVDQU        16(AX), Y1
+	VPSLLVD        shiftCounts110<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VBROADCASTI128 32(AX), Y0
+	VINSERTI128    $0x01, 64(AX), Y0, Y0
+	VPSRLVD        shiftCounts221<>+0(SB), Y0, Y0
+	VBROADCASTI128 48(AX), Y1
+	VINSERTI128    $0x01, 80(AX), Y1, Y1
+	VPSLLVD        shiftCounts250<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 64(AX), Y1
+	VPSLLVD        shiftCounts24<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VBROADCASTI128 80(AX), Y0
+	VINSERTI128    $0x01, 112(AX), Y0, Y0
+	VPSRLVD        shiftCounts251<>+0(SB), Y0, Y0
+	VBROADCASTI128 96(AX), Y1
+	VINSERTI128    $0x01, 128(AX), Y1, Y1
+	VPSLLVD        shiftCounts222<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 112(AX), Y1
+	VPSLLVD        shiftCounts26<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VBROADCASTI128 128(AX), Y0
+	VINSERTI128    $0x01, 160(AX), Y0, Y0
+	VPSRLVD        shiftCounts223<>+0(SB), Y0, Y0
+	VBROADCASTI128 144(AX), Y1
+	VINSERTI128    $0x01, 176(AX), Y1, Y1
+	VPSLLVD        shiftCounts252<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 160(AX), Y1
+	VPSLLVD        shiftCounts28<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VBROADCASTI128 176(AX), Y0
+	VINSERTI128    $0x01, 208(AX), Y0, Y0
+	VPSRLVD        shiftCounts253<>+0(SB), Y0, Y0
+	VBROADCASTI128 192(AX), Y1
+	VINSERTI128    $0x01, 224(AX), Y1, Y1
+	VPSLLVD        shiftCounts254<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 208(AX), Y1
+	VPSLLVD        shiftCounts30<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 128(CX)
+	VBROADCASTI128 224(AX), Y0
+	VINSERTI128    $0x01, 256(AX), Y0, Y0
+	VPSRLVD        shiftCounts255<>+0(SB), Y0, Y0
+	VBROADCASTI128 240(AX), Y1
+	VINSERTI128    $0x01, 272(AX), Y1, Y1
+	VPSLLVD        shiftCounts256<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 160(CX)
+	VMOVDQU        272(AX), Y0
+	VPSRLVD        shiftCounts257<>+0(SB), Y0, Y0
+	VMOVDQU        288(AX), Y1
+	VPSLLVD        shiftCounts157<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 320(AX), Y1
+	VPSLLVD        shiftCounts224<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 192(CX)
+	VMOVDQU        320(AX), Y0
+	VPSRLVD        shiftCounts258<>+0(SB), Y0, Y0
+	VMOVDQU        336(AX), Y1
+	VPSLLVD        shiftCounts259<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 368(AX), Y1
+	VPSLLVD        shiftCounts241<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 224(CX)
+	VMOVDQU        368(AX), Y0
+	VPSRLVD        shiftCounts260<>+0(SB), Y0, Y0
+	VMOVDQU        384(AX), Y1
+	VPSLLVD        shiftCounts261<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 416(AX), Y1
+	VPSLLVD        shiftCounts98<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 256(CX)
+	VMOVDQU        416(AX), Y0
+	VPSRLVD        shiftCounts153<>+0(SB), Y0, Y0
+	VMOVDQU        432(AX), Y1
+	VPSLLVD        shiftCounts262<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 464(AX), Y1
+	VPSLLVD        shiftCounts76<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 288(CX)
+	VMOVDQU        464(AX), Y0
+	VPSRLVD        shiftCounts158<>+0(SB), Y0, Y0
+	VMOVDQU        480(AX), Y1
+	VPSLLVD        shiftCounts263<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 320(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts250<>+0(SB)/4, $0x00000002
+DATA shiftCounts250<>+4(SB)/4, $0x00000002
+DATA shiftCounts250<>+8(SB)/4, $0x00000002
+DATA shiftCounts250<>+12(SB)/4, $0x00000002
+DATA shiftCounts250<>+16(SB)/4, $0x0000000e
+DATA shiftCounts250<>+20(SB)/4, $0x0000000e
+DATA shiftCounts250<>+24(SB)/4, $0x0000000e
+DATA shiftCounts250<>+28(SB)/4, $0x0000000e
+GLOBL shiftCounts250<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts251<>+0(SB)/4, $0x00000012
+DATA shiftCounts251<>+4(SB)/4, $0x00000012
+DATA shiftCounts251<>+8(SB)/4, $0x00000012
+DATA shiftCounts251<>+12(SB)/4, $0x00000012
+DATA shiftCounts251<>+16(SB)/4, $0x00000006
+DATA shiftCounts251<>+20(SB)/4, $0x00000006
+DATA shiftCounts251<>+24(SB)/4, $0x00000006
+DATA shiftCounts251<>+28(SB)/4, $0x00000006
+GLOBL shiftCounts251<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts252<>+0(SB)/4, $0x00000006
+DATA shiftCounts252<>+4(SB)/4, $0x00000006
+DATA shiftCounts252<>+8(SB)/4, $0x00000006
+DATA shiftCounts252<>+12(SB)/4, $0x00000006
+DATA shiftCounts252<>+16(SB)/4, $0x00000012
+DATA shiftCounts252<>+20(SB)/4, $0x00000012
+DATA shiftCounts252<>+24(SB)/4, $0x00000012
+DATA shiftCounts252<>+28(SB)/4, $0x00000012
+GLOBL shiftCounts252<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts253<>+0(SB)/4, $0x0000000e
+DATA shiftCounts253<>+4(SB)/4, $0x0000000e
+DATA shiftCounts253<>+8(SB)/4, $0x0000000e
+DATA shiftCounts253<>+12(SB)/4, $0x0000000e
+DATA shiftCounts253<>+16(SB)/4, $0x00000002
+DATA shiftCounts253<>+20(SB)/4, $0x00000002
+DATA shiftCounts253<>+24(SB)/4, $0x00000002
+DATA shiftCounts253<>+28(SB)/4, $0x00000002
+GLOBL shiftCounts253<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts254<>+0(SB)/4, $0x00000008
+DATA shiftCounts254<>+4(SB)/4, $0x00000008
+DATA shiftCounts254<>+8(SB)/4, $0x00000008
+DATA shiftCounts254<>+12(SB)/4, $0x00000008
+DATA shiftCounts254<>+16(SB)/4, $0x00000014
+DATA shiftCounts254<>+20(SB)/4, $0x00000014
+DATA shiftCounts254<>+24(SB)/4, $0x00000014
+DATA shiftCounts254<>+28(SB)/4, $0x00000014
+GLOBL shiftCounts254<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts255<>+0(SB)/4, $0x0000000c
+DATA shiftCounts255<>+4(SB)/4, $0x0000000c
+DATA shiftCounts255<>+8(SB)/4, $0x0000000c
+DATA shiftCounts255<>+12(SB)/4, $0x0000000c
+DATA shiftCounts255<>+16(SB)/4, $0x00000000
+DATA shiftCounts255<>+20(SB)/4, $0x00000000
+DATA shiftCounts255<>+24(SB)/4, $0x00000000
+DATA shiftCounts255<>+28(SB)/4, $0x00000000
+GLOBL shiftCounts255<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts256<>+0(SB)/4, $0x0000000a
+DATA shiftCounts256<>+4(SB)/4, $0x0000000a
+DATA shiftCounts256<>+8(SB)/4, $0x0000000a
+DATA shiftCounts256<>+12(SB)/4, $0x0000000a
+DATA shiftCounts256<>+16(SB)/4, $0x00000016
+DATA shiftCounts256<>+20(SB)/4, $0x00000016
+DATA shiftCounts256<>+24(SB)/4, $0x00000016
+DATA shiftCounts256<>+28(SB)/4, $0x00000016
+GLOBL shiftCounts256<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts257<>+0(SB)/4, $0x0000000a
+DATA shiftCounts257<>+4(SB)/4, $0x0000000a
+DATA shiftCounts257<>+8(SB)/4, $0x0000000a
+DATA shiftCounts257<>+12(SB)/4, $0x0000000a
+DATA shiftCounts257<>+16(SB)/4, $0x00000014
+DATA shiftCounts257<>+20(SB)/4, $0x00000014
+DATA shiftCounts257<>+24(SB)/4, $0x00000014
+DATA shiftCounts257<>+28(SB)/4, $0x00000014
+GLOBL shiftCounts257<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts258<>+0(SB)/4, $0x00000008
+DATA shiftCounts258<>+4(SB)/4, $0x00000008
+DATA shiftCounts258<>+8(SB)/4, $0x00000008
+DATA shiftCounts258<>+12(SB)/4, $0x00000008
+DATA shiftCounts258<>+16(SB)/4, $0x00000012
+DATA shiftCounts258<>+20(SB)/4, $0x00000012
+DATA shiftCounts258<>+24(SB)/4, $0x00000012
+DATA shiftCounts258<>+28(SB)/4, $0x00000012
+GLOBL shiftCounts258<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts259<>+0(SB)/4, $0x0000000e
+DATA shiftCounts259<>+4(SB)/4, $0x0000000e
+DATA shiftCounts259<>+8(SB)/4, $0x0000000e
+DATA shiftCounts259<>+12(SB)/4, $0x0000000e
+DATA shiftCounts259<>+16(SB)/4, $0x00000004
+DATA shiftCounts259<>+20(SB)/4, $0x00000004
+DATA shiftCounts259<>+24(SB)/4, $0x00000004
+DATA shiftCounts259<>+28(SB)/4, $0x00000004
+GLOBL shiftCounts259<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts260<>+0(SB)/4, $0x00000006
+DATA shiftCounts260<>+4(SB)/4, $0x00000006
+DATA shiftCounts260<>+8(SB)/4, $0x00000006
+DATA shiftCounts260<>+12(SB)/4, $0x00000006
+DATA shiftCounts260<>+16(SB)/4, $0x00000010
+DATA shiftCounts260<>+20(SB)/4, $0x00000010
+DATA shiftCounts260<>+24(SB)/4, $0x00000010
+DATA shiftCounts260<>+28(SB)/4, $0x00000010
+GLOBL shiftCounts260<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts261<>+0(SB)/4, $0x00000010
+DATA shiftCounts261<>+4(SB)/4, $0x00000010
+DATA shiftCounts261<>+8(SB)/4, $0x00000010
+DATA shiftCounts261<>+12(SB)/4, $0x00000010
+DATA shiftCounts261<>+16(SB)/4, $0x00000006
+DATA shiftCounts261<>+20(SB)/4, $0x00000006
+DATA shiftCounts261<>+24(SB)/4, $0x00000006
+DATA shiftCounts261<>+28(SB)/4, $0x00000006
+GLOBL shiftCounts261<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts262<>+0(SB)/4, $0x00000012
+DATA shiftCounts262<>+4(SB)/4, $0x00000012
+DATA shiftCounts262<>+8(SB)/4, $0x00000012
+DATA shiftCounts262<>+12(SB)/4, $0x00000012
+DATA shiftCounts262<>+16(SB)/4, $0x00000008
+DATA shiftCounts262<>+20(SB)/4, $0x00000008
+DATA shiftCounts262<>+24(SB)/4, $0x00000008
+DATA shiftCounts262<>+28(SB)/4, $0x00000008
+GLOBL shiftCounts262<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts263<>+0(SB)/4, $0x00000014
+DATA shiftCounts263<>+4(SB)/4, $0x00000014
+DATA shiftCounts263<>+8(SB)/4, $0x00000014
+DATA shiftCounts263<>+12(SB)/4, $0x00000014
+DATA shiftCounts263<>+16(SB)/4, $0x0000000a
+DATA shiftCounts263<>+20(SB)/4, $0x0000000a
+DATA shiftCounts263<>+24(SB)/4, $0x0000000a
+DATA shiftCounts263<>+28(SB)/4, $0x0000000a
+GLOBL shiftCounts263<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_23(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_23(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VMOVDQU        (AX), Y0
+	VPSRLVD        shiftCounts264<>+0(SB), Y0, Y0
+	VMOVDQU        16(AX), Y1
+	VPSLLVD        shiftCounts265<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VBROADCASTI128 32(AX), Y0
+	VINSERTI128    $0x01, 64(AX), Y0, Y0
+	VPSRLVD        shiftCounts187<>+0(SB), Y0, Y0
+	VBROADCASTI128 48(AX), Y1
+	VINSERTI128    $0x01, 80(AX), Y1, Y1
+	VPSLLVD        shiftCounts266<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 64(AX), Y1
+	VPSLLVD        shiftCounts28<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VMOVDQU        80(AX), Y0
+	VPSRLVD        shiftCounts267<>+0(SB), Y0, Y0
+	VMOVDQU        96(AX), Y1
+	VPSLLVD        shiftCounts268<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 128(AX), Y1
+	VPSLLVD        shiftCounts224<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VMOVDQU        128(AX), Y0
+	VPSRLVD        shiftCounts269<>+0(SB), Y0, Y0
+	VMOVDQU        144(AX), Y1
+	VPSLLVD        shiftCounts270<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 176(AX), Y1
+	VPSLLVD        shiftCounts81<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VMOVDQU        176(AX), Y0
+	VPSRLVD        shiftCounts271<>+0(SB), Y0, Y0
+	VMOVDQU        192(AX), Y1
+	VPSLLVD        shiftCounts272<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 128(CX)
+	VBROADCASTI128 208(AX), Y0
+	VINSERTI128    $0x01, 240(AX), Y0, Y0
+	VPSRLVD        shiftCounts273<>+0(SB), Y0, Y0
+	VBROADCASTI128 224(AX), Y1
+	VINSERTI128    $0x01, 256(AX), Y1, Y1
+	VPSLLVD        shiftCounts189<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 240(AX), Y1
+	VPSLLVD        shiftCounts25<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 160(CX)
+	VBROADCASTI128 256(AX), Y0
+	VINSERTI128    $0x01, 288(AX), Y0, Y0
+	VPSRLVD        shiftCounts190<>+0(SB), Y0, Y0
+	VBROADCASTI128 272(AX), Y1
+	VINSERTI128    $0x01, 304(AX), Y1, Y1
+	VPSLLVD        shiftCounts274<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 288(AX), Y1
+	VPSLLVD        shiftCounts30<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 192(CX)
+	VMOVDQU        304(AX), Y0
+	VPSRLVD        shiftCounts275<>+0(SB), Y0, Y0
+	VMOVDQU        320(AX), Y1
+	VPSLLVD        shiftCounts276<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 352(AX), Y1
+	VPSLLVD        shiftCounts241<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 224(CX)
+	VMOVDQU        352(AX), Y0
+	VPSRLVD        shiftCounts277<>+0(SB), Y0, Y0
+	VMOVDQU        368(AX), Y1
+	VPSLLVD        shiftCounts278<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 400(AX), Y1
+	VPSLLVD        shiftCounts43<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 256(CX)
+	VMOVDQU        400(AX), Y0
+	VPSRLVD        shiftCounts279<>+0(SB), Y0, Y0
+	VMOVDQU        416(AX), Y1
+	VPSLLVD        shiftCounts280<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 288(CX)
+	VBROADCASTI128 432(AX), Y0
+	VINSERTI128    $0x01, 464(AX), Y0, Y0
+	VPSRLVD        shiftCounts281<>+0(SB), Y0, Y0
+	VBROADCASTI128 448(AX), Y1
+	VINSERTI128    $0x01, 480(AX), Y1, Y1
+	VPSLLVD        shiftCounts282<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 464(AX), Y1
+	VPSLLVD        shiftCounts27<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 320(CX)
+	VBROADCASTI128 480(AX), Y0
+	VPSRLVD        shiftCounts14<>+0(SB), Y0, Y0
+	VBROADCASTI128 496(AX), Y1
+	VPSLLVD        shiftCounts9<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        X0, 352(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts264<>+0(SB)/4, $0x00000000
+DATA shiftCounts264<>+4(SB)/4, $0x00000000
+DATA shiftCounts264<>+8(SB)/4, $0x00000000
+DATA shiftCounts264<>+12(SB)/4, $0x00000000
+DATA shiftCounts264<>+16(SB)/4, $0x00000009
+DATA shiftCounts264<>+20(SB)/4, $0x00000009
+DATA shiftCounts264<>+24(SB)/4, $0x00000009
+DATA shiftCounts264<>+28(SB)/4, $0x00000009
+GLOBL shiftCounts264<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts265<>+0(SB)/4, $0x00000017
+DATA shiftCounts265<>+4(SB)/4, $0x00000017
+DATA shiftCounts265<>+8(SB)/4, $0x00000017
+DATA shiftCounts265<>+12(SB)/4, $0x00000017
+DATA shiftCounts265<>+16(SB)/4, $0x0000000e
+DATA shiftCounts265<>+20(SB)/4, $0x0000000e
+DATA shiftCounts265<>+24(SB)/4, $0x0000000e
+DATA shiftCounts265<>+28(SB)/4, $0x0000000e
+GLOBL shiftCounts265<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts266<>+0(SB)/4, $0x00000005
+DATA shiftCounts266<>+4(SB)/4, $0x00000005
+DATA shiftCounts266<>+8(SB)/4, $0x00000005
+DATA shiftCounts266<>+12(SB)/4, $0x00000005
+DATA shiftCounts266<>+16(SB)/4, $0x00000013
+DATA shiftCounts266<>+20(SB)/4, $0x00000013
+DATA shiftCounts266<>+24(SB)/4, $0x00000013
+DATA shiftCounts266<>+28(SB)/4, $0x00000013
+GLOBL shiftCounts266<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts267<>+0(SB)/4, $0x0000000d
+DATA shiftCounts267<>+4(SB)/4, $0x0000000d
+DATA shiftCounts267<>+8(SB)/4, $0x0000000d
+DATA shiftCounts267<>+12(SB)/4, $0x0000000d
+DATA shiftCounts267<>+16(SB)/4, $0x00000016
+DATA shiftCounts267<>+20(SB)/4, $0x00000016
+DATA shiftCounts267<>+24(SB)/4, $0x00000016
+DATA shiftCounts267<>+28(SB)/4, $0x00000016
+GLOBL shiftCounts267<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts268<>+0(SB)/4, $0x0000000a
+DATA shiftCounts268<>+4(SB)/4, $0x0000000a
+DATA shiftCounts268<>+8(SB)/4, $0x0000000a
+DATA shiftCounts268<>+12(SB)/4, $0x0000000a
+DATA shiftCounts268<>+16(SB)/4, $0x00000001
+DATA shiftCounts268<>+20(SB)/4, $0x00000001
+DATA shiftCounts268<>+24(SB)/4, $0x00000001
+DATA shiftCounts268<>+28(SB)/4, $0x00000001
+GLOBL shiftCounts268<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts269<>+0(SB)/4, $0x00000008
+DATA shiftCounts269<>+4(SB)/4, $0x00000008
+DATA shiftCounts269<>+8(SB)/4, $0x00000008
+DATA shiftCounts269<>+12(SB)/4, $0x00000008
+DATA shiftCounts269<>+16(SB)/4, $0x00000011
+DATA shiftCounts269<>+20(SB)/4, $0x00000011
+DATA shiftCounts269<>+24(SB)/4, $0x00000011
+DATA shiftCounts269<>+28(SB)/4, $0x00000011
+GLOBL shiftCounts269<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts270<>+0(SB)/4, $0x0000000f
+DATA shiftCounts270<>+4(SB)/4, $0x0000000f
+DATA shiftCounts270<>+8(SB)/4, $0x0000000f
+DATA shiftCounts270<>+12(SB)/4, $0x0000000f
+DATA shiftCounts270<>+16(SB)/4, $0x00000006
+DATA shiftCounts270<>+20(SB)/4, $0x00000006
+DATA shiftCounts270<>+24(SB)/4, $0x00000006
+DATA shiftCounts270<>+28(SB)/4, $0x00000006
+GLOBL shiftCounts270<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts271<>+0(SB)/4, $0x00000003
+DATA shiftCounts271<>+4(SB)/4, $0x00000003
+DATA shiftCounts271<>+8(SB)/4, $0x00000003
+DATA shiftCounts271<>+12(SB)/4, $0x00000003
+DATA shiftCounts271<>+16(SB)/4, $0x0000000c
+DATA shiftCounts271<>+20(SB)/4, $0x0000000c
+DATA shiftCounts271<>+24(SB)/4, $0x0000000c
+DATA shiftCounts271<>+28(SB)/4, $0x0000000c
+GLOBL shiftCounts271<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts272<>+0(SB)/4, $0x00000014
+DATA shiftCounts272<>+4(SB)/4, $0x00000014
+DATA shiftCounts272<>+8(SB)/4, $0x00000014
+DATA shiftCounts272<>+12(SB)/4, $0x00000014
+DATA shiftCounts272<>+16(SB)/4, $0x0000000b
+DATA shiftCounts272<>+20(SB)/4, $0x0000000b
+DATA shiftCounts272<>+24(SB)/4, $0x0000000b
+DATA shiftCounts272<>+28(SB)/4, $0x0000000b
+GLOBL shiftCounts272<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts273<>+0(SB)/4, $0x00000015
+DATA shiftCounts273<>+4(SB)/4, $0x00000015
+DATA shiftCounts273<>+8(SB)/4, $0x00000015
+DATA shiftCounts273<>+12(SB)/4, $0x00000015
+DATA shiftCounts273<>+16(SB)/4, $0x00000007
+DATA shiftCounts273<>+20(SB)/4, $0x00000007
+DATA shiftCounts273<>+24(SB)/4, $0x00000007
+DATA shiftCounts273<>+28(SB)/4, $0x00000007
+GLOBL shiftCounts273<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts274<>+0(SB)/4, $0x00000007
+DATA shiftCounts274<>+4(SB)/4, $0x00000007
+DATA shiftCounts274<>+8(SB)/4, $0x00000007
+DATA shiftCounts274<>+12(SB)/4, $0x00000007
+DATA shiftCounts274<>+16(SB)/4, $0x00000015
+DATA shiftCounts274<>+20(SB)/4, $0x00000015
+DATA shiftCounts274<>+24(SB)/4, $0x00000015
+DATA shiftCounts274<>+28(SB)/4, $0x00000015
+GLOBL shiftCounts274<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts275<>+0(SB)/4, $0x0000000b
+DATA shiftCounts275<>+4(SB)/4, $0x0000000b
+DATA shiftCounts275<>+8(SB)/4, $0x0000000b
+DATA shiftCounts275<>+12(SB)/4, $0x0000000b
+DATA shiftCounts275<>+16(SB)/4, $0x00000014
+DATA shiftCounts275<>+20(SB)/4, $0x00000014
+DATA shiftCounts275<>+24(SB)/4, $0x00000014
+DATA shiftCounts275<>+28(SB)/4, $0x00000014
+GLOBL shiftCounts275<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts276<>+0(SB)/4, $0x0000000c
+DATA shiftCounts276<>+4(SB)/4, $0x0000000c
+DATA shiftCounts276<>+8(SB)/4, $0x0000000c
+DATA shiftCounts276<>+12(SB)/4, $0x0000000c
+DATA shiftCounts276<>+16(SB)/4, $0x00000003
+DATA shiftCounts276<>+20(SB)/4, $0x00000003
+DATA shiftCounts276<>+24(SB)/4, $0x00000003
+DATA shiftCounts276<>+28(SB)/4, $0x00000003
+GLOBL shiftCounts276<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts277<>+0(SB)/4, $0x00000006
+DATA shiftCounts277<>+4(SB)/4, $0x00000006
+DATA shiftCounts277<>+8(SB)/4, $0x00000006
+DATA shiftCounts277<>+12(SB)/4, $0x00000006
+DATA shiftCounts277<>+16(SB)/4, $0x0000000f
+DATA shiftCounts277<>+20(SB)/4, $0x0000000f
+DATA shiftCounts277<>+24(SB)/4, $0x0000000f
+DATA shiftCounts277<>+28(SB)/4, $0x0000000f
+GLOBL shiftCounts277<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts278<>+0(SB)/4, $0x00000011
+DATA shiftCounts278<>+4(SB)/4, $0x00000011
+DATA shiftCounts278<>+8(SB)/4, $0x00000011
+DATA shiftCounts278<>+12(SB)/4, $0x00000011
+DATA shiftCounts278<>+16(SB)/4, $0x00000008
+DATA shiftCounts278<>+20(SB)/4, $0x00000008
+DATA shiftCounts278<>+24(SB)/4, $0x00000008
+DATA shiftCounts278<>+28(SB)/4, $0x00000008
+GLOBL shiftCounts278<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts279<>+0(SB)/4, $0x00000001
+DATA shiftCounts279<>+4(SB)/4, $0x00000001
+DATA shiftCounts279<>+8(SB)/4, $0x00000001
+DATA shiftCounts279<>+12(SB)/4, $0x00000001
+DATA shiftCounts279<>+16(SB)/4, $0x0000000a
+DATA shiftCounts279<>+20(SB)/4, $0x0000000a
+DATA shiftCounts279<>+24(SB)/4, $0x0000000a
+DATA shiftCounts279<>+28(SB)/4, $0x0000000a
+GLOBL shiftCounts279<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts280<>+0(SB)/4, $0x00000016
+DATA shiftCounts280<>+4(SB)/4, $0x00000016
+DATA shiftCounts280<>+8(SB)/4, $0x00000016
+DATA shiftCounts280<>+12(SB)/4, $0x00000016
+DATA shiftCounts280<>+16(SB)/4, $0x0000000d
+DATA shiftCounts280<>+20(SB)/4, $0x0000000d
+DATA shiftCounts280<>+24(SB)/4, $0x0000000d
+DATA shiftCounts280<>+28(SB)/4, $0x0000000d
+GLOBL shiftCounts280<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts281<>+0(SB)/4, $0x00000013
+DATA shiftCounts281<>+4(SB)/4, $0x00000013
+DATA shiftCounts281<>+8(SB)/4, $0x00000013
+DATA shiftCounts281<>+12(SB)/4, $0x00000013
+DATA shiftCounts281<>+16(SB)/4, $0x00000005
+DATA shiftCounts281<>+20(SB)/4, $0x00000005
+DATA shiftCounts281<>+24(SB)/4, $0x00000005
+DATA shiftCounts281<>+28(SB)/4, $0x00000005
+GLOBL shiftCounts281<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts282<>+0(SB)/4, $0x00000004
+DATA shiftCounts282<>+4(SB)/4, $0x00000004
+DATA shiftCounts282<>+8(SB)/4, $0x00000004
+DATA shiftCounts282<>+12(SB)/4, $0x00000004
+DATA shiftCounts282<>+16(SB)/4, $0x00000012
+DATA shiftCounts282<>+20(SB)/4, $0x00000012
+DATA shiftCounts282<>+24(SB)/4, $0x00000012
+DATA shiftCounts282<>+28(SB)/4, $0x00000012
+GLOBL shiftCounts282<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_24(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_24(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VMOVDQU        (AX), Y0
+	VPSRLVD        shiftCounts128<>+0(SB), Y0, Y0
+	VMOVDQU        16(AX), Y1
+	VPSLLVD        shiftCounts130<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VBROADCASTI128 32(AX), Y0
+	VINSERTI128    $0x01, 64(AX), Y0, Y0
+	VPSRLVD        shiftCounts283<>+0(SB), Y0, Y0
+	VBROADCASTI128 48(AX), Y1
+	VINSERTI128    $0x01, 80(AX), Y1, Y1
+	VPSLLVD        shiftCounts284<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VMOVDQU        80(AX), Y0
+	VPSRLVD        shiftCounts225<>+0(SB), Y0, Y0
+	VMOVDQU        96(AX), Y1
+	VPSLLVD        shiftCounts226<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VMOVDQU        128(AX), Y0
+	VPSRLVD        shiftCounts128<>+0(SB), Y0, Y0
+	VMOVDQU        144(AX), Y1
+	VPSLLVD        shiftCounts130<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VBROADCASTI128 160(AX), Y0
+	VINSERTI128    $0x01, 192(AX), Y0, Y0
+	VPSRLVD        shiftCounts283<>+0(SB), Y0, Y0
+	VBROADCASTI128 176(AX), Y1
+	VINSERTI128    $0x01, 208(AX), Y1, Y1
+	VPSLLVD        shiftCounts284<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 128(CX)
+	VMOVDQU        208(AX), Y0
+	VPSRLVD        shiftCounts225<>+0(SB), Y0, Y0
+	VMOVDQU        224(AX), Y1
+	VPSLLVD        shiftCounts226<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 160(CX)
+	VMOVDQU        256(AX), Y0
+	VPSRLVD        shiftCounts128<>+0(SB), Y0, Y0
+	VMOVDQU        272(AX), Y1
+	VPSLLVD        shiftCounts130<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 192(CX)
+	VBROADCASTI128 288(AX), Y0
+	VINSERTI128    $0x01, 320(AX), Y0, Y0
+	VPSRLVD        shiftCounts283<>+0(SB), Y0, Y0
+	VBROADCASTI128 304(AX), Y1
+	VINSERTI128    $0x01, 336(AX), Y1, Y1
+	VPSLLVD        shiftCounts284<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 224(CX)
+	VMOVDQU        336(AX), Y0
+	VPSRLVD        shiftCounts225<>+0(SB), Y0, Y0
+	VMOVDQU        352(AX), Y1
+	VPSLLVD        shiftCounts226<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 256(CX)
+	VMOVDQU        384(AX), Y0
+	VPSRLVD        shiftCounts128<>+0(SB), Y0, Y0
+	VMOVDQU        400(AX), Y1
+	VPSLLVD        shiftCounts130<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 288(CX)
+	VBROADCASTI128 416(AX), Y0
+	VINSERTI128    $0x01, 448(AX), Y0, Y0
+	VPSRLVD        shiftCounts283<>+0(SB), Y0, Y0
+	VBROADCASTI128 432(AX), Y1
+	VINSERTI128    $0x01, 464(AX), Y1, Y1
+	VPSLLVD        shiftCounts284<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 320(CX)
+	VMOVDQU        464(AX), Y0
+	VPSRLVD        shiftCounts225<>+0(SB), Y0, Y0
+	VMOVDQU        480(AX), Y1
+	VPSLLVD        shiftCounts226<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 352(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts283<>+0(SB)/4, $0x00000010
+DATA shiftCounts283<>+4(SB)/4, $0x00000010
+DATA shiftCounts283<>+8(SB)/4, $0x00000010
+DATA shiftCounts283<>+12(SB)/4, $0x00000010
+DATA shiftCounts283<>+16(SB)/4, $0x00000000
+DATA shiftCounts283<>+20(SB)/4, $0x00000000
+DATA shiftCounts283<>+24(SB)/4, $0x00000000
+DATA shiftCounts283<>+28(SB)/4, $0x00000000
+GLOBL shiftCounts283<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts284<>+0(SB)/4, $0x00000008
+DATA shiftCounts284<>+4(SB)/4, $0x00000008
+DATA shiftCounts284<>+8(SB)/4, $0x00000008
+DATA shiftCounts284<>+12(SB)/4, $0x00000008
+DATA shiftCounts284<>+16(SB)/4, $0x00000018
+DATA shiftCounts284<>+20(SB)/4, $0x00000018
+DATA shiftCounts284<>+24(SB)/4, $0x00000018
+DATA shiftCounts284<>+28(SB)/4, $0x00000018
+GLOBL shiftCounts284<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_25(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_25(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VMOVDQU        (AX), Y0
+	VPSRLVD        shiftCounts285<>+0(SB), Y0, Y0
+	VMOVDQU        16(AX), Y1
+	VPSLLVD        shiftCounts286<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VMOVDQU        32(AX), Y0
+	VPSRLVD        shiftCounts139<>+0(SB), Y0, Y0
+	VMOVDQU        48(AX), Y1
+	VPSLLVD        shiftCounts140<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 80(AX), Y1
+	VPSLLVD        shiftCounts81<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VMOVDQU        80(AX), Y0
+	VPSRLVD        shiftCounts144<>+0(SB), Y0, Y0
+	VMOVDQU        96(AX), Y1
+	VPSLLVD        shiftCounts287<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VMOVDQU        112(AX), Y0
+	VPSRLVD        shiftCounts148<>+0(SB), Y0, Y0
+	VMOVDQU        128(AX), Y1
+	VPSLLVD        shiftCounts149<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 160(AX), Y1
+	VPSLLVD        shiftCounts241<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VMOVDQU        160(AX), Y0
+	VPSRLVD        shiftCounts288<>+0(SB), Y0, Y0
+	VMOVDQU        176(AX), Y1
+	VPSLLVD        shiftCounts289<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 128(CX)
+	VBROADCASTI128 192(AX), Y0
+	VINSERTI128    $0x01, 224(AX), Y0, Y0
+	VPSRLVD        shiftCounts290<>+0(SB), Y0, Y0
+	VBROADCASTI128 208(AX), Y1
+	VINSERTI128    $0x01, 240(AX), Y1, Y1
+	VPSLLVD        shiftCounts291<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 224(AX), Y1
+	VPSLLVD        shiftCounts30<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 160(CX)
+	VMOVDQU        240(AX), Y0
+	VPSRLVD        shiftCounts292<>+0(SB), Y0, Y0
+	VMOVDQU        256(AX), Y1
+	VPSLLVD        shiftCounts293<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 192(CX)
+	VBROADCASTI128 272(AX), Y0
+	VINSERTI128    $0x01, 304(AX), Y0, Y0
+	VPSRLVD        shiftCounts294<>+0(SB), Y0, Y0
+	VBROADCASTI128 288(AX), Y1
+	VINSERTI128    $0x01, 320(AX), Y1, Y1
+	VPSLLVD        shiftCounts295<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 304(AX), Y1
+	VPSLLVD        shiftCounts27<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 224(CX)
+	VMOVDQU        320(AX), Y0
+	VPSRLVD        shiftCounts296<>+0(SB), Y0, Y0
+	VMOVDQU        336(AX), Y1
+	VPSLLVD        shiftCounts297<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 368(AX), Y1
+	VPSLLVD        shiftCounts43<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 256(CX)
+	VMOVDQU        368(AX), Y0
+	VPSRLVD        shiftCounts138<>+0(SB), Y0, Y0
+	VMOVDQU        384(AX), Y1
+	VPSLLVD        shiftCounts298<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 288(CX)
+	VMOVDQU        400(AX), Y0
+	VPSRLVD        shiftCounts142<>+0(SB), Y0, Y0
+	VMOVDQU        416(AX), Y1
+	VPSLLVD        shiftCounts143<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 448(AX), Y1
+	VPSLLVD        shiftCounts98<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 320(CX)
+	VMOVDQU        448(AX), Y0
+	VPSRLVD        shiftCounts147<>+0(SB), Y0, Y0
+	VMOVDQU        464(AX), Y1
+	VPSLLVD        shiftCounts299<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 352(CX)
+	VBROADCASTI128 480(AX), Y0
+	VPSRLVD        shiftCounts18<>+0(SB), Y0, Y0
+	VBROADCASTI128 496(AX), Y1
+	VPSLLVD        shiftCounts7<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        X0, 384(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts285<>+0(SB)/4, $0x00000000
+DATA shiftCounts285<>+4(SB)/4, $0x00000000
+DATA shiftCounts285<>+8(SB)/4, $0x00000000
+DATA shiftCounts285<>+12(SB)/4, $0x00000000
+DATA shiftCounts285<>+16(SB)/4, $0x00000007
+DATA shiftCounts285<>+20(SB)/4, $0x00000007
+DATA shiftCounts285<>+24(SB)/4, $0x00000007
+DATA shiftCounts285<>+28(SB)/4, $0x00000007
+GLOBL shiftCounts285<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts286<>+0(SB)/4, $0x00000019
+DATA shiftCounts286<>+4(SB)/4, $0x00000019
+DATA shiftCounts286<>+8(SB)/4, $0x00000019
+DATA shiftCounts286<>+12(SB)/4, $0x00000019
+DATA shiftCounts286<>+16(SB)/4, $0x00000012
+DATA shiftCounts286<>+20(SB)/4, $0x00000012
+DATA shiftCounts286<>+24(SB)/4, $0x00000012
+DATA shiftCounts286<>+28(SB)/4, $0x00000012
+GLOBL shiftCounts286<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts287<>+0(SB)/4, $0x00000016
+DATA shiftCounts287<>+4(SB)/4, $0x00000016
+DATA shiftCounts287<>+8(SB)/4, $0x00000016
+DATA shiftCounts287<>+12(SB)/4, $0x00000016
+DATA shiftCounts287<>+16(SB)/4, $0x0000000f
+DATA shiftCounts287<>+20(SB)/4, $0x0000000f
+DATA shiftCounts287<>+24(SB)/4, $0x0000000f
+DATA shiftCounts287<>+28(SB)/4, $0x0000000f
+GLOBL shiftCounts287<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts288<>+0(SB)/4, $0x00000006
+DATA shiftCounts288<>+4(SB)/4, $0x00000006
+DATA shiftCounts288<>+8(SB)/4, $0x00000006
+DATA shiftCounts288<>+12(SB)/4, $0x00000006
+DATA shiftCounts288<>+16(SB)/4, $0x0000000d
+DATA shiftCounts288<>+20(SB)/4, $0x0000000d
+DATA shiftCounts288<>+24(SB)/4, $0x0000000d
+DATA shiftCounts288<>+28(SB)/4, $0x0000000d
+GLOBL shiftCounts288<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts289<>+0(SB)/4, $0x00000013
+DATA shiftCounts289<>+4(SB)/4, $0x00000013
+DATA shiftCounts289<>+8(SB)/4, $0x00000013
+DATA shiftCounts289<>+12(SB)/4, $0x00000013
+DATA shiftCounts289<>+16(SB)/4, $0x0000000c
+DATA shiftCounts289<>+20(SB)/4, $0x0000000c
+DATA shiftCounts289<>+24(SB)/4, $0x0000000c
+DATA shiftCounts289<>+28(SB)/4, $0x0000000c
+GLOBL shiftCounts289<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts290<>+0(SB)/4, $0x00000014
+DATA shiftCounts290<>+4(SB)/4, $0x00000014
+DATA shiftCounts290<>+8(SB)/4, $0x00000014
+DATA shiftCounts290<>+12(SB)/4, $0x00000014
+DATA shiftCounts290<>+16(SB)/4, $0x00000002
+DATA shiftCounts290<>+20(SB)/4, $0x00000002
+DATA shiftCounts290<>+24(SB)/4, $0x00000002
+DATA shiftCounts290<>+28(SB)/4, $0x00000002
+GLOBL shiftCounts290<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts291<>+0(SB)/4, $0x00000005
+DATA shiftCounts291<>+4(SB)/4, $0x00000005
+DATA shiftCounts291<>+8(SB)/4, $0x00000005
+DATA shiftCounts291<>+12(SB)/4, $0x00000005
+DATA shiftCounts291<>+16(SB)/4, $0x00000017
+DATA shiftCounts291<>+20(SB)/4, $0x00000017
+DATA shiftCounts291<>+24(SB)/4, $0x00000017
+DATA shiftCounts291<>+28(SB)/4, $0x00000017
+GLOBL shiftCounts291<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts292<>+0(SB)/4, $0x00000009
+DATA shiftCounts292<>+4(SB)/4, $0x00000009
+DATA shiftCounts292<>+8(SB)/4, $0x00000009
+DATA shiftCounts292<>+12(SB)/4, $0x00000009
+DATA shiftCounts292<>+16(SB)/4, $0x00000010
+DATA shiftCounts292<>+20(SB)/4, $0x00000010
+DATA shiftCounts292<>+24(SB)/4, $0x00000010
+DATA shiftCounts292<>+28(SB)/4, $0x00000010
+GLOBL shiftCounts292<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts293<>+0(SB)/4, $0x00000010
+DATA shiftCounts293<>+4(SB)/4, $0x00000010
+DATA shiftCounts293<>+8(SB)/4, $0x00000010
+DATA shiftCounts293<>+12(SB)/4, $0x00000010
+DATA shiftCounts293<>+16(SB)/4, $0x00000009
+DATA shiftCounts293<>+20(SB)/4, $0x00000009
+DATA shiftCounts293<>+24(SB)/4, $0x00000009
+DATA shiftCounts293<>+28(SB)/4, $0x00000009
+GLOBL shiftCounts293<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts294<>+0(SB)/4, $0x00000017
+DATA shiftCounts294<>+4(SB)/4, $0x00000017
+DATA shiftCounts294<>+8(SB)/4, $0x00000017
+DATA shiftCounts294<>+12(SB)/4, $0x00000017
+DATA shiftCounts294<>+16(SB)/4, $0x00000005
+DATA shiftCounts294<>+20(SB)/4, $0x00000005
+DATA shiftCounts294<>+24(SB)/4, $0x00000005
+DATA shiftCounts294<>+28(SB)/4, $0x00000005
+GLOBL shiftCounts294<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts295<>+0(SB)/4, $0x00000002
+DATA shiftCounts295<>+4(SB)/4, $0x00000002
+DATA shiftCounts295<>+8(SB)/4, $0x00000002
+DATA shiftCounts295<>+12(SB)/4, $0x00000002
+DATA shiftCounts295<>+16(SB)/4, $0x00000014
+DATA shiftCounts295<>+20(SB)/4, $0x00000014
+DATA shiftCounts295<>+24(SB)/4, $0x00000014
+DATA shiftCounts295<>+28(SB)/4, $0x00000014
+GLOBL shiftCounts295<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts296<>+0(SB)/4, $0x0000000c
+DATA shiftCounts296<>+4(SB)/4, $0x0000000c
+DATA shiftCounts296<>+8(SB)/4, $0x0000000c
+DATA shiftCounts296<>+12(SB)/4, $0x0000000c
+DATA shiftCounts296<>+16(SB)/4, $0x00000013
+DATA shiftCounts296<>+20(SB)/4, $0x00000013
+DATA shiftCounts296<>+24(SB)/4, $0x00000013
+DATA shiftCounts296<>+28(SB)/4, $0x00000013
+GLOBL shiftCounts296<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts297<>+0(SB)/4, $0x0000000d
+DATA shiftCounts297<>+4(SB)/4, $0x0000000d
+DATA shiftCounts297<>+8(SB)/4, $0x0000000d
+DATA shiftCounts297<>+12(SB)/4, $0x0000000d
+DATA shiftCounts297<>+16(SB)/4, $0x00000006
+DATA shiftCounts297<>+20(SB)/4, $0x00000006
+DATA shiftCounts297<>+24(SB)/4, $0x00000006
+DATA shiftCounts297<>+28(SB)/4, $0x00000006
+GLOBL shiftCounts297<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts298<>+0(SB)/4, $0x00000018
+DATA shiftCounts298<>+4(SB)/4, $0x00000018
+DATA shiftCounts298<>+8(SB)/4, $0x00000018
+DATA shiftCounts298<>+12(SB)/4, $0x00000018
+DATA shiftCounts298<>+16(SB)/4, $0x00000011
+DATA shiftCounts298<>+20(SB)/4, $0x00000011
+DATA shiftCounts298<>+24(SB)/4, $0x00000011
+DATA shiftCounts298<>+28(SB)/4, $0x00000011
+GLOBL shiftCounts298<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts299<>+0(SB)/4, $0x00000015
+DATA shiftCounts299<>+4(SB)/4, $0x00000015
+DATA shiftCounts299<>+8(SB)/4, $0x00000015
+DATA shiftCounts299<>+12(SB)/4, $0x00000015
+DATA shiftCounts299<>+16(SB)/4, $0x0000000e
+DATA shiftCounts299<>+20(SB)/4, $0x0000000e
+DATA shiftCounts299<>+24(SB)/4, $0x0000000e
+DATA shiftCounts299<>+28(SB)/4, $0x0000000e
+GLOBL shiftCounts299<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_26(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_26(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VMOVDQU        (AX), Y0
+	VPSRLVD        shiftCounts134<>+0(SB), Y0, Y0
+	VMOVDQU        16(AX), Y1
+	VPSLLVD        shiftCounts136<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VMOVDQU        32(AX), Y0
+	VPSRLVD        shiftCounts201<>+0(SB), Y0, Y0
+	VMOVDQU        48(AX), Y1
+	VPSLLVD        shiftCounts202<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VBROADCASTI128 64(AX), Y0
+	VINSERTI128    $0x01, 96(AX), Y0, Y0
+	VPSRLVD        shiftCounts300<>+0(SB), Y0, Y0
+	VBROADCASTI128 80(AX), Y1
+	VINSERTI128    $0x01, 112(AX), Y1, Y1
+	VPSLLVD        shiftCounts301<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 96(AX), Y1
+	VPSLLVD        shiftCounts28<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VMOVDQU        112(AX), Y0
+	VPSRLVD        shiftCounts208<>+0(SB), Y0, Y0
+	VMOVDQU        128(AX), Y1
+	VPSLLVD        shiftCounts209<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VBROADCASTI128 144(AX), Y0
+	VINSERTI128    $0x01, 176(AX), Y0, Y0
+	VPSRLVD        shiftCounts302<>+0(SB), Y0, Y0
+	VBROADCASTI128 160(AX), Y1
+	VINSERTI128    $0x01, 192(AX), Y1, Y1
+	VPSLLVD        shiftCounts303<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 176(AX), Y1
+	VPSLLVD        shiftCounts30<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 128(CX)
+	VMOVDQU        192(AX), Y0
+	VPSRLVD        shiftCounts216<>+0(SB), Y0, Y0
+	VMOVDQU        208(AX), Y1
+	VPSLLVD        shiftCounts217<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 160(CX)
+	VBROADCASTI128 224(AX), Y0
+	VINSERTI128    $0x01, 256(AX), Y0, Y0
+	VPSRLVD        shiftCounts304<>+0(SB), Y0, Y0
+	VBROADCASTI128 240(AX), Y1
+	VINSERTI128    $0x01, 272(AX), Y1, Y1
+	VPSLLVD        shiftCounts305<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 192(CX)
+	VMOVDQU        272(AX), Y0
+	VPSRLVD        shiftCounts306<>+0(SB), Y0, Y0
+	VMOVDQU        288(AX), Y1
+	VPSLLVD        shiftCounts307<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 224(CX)
+	VMOVDQU        304(AX), Y0
+	VPSRLVD        shiftCounts308<>+0(SB), Y0, Y0
+	VMOVDQU        320(AX), Y1
+	VPSLLVD        shiftCounts309<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 352(AX), Y1
+	VPSLLVD        shiftCounts98<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 256(CX)
+	VMOVDQU        352(AX), Y0
+	VPSRLVD        shiftCounts310<>+0(SB), Y0, Y0
+	VMOVDQU        368(AX), Y1
+	VPSLLVD        shiftCounts311<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 288(CX)
+	VMOVDQU        384(AX), Y0
+	VPSRLVD        shiftCounts312<>+0(SB), Y0, Y0
+	VMOVDQU        400(AX), Y1
+	VPSLLVD        shiftCounts313<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 432(AX), Y1
+	VPSLLVD        shiftCounts76<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 320(CX)
+	VMOVDQU        432(AX), Y0
+	VPSRLVD        shiftCounts314<>+0(SB), Y0, Y0
+	VMOVDQU        448(AX), Y1
+	VPSLLVD        shiftCounts315<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 352(CX)
+	VMOVDQU        464(AX), Y0
+	VPSRLVD        shiftCounts316<>+0(SB), Y0, Y0
+	VMOVDQU        480(AX), Y1
+	VPSLLVD        shiftCounts317<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 384(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts300<>+0(SB)/4, $0x00000018
+DATA shiftCounts300<>+4(SB)/4, $0x00000018
+DATA shiftCounts300<>+8(SB)/4, $0x00000018
+DATA shiftCounts300<>+12(SB)/4, $0x00000018
+DATA shiftCounts300<>+16(SB)/4, $0x00000004
+DATA shiftCounts300<>+20(SB)/4, $0x00000004
+DATA shiftCounts300<>+24(SB)/4, $0x00000004
+DATA shiftCounts300<>+28(SB)/4, $0x00000004
+GLOBL shiftCounts300<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts301<>+0(SB)/4, $0x00000002
+DATA shiftCounts301<>+4(SB)/4, $0x00000002
+DATA shiftCounts301<>+8(SB)/4, $0x00000002
+DATA shiftCounts301<>+12(SB)/4, $0x00000002
+DATA shiftCounts301<>+16(SB)/4, $0x00000016
+DATA shiftCounts301<>+20(SB)/4, $0x00000016
+DATA shiftCounts301<>+24(SB)/4, $0x00000016
+DATA shiftCounts301<>+28(SB)/4, $0x00000016
+GLOBL shiftCounts301<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts302<>+0(SB)/4, $0x00000016
+DATA shiftCounts302<>+4(SB)/4, $0x00000016
+DATA shiftCounts302<>+8(SB)/4, $0x00000016
+DATA shiftCounts302<>+12(SB)/4, $0x00000016
+DATA shiftCounts302<>+16(SB)/4, $0x00000002
+DATA shiftCounts302<>+20(SB)/4, $0x00000002
+DATA shiftCounts302<>+24(SB)/4, $0x00000002
+DATA shiftCounts302<>+28(SB)/4, $0x00000002
+GLOBL shiftCounts302<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts303<>+0(SB)/4, $0x00000004
+DATA shiftCounts303<>+4(SB)/4, $0x00000004
+DATA shiftCounts303<>+8(SB)/4, $0x00000004
+DATA shiftCounts303<>+12(SB)/4, $0x00000004
+DATA shiftCounts303<>+16(SB)/4, $0x00000018
+DATA shiftCounts303<>+20(SB)/4, $0x00000018
+DATA shiftCounts303<>+24(SB)/4, $0x00000018
+DATA shiftCounts303<>+28(SB)/4, $0x00000018
+GLOBL shiftCounts303<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts304<>+0(SB)/4, $0x00000014
+DATA shiftCounts304<>+4(SB)/4, $0x00000014
+DATA shiftCounts304<>+8(SB)/4, $0x00000014
+DATA shiftCounts304<>+12(SB)/4, $0x00000014
+DATA shiftCounts304<>+16(SB)/4, $0x00000000
+DATA shiftCounts304<>+20(SB)/4, $0x00000000
+DATA shiftCounts304<>+24(SB)/4, $0x00000000
+DATA shiftCounts304<>+28(SB)/4, $0x00000000
+GLOBL shiftCounts304<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts305<>+0(SB)/4, $0x00000006
+DATA shiftCounts305<>+4(SB)/4, $0x00000006
+DATA shiftCounts305<>+8(SB)/4, $0x00000006
+DATA shiftCounts305<>+12(SB)/4, $0x00000006
+DATA shiftCounts305<>+16(SB)/4, $0x0000001a
+DATA shiftCounts305<>+20(SB)/4, $0x0000001a
+DATA shiftCounts305<>+24(SB)/4, $0x0000001a
+DATA shiftCounts305<>+28(SB)/4, $0x0000001a
+GLOBL shiftCounts305<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts306<>+0(SB)/4, $0x00000006
+DATA shiftCounts306<>+4(SB)/4, $0x00000006
+DATA shiftCounts306<>+8(SB)/4, $0x00000006
+DATA shiftCounts306<>+12(SB)/4, $0x00000006
+DATA shiftCounts306<>+16(SB)/4, $0x0000000c
+DATA shiftCounts306<>+20(SB)/4, $0x0000000c
+DATA shiftCounts306<>+24(SB)/4, $0x0000000c
+DATA shiftCounts306<>+28(SB)/4, $0x0000000c
+GLOBL shiftCounts306<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts307<>+0(SB)/4, $0x00000014
+DATA shiftCounts307<>+4(SB)/4, $0x00000014
+DATA shiftCounts307<>+8(SB)/4, $0x00000014
+DATA shiftCounts307<>+12(SB)/4, $0x00000014
+DATA shiftCounts307<>+16(SB)/4, $0x0000000e
+DATA shiftCounts307<>+20(SB)/4, $0x0000000e
+DATA shiftCounts307<>+24(SB)/4, $0x0000000e
+DATA shiftCounts307<>+28(SB)/4, $0x0000000e
+GLOBL shiftCounts307<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts308<>+0(SB)/4, $0x00000012
+DATA shiftCounts308<>+4(SB)/4, $0x00000012
+DATA shiftCounts308<>+8(SB)/4, $0x00000012
+DATA shiftCounts308<>+12(SB)/4, $0x00000012
+DATA shiftCounts308<>+16(SB)/4, $0x00000018
+DATA shiftCounts308<>+20(SB)/4, $0x00000018
+DATA shiftCounts308<>+24(SB)/4, $0x00000018
+DATA shiftCounts308<>+28(SB)/4, $0x00000018
+GLOBL shiftCounts308<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts309<>+0(SB)/4, $0x00000008
+DATA shiftCounts309<>+4(SB)/4, $0x00000008
+DATA shiftCounts309<>+8(SB)/4, $0x00000008
+DATA shiftCounts309<>+12(SB)/4, $0x00000008
+DATA shiftCounts309<>+16(SB)/4, $0x00000002
+DATA shiftCounts309<>+20(SB)/4, $0x00000002
+DATA shiftCounts309<>+24(SB)/4, $0x00000002
+DATA shiftCounts309<>+28(SB)/4, $0x00000002
+GLOBL shiftCounts309<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts310<>+0(SB)/4, $0x00000004
+DATA shiftCounts310<>+4(SB)/4, $0x00000004
+DATA shiftCounts310<>+8(SB)/4, $0x00000004
+DATA shiftCounts310<>+12(SB)/4, $0x00000004
+DATA shiftCounts310<>+16(SB)/4, $0x0000000a
+DATA shiftCounts310<>+20(SB)/4, $0x0000000a
+DATA shiftCounts310<>+24(SB)/4, $0x0000000a
+DATA shiftCounts310<>+28(SB)/4, $0x0000000a
+GLOBL shiftCounts310<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts311<>+0(SB)/4, $0x00000016
+DATA shiftCounts311<>+4(SB)/4, $0x00000016
+DATA shiftCounts311<>+8(SB)/4, $0x00000016
+DATA shiftCounts311<>+12(SB)/4, $0x00000016
+DATA shiftCounts311<>+16(SB)/4, $0x00000010
+DATA shiftCounts311<>+20(SB)/4, $0x00000010
+DATA shiftCounts311<>+24(SB)/4, $0x00000010
+DATA shiftCounts311<>+28(SB)/4, $0x00000010
+GLOBL shiftCounts311<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts312<>+0(SB)/4, $0x00000010
+DATA shiftCounts312<>+4(SB)/4, $0x00000010
+DATA shiftCounts312<>+8(SB)/4, $0x00000010
+DATA shiftCounts312<>+12(SB)/4, $0x00000010
+DATA shiftCounts312<>+16(SB)/4, $0x00000016
+DATA shiftCounts312<>+20(SB)/4, $0x00000016
+DATA shiftCounts312<>+24(SB)/4, $0x00000016
+DATA shiftCounts312<>+28(SB)/4, $0x00000016
+GLOBL shiftCounts312<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts313<>+0(SB)/4, $0x0000000a
+DATA shiftCounts313<>+4(SB)/4, $0x0000000a
+DATA shiftCounts313<>+8(SB)/4, $0x0000000a
+DATA shiftCounts313<>+12(SB)/4, $0x0000000a
+DATA shiftCounts313<>+16(SB)/4, $0x00000004
+DATA shiftCounts313<>+20(SB)/4, $0x00000004
+DATA shiftCounts313<>+24(SB)/4, $0x00000004
+DATA shiftCounts313<>+28(SB)/4, $0x00000004
+GLOBL shiftCounts313<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts314<>+0(SB)/4, $0x00000002
+DATA shiftCounts314<>+4(SB)/4, $0x00000002
+DATA shiftCounts314<>+8(SB)/4, $0x00000002
+DATA shiftCounts314<>+12(SB)/4, $0x00000002
+DATA shiftCounts314<>+16(SB)/4, $0x00000008
+DATA shiftCounts314<>+20(SB)/4, $0x00000008
+DATA shiftCounts314<>+24(SB)/4, $0x00000008
+DATA shiftCounts314<>+28(SB)/4, $0x00000008
+GLOBL shiftCounts314<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts315<>+0(SB)/4, $0x00000018
+DATA shiftCounts315<>+4(SB)/4, $0x00000018
+DATA shiftCounts315<>+8(SB)/4, $0x00000018
+DATA shiftCounts315<>+12(SB)/4, $0x00000018
+DATA shiftCounts315<>+16(SB)/4, $0x00000012
+DATA shiftCounts315<>+20(SB)/4, $0x00000012
+DATA shiftCounts315<>+24(SB)/4, $0x00000012
+DATA shiftCounts315<>+28(SB)/4, $0x00000012
+GLOBL shiftCounts315<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts316<>+0(SB)/4, $0x0000000e
+DATA shiftCounts316<>+4(SB)/4, $0x0000000e
+DATA shiftCounts316<>+8(SB)/4, $0x0000000e
+DATA shiftCounts316<>+12(SB)/4, $0x0000000e
+DATA shiftCounts316<>+16(SB)/4, $0x00000014
+DATA shiftCounts316<>+20(SB)/4, $0x00000014
+DATA shiftCounts316<>+24(SB)/4, $0x00000014
+DATA shiftCounts316<>+28(SB)/4, $0x00000014
+GLOBL shiftCounts316<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts317<>+0(SB)/4, $0x0000000c
+DATA shiftCounts317<>+4(SB)/4, $0x0000000c
+DATA shiftCounts317<>+8(SB)/4, $0x0000000c
+DATA shiftCounts317<>+12(SB)/4, $0x0000000c
+DATA shiftCounts317<>+16(SB)/4, $0x00000006
+DATA shiftCounts317<>+20(SB)/4, $0x00000006
+DATA shiftCounts317<>+24(SB)/4, $0x00000006
+DATA shiftCounts317<>+28(SB)/4, $0x00000006
+GLOBL shiftCounts317<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_27(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_27(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VMOVDQU        (AX), Y0
+	VPSRLVD        shiftCounts82<>+0(SB), Y0, Y0
+	VMOVDQU        16(AX), Y1
+	VPSLLVD        shiftCounts85<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VMOVDQU        32(AX), Y0
+	VPSRLVD        shiftCounts318<>+0(SB), Y0, Y0
+	VMOVDQU        48(AX), Y1
+	VPSLLVD        shiftCounts88<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VMOVDQU        64(AX), Y0
+	VPSRLVD        shiftCounts319<>+0(SB), Y0, Y0
+	VMOVDQU        80(AX), Y1
+	VPSLLVD        shiftCounts91<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 112(AX), Y1
+	VPSLLVD        shiftCounts81<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VMOVDQU        112(AX), Y0
+	VPSRLVD        shiftCounts94<>+0(SB), Y0, Y0
+	VMOVDQU        128(AX), Y1
+	VPSLLVD        shiftCounts97<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VMOVDQU        144(AX), Y0
+	VPSRLVD        shiftCounts320<>+0(SB), Y0, Y0
+	VMOVDQU        160(AX), Y1
+	VPSLLVD        shiftCounts321<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 128(CX)
+	VBROADCASTI128 176(AX), Y0
+	VINSERTI128    $0x01, 208(AX), Y0, Y0
+	VPSRLVD        shiftCounts322<>+0(SB), Y0, Y0
+	VBROADCASTI128 192(AX), Y1
+	VINSERTI128    $0x01, 224(AX), Y1, Y1
+	VPSLLVD        shiftCounts323<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 208(AX), Y1
+	VPSLLVD        shiftCounts31<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 160(CX)
+	VMOVDQU        224(AX), Y0
+	VPSRLVD        shiftCounts324<>+0(SB), Y0, Y0
+	VMOVDQU        240(AX), Y1
+	VPSLLVD        shiftCounts325<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 192(CX)
+	VMOVDQU        256(AX), Y0
+	VPSRLVD        shiftCounts326<>+0(SB), Y0, Y0
+	VMOVDQU        272(AX), Y1
+	VPSLLVD        shiftCounts327<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 224(CX)
+	VBROADCASTI128 288(AX), Y0
+	VINSERTI128    $0x01, 320(AX), Y0, Y0
+	VPSRLVD        shiftCounts328<>+0(SB), Y0, Y0
+	VBROADCASTI128 304(AX), Y1
+	VINSERTI128    $0x01, 336(AX), Y1, Y1
+	VPSLLVD        shiftCounts329<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 320(AX), Y1
+	VPSLLVD        shiftCounts28<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 256(CX)
+	VMOVDQU        336(AX), Y0
+	VPSRLVD        shiftCounts330<>+0(SB), Y0, Y0
+	VMOVDQU        352(AX), Y1
+	VPSLLVD        shiftCounts84<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 288(CX)
+	VMOVDQU        368(AX), Y0
+	VPSRLVD        shiftCounts331<>+0(SB), Y0, Y0
+	VMOVDQU        384(AX), Y1
+	VPSLLVD        shiftCounts87<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 416(AX), Y1
+	VPSLLVD        shiftCounts76<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 320(CX)
+	VMOVDQU        416(AX), Y0
+	VPSRLVD        shiftCounts90<>+0(SB), Y0, Y0
+	VMOVDQU        432(AX), Y1
+	VPSLLVD        shiftCounts93<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 352(CX)
+	VMOVDQU        448(AX), Y0
+	VPSRLVD        shiftCounts332<>+0(SB), Y0, Y0
+	VMOVDQU        464(AX), Y1
+	VPSLLVD        shiftCounts96<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 384(CX)
+	VBROADCASTI128 480(AX), Y0
+	VPSRLVD        shiftCounts22<>+0(SB), Y0, Y0
+	VBROADCASTI128 496(AX), Y1
+	VPSLLVD        shiftCounts5<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        X0, 416(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts318<>+0(SB)/4, $0x0000000a
+DATA shiftCounts318<>+4(SB)/4, $0x0000000a
+DATA shiftCounts318<>+8(SB)/4, $0x0000000a
+DATA shiftCounts318<>+12(SB)/4, $0x0000000a
+DATA shiftCounts318<>+16(SB)/4, $0x0000000f
+DATA shiftCounts318<>+20(SB)/4, $0x0000000f
+DATA shiftCounts318<>+24(SB)/4, $0x0000000f
+DATA shiftCounts318<>+28(SB)/4, $0x0000000f
+GLOBL shiftCounts318<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts319<>+0(SB)/4, $0x00000014
+DATA shiftCounts319<>+4(SB)/4, $0x00000014
+DATA shiftCounts319<>+8(SB)/4, $0x00000014
+DATA shiftCounts319<>+12(SB)/4, $0x00000014
+DATA shiftCounts319<>+16(SB)/4, $0x00000019
+DATA shiftCounts319<>+20(SB)/4, $0x00000019
+DATA shiftCounts319<>+24(SB)/4, $0x00000019
+DATA shiftCounts319<>+28(SB)/4, $0x00000019
+GLOBL shiftCounts319<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts320<>+0(SB)/4, $0x0000000d
+DATA shiftCounts320<>+4(SB)/4, $0x0000000d
+DATA shiftCounts320<>+8(SB)/4, $0x0000000d
+DATA shiftCounts320<>+12(SB)/4, $0x0000000d
+DATA shiftCounts320<>+16(SB)/4, $0x00000012
+DATA shiftCounts320<>+20(SB)/4, $0x00000012
+DATA shiftCounts320<>+24(SB)/4, $0x00000012
+DATA shiftCounts320<>+28(SB)/4, $0x00000012
+GLOBL shiftCounts320<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts321<>+0(SB)/4, $0x0000000e
+DATA shiftCounts321<>+4(SB)/4, $0x0000000e
+DATA shiftCounts321<>+8(SB)/4, $0x0000000e
+DATA shiftCounts321<>+12(SB)/4, $0x0000000e
+DATA shiftCounts321<>+16(SB)/4, $0x00000009
+DATA shiftCounts321<>+20(SB)/4, $0x00000009
+DATA shiftCounts321<>+24(SB)/4, $0x00000009
+DATA shiftCounts321<>+28(SB)/4, $0x00000009
+GLOBL shiftCounts321<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts322<>+0(SB)/4, $0x00000017
+DATA shiftCounts322<>+4(SB)/4, $0x00000017
+DATA shiftCounts322<>+8(SB)/4, $0x00000017
+DATA shiftCounts322<>+12(SB)/4, $0x00000017
+DATA shiftCounts322<>+16(SB)/4, $0x00000001
+DATA shiftCounts322<>+20(SB)/4, $0x00000001
+DATA shiftCounts322<>+24(SB)/4, $0x00000001
+DATA shiftCounts322<>+28(SB)/4, $0x00000001
+GLOBL shiftCounts322<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts323<>+0(SB)/4, $0x00000004
+DATA shiftCounts323<>+4(SB)/4, $0x00000004
+DATA shiftCounts323<>+8(SB)/4, $0x00000004
+DATA shiftCounts323<>+12(SB)/4, $0x00000004
+DATA shiftCounts323<>+16(SB)/4, $0x0000001a
+DATA shiftCounts323<>+20(SB)/4, $0x0000001a
+DATA shiftCounts323<>+24(SB)/4, $0x0000001a
+DATA shiftCounts323<>+28(SB)/4, $0x0000001a
+GLOBL shiftCounts323<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts324<>+0(SB)/4, $0x00000006
+DATA shiftCounts324<>+4(SB)/4, $0x00000006
+DATA shiftCounts324<>+8(SB)/4, $0x00000006
+DATA shiftCounts324<>+12(SB)/4, $0x00000006
+DATA shiftCounts324<>+16(SB)/4, $0x0000000b
+DATA shiftCounts324<>+20(SB)/4, $0x0000000b
+DATA shiftCounts324<>+24(SB)/4, $0x0000000b
+DATA shiftCounts324<>+28(SB)/4, $0x0000000b
+GLOBL shiftCounts324<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts325<>+0(SB)/4, $0x00000015
+DATA shiftCounts325<>+4(SB)/4, $0x00000015
+DATA shiftCounts325<>+8(SB)/4, $0x00000015
+DATA shiftCounts325<>+12(SB)/4, $0x00000015
+DATA shiftCounts325<>+16(SB)/4, $0x00000010
+DATA shiftCounts325<>+20(SB)/4, $0x00000010
+DATA shiftCounts325<>+24(SB)/4, $0x00000010
+DATA shiftCounts325<>+28(SB)/4, $0x00000010
+GLOBL shiftCounts325<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts326<>+0(SB)/4, $0x00000010
+DATA shiftCounts326<>+4(SB)/4, $0x00000010
+DATA shiftCounts326<>+8(SB)/4, $0x00000010
+DATA shiftCounts326<>+12(SB)/4, $0x00000010
+DATA shiftCounts326<>+16(SB)/4, $0x00000015
+DATA shiftCounts326<>+20(SB)/4, $0x00000015
+DATA shiftCounts326<>+24(SB)/4, $0x00000015
+DATA shiftCounts326<>+28(SB)/4, $0x00000015
+GLOBL shiftCounts326<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts327<>+0(SB)/4, $0x0000000b
+DATA shiftCounts327<>+4(SB)/4, $0x0000000b
+DATA shiftCounts327<>+8(SB)/4, $0x0000000b
+DATA shiftCounts327<>+12(SB)/4, $0x0000000b
+DATA shiftCounts327<>+16(SB)/4, $0x00000006
+DATA shiftCounts327<>+20(SB)/4, $0x00000006
+DATA shiftCounts327<>+24(SB)/4, $0x00000006
+DATA shiftCounts327<>+28(SB)/4, $0x00000006
+GLOBL shiftCounts327<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts328<>+0(SB)/4, $0x0000001a
+DATA shiftCounts328<>+4(SB)/4, $0x0000001a
+DATA shiftCounts328<>+8(SB)/4, $0x0000001a
+DATA shiftCounts328<>+12(SB)/4, $0x0000001a
+DATA shiftCounts328<>+16(SB)/4, $0x00000004
+DATA shiftCounts328<>+20(SB)/4, $0x00000004
+DATA shiftCounts328<>+24(SB)/4, $0x00000004
+DATA shiftCounts328<>+28(SB)/4, $0x00000004
+GLOBL shiftCounts328<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts329<>+0(SB)/4, $0x00000001
+DATA shiftCounts329<>+4(SB)/4, $0x00000001
+DATA shiftCounts329<>+8(SB)/4, $0x00000001
+DATA shiftCounts329<>+12(SB)/4, $0x00000001
+DATA shiftCounts329<>+16(SB)/4, $0x00000017
+DATA shiftCounts329<>+20(SB)/4, $0x00000017
+DATA shiftCounts329<>+24(SB)/4, $0x00000017
+DATA shiftCounts329<>+28(SB)/4, $0x00000017
+GLOBL shiftCounts329<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts330<>+0(SB)/4, $0x00000009
+DATA shiftCounts330<>+4(SB)/4, $0x00000009
+DATA shiftCounts330<>+8(SB)/4, $0x00000009
+DATA shiftCounts330<>+12(SB)/4, $0x00000009
+DATA shiftCounts330<>+16(SB)/4, $0x0000000e
+DATA shiftCounts330<>+20(SB)/4, $0x0000000e
+DATA shiftCounts330<>+24(SB)/4, $0x0000000e
+DATA shiftCounts330<>+28(SB)/4, $0x0000000e
+GLOBL shiftCounts330<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts331<>+0(SB)/4, $0x00000013
+DATA shiftCounts331<>+4(SB)/4, $0x00000013
+DATA shiftCounts331<>+8(SB)/4, $0x00000013
+DATA shiftCounts331<>+12(SB)/4, $0x00000013
+DATA shiftCounts331<>+16(SB)/4, $0x00000018
+DATA shiftCounts331<>+20(SB)/4, $0x00000018
+DATA shiftCounts331<>+24(SB)/4, $0x00000018
+DATA shiftCounts331<>+28(SB)/4, $0x00000018
+GLOBL shiftCounts331<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts332<>+0(SB)/4, $0x0000000c
+DATA shiftCounts332<>+4(SB)/4, $0x0000000c
+DATA shiftCounts332<>+8(SB)/4, $0x0000000c
+DATA shiftCounts332<>+12(SB)/4, $0x0000000c
+DATA shiftCounts332<>+16(SB)/4, $0x00000011
+DATA shiftCounts332<>+20(SB)/4, $0x00000011
+DATA shiftCounts332<>+24(SB)/4, $0x00000011
+DATA shiftCounts332<>+28(SB)/4, $0x00000011
+GLOBL shiftCounts332<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_28(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_28(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VMOVDQU        (AX), Y0
+	VPSRLVD        shiftCounts66<>+0(SB), Y0, Y0
+	VMOVDQU        16(AX), Y1
+	VPSLLVD        shiftCounts70<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VMOVDQU        32(AX), Y0
+	VPSRLVD        shiftCounts57<>+0(SB), Y0, Y0
+	VMOVDQU        48(AX), Y1
+	VPSLLVD        shiftCounts74<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VMOVDQU        64(AX), Y0
+	VPSRLVD        shiftCounts133<>+0(SB), Y0, Y0
+	VMOVDQU        80(AX), Y1
+	VPSLLVD        shiftCounts78<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VBROADCASTI128 96(AX), Y0
+	VINSERTI128    $0x01, 128(AX), Y0, Y0
+	VPSRLVD        shiftCounts333<>+0(SB), Y0, Y0
+	VBROADCASTI128 112(AX), Y1
+	VINSERTI128    $0x01, 144(AX), Y1, Y1
+	VPSLLVD        shiftCounts334<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VMOVDQU        144(AX), Y0
+	VPSRLVD        shiftCounts132<>+0(SB), Y0, Y0
+	VMOVDQU        160(AX), Y1
+	VPSLLVD        shiftCounts156<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 128(CX)
+	VMOVDQU        176(AX), Y0
+	VPSRLVD        shiftCounts194<>+0(SB), Y0, Y0
+	VMOVDQU        192(AX), Y1
+	VPSLLVD        shiftCounts195<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 160(CX)
+	VMOVDQU        208(AX), Y0
+	VPSRLVD        shiftCounts59<>+0(SB), Y0, Y0
+	VMOVDQU        224(AX), Y1
+	VPSLLVD        shiftCounts131<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 192(CX)
+	VMOVDQU        256(AX), Y0
+	VPSRLVD        shiftCounts66<>+0(SB), Y0, Y0
+	VMOVDQU        272(AX), Y1
+	VPSLLVD        shiftCounts70<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 224(CX)
+	VMOVDQU        288(AX), Y0
+	VPSRLVD        shiftCounts57<>+0(SB), Y0, Y0
+	VMOVDQU        304(AX), Y1
+	VPSLLVD        shiftCounts74<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 256(CX)
+	VMOVDQU        320(AX), Y0
+	VPSRLVD        shiftCounts133<>+0(SB), Y0, Y0
+	VMOVDQU        336(AX), Y1
+	VPSLLVD        shiftCounts78<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 288(CX)
+	VBROADCASTI128 352(AX), Y0
+	VINSERTI128    $0x01, 384(AX), Y0, Y0
+	VPSRLVD        shiftCounts333<>+0(SB), Y0, Y0
+	VBROADCASTI128 368(AX), Y1
+	VINSERTI128    $0x01, 400(AX), Y1, Y1
+	VPSLLVD        shiftCounts334<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 320(CX)
+	VMOVDQU        400(AX), Y0
+	VPSRLVD        shiftCounts132<>+0(SB), Y0, Y0
+	VMOVDQU        416(AX), Y1
+	VPSLLVD        shiftCounts156<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 352(CX)
+	VMOVDQU        432(AX), Y0
+	VPSRLVD        shiftCounts194<>+0(SB), Y0, Y0
+	VMOVDQU        448(AX), Y1
+	VPSLLVD        shiftCounts195<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 384(CX)
+	VMOVDQU        464(AX), Y0
+	VPSRLVD        shiftCounts59<>+0(SB), Y0, Y0
+	VMOVDQU        480(AX), Y1
+	VPSLLVD        shiftCounts131<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 416(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts333<>+0(SB)/4, $0x00000018
+DATA shiftCounts333<>+4(SB)/4, $0x00000018
+DATA shiftCounts333<>+8(SB)/4, $0x00000018
+DATA shiftCounts333<>+12(SB)/4, $0x00000018
+DATA shiftCounts333<>+16(SB)/4, $0x00000000
+DATA shiftCounts333<>+20(SB)/4, $0x00000000
+DATA shiftCounts333<>+24(SB)/4, $0x00000000
+DATA shiftCounts333<>+28(SB)/4, $0x00000000
+GLOBL shiftCounts333<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts334<>+0(SB)/4, $0x00000004
+DATA shiftCounts334<>+4(SB)/4, $0x00000004
+DATA shiftCounts334<>+8(SB)/4, $0x00000004
+DATA shiftCounts334<>+12(SB)/4, $0x00000004
+DATA shiftCounts334<>+16(SB)/4, $0x0000001c
+DATA shiftCounts334<>+20(SB)/4, $0x0000001c
+DATA shiftCounts334<>+24(SB)/4, $0x0000001c
+DATA shiftCounts334<>+28(SB)/4, $0x0000001c
+GLOBL shiftCounts334<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_29(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_29(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VMOVDQU        (AX), Y0
+	VPSRLVD        shiftCounts335<>+0(SB), Y0, Y0
+	VMOVDQU        16(AX), Y1
+	VPSLLVD        shiftCounts336<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VMOVDQU        32(AX), Y0
+	VPSRLVD        shiftCounts50<>+0(SB), Y0, Y0
+	VMOVDQU        48(AX), Y1
+	VPSLLVD        shiftCounts337<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VMOVDQU        64(AX), Y0
+	VPSRLVD        shiftCounts338<>+0(SB), Y0, Y0
+	VMOVDQU        80(AX), Y1
+	VPSLLVD        shiftCounts339<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VMOVDQU        96(AX), Y0
+	VPSRLVD        shiftCounts340<>+0(SB), Y0, Y0
+	VMOVDQU        112(AX), Y1
+	VPSLLVD        shiftCounts341<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VMOVDQU        128(AX), Y0
+	VPSRLVD        shiftCounts342<>+0(SB), Y0, Y0
+	VMOVDQU        144(AX), Y1
+	VPSLLVD        shiftCounts343<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 176(AX), Y1
+	VPSLLVD        shiftCounts43<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 128(CX)
+	VMOVDQU        176(AX), Y0
+	VPSRLVD        shiftCounts49<>+0(SB), Y0, Y0
+	VMOVDQU        192(AX), Y1
+	VPSLLVD        shiftCounts344<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 160(CX)
+	VMOVDQU        208(AX), Y0
+	VPSRLVD        shiftCounts345<>+0(SB), Y0, Y0
+	VMOVDQU        224(AX), Y1
+	VPSLLVD        shiftCounts346<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 192(CX)
+	VMOVDQU        240(AX), Y0
+	VPSRLVD        shiftCounts347<>+0(SB), Y0, Y0
+	VMOVDQU        256(AX), Y1
+	VPSLLVD        shiftCounts348<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 224(CX)
+	VMOVDQU        272(AX), Y0
+	VPSRLVD        shiftCounts349<>+0(SB), Y0, Y0
+	VMOVDQU        288(AX), Y1
+	VPSLLVD        shiftCounts350<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 256(CX)
+	VMOVDQU        304(AX), Y0
+	VPSRLVD        shiftCounts351<>+0(SB), Y0, Y0
+	VMOVDQU        320(AX), Y1
+	VPSLLVD        shiftCounts48<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VBROADCASTI128 352(AX), Y1
+	VPSLLVD        shiftCounts76<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 288(CX)
+	VMOVDQU        352(AX), Y0
+	VPSRLVD        shiftCounts352<>+0(SB), Y0, Y0
+	VMOVDQU        368(AX), Y1
+	VPSLLVD        shiftCounts353<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 320(CX)
+	VMOVDQU        384(AX), Y0
+	VPSRLVD        shiftCounts354<>+0(SB), Y0, Y0
+	VMOVDQU        400(AX), Y1
+	VPSLLVD        shiftCounts355<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 352(CX)
+	VMOVDQU        416(AX), Y0
+	VPSRLVD        shiftCounts356<>+0(SB), Y0, Y0
+	VMOVDQU        432(AX), Y1
+	VPSLLVD        shiftCounts357<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 384(CX)
+	VMOVDQU        448(AX), Y0
+	VPSRLVD        shiftCounts358<>+0(SB), Y0, Y0
+	VMOVDQU        464(AX), Y1
+	VPSLLVD        shiftCounts359<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 416(CX)
+	VBROADCASTI128 480(AX), Y0
+	VPSRLVD        shiftCounts26<>+0(SB), Y0, Y0
+	VBROADCASTI128 496(AX), Y1
+	VPSLLVD        shiftCounts3<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        X0, 448(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts335<>+0(SB)/4, $0x00000000
+DATA shiftCounts335<>+4(SB)/4, $0x00000000
+DATA shiftCounts335<>+8(SB)/4, $0x00000000
+DATA shiftCounts335<>+12(SB)/4, $0x00000000
+DATA shiftCounts335<>+16(SB)/4, $0x00000003
+DATA shiftCounts335<>+20(SB)/4, $0x00000003
+DATA shiftCounts335<>+24(SB)/4, $0x00000003
+DATA shiftCounts335<>+28(SB)/4, $0x00000003
+GLOBL shiftCounts335<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts336<>+0(SB)/4, $0x0000001d
+DATA shiftCounts336<>+4(SB)/4, $0x0000001d
+DATA shiftCounts336<>+8(SB)/4, $0x0000001d
+DATA shiftCounts336<>+12(SB)/4, $0x0000001d
+DATA shiftCounts336<>+16(SB)/4, $0x0000001a
+DATA shiftCounts336<>+20(SB)/4, $0x0000001a
+DATA shiftCounts336<>+24(SB)/4, $0x0000001a
+DATA shiftCounts336<>+28(SB)/4, $0x0000001a
+GLOBL shiftCounts336<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts337<>+0(SB)/4, $0x00000017
+DATA shiftCounts337<>+4(SB)/4, $0x00000017
+DATA shiftCounts337<>+8(SB)/4, $0x00000017
+DATA shiftCounts337<>+12(SB)/4, $0x00000017
+DATA shiftCounts337<>+16(SB)/4, $0x00000014
+DATA shiftCounts337<>+20(SB)/4, $0x00000014
+DATA shiftCounts337<>+24(SB)/4, $0x00000014
+DATA shiftCounts337<>+28(SB)/4, $0x00000014
+GLOBL shiftCounts337<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts338<>+0(SB)/4, $0x0000000c
+DATA shiftCounts338<>+4(SB)/4, $0x0000000c
+DATA shiftCounts338<>+8(SB)/4, $0x0000000c
+DATA shiftCounts338<>+12(SB)/4, $0x0000000c
+DATA shiftCounts338<>+16(SB)/4, $0x0000000f
+DATA shiftCounts338<>+20(SB)/4, $0x0000000f
+DATA shiftCounts338<>+24(SB)/4, $0x0000000f
+DATA shiftCounts338<>+28(SB)/4, $0x0000000f
+GLOBL shiftCounts338<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts339<>+0(SB)/4, $0x00000011
+DATA shiftCounts339<>+4(SB)/4, $0x00000011
+DATA shiftCounts339<>+8(SB)/4, $0x00000011
+DATA shiftCounts339<>+12(SB)/4, $0x00000011
+DATA shiftCounts339<>+16(SB)/4, $0x0000000e
+DATA shiftCounts339<>+20(SB)/4, $0x0000000e
+DATA shiftCounts339<>+24(SB)/4, $0x0000000e
+DATA shiftCounts339<>+28(SB)/4, $0x0000000e
+GLOBL shiftCounts339<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts340<>+0(SB)/4, $0x00000012
+DATA shiftCounts340<>+4(SB)/4, $0x00000012
+DATA shiftCounts340<>+8(SB)/4, $0x00000012
+DATA shiftCounts340<>+12(SB)/4, $0x00000012
+DATA shiftCounts340<>+16(SB)/4, $0x00000015
+DATA shiftCounts340<>+20(SB)/4, $0x00000015
+DATA shiftCounts340<>+24(SB)/4, $0x00000015
+DATA shiftCounts340<>+28(SB)/4, $0x00000015
+GLOBL shiftCounts340<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts341<>+0(SB)/4, $0x0000000b
+DATA shiftCounts341<>+4(SB)/4, $0x0000000b
+DATA shiftCounts341<>+8(SB)/4, $0x0000000b
+DATA shiftCounts341<>+12(SB)/4, $0x0000000b
+DATA shiftCounts341<>+16(SB)/4, $0x00000008
+DATA shiftCounts341<>+20(SB)/4, $0x00000008
+DATA shiftCounts341<>+24(SB)/4, $0x00000008
+DATA shiftCounts341<>+28(SB)/4, $0x00000008
+GLOBL shiftCounts341<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts342<>+0(SB)/4, $0x00000018
+DATA shiftCounts342<>+4(SB)/4, $0x00000018
+DATA shiftCounts342<>+8(SB)/4, $0x00000018
+DATA shiftCounts342<>+12(SB)/4, $0x00000018
+DATA shiftCounts342<>+16(SB)/4, $0x0000001b
+DATA shiftCounts342<>+20(SB)/4, $0x0000001b
+DATA shiftCounts342<>+24(SB)/4, $0x0000001b
+DATA shiftCounts342<>+28(SB)/4, $0x0000001b
+GLOBL shiftCounts342<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts343<>+0(SB)/4, $0x00000005
+DATA shiftCounts343<>+4(SB)/4, $0x00000005
+DATA shiftCounts343<>+8(SB)/4, $0x00000005
+DATA shiftCounts343<>+12(SB)/4, $0x00000005
+DATA shiftCounts343<>+16(SB)/4, $0x00000002
+DATA shiftCounts343<>+20(SB)/4, $0x00000002
+DATA shiftCounts343<>+24(SB)/4, $0x00000002
+DATA shiftCounts343<>+28(SB)/4, $0x00000002
+GLOBL shiftCounts343<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts344<>+0(SB)/4, $0x0000001c
+DATA shiftCounts344<>+4(SB)/4, $0x0000001c
+DATA shiftCounts344<>+8(SB)/4, $0x0000001c
+DATA shiftCounts344<>+12(SB)/4, $0x0000001c
+DATA shiftCounts344<>+16(SB)/4, $0x00000019
+DATA shiftCounts344<>+20(SB)/4, $0x00000019
+DATA shiftCounts344<>+24(SB)/4, $0x00000019
+DATA shiftCounts344<>+28(SB)/4, $0x00000019
+GLOBL shiftCounts344<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts345<>+0(SB)/4, $0x00000007
+DATA shiftCounts345<>+4(SB)/4, $0x00000007
+DATA shiftCounts345<>+8(SB)/4, $0x00000007
+DATA shiftCounts345<>+12(SB)/4, $0x00000007
+DATA shiftCounts345<>+16(SB)/4, $0x0000000a
+DATA shiftCounts345<>+20(SB)/4, $0x0000000a
+DATA shiftCounts345<>+24(SB)/4, $0x0000000a
+DATA shiftCounts345<>+28(SB)/4, $0x0000000a
+GLOBL shiftCounts345<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts346<>+0(SB)/4, $0x00000016
+DATA shiftCounts346<>+4(SB)/4, $0x00000016
+DATA shiftCounts346<>+8(SB)/4, $0x00000016
+DATA shiftCounts346<>+12(SB)/4, $0x00000016
+DATA shiftCounts346<>+16(SB)/4, $0x00000013
+DATA shiftCounts346<>+20(SB)/4, $0x00000013
+DATA shiftCounts346<>+24(SB)/4, $0x00000013
+DATA shiftCounts346<>+28(SB)/4, $0x00000013
+GLOBL shiftCounts346<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts347<>+0(SB)/4, $0x0000000d
+DATA shiftCounts347<>+4(SB)/4, $0x0000000d
+DATA shiftCounts347<>+8(SB)/4, $0x0000000d
+DATA shiftCounts347<>+12(SB)/4, $0x0000000d
+DATA shiftCounts347<>+16(SB)/4, $0x00000010
+DATA shiftCounts347<>+20(SB)/4, $0x00000010
+DATA shiftCounts347<>+24(SB)/4, $0x00000010
+DATA shiftCounts347<>+28(SB)/4, $0x00000010
+GLOBL shiftCounts347<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts348<>+0(SB)/4, $0x00000010
+DATA shiftCounts348<>+4(SB)/4, $0x00000010
+DATA shiftCounts348<>+8(SB)/4, $0x00000010
+DATA shiftCounts348<>+12(SB)/4, $0x00000010
+DATA shiftCounts348<>+16(SB)/4, $0x0000000d
+DATA shiftCounts348<>+20(SB)/4, $0x0000000d
+DATA shiftCounts348<>+24(SB)/4, $0x0000000d
+DATA shiftCounts348<>+28(SB)/4, $0x0000000d
+GLOBL shiftCounts348<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts349<>+0(SB)/4, $0x00000013
+DATA shiftCounts349<>+4(SB)/4, $0x00000013
+DATA shiftCounts349<>+8(SB)/4, $0x00000013
+DATA shiftCounts349<>+12(SB)/4, $0x00000013
+DATA shiftCounts349<>+16(SB)/4, $0x00000016
+DATA shiftCounts349<>+20(SB)/4, $0x00000016
+DATA shiftCounts349<>+24(SB)/4, $0x00000016
+DATA shiftCounts349<>+28(SB)/4, $0x00000016
+GLOBL shiftCounts349<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts350<>+0(SB)/4, $0x0000000a
+DATA shiftCounts350<>+4(SB)/4, $0x0000000a
+DATA shiftCounts350<>+8(SB)/4, $0x0000000a
+DATA shiftCounts350<>+12(SB)/4, $0x0000000a
+DATA shiftCounts350<>+16(SB)/4, $0x00000007
+DATA shiftCounts350<>+20(SB)/4, $0x00000007
+DATA shiftCounts350<>+24(SB)/4, $0x00000007
+DATA shiftCounts350<>+28(SB)/4, $0x00000007
+GLOBL shiftCounts350<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts351<>+0(SB)/4, $0x00000019
+DATA shiftCounts351<>+4(SB)/4, $0x00000019
+DATA shiftCounts351<>+8(SB)/4, $0x00000019
+DATA shiftCounts351<>+12(SB)/4, $0x00000019
+DATA shiftCounts351<>+16(SB)/4, $0x0000001c
+DATA shiftCounts351<>+20(SB)/4, $0x0000001c
+DATA shiftCounts351<>+24(SB)/4, $0x0000001c
+DATA shiftCounts351<>+28(SB)/4, $0x0000001c
+GLOBL shiftCounts351<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts352<>+0(SB)/4, $0x00000002
+DATA shiftCounts352<>+4(SB)/4, $0x00000002
+DATA shiftCounts352<>+8(SB)/4, $0x00000002
+DATA shiftCounts352<>+12(SB)/4, $0x00000002
+DATA shiftCounts352<>+16(SB)/4, $0x00000005
+DATA shiftCounts352<>+20(SB)/4, $0x00000005
+DATA shiftCounts352<>+24(SB)/4, $0x00000005
+DATA shiftCounts352<>+28(SB)/4, $0x00000005
+GLOBL shiftCounts352<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts353<>+0(SB)/4, $0x0000001b
+DATA shiftCounts353<>+4(SB)/4, $0x0000001b
+DATA shiftCounts353<>+8(SB)/4, $0x0000001b
+DATA shiftCounts353<>+12(SB)/4, $0x0000001b
+DATA shiftCounts353<>+16(SB)/4, $0x00000018
+DATA shiftCounts353<>+20(SB)/4, $0x00000018
+DATA shiftCounts353<>+24(SB)/4, $0x00000018
+DATA shiftCounts353<>+28(SB)/4, $0x00000018
+GLOBL shiftCounts353<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts354<>+0(SB)/4, $0x00000008
+DATA shiftCounts354<>+4(SB)/4, $0x00000008
+DATA shiftCounts354<>+8(SB)/4, $0x00000008
+DATA shiftCounts354<>+12(SB)/4, $0x00000008
+DATA shiftCounts354<>+16(SB)/4, $0x0000000b
+DATA shiftCounts354<>+20(SB)/4, $0x0000000b
+DATA shiftCounts354<>+24(SB)/4, $0x0000000b
+DATA shiftCounts354<>+28(SB)/4, $0x0000000b
+GLOBL shiftCounts354<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts355<>+0(SB)/4, $0x00000015
+DATA shiftCounts355<>+4(SB)/4, $0x00000015
+DATA shiftCounts355<>+8(SB)/4, $0x00000015
+DATA shiftCounts355<>+12(SB)/4, $0x00000015
+DATA shiftCounts355<>+16(SB)/4, $0x00000012
+DATA shiftCounts355<>+20(SB)/4, $0x00000012
+DATA shiftCounts355<>+24(SB)/4, $0x00000012
+DATA shiftCounts355<>+28(SB)/4, $0x00000012
+GLOBL shiftCounts355<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts356<>+0(SB)/4, $0x0000000e
+DATA shiftCounts356<>+4(SB)/4, $0x0000000e
+DATA shiftCounts356<>+8(SB)/4, $0x0000000e
+DATA shiftCounts356<>+12(SB)/4, $0x0000000e
+DATA shiftCounts356<>+16(SB)/4, $0x00000011
+DATA shiftCounts356<>+20(SB)/4, $0x00000011
+DATA shiftCounts356<>+24(SB)/4, $0x00000011
+DATA shiftCounts356<>+28(SB)/4, $0x00000011
+GLOBL shiftCounts356<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts357<>+0(SB)/4, $0x0000000f
+DATA shiftCounts357<>+4(SB)/4, $0x0000000f
+DATA shiftCounts357<>+8(SB)/4, $0x0000000f
+DATA shiftCounts357<>+12(SB)/4, $0x0000000f
+DATA shiftCounts357<>+16(SB)/4, $0x0000000c
+DATA shiftCounts357<>+20(SB)/4, $0x0000000c
+DATA shiftCounts357<>+24(SB)/4, $0x0000000c
+DATA shiftCounts357<>+28(SB)/4, $0x0000000c
+GLOBL shiftCounts357<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts358<>+0(SB)/4, $0x00000014
+DATA shiftCounts358<>+4(SB)/4, $0x00000014
+DATA shiftCounts358<>+8(SB)/4, $0x00000014
+DATA shiftCounts358<>+12(SB)/4, $0x00000014
+DATA shiftCounts358<>+16(SB)/4, $0x00000017
+DATA shiftCounts358<>+20(SB)/4, $0x00000017
+DATA shiftCounts358<>+24(SB)/4, $0x00000017
+DATA shiftCounts358<>+28(SB)/4, $0x00000017
+GLOBL shiftCounts358<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts359<>+0(SB)/4, $0x00000009
+DATA shiftCounts359<>+4(SB)/4, $0x00000009
+DATA shiftCounts359<>+8(SB)/4, $0x00000009
+DATA shiftCounts359<>+12(SB)/4, $0x00000009
+DATA shiftCounts359<>+16(SB)/4, $0x00000006
+DATA shiftCounts359<>+20(SB)/4, $0x00000006
+DATA shiftCounts359<>+24(SB)/4, $0x00000006
+DATA shiftCounts359<>+28(SB)/4, $0x00000006
+GLOBL shiftCounts359<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_30(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_30(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VMOVDQU        (AX), Y0
+	VPSRLVD        shiftCounts32<>+0(SB), Y0, Y0
+	VMOVDQU        16(AX), Y1
+	VPSLLVD        shiftCounts42<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VMOVDQU        32(AX), Y0
+	VPSRLVD        shiftCounts99<>+0(SB), Y0, Y0
+	VMOVDQU        48(AX), Y1
+	VPSLLVD        shiftCounts100<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VMOVDQU        64(AX), Y0
+	VPSRLVD        shiftCounts163<>+0(SB), Y0, Y0
+	VMOVDQU        80(AX), Y1
+	VPSLLVD        shiftCounts64<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VMOVDQU        96(AX), Y0
+	VPSRLVD        shiftCounts165<>+0(SB), Y0, Y0
+	VMOVDQU        112(AX), Y1
+	VPSLLVD        shiftCounts38<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VMOVDQU        128(AX), Y0
+	VPSRLVD        shiftCounts360<>+0(SB), Y0, Y0
+	VMOVDQU        144(AX), Y1
+	VPSLLVD        shiftCounts107<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 128(CX)
+	VMOVDQU        160(AX), Y0
+	VPSRLVD        shiftCounts181<>+0(SB), Y0, Y0
+	VMOVDQU        176(AX), Y1
+	VPSLLVD        shiftCounts45<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 160(CX)
+	VMOVDQU        192(AX), Y0
+	VPSRLVD        shiftCounts183<>+0(SB), Y0, Y0
+	VMOVDQU        208(AX), Y1
+	VPSLLVD        shiftCounts34<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 192(CX)
+	VBROADCASTI128 224(AX), Y0
+	VINSERTI128    $0x01, 256(AX), Y0, Y0
+	VPSRLVD        shiftCounts361<>+0(SB), Y0, Y0
+	VBROADCASTI128 240(AX), Y1
+	VINSERTI128    $0x01, 272(AX), Y1, Y1
+	VPSLLVD        shiftCounts362<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 224(CX)
+	VMOVDQU        272(AX), Y0
+	VPSRLVD        shiftCounts61<>+0(SB), Y0, Y0
+	VMOVDQU        288(AX), Y1
+	VPSLLVD        shiftCounts65<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 256(CX)
+	VMOVDQU        304(AX), Y0
+	VPSRLVD        shiftCounts106<>+0(SB), Y0, Y0
+	VMOVDQU        320(AX), Y1
+	VPSLLVD        shiftCounts40<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 288(CX)
+	VMOVDQU        336(AX), Y0
+	VPSRLVD        shiftCounts363<>+0(SB), Y0, Y0
+	VMOVDQU        352(AX), Y1
+	VPSLLVD        shiftCounts46<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 320(CX)
+	VMOVDQU        368(AX), Y0
+	VPSRLVD        shiftCounts364<>+0(SB), Y0, Y0
+	VMOVDQU        384(AX), Y1
+	VPSLLVD        shiftCounts63<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 352(CX)
+	VMOVDQU        400(AX), Y0
+	VPSRLVD        shiftCounts365<>+0(SB), Y0, Y0
+	VMOVDQU        416(AX), Y1
+	VPSLLVD        shiftCounts36<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 384(CX)
+	VMOVDQU        432(AX), Y0
+	VPSRLVD        shiftCounts366<>+0(SB), Y0, Y0
+	VMOVDQU        448(AX), Y1
+	VPSLLVD        shiftCounts105<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 416(CX)
+	VMOVDQU        464(AX), Y0
+	VPSRLVD        shiftCounts367<>+0(SB), Y0, Y0
+	VMOVDQU        480(AX), Y1
+	VPSLLVD        shiftCounts62<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 448(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts360<>+0(SB)/4, $0x00000010
+DATA shiftCounts360<>+4(SB)/4, $0x00000010
+DATA shiftCounts360<>+8(SB)/4, $0x00000010
+DATA shiftCounts360<>+12(SB)/4, $0x00000010
+DATA shiftCounts360<>+16(SB)/4, $0x00000012
+DATA shiftCounts360<>+20(SB)/4, $0x00000012
+DATA shiftCounts360<>+24(SB)/4, $0x00000012
+DATA shiftCounts360<>+28(SB)/4, $0x00000012
+GLOBL shiftCounts360<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts361<>+0(SB)/4, $0x0000001c
+DATA shiftCounts361<>+4(SB)/4, $0x0000001c
+DATA shiftCounts361<>+8(SB)/4, $0x0000001c
+DATA shiftCounts361<>+12(SB)/4, $0x0000001c
+DATA shiftCounts361<>+16(SB)/4, $0x00000000
+DATA shiftCounts361<>+20(SB)/4, $0x00000000
+DATA shiftCounts361<>+24(SB)/4, $0x00000000
+DATA shiftCounts361<>+28(SB)/4, $0x00000000
+GLOBL shiftCounts361<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts362<>+0(SB)/4, $0x00000002
+DATA shiftCounts362<>+4(SB)/4, $0x00000002
+DATA shiftCounts362<>+8(SB)/4, $0x00000002
+DATA shiftCounts362<>+12(SB)/4, $0x00000002
+DATA shiftCounts362<>+16(SB)/4, $0x0000001e
+DATA shiftCounts362<>+20(SB)/4, $0x0000001e
+DATA shiftCounts362<>+24(SB)/4, $0x0000001e
+DATA shiftCounts362<>+28(SB)/4, $0x0000001e
+GLOBL shiftCounts362<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts363<>+0(SB)/4, $0x0000000a
+DATA shiftCounts363<>+4(SB)/4, $0x0000000a
+DATA shiftCounts363<>+8(SB)/4, $0x0000000a
+DATA shiftCounts363<>+12(SB)/4, $0x0000000a
+DATA shiftCounts363<>+16(SB)/4, $0x0000000c
+DATA shiftCounts363<>+20(SB)/4, $0x0000000c
+DATA shiftCounts363<>+24(SB)/4, $0x0000000c
+DATA shiftCounts363<>+28(SB)/4, $0x0000000c
+GLOBL shiftCounts363<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts364<>+0(SB)/4, $0x0000000e
+DATA shiftCounts364<>+4(SB)/4, $0x0000000e
+DATA shiftCounts364<>+8(SB)/4, $0x0000000e
+DATA shiftCounts364<>+12(SB)/4, $0x0000000e
+DATA shiftCounts364<>+16(SB)/4, $0x00000010
+DATA shiftCounts364<>+20(SB)/4, $0x00000010
+DATA shiftCounts364<>+24(SB)/4, $0x00000010
+DATA shiftCounts364<>+28(SB)/4, $0x00000010
+GLOBL shiftCounts364<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts365<>+0(SB)/4, $0x00000012
+DATA shiftCounts365<>+4(SB)/4, $0x00000012
+DATA shiftCounts365<>+8(SB)/4, $0x00000012
+DATA shiftCounts365<>+12(SB)/4, $0x00000012
+DATA shiftCounts365<>+16(SB)/4, $0x00000014
+DATA shiftCounts365<>+20(SB)/4, $0x00000014
+DATA shiftCounts365<>+24(SB)/4, $0x00000014
+DATA shiftCounts365<>+28(SB)/4, $0x00000014
+GLOBL shiftCounts365<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts366<>+0(SB)/4, $0x00000016
+DATA shiftCounts366<>+4(SB)/4, $0x00000016
+DATA shiftCounts366<>+8(SB)/4, $0x00000016
+DATA shiftCounts366<>+12(SB)/4, $0x00000016
+DATA shiftCounts366<>+16(SB)/4, $0x00000018
+DATA shiftCounts366<>+20(SB)/4, $0x00000018
+DATA shiftCounts366<>+24(SB)/4, $0x00000018
+DATA shiftCounts366<>+28(SB)/4, $0x00000018
+GLOBL shiftCounts366<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts367<>+0(SB)/4, $0x0000001a
+DATA shiftCounts367<>+4(SB)/4, $0x0000001a
+DATA shiftCounts367<>+8(SB)/4, $0x0000001a
+DATA shiftCounts367<>+12(SB)/4, $0x0000001a
+DATA shiftCounts367<>+16(SB)/4, $0x0000001c
+DATA shiftCounts367<>+20(SB)/4, $0x0000001c
+DATA shiftCounts367<>+24(SB)/4, $0x0000001c
+DATA shiftCounts367<>+28(SB)/4, $0x0000001c
+GLOBL shiftCounts367<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_31(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·pack32AVX2_31(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, AX
+	VMOVDQU        (AX), Y0
+	VPSRLVD        shiftCounts368<>+0(SB), Y0, Y0
+	VMOVDQU        16(AX), Y1
+	VPSLLVD        shiftCounts369<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, (CX)
+	VMOVDQU        32(AX), Y0
+	VPSRLVD        shiftCounts113<>+0(SB), Y0, Y0
+	VMOVDQU        48(AX), Y1
+	VPSLLVD        shiftCounts370<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 32(CX)
+	VMOVDQU        64(AX), Y0
+	VPSRLVD        shiftCounts117<>+0(SB), Y0, Y0
+	VMOVDQU        80(AX), Y1
+	VPSLLVD        shiftCounts371<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 64(CX)
+	VMOVDQU        96(AX), Y0
+	VPSRLVD        shiftCounts121<>+0(SB), Y0, Y0
+	VMOVDQU        112(AX), Y1
+	VPSLLVD        shiftCounts372<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 96(CX)
+	VMOVDQU        128(AX), Y0
+	VPSRLVD        shiftCounts125<>+0(SB), Y0, Y0
+	VMOVDQU        144(AX), Y1
+	VPSLLVD        shiftCounts373<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 128(CX)
+	VMOVDQU        160(AX), Y0
+	VPSRLVD        shiftCounts374<>+0(SB), Y0, Y0
+	VMOVDQU        176(AX), Y1
+	VPSLLVD        shiftCounts375<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 160(CX)
+	VMOVDQU        192(AX), Y0
+	VPSRLVD        shiftCounts376<>+0(SB), Y0, Y0
+	VMOVDQU        208(AX), Y1
+	VPSLLVD        shiftCounts377<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 192(CX)
+	VMOVDQU        224(AX), Y0
+	VPSRLVD        shiftCounts378<>+0(SB), Y0, Y0
+	VMOVDQU        240(AX), Y1
+	VPSLLVD        shiftCounts379<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 224(CX)
+	VMOVDQU        256(AX), Y0
+	VPSRLVD        shiftCounts380<>+0(SB), Y0, Y0
+	VMOVDQU        272(AX), Y1
+	VPSLLVD        shiftCounts381<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 256(CX)
+	VMOVDQU        288(AX), Y0
+	VPSRLVD        shiftCounts382<>+0(SB), Y0, Y0
+	VMOVDQU        304(AX), Y1
+	VPSLLVD        shiftCounts383<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 288(CX)
+	VMOVDQU        320(AX), Y0
+	VPSRLVD        shiftCounts384<>+0(SB), Y0, Y0
+	VMOVDQU        336(AX), Y1
+	VPSLLVD        shiftCounts385<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 320(CX)
+	VMOVDQU        352(AX), Y0
+	VPSRLVD        shiftCounts386<>+0(SB), Y0, Y0
+	VMOVDQU        368(AX), Y1
+	VPSLLVD        shiftCounts112<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 352(CX)
+	VMOVDQU        384(AX), Y0
+	VPSRLVD        shiftCounts115<>+0(SB), Y0, Y0
+	VMOVDQU        400(AX), Y1
+	VPSLLVD        shiftCounts116<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 384(CX)
+	VMOVDQU        416(AX), Y0
+	VPSRLVD        shiftCounts119<>+0(SB), Y0, Y0
+	VMOVDQU        432(AX), Y1
+	VPSLLVD        shiftCounts120<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 416(CX)
+	VMOVDQU        448(AX), Y0
+	VPSRLVD        shiftCounts123<>+0(SB), Y0, Y0
+	VMOVDQU        464(AX), Y1
+	VPSLLVD        shiftCounts124<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        Y0, 448(CX)
+	VBROADCASTI128 480(AX), Y0
+	VPSRLVD        shiftCounts30<>+0(SB), Y0, Y0
+	VBROADCASTI128 496(AX), Y1
+	VPSLLVD        shiftCounts1<>+0(SB), Y1, Y1
+	VPOR           Y1, Y0, Y0
+	VMOVDQU        X0, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts368<>+0(SB)/4, $0x00000000
+DATA shiftCounts368<>+4(SB)/4, $0x00000000
+DATA shiftCounts368<>+8(SB)/4, $0x00000000
+DATA shiftCounts368<>+12(SB)/4, $0x00000000
+DATA shiftCounts368<>+16(SB)/4, $0x00000001
+DATA shiftCounts368<>+20(SB)/4, $0x00000001
+DATA shiftCounts368<>+24(SB)/4, $0x00000001
+DATA shiftCounts368<>+28(SB)/4, $0x00000001
+GLOBL shiftCounts368<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts369<>+0(SB)/4, $0x0000001f
+DATA shiftCounts369<>+4(SB)/4, $0x0000001f
+DATA shiftCounts369<>+8(SB)/4, $0x0000001f
+DATA shiftCounts369<>+12(SB)/4, $0x0000001f
+DATA shiftCounts369<>+16(SB)/4, $0x0000001e
+DATA shiftCounts369<>+20(SB)/4, $0x0000001e
+DATA shiftCounts369<>+24(SB)/4, $0x0000001e
+DATA shiftCounts369<>+28(SB)/4, $0x0000001e
+GLOBL shiftCounts369<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts370<>+0(SB)/4, $0x0000001d
+DATA shiftCounts370<>+4(SB)/4, $0x0000001d
+DATA shiftCounts370<>+8(SB)/4, $0x0000001d
+DATA shiftCounts370<>+12(SB)/4, $0x0000001d
+DATA shiftCounts370<>+16(SB)/4, $0x0000001c
+DATA shiftCounts370<>+20(SB)/4, $0x0000001c
+DATA shiftCounts370<>+24(SB)/4, $0x0000001c
+DATA shiftCounts370<>+28(SB)/4, $0x0000001c
+GLOBL shiftCounts370<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts371<>+0(SB)/4, $0x0000001b
+DATA shiftCounts371<>+4(SB)/4, $0x0000001b
+DATA shiftCounts371<>+8(SB)/4, $0x0000001b
+DATA shiftCounts371<>+12(SB)/4, $0x0000001b
+DATA shiftCounts371<>+16(SB)/4, $0x0000001a
+DATA shiftCounts371<>+20(SB)/4, $0x0000001a
+DATA shiftCounts371<>+24(SB)/4, $0x0000001a
+DATA shiftCounts371<>+28(SB)/4, $0x0000001a
+GLOBL shiftCounts371<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts372<>+0(SB)/4, $0x00000019
+DATA shiftCounts372<>+4(SB)/4, $0x00000019
+DATA shiftCounts372<>+8(SB)/4, $0x00000019
+DATA shiftCounts372<>+12(SB)/4, $0x00000019
+DATA shiftCounts372<>+16(SB)/4, $0x00000018
+DATA shiftCounts372<>+20(SB)/4, $0x00000018
+DATA shiftCounts372<>+24(SB)/4, $0x00000018
+DATA shiftCounts372<>+28(SB)/4, $0x00000018
+GLOBL shiftCounts372<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts373<>+0(SB)/4, $0x00000017
+DATA shiftCounts373<>+4(SB)/4, $0x00000017
+DATA shiftCounts373<>+8(SB)/4, $0x00000017
+DATA shiftCounts373<>+12(SB)/4, $0x00000017
+DATA shiftCounts373<>+16(SB)/4, $0x00000016
+DATA shiftCounts373<>+20(SB)/4, $0x00000016
+DATA shiftCounts373<>+24(SB)/4, $0x00000016
+DATA shiftCounts373<>+28(SB)/4, $0x00000016
+GLOBL shiftCounts373<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts374<>+0(SB)/4, $0x0000000a
+DATA shiftCounts374<>+4(SB)/4, $0x0000000a
+DATA shiftCounts374<>+8(SB)/4, $0x0000000a
+DATA shiftCounts374<>+12(SB)/4, $0x0000000a
+DATA shiftCounts374<>+16(SB)/4, $0x0000000b
+DATA shiftCounts374<>+20(SB)/4, $0x0000000b
+DATA shiftCounts374<>+24(SB)/4, $0x0000000b
+DATA shiftCounts374<>+28(SB)/4, $0x0000000b
+GLOBL shiftCounts374<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts375<>+0(SB)/4, $0x00000015
+DATA shiftCounts375<>+4(SB)/4, $0x00000015
+DATA shiftCounts375<>+8(SB)/4, $0x00000015
+DATA shiftCounts375<>+12(SB)/4, $0x00000015
+DATA shiftCounts375<>+16(SB)/4, $0x00000014
+DATA shiftCounts375<>+20(SB)/4, $0x00000014
+DATA shiftCounts375<>+24(SB)/4, $0x00000014
+DATA shiftCounts375<>+28(SB)/4, $0x00000014
+GLOBL shiftCounts375<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts376<>+0(SB)/4, $0x0000000c
+DATA shiftCounts376<>+4(SB)/4, $0x0000000c
+DATA shiftCounts376<>+8(SB)/4, $0x0000000c
+DATA shiftCounts376<>+12(SB)/4, $0x0000000c
+DATA shiftCounts376<>+16(SB)/4, $0x0000000d
+DATA shiftCounts376<>+20(SB)/4, $0x0000000d
+DATA shiftCounts376<>+24(SB)/4, $0x0000000d
+DATA shiftCounts376<>+28(SB)/4, $0x0000000d
+GLOBL shiftCounts376<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts377<>+0(SB)/4, $0x00000013
+DATA shiftCounts377<>+4(SB)/4, $0x00000013
+DATA shiftCounts377<>+8(SB)/4, $0x00000013
+DATA shiftCounts377<>+12(SB)/4, $0x00000013
+DATA shiftCounts377<>+16(SB)/4, $0x00000012
+DATA shiftCounts377<>+20(SB)/4, $0x00000012
+DATA shiftCounts377<>+24(SB)/4, $0x00000012
+DATA shiftCounts377<>+28(SB)/4, $0x00000012
+GLOBL shiftCounts377<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts378<>+0(SB)/4, $0x0000000e
+DATA shiftCounts378<>+4(SB)/4, $0x0000000e
+DATA shiftCounts378<>+8(SB)/4, $0x0000000e
+DATA shiftCounts378<>+12(SB)/4, $0x0000000e
+DATA shiftCounts378<>+16(SB)/4, $0x0000000f
+DATA shiftCounts378<>+20(SB)/4, $0x0000000f
+DATA shiftCounts378<>+24(SB)/4, $0x0000000f
+DATA shiftCounts378<>+28(SB)/4, $0x0000000f
+GLOBL shiftCounts378<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts379<>+0(SB)/4, $0x00000011
+DATA shiftCounts379<>+4(SB)/4, $0x00000011
+DATA shiftCounts379<>+8(SB)/4, $0x00000011
+DATA shiftCounts379<>+12(SB)/4, $0x00000011
+DATA shiftCounts379<>+16(SB)/4, $0x00000010
+DATA shiftCounts379<>+20(SB)/4, $0x00000010
+DATA shiftCounts379<>+24(SB)/4, $0x00000010
+DATA shiftCounts379<>+28(SB)/4, $0x00000010
+GLOBL shiftCounts379<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts380<>+0(SB)/4, $0x00000010
+DATA shiftCounts380<>+4(SB)/4, $0x00000010
+DATA shiftCounts380<>+8(SB)/4, $0x00000010
+DATA shiftCounts380<>+12(SB)/4, $0x00000010
+DATA shiftCounts380<>+16(SB)/4, $0x00000011
+DATA shiftCounts380<>+20(SB)/4, $0x00000011
+DATA shiftCounts380<>+24(SB)/4, $0x00000011
+DATA shiftCounts380<>+28(SB)/4, $0x00000011
+GLOBL shiftCounts380<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts381<>+0(SB)/4, $0x0000000f
+DATA shiftCounts381<>+4(SB)/4, $0x0000000f
+DATA shiftCounts381<>+8(SB)/4, $0x0000000f
+DATA shiftCounts381<>+12(SB)/4, $0x0000000f
+DATA shiftCounts381<>+16(SB)/4, $0x0000000e
+DATA shiftCounts381<>+20(SB)/4, $0x0000000e
+DATA shiftCounts381<>+24(SB)/4, $0x0000000e
+DATA shiftCounts381<>+28(SB)/4, $0x0000000e
+GLOBL shiftCounts381<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts382<>+0(SB)/4, $0x00000012
+DATA shiftCounts382<>+4(SB)/4, $0x00000012
+DATA shiftCounts382<>+8(SB)/4, $0x00000012
+DATA shiftCounts382<>+12(SB)/4, $0x00000012
+DATA shiftCounts382<>+16(SB)/4, $0x00000013
+DATA shiftCounts382<>+20(SB)/4, $0x00000013
+DATA shiftCounts382<>+24(SB)/4, $0x00000013
+DATA shiftCounts382<>+28(SB)/4, $0x00000013
+GLOBL shiftCounts382<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts383<>+0(SB)/4, $0x0000000d
+DATA shiftCounts383<>+4(SB)/4, $0x0000000d
+DATA shiftCounts383<>+8(SB)/4, $0x0000000d
+DATA shiftCounts383<>+12(SB)/4, $0x0000000d
+DATA shiftCounts383<>+16(SB)/4, $0x0000000c
+DATA shiftCounts383<>+20(SB)/4, $0x0000000c
+DATA shiftCounts383<>+24(SB)/4, $0x0000000c
+DATA shiftCounts383<>+28(SB)/4, $0x0000000c
+GLOBL shiftCounts383<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts384<>+0(SB)/4, $0x00000014
+DATA shiftCounts384<>+4(SB)/4, $0x00000014
+DATA shiftCounts384<>+8(SB)/4, $0x00000014
+DATA shiftCounts384<>+12(SB)/4, $0x00000014
+DATA shiftCounts384<>+16(SB)/4, $0x00000015
+DATA shiftCounts384<>+20(SB)/4, $0x00000015
+DATA shiftCounts384<>+24(SB)/4, $0x00000015
+DATA shiftCounts384<>+28(SB)/4, $0x00000015
+GLOBL shiftCounts384<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts385<>+0(SB)/4, $0x0000000b
+DATA shiftCounts385<>+4(SB)/4, $0x0000000b
+DATA shiftCounts385<>+8(SB)/4, $0x0000000b
+DATA shiftCounts385<>+12(SB)/4, $0x0000000b
+DATA shiftCounts385<>+16(SB)/4, $0x0000000a
+DATA shiftCounts385<>+20(SB)/4, $0x0000000a
+DATA shiftCounts385<>+24(SB)/4, $0x0000000a
+DATA shiftCounts385<>+28(SB)/4, $0x0000000a
+GLOBL shiftCounts385<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts386<>+0(SB)/4, $0x00000016
+DATA shiftCounts386<>+4(SB)/4, $0x00000016
+DATA shiftCounts386<>+8(SB)/4, $0x00000016
+DATA shiftCounts386<>+12(SB)/4, $0x00000016
+DATA shiftCounts386<>+16(SB)/4, $0x00000017
+DATA shiftCounts386<>+20(SB)/4, $0x00000017
+DATA shiftCounts386<>+24(SB)/4, $0x00000017
+DATA shiftCounts386<>+28(SB)/4, $0x00000017
+GLOBL shiftCounts386<>(SB), RODATA|NOPTR, $32
+
+// func pack32AVX2_32(in uintptr, out *byte, offset int, seed *byte)
+// Requires: AVX
+TEXT ·pack32AVX2_32(SB), NOSPLIT, $0-32
+	MOVQ    in+0(FP), AX
+	MOVQ    out+8(FP), CX
+	MOVQ    offset+16(FP), DX
+	SHLQ    $0x02, DX
+	ADDQ    DX, AX
+	VMOVDQU (AX), Y0
+	VMOVDQU Y0, (CX)
+	VMOVDQU 32(AX), Y0
+	VMOVDQU Y0, 32(CX)
+	VMOVDQU 64(AX), Y0
+	VMOVDQU Y0, 64(CX)
+	VMOVDQU 96(AX), Y0
+	VMOVDQU Y0, 96(CX)
+	VMOVDQU 128(AX), Y0
+	VMOVDQU Y0, 128(CX)
+	VMOVDQU 160(AX), Y0
+	VMOVDQU Y0, 160(CX)
+	VMOVDQU 192(AX), Y0
+	VMOVDQU Y0, 192(CX)
+	VMOVDQU 224(AX), Y0
+	VMOVDQU Y0, 224(CX)
+	VMOVDQU 256(AX), Y0
+	VMOVDQU Y0, 256(CX)
+	VMOVDQU 288(AX), Y0
+	VMOVDQU Y0, 288(CX)
+	VMOVDQU 320(AX), Y0
+	VMOVDQU Y0, 320(CX)
+	VMOVDQU 352(AX), Y0
+	VMOVDQU Y0, 352(CX)
+	VMOVDQU 384(AX), Y0
+	VMOVDQU Y0, 384(CX)
+	VMOVDQU 416(AX), Y0
+	VMOVDQU Y0, 416(CX)
+	VMOVDQU 448(AX), Y0
+	VMOVDQU Y0, 448(CX)
+	VMOVDQU 480(AX), Y0
+	VMOVDQU Y0, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpack32AVX2_1(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_1(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x00000001, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts368<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts113<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts117<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts121<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts125<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts374<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts376<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts378<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts380<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts382<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts384<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts386<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts115<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts119<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts123<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts127<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpack32AVX2_2(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_2(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x00000003, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts32<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts99<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts163<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts165<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts360<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts181<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts183<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts185<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts32<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts99<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts163<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts165<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts360<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts181<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts183<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts185<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpack32AVX2_3(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_3(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts335<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts50<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts338<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts340<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts342<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VMOVDQU        (AX), Y1
+	VPSRLVD        shiftCounts387<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts2<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts388<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts389<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts52<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts390<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts391<>+0(SB), Y1, Y1
+	VBROADCASTI128 32(AX), Y2
+	VPSLLVD        shiftCounts392<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts352<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts354<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts356<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts358<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts54<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts387<>+0(SB)/4, $0x0000001e
+DATA shiftCounts387<>+4(SB)/4, $0x0000001e
+DATA shiftCounts387<>+8(SB)/4, $0x0000001e
+DATA shiftCounts387<>+12(SB)/4, $0x0000001e
+DATA shiftCounts387<>+16(SB)/4, $0x00000001
+DATA shiftCounts387<>+20(SB)/4, $0x00000001
+DATA shiftCounts387<>+24(SB)/4, $0x00000001
+DATA shiftCounts387<>+28(SB)/4, $0x00000001
+GLOBL shiftCounts387<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts388<>+0(SB)/4, $0x00000004
+DATA shiftCounts388<>+4(SB)/4, $0x00000004
+DATA shiftCounts388<>+8(SB)/4, $0x00000004
+DATA shiftCounts388<>+12(SB)/4, $0x00000004
+DATA shiftCounts388<>+16(SB)/4, $0x00000007
+DATA shiftCounts388<>+20(SB)/4, $0x00000007
+DATA shiftCounts388<>+24(SB)/4, $0x00000007
+DATA shiftCounts388<>+28(SB)/4, $0x00000007
+GLOBL shiftCounts388<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts389<>+0(SB)/4, $0x0000000a
+DATA shiftCounts389<>+4(SB)/4, $0x0000000a
+DATA shiftCounts389<>+8(SB)/4, $0x0000000a
+DATA shiftCounts389<>+12(SB)/4, $0x0000000a
+DATA shiftCounts389<>+16(SB)/4, $0x0000000d
+DATA shiftCounts389<>+20(SB)/4, $0x0000000d
+DATA shiftCounts389<>+24(SB)/4, $0x0000000d
+DATA shiftCounts389<>+28(SB)/4, $0x0000000d
+GLOBL shiftCounts389<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts390<>+0(SB)/4, $0x00000016
+DATA shiftCounts390<>+4(SB)/4, $0x00000016
+DATA shiftCounts390<>+8(SB)/4, $0x00000016
+DATA shiftCounts390<>+12(SB)/4, $0x00000016
+DATA shiftCounts390<>+16(SB)/4, $0x00000019
+DATA shiftCounts390<>+20(SB)/4, $0x00000019
+DATA shiftCounts390<>+24(SB)/4, $0x00000019
+DATA shiftCounts390<>+28(SB)/4, $0x00000019
+GLOBL shiftCounts390<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts391<>+0(SB)/4, $0x0000001c
+DATA shiftCounts391<>+4(SB)/4, $0x0000001c
+DATA shiftCounts391<>+8(SB)/4, $0x0000001c
+DATA shiftCounts391<>+12(SB)/4, $0x0000001c
+DATA shiftCounts391<>+16(SB)/4, $0x0000001f
+DATA shiftCounts391<>+20(SB)/4, $0x0000001f
+DATA shiftCounts391<>+24(SB)/4, $0x0000001f
+DATA shiftCounts391<>+28(SB)/4, $0x0000001f
+GLOBL shiftCounts391<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts392<>+0(SB)/4, $0x00000020
+DATA shiftCounts392<>+4(SB)/4, $0x00000020
+DATA shiftCounts392<>+8(SB)/4, $0x00000020
+DATA shiftCounts392<>+12(SB)/4, $0x00000020
+DATA shiftCounts392<>+16(SB)/4, $0x00000001
+DATA shiftCounts392<>+20(SB)/4, $0x00000001
+DATA shiftCounts392<>+24(SB)/4, $0x00000001
+DATA shiftCounts392<>+28(SB)/4, $0x00000001
+GLOBL shiftCounts392<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_4(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_4(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x0000000f, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts66<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts57<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts133<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts192<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts66<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts57<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts133<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts192<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts66<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts57<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts133<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts192<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts66<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts57<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts133<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts192<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpack32AVX2_5(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_5(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x0000001f, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts82<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts318<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts319<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VMOVDQU        (AX), Y1
+	VPSRLVD        shiftCounts393<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts2<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts394<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts395<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VMOVDQU        16(AX), Y1
+	VPSRLVD        shiftCounts396<>+0(SB), Y1, Y1
+	VBROADCASTI128 32(AX), Y2
+	VPSLLVD        shiftCounts4<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts324<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts326<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts397<>+0(SB), Y1, Y1
+	VBROADCASTI128 48(AX), Y2
+	VPSLLVD        shiftCounts392<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts398<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts399<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts400<>+0(SB), Y1, Y1
+	VBROADCASTI128 64(AX), Y2
+	VPSLLVD        shiftCounts401<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VBROADCASTI128 64(AX), Y1
+	VPSRLVD        shiftCounts90<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VBROADCASTI128 64(AX), Y1
+	VPSRLVD        shiftCounts332<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VBROADCASTI128 64(AX), Y1
+	VPSRLVD        shiftCounts402<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts393<>+0(SB)/4, $0x0000001e
+DATA shiftCounts393<>+4(SB)/4, $0x0000001e
+DATA shiftCounts393<>+8(SB)/4, $0x0000001e
+DATA shiftCounts393<>+12(SB)/4, $0x0000001e
+DATA shiftCounts393<>+16(SB)/4, $0x00000003
+DATA shiftCounts393<>+20(SB)/4, $0x00000003
+DATA shiftCounts393<>+24(SB)/4, $0x00000003
+DATA shiftCounts393<>+28(SB)/4, $0x00000003
+GLOBL shiftCounts393<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts394<>+0(SB)/4, $0x00000008
+DATA shiftCounts394<>+4(SB)/4, $0x00000008
+DATA shiftCounts394<>+8(SB)/4, $0x00000008
+DATA shiftCounts394<>+12(SB)/4, $0x00000008
+DATA shiftCounts394<>+16(SB)/4, $0x0000000d
+DATA shiftCounts394<>+20(SB)/4, $0x0000000d
+DATA shiftCounts394<>+24(SB)/4, $0x0000000d
+DATA shiftCounts394<>+28(SB)/4, $0x0000000d
+GLOBL shiftCounts394<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts395<>+0(SB)/4, $0x00000012
+DATA shiftCounts395<>+4(SB)/4, $0x00000012
+DATA shiftCounts395<>+8(SB)/4, $0x00000012
+DATA shiftCounts395<>+12(SB)/4, $0x00000012
+DATA shiftCounts395<>+16(SB)/4, $0x00000017
+DATA shiftCounts395<>+20(SB)/4, $0x00000017
+DATA shiftCounts395<>+24(SB)/4, $0x00000017
+DATA shiftCounts395<>+28(SB)/4, $0x00000017
+GLOBL shiftCounts395<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts396<>+0(SB)/4, $0x0000001c
+DATA shiftCounts396<>+4(SB)/4, $0x0000001c
+DATA shiftCounts396<>+8(SB)/4, $0x0000001c
+DATA shiftCounts396<>+12(SB)/4, $0x0000001c
+DATA shiftCounts396<>+16(SB)/4, $0x00000001
+DATA shiftCounts396<>+20(SB)/4, $0x00000001
+DATA shiftCounts396<>+24(SB)/4, $0x00000001
+DATA shiftCounts396<>+28(SB)/4, $0x00000001
+GLOBL shiftCounts396<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts397<>+0(SB)/4, $0x0000001a
+DATA shiftCounts397<>+4(SB)/4, $0x0000001a
+DATA shiftCounts397<>+8(SB)/4, $0x0000001a
+DATA shiftCounts397<>+12(SB)/4, $0x0000001a
+DATA shiftCounts397<>+16(SB)/4, $0x0000001f
+DATA shiftCounts397<>+20(SB)/4, $0x0000001f
+DATA shiftCounts397<>+24(SB)/4, $0x0000001f
+DATA shiftCounts397<>+28(SB)/4, $0x0000001f
+GLOBL shiftCounts397<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts398<>+0(SB)/4, $0x00000004
+DATA shiftCounts398<>+4(SB)/4, $0x00000004
+DATA shiftCounts398<>+8(SB)/4, $0x00000004
+DATA shiftCounts398<>+12(SB)/4, $0x00000004
+DATA shiftCounts398<>+16(SB)/4, $0x00000009
+DATA shiftCounts398<>+20(SB)/4, $0x00000009
+DATA shiftCounts398<>+24(SB)/4, $0x00000009
+DATA shiftCounts398<>+28(SB)/4, $0x00000009
+GLOBL shiftCounts398<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts399<>+0(SB)/4, $0x0000000e
+DATA shiftCounts399<>+4(SB)/4, $0x0000000e
+DATA shiftCounts399<>+8(SB)/4, $0x0000000e
+DATA shiftCounts399<>+12(SB)/4, $0x0000000e
+DATA shiftCounts399<>+16(SB)/4, $0x00000013
+DATA shiftCounts399<>+20(SB)/4, $0x00000013
+DATA shiftCounts399<>+24(SB)/4, $0x00000013
+DATA shiftCounts399<>+28(SB)/4, $0x00000013
+GLOBL shiftCounts399<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts400<>+0(SB)/4, $0x00000018
+DATA shiftCounts400<>+4(SB)/4, $0x00000018
+DATA shiftCounts400<>+8(SB)/4, $0x00000018
+DATA shiftCounts400<>+12(SB)/4, $0x00000018
+DATA shiftCounts400<>+16(SB)/4, $0x0000001d
+DATA shiftCounts400<>+20(SB)/4, $0x0000001d
+DATA shiftCounts400<>+24(SB)/4, $0x0000001d
+DATA shiftCounts400<>+28(SB)/4, $0x0000001d
+GLOBL shiftCounts400<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts401<>+0(SB)/4, $0x00000020
+DATA shiftCounts401<>+4(SB)/4, $0x00000020
+DATA shiftCounts401<>+8(SB)/4, $0x00000020
+DATA shiftCounts401<>+12(SB)/4, $0x00000020
+DATA shiftCounts401<>+16(SB)/4, $0x00000003
+DATA shiftCounts401<>+20(SB)/4, $0x00000003
+DATA shiftCounts401<>+24(SB)/4, $0x00000003
+DATA shiftCounts401<>+28(SB)/4, $0x00000003
+GLOBL shiftCounts401<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts402<>+0(SB)/4, $0x00000016
+DATA shiftCounts402<>+4(SB)/4, $0x00000016
+DATA shiftCounts402<>+8(SB)/4, $0x00000016
+DATA shiftCounts402<>+12(SB)/4, $0x00000016
+DATA shiftCounts402<>+16(SB)/4, $0x0000001b
+DATA shiftCounts402<>+20(SB)/4, $0x0000001b
+DATA shiftCounts402<>+24(SB)/4, $0x0000001b
+DATA shiftCounts402<>+28(SB)/4, $0x0000001b
+GLOBL shiftCounts402<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_6(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_6(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x0000003f, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts134<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts201<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts204<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts403<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts310<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts312<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VMOVDQU        16(AX), Y1
+	VPSRLVD        shiftCounts404<>+0(SB), Y1, Y1
+	VBROADCASTI128 32(AX), Y2
+	VPSLLVD        shiftCounts4<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts216<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts219<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts134<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts201<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts204<>+0(SB), Y1, Y1
+	VBROADCASTI128 64(AX), Y2
+	VPSLLVD        shiftCounts403<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VBROADCASTI128 64(AX), Y1
+	VPSRLVD        shiftCounts310<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VBROADCASTI128 64(AX), Y1
+	VPSRLVD        shiftCounts312<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VMOVDQU        64(AX), Y1
+	VPSRLVD        shiftCounts404<>+0(SB), Y1, Y1
+	VBROADCASTI128 80(AX), Y2
+	VPSLLVD        shiftCounts4<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VBROADCASTI128 80(AX), Y1
+	VPSRLVD        shiftCounts216<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VBROADCASTI128 80(AX), Y1
+	VPSRLVD        shiftCounts219<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts403<>+0(SB)/4, $0x00000020
+DATA shiftCounts403<>+4(SB)/4, $0x00000020
+DATA shiftCounts403<>+8(SB)/4, $0x00000020
+DATA shiftCounts403<>+12(SB)/4, $0x00000020
+DATA shiftCounts403<>+16(SB)/4, $0x00000002
+DATA shiftCounts403<>+20(SB)/4, $0x00000002
+DATA shiftCounts403<>+24(SB)/4, $0x00000002
+DATA shiftCounts403<>+28(SB)/4, $0x00000002
+GLOBL shiftCounts403<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts404<>+0(SB)/4, $0x0000001c
+DATA shiftCounts404<>+4(SB)/4, $0x0000001c
+DATA shiftCounts404<>+8(SB)/4, $0x0000001c
+DATA shiftCounts404<>+12(SB)/4, $0x0000001c
+DATA shiftCounts404<>+16(SB)/4, $0x00000002
+DATA shiftCounts404<>+20(SB)/4, $0x00000002
+DATA shiftCounts404<>+24(SB)/4, $0x00000002
+DATA shiftCounts404<>+28(SB)/4, $0x00000002
+GLOBL shiftCounts404<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_7(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_7(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x0000007f, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts285<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts139<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VMOVDQU        (AX), Y1
+	VPSRLVD        shiftCounts405<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts4<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts406<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts407<>+0(SB), Y1, Y1
+	VBROADCASTI128 32(AX), Y2
+	VPSLLVD        shiftCounts392<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts288<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts408<>+0(SB), Y1, Y1
+	VBROADCASTI128 48(AX), Y2
+	VPSLLVD        shiftCounts409<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts141<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts145<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VMOVDQU        48(AX), Y1
+	VPSRLVD        shiftCounts410<>+0(SB), Y1, Y1
+	VBROADCASTI128 64(AX), Y2
+	VPSLLVD        shiftCounts2<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VBROADCASTI128 64(AX), Y1
+	VPSRLVD        shiftCounts296<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VMOVDQU        64(AX), Y1
+	VPSRLVD        shiftCounts411<>+0(SB), Y1, Y1
+	VBROADCASTI128 80(AX), Y2
+	VPSLLVD        shiftCounts6<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VBROADCASTI128 80(AX), Y1
+	VPSRLVD        shiftCounts412<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VBROADCASTI128 80(AX), Y1
+	VPSRLVD        shiftCounts413<>+0(SB), Y1, Y1
+	VBROADCASTI128 96(AX), Y2
+	VPSLLVD        shiftCounts401<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VBROADCASTI128 96(AX), Y1
+	VPSRLVD        shiftCounts147<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VBROADCASTI128 96(AX), Y1
+	VPSRLVD        shiftCounts151<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts405<>+0(SB)/4, $0x0000001c
+DATA shiftCounts405<>+4(SB)/4, $0x0000001c
+DATA shiftCounts405<>+8(SB)/4, $0x0000001c
+DATA shiftCounts405<>+12(SB)/4, $0x0000001c
+DATA shiftCounts405<>+16(SB)/4, $0x00000003
+DATA shiftCounts405<>+20(SB)/4, $0x00000003
+DATA shiftCounts405<>+24(SB)/4, $0x00000003
+DATA shiftCounts405<>+28(SB)/4, $0x00000003
+GLOBL shiftCounts405<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts406<>+0(SB)/4, $0x0000000a
+DATA shiftCounts406<>+4(SB)/4, $0x0000000a
+DATA shiftCounts406<>+8(SB)/4, $0x0000000a
+DATA shiftCounts406<>+12(SB)/4, $0x0000000a
+DATA shiftCounts406<>+16(SB)/4, $0x00000011
+DATA shiftCounts406<>+20(SB)/4, $0x00000011
+DATA shiftCounts406<>+24(SB)/4, $0x00000011
+DATA shiftCounts406<>+28(SB)/4, $0x00000011
+GLOBL shiftCounts406<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts407<>+0(SB)/4, $0x00000018
+DATA shiftCounts407<>+4(SB)/4, $0x00000018
+DATA shiftCounts407<>+8(SB)/4, $0x00000018
+DATA shiftCounts407<>+12(SB)/4, $0x00000018
+DATA shiftCounts407<>+16(SB)/4, $0x0000001f
+DATA shiftCounts407<>+20(SB)/4, $0x0000001f
+DATA shiftCounts407<>+24(SB)/4, $0x0000001f
+DATA shiftCounts407<>+28(SB)/4, $0x0000001f
+GLOBL shiftCounts407<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts408<>+0(SB)/4, $0x00000014
+DATA shiftCounts408<>+4(SB)/4, $0x00000014
+DATA shiftCounts408<>+8(SB)/4, $0x00000014
+DATA shiftCounts408<>+12(SB)/4, $0x00000014
+DATA shiftCounts408<>+16(SB)/4, $0x0000001b
+DATA shiftCounts408<>+20(SB)/4, $0x0000001b
+DATA shiftCounts408<>+24(SB)/4, $0x0000001b
+DATA shiftCounts408<>+28(SB)/4, $0x0000001b
+GLOBL shiftCounts408<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts409<>+0(SB)/4, $0x00000020
+DATA shiftCounts409<>+4(SB)/4, $0x00000020
+DATA shiftCounts409<>+8(SB)/4, $0x00000020
+DATA shiftCounts409<>+12(SB)/4, $0x00000020
+DATA shiftCounts409<>+16(SB)/4, $0x00000005
+DATA shiftCounts409<>+20(SB)/4, $0x00000005
+DATA shiftCounts409<>+24(SB)/4, $0x00000005
+DATA shiftCounts409<>+28(SB)/4, $0x00000005
+GLOBL shiftCounts409<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts410<>+0(SB)/4, $0x0000001e
+DATA shiftCounts410<>+4(SB)/4, $0x0000001e
+DATA shiftCounts410<>+8(SB)/4, $0x0000001e
+DATA shiftCounts410<>+12(SB)/4, $0x0000001e
+DATA shiftCounts410<>+16(SB)/4, $0x00000005
+DATA shiftCounts410<>+20(SB)/4, $0x00000005
+DATA shiftCounts410<>+24(SB)/4, $0x00000005
+DATA shiftCounts410<>+28(SB)/4, $0x00000005
+GLOBL shiftCounts410<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts411<>+0(SB)/4, $0x0000001a
+DATA shiftCounts411<>+4(SB)/4, $0x0000001a
+DATA shiftCounts411<>+8(SB)/4, $0x0000001a
+DATA shiftCounts411<>+12(SB)/4, $0x0000001a
+DATA shiftCounts411<>+16(SB)/4, $0x00000001
+DATA shiftCounts411<>+20(SB)/4, $0x00000001
+DATA shiftCounts411<>+24(SB)/4, $0x00000001
+DATA shiftCounts411<>+28(SB)/4, $0x00000001
+GLOBL shiftCounts411<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts412<>+0(SB)/4, $0x00000008
+DATA shiftCounts412<>+4(SB)/4, $0x00000008
+DATA shiftCounts412<>+8(SB)/4, $0x00000008
+DATA shiftCounts412<>+12(SB)/4, $0x00000008
+DATA shiftCounts412<>+16(SB)/4, $0x0000000f
+DATA shiftCounts412<>+20(SB)/4, $0x0000000f
+DATA shiftCounts412<>+24(SB)/4, $0x0000000f
+DATA shiftCounts412<>+28(SB)/4, $0x0000000f
+GLOBL shiftCounts412<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts413<>+0(SB)/4, $0x00000016
+DATA shiftCounts413<>+4(SB)/4, $0x00000016
+DATA shiftCounts413<>+8(SB)/4, $0x00000016
+DATA shiftCounts413<>+12(SB)/4, $0x00000016
+DATA shiftCounts413<>+16(SB)/4, $0x0000001d
+DATA shiftCounts413<>+20(SB)/4, $0x0000001d
+DATA shiftCounts413<>+24(SB)/4, $0x0000001d
+DATA shiftCounts413<>+28(SB)/4, $0x0000001d
+GLOBL shiftCounts413<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_8(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_8(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x000000ff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts128<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts414<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts128<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts414<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts128<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts414<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts128<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts414<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VBROADCASTI128 64(AX), Y1
+	VPSRLVD        shiftCounts128<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VBROADCASTI128 64(AX), Y1
+	VPSRLVD        shiftCounts414<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VBROADCASTI128 80(AX), Y1
+	VPSRLVD        shiftCounts128<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VBROADCASTI128 80(AX), Y1
+	VPSRLVD        shiftCounts414<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VBROADCASTI128 96(AX), Y1
+	VPSRLVD        shiftCounts128<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VBROADCASTI128 96(AX), Y1
+	VPSRLVD        shiftCounts414<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VBROADCASTI128 112(AX), Y1
+	VPSRLVD        shiftCounts128<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VBROADCASTI128 112(AX), Y1
+	VPSRLVD        shiftCounts414<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts414<>+0(SB)/4, $0x00000010
+DATA shiftCounts414<>+4(SB)/4, $0x00000010
+DATA shiftCounts414<>+8(SB)/4, $0x00000010
+DATA shiftCounts414<>+12(SB)/4, $0x00000010
+DATA shiftCounts414<>+16(SB)/4, $0x00000018
+DATA shiftCounts414<>+20(SB)/4, $0x00000018
+DATA shiftCounts414<>+24(SB)/4, $0x00000018
+DATA shiftCounts414<>+28(SB)/4, $0x00000018
+GLOBL shiftCounts414<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_9(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_9(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x000001ff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts264<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts415<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts409<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts416<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts417<>+0(SB), Y1, Y1
+	VBROADCASTI128 32(AX), Y2
+	VPSLLVD        shiftCounts392<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts269<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VMOVDQU        32(AX), Y1
+	VPSRLVD        shiftCounts418<>+0(SB), Y1, Y1
+	VBROADCASTI128 48(AX), Y2
+	VPSLLVD        shiftCounts6<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts419<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VMOVDQU        48(AX), Y1
+	VPSRLVD        shiftCounts420<>+0(SB), Y1, Y1
+	VBROADCASTI128 64(AX), Y2
+	VPSLLVD        shiftCounts2<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VBROADCASTI128 64(AX), Y1
+	VPSRLVD        shiftCounts421<>+0(SB), Y1, Y1
+	VBROADCASTI128 80(AX), Y2
+	VPSLLVD        shiftCounts422<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VBROADCASTI128 80(AX), Y1
+	VPSRLVD        shiftCounts423<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VBROADCASTI128 80(AX), Y1
+	VPSRLVD        shiftCounts424<>+0(SB), Y1, Y1
+	VBROADCASTI128 96(AX), Y2
+	VPSLLVD        shiftCounts401<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VBROADCASTI128 96(AX), Y1
+	VPSRLVD        shiftCounts277<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VMOVDQU        96(AX), Y1
+	VPSRLVD        shiftCounts425<>+0(SB), Y1, Y1
+	VBROADCASTI128 112(AX), Y2
+	VPSLLVD        shiftCounts8<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VBROADCASTI128 112(AX), Y1
+	VPSRLVD        shiftCounts426<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VMOVDQU        112(AX), Y1
+	VPSRLVD        shiftCounts427<>+0(SB), Y1, Y1
+	VBROADCASTI128 128(AX), Y2
+	VPSLLVD        shiftCounts4<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VBROADCASTI128 128(AX), Y1
+	VPSRLVD        shiftCounts428<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts415<>+0(SB)/4, $0x00000012
+DATA shiftCounts415<>+4(SB)/4, $0x00000012
+DATA shiftCounts415<>+8(SB)/4, $0x00000012
+DATA shiftCounts415<>+12(SB)/4, $0x00000012
+DATA shiftCounts415<>+16(SB)/4, $0x0000001b
+DATA shiftCounts415<>+20(SB)/4, $0x0000001b
+DATA shiftCounts415<>+24(SB)/4, $0x0000001b
+DATA shiftCounts415<>+28(SB)/4, $0x0000001b
+GLOBL shiftCounts415<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts416<>+0(SB)/4, $0x00000004
+DATA shiftCounts416<>+4(SB)/4, $0x00000004
+DATA shiftCounts416<>+8(SB)/4, $0x00000004
+DATA shiftCounts416<>+12(SB)/4, $0x00000004
+DATA shiftCounts416<>+16(SB)/4, $0x0000000d
+DATA shiftCounts416<>+20(SB)/4, $0x0000000d
+DATA shiftCounts416<>+24(SB)/4, $0x0000000d
+DATA shiftCounts416<>+28(SB)/4, $0x0000000d
+GLOBL shiftCounts416<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts417<>+0(SB)/4, $0x00000016
+DATA shiftCounts417<>+4(SB)/4, $0x00000016
+DATA shiftCounts417<>+8(SB)/4, $0x00000016
+DATA shiftCounts417<>+12(SB)/4, $0x00000016
+DATA shiftCounts417<>+16(SB)/4, $0x0000001f
+DATA shiftCounts417<>+20(SB)/4, $0x0000001f
+DATA shiftCounts417<>+24(SB)/4, $0x0000001f
+DATA shiftCounts417<>+28(SB)/4, $0x0000001f
+GLOBL shiftCounts417<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts418<>+0(SB)/4, $0x0000001a
+DATA shiftCounts418<>+4(SB)/4, $0x0000001a
+DATA shiftCounts418<>+8(SB)/4, $0x0000001a
+DATA shiftCounts418<>+12(SB)/4, $0x0000001a
+DATA shiftCounts418<>+16(SB)/4, $0x00000003
+DATA shiftCounts418<>+20(SB)/4, $0x00000003
+DATA shiftCounts418<>+24(SB)/4, $0x00000003
+DATA shiftCounts418<>+28(SB)/4, $0x00000003
+GLOBL shiftCounts418<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts419<>+0(SB)/4, $0x0000000c
+DATA shiftCounts419<>+4(SB)/4, $0x0000000c
+DATA shiftCounts419<>+8(SB)/4, $0x0000000c
+DATA shiftCounts419<>+12(SB)/4, $0x0000000c
+DATA shiftCounts419<>+16(SB)/4, $0x00000015
+DATA shiftCounts419<>+20(SB)/4, $0x00000015
+DATA shiftCounts419<>+24(SB)/4, $0x00000015
+DATA shiftCounts419<>+28(SB)/4, $0x00000015
+GLOBL shiftCounts419<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts420<>+0(SB)/4, $0x0000001e
+DATA shiftCounts420<>+4(SB)/4, $0x0000001e
+DATA shiftCounts420<>+8(SB)/4, $0x0000001e
+DATA shiftCounts420<>+12(SB)/4, $0x0000001e
+DATA shiftCounts420<>+16(SB)/4, $0x00000007
+DATA shiftCounts420<>+20(SB)/4, $0x00000007
+DATA shiftCounts420<>+24(SB)/4, $0x00000007
+DATA shiftCounts420<>+28(SB)/4, $0x00000007
+GLOBL shiftCounts420<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts421<>+0(SB)/4, $0x00000010
+DATA shiftCounts421<>+4(SB)/4, $0x00000010
+DATA shiftCounts421<>+8(SB)/4, $0x00000010
+DATA shiftCounts421<>+12(SB)/4, $0x00000010
+DATA shiftCounts421<>+16(SB)/4, $0x00000019
+DATA shiftCounts421<>+20(SB)/4, $0x00000019
+DATA shiftCounts421<>+24(SB)/4, $0x00000019
+DATA shiftCounts421<>+28(SB)/4, $0x00000019
+GLOBL shiftCounts421<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts422<>+0(SB)/4, $0x00000020
+DATA shiftCounts422<>+4(SB)/4, $0x00000020
+DATA shiftCounts422<>+8(SB)/4, $0x00000020
+DATA shiftCounts422<>+12(SB)/4, $0x00000020
+DATA shiftCounts422<>+16(SB)/4, $0x00000007
+DATA shiftCounts422<>+20(SB)/4, $0x00000007
+DATA shiftCounts422<>+24(SB)/4, $0x00000007
+DATA shiftCounts422<>+28(SB)/4, $0x00000007
+GLOBL shiftCounts422<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts423<>+0(SB)/4, $0x00000002
+DATA shiftCounts423<>+4(SB)/4, $0x00000002
+DATA shiftCounts423<>+8(SB)/4, $0x00000002
+DATA shiftCounts423<>+12(SB)/4, $0x00000002
+DATA shiftCounts423<>+16(SB)/4, $0x0000000b
+DATA shiftCounts423<>+20(SB)/4, $0x0000000b
+DATA shiftCounts423<>+24(SB)/4, $0x0000000b
+DATA shiftCounts423<>+28(SB)/4, $0x0000000b
+GLOBL shiftCounts423<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts424<>+0(SB)/4, $0x00000014
+DATA shiftCounts424<>+4(SB)/4, $0x00000014
+DATA shiftCounts424<>+8(SB)/4, $0x00000014
+DATA shiftCounts424<>+12(SB)/4, $0x00000014
+DATA shiftCounts424<>+16(SB)/4, $0x0000001d
+DATA shiftCounts424<>+20(SB)/4, $0x0000001d
+DATA shiftCounts424<>+24(SB)/4, $0x0000001d
+DATA shiftCounts424<>+28(SB)/4, $0x0000001d
+GLOBL shiftCounts424<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts425<>+0(SB)/4, $0x00000018
+DATA shiftCounts425<>+4(SB)/4, $0x00000018
+DATA shiftCounts425<>+8(SB)/4, $0x00000018
+DATA shiftCounts425<>+12(SB)/4, $0x00000018
+DATA shiftCounts425<>+16(SB)/4, $0x00000001
+DATA shiftCounts425<>+20(SB)/4, $0x00000001
+DATA shiftCounts425<>+24(SB)/4, $0x00000001
+DATA shiftCounts425<>+28(SB)/4, $0x00000001
+GLOBL shiftCounts425<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts426<>+0(SB)/4, $0x0000000a
+DATA shiftCounts426<>+4(SB)/4, $0x0000000a
+DATA shiftCounts426<>+8(SB)/4, $0x0000000a
+DATA shiftCounts426<>+12(SB)/4, $0x0000000a
+DATA shiftCounts426<>+16(SB)/4, $0x00000013
+DATA shiftCounts426<>+20(SB)/4, $0x00000013
+DATA shiftCounts426<>+24(SB)/4, $0x00000013
+DATA shiftCounts426<>+28(SB)/4, $0x00000013
+GLOBL shiftCounts426<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts427<>+0(SB)/4, $0x0000001c
+DATA shiftCounts427<>+4(SB)/4, $0x0000001c
+DATA shiftCounts427<>+8(SB)/4, $0x0000001c
+DATA shiftCounts427<>+12(SB)/4, $0x0000001c
+DATA shiftCounts427<>+16(SB)/4, $0x00000005
+DATA shiftCounts427<>+20(SB)/4, $0x00000005
+DATA shiftCounts427<>+24(SB)/4, $0x00000005
+DATA shiftCounts427<>+28(SB)/4, $0x00000005
+GLOBL shiftCounts427<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts428<>+0(SB)/4, $0x0000000e
+DATA shiftCounts428<>+4(SB)/4, $0x0000000e
+DATA shiftCounts428<>+8(SB)/4, $0x0000000e
+DATA shiftCounts428<>+12(SB)/4, $0x0000000e
+DATA shiftCounts428<>+16(SB)/4, $0x00000017
+DATA shiftCounts428<>+20(SB)/4, $0x00000017
+DATA shiftCounts428<>+24(SB)/4, $0x00000017
+DATA shiftCounts428<>+28(SB)/4, $0x00000017
+GLOBL shiftCounts428<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_10(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_10(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x000003ff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts108<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts429<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts403<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts258<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VMOVDQU        16(AX), Y1
+	VPSRLVD        shiftCounts430<>+0(SB), Y1, Y1
+	VBROADCASTI128 32(AX), Y2
+	VPSLLVD        shiftCounts4<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts159<>+0(SB), Y1, Y1
+	VBROADCASTI128 48(AX), Y2
+	VPSLLVD        shiftCounts431<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts153<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VMOVDQU        48(AX), Y1
+	VPSRLVD        shiftCounts432<>+0(SB), Y1, Y1
+	VBROADCASTI128 64(AX), Y2
+	VPSLLVD        shiftCounts8<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VBROADCASTI128 64(AX), Y1
+	VPSRLVD        shiftCounts433<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VBROADCASTI128 80(AX), Y1
+	VPSRLVD        shiftCounts108<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VBROADCASTI128 80(AX), Y1
+	VPSRLVD        shiftCounts429<>+0(SB), Y1, Y1
+	VBROADCASTI128 96(AX), Y2
+	VPSLLVD        shiftCounts403<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VBROADCASTI128 96(AX), Y1
+	VPSRLVD        shiftCounts258<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VMOVDQU        96(AX), Y1
+	VPSRLVD        shiftCounts430<>+0(SB), Y1, Y1
+	VBROADCASTI128 112(AX), Y2
+	VPSLLVD        shiftCounts4<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VBROADCASTI128 112(AX), Y1
+	VPSRLVD        shiftCounts159<>+0(SB), Y1, Y1
+	VBROADCASTI128 128(AX), Y2
+	VPSLLVD        shiftCounts431<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VBROADCASTI128 128(AX), Y1
+	VPSRLVD        shiftCounts153<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VMOVDQU        128(AX), Y1
+	VPSRLVD        shiftCounts432<>+0(SB), Y1, Y1
+	VBROADCASTI128 144(AX), Y2
+	VPSLLVD        shiftCounts8<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VBROADCASTI128 144(AX), Y1
+	VPSRLVD        shiftCounts433<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts429<>+0(SB)/4, $0x00000014
+DATA shiftCounts429<>+4(SB)/4, $0x00000014
+DATA shiftCounts429<>+8(SB)/4, $0x00000014
+DATA shiftCounts429<>+12(SB)/4, $0x00000014
+DATA shiftCounts429<>+16(SB)/4, $0x0000001e
+DATA shiftCounts429<>+20(SB)/4, $0x0000001e
+DATA shiftCounts429<>+24(SB)/4, $0x0000001e
+DATA shiftCounts429<>+28(SB)/4, $0x0000001e
+GLOBL shiftCounts429<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts430<>+0(SB)/4, $0x0000001c
+DATA shiftCounts430<>+4(SB)/4, $0x0000001c
+DATA shiftCounts430<>+8(SB)/4, $0x0000001c
+DATA shiftCounts430<>+12(SB)/4, $0x0000001c
+DATA shiftCounts430<>+16(SB)/4, $0x00000006
+DATA shiftCounts430<>+20(SB)/4, $0x00000006
+DATA shiftCounts430<>+24(SB)/4, $0x00000006
+DATA shiftCounts430<>+28(SB)/4, $0x00000006
+GLOBL shiftCounts430<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts431<>+0(SB)/4, $0x00000020
+DATA shiftCounts431<>+4(SB)/4, $0x00000020
+DATA shiftCounts431<>+8(SB)/4, $0x00000020
+DATA shiftCounts431<>+12(SB)/4, $0x00000020
+DATA shiftCounts431<>+16(SB)/4, $0x00000006
+DATA shiftCounts431<>+20(SB)/4, $0x00000006
+DATA shiftCounts431<>+24(SB)/4, $0x00000006
+DATA shiftCounts431<>+28(SB)/4, $0x00000006
+GLOBL shiftCounts431<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts432<>+0(SB)/4, $0x00000018
+DATA shiftCounts432<>+4(SB)/4, $0x00000018
+DATA shiftCounts432<>+8(SB)/4, $0x00000018
+DATA shiftCounts432<>+12(SB)/4, $0x00000018
+DATA shiftCounts432<>+16(SB)/4, $0x00000002
+DATA shiftCounts432<>+20(SB)/4, $0x00000002
+DATA shiftCounts432<>+24(SB)/4, $0x00000002
+DATA shiftCounts432<>+28(SB)/4, $0x00000002
+GLOBL shiftCounts432<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts433<>+0(SB)/4, $0x0000000c
+DATA shiftCounts433<>+4(SB)/4, $0x0000000c
+DATA shiftCounts433<>+8(SB)/4, $0x0000000c
+DATA shiftCounts433<>+12(SB)/4, $0x0000000c
+DATA shiftCounts433<>+16(SB)/4, $0x00000016
+DATA shiftCounts433<>+20(SB)/4, $0x00000016
+DATA shiftCounts433<>+24(SB)/4, $0x00000016
+DATA shiftCounts433<>+28(SB)/4, $0x00000016
+GLOBL shiftCounts433<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_11(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_11(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x000007ff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts228<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VMOVDQU        (AX), Y1
+	VPSRLVD        shiftCounts434<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts10<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts435<>+0(SB), Y1, Y1
+	VBROADCASTI128 32(AX), Y2
+	VPSLLVD        shiftCounts436<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts232<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VMOVDQU        32(AX), Y1
+	VPSRLVD        shiftCounts437<>+0(SB), Y1, Y1
+	VBROADCASTI128 48(AX), Y2
+	VPSLLVD        shiftCounts8<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts438<>+0(SB), Y1, Y1
+	VBROADCASTI128 64(AX), Y2
+	VPSLLVD        shiftCounts422<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VBROADCASTI128 64(AX), Y1
+	VPSRLVD        shiftCounts236<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VMOVDQU        64(AX), Y1
+	VPSRLVD        shiftCounts439<>+0(SB), Y1, Y1
+	VBROADCASTI128 80(AX), Y2
+	VPSLLVD        shiftCounts6<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VBROADCASTI128 80(AX), Y1
+	VPSRLVD        shiftCounts440<>+0(SB), Y1, Y1
+	VBROADCASTI128 96(AX), Y2
+	VPSLLVD        shiftCounts409<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VBROADCASTI128 96(AX), Y1
+	VPSRLVD        shiftCounts242<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VMOVDQU        96(AX), Y1
+	VPSRLVD        shiftCounts441<>+0(SB), Y1, Y1
+	VBROADCASTI128 112(AX), Y2
+	VPSLLVD        shiftCounts4<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VBROADCASTI128 112(AX), Y1
+	VPSRLVD        shiftCounts442<>+0(SB), Y1, Y1
+	VBROADCASTI128 128(AX), Y2
+	VPSLLVD        shiftCounts401<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VBROADCASTI128 128(AX), Y1
+	VPSRLVD        shiftCounts246<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VMOVDQU        128(AX), Y1
+	VPSRLVD        shiftCounts443<>+0(SB), Y1, Y1
+	VBROADCASTI128 144(AX), Y2
+	VPSLLVD        shiftCounts2<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VBROADCASTI128 144(AX), Y1
+	VPSRLVD        shiftCounts444<>+0(SB), Y1, Y1
+	VBROADCASTI128 160(AX), Y2
+	VPSLLVD        shiftCounts392<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VBROADCASTI128 160(AX), Y1
+	VPSRLVD        shiftCounts445<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts434<>+0(SB)/4, $0x00000016
+DATA shiftCounts434<>+4(SB)/4, $0x00000016
+DATA shiftCounts434<>+8(SB)/4, $0x00000016
+DATA shiftCounts434<>+12(SB)/4, $0x00000016
+DATA shiftCounts434<>+16(SB)/4, $0x00000001
+DATA shiftCounts434<>+20(SB)/4, $0x00000001
+DATA shiftCounts434<>+24(SB)/4, $0x00000001
+DATA shiftCounts434<>+28(SB)/4, $0x00000001
+GLOBL shiftCounts434<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts435<>+0(SB)/4, $0x0000000c
+DATA shiftCounts435<>+4(SB)/4, $0x0000000c
+DATA shiftCounts435<>+8(SB)/4, $0x0000000c
+DATA shiftCounts435<>+12(SB)/4, $0x0000000c
+DATA shiftCounts435<>+16(SB)/4, $0x00000017
+DATA shiftCounts435<>+20(SB)/4, $0x00000017
+DATA shiftCounts435<>+24(SB)/4, $0x00000017
+DATA shiftCounts435<>+28(SB)/4, $0x00000017
+GLOBL shiftCounts435<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts436<>+0(SB)/4, $0x00000020
+DATA shiftCounts436<>+4(SB)/4, $0x00000020
+DATA shiftCounts436<>+8(SB)/4, $0x00000020
+DATA shiftCounts436<>+12(SB)/4, $0x00000020
+DATA shiftCounts436<>+16(SB)/4, $0x00000009
+DATA shiftCounts436<>+20(SB)/4, $0x00000009
+DATA shiftCounts436<>+24(SB)/4, $0x00000009
+DATA shiftCounts436<>+28(SB)/4, $0x00000009
+GLOBL shiftCounts436<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts437<>+0(SB)/4, $0x00000018
+DATA shiftCounts437<>+4(SB)/4, $0x00000018
+DATA shiftCounts437<>+8(SB)/4, $0x00000018
+DATA shiftCounts437<>+12(SB)/4, $0x00000018
+DATA shiftCounts437<>+16(SB)/4, $0x00000003
+DATA shiftCounts437<>+20(SB)/4, $0x00000003
+DATA shiftCounts437<>+24(SB)/4, $0x00000003
+DATA shiftCounts437<>+28(SB)/4, $0x00000003
+GLOBL shiftCounts437<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts438<>+0(SB)/4, $0x0000000e
+DATA shiftCounts438<>+4(SB)/4, $0x0000000e
+DATA shiftCounts438<>+8(SB)/4, $0x0000000e
+DATA shiftCounts438<>+12(SB)/4, $0x0000000e
+DATA shiftCounts438<>+16(SB)/4, $0x00000019
+DATA shiftCounts438<>+20(SB)/4, $0x00000019
+DATA shiftCounts438<>+24(SB)/4, $0x00000019
+DATA shiftCounts438<>+28(SB)/4, $0x00000019
+GLOBL shiftCounts438<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts439<>+0(SB)/4, $0x0000001a
+DATA shiftCounts439<>+4(SB)/4, $0x0000001a
+DATA shiftCounts439<>+8(SB)/4, $0x0000001a
+DATA shiftCounts439<>+12(SB)/4, $0x0000001a
+DATA shiftCounts439<>+16(SB)/4, $0x00000005
+DATA shiftCounts439<>+20(SB)/4, $0x00000005
+DATA shiftCounts439<>+24(SB)/4, $0x00000005
+DATA shiftCounts439<>+28(SB)/4, $0x00000005
+GLOBL shiftCounts439<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts440<>+0(SB)/4, $0x00000010
+DATA shiftCounts440<>+4(SB)/4, $0x00000010
+DATA shiftCounts440<>+8(SB)/4, $0x00000010
+DATA shiftCounts440<>+12(SB)/4, $0x00000010
+DATA shiftCounts440<>+16(SB)/4, $0x0000001b
+DATA shiftCounts440<>+20(SB)/4, $0x0000001b
+DATA shiftCounts440<>+24(SB)/4, $0x0000001b
+DATA shiftCounts440<>+28(SB)/4, $0x0000001b
+GLOBL shiftCounts440<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts441<>+0(SB)/4, $0x0000001c
+DATA shiftCounts441<>+4(SB)/4, $0x0000001c
+DATA shiftCounts441<>+8(SB)/4, $0x0000001c
+DATA shiftCounts441<>+12(SB)/4, $0x0000001c
+DATA shiftCounts441<>+16(SB)/4, $0x00000007
+DATA shiftCounts441<>+20(SB)/4, $0x00000007
+DATA shiftCounts441<>+24(SB)/4, $0x00000007
+DATA shiftCounts441<>+28(SB)/4, $0x00000007
+GLOBL shiftCounts441<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts442<>+0(SB)/4, $0x00000012
+DATA shiftCounts442<>+4(SB)/4, $0x00000012
+DATA shiftCounts442<>+8(SB)/4, $0x00000012
+DATA shiftCounts442<>+12(SB)/4, $0x00000012
+DATA shiftCounts442<>+16(SB)/4, $0x0000001d
+DATA shiftCounts442<>+20(SB)/4, $0x0000001d
+DATA shiftCounts442<>+24(SB)/4, $0x0000001d
+DATA shiftCounts442<>+28(SB)/4, $0x0000001d
+GLOBL shiftCounts442<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts443<>+0(SB)/4, $0x0000001e
+DATA shiftCounts443<>+4(SB)/4, $0x0000001e
+DATA shiftCounts443<>+8(SB)/4, $0x0000001e
+DATA shiftCounts443<>+12(SB)/4, $0x0000001e
+DATA shiftCounts443<>+16(SB)/4, $0x00000009
+DATA shiftCounts443<>+20(SB)/4, $0x00000009
+DATA shiftCounts443<>+24(SB)/4, $0x00000009
+DATA shiftCounts443<>+28(SB)/4, $0x00000009
+GLOBL shiftCounts443<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts444<>+0(SB)/4, $0x00000014
+DATA shiftCounts444<>+4(SB)/4, $0x00000014
+DATA shiftCounts444<>+8(SB)/4, $0x00000014
+DATA shiftCounts444<>+12(SB)/4, $0x00000014
+DATA shiftCounts444<>+16(SB)/4, $0x0000001f
+DATA shiftCounts444<>+20(SB)/4, $0x0000001f
+DATA shiftCounts444<>+24(SB)/4, $0x0000001f
+DATA shiftCounts444<>+28(SB)/4, $0x0000001f
+GLOBL shiftCounts444<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts445<>+0(SB)/4, $0x0000000a
+DATA shiftCounts445<>+4(SB)/4, $0x0000000a
+DATA shiftCounts445<>+8(SB)/4, $0x0000000a
+DATA shiftCounts445<>+12(SB)/4, $0x0000000a
+DATA shiftCounts445<>+16(SB)/4, $0x00000015
+DATA shiftCounts445<>+20(SB)/4, $0x00000015
+DATA shiftCounts445<>+24(SB)/4, $0x00000015
+DATA shiftCounts445<>+28(SB)/4, $0x00000015
+GLOBL shiftCounts445<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_12(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_12(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x00000fff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts220<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VMOVDQU        (AX), Y1
+	VPSRLVD        shiftCounts300<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts8<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts446<>+0(SB), Y1, Y1
+	VBROADCASTI128 32(AX), Y2
+	VPSLLVD        shiftCounts447<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts254<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts220<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VMOVDQU        48(AX), Y1
+	VPSRLVD        shiftCounts300<>+0(SB), Y1, Y1
+	VBROADCASTI128 64(AX), Y2
+	VPSLLVD        shiftCounts8<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VBROADCASTI128 64(AX), Y1
+	VPSRLVD        shiftCounts446<>+0(SB), Y1, Y1
+	VBROADCASTI128 80(AX), Y2
+	VPSLLVD        shiftCounts447<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VBROADCASTI128 80(AX), Y1
+	VPSRLVD        shiftCounts254<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VBROADCASTI128 96(AX), Y1
+	VPSRLVD        shiftCounts220<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VMOVDQU        96(AX), Y1
+	VPSRLVD        shiftCounts300<>+0(SB), Y1, Y1
+	VBROADCASTI128 112(AX), Y2
+	VPSLLVD        shiftCounts8<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VBROADCASTI128 112(AX), Y1
+	VPSRLVD        shiftCounts446<>+0(SB), Y1, Y1
+	VBROADCASTI128 128(AX), Y2
+	VPSLLVD        shiftCounts447<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VBROADCASTI128 128(AX), Y1
+	VPSRLVD        shiftCounts254<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VBROADCASTI128 144(AX), Y1
+	VPSRLVD        shiftCounts220<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VMOVDQU        144(AX), Y1
+	VPSRLVD        shiftCounts300<>+0(SB), Y1, Y1
+	VBROADCASTI128 160(AX), Y2
+	VPSLLVD        shiftCounts8<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VBROADCASTI128 160(AX), Y1
+	VPSRLVD        shiftCounts446<>+0(SB), Y1, Y1
+	VBROADCASTI128 176(AX), Y2
+	VPSLLVD        shiftCounts447<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VBROADCASTI128 176(AX), Y1
+	VPSRLVD        shiftCounts254<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts446<>+0(SB)/4, $0x00000010
+DATA shiftCounts446<>+4(SB)/4, $0x00000010
+DATA shiftCounts446<>+8(SB)/4, $0x00000010
+DATA shiftCounts446<>+12(SB)/4, $0x00000010
+DATA shiftCounts446<>+16(SB)/4, $0x0000001c
+DATA shiftCounts446<>+20(SB)/4, $0x0000001c
+DATA shiftCounts446<>+24(SB)/4, $0x0000001c
+DATA shiftCounts446<>+28(SB)/4, $0x0000001c
+GLOBL shiftCounts446<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts447<>+0(SB)/4, $0x00000020
+DATA shiftCounts447<>+4(SB)/4, $0x00000020
+DATA shiftCounts447<>+8(SB)/4, $0x00000020
+DATA shiftCounts447<>+12(SB)/4, $0x00000020
+DATA shiftCounts447<>+16(SB)/4, $0x00000004
+DATA shiftCounts447<>+20(SB)/4, $0x00000004
+DATA shiftCounts447<>+24(SB)/4, $0x00000004
+DATA shiftCounts447<>+28(SB)/4, $0x00000004
+GLOBL shiftCounts447<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_13(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_13(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x00001fff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts197<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VMOVDQU        (AX), Y1
+	VPSRLVD        shiftCounts448<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts6<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VMOVDQU        16(AX), Y1
+	VPSRLVD        shiftCounts449<>+0(SB), Y1, Y1
+	VBROADCASTI128 32(AX), Y2
+	VPSLLVD        shiftCounts12<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts450<>+0(SB), Y1, Y1
+	VBROADCASTI128 48(AX), Y2
+	VPSLLVD        shiftCounts409<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts451<>+0(SB), Y1, Y1
+	VBROADCASTI128 64(AX), Y2
+	VPSLLVD        shiftCounts452<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VBROADCASTI128 64(AX), Y1
+	VPSRLVD        shiftCounts205<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VMOVDQU        64(AX), Y1
+	VPSRLVD        shiftCounts453<>+0(SB), Y1, Y1
+	VBROADCASTI128 80(AX), Y2
+	VPSLLVD        shiftCounts4<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VMOVDQU        80(AX), Y1
+	VPSRLVD        shiftCounts454<>+0(SB), Y1, Y1
+	VBROADCASTI128 96(AX), Y2
+	VPSLLVD        shiftCounts10<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VBROADCASTI128 96(AX), Y1
+	VPSRLVD        shiftCounts455<>+0(SB), Y1, Y1
+	VBROADCASTI128 112(AX), Y2
+	VPSLLVD        shiftCounts401<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VBROADCASTI128 112(AX), Y1
+	VPSRLVD        shiftCounts456<>+0(SB), Y1, Y1
+	VBROADCASTI128 128(AX), Y2
+	VPSLLVD        shiftCounts436<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VBROADCASTI128 128(AX), Y1
+	VPSRLVD        shiftCounts212<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VMOVDQU        128(AX), Y1
+	VPSRLVD        shiftCounts457<>+0(SB), Y1, Y1
+	VBROADCASTI128 144(AX), Y2
+	VPSLLVD        shiftCounts2<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VMOVDQU        144(AX), Y1
+	VPSRLVD        shiftCounts458<>+0(SB), Y1, Y1
+	VBROADCASTI128 160(AX), Y2
+	VPSLLVD        shiftCounts8<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VBROADCASTI128 160(AX), Y1
+	VPSRLVD        shiftCounts459<>+0(SB), Y1, Y1
+	VBROADCASTI128 176(AX), Y2
+	VPSLLVD        shiftCounts392<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VBROADCASTI128 176(AX), Y1
+	VPSRLVD        shiftCounts460<>+0(SB), Y1, Y1
+	VBROADCASTI128 192(AX), Y2
+	VPSLLVD        shiftCounts422<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VBROADCASTI128 192(AX), Y1
+	VPSRLVD        shiftCounts461<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts448<>+0(SB)/4, $0x0000001a
+DATA shiftCounts448<>+4(SB)/4, $0x0000001a
+DATA shiftCounts448<>+8(SB)/4, $0x0000001a
+DATA shiftCounts448<>+12(SB)/4, $0x0000001a
+DATA shiftCounts448<>+16(SB)/4, $0x00000007
+DATA shiftCounts448<>+20(SB)/4, $0x00000007
+DATA shiftCounts448<>+24(SB)/4, $0x00000007
+DATA shiftCounts448<>+28(SB)/4, $0x00000007
+GLOBL shiftCounts448<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts449<>+0(SB)/4, $0x00000014
+DATA shiftCounts449<>+4(SB)/4, $0x00000014
+DATA shiftCounts449<>+8(SB)/4, $0x00000014
+DATA shiftCounts449<>+12(SB)/4, $0x00000014
+DATA shiftCounts449<>+16(SB)/4, $0x00000001
+DATA shiftCounts449<>+20(SB)/4, $0x00000001
+DATA shiftCounts449<>+24(SB)/4, $0x00000001
+DATA shiftCounts449<>+28(SB)/4, $0x00000001
+GLOBL shiftCounts449<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts450<>+0(SB)/4, $0x0000000e
+DATA shiftCounts450<>+4(SB)/4, $0x0000000e
+DATA shiftCounts450<>+8(SB)/4, $0x0000000e
+DATA shiftCounts450<>+12(SB)/4, $0x0000000e
+DATA shiftCounts450<>+16(SB)/4, $0x0000001b
+DATA shiftCounts450<>+20(SB)/4, $0x0000001b
+DATA shiftCounts450<>+24(SB)/4, $0x0000001b
+DATA shiftCounts450<>+28(SB)/4, $0x0000001b
+GLOBL shiftCounts450<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts451<>+0(SB)/4, $0x00000008
+DATA shiftCounts451<>+4(SB)/4, $0x00000008
+DATA shiftCounts451<>+8(SB)/4, $0x00000008
+DATA shiftCounts451<>+12(SB)/4, $0x00000008
+DATA shiftCounts451<>+16(SB)/4, $0x00000015
+DATA shiftCounts451<>+20(SB)/4, $0x00000015
+DATA shiftCounts451<>+24(SB)/4, $0x00000015
+DATA shiftCounts451<>+28(SB)/4, $0x00000015
+GLOBL shiftCounts451<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts452<>+0(SB)/4, $0x00000020
+DATA shiftCounts452<>+4(SB)/4, $0x00000020
+DATA shiftCounts452<>+8(SB)/4, $0x00000020
+DATA shiftCounts452<>+12(SB)/4, $0x00000020
+DATA shiftCounts452<>+16(SB)/4, $0x0000000b
+DATA shiftCounts452<>+20(SB)/4, $0x0000000b
+DATA shiftCounts452<>+24(SB)/4, $0x0000000b
+DATA shiftCounts452<>+28(SB)/4, $0x0000000b
+GLOBL shiftCounts452<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts453<>+0(SB)/4, $0x0000001c
+DATA shiftCounts453<>+4(SB)/4, $0x0000001c
+DATA shiftCounts453<>+8(SB)/4, $0x0000001c
+DATA shiftCounts453<>+12(SB)/4, $0x0000001c
+DATA shiftCounts453<>+16(SB)/4, $0x00000009
+DATA shiftCounts453<>+20(SB)/4, $0x00000009
+DATA shiftCounts453<>+24(SB)/4, $0x00000009
+DATA shiftCounts453<>+28(SB)/4, $0x00000009
+GLOBL shiftCounts453<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts454<>+0(SB)/4, $0x00000016
+DATA shiftCounts454<>+4(SB)/4, $0x00000016
+DATA shiftCounts454<>+8(SB)/4, $0x00000016
+DATA shiftCounts454<>+12(SB)/4, $0x00000016
+DATA shiftCounts454<>+16(SB)/4, $0x00000003
+DATA shiftCounts454<>+20(SB)/4, $0x00000003
+DATA shiftCounts454<>+24(SB)/4, $0x00000003
+DATA shiftCounts454<>+28(SB)/4, $0x00000003
+GLOBL shiftCounts454<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts455<>+0(SB)/4, $0x00000010
+DATA shiftCounts455<>+4(SB)/4, $0x00000010
+DATA shiftCounts455<>+8(SB)/4, $0x00000010
+DATA shiftCounts455<>+12(SB)/4, $0x00000010
+DATA shiftCounts455<>+16(SB)/4, $0x0000001d
+DATA shiftCounts455<>+20(SB)/4, $0x0000001d
+DATA shiftCounts455<>+24(SB)/4, $0x0000001d
+DATA shiftCounts455<>+28(SB)/4, $0x0000001d
+GLOBL shiftCounts455<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts456<>+0(SB)/4, $0x0000000a
+DATA shiftCounts456<>+4(SB)/4, $0x0000000a
+DATA shiftCounts456<>+8(SB)/4, $0x0000000a
+DATA shiftCounts456<>+12(SB)/4, $0x0000000a
+DATA shiftCounts456<>+16(SB)/4, $0x00000017
+DATA shiftCounts456<>+20(SB)/4, $0x00000017
+DATA shiftCounts456<>+24(SB)/4, $0x00000017
+DATA shiftCounts456<>+28(SB)/4, $0x00000017
+GLOBL shiftCounts456<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts457<>+0(SB)/4, $0x0000001e
+DATA shiftCounts457<>+4(SB)/4, $0x0000001e
+DATA shiftCounts457<>+8(SB)/4, $0x0000001e
+DATA shiftCounts457<>+12(SB)/4, $0x0000001e
+DATA shiftCounts457<>+16(SB)/4, $0x0000000b
+DATA shiftCounts457<>+20(SB)/4, $0x0000000b
+DATA shiftCounts457<>+24(SB)/4, $0x0000000b
+DATA shiftCounts457<>+28(SB)/4, $0x0000000b
+GLOBL shiftCounts457<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts458<>+0(SB)/4, $0x00000018
+DATA shiftCounts458<>+4(SB)/4, $0x00000018
+DATA shiftCounts458<>+8(SB)/4, $0x00000018
+DATA shiftCounts458<>+12(SB)/4, $0x00000018
+DATA shiftCounts458<>+16(SB)/4, $0x00000005
+DATA shiftCounts458<>+20(SB)/4, $0x00000005
+DATA shiftCounts458<>+24(SB)/4, $0x00000005
+DATA shiftCounts458<>+28(SB)/4, $0x00000005
+GLOBL shiftCounts458<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts459<>+0(SB)/4, $0x00000012
+DATA shiftCounts459<>+4(SB)/4, $0x00000012
+DATA shiftCounts459<>+8(SB)/4, $0x00000012
+DATA shiftCounts459<>+12(SB)/4, $0x00000012
+DATA shiftCounts459<>+16(SB)/4, $0x0000001f
+DATA shiftCounts459<>+20(SB)/4, $0x0000001f
+DATA shiftCounts459<>+24(SB)/4, $0x0000001f
+DATA shiftCounts459<>+28(SB)/4, $0x0000001f
+GLOBL shiftCounts459<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts460<>+0(SB)/4, $0x0000000c
+DATA shiftCounts460<>+4(SB)/4, $0x0000000c
+DATA shiftCounts460<>+8(SB)/4, $0x0000000c
+DATA shiftCounts460<>+12(SB)/4, $0x0000000c
+DATA shiftCounts460<>+16(SB)/4, $0x00000019
+DATA shiftCounts460<>+20(SB)/4, $0x00000019
+DATA shiftCounts460<>+24(SB)/4, $0x00000019
+DATA shiftCounts460<>+28(SB)/4, $0x00000019
+GLOBL shiftCounts460<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts461<>+0(SB)/4, $0x00000006
+DATA shiftCounts461<>+4(SB)/4, $0x00000006
+DATA shiftCounts461<>+8(SB)/4, $0x00000006
+DATA shiftCounts461<>+12(SB)/4, $0x00000006
+DATA shiftCounts461<>+16(SB)/4, $0x00000013
+DATA shiftCounts461<>+20(SB)/4, $0x00000013
+DATA shiftCounts461<>+24(SB)/4, $0x00000013
+DATA shiftCounts461<>+28(SB)/4, $0x00000013
+GLOBL shiftCounts461<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_14(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_14(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x00003fff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts186<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VMOVDQU        (AX), Y1
+	VPSRLVD        shiftCounts462<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts4<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VMOVDQU        16(AX), Y1
+	VPSRLVD        shiftCounts463<>+0(SB), Y1, Y1
+	VBROADCASTI128 32(AX), Y2
+	VPSLLVD        shiftCounts8<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VMOVDQU        32(AX), Y1
+	VPSRLVD        shiftCounts290<>+0(SB), Y1, Y1
+	VBROADCASTI128 48(AX), Y2
+	VPSLLVD        shiftCounts12<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts464<>+0(SB), Y1, Y1
+	VBROADCASTI128 64(AX), Y2
+	VPSLLVD        shiftCounts403<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VBROADCASTI128 64(AX), Y1
+	VPSRLVD        shiftCounts465<>+0(SB), Y1, Y1
+	VBROADCASTI128 80(AX), Y2
+	VPSLLVD        shiftCounts431<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VBROADCASTI128 80(AX), Y1
+	VPSRLVD        shiftCounts466<>+0(SB), Y1, Y1
+	VBROADCASTI128 96(AX), Y2
+	VPSLLVD        shiftCounts467<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VBROADCASTI128 96(AX), Y1
+	VPSRLVD        shiftCounts282<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VBROADCASTI128 112(AX), Y1
+	VPSRLVD        shiftCounts186<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VMOVDQU        112(AX), Y1
+	VPSRLVD        shiftCounts462<>+0(SB), Y1, Y1
+	VBROADCASTI128 128(AX), Y2
+	VPSLLVD        shiftCounts4<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VMOVDQU        128(AX), Y1
+	VPSRLVD        shiftCounts463<>+0(SB), Y1, Y1
+	VBROADCASTI128 144(AX), Y2
+	VPSLLVD        shiftCounts8<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VMOVDQU        144(AX), Y1
+	VPSRLVD        shiftCounts290<>+0(SB), Y1, Y1
+	VBROADCASTI128 160(AX), Y2
+	VPSLLVD        shiftCounts12<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VBROADCASTI128 160(AX), Y1
+	VPSRLVD        shiftCounts464<>+0(SB), Y1, Y1
+	VBROADCASTI128 176(AX), Y2
+	VPSLLVD        shiftCounts403<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VBROADCASTI128 176(AX), Y1
+	VPSRLVD        shiftCounts465<>+0(SB), Y1, Y1
+	VBROADCASTI128 192(AX), Y2
+	VPSLLVD        shiftCounts431<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VBROADCASTI128 192(AX), Y1
+	VPSRLVD        shiftCounts466<>+0(SB), Y1, Y1
+	VBROADCASTI128 208(AX), Y2
+	VPSLLVD        shiftCounts467<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VBROADCASTI128 208(AX), Y1
+	VPSRLVD        shiftCounts282<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts462<>+0(SB)/4, $0x0000001c
+DATA shiftCounts462<>+4(SB)/4, $0x0000001c
+DATA shiftCounts462<>+8(SB)/4, $0x0000001c
+DATA shiftCounts462<>+12(SB)/4, $0x0000001c
+DATA shiftCounts462<>+16(SB)/4, $0x0000000a
+DATA shiftCounts462<>+20(SB)/4, $0x0000000a
+DATA shiftCounts462<>+24(SB)/4, $0x0000000a
+DATA shiftCounts462<>+28(SB)/4, $0x0000000a
+GLOBL shiftCounts462<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts463<>+0(SB)/4, $0x00000018
+DATA shiftCounts463<>+4(SB)/4, $0x00000018
+DATA shiftCounts463<>+8(SB)/4, $0x00000018
+DATA shiftCounts463<>+12(SB)/4, $0x00000018
+DATA shiftCounts463<>+16(SB)/4, $0x00000006
+DATA shiftCounts463<>+20(SB)/4, $0x00000006
+DATA shiftCounts463<>+24(SB)/4, $0x00000006
+DATA shiftCounts463<>+28(SB)/4, $0x00000006
+GLOBL shiftCounts463<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts464<>+0(SB)/4, $0x00000010
+DATA shiftCounts464<>+4(SB)/4, $0x00000010
+DATA shiftCounts464<>+8(SB)/4, $0x00000010
+DATA shiftCounts464<>+12(SB)/4, $0x00000010
+DATA shiftCounts464<>+16(SB)/4, $0x0000001e
+DATA shiftCounts464<>+20(SB)/4, $0x0000001e
+DATA shiftCounts464<>+24(SB)/4, $0x0000001e
+DATA shiftCounts464<>+28(SB)/4, $0x0000001e
+GLOBL shiftCounts464<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts465<>+0(SB)/4, $0x0000000c
+DATA shiftCounts465<>+4(SB)/4, $0x0000000c
+DATA shiftCounts465<>+8(SB)/4, $0x0000000c
+DATA shiftCounts465<>+12(SB)/4, $0x0000000c
+DATA shiftCounts465<>+16(SB)/4, $0x0000001a
+DATA shiftCounts465<>+20(SB)/4, $0x0000001a
+DATA shiftCounts465<>+24(SB)/4, $0x0000001a
+DATA shiftCounts465<>+28(SB)/4, $0x0000001a
+GLOBL shiftCounts465<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts466<>+0(SB)/4, $0x00000008
+DATA shiftCounts466<>+4(SB)/4, $0x00000008
+DATA shiftCounts466<>+8(SB)/4, $0x00000008
+DATA shiftCounts466<>+12(SB)/4, $0x00000008
+DATA shiftCounts466<>+16(SB)/4, $0x00000016
+DATA shiftCounts466<>+20(SB)/4, $0x00000016
+DATA shiftCounts466<>+24(SB)/4, $0x00000016
+DATA shiftCounts466<>+28(SB)/4, $0x00000016
+GLOBL shiftCounts466<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts467<>+0(SB)/4, $0x00000020
+DATA shiftCounts467<>+4(SB)/4, $0x00000020
+DATA shiftCounts467<>+8(SB)/4, $0x00000020
+DATA shiftCounts467<>+12(SB)/4, $0x00000020
+DATA shiftCounts467<>+16(SB)/4, $0x0000000a
+DATA shiftCounts467<>+20(SB)/4, $0x0000000a
+DATA shiftCounts467<>+24(SB)/4, $0x0000000a
+DATA shiftCounts467<>+28(SB)/4, $0x0000000a
+GLOBL shiftCounts467<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_15(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_15(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x00007fff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts170<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VMOVDQU        (AX), Y1
+	VPSRLVD        shiftCounts468<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts2<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VMOVDQU        16(AX), Y1
+	VPSRLVD        shiftCounts469<>+0(SB), Y1, Y1
+	VBROADCASTI128 32(AX), Y2
+	VPSLLVD        shiftCounts4<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VMOVDQU        32(AX), Y1
+	VPSRLVD        shiftCounts470<>+0(SB), Y1, Y1
+	VBROADCASTI128 48(AX), Y2
+	VPSLLVD        shiftCounts6<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VMOVDQU        48(AX), Y1
+	VPSRLVD        shiftCounts471<>+0(SB), Y1, Y1
+	VBROADCASTI128 64(AX), Y2
+	VPSLLVD        shiftCounts8<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VMOVDQU        64(AX), Y1
+	VPSRLVD        shiftCounts472<>+0(SB), Y1, Y1
+	VBROADCASTI128 80(AX), Y2
+	VPSLLVD        shiftCounts10<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VMOVDQU        80(AX), Y1
+	VPSRLVD        shiftCounts473<>+0(SB), Y1, Y1
+	VBROADCASTI128 96(AX), Y2
+	VPSLLVD        shiftCounts12<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VMOVDQU        96(AX), Y1
+	VPSRLVD        shiftCounts474<>+0(SB), Y1, Y1
+	VBROADCASTI128 112(AX), Y2
+	VPSLLVD        shiftCounts14<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VBROADCASTI128 112(AX), Y1
+	VPSRLVD        shiftCounts475<>+0(SB), Y1, Y1
+	VBROADCASTI128 128(AX), Y2
+	VPSLLVD        shiftCounts392<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VBROADCASTI128 128(AX), Y1
+	VPSRLVD        shiftCounts476<>+0(SB), Y1, Y1
+	VBROADCASTI128 144(AX), Y2
+	VPSLLVD        shiftCounts401<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VBROADCASTI128 144(AX), Y1
+	VPSRLVD        shiftCounts477<>+0(SB), Y1, Y1
+	VBROADCASTI128 160(AX), Y2
+	VPSLLVD        shiftCounts409<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VBROADCASTI128 160(AX), Y1
+	VPSRLVD        shiftCounts478<>+0(SB), Y1, Y1
+	VBROADCASTI128 176(AX), Y2
+	VPSLLVD        shiftCounts422<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VBROADCASTI128 176(AX), Y1
+	VPSRLVD        shiftCounts479<>+0(SB), Y1, Y1
+	VBROADCASTI128 192(AX), Y2
+	VPSLLVD        shiftCounts436<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VBROADCASTI128 192(AX), Y1
+	VPSRLVD        shiftCounts480<>+0(SB), Y1, Y1
+	VBROADCASTI128 208(AX), Y2
+	VPSLLVD        shiftCounts452<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VBROADCASTI128 208(AX), Y1
+	VPSRLVD        shiftCounts481<>+0(SB), Y1, Y1
+	VBROADCASTI128 224(AX), Y2
+	VPSLLVD        shiftCounts482<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VBROADCASTI128 224(AX), Y1
+	VPSRLVD        shiftCounts483<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts468<>+0(SB)/4, $0x0000001e
+DATA shiftCounts468<>+4(SB)/4, $0x0000001e
+DATA shiftCounts468<>+8(SB)/4, $0x0000001e
+DATA shiftCounts468<>+12(SB)/4, $0x0000001e
+DATA shiftCounts468<>+16(SB)/4, $0x0000000d
+DATA shiftCounts468<>+20(SB)/4, $0x0000000d
+DATA shiftCounts468<>+24(SB)/4, $0x0000000d
+DATA shiftCounts468<>+28(SB)/4, $0x0000000d
+GLOBL shiftCounts468<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts469<>+0(SB)/4, $0x0000001c
+DATA shiftCounts469<>+4(SB)/4, $0x0000001c
+DATA shiftCounts469<>+8(SB)/4, $0x0000001c
+DATA shiftCounts469<>+12(SB)/4, $0x0000001c
+DATA shiftCounts469<>+16(SB)/4, $0x0000000b
+DATA shiftCounts469<>+20(SB)/4, $0x0000000b
+DATA shiftCounts469<>+24(SB)/4, $0x0000000b
+DATA shiftCounts469<>+28(SB)/4, $0x0000000b
+GLOBL shiftCounts469<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts470<>+0(SB)/4, $0x0000001a
+DATA shiftCounts470<>+4(SB)/4, $0x0000001a
+DATA shiftCounts470<>+8(SB)/4, $0x0000001a
+DATA shiftCounts470<>+12(SB)/4, $0x0000001a
+DATA shiftCounts470<>+16(SB)/4, $0x00000009
+DATA shiftCounts470<>+20(SB)/4, $0x00000009
+DATA shiftCounts470<>+24(SB)/4, $0x00000009
+DATA shiftCounts470<>+28(SB)/4, $0x00000009
+GLOBL shiftCounts470<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts471<>+0(SB)/4, $0x00000018
+DATA shiftCounts471<>+4(SB)/4, $0x00000018
+DATA shiftCounts471<>+8(SB)/4, $0x00000018
+DATA shiftCounts471<>+12(SB)/4, $0x00000018
+DATA shiftCounts471<>+16(SB)/4, $0x00000007
+DATA shiftCounts471<>+20(SB)/4, $0x00000007
+DATA shiftCounts471<>+24(SB)/4, $0x00000007
+DATA shiftCounts471<>+28(SB)/4, $0x00000007
+GLOBL shiftCounts471<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts472<>+0(SB)/4, $0x00000016
+DATA shiftCounts472<>+4(SB)/4, $0x00000016
+DATA shiftCounts472<>+8(SB)/4, $0x00000016
+DATA shiftCounts472<>+12(SB)/4, $0x00000016
+DATA shiftCounts472<>+16(SB)/4, $0x00000005
+DATA shiftCounts472<>+20(SB)/4, $0x00000005
+DATA shiftCounts472<>+24(SB)/4, $0x00000005
+DATA shiftCounts472<>+28(SB)/4, $0x00000005
+GLOBL shiftCounts472<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts473<>+0(SB)/4, $0x00000014
+DATA shiftCounts473<>+4(SB)/4, $0x00000014
+DATA shiftCounts473<>+8(SB)/4, $0x00000014
+DATA shiftCounts473<>+12(SB)/4, $0x00000014
+DATA shiftCounts473<>+16(SB)/4, $0x00000003
+DATA shiftCounts473<>+20(SB)/4, $0x00000003
+DATA shiftCounts473<>+24(SB)/4, $0x00000003
+DATA shiftCounts473<>+28(SB)/4, $0x00000003
+GLOBL shiftCounts473<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts474<>+0(SB)/4, $0x00000012
+DATA shiftCounts474<>+4(SB)/4, $0x00000012
+DATA shiftCounts474<>+8(SB)/4, $0x00000012
+DATA shiftCounts474<>+12(SB)/4, $0x00000012
+DATA shiftCounts474<>+16(SB)/4, $0x00000001
+DATA shiftCounts474<>+20(SB)/4, $0x00000001
+DATA shiftCounts474<>+24(SB)/4, $0x00000001
+DATA shiftCounts474<>+28(SB)/4, $0x00000001
+GLOBL shiftCounts474<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts475<>+0(SB)/4, $0x00000010
+DATA shiftCounts475<>+4(SB)/4, $0x00000010
+DATA shiftCounts475<>+8(SB)/4, $0x00000010
+DATA shiftCounts475<>+12(SB)/4, $0x00000010
+DATA shiftCounts475<>+16(SB)/4, $0x0000001f
+DATA shiftCounts475<>+20(SB)/4, $0x0000001f
+DATA shiftCounts475<>+24(SB)/4, $0x0000001f
+DATA shiftCounts475<>+28(SB)/4, $0x0000001f
+GLOBL shiftCounts475<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts476<>+0(SB)/4, $0x0000000e
+DATA shiftCounts476<>+4(SB)/4, $0x0000000e
+DATA shiftCounts476<>+8(SB)/4, $0x0000000e
+DATA shiftCounts476<>+12(SB)/4, $0x0000000e
+DATA shiftCounts476<>+16(SB)/4, $0x0000001d
+DATA shiftCounts476<>+20(SB)/4, $0x0000001d
+DATA shiftCounts476<>+24(SB)/4, $0x0000001d
+DATA shiftCounts476<>+28(SB)/4, $0x0000001d
+GLOBL shiftCounts476<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts477<>+0(SB)/4, $0x0000000c
+DATA shiftCounts477<>+4(SB)/4, $0x0000000c
+DATA shiftCounts477<>+8(SB)/4, $0x0000000c
+DATA shiftCounts477<>+12(SB)/4, $0x0000000c
+DATA shiftCounts477<>+16(SB)/4, $0x0000001b
+DATA shiftCounts477<>+20(SB)/4, $0x0000001b
+DATA shiftCounts477<>+24(SB)/4, $0x0000001b
+DATA shiftCounts477<>+28(SB)/4, $0x0000001b
+GLOBL shiftCounts477<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts478<>+0(SB)/4, $0x0000000a
+DATA shiftCounts478<>+4(SB)/4, $0x0000000a
+DATA shiftCounts478<>+8(SB)/4, $0x0000000a
+DATA shiftCounts478<>+12(SB)/4, $0x0000000a
+DATA shiftCounts478<>+16(SB)/4, $0x00000019
+DATA shiftCounts478<>+20(SB)/4, $0x00000019
+DATA shiftCounts478<>+24(SB)/4, $0x00000019
+DATA shiftCounts478<>+28(SB)/4, $0x00000019
+GLOBL shiftCounts478<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts479<>+0(SB)/4, $0x00000008
+DATA shiftCounts479<>+4(SB)/4, $0x00000008
+DATA shiftCounts479<>+8(SB)/4, $0x00000008
+DATA shiftCounts479<>+12(SB)/4, $0x00000008
+DATA shiftCounts479<>+16(SB)/4, $0x00000017
+DATA shiftCounts479<>+20(SB)/4, $0x00000017
+DATA shiftCounts479<>+24(SB)/4, $0x00000017
+DATA shiftCounts479<>+28(SB)/4, $0x00000017
+GLOBL shiftCounts479<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts480<>+0(SB)/4, $0x00000006
+DATA shiftCounts480<>+4(SB)/4, $0x00000006
+DATA shiftCounts480<>+8(SB)/4, $0x00000006
+DATA shiftCounts480<>+12(SB)/4, $0x00000006
+DATA shiftCounts480<>+16(SB)/4, $0x00000015
+DATA shiftCounts480<>+20(SB)/4, $0x00000015
+DATA shiftCounts480<>+24(SB)/4, $0x00000015
+DATA shiftCounts480<>+28(SB)/4, $0x00000015
+GLOBL shiftCounts480<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts481<>+0(SB)/4, $0x00000004
+DATA shiftCounts481<>+4(SB)/4, $0x00000004
+DATA shiftCounts481<>+8(SB)/4, $0x00000004
+DATA shiftCounts481<>+12(SB)/4, $0x00000004
+DATA shiftCounts481<>+16(SB)/4, $0x00000013
+DATA shiftCounts481<>+20(SB)/4, $0x00000013
+DATA shiftCounts481<>+24(SB)/4, $0x00000013
+DATA shiftCounts481<>+28(SB)/4, $0x00000013
+GLOBL shiftCounts481<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts482<>+0(SB)/4, $0x00000020
+DATA shiftCounts482<>+4(SB)/4, $0x00000020
+DATA shiftCounts482<>+8(SB)/4, $0x00000020
+DATA shiftCounts482<>+12(SB)/4, $0x00000020
+DATA shiftCounts482<>+16(SB)/4, $0x0000000d
+DATA shiftCounts482<>+20(SB)/4, $0x0000000d
+DATA shiftCounts482<>+24(SB)/4, $0x0000000d
+DATA shiftCounts482<>+28(SB)/4, $0x0000000d
+GLOBL shiftCounts482<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts483<>+0(SB)/4, $0x00000002
+DATA shiftCounts483<>+4(SB)/4, $0x00000002
+DATA shiftCounts483<>+8(SB)/4, $0x00000002
+DATA shiftCounts483<>+12(SB)/4, $0x00000002
+DATA shiftCounts483<>+16(SB)/4, $0x00000011
+DATA shiftCounts483<>+20(SB)/4, $0x00000011
+DATA shiftCounts483<>+24(SB)/4, $0x00000011
+DATA shiftCounts483<>+28(SB)/4, $0x00000011
+GLOBL shiftCounts483<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_16(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_16(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x0000ffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts484<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts484<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts484<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts484<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VBROADCASTI128 64(AX), Y1
+	VPSRLVD        shiftCounts484<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VBROADCASTI128 80(AX), Y1
+	VPSRLVD        shiftCounts484<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VBROADCASTI128 96(AX), Y1
+	VPSRLVD        shiftCounts484<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VBROADCASTI128 112(AX), Y1
+	VPSRLVD        shiftCounts484<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VBROADCASTI128 128(AX), Y1
+	VPSRLVD        shiftCounts484<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VBROADCASTI128 144(AX), Y1
+	VPSRLVD        shiftCounts484<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VBROADCASTI128 160(AX), Y1
+	VPSRLVD        shiftCounts484<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VBROADCASTI128 176(AX), Y1
+	VPSRLVD        shiftCounts484<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VBROADCASTI128 192(AX), Y1
+	VPSRLVD        shiftCounts484<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VBROADCASTI128 208(AX), Y1
+	VPSRLVD        shiftCounts484<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VBROADCASTI128 224(AX), Y1
+	VPSRLVD        shiftCounts484<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VBROADCASTI128 240(AX), Y1
+	VPSRLVD        shiftCounts484<>+0(SB), Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts484<>+0(SB)/4, $0x00000000
+DATA shiftCounts484<>+4(SB)/4, $0x00000000
+DATA shiftCounts484<>+8(SB)/4, $0x00000000
+DATA shiftCounts484<>+12(SB)/4, $0x00000000
+DATA shiftCounts484<>+16(SB)/4, $0x00000010
+DATA shiftCounts484<>+20(SB)/4, $0x00000010
+DATA shiftCounts484<>+24(SB)/4, $0x00000010
+DATA shiftCounts484<>+28(SB)/4, $0x00000010
+GLOBL shiftCounts484<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_17(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_17(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x0001ffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts485<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts486<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts487<>+0(SB), Y1, Y1
+	VBROADCASTI128 32(AX), Y2
+	VPSLLVD        shiftCounts482<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts488<>+0(SB), Y1, Y1
+	VBROADCASTI128 48(AX), Y2
+	VPSLLVD        shiftCounts452<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts489<>+0(SB), Y1, Y1
+	VBROADCASTI128 64(AX), Y2
+	VPSLLVD        shiftCounts436<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VBROADCASTI128 64(AX), Y1
+	VPSRLVD        shiftCounts490<>+0(SB), Y1, Y1
+	VBROADCASTI128 80(AX), Y2
+	VPSLLVD        shiftCounts422<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VBROADCASTI128 80(AX), Y1
+	VPSRLVD        shiftCounts491<>+0(SB), Y1, Y1
+	VBROADCASTI128 96(AX), Y2
+	VPSLLVD        shiftCounts409<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VBROADCASTI128 96(AX), Y1
+	VPSRLVD        shiftCounts492<>+0(SB), Y1, Y1
+	VBROADCASTI128 112(AX), Y2
+	VPSLLVD        shiftCounts401<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VBROADCASTI128 112(AX), Y1
+	VPSRLVD        shiftCounts493<>+0(SB), Y1, Y1
+	VBROADCASTI128 128(AX), Y2
+	VPSLLVD        shiftCounts392<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VMOVDQU        128(AX), Y1
+	VPSRLVD        shiftCounts178<>+0(SB), Y1, Y1
+	VBROADCASTI128 144(AX), Y2
+	VPSLLVD        shiftCounts16<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VMOVDQU        144(AX), Y1
+	VPSRLVD        shiftCounts494<>+0(SB), Y1, Y1
+	VBROADCASTI128 160(AX), Y2
+	VPSLLVD        shiftCounts14<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VMOVDQU        160(AX), Y1
+	VPSRLVD        shiftCounts495<>+0(SB), Y1, Y1
+	VBROADCASTI128 176(AX), Y2
+	VPSLLVD        shiftCounts12<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VMOVDQU        176(AX), Y1
+	VPSRLVD        shiftCounts496<>+0(SB), Y1, Y1
+	VBROADCASTI128 192(AX), Y2
+	VPSLLVD        shiftCounts10<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VMOVDQU        192(AX), Y1
+	VPSRLVD        shiftCounts497<>+0(SB), Y1, Y1
+	VBROADCASTI128 208(AX), Y2
+	VPSLLVD        shiftCounts8<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VMOVDQU        208(AX), Y1
+	VPSRLVD        shiftCounts498<>+0(SB), Y1, Y1
+	VBROADCASTI128 224(AX), Y2
+	VPSLLVD        shiftCounts6<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VMOVDQU        224(AX), Y1
+	VPSRLVD        shiftCounts499<>+0(SB), Y1, Y1
+	VBROADCASTI128 240(AX), Y2
+	VPSLLVD        shiftCounts4<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VMOVDQU        240(AX), Y1
+	VPSRLVD        shiftCounts500<>+0(SB), Y1, Y1
+	VBROADCASTI128 256(AX), Y2
+	VPSLLVD        shiftCounts2<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts485<>+0(SB)/4, $0x00000000
+DATA shiftCounts485<>+4(SB)/4, $0x00000000
+DATA shiftCounts485<>+8(SB)/4, $0x00000000
+DATA shiftCounts485<>+12(SB)/4, $0x00000000
+DATA shiftCounts485<>+16(SB)/4, $0x00000011
+DATA shiftCounts485<>+20(SB)/4, $0x00000011
+DATA shiftCounts485<>+24(SB)/4, $0x00000011
+DATA shiftCounts485<>+28(SB)/4, $0x00000011
+GLOBL shiftCounts485<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts486<>+0(SB)/4, $0x00000020
+DATA shiftCounts486<>+4(SB)/4, $0x00000020
+DATA shiftCounts486<>+8(SB)/4, $0x00000020
+DATA shiftCounts486<>+12(SB)/4, $0x00000020
+DATA shiftCounts486<>+16(SB)/4, $0x0000000f
+DATA shiftCounts486<>+20(SB)/4, $0x0000000f
+DATA shiftCounts486<>+24(SB)/4, $0x0000000f
+DATA shiftCounts486<>+28(SB)/4, $0x0000000f
+GLOBL shiftCounts486<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts487<>+0(SB)/4, $0x00000002
+DATA shiftCounts487<>+4(SB)/4, $0x00000002
+DATA shiftCounts487<>+8(SB)/4, $0x00000002
+DATA shiftCounts487<>+12(SB)/4, $0x00000002
+DATA shiftCounts487<>+16(SB)/4, $0x00000013
+DATA shiftCounts487<>+20(SB)/4, $0x00000013
+DATA shiftCounts487<>+24(SB)/4, $0x00000013
+DATA shiftCounts487<>+28(SB)/4, $0x00000013
+GLOBL shiftCounts487<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts488<>+0(SB)/4, $0x00000004
+DATA shiftCounts488<>+4(SB)/4, $0x00000004
+DATA shiftCounts488<>+8(SB)/4, $0x00000004
+DATA shiftCounts488<>+12(SB)/4, $0x00000004
+DATA shiftCounts488<>+16(SB)/4, $0x00000015
+DATA shiftCounts488<>+20(SB)/4, $0x00000015
+DATA shiftCounts488<>+24(SB)/4, $0x00000015
+DATA shiftCounts488<>+28(SB)/4, $0x00000015
+GLOBL shiftCounts488<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts489<>+0(SB)/4, $0x00000006
+DATA shiftCounts489<>+4(SB)/4, $0x00000006
+DATA shiftCounts489<>+8(SB)/4, $0x00000006
+DATA shiftCounts489<>+12(SB)/4, $0x00000006
+DATA shiftCounts489<>+16(SB)/4, $0x00000017
+DATA shiftCounts489<>+20(SB)/4, $0x00000017
+DATA shiftCounts489<>+24(SB)/4, $0x00000017
+DATA shiftCounts489<>+28(SB)/4, $0x00000017
+GLOBL shiftCounts489<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts490<>+0(SB)/4, $0x00000008
+DATA shiftCounts490<>+4(SB)/4, $0x00000008
+DATA shiftCounts490<>+8(SB)/4, $0x00000008
+DATA shiftCounts490<>+12(SB)/4, $0x00000008
+DATA shiftCounts490<>+16(SB)/4, $0x00000019
+DATA shiftCounts490<>+20(SB)/4, $0x00000019
+DATA shiftCounts490<>+24(SB)/4, $0x00000019
+DATA shiftCounts490<>+28(SB)/4, $0x00000019
+GLOBL shiftCounts490<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts491<>+0(SB)/4, $0x0000000a
+DATA shiftCounts491<>+4(SB)/4, $0x0000000a
+DATA shiftCounts491<>+8(SB)/4, $0x0000000a
+DATA shiftCounts491<>+12(SB)/4, $0x0000000a
+DATA shiftCounts491<>+16(SB)/4, $0x0000001b
+DATA shiftCounts491<>+20(SB)/4, $0x0000001b
+DATA shiftCounts491<>+24(SB)/4, $0x0000001b
+DATA shiftCounts491<>+28(SB)/4, $0x0000001b
+GLOBL shiftCounts491<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts492<>+0(SB)/4, $0x0000000c
+DATA shiftCounts492<>+4(SB)/4, $0x0000000c
+DATA shiftCounts492<>+8(SB)/4, $0x0000000c
+DATA shiftCounts492<>+12(SB)/4, $0x0000000c
+DATA shiftCounts492<>+16(SB)/4, $0x0000001d
+DATA shiftCounts492<>+20(SB)/4, $0x0000001d
+DATA shiftCounts492<>+24(SB)/4, $0x0000001d
+DATA shiftCounts492<>+28(SB)/4, $0x0000001d
+GLOBL shiftCounts492<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts493<>+0(SB)/4, $0x0000000e
+DATA shiftCounts493<>+4(SB)/4, $0x0000000e
+DATA shiftCounts493<>+8(SB)/4, $0x0000000e
+DATA shiftCounts493<>+12(SB)/4, $0x0000000e
+DATA shiftCounts493<>+16(SB)/4, $0x0000001f
+DATA shiftCounts493<>+20(SB)/4, $0x0000001f
+DATA shiftCounts493<>+24(SB)/4, $0x0000001f
+DATA shiftCounts493<>+28(SB)/4, $0x0000001f
+GLOBL shiftCounts493<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts494<>+0(SB)/4, $0x00000012
+DATA shiftCounts494<>+4(SB)/4, $0x00000012
+DATA shiftCounts494<>+8(SB)/4, $0x00000012
+DATA shiftCounts494<>+12(SB)/4, $0x00000012
+DATA shiftCounts494<>+16(SB)/4, $0x00000003
+DATA shiftCounts494<>+20(SB)/4, $0x00000003
+DATA shiftCounts494<>+24(SB)/4, $0x00000003
+DATA shiftCounts494<>+28(SB)/4, $0x00000003
+GLOBL shiftCounts494<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts495<>+0(SB)/4, $0x00000014
+DATA shiftCounts495<>+4(SB)/4, $0x00000014
+DATA shiftCounts495<>+8(SB)/4, $0x00000014
+DATA shiftCounts495<>+12(SB)/4, $0x00000014
+DATA shiftCounts495<>+16(SB)/4, $0x00000005
+DATA shiftCounts495<>+20(SB)/4, $0x00000005
+DATA shiftCounts495<>+24(SB)/4, $0x00000005
+DATA shiftCounts495<>+28(SB)/4, $0x00000005
+GLOBL shiftCounts495<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts496<>+0(SB)/4, $0x00000016
+DATA shiftCounts496<>+4(SB)/4, $0x00000016
+DATA shiftCounts496<>+8(SB)/4, $0x00000016
+DATA shiftCounts496<>+12(SB)/4, $0x00000016
+DATA shiftCounts496<>+16(SB)/4, $0x00000007
+DATA shiftCounts496<>+20(SB)/4, $0x00000007
+DATA shiftCounts496<>+24(SB)/4, $0x00000007
+DATA shiftCounts496<>+28(SB)/4, $0x00000007
+GLOBL shiftCounts496<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts497<>+0(SB)/4, $0x00000018
+DATA shiftCounts497<>+4(SB)/4, $0x00000018
+DATA shiftCounts497<>+8(SB)/4, $0x00000018
+DATA shiftCounts497<>+12(SB)/4, $0x00000018
+DATA shiftCounts497<>+16(SB)/4, $0x00000009
+DATA shiftCounts497<>+20(SB)/4, $0x00000009
+DATA shiftCounts497<>+24(SB)/4, $0x00000009
+DATA shiftCounts497<>+28(SB)/4, $0x00000009
+GLOBL shiftCounts497<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts498<>+0(SB)/4, $0x0000001a
+DATA shiftCounts498<>+4(SB)/4, $0x0000001a
+DATA shiftCounts498<>+8(SB)/4, $0x0000001a
+DATA shiftCounts498<>+12(SB)/4, $0x0000001a
+DATA shiftCounts498<>+16(SB)/4, $0x0000000b
+DATA shiftCounts498<>+20(SB)/4, $0x0000000b
+DATA shiftCounts498<>+24(SB)/4, $0x0000000b
+DATA shiftCounts498<>+28(SB)/4, $0x0000000b
+GLOBL shiftCounts498<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts499<>+0(SB)/4, $0x0000001c
+DATA shiftCounts499<>+4(SB)/4, $0x0000001c
+DATA shiftCounts499<>+8(SB)/4, $0x0000001c
+DATA shiftCounts499<>+12(SB)/4, $0x0000001c
+DATA shiftCounts499<>+16(SB)/4, $0x0000000d
+DATA shiftCounts499<>+20(SB)/4, $0x0000000d
+DATA shiftCounts499<>+24(SB)/4, $0x0000000d
+DATA shiftCounts499<>+28(SB)/4, $0x0000000d
+GLOBL shiftCounts499<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts500<>+0(SB)/4, $0x0000001e
+DATA shiftCounts500<>+4(SB)/4, $0x0000001e
+DATA shiftCounts500<>+8(SB)/4, $0x0000001e
+DATA shiftCounts500<>+12(SB)/4, $0x0000001e
+DATA shiftCounts500<>+16(SB)/4, $0x0000000f
+DATA shiftCounts500<>+20(SB)/4, $0x0000000f
+DATA shiftCounts500<>+24(SB)/4, $0x0000000f
+DATA shiftCounts500<>+28(SB)/4, $0x0000000f
+GLOBL shiftCounts500<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_18(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_18(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x0003ffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts501<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts502<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts503<>+0(SB), Y1, Y1
+	VBROADCASTI128 32(AX), Y2
+	VPSLLVD        shiftCounts467<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts504<>+0(SB), Y1, Y1
+	VBROADCASTI128 48(AX), Y2
+	VPSLLVD        shiftCounts431<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts505<>+0(SB), Y1, Y1
+	VBROADCASTI128 64(AX), Y2
+	VPSLLVD        shiftCounts403<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VMOVDQU        64(AX), Y1
+	VPSRLVD        shiftCounts190<>+0(SB), Y1, Y1
+	VBROADCASTI128 80(AX), Y2
+	VPSLLVD        shiftCounts16<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VMOVDQU        80(AX), Y1
+	VPSRLVD        shiftCounts506<>+0(SB), Y1, Y1
+	VBROADCASTI128 96(AX), Y2
+	VPSLLVD        shiftCounts12<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VMOVDQU        96(AX), Y1
+	VPSRLVD        shiftCounts507<>+0(SB), Y1, Y1
+	VBROADCASTI128 112(AX), Y2
+	VPSLLVD        shiftCounts8<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VMOVDQU        112(AX), Y1
+	VPSRLVD        shiftCounts508<>+0(SB), Y1, Y1
+	VBROADCASTI128 128(AX), Y2
+	VPSLLVD        shiftCounts4<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VBROADCASTI128 144(AX), Y1
+	VPSRLVD        shiftCounts501<>+0(SB), Y1, Y1
+	VBROADCASTI128 160(AX), Y2
+	VPSLLVD        shiftCounts502<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VBROADCASTI128 160(AX), Y1
+	VPSRLVD        shiftCounts503<>+0(SB), Y1, Y1
+	VBROADCASTI128 176(AX), Y2
+	VPSLLVD        shiftCounts467<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VBROADCASTI128 176(AX), Y1
+	VPSRLVD        shiftCounts504<>+0(SB), Y1, Y1
+	VBROADCASTI128 192(AX), Y2
+	VPSLLVD        shiftCounts431<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VBROADCASTI128 192(AX), Y1
+	VPSRLVD        shiftCounts505<>+0(SB), Y1, Y1
+	VBROADCASTI128 208(AX), Y2
+	VPSLLVD        shiftCounts403<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VMOVDQU        208(AX), Y1
+	VPSRLVD        shiftCounts190<>+0(SB), Y1, Y1
+	VBROADCASTI128 224(AX), Y2
+	VPSLLVD        shiftCounts16<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VMOVDQU        224(AX), Y1
+	VPSRLVD        shiftCounts506<>+0(SB), Y1, Y1
+	VBROADCASTI128 240(AX), Y2
+	VPSLLVD        shiftCounts12<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VMOVDQU        240(AX), Y1
+	VPSRLVD        shiftCounts507<>+0(SB), Y1, Y1
+	VBROADCASTI128 256(AX), Y2
+	VPSLLVD        shiftCounts8<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VMOVDQU        256(AX), Y1
+	VPSRLVD        shiftCounts508<>+0(SB), Y1, Y1
+	VBROADCASTI128 272(AX), Y2
+	VPSLLVD        shiftCounts4<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts501<>+0(SB)/4, $0x00000000
+DATA shiftCounts501<>+4(SB)/4, $0x00000000
+DATA shiftCounts501<>+8(SB)/4, $0x00000000
+DATA shiftCounts501<>+12(SB)/4, $0x00000000
+DATA shiftCounts501<>+16(SB)/4, $0x00000012
+DATA shiftCounts501<>+20(SB)/4, $0x00000012
+DATA shiftCounts501<>+24(SB)/4, $0x00000012
+DATA shiftCounts501<>+28(SB)/4, $0x00000012
+GLOBL shiftCounts501<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts502<>+0(SB)/4, $0x00000020
+DATA shiftCounts502<>+4(SB)/4, $0x00000020
+DATA shiftCounts502<>+8(SB)/4, $0x00000020
+DATA shiftCounts502<>+12(SB)/4, $0x00000020
+DATA shiftCounts502<>+16(SB)/4, $0x0000000e
+DATA shiftCounts502<>+20(SB)/4, $0x0000000e
+DATA shiftCounts502<>+24(SB)/4, $0x0000000e
+DATA shiftCounts502<>+28(SB)/4, $0x0000000e
+GLOBL shiftCounts502<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts503<>+0(SB)/4, $0x00000004
+DATA shiftCounts503<>+4(SB)/4, $0x00000004
+DATA shiftCounts503<>+8(SB)/4, $0x00000004
+DATA shiftCounts503<>+12(SB)/4, $0x00000004
+DATA shiftCounts503<>+16(SB)/4, $0x00000016
+DATA shiftCounts503<>+20(SB)/4, $0x00000016
+DATA shiftCounts503<>+24(SB)/4, $0x00000016
+DATA shiftCounts503<>+28(SB)/4, $0x00000016
+GLOBL shiftCounts503<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts504<>+0(SB)/4, $0x00000008
+DATA shiftCounts504<>+4(SB)/4, $0x00000008
+DATA shiftCounts504<>+8(SB)/4, $0x00000008
+DATA shiftCounts504<>+12(SB)/4, $0x00000008
+DATA shiftCounts504<>+16(SB)/4, $0x0000001a
+DATA shiftCounts504<>+20(SB)/4, $0x0000001a
+DATA shiftCounts504<>+24(SB)/4, $0x0000001a
+DATA shiftCounts504<>+28(SB)/4, $0x0000001a
+GLOBL shiftCounts504<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts505<>+0(SB)/4, $0x0000000c
+DATA shiftCounts505<>+4(SB)/4, $0x0000000c
+DATA shiftCounts505<>+8(SB)/4, $0x0000000c
+DATA shiftCounts505<>+12(SB)/4, $0x0000000c
+DATA shiftCounts505<>+16(SB)/4, $0x0000001e
+DATA shiftCounts505<>+20(SB)/4, $0x0000001e
+DATA shiftCounts505<>+24(SB)/4, $0x0000001e
+DATA shiftCounts505<>+28(SB)/4, $0x0000001e
+GLOBL shiftCounts505<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts506<>+0(SB)/4, $0x00000014
+DATA shiftCounts506<>+4(SB)/4, $0x00000014
+DATA shiftCounts506<>+8(SB)/4, $0x00000014
+DATA shiftCounts506<>+12(SB)/4, $0x00000014
+DATA shiftCounts506<>+16(SB)/4, $0x00000006
+DATA shiftCounts506<>+20(SB)/4, $0x00000006
+DATA shiftCounts506<>+24(SB)/4, $0x00000006
+DATA shiftCounts506<>+28(SB)/4, $0x00000006
+GLOBL shiftCounts506<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts507<>+0(SB)/4, $0x00000018
+DATA shiftCounts507<>+4(SB)/4, $0x00000018
+DATA shiftCounts507<>+8(SB)/4, $0x00000018
+DATA shiftCounts507<>+12(SB)/4, $0x00000018
+DATA shiftCounts507<>+16(SB)/4, $0x0000000a
+DATA shiftCounts507<>+20(SB)/4, $0x0000000a
+DATA shiftCounts507<>+24(SB)/4, $0x0000000a
+DATA shiftCounts507<>+28(SB)/4, $0x0000000a
+GLOBL shiftCounts507<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts508<>+0(SB)/4, $0x0000001c
+DATA shiftCounts508<>+4(SB)/4, $0x0000001c
+DATA shiftCounts508<>+8(SB)/4, $0x0000001c
+DATA shiftCounts508<>+12(SB)/4, $0x0000001c
+DATA shiftCounts508<>+16(SB)/4, $0x0000000e
+DATA shiftCounts508<>+20(SB)/4, $0x0000000e
+DATA shiftCounts508<>+24(SB)/4, $0x0000000e
+DATA shiftCounts508<>+28(SB)/4, $0x0000000e
+GLOBL shiftCounts508<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_19(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_19(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x0007ffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts509<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts482<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts510<>+0(SB), Y1, Y1
+	VBROADCASTI128 32(AX), Y2
+	VPSLLVD        shiftCounts422<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VBROADCASTI128 32(AX), Y1
+	VPSRLVD        shiftCounts511<>+0(SB), Y1, Y1
+	VBROADCASTI128 48(AX), Y2
+	VPSLLVD        shiftCounts392<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VMOVDQU        48(AX), Y1
+	VPSRLVD        shiftCounts512<>+0(SB), Y1, Y1
+	VBROADCASTI128 64(AX), Y2
+	VPSLLVD        shiftCounts14<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VMOVDQU        64(AX), Y1
+	VPSRLVD        shiftCounts513<>+0(SB), Y1, Y1
+	VBROADCASTI128 80(AX), Y2
+	VPSLLVD        shiftCounts8<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VMOVDQU        80(AX), Y1
+	VPSRLVD        shiftCounts514<>+0(SB), Y1, Y1
+	VMOVDQU        96(AX), Y2
+	VPSLLVD        shiftCounts205<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VBROADCASTI128 112(AX), Y1
+	VPSRLVD        shiftCounts515<>+0(SB), Y1, Y1
+	VBROADCASTI128 128(AX), Y2
+	VPSLLVD        shiftCounts436<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VBROADCASTI128 128(AX), Y1
+	VPSRLVD        shiftCounts516<>+0(SB), Y1, Y1
+	VBROADCASTI128 144(AX), Y2
+	VPSLLVD        shiftCounts401<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VMOVDQU        144(AX), Y1
+	VPSRLVD        shiftCounts517<>+0(SB), Y1, Y1
+	VBROADCASTI128 160(AX), Y2
+	VPSLLVD        shiftCounts16<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VMOVDQU        160(AX), Y1
+	VPSRLVD        shiftCounts518<>+0(SB), Y1, Y1
+	VBROADCASTI128 176(AX), Y2
+	VPSLLVD        shiftCounts10<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VMOVDQU        176(AX), Y1
+	VPSRLVD        shiftCounts519<>+0(SB), Y1, Y1
+	VMOVDQU        192(AX), Y2
+	VPSLLVD        shiftCounts212<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VBROADCASTI128 208(AX), Y1
+	VPSRLVD        shiftCounts520<>+0(SB), Y1, Y1
+	VBROADCASTI128 224(AX), Y2
+	VPSLLVD        shiftCounts452<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VBROADCASTI128 224(AX), Y1
+	VPSRLVD        shiftCounts521<>+0(SB), Y1, Y1
+	VBROADCASTI128 240(AX), Y2
+	VPSLLVD        shiftCounts409<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VMOVDQU        240(AX), Y1
+	VPSRLVD        shiftCounts522<>+0(SB), Y1, Y1
+	VBROADCASTI128 256(AX), Y2
+	VPSLLVD        shiftCounts18<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VMOVDQU        256(AX), Y1
+	VPSRLVD        shiftCounts523<>+0(SB), Y1, Y1
+	VBROADCASTI128 272(AX), Y2
+	VPSLLVD        shiftCounts12<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VMOVDQU        272(AX), Y1
+	VPSRLVD        shiftCounts524<>+0(SB), Y1, Y1
+	VBROADCASTI128 288(AX), Y2
+	VPSLLVD        shiftCounts6<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts509<>+0(SB)/4, $0x00000000
+DATA shiftCounts509<>+4(SB)/4, $0x00000000
+DATA shiftCounts509<>+8(SB)/4, $0x00000000
+DATA shiftCounts509<>+12(SB)/4, $0x00000000
+DATA shiftCounts509<>+16(SB)/4, $0x00000013
+DATA shiftCounts509<>+20(SB)/4, $0x00000013
+DATA shiftCounts509<>+24(SB)/4, $0x00000013
+DATA shiftCounts509<>+28(SB)/4, $0x00000013
+GLOBL shiftCounts509<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts510<>+0(SB)/4, $0x00000006
+DATA shiftCounts510<>+4(SB)/4, $0x00000006
+DATA shiftCounts510<>+8(SB)/4, $0x00000006
+DATA shiftCounts510<>+12(SB)/4, $0x00000006
+DATA shiftCounts510<>+16(SB)/4, $0x00000019
+DATA shiftCounts510<>+20(SB)/4, $0x00000019
+DATA shiftCounts510<>+24(SB)/4, $0x00000019
+DATA shiftCounts510<>+28(SB)/4, $0x00000019
+GLOBL shiftCounts510<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts511<>+0(SB)/4, $0x0000000c
+DATA shiftCounts511<>+4(SB)/4, $0x0000000c
+DATA shiftCounts511<>+8(SB)/4, $0x0000000c
+DATA shiftCounts511<>+12(SB)/4, $0x0000000c
+DATA shiftCounts511<>+16(SB)/4, $0x0000001f
+DATA shiftCounts511<>+20(SB)/4, $0x0000001f
+DATA shiftCounts511<>+24(SB)/4, $0x0000001f
+DATA shiftCounts511<>+28(SB)/4, $0x0000001f
+GLOBL shiftCounts511<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts512<>+0(SB)/4, $0x00000012
+DATA shiftCounts512<>+4(SB)/4, $0x00000012
+DATA shiftCounts512<>+8(SB)/4, $0x00000012
+DATA shiftCounts512<>+12(SB)/4, $0x00000012
+DATA shiftCounts512<>+16(SB)/4, $0x00000005
+DATA shiftCounts512<>+20(SB)/4, $0x00000005
+DATA shiftCounts512<>+24(SB)/4, $0x00000005
+DATA shiftCounts512<>+28(SB)/4, $0x00000005
+GLOBL shiftCounts512<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts513<>+0(SB)/4, $0x00000018
+DATA shiftCounts513<>+4(SB)/4, $0x00000018
+DATA shiftCounts513<>+8(SB)/4, $0x00000018
+DATA shiftCounts513<>+12(SB)/4, $0x00000018
+DATA shiftCounts513<>+16(SB)/4, $0x0000000b
+DATA shiftCounts513<>+20(SB)/4, $0x0000000b
+DATA shiftCounts513<>+24(SB)/4, $0x0000000b
+DATA shiftCounts513<>+28(SB)/4, $0x0000000b
+GLOBL shiftCounts513<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts514<>+0(SB)/4, $0x0000001e
+DATA shiftCounts514<>+4(SB)/4, $0x0000001e
+DATA shiftCounts514<>+8(SB)/4, $0x0000001e
+DATA shiftCounts514<>+12(SB)/4, $0x0000001e
+DATA shiftCounts514<>+16(SB)/4, $0x00000011
+DATA shiftCounts514<>+20(SB)/4, $0x00000011
+DATA shiftCounts514<>+24(SB)/4, $0x00000011
+DATA shiftCounts514<>+28(SB)/4, $0x00000011
+GLOBL shiftCounts514<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts515<>+0(SB)/4, $0x00000004
+DATA shiftCounts515<>+4(SB)/4, $0x00000004
+DATA shiftCounts515<>+8(SB)/4, $0x00000004
+DATA shiftCounts515<>+12(SB)/4, $0x00000004
+DATA shiftCounts515<>+16(SB)/4, $0x00000017
+DATA shiftCounts515<>+20(SB)/4, $0x00000017
+DATA shiftCounts515<>+24(SB)/4, $0x00000017
+DATA shiftCounts515<>+28(SB)/4, $0x00000017
+GLOBL shiftCounts515<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts516<>+0(SB)/4, $0x0000000a
+DATA shiftCounts516<>+4(SB)/4, $0x0000000a
+DATA shiftCounts516<>+8(SB)/4, $0x0000000a
+DATA shiftCounts516<>+12(SB)/4, $0x0000000a
+DATA shiftCounts516<>+16(SB)/4, $0x0000001d
+DATA shiftCounts516<>+20(SB)/4, $0x0000001d
+DATA shiftCounts516<>+24(SB)/4, $0x0000001d
+DATA shiftCounts516<>+28(SB)/4, $0x0000001d
+GLOBL shiftCounts516<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts517<>+0(SB)/4, $0x00000010
+DATA shiftCounts517<>+4(SB)/4, $0x00000010
+DATA shiftCounts517<>+8(SB)/4, $0x00000010
+DATA shiftCounts517<>+12(SB)/4, $0x00000010
+DATA shiftCounts517<>+16(SB)/4, $0x00000003
+DATA shiftCounts517<>+20(SB)/4, $0x00000003
+DATA shiftCounts517<>+24(SB)/4, $0x00000003
+DATA shiftCounts517<>+28(SB)/4, $0x00000003
+GLOBL shiftCounts517<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts518<>+0(SB)/4, $0x00000016
+DATA shiftCounts518<>+4(SB)/4, $0x00000016
+DATA shiftCounts518<>+8(SB)/4, $0x00000016
+DATA shiftCounts518<>+12(SB)/4, $0x00000016
+DATA shiftCounts518<>+16(SB)/4, $0x00000009
+DATA shiftCounts518<>+20(SB)/4, $0x00000009
+DATA shiftCounts518<>+24(SB)/4, $0x00000009
+DATA shiftCounts518<>+28(SB)/4, $0x00000009
+GLOBL shiftCounts518<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts519<>+0(SB)/4, $0x0000001c
+DATA shiftCounts519<>+4(SB)/4, $0x0000001c
+DATA shiftCounts519<>+8(SB)/4, $0x0000001c
+DATA shiftCounts519<>+12(SB)/4, $0x0000001c
+DATA shiftCounts519<>+16(SB)/4, $0x0000000f
+DATA shiftCounts519<>+20(SB)/4, $0x0000000f
+DATA shiftCounts519<>+24(SB)/4, $0x0000000f
+DATA shiftCounts519<>+28(SB)/4, $0x0000000f
+GLOBL shiftCounts519<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts520<>+0(SB)/4, $0x00000002
+DATA shiftCounts520<>+4(SB)/4, $0x00000002
+DATA shiftCounts520<>+8(SB)/4, $0x00000002
+DATA shiftCounts520<>+12(SB)/4, $0x00000002
+DATA shiftCounts520<>+16(SB)/4, $0x00000015
+DATA shiftCounts520<>+20(SB)/4, $0x00000015
+DATA shiftCounts520<>+24(SB)/4, $0x00000015
+DATA shiftCounts520<>+28(SB)/4, $0x00000015
+GLOBL shiftCounts520<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts521<>+0(SB)/4, $0x00000008
+DATA shiftCounts521<>+4(SB)/4, $0x00000008
+DATA shiftCounts521<>+8(SB)/4, $0x00000008
+DATA shiftCounts521<>+12(SB)/4, $0x00000008
+DATA shiftCounts521<>+16(SB)/4, $0x0000001b
+DATA shiftCounts521<>+20(SB)/4, $0x0000001b
+DATA shiftCounts521<>+24(SB)/4, $0x0000001b
+DATA shiftCounts521<>+28(SB)/4, $0x0000001b
+GLOBL shiftCounts521<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts522<>+0(SB)/4, $0x0000000e
+DATA shiftCounts522<>+4(SB)/4, $0x0000000e
+DATA shiftCounts522<>+8(SB)/4, $0x0000000e
+DATA shiftCounts522<>+12(SB)/4, $0x0000000e
+DATA shiftCounts522<>+16(SB)/4, $0x00000001
+DATA shiftCounts522<>+20(SB)/4, $0x00000001
+DATA shiftCounts522<>+24(SB)/4, $0x00000001
+DATA shiftCounts522<>+28(SB)/4, $0x00000001
+GLOBL shiftCounts522<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts523<>+0(SB)/4, $0x00000014
+DATA shiftCounts523<>+4(SB)/4, $0x00000014
+DATA shiftCounts523<>+8(SB)/4, $0x00000014
+DATA shiftCounts523<>+12(SB)/4, $0x00000014
+DATA shiftCounts523<>+16(SB)/4, $0x00000007
+DATA shiftCounts523<>+20(SB)/4, $0x00000007
+DATA shiftCounts523<>+24(SB)/4, $0x00000007
+DATA shiftCounts523<>+28(SB)/4, $0x00000007
+GLOBL shiftCounts523<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts524<>+0(SB)/4, $0x0000001a
+DATA shiftCounts524<>+4(SB)/4, $0x0000001a
+DATA shiftCounts524<>+8(SB)/4, $0x0000001a
+DATA shiftCounts524<>+12(SB)/4, $0x0000001a
+DATA shiftCounts524<>+16(SB)/4, $0x0000000d
+DATA shiftCounts524<>+20(SB)/4, $0x0000000d
+DATA shiftCounts524<>+24(SB)/4, $0x0000000d
+DATA shiftCounts524<>+28(SB)/4, $0x0000000d
+GLOBL shiftCounts524<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_20(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_20(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x000fffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts525<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts526<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts527<>+0(SB), Y1, Y1
+	VBROADCASTI128 32(AX), Y2
+	VPSLLVD        shiftCounts447<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VMOVDQU        32(AX), Y1
+	VPSRLVD        shiftCounts223<>+0(SB), Y1, Y1
+	VBROADCASTI128 48(AX), Y2
+	VPSLLVD        shiftCounts16<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VMOVDQU        48(AX), Y1
+	VPSRLVD        shiftCounts528<>+0(SB), Y1, Y1
+	VBROADCASTI128 64(AX), Y2
+	VPSLLVD        shiftCounts8<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VBROADCASTI128 80(AX), Y1
+	VPSRLVD        shiftCounts525<>+0(SB), Y1, Y1
+	VBROADCASTI128 96(AX), Y2
+	VPSLLVD        shiftCounts526<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VBROADCASTI128 96(AX), Y1
+	VPSRLVD        shiftCounts527<>+0(SB), Y1, Y1
+	VBROADCASTI128 112(AX), Y2
+	VPSLLVD        shiftCounts447<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VMOVDQU        112(AX), Y1
+	VPSRLVD        shiftCounts223<>+0(SB), Y1, Y1
+	VBROADCASTI128 128(AX), Y2
+	VPSLLVD        shiftCounts16<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VMOVDQU        128(AX), Y1
+	VPSRLVD        shiftCounts528<>+0(SB), Y1, Y1
+	VBROADCASTI128 144(AX), Y2
+	VPSLLVD        shiftCounts8<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VBROADCASTI128 160(AX), Y1
+	VPSRLVD        shiftCounts525<>+0(SB), Y1, Y1
+	VBROADCASTI128 176(AX), Y2
+	VPSLLVD        shiftCounts526<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VBROADCASTI128 176(AX), Y1
+	VPSRLVD        shiftCounts527<>+0(SB), Y1, Y1
+	VBROADCASTI128 192(AX), Y2
+	VPSLLVD        shiftCounts447<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VMOVDQU        192(AX), Y1
+	VPSRLVD        shiftCounts223<>+0(SB), Y1, Y1
+	VBROADCASTI128 208(AX), Y2
+	VPSLLVD        shiftCounts16<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VMOVDQU        208(AX), Y1
+	VPSRLVD        shiftCounts528<>+0(SB), Y1, Y1
+	VBROADCASTI128 224(AX), Y2
+	VPSLLVD        shiftCounts8<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VBROADCASTI128 240(AX), Y1
+	VPSRLVD        shiftCounts525<>+0(SB), Y1, Y1
+	VBROADCASTI128 256(AX), Y2
+	VPSLLVD        shiftCounts526<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VBROADCASTI128 256(AX), Y1
+	VPSRLVD        shiftCounts527<>+0(SB), Y1, Y1
+	VBROADCASTI128 272(AX), Y2
+	VPSLLVD        shiftCounts447<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VMOVDQU        272(AX), Y1
+	VPSRLVD        shiftCounts223<>+0(SB), Y1, Y1
+	VBROADCASTI128 288(AX), Y2
+	VPSLLVD        shiftCounts16<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VMOVDQU        288(AX), Y1
+	VPSRLVD        shiftCounts528<>+0(SB), Y1, Y1
+	VBROADCASTI128 304(AX), Y2
+	VPSLLVD        shiftCounts8<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts525<>+0(SB)/4, $0x00000000
+DATA shiftCounts525<>+4(SB)/4, $0x00000000
+DATA shiftCounts525<>+8(SB)/4, $0x00000000
+DATA shiftCounts525<>+12(SB)/4, $0x00000000
+DATA shiftCounts525<>+16(SB)/4, $0x00000014
+DATA shiftCounts525<>+20(SB)/4, $0x00000014
+DATA shiftCounts525<>+24(SB)/4, $0x00000014
+DATA shiftCounts525<>+28(SB)/4, $0x00000014
+GLOBL shiftCounts525<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts526<>+0(SB)/4, $0x00000020
+DATA shiftCounts526<>+4(SB)/4, $0x00000020
+DATA shiftCounts526<>+8(SB)/4, $0x00000020
+DATA shiftCounts526<>+12(SB)/4, $0x00000020
+DATA shiftCounts526<>+16(SB)/4, $0x0000000c
+DATA shiftCounts526<>+20(SB)/4, $0x0000000c
+DATA shiftCounts526<>+24(SB)/4, $0x0000000c
+DATA shiftCounts526<>+28(SB)/4, $0x0000000c
+GLOBL shiftCounts526<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts527<>+0(SB)/4, $0x00000008
+DATA shiftCounts527<>+4(SB)/4, $0x00000008
+DATA shiftCounts527<>+8(SB)/4, $0x00000008
+DATA shiftCounts527<>+12(SB)/4, $0x00000008
+DATA shiftCounts527<>+16(SB)/4, $0x0000001c
+DATA shiftCounts527<>+20(SB)/4, $0x0000001c
+DATA shiftCounts527<>+24(SB)/4, $0x0000001c
+DATA shiftCounts527<>+28(SB)/4, $0x0000001c
+GLOBL shiftCounts527<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts528<>+0(SB)/4, $0x00000018
+DATA shiftCounts528<>+4(SB)/4, $0x00000018
+DATA shiftCounts528<>+8(SB)/4, $0x00000018
+DATA shiftCounts528<>+12(SB)/4, $0x00000018
+DATA shiftCounts528<>+16(SB)/4, $0x0000000c
+DATA shiftCounts528<>+20(SB)/4, $0x0000000c
+DATA shiftCounts528<>+24(SB)/4, $0x0000000c
+DATA shiftCounts528<>+28(SB)/4, $0x0000000c
+GLOBL shiftCounts528<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_21(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_21(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x001fffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts529<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts452<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VBROADCASTI128 16(AX), Y1
+	VPSRLVD        shiftCounts530<>+0(SB), Y1, Y1
+	VBROADCASTI128 32(AX), Y2
+	VPSLLVD        shiftCounts392<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VMOVDQU        32(AX), Y1
+	VPSRLVD        shiftCounts231<>+0(SB), Y1, Y1
+	VBROADCASTI128 48(AX), Y2
+	VPSLLVD        shiftCounts12<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VMOVDQU        48(AX), Y1
+	VPSRLVD        shiftCounts531<>+0(SB), Y1, Y1
+	VMOVDQU        64(AX), Y2
+	VPSLLVD        shiftCounts232<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VBROADCASTI128 80(AX), Y1
+	VPSRLVD        shiftCounts532<>+0(SB), Y1, Y1
+	VBROADCASTI128 96(AX), Y2
+	VPSLLVD        shiftCounts401<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VMOVDQU        96(AX), Y1
+	VPSRLVD        shiftCounts235<>+0(SB), Y1, Y1
+	VBROADCASTI128 112(AX), Y2
+	VPSLLVD        shiftCounts14<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VMOVDQU        112(AX), Y1
+	VPSRLVD        shiftCounts533<>+0(SB), Y1, Y1
+	VMOVDQU        128(AX), Y2
+	VPSLLVD        shiftCounts236<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VBROADCASTI128 144(AX), Y1
+	VPSRLVD        shiftCounts534<>+0(SB), Y1, Y1
+	VBROADCASTI128 160(AX), Y2
+	VPSLLVD        shiftCounts409<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VMOVDQU        160(AX), Y1
+	VPSRLVD        shiftCounts240<>+0(SB), Y1, Y1
+	VBROADCASTI128 176(AX), Y2
+	VPSLLVD        shiftCounts16<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VMOVDQU        176(AX), Y1
+	VPSRLVD        shiftCounts535<>+0(SB), Y1, Y1
+	VMOVDQU        192(AX), Y2
+	VPSLLVD        shiftCounts242<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VBROADCASTI128 208(AX), Y1
+	VPSRLVD        shiftCounts536<>+0(SB), Y1, Y1
+	VBROADCASTI128 224(AX), Y2
+	VPSLLVD        shiftCounts422<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VMOVDQU        224(AX), Y1
+	VPSRLVD        shiftCounts245<>+0(SB), Y1, Y1
+	VBROADCASTI128 240(AX), Y2
+	VPSLLVD        shiftCounts18<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VMOVDQU        240(AX), Y1
+	VPSRLVD        shiftCounts537<>+0(SB), Y1, Y1
+	VMOVDQU        256(AX), Y2
+	VPSLLVD        shiftCounts246<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VBROADCASTI128 272(AX), Y1
+	VPSRLVD        shiftCounts538<>+0(SB), Y1, Y1
+	VBROADCASTI128 288(AX), Y2
+	VPSLLVD        shiftCounts436<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VMOVDQU        288(AX), Y1
+	VPSRLVD        shiftCounts249<>+0(SB), Y1, Y1
+	VBROADCASTI128 304(AX), Y2
+	VPSLLVD        shiftCounts20<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VMOVDQU        304(AX), Y1
+	VPSRLVD        shiftCounts539<>+0(SB), Y1, Y1
+	VBROADCASTI128 320(AX), Y2
+	VPSLLVD        shiftCounts10<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts529<>+0(SB)/4, $0x00000000
+DATA shiftCounts529<>+4(SB)/4, $0x00000000
+DATA shiftCounts529<>+8(SB)/4, $0x00000000
+DATA shiftCounts529<>+12(SB)/4, $0x00000000
+DATA shiftCounts529<>+16(SB)/4, $0x00000015
+DATA shiftCounts529<>+20(SB)/4, $0x00000015
+DATA shiftCounts529<>+24(SB)/4, $0x00000015
+DATA shiftCounts529<>+28(SB)/4, $0x00000015
+GLOBL shiftCounts529<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts530<>+0(SB)/4, $0x0000000a
+DATA shiftCounts530<>+4(SB)/4, $0x0000000a
+DATA shiftCounts530<>+8(SB)/4, $0x0000000a
+DATA shiftCounts530<>+12(SB)/4, $0x0000000a
+DATA shiftCounts530<>+16(SB)/4, $0x0000001f
+DATA shiftCounts530<>+20(SB)/4, $0x0000001f
+DATA shiftCounts530<>+24(SB)/4, $0x0000001f
+DATA shiftCounts530<>+28(SB)/4, $0x0000001f
+GLOBL shiftCounts530<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts531<>+0(SB)/4, $0x0000001e
+DATA shiftCounts531<>+4(SB)/4, $0x0000001e
+DATA shiftCounts531<>+8(SB)/4, $0x0000001e
+DATA shiftCounts531<>+12(SB)/4, $0x0000001e
+DATA shiftCounts531<>+16(SB)/4, $0x00000013
+DATA shiftCounts531<>+20(SB)/4, $0x00000013
+DATA shiftCounts531<>+24(SB)/4, $0x00000013
+DATA shiftCounts531<>+28(SB)/4, $0x00000013
+GLOBL shiftCounts531<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts532<>+0(SB)/4, $0x00000008
+DATA shiftCounts532<>+4(SB)/4, $0x00000008
+DATA shiftCounts532<>+8(SB)/4, $0x00000008
+DATA shiftCounts532<>+12(SB)/4, $0x00000008
+DATA shiftCounts532<>+16(SB)/4, $0x0000001d
+DATA shiftCounts532<>+20(SB)/4, $0x0000001d
+DATA shiftCounts532<>+24(SB)/4, $0x0000001d
+DATA shiftCounts532<>+28(SB)/4, $0x0000001d
+GLOBL shiftCounts532<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts533<>+0(SB)/4, $0x0000001c
+DATA shiftCounts533<>+4(SB)/4, $0x0000001c
+DATA shiftCounts533<>+8(SB)/4, $0x0000001c
+DATA shiftCounts533<>+12(SB)/4, $0x0000001c
+DATA shiftCounts533<>+16(SB)/4, $0x00000011
+DATA shiftCounts533<>+20(SB)/4, $0x00000011
+DATA shiftCounts533<>+24(SB)/4, $0x00000011
+DATA shiftCounts533<>+28(SB)/4, $0x00000011
+GLOBL shiftCounts533<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts534<>+0(SB)/4, $0x00000006
+DATA shiftCounts534<>+4(SB)/4, $0x00000006
+DATA shiftCounts534<>+8(SB)/4, $0x00000006
+DATA shiftCounts534<>+12(SB)/4, $0x00000006
+DATA shiftCounts534<>+16(SB)/4, $0x0000001b
+DATA shiftCounts534<>+20(SB)/4, $0x0000001b
+DATA shiftCounts534<>+24(SB)/4, $0x0000001b
+DATA shiftCounts534<>+28(SB)/4, $0x0000001b
+GLOBL shiftCounts534<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts535<>+0(SB)/4, $0x0000001a
+DATA shiftCounts535<>+4(SB)/4, $0x0000001a
+DATA shiftCounts535<>+8(SB)/4, $0x0000001a
+DATA shiftCounts535<>+12(SB)/4, $0x0000001a
+DATA shiftCounts535<>+16(SB)/4, $0x0000000f
+DATA shiftCounts535<>+20(SB)/4, $0x0000000f
+DATA shiftCounts535<>+24(SB)/4, $0x0000000f
+DATA shiftCounts535<>+28(SB)/4, $0x0000000f
+GLOBL shiftCounts535<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts536<>+0(SB)/4, $0x00000004
+DATA shiftCounts536<>+4(SB)/4, $0x00000004
+DATA shiftCounts536<>+8(SB)/4, $0x00000004
+DATA shiftCounts536<>+12(SB)/4, $0x00000004
+DATA shiftCounts536<>+16(SB)/4, $0x00000019
+DATA shiftCounts536<>+20(SB)/4, $0x00000019
+DATA shiftCounts536<>+24(SB)/4, $0x00000019
+DATA shiftCounts536<>+28(SB)/4, $0x00000019
+GLOBL shiftCounts536<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts537<>+0(SB)/4, $0x00000018
+DATA shiftCounts537<>+4(SB)/4, $0x00000018
+DATA shiftCounts537<>+8(SB)/4, $0x00000018
+DATA shiftCounts537<>+12(SB)/4, $0x00000018
+DATA shiftCounts537<>+16(SB)/4, $0x0000000d
+DATA shiftCounts537<>+20(SB)/4, $0x0000000d
+DATA shiftCounts537<>+24(SB)/4, $0x0000000d
+DATA shiftCounts537<>+28(SB)/4, $0x0000000d
+GLOBL shiftCounts537<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts538<>+0(SB)/4, $0x00000002
+DATA shiftCounts538<>+4(SB)/4, $0x00000002
+DATA shiftCounts538<>+8(SB)/4, $0x00000002
+DATA shiftCounts538<>+12(SB)/4, $0x00000002
+DATA shiftCounts538<>+16(SB)/4, $0x00000017
+DATA shiftCounts538<>+20(SB)/4, $0x00000017
+DATA shiftCounts538<>+24(SB)/4, $0x00000017
+DATA shiftCounts538<>+28(SB)/4, $0x00000017
+GLOBL shiftCounts538<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts539<>+0(SB)/4, $0x00000016
+DATA shiftCounts539<>+4(SB)/4, $0x00000016
+DATA shiftCounts539<>+8(SB)/4, $0x00000016
+DATA shiftCounts539<>+12(SB)/4, $0x00000016
+DATA shiftCounts539<>+16(SB)/4, $0x0000000b
+DATA shiftCounts539<>+20(SB)/4, $0x0000000b
+DATA shiftCounts539<>+24(SB)/4, $0x0000000b
+DATA shiftCounts539<>+28(SB)/4, $0x0000000b
+GLOBL shiftCounts539<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_22(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_22(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x003fffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts540<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts467<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VMOVDQU        16(AX), Y1
+	VPSRLVD        shiftCounts157<>+0(SB), Y1, Y1
+	VBROADCASTI128 32(AX), Y2
+	VPSLLVD        shiftCounts20<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VMOVDQU        32(AX), Y1
+	VPSRLVD        shiftCounts541<>+0(SB), Y1, Y1
+	VMOVDQU        48(AX), Y2
+	VPSLLVD        shiftCounts258<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VBROADCASTI128 64(AX), Y1
+	VPSRLVD        shiftCounts323<>+0(SB), Y1, Y1
+	VBROADCASTI128 80(AX), Y2
+	VPSLLVD        shiftCounts431<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VMOVDQU        80(AX), Y1
+	VPSRLVD        shiftCounts261<>+0(SB), Y1, Y1
+	VBROADCASTI128 96(AX), Y2
+	VPSLLVD        shiftCounts16<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VMOVDQU        96(AX), Y1
+	VPSRLVD        shiftCounts542<>+0(SB), Y1, Y1
+	VMOVDQU        112(AX), Y2
+	VPSLLVD        shiftCounts153<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VBROADCASTI128 128(AX), Y1
+	VPSRLVD        shiftCounts543<>+0(SB), Y1, Y1
+	VBROADCASTI128 144(AX), Y2
+	VPSLLVD        shiftCounts403<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VMOVDQU        144(AX), Y1
+	VPSRLVD        shiftCounts263<>+0(SB), Y1, Y1
+	VBROADCASTI128 160(AX), Y2
+	VPSLLVD        shiftCounts12<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VBROADCASTI128 176(AX), Y1
+	VPSRLVD        shiftCounts540<>+0(SB), Y1, Y1
+	VBROADCASTI128 192(AX), Y2
+	VPSLLVD        shiftCounts467<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VMOVDQU        192(AX), Y1
+	VPSRLVD        shiftCounts157<>+0(SB), Y1, Y1
+	VBROADCASTI128 208(AX), Y2
+	VPSLLVD        shiftCounts20<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VMOVDQU        208(AX), Y1
+	VPSRLVD        shiftCounts541<>+0(SB), Y1, Y1
+	VMOVDQU        224(AX), Y2
+	VPSLLVD        shiftCounts258<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VBROADCASTI128 240(AX), Y1
+	VPSRLVD        shiftCounts323<>+0(SB), Y1, Y1
+	VBROADCASTI128 256(AX), Y2
+	VPSLLVD        shiftCounts431<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VMOVDQU        256(AX), Y1
+	VPSRLVD        shiftCounts261<>+0(SB), Y1, Y1
+	VBROADCASTI128 272(AX), Y2
+	VPSLLVD        shiftCounts16<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VMOVDQU        272(AX), Y1
+	VPSRLVD        shiftCounts542<>+0(SB), Y1, Y1
+	VMOVDQU        288(AX), Y2
+	VPSLLVD        shiftCounts153<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VBROADCASTI128 304(AX), Y1
+	VPSRLVD        shiftCounts543<>+0(SB), Y1, Y1
+	VBROADCASTI128 320(AX), Y2
+	VPSLLVD        shiftCounts403<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VMOVDQU        320(AX), Y1
+	VPSRLVD        shiftCounts263<>+0(SB), Y1, Y1
+	VBROADCASTI128 336(AX), Y2
+	VPSLLVD        shiftCounts12<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts540<>+0(SB)/4, $0x00000000
+DATA shiftCounts540<>+4(SB)/4, $0x00000000
+DATA shiftCounts540<>+8(SB)/4, $0x00000000
+DATA shiftCounts540<>+12(SB)/4, $0x00000000
+DATA shiftCounts540<>+16(SB)/4, $0x00000016
+DATA shiftCounts540<>+20(SB)/4, $0x00000016
+DATA shiftCounts540<>+24(SB)/4, $0x00000016
+DATA shiftCounts540<>+28(SB)/4, $0x00000016
+GLOBL shiftCounts540<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts541<>+0(SB)/4, $0x00000018
+DATA shiftCounts541<>+4(SB)/4, $0x00000018
+DATA shiftCounts541<>+8(SB)/4, $0x00000018
+DATA shiftCounts541<>+12(SB)/4, $0x00000018
+DATA shiftCounts541<>+16(SB)/4, $0x0000000e
+DATA shiftCounts541<>+20(SB)/4, $0x0000000e
+DATA shiftCounts541<>+24(SB)/4, $0x0000000e
+DATA shiftCounts541<>+28(SB)/4, $0x0000000e
+GLOBL shiftCounts541<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts542<>+0(SB)/4, $0x0000001c
+DATA shiftCounts542<>+4(SB)/4, $0x0000001c
+DATA shiftCounts542<>+8(SB)/4, $0x0000001c
+DATA shiftCounts542<>+12(SB)/4, $0x0000001c
+DATA shiftCounts542<>+16(SB)/4, $0x00000012
+DATA shiftCounts542<>+20(SB)/4, $0x00000012
+DATA shiftCounts542<>+24(SB)/4, $0x00000012
+DATA shiftCounts542<>+28(SB)/4, $0x00000012
+GLOBL shiftCounts542<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts543<>+0(SB)/4, $0x00000008
+DATA shiftCounts543<>+4(SB)/4, $0x00000008
+DATA shiftCounts543<>+8(SB)/4, $0x00000008
+DATA shiftCounts543<>+12(SB)/4, $0x00000008
+DATA shiftCounts543<>+16(SB)/4, $0x0000001e
+DATA shiftCounts543<>+20(SB)/4, $0x0000001e
+DATA shiftCounts543<>+24(SB)/4, $0x0000001e
+DATA shiftCounts543<>+28(SB)/4, $0x0000001e
+GLOBL shiftCounts543<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_23(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_23(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x007fffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts544<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts436<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VMOVDQU        16(AX), Y1
+	VPSRLVD        shiftCounts545<>+0(SB), Y1, Y1
+	VBROADCASTI128 32(AX), Y2
+	VPSLLVD        shiftCounts18<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VMOVDQU        32(AX), Y1
+	VPSRLVD        shiftCounts546<>+0(SB), Y1, Y1
+	VMOVDQU        48(AX), Y2
+	VPSLLVD        shiftCounts416<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VMOVDQU        64(AX), Y1
+	VPSRLVD        shiftCounts268<>+0(SB), Y1, Y1
+	VBROADCASTI128 80(AX), Y2
+	VPSLLVD        shiftCounts22<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VMOVDQU        80(AX), Y1
+	VPSRLVD        shiftCounts547<>+0(SB), Y1, Y1
+	VMOVDQU        96(AX), Y2
+	VPSLLVD        shiftCounts269<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VBROADCASTI128 112(AX), Y1
+	VPSRLVD        shiftCounts548<>+0(SB), Y1, Y1
+	VBROADCASTI128 128(AX), Y2
+	VPSLLVD        shiftCounts401<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VMOVDQU        128(AX), Y1
+	VPSRLVD        shiftCounts272<>+0(SB), Y1, Y1
+	VMOVDQU        144(AX), Y2
+	VPSLLVD        shiftCounts419<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VBROADCASTI128 160(AX), Y1
+	VPSRLVD        shiftCounts549<>+0(SB), Y1, Y1
+	VBROADCASTI128 176(AX), Y2
+	VPSLLVD        shiftCounts422<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VMOVDQU        176(AX), Y1
+	VPSRLVD        shiftCounts550<>+0(SB), Y1, Y1
+	VBROADCASTI128 192(AX), Y2
+	VPSLLVD        shiftCounts16<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VMOVDQU        192(AX), Y1
+	VPSRLVD        shiftCounts551<>+0(SB), Y1, Y1
+	VMOVDQU        208(AX), Y2
+	VPSLLVD        shiftCounts423<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VMOVDQU        224(AX), Y1
+	VPSRLVD        shiftCounts276<>+0(SB), Y1, Y1
+	VBROADCASTI128 240(AX), Y2
+	VPSLLVD        shiftCounts20<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VMOVDQU        240(AX), Y1
+	VPSRLVD        shiftCounts552<>+0(SB), Y1, Y1
+	VMOVDQU        256(AX), Y2
+	VPSLLVD        shiftCounts277<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VBROADCASTI128 272(AX), Y1
+	VPSRLVD        shiftCounts553<>+0(SB), Y1, Y1
+	VBROADCASTI128 288(AX), Y2
+	VPSLLVD        shiftCounts392<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VMOVDQU        288(AX), Y1
+	VPSRLVD        shiftCounts280<>+0(SB), Y1, Y1
+	VMOVDQU        304(AX), Y2
+	VPSLLVD        shiftCounts426<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VBROADCASTI128 320(AX), Y1
+	VPSRLVD        shiftCounts554<>+0(SB), Y1, Y1
+	VBROADCASTI128 336(AX), Y2
+	VPSLLVD        shiftCounts409<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VMOVDQU        336(AX), Y1
+	VPSRLVD        shiftCounts555<>+0(SB), Y1, Y1
+	VBROADCASTI128 352(AX), Y2
+	VPSLLVD        shiftCounts14<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts544<>+0(SB)/4, $0x00000000
+DATA shiftCounts544<>+4(SB)/4, $0x00000000
+DATA shiftCounts544<>+8(SB)/4, $0x00000000
+DATA shiftCounts544<>+12(SB)/4, $0x00000000
+DATA shiftCounts544<>+16(SB)/4, $0x00000017
+DATA shiftCounts544<>+20(SB)/4, $0x00000017
+DATA shiftCounts544<>+24(SB)/4, $0x00000017
+DATA shiftCounts544<>+28(SB)/4, $0x00000017
+GLOBL shiftCounts544<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts545<>+0(SB)/4, $0x0000000e
+DATA shiftCounts545<>+4(SB)/4, $0x0000000e
+DATA shiftCounts545<>+8(SB)/4, $0x0000000e
+DATA shiftCounts545<>+12(SB)/4, $0x0000000e
+DATA shiftCounts545<>+16(SB)/4, $0x00000005
+DATA shiftCounts545<>+20(SB)/4, $0x00000005
+DATA shiftCounts545<>+24(SB)/4, $0x00000005
+DATA shiftCounts545<>+28(SB)/4, $0x00000005
+GLOBL shiftCounts545<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts546<>+0(SB)/4, $0x0000001c
+DATA shiftCounts546<>+4(SB)/4, $0x0000001c
+DATA shiftCounts546<>+8(SB)/4, $0x0000001c
+DATA shiftCounts546<>+12(SB)/4, $0x0000001c
+DATA shiftCounts546<>+16(SB)/4, $0x00000013
+DATA shiftCounts546<>+20(SB)/4, $0x00000013
+DATA shiftCounts546<>+24(SB)/4, $0x00000013
+DATA shiftCounts546<>+28(SB)/4, $0x00000013
+GLOBL shiftCounts546<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts547<>+0(SB)/4, $0x00000018
+DATA shiftCounts547<>+4(SB)/4, $0x00000018
+DATA shiftCounts547<>+8(SB)/4, $0x00000018
+DATA shiftCounts547<>+12(SB)/4, $0x00000018
+DATA shiftCounts547<>+16(SB)/4, $0x0000000f
+DATA shiftCounts547<>+20(SB)/4, $0x0000000f
+DATA shiftCounts547<>+24(SB)/4, $0x0000000f
+DATA shiftCounts547<>+28(SB)/4, $0x0000000f
+GLOBL shiftCounts547<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts548<>+0(SB)/4, $0x00000006
+DATA shiftCounts548<>+4(SB)/4, $0x00000006
+DATA shiftCounts548<>+8(SB)/4, $0x00000006
+DATA shiftCounts548<>+12(SB)/4, $0x00000006
+DATA shiftCounts548<>+16(SB)/4, $0x0000001d
+DATA shiftCounts548<>+20(SB)/4, $0x0000001d
+DATA shiftCounts548<>+24(SB)/4, $0x0000001d
+DATA shiftCounts548<>+28(SB)/4, $0x0000001d
+GLOBL shiftCounts548<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts549<>+0(SB)/4, $0x00000002
+DATA shiftCounts549<>+4(SB)/4, $0x00000002
+DATA shiftCounts549<>+8(SB)/4, $0x00000002
+DATA shiftCounts549<>+12(SB)/4, $0x00000002
+DATA shiftCounts549<>+16(SB)/4, $0x00000019
+DATA shiftCounts549<>+20(SB)/4, $0x00000019
+DATA shiftCounts549<>+24(SB)/4, $0x00000019
+DATA shiftCounts549<>+28(SB)/4, $0x00000019
+GLOBL shiftCounts549<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts550<>+0(SB)/4, $0x00000010
+DATA shiftCounts550<>+4(SB)/4, $0x00000010
+DATA shiftCounts550<>+8(SB)/4, $0x00000010
+DATA shiftCounts550<>+12(SB)/4, $0x00000010
+DATA shiftCounts550<>+16(SB)/4, $0x00000007
+DATA shiftCounts550<>+20(SB)/4, $0x00000007
+DATA shiftCounts550<>+24(SB)/4, $0x00000007
+DATA shiftCounts550<>+28(SB)/4, $0x00000007
+GLOBL shiftCounts550<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts551<>+0(SB)/4, $0x0000001e
+DATA shiftCounts551<>+4(SB)/4, $0x0000001e
+DATA shiftCounts551<>+8(SB)/4, $0x0000001e
+DATA shiftCounts551<>+12(SB)/4, $0x0000001e
+DATA shiftCounts551<>+16(SB)/4, $0x00000015
+DATA shiftCounts551<>+20(SB)/4, $0x00000015
+DATA shiftCounts551<>+24(SB)/4, $0x00000015
+DATA shiftCounts551<>+28(SB)/4, $0x00000015
+GLOBL shiftCounts551<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts552<>+0(SB)/4, $0x0000001a
+DATA shiftCounts552<>+4(SB)/4, $0x0000001a
+DATA shiftCounts552<>+8(SB)/4, $0x0000001a
+DATA shiftCounts552<>+12(SB)/4, $0x0000001a
+DATA shiftCounts552<>+16(SB)/4, $0x00000011
+DATA shiftCounts552<>+20(SB)/4, $0x00000011
+DATA shiftCounts552<>+24(SB)/4, $0x00000011
+DATA shiftCounts552<>+28(SB)/4, $0x00000011
+GLOBL shiftCounts552<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts553<>+0(SB)/4, $0x00000008
+DATA shiftCounts553<>+4(SB)/4, $0x00000008
+DATA shiftCounts553<>+8(SB)/4, $0x00000008
+DATA shiftCounts553<>+12(SB)/4, $0x00000008
+DATA shiftCounts553<>+16(SB)/4, $0x0000001f
+DATA shiftCounts553<>+20(SB)/4, $0x0000001f
+DATA shiftCounts553<>+24(SB)/4, $0x0000001f
+DATA shiftCounts553<>+28(SB)/4, $0x0000001f
+GLOBL shiftCounts553<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts554<>+0(SB)/4, $0x00000004
+DATA shiftCounts554<>+4(SB)/4, $0x00000004
+DATA shiftCounts554<>+8(SB)/4, $0x00000004
+DATA shiftCounts554<>+12(SB)/4, $0x00000004
+DATA shiftCounts554<>+16(SB)/4, $0x0000001b
+DATA shiftCounts554<>+20(SB)/4, $0x0000001b
+DATA shiftCounts554<>+24(SB)/4, $0x0000001b
+DATA shiftCounts554<>+28(SB)/4, $0x0000001b
+GLOBL shiftCounts554<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts555<>+0(SB)/4, $0x00000012
+DATA shiftCounts555<>+4(SB)/4, $0x00000012
+DATA shiftCounts555<>+8(SB)/4, $0x00000012
+DATA shiftCounts555<>+12(SB)/4, $0x00000012
+DATA shiftCounts555<>+16(SB)/4, $0x00000009
+DATA shiftCounts555<>+20(SB)/4, $0x00000009
+DATA shiftCounts555<>+24(SB)/4, $0x00000009
+DATA shiftCounts555<>+28(SB)/4, $0x00000009
+GLOBL shiftCounts555<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_24(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_24(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x00ffffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts556<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts557<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VMOVDQU        16(AX), Y1
+	VPSRLVD        shiftCounts226<>+0(SB), Y1, Y1
+	VBROADCASTI128 32(AX), Y2
+	VPSLLVD        shiftCounts16<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts556<>+0(SB), Y1, Y1
+	VBROADCASTI128 64(AX), Y2
+	VPSLLVD        shiftCounts557<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VMOVDQU        64(AX), Y1
+	VPSRLVD        shiftCounts226<>+0(SB), Y1, Y1
+	VBROADCASTI128 80(AX), Y2
+	VPSLLVD        shiftCounts16<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VBROADCASTI128 96(AX), Y1
+	VPSRLVD        shiftCounts556<>+0(SB), Y1, Y1
+	VBROADCASTI128 112(AX), Y2
+	VPSLLVD        shiftCounts557<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VMOVDQU        112(AX), Y1
+	VPSRLVD        shiftCounts226<>+0(SB), Y1, Y1
+	VBROADCASTI128 128(AX), Y2
+	VPSLLVD        shiftCounts16<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VBROADCASTI128 144(AX), Y1
+	VPSRLVD        shiftCounts556<>+0(SB), Y1, Y1
+	VBROADCASTI128 160(AX), Y2
+	VPSLLVD        shiftCounts557<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VMOVDQU        160(AX), Y1
+	VPSRLVD        shiftCounts226<>+0(SB), Y1, Y1
+	VBROADCASTI128 176(AX), Y2
+	VPSLLVD        shiftCounts16<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VBROADCASTI128 192(AX), Y1
+	VPSRLVD        shiftCounts556<>+0(SB), Y1, Y1
+	VBROADCASTI128 208(AX), Y2
+	VPSLLVD        shiftCounts557<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VMOVDQU        208(AX), Y1
+	VPSRLVD        shiftCounts226<>+0(SB), Y1, Y1
+	VBROADCASTI128 224(AX), Y2
+	VPSLLVD        shiftCounts16<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VBROADCASTI128 240(AX), Y1
+	VPSRLVD        shiftCounts556<>+0(SB), Y1, Y1
+	VBROADCASTI128 256(AX), Y2
+	VPSLLVD        shiftCounts557<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VMOVDQU        256(AX), Y1
+	VPSRLVD        shiftCounts226<>+0(SB), Y1, Y1
+	VBROADCASTI128 272(AX), Y2
+	VPSLLVD        shiftCounts16<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VBROADCASTI128 288(AX), Y1
+	VPSRLVD        shiftCounts556<>+0(SB), Y1, Y1
+	VBROADCASTI128 304(AX), Y2
+	VPSLLVD        shiftCounts557<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VMOVDQU        304(AX), Y1
+	VPSRLVD        shiftCounts226<>+0(SB), Y1, Y1
+	VBROADCASTI128 320(AX), Y2
+	VPSLLVD        shiftCounts16<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VBROADCASTI128 336(AX), Y1
+	VPSRLVD        shiftCounts556<>+0(SB), Y1, Y1
+	VBROADCASTI128 352(AX), Y2
+	VPSLLVD        shiftCounts557<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VMOVDQU        352(AX), Y1
+	VPSRLVD        shiftCounts226<>+0(SB), Y1, Y1
+	VBROADCASTI128 368(AX), Y2
+	VPSLLVD        shiftCounts16<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts556<>+0(SB)/4, $0x00000000
+DATA shiftCounts556<>+4(SB)/4, $0x00000000
+DATA shiftCounts556<>+8(SB)/4, $0x00000000
+DATA shiftCounts556<>+12(SB)/4, $0x00000000
+DATA shiftCounts556<>+16(SB)/4, $0x00000018
+DATA shiftCounts556<>+20(SB)/4, $0x00000018
+DATA shiftCounts556<>+24(SB)/4, $0x00000018
+DATA shiftCounts556<>+28(SB)/4, $0x00000018
+GLOBL shiftCounts556<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts557<>+0(SB)/4, $0x00000020
+DATA shiftCounts557<>+4(SB)/4, $0x00000020
+DATA shiftCounts557<>+8(SB)/4, $0x00000020
+DATA shiftCounts557<>+12(SB)/4, $0x00000020
+DATA shiftCounts557<>+16(SB)/4, $0x00000008
+DATA shiftCounts557<>+20(SB)/4, $0x00000008
+DATA shiftCounts557<>+24(SB)/4, $0x00000008
+DATA shiftCounts557<>+28(SB)/4, $0x00000008
+GLOBL shiftCounts557<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_25(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_25(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x01ffffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts558<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts422<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VMOVDQU        16(AX), Y1
+	VPSRLVD        shiftCounts559<>+0(SB), Y1, Y1
+	VMOVDQU        32(AX), Y2
+	VPSLLVD        shiftCounts139<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VBROADCASTI128 48(AX), Y1
+	VPSRLVD        shiftCounts560<>+0(SB), Y1, Y1
+	VBROADCASTI128 64(AX), Y2
+	VPSLLVD        shiftCounts401<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VMOVDQU        64(AX), Y1
+	VPSRLVD        shiftCounts287<>+0(SB), Y1, Y1
+	VMOVDQU        80(AX), Y2
+	VPSLLVD        shiftCounts406<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VMOVDQU        96(AX), Y1
+	VPSRLVD        shiftCounts149<>+0(SB), Y1, Y1
+	VBROADCASTI128 112(AX), Y2
+	VPSLLVD        shiftCounts24<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VMOVDQU        112(AX), Y1
+	VPSRLVD        shiftCounts561<>+0(SB), Y1, Y1
+	VMOVDQU        128(AX), Y2
+	VPSLLVD        shiftCounts288<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VMOVDQU        144(AX), Y1
+	VPSRLVD        shiftCounts137<>+0(SB), Y1, Y1
+	VBROADCASTI128 160(AX), Y2
+	VPSLLVD        shiftCounts20<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VMOVDQU        160(AX), Y1
+	VPSRLVD        shiftCounts562<>+0(SB), Y1, Y1
+	VMOVDQU        176(AX), Y2
+	VPSLLVD        shiftCounts141<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VMOVDQU        192(AX), Y1
+	VPSRLVD        shiftCounts293<>+0(SB), Y1, Y1
+	VMOVDQU        208(AX), Y2
+	VPSLLVD        shiftCounts145<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VBROADCASTI128 224(AX), Y1
+	VPSRLVD        shiftCounts563<>+0(SB), Y1, Y1
+	VBROADCASTI128 240(AX), Y2
+	VPSLLVD        shiftCounts409<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VMOVDQU        240(AX), Y1
+	VPSRLVD        shiftCounts564<>+0(SB), Y1, Y1
+	VMOVDQU        256(AX), Y2
+	VPSLLVD        shiftCounts296<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VBROADCASTI128 272(AX), Y1
+	VPSRLVD        shiftCounts565<>+0(SB), Y1, Y1
+	VBROADCASTI128 288(AX), Y2
+	VPSLLVD        shiftCounts392<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VMOVDQU        288(AX), Y1
+	VPSRLVD        shiftCounts298<>+0(SB), Y1, Y1
+	VMOVDQU        304(AX), Y2
+	VPSLLVD        shiftCounts412<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VMOVDQU        320(AX), Y1
+	VPSRLVD        shiftCounts143<>+0(SB), Y1, Y1
+	VBROADCASTI128 336(AX), Y2
+	VPSLLVD        shiftCounts22<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VMOVDQU        336(AX), Y1
+	VPSRLVD        shiftCounts566<>+0(SB), Y1, Y1
+	VMOVDQU        352(AX), Y2
+	VPSLLVD        shiftCounts147<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VMOVDQU        368(AX), Y1
+	VPSRLVD        shiftCounts567<>+0(SB), Y1, Y1
+	VBROADCASTI128 384(AX), Y2
+	VPSLLVD        shiftCounts18<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts558<>+0(SB)/4, $0x00000000
+DATA shiftCounts558<>+4(SB)/4, $0x00000000
+DATA shiftCounts558<>+8(SB)/4, $0x00000000
+DATA shiftCounts558<>+12(SB)/4, $0x00000000
+DATA shiftCounts558<>+16(SB)/4, $0x00000019
+DATA shiftCounts558<>+20(SB)/4, $0x00000019
+DATA shiftCounts558<>+24(SB)/4, $0x00000019
+DATA shiftCounts558<>+28(SB)/4, $0x00000019
+GLOBL shiftCounts558<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts559<>+0(SB)/4, $0x00000012
+DATA shiftCounts559<>+4(SB)/4, $0x00000012
+DATA shiftCounts559<>+8(SB)/4, $0x00000012
+DATA shiftCounts559<>+12(SB)/4, $0x00000012
+DATA shiftCounts559<>+16(SB)/4, $0x0000000b
+DATA shiftCounts559<>+20(SB)/4, $0x0000000b
+DATA shiftCounts559<>+24(SB)/4, $0x0000000b
+DATA shiftCounts559<>+28(SB)/4, $0x0000000b
+GLOBL shiftCounts559<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts560<>+0(SB)/4, $0x00000004
+DATA shiftCounts560<>+4(SB)/4, $0x00000004
+DATA shiftCounts560<>+8(SB)/4, $0x00000004
+DATA shiftCounts560<>+12(SB)/4, $0x00000004
+DATA shiftCounts560<>+16(SB)/4, $0x0000001d
+DATA shiftCounts560<>+20(SB)/4, $0x0000001d
+DATA shiftCounts560<>+24(SB)/4, $0x0000001d
+DATA shiftCounts560<>+28(SB)/4, $0x0000001d
+GLOBL shiftCounts560<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts561<>+0(SB)/4, $0x0000001a
+DATA shiftCounts561<>+4(SB)/4, $0x0000001a
+DATA shiftCounts561<>+8(SB)/4, $0x0000001a
+DATA shiftCounts561<>+12(SB)/4, $0x0000001a
+DATA shiftCounts561<>+16(SB)/4, $0x00000013
+DATA shiftCounts561<>+20(SB)/4, $0x00000013
+DATA shiftCounts561<>+24(SB)/4, $0x00000013
+DATA shiftCounts561<>+28(SB)/4, $0x00000013
+GLOBL shiftCounts561<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts562<>+0(SB)/4, $0x0000001e
+DATA shiftCounts562<>+4(SB)/4, $0x0000001e
+DATA shiftCounts562<>+8(SB)/4, $0x0000001e
+DATA shiftCounts562<>+12(SB)/4, $0x0000001e
+DATA shiftCounts562<>+16(SB)/4, $0x00000017
+DATA shiftCounts562<>+20(SB)/4, $0x00000017
+DATA shiftCounts562<>+24(SB)/4, $0x00000017
+DATA shiftCounts562<>+28(SB)/4, $0x00000017
+GLOBL shiftCounts562<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts563<>+0(SB)/4, $0x00000002
+DATA shiftCounts563<>+4(SB)/4, $0x00000002
+DATA shiftCounts563<>+8(SB)/4, $0x00000002
+DATA shiftCounts563<>+12(SB)/4, $0x00000002
+DATA shiftCounts563<>+16(SB)/4, $0x0000001b
+DATA shiftCounts563<>+20(SB)/4, $0x0000001b
+DATA shiftCounts563<>+24(SB)/4, $0x0000001b
+DATA shiftCounts563<>+28(SB)/4, $0x0000001b
+GLOBL shiftCounts563<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts564<>+0(SB)/4, $0x00000014
+DATA shiftCounts564<>+4(SB)/4, $0x00000014
+DATA shiftCounts564<>+8(SB)/4, $0x00000014
+DATA shiftCounts564<>+12(SB)/4, $0x00000014
+DATA shiftCounts564<>+16(SB)/4, $0x0000000d
+DATA shiftCounts564<>+20(SB)/4, $0x0000000d
+DATA shiftCounts564<>+24(SB)/4, $0x0000000d
+DATA shiftCounts564<>+28(SB)/4, $0x0000000d
+GLOBL shiftCounts564<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts565<>+0(SB)/4, $0x00000006
+DATA shiftCounts565<>+4(SB)/4, $0x00000006
+DATA shiftCounts565<>+8(SB)/4, $0x00000006
+DATA shiftCounts565<>+12(SB)/4, $0x00000006
+DATA shiftCounts565<>+16(SB)/4, $0x0000001f
+DATA shiftCounts565<>+20(SB)/4, $0x0000001f
+DATA shiftCounts565<>+24(SB)/4, $0x0000001f
+DATA shiftCounts565<>+28(SB)/4, $0x0000001f
+GLOBL shiftCounts565<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts566<>+0(SB)/4, $0x0000001c
+DATA shiftCounts566<>+4(SB)/4, $0x0000001c
+DATA shiftCounts566<>+8(SB)/4, $0x0000001c
+DATA shiftCounts566<>+12(SB)/4, $0x0000001c
+DATA shiftCounts566<>+16(SB)/4, $0x00000015
+DATA shiftCounts566<>+20(SB)/4, $0x00000015
+DATA shiftCounts566<>+24(SB)/4, $0x00000015
+DATA shiftCounts566<>+28(SB)/4, $0x00000015
+GLOBL shiftCounts566<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts567<>+0(SB)/4, $0x0000000e
+DATA shiftCounts567<>+4(SB)/4, $0x0000000e
+DATA shiftCounts567<>+8(SB)/4, $0x0000000e
+DATA shiftCounts567<>+12(SB)/4, $0x0000000e
+DATA shiftCounts567<>+16(SB)/4, $0x00000007
+DATA shiftCounts567<>+20(SB)/4, $0x00000007
+DATA shiftCounts567<>+24(SB)/4, $0x00000007
+DATA shiftCounts567<>+28(SB)/4, $0x00000007
+GLOBL shiftCounts567<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_26(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_26(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x03ffffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts568<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts431<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VMOVDQU        16(AX), Y1
+	VPSRLVD        shiftCounts307<>+0(SB), Y1, Y1
+	VMOVDQU        32(AX), Y2
+	VPSLLVD        shiftCounts201<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VMOVDQU        48(AX), Y1
+	VPSRLVD        shiftCounts309<>+0(SB), Y1, Y1
+	VBROADCASTI128 64(AX), Y2
+	VPSLLVD        shiftCounts24<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VMOVDQU        64(AX), Y1
+	VPSRLVD        shiftCounts569<>+0(SB), Y1, Y1
+	VMOVDQU        80(AX), Y2
+	VPSLLVD        shiftCounts310<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VMOVDQU        96(AX), Y1
+	VPSRLVD        shiftCounts209<>+0(SB), Y1, Y1
+	VMOVDQU        112(AX), Y2
+	VPSLLVD        shiftCounts312<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VBROADCASTI128 128(AX), Y1
+	VPSRLVD        shiftCounts570<>+0(SB), Y1, Y1
+	VBROADCASTI128 144(AX), Y2
+	VPSLLVD        shiftCounts403<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VMOVDQU        144(AX), Y1
+	VPSRLVD        shiftCounts315<>+0(SB), Y1, Y1
+	VMOVDQU        160(AX), Y2
+	VPSLLVD        shiftCounts216<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VMOVDQU        176(AX), Y1
+	VPSRLVD        shiftCounts317<>+0(SB), Y1, Y1
+	VBROADCASTI128 192(AX), Y2
+	VPSLLVD        shiftCounts20<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VBROADCASTI128 208(AX), Y1
+	VPSRLVD        shiftCounts568<>+0(SB), Y1, Y1
+	VBROADCASTI128 224(AX), Y2
+	VPSLLVD        shiftCounts431<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VMOVDQU        224(AX), Y1
+	VPSRLVD        shiftCounts307<>+0(SB), Y1, Y1
+	VMOVDQU        240(AX), Y2
+	VPSLLVD        shiftCounts201<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VMOVDQU        256(AX), Y1
+	VPSRLVD        shiftCounts309<>+0(SB), Y1, Y1
+	VBROADCASTI128 272(AX), Y2
+	VPSLLVD        shiftCounts24<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VMOVDQU        272(AX), Y1
+	VPSRLVD        shiftCounts569<>+0(SB), Y1, Y1
+	VMOVDQU        288(AX), Y2
+	VPSLLVD        shiftCounts310<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VMOVDQU        304(AX), Y1
+	VPSRLVD        shiftCounts209<>+0(SB), Y1, Y1
+	VMOVDQU        320(AX), Y2
+	VPSLLVD        shiftCounts312<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VBROADCASTI128 336(AX), Y1
+	VPSRLVD        shiftCounts570<>+0(SB), Y1, Y1
+	VBROADCASTI128 352(AX), Y2
+	VPSLLVD        shiftCounts403<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VMOVDQU        352(AX), Y1
+	VPSRLVD        shiftCounts315<>+0(SB), Y1, Y1
+	VMOVDQU        368(AX), Y2
+	VPSLLVD        shiftCounts216<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VMOVDQU        384(AX), Y1
+	VPSRLVD        shiftCounts317<>+0(SB), Y1, Y1
+	VBROADCASTI128 400(AX), Y2
+	VPSLLVD        shiftCounts20<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts568<>+0(SB)/4, $0x00000000
+DATA shiftCounts568<>+4(SB)/4, $0x00000000
+DATA shiftCounts568<>+8(SB)/4, $0x00000000
+DATA shiftCounts568<>+12(SB)/4, $0x00000000
+DATA shiftCounts568<>+16(SB)/4, $0x0000001a
+DATA shiftCounts568<>+20(SB)/4, $0x0000001a
+DATA shiftCounts568<>+24(SB)/4, $0x0000001a
+DATA shiftCounts568<>+28(SB)/4, $0x0000001a
+GLOBL shiftCounts568<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts569<>+0(SB)/4, $0x0000001c
+DATA shiftCounts569<>+4(SB)/4, $0x0000001c
+DATA shiftCounts569<>+8(SB)/4, $0x0000001c
+DATA shiftCounts569<>+12(SB)/4, $0x0000001c
+DATA shiftCounts569<>+16(SB)/4, $0x00000016
+DATA shiftCounts569<>+20(SB)/4, $0x00000016
+DATA shiftCounts569<>+24(SB)/4, $0x00000016
+DATA shiftCounts569<>+28(SB)/4, $0x00000016
+GLOBL shiftCounts569<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts570<>+0(SB)/4, $0x00000004
+DATA shiftCounts570<>+4(SB)/4, $0x00000004
+DATA shiftCounts570<>+8(SB)/4, $0x00000004
+DATA shiftCounts570<>+12(SB)/4, $0x00000004
+DATA shiftCounts570<>+16(SB)/4, $0x0000001e
+DATA shiftCounts570<>+20(SB)/4, $0x0000001e
+DATA shiftCounts570<>+24(SB)/4, $0x0000001e
+DATA shiftCounts570<>+28(SB)/4, $0x0000001e
+GLOBL shiftCounts570<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_27(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_27(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x07ffffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts571<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts409<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VMOVDQU        16(AX), Y1
+	VPSRLVD        shiftCounts572<>+0(SB), Y1, Y1
+	VMOVDQU        32(AX), Y2
+	VPSLLVD        shiftCounts318<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VMOVDQU        48(AX), Y1
+	VPSRLVD        shiftCounts573<>+0(SB), Y1, Y1
+	VMOVDQU        64(AX), Y2
+	VPSLLVD        shiftCounts319<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VBROADCASTI128 80(AX), Y1
+	VPSRLVD        shiftCounts574<>+0(SB), Y1, Y1
+	VBROADCASTI128 96(AX), Y2
+	VPSLLVD        shiftCounts401<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VMOVDQU        96(AX), Y1
+	VPSRLVD        shiftCounts97<>+0(SB), Y1, Y1
+	VMOVDQU        112(AX), Y2
+	VPSLLVD        shiftCounts394<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VMOVDQU        128(AX), Y1
+	VPSRLVD        shiftCounts321<>+0(SB), Y1, Y1
+	VMOVDQU        144(AX), Y2
+	VPSLLVD        shiftCounts395<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VBROADCASTI128 160(AX), Y1
+	VPSRLVD        shiftCounts575<>+0(SB), Y1, Y1
+	VBROADCASTI128 176(AX), Y2
+	VPSLLVD        shiftCounts392<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VMOVDQU        176(AX), Y1
+	VPSRLVD        shiftCounts89<>+0(SB), Y1, Y1
+	VMOVDQU        192(AX), Y2
+	VPSLLVD        shiftCounts324<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VMOVDQU        208(AX), Y1
+	VPSRLVD        shiftCounts92<>+0(SB), Y1, Y1
+	VMOVDQU        224(AX), Y2
+	VPSLLVD        shiftCounts326<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VMOVDQU        240(AX), Y1
+	VPSRLVD        shiftCounts95<>+0(SB), Y1, Y1
+	VBROADCASTI128 256(AX), Y2
+	VPSLLVD        shiftCounts26<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VMOVDQU        256(AX), Y1
+	VPSRLVD        shiftCounts576<>+0(SB), Y1, Y1
+	VMOVDQU        272(AX), Y2
+	VPSLLVD        shiftCounts398<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VMOVDQU        288(AX), Y1
+	VPSRLVD        shiftCounts84<>+0(SB), Y1, Y1
+	VMOVDQU        304(AX), Y2
+	VPSLLVD        shiftCounts399<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VMOVDQU        320(AX), Y1
+	VPSRLVD        shiftCounts87<>+0(SB), Y1, Y1
+	VBROADCASTI128 336(AX), Y2
+	VPSLLVD        shiftCounts24<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VMOVDQU        336(AX), Y1
+	VPSRLVD        shiftCounts577<>+0(SB), Y1, Y1
+	VMOVDQU        352(AX), Y2
+	VPSLLVD        shiftCounts90<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VMOVDQU        368(AX), Y1
+	VPSRLVD        shiftCounts578<>+0(SB), Y1, Y1
+	VMOVDQU        384(AX), Y2
+	VPSLLVD        shiftCounts332<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VMOVDQU        400(AX), Y1
+	VPSRLVD        shiftCounts579<>+0(SB), Y1, Y1
+	VBROADCASTI128 416(AX), Y2
+	VPSLLVD        shiftCounts22<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts571<>+0(SB)/4, $0x00000000
+DATA shiftCounts571<>+4(SB)/4, $0x00000000
+DATA shiftCounts571<>+8(SB)/4, $0x00000000
+DATA shiftCounts571<>+12(SB)/4, $0x00000000
+DATA shiftCounts571<>+16(SB)/4, $0x0000001b
+DATA shiftCounts571<>+20(SB)/4, $0x0000001b
+DATA shiftCounts571<>+24(SB)/4, $0x0000001b
+DATA shiftCounts571<>+28(SB)/4, $0x0000001b
+GLOBL shiftCounts571<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts572<>+0(SB)/4, $0x00000016
+DATA shiftCounts572<>+4(SB)/4, $0x00000016
+DATA shiftCounts572<>+8(SB)/4, $0x00000016
+DATA shiftCounts572<>+12(SB)/4, $0x00000016
+DATA shiftCounts572<>+16(SB)/4, $0x00000011
+DATA shiftCounts572<>+20(SB)/4, $0x00000011
+DATA shiftCounts572<>+24(SB)/4, $0x00000011
+DATA shiftCounts572<>+28(SB)/4, $0x00000011
+GLOBL shiftCounts572<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts573<>+0(SB)/4, $0x0000000c
+DATA shiftCounts573<>+4(SB)/4, $0x0000000c
+DATA shiftCounts573<>+8(SB)/4, $0x0000000c
+DATA shiftCounts573<>+12(SB)/4, $0x0000000c
+DATA shiftCounts573<>+16(SB)/4, $0x00000007
+DATA shiftCounts573<>+20(SB)/4, $0x00000007
+DATA shiftCounts573<>+24(SB)/4, $0x00000007
+DATA shiftCounts573<>+28(SB)/4, $0x00000007
+GLOBL shiftCounts573<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts574<>+0(SB)/4, $0x00000002
+DATA shiftCounts574<>+4(SB)/4, $0x00000002
+DATA shiftCounts574<>+8(SB)/4, $0x00000002
+DATA shiftCounts574<>+12(SB)/4, $0x00000002
+DATA shiftCounts574<>+16(SB)/4, $0x0000001d
+DATA shiftCounts574<>+20(SB)/4, $0x0000001d
+DATA shiftCounts574<>+24(SB)/4, $0x0000001d
+DATA shiftCounts574<>+28(SB)/4, $0x0000001d
+GLOBL shiftCounts574<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts575<>+0(SB)/4, $0x00000004
+DATA shiftCounts575<>+4(SB)/4, $0x00000004
+DATA shiftCounts575<>+8(SB)/4, $0x00000004
+DATA shiftCounts575<>+12(SB)/4, $0x00000004
+DATA shiftCounts575<>+16(SB)/4, $0x0000001f
+DATA shiftCounts575<>+20(SB)/4, $0x0000001f
+DATA shiftCounts575<>+24(SB)/4, $0x0000001f
+DATA shiftCounts575<>+28(SB)/4, $0x0000001f
+GLOBL shiftCounts575<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts576<>+0(SB)/4, $0x0000001c
+DATA shiftCounts576<>+4(SB)/4, $0x0000001c
+DATA shiftCounts576<>+8(SB)/4, $0x0000001c
+DATA shiftCounts576<>+12(SB)/4, $0x0000001c
+DATA shiftCounts576<>+16(SB)/4, $0x00000017
+DATA shiftCounts576<>+20(SB)/4, $0x00000017
+DATA shiftCounts576<>+24(SB)/4, $0x00000017
+DATA shiftCounts576<>+28(SB)/4, $0x00000017
+GLOBL shiftCounts576<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts577<>+0(SB)/4, $0x0000001e
+DATA shiftCounts577<>+4(SB)/4, $0x0000001e
+DATA shiftCounts577<>+8(SB)/4, $0x0000001e
+DATA shiftCounts577<>+12(SB)/4, $0x0000001e
+DATA shiftCounts577<>+16(SB)/4, $0x00000019
+DATA shiftCounts577<>+20(SB)/4, $0x00000019
+DATA shiftCounts577<>+24(SB)/4, $0x00000019
+DATA shiftCounts577<>+28(SB)/4, $0x00000019
+GLOBL shiftCounts577<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts578<>+0(SB)/4, $0x00000014
+DATA shiftCounts578<>+4(SB)/4, $0x00000014
+DATA shiftCounts578<>+8(SB)/4, $0x00000014
+DATA shiftCounts578<>+12(SB)/4, $0x00000014
+DATA shiftCounts578<>+16(SB)/4, $0x0000000f
+DATA shiftCounts578<>+20(SB)/4, $0x0000000f
+DATA shiftCounts578<>+24(SB)/4, $0x0000000f
+DATA shiftCounts578<>+28(SB)/4, $0x0000000f
+GLOBL shiftCounts578<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts579<>+0(SB)/4, $0x0000000a
+DATA shiftCounts579<>+4(SB)/4, $0x0000000a
+DATA shiftCounts579<>+8(SB)/4, $0x0000000a
+DATA shiftCounts579<>+12(SB)/4, $0x0000000a
+DATA shiftCounts579<>+16(SB)/4, $0x00000005
+DATA shiftCounts579<>+20(SB)/4, $0x00000005
+DATA shiftCounts579<>+24(SB)/4, $0x00000005
+DATA shiftCounts579<>+28(SB)/4, $0x00000005
+GLOBL shiftCounts579<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_28(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_28(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x0fffffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts580<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts447<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VMOVDQU        16(AX), Y1
+	VPSRLVD        shiftCounts156<>+0(SB), Y1, Y1
+	VMOVDQU        32(AX), Y2
+	VPSLLVD        shiftCounts57<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VMOVDQU        48(AX), Y1
+	VPSRLVD        shiftCounts195<>+0(SB), Y1, Y1
+	VMOVDQU        64(AX), Y2
+	VPSLLVD        shiftCounts133<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VMOVDQU        80(AX), Y1
+	VPSRLVD        shiftCounts131<>+0(SB), Y1, Y1
+	VBROADCASTI128 96(AX), Y2
+	VPSLLVD        shiftCounts24<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VBROADCASTI128 112(AX), Y1
+	VPSRLVD        shiftCounts580<>+0(SB), Y1, Y1
+	VBROADCASTI128 128(AX), Y2
+	VPSLLVD        shiftCounts447<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VMOVDQU        128(AX), Y1
+	VPSRLVD        shiftCounts156<>+0(SB), Y1, Y1
+	VMOVDQU        144(AX), Y2
+	VPSLLVD        shiftCounts57<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VMOVDQU        160(AX), Y1
+	VPSRLVD        shiftCounts195<>+0(SB), Y1, Y1
+	VMOVDQU        176(AX), Y2
+	VPSLLVD        shiftCounts133<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VMOVDQU        192(AX), Y1
+	VPSRLVD        shiftCounts131<>+0(SB), Y1, Y1
+	VBROADCASTI128 208(AX), Y2
+	VPSLLVD        shiftCounts24<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VBROADCASTI128 224(AX), Y1
+	VPSRLVD        shiftCounts580<>+0(SB), Y1, Y1
+	VBROADCASTI128 240(AX), Y2
+	VPSLLVD        shiftCounts447<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VMOVDQU        240(AX), Y1
+	VPSRLVD        shiftCounts156<>+0(SB), Y1, Y1
+	VMOVDQU        256(AX), Y2
+	VPSLLVD        shiftCounts57<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VMOVDQU        272(AX), Y1
+	VPSRLVD        shiftCounts195<>+0(SB), Y1, Y1
+	VMOVDQU        288(AX), Y2
+	VPSLLVD        shiftCounts133<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VMOVDQU        304(AX), Y1
+	VPSRLVD        shiftCounts131<>+0(SB), Y1, Y1
+	VBROADCASTI128 320(AX), Y2
+	VPSLLVD        shiftCounts24<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VBROADCASTI128 336(AX), Y1
+	VPSRLVD        shiftCounts580<>+0(SB), Y1, Y1
+	VBROADCASTI128 352(AX), Y2
+	VPSLLVD        shiftCounts447<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VMOVDQU        352(AX), Y1
+	VPSRLVD        shiftCounts156<>+0(SB), Y1, Y1
+	VMOVDQU        368(AX), Y2
+	VPSLLVD        shiftCounts57<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VMOVDQU        384(AX), Y1
+	VPSRLVD        shiftCounts195<>+0(SB), Y1, Y1
+	VMOVDQU        400(AX), Y2
+	VPSLLVD        shiftCounts133<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VMOVDQU        416(AX), Y1
+	VPSRLVD        shiftCounts131<>+0(SB), Y1, Y1
+	VBROADCASTI128 432(AX), Y2
+	VPSLLVD        shiftCounts24<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts580<>+0(SB)/4, $0x00000000
+DATA shiftCounts580<>+4(SB)/4, $0x00000000
+DATA shiftCounts580<>+8(SB)/4, $0x00000000
+DATA shiftCounts580<>+12(SB)/4, $0x00000000
+DATA shiftCounts580<>+16(SB)/4, $0x0000001c
+DATA shiftCounts580<>+20(SB)/4, $0x0000001c
+DATA shiftCounts580<>+24(SB)/4, $0x0000001c
+DATA shiftCounts580<>+28(SB)/4, $0x0000001c
+GLOBL shiftCounts580<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_29(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_29(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x1fffffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts581<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts401<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VMOVDQU        16(AX), Y1
+	VPSRLVD        shiftCounts582<>+0(SB), Y1, Y1
+	VMOVDQU        32(AX), Y2
+	VPSLLVD        shiftCounts50<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VMOVDQU        48(AX), Y1
+	VPSRLVD        shiftCounts583<>+0(SB), Y1, Y1
+	VMOVDQU        64(AX), Y2
+	VPSLLVD        shiftCounts338<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VMOVDQU        80(AX), Y1
+	VPSRLVD        shiftCounts584<>+0(SB), Y1, Y1
+	VMOVDQU        96(AX), Y2
+	VPSLLVD        shiftCounts340<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VMOVDQU        112(AX), Y1
+	VPSRLVD        shiftCounts585<>+0(SB), Y1, Y1
+	VMOVDQU        128(AX), Y2
+	VPSLLVD        shiftCounts342<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VBROADCASTI128 144(AX), Y1
+	VPSRLVD        shiftCounts586<>+0(SB), Y1, Y1
+	VBROADCASTI128 160(AX), Y2
+	VPSLLVD        shiftCounts392<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VMOVDQU        160(AX), Y1
+	VPSRLVD        shiftCounts344<>+0(SB), Y1, Y1
+	VMOVDQU        176(AX), Y2
+	VPSLLVD        shiftCounts388<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VMOVDQU        192(AX), Y1
+	VPSRLVD        shiftCounts346<>+0(SB), Y1, Y1
+	VMOVDQU        208(AX), Y2
+	VPSLLVD        shiftCounts389<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VMOVDQU        224(AX), Y1
+	VPSRLVD        shiftCounts348<>+0(SB), Y1, Y1
+	VMOVDQU        240(AX), Y2
+	VPSLLVD        shiftCounts52<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VMOVDQU        256(AX), Y1
+	VPSRLVD        shiftCounts350<>+0(SB), Y1, Y1
+	VMOVDQU        272(AX), Y2
+	VPSLLVD        shiftCounts390<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VMOVDQU        288(AX), Y1
+	VPSRLVD        shiftCounts48<>+0(SB), Y1, Y1
+	VBROADCASTI128 304(AX), Y2
+	VPSLLVD        shiftCounts28<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VMOVDQU        304(AX), Y1
+	VPSRLVD        shiftCounts587<>+0(SB), Y1, Y1
+	VMOVDQU        320(AX), Y2
+	VPSLLVD        shiftCounts352<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VMOVDQU        336(AX), Y1
+	VPSRLVD        shiftCounts588<>+0(SB), Y1, Y1
+	VMOVDQU        352(AX), Y2
+	VPSLLVD        shiftCounts354<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VMOVDQU        368(AX), Y1
+	VPSRLVD        shiftCounts589<>+0(SB), Y1, Y1
+	VMOVDQU        384(AX), Y2
+	VPSLLVD        shiftCounts356<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VMOVDQU        400(AX), Y1
+	VPSRLVD        shiftCounts590<>+0(SB), Y1, Y1
+	VMOVDQU        416(AX), Y2
+	VPSLLVD        shiftCounts358<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VMOVDQU        432(AX), Y1
+	VPSRLVD        shiftCounts591<>+0(SB), Y1, Y1
+	VBROADCASTI128 448(AX), Y2
+	VPSLLVD        shiftCounts26<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts581<>+0(SB)/4, $0x00000000
+DATA shiftCounts581<>+4(SB)/4, $0x00000000
+DATA shiftCounts581<>+8(SB)/4, $0x00000000
+DATA shiftCounts581<>+12(SB)/4, $0x00000000
+DATA shiftCounts581<>+16(SB)/4, $0x0000001d
+DATA shiftCounts581<>+20(SB)/4, $0x0000001d
+DATA shiftCounts581<>+24(SB)/4, $0x0000001d
+DATA shiftCounts581<>+28(SB)/4, $0x0000001d
+GLOBL shiftCounts581<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts582<>+0(SB)/4, $0x0000001a
+DATA shiftCounts582<>+4(SB)/4, $0x0000001a
+DATA shiftCounts582<>+8(SB)/4, $0x0000001a
+DATA shiftCounts582<>+12(SB)/4, $0x0000001a
+DATA shiftCounts582<>+16(SB)/4, $0x00000017
+DATA shiftCounts582<>+20(SB)/4, $0x00000017
+DATA shiftCounts582<>+24(SB)/4, $0x00000017
+DATA shiftCounts582<>+28(SB)/4, $0x00000017
+GLOBL shiftCounts582<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts583<>+0(SB)/4, $0x00000014
+DATA shiftCounts583<>+4(SB)/4, $0x00000014
+DATA shiftCounts583<>+8(SB)/4, $0x00000014
+DATA shiftCounts583<>+12(SB)/4, $0x00000014
+DATA shiftCounts583<>+16(SB)/4, $0x00000011
+DATA shiftCounts583<>+20(SB)/4, $0x00000011
+DATA shiftCounts583<>+24(SB)/4, $0x00000011
+DATA shiftCounts583<>+28(SB)/4, $0x00000011
+GLOBL shiftCounts583<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts584<>+0(SB)/4, $0x0000000e
+DATA shiftCounts584<>+4(SB)/4, $0x0000000e
+DATA shiftCounts584<>+8(SB)/4, $0x0000000e
+DATA shiftCounts584<>+12(SB)/4, $0x0000000e
+DATA shiftCounts584<>+16(SB)/4, $0x0000000b
+DATA shiftCounts584<>+20(SB)/4, $0x0000000b
+DATA shiftCounts584<>+24(SB)/4, $0x0000000b
+DATA shiftCounts584<>+28(SB)/4, $0x0000000b
+GLOBL shiftCounts584<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts585<>+0(SB)/4, $0x00000008
+DATA shiftCounts585<>+4(SB)/4, $0x00000008
+DATA shiftCounts585<>+8(SB)/4, $0x00000008
+DATA shiftCounts585<>+12(SB)/4, $0x00000008
+DATA shiftCounts585<>+16(SB)/4, $0x00000005
+DATA shiftCounts585<>+20(SB)/4, $0x00000005
+DATA shiftCounts585<>+24(SB)/4, $0x00000005
+DATA shiftCounts585<>+28(SB)/4, $0x00000005
+GLOBL shiftCounts585<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts586<>+0(SB)/4, $0x00000002
+DATA shiftCounts586<>+4(SB)/4, $0x00000002
+DATA shiftCounts586<>+8(SB)/4, $0x00000002
+DATA shiftCounts586<>+12(SB)/4, $0x00000002
+DATA shiftCounts586<>+16(SB)/4, $0x0000001f
+DATA shiftCounts586<>+20(SB)/4, $0x0000001f
+DATA shiftCounts586<>+24(SB)/4, $0x0000001f
+DATA shiftCounts586<>+28(SB)/4, $0x0000001f
+GLOBL shiftCounts586<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts587<>+0(SB)/4, $0x0000001e
+DATA shiftCounts587<>+4(SB)/4, $0x0000001e
+DATA shiftCounts587<>+8(SB)/4, $0x0000001e
+DATA shiftCounts587<>+12(SB)/4, $0x0000001e
+DATA shiftCounts587<>+16(SB)/4, $0x0000001b
+DATA shiftCounts587<>+20(SB)/4, $0x0000001b
+DATA shiftCounts587<>+24(SB)/4, $0x0000001b
+DATA shiftCounts587<>+28(SB)/4, $0x0000001b
+GLOBL shiftCounts587<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts588<>+0(SB)/4, $0x00000018
+DATA shiftCounts588<>+4(SB)/4, $0x00000018
+DATA shiftCounts588<>+8(SB)/4, $0x00000018
+DATA shiftCounts588<>+12(SB)/4, $0x00000018
+DATA shiftCounts588<>+16(SB)/4, $0x00000015
+DATA shiftCounts588<>+20(SB)/4, $0x00000015
+DATA shiftCounts588<>+24(SB)/4, $0x00000015
+DATA shiftCounts588<>+28(SB)/4, $0x00000015
+GLOBL shiftCounts588<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts589<>+0(SB)/4, $0x00000012
+DATA shiftCounts589<>+4(SB)/4, $0x00000012
+DATA shiftCounts589<>+8(SB)/4, $0x00000012
+DATA shiftCounts589<>+12(SB)/4, $0x00000012
+DATA shiftCounts589<>+16(SB)/4, $0x0000000f
+DATA shiftCounts589<>+20(SB)/4, $0x0000000f
+DATA shiftCounts589<>+24(SB)/4, $0x0000000f
+DATA shiftCounts589<>+28(SB)/4, $0x0000000f
+GLOBL shiftCounts589<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts590<>+0(SB)/4, $0x0000000c
+DATA shiftCounts590<>+4(SB)/4, $0x0000000c
+DATA shiftCounts590<>+8(SB)/4, $0x0000000c
+DATA shiftCounts590<>+12(SB)/4, $0x0000000c
+DATA shiftCounts590<>+16(SB)/4, $0x00000009
+DATA shiftCounts590<>+20(SB)/4, $0x00000009
+DATA shiftCounts590<>+24(SB)/4, $0x00000009
+DATA shiftCounts590<>+28(SB)/4, $0x00000009
+GLOBL shiftCounts590<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts591<>+0(SB)/4, $0x00000006
+DATA shiftCounts591<>+4(SB)/4, $0x00000006
+DATA shiftCounts591<>+8(SB)/4, $0x00000006
+DATA shiftCounts591<>+12(SB)/4, $0x00000006
+DATA shiftCounts591<>+16(SB)/4, $0x00000003
+DATA shiftCounts591<>+20(SB)/4, $0x00000003
+DATA shiftCounts591<>+24(SB)/4, $0x00000003
+DATA shiftCounts591<>+28(SB)/4, $0x00000003
+GLOBL shiftCounts591<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_30(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_30(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x3fffffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts592<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts403<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VMOVDQU        16(AX), Y1
+	VPSRLVD        shiftCounts65<>+0(SB), Y1, Y1
+	VMOVDQU        32(AX), Y2
+	VPSLLVD        shiftCounts99<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VMOVDQU        48(AX), Y1
+	VPSRLVD        shiftCounts40<>+0(SB), Y1, Y1
+	VMOVDQU        64(AX), Y2
+	VPSLLVD        shiftCounts163<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VMOVDQU        80(AX), Y1
+	VPSRLVD        shiftCounts46<>+0(SB), Y1, Y1
+	VMOVDQU        96(AX), Y2
+	VPSLLVD        shiftCounts165<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VMOVDQU        112(AX), Y1
+	VPSRLVD        shiftCounts63<>+0(SB), Y1, Y1
+	VMOVDQU        128(AX), Y2
+	VPSLLVD        shiftCounts360<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VMOVDQU        144(AX), Y1
+	VPSRLVD        shiftCounts36<>+0(SB), Y1, Y1
+	VMOVDQU        160(AX), Y2
+	VPSLLVD        shiftCounts181<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VMOVDQU        176(AX), Y1
+	VPSRLVD        shiftCounts105<>+0(SB), Y1, Y1
+	VMOVDQU        192(AX), Y2
+	VPSLLVD        shiftCounts183<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VMOVDQU        208(AX), Y1
+	VPSRLVD        shiftCounts62<>+0(SB), Y1, Y1
+	VBROADCASTI128 224(AX), Y2
+	VPSLLVD        shiftCounts28<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VBROADCASTI128 240(AX), Y1
+	VPSRLVD        shiftCounts592<>+0(SB), Y1, Y1
+	VBROADCASTI128 256(AX), Y2
+	VPSLLVD        shiftCounts403<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VMOVDQU        256(AX), Y1
+	VPSRLVD        shiftCounts65<>+0(SB), Y1, Y1
+	VMOVDQU        272(AX), Y2
+	VPSLLVD        shiftCounts99<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VMOVDQU        288(AX), Y1
+	VPSRLVD        shiftCounts40<>+0(SB), Y1, Y1
+	VMOVDQU        304(AX), Y2
+	VPSLLVD        shiftCounts163<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VMOVDQU        320(AX), Y1
+	VPSRLVD        shiftCounts46<>+0(SB), Y1, Y1
+	VMOVDQU        336(AX), Y2
+	VPSLLVD        shiftCounts165<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VMOVDQU        352(AX), Y1
+	VPSRLVD        shiftCounts63<>+0(SB), Y1, Y1
+	VMOVDQU        368(AX), Y2
+	VPSLLVD        shiftCounts360<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VMOVDQU        384(AX), Y1
+	VPSRLVD        shiftCounts36<>+0(SB), Y1, Y1
+	VMOVDQU        400(AX), Y2
+	VPSLLVD        shiftCounts181<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VMOVDQU        416(AX), Y1
+	VPSRLVD        shiftCounts105<>+0(SB), Y1, Y1
+	VMOVDQU        432(AX), Y2
+	VPSLLVD        shiftCounts183<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VMOVDQU        448(AX), Y1
+	VPSRLVD        shiftCounts62<>+0(SB), Y1, Y1
+	VBROADCASTI128 464(AX), Y2
+	VPSLLVD        shiftCounts28<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts592<>+0(SB)/4, $0x00000000
+DATA shiftCounts592<>+4(SB)/4, $0x00000000
+DATA shiftCounts592<>+8(SB)/4, $0x00000000
+DATA shiftCounts592<>+12(SB)/4, $0x00000000
+DATA shiftCounts592<>+16(SB)/4, $0x0000001e
+DATA shiftCounts592<>+20(SB)/4, $0x0000001e
+DATA shiftCounts592<>+24(SB)/4, $0x0000001e
+DATA shiftCounts592<>+28(SB)/4, $0x0000001e
+GLOBL shiftCounts592<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_31(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpack32AVX2_31(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x7fffffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VBROADCASTI128 (AX), Y1
+	VPSRLVD        shiftCounts593<>+0(SB), Y1, Y1
+	VBROADCASTI128 16(AX), Y2
+	VPSLLVD        shiftCounts392<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, (CX)
+	VMOVDQU        16(AX), Y1
+	VPSRLVD        shiftCounts594<>+0(SB), Y1, Y1
+	VMOVDQU        32(AX), Y2
+	VPSLLVD        shiftCounts113<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 32(CX)
+	VMOVDQU        48(AX), Y1
+	VPSRLVD        shiftCounts595<>+0(SB), Y1, Y1
+	VMOVDQU        64(AX), Y2
+	VPSLLVD        shiftCounts117<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 64(CX)
+	VMOVDQU        80(AX), Y1
+	VPSRLVD        shiftCounts596<>+0(SB), Y1, Y1
+	VMOVDQU        96(AX), Y2
+	VPSLLVD        shiftCounts121<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 96(CX)
+	VMOVDQU        112(AX), Y1
+	VPSRLVD        shiftCounts597<>+0(SB), Y1, Y1
+	VMOVDQU        128(AX), Y2
+	VPSLLVD        shiftCounts125<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 128(CX)
+	VMOVDQU        144(AX), Y1
+	VPSRLVD        shiftCounts598<>+0(SB), Y1, Y1
+	VMOVDQU        160(AX), Y2
+	VPSLLVD        shiftCounts374<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 160(CX)
+	VMOVDQU        176(AX), Y1
+	VPSRLVD        shiftCounts599<>+0(SB), Y1, Y1
+	VMOVDQU        192(AX), Y2
+	VPSLLVD        shiftCounts376<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 192(CX)
+	VMOVDQU        208(AX), Y1
+	VPSRLVD        shiftCounts600<>+0(SB), Y1, Y1
+	VMOVDQU        224(AX), Y2
+	VPSLLVD        shiftCounts378<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 224(CX)
+	VMOVDQU        240(AX), Y1
+	VPSRLVD        shiftCounts601<>+0(SB), Y1, Y1
+	VMOVDQU        256(AX), Y2
+	VPSLLVD        shiftCounts380<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 256(CX)
+	VMOVDQU        272(AX), Y1
+	VPSRLVD        shiftCounts602<>+0(SB), Y1, Y1
+	VMOVDQU        288(AX), Y2
+	VPSLLVD        shiftCounts382<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 288(CX)
+	VMOVDQU        304(AX), Y1
+	VPSRLVD        shiftCounts603<>+0(SB), Y1, Y1
+	VMOVDQU        320(AX), Y2
+	VPSLLVD        shiftCounts384<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 320(CX)
+	VMOVDQU        336(AX), Y1
+	VPSRLVD        shiftCounts604<>+0(SB), Y1, Y1
+	VMOVDQU        352(AX), Y2
+	VPSLLVD        shiftCounts386<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 352(CX)
+	VMOVDQU        368(AX), Y1
+	VPSRLVD        shiftCounts605<>+0(SB), Y1, Y1
+	VMOVDQU        384(AX), Y2
+	VPSLLVD        shiftCounts115<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 384(CX)
+	VMOVDQU        400(AX), Y1
+	VPSRLVD        shiftCounts606<>+0(SB), Y1, Y1
+	VMOVDQU        416(AX), Y2
+	VPSLLVD        shiftCounts119<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 416(CX)
+	VMOVDQU        432(AX), Y1
+	VPSRLVD        shiftCounts607<>+0(SB), Y1, Y1
+	VMOVDQU        448(AX), Y2
+	VPSLLVD        shiftCounts123<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 448(CX)
+	VMOVDQU        464(AX), Y1
+	VPSRLVD        shiftCounts608<>+0(SB), Y1, Y1
+	VBROADCASTI128 480(AX), Y2
+	VPSLLVD        shiftCounts30<>+0(SB), Y2, Y2
+	VPOR           Y2, Y1, Y1
+	VPAND          Y0, Y1, Y1
+	VMOVDQU        Y1, 480(CX)
+	VZEROUPPER
+	RET
+
+DATA shiftCounts593<>+0(SB)/4, $0x00000000
+DATA shiftCounts593<>+4(SB)/4, $0x00000000
+DATA shiftCounts593<>+8(SB)/4, $0x00000000
+DATA shiftCounts593<>+12(SB)/4, $0x00000000
+DATA shiftCounts593<>+16(SB)/4, $0x0000001f
+DATA shiftCounts593<>+20(SB)/4, $0x0000001f
+DATA shiftCounts593<>+24(SB)/4, $0x0000001f
+DATA shiftCounts593<>+28(SB)/4, $0x0000001f
+GLOBL shiftCounts593<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts594<>+0(SB)/4, $0x0000001e
+DATA shiftCounts594<>+4(SB)/4, $0x0000001e
+DATA shiftCounts594<>+8(SB)/4, $0x0000001e
+DATA shiftCounts594<>+12(SB)/4, $0x0000001e
+DATA shiftCounts594<>+16(SB)/4, $0x0000001d
+DATA shiftCounts594<>+20(SB)/4, $0x0000001d
+DATA shiftCounts594<>+24(SB)/4, $0x0000001d
+DATA shiftCounts594<>+28(SB)/4, $0x0000001d
+GLOBL shiftCounts594<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts595<>+0(SB)/4, $0x0000001c
+DATA shiftCounts595<>+4(SB)/4, $0x0000001c
+DATA shiftCounts595<>+8(SB)/4, $0x0000001c
+DATA shiftCounts595<>+12(SB)/4, $0x0000001c
+DATA shiftCounts595<>+16(SB)/4, $0x0000001b
+DATA shiftCounts595<>+20(SB)/4, $0x0000001b
+DATA shiftCounts595<>+24(SB)/4, $0x0000001b
+DATA shiftCounts595<>+28(SB)/4, $0x0000001b
+GLOBL shiftCounts595<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts596<>+0(SB)/4, $0x0000001a
+DATA shiftCounts596<>+4(SB)/4, $0x0000001a
+DATA shiftCounts596<>+8(SB)/4, $0x0000001a
+DATA shiftCounts596<>+12(SB)/4, $0x0000001a
+DATA shiftCounts596<>+16(SB)/4, $0x00000019
+DATA shiftCounts596<>+20(SB)/4, $0x00000019
+DATA shiftCounts596<>+24(SB)/4, $0x00000019
+DATA shiftCounts596<>+28(SB)/4, $0x00000019
+GLOBL shiftCounts596<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts597<>+0(SB)/4, $0x00000018
+DATA shiftCounts597<>+4(SB)/4, $0x00000018
+DATA shiftCounts597<>+8(SB)/4, $0x00000018
+DATA shiftCounts597<>+12(SB)/4, $0x00000018
+DATA shiftCounts597<>+16(SB)/4, $0x00000017
+DATA shiftCounts597<>+20(SB)/4, $0x00000017
+DATA shiftCounts597<>+24(SB)/4, $0x00000017
+DATA shiftCounts597<>+28(SB)/4, $0x00000017
+GLOBL shiftCounts597<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts598<>+0(SB)/4, $0x00000016
+DATA shiftCounts598<>+4(SB)/4, $0x00000016
+DATA shiftCounts598<>+8(SB)/4, $0x00000016
+DATA shiftCounts598<>+12(SB)/4, $0x00000016
+DATA shiftCounts598<>+16(SB)/4, $0x00000015
+DATA shiftCounts598<>+20(SB)/4, $0x00000015
+DATA shiftCounts598<>+24(SB)/4, $0x00000015
+DATA shiftCounts598<>+28(SB)/4, $0x00000015
+GLOBL shiftCounts598<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts599<>+0(SB)/4, $0x00000014
+DATA shiftCounts599<>+4(SB)/4, $0x00000014
+DATA shiftCounts599<>+8(SB)/4, $0x00000014
+DATA shiftCounts599<>+12(SB)/4, $0x00000014
+DATA shiftCounts599<>+16(SB)/4, $0x00000013
+DATA shiftCounts599<>+20(SB)/4, $0x00000013
+DATA shiftCounts599<>+24(SB)/4, $0x00000013
+DATA shiftCounts599<>+28(SB)/4, $0x00000013
+GLOBL shiftCounts599<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts600<>+0(SB)/4, $0x00000012
+DATA shiftCounts600<>+4(SB)/4, $0x00000012
+DATA shiftCounts600<>+8(SB)/4, $0x00000012
+DATA shiftCounts600<>+12(SB)/4, $0x00000012
+DATA shiftCounts600<>+16(SB)/4, $0x00000011
+DATA shiftCounts600<>+20(SB)/4, $0x00000011
+DATA shiftCounts600<>+24(SB)/4, $0x00000011
+DATA shiftCounts600<>+28(SB)/4, $0x00000011
+GLOBL shiftCounts600<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts601<>+0(SB)/4, $0x00000010
+DATA shiftCounts601<>+4(SB)/4, $0x00000010
+DATA shiftCounts601<>+8(SB)/4, $0x00000010
+DATA shiftCounts601<>+12(SB)/4, $0x00000010
+DATA shiftCounts601<>+16(SB)/4, $0x0000000f
+DATA shiftCounts601<>+20(SB)/4, $0x0000000f
+DATA shiftCounts601<>+24(SB)/4, $0x0000000f
+DATA shiftCounts601<>+28(SB)/4, $0x0000000f
+GLOBL shiftCounts601<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts602<>+0(SB)/4, $0x0000000e
+DATA shiftCounts602<>+4(SB)/4, $0x0000000e
+DATA shiftCounts602<>+8(SB)/4, $0x0000000e
+DATA shiftCounts602<>+12(SB)/4, $0x0000000e
+DATA shiftCounts602<>+16(SB)/4, $0x0000000d
+DATA shiftCounts602<>+20(SB)/4, $0x0000000d
+DATA shiftCounts602<>+24(SB)/4, $0x0000000d
+DATA shiftCounts602<>+28(SB)/4, $0x0000000d
+GLOBL shiftCounts602<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts603<>+0(SB)/4, $0x0000000c
+DATA shiftCounts603<>+4(SB)/4, $0x0000000c
+DATA shiftCounts603<>+8(SB)/4, $0x0000000c
+DATA shiftCounts603<>+12(SB)/4, $0x0000000c
+DATA shiftCounts603<>+16(SB)/4, $0x0000000b
+DATA shiftCounts603<>+20(SB)/4, $0x0000000b
+DATA shiftCounts603<>+24(SB)/4, $0x0000000b
+DATA shiftCounts603<>+28(SB)/4, $0x0000000b
+GLOBL shiftCounts603<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts604<>+0(SB)/4, $0x0000000a
+DATA shiftCounts604<>+4(SB)/4, $0x0000000a
+DATA shiftCounts604<>+8(SB)/4, $0x0000000a
+DATA shiftCounts604<>+12(SB)/4, $0x0000000a
+DATA shiftCounts604<>+16(SB)/4, $0x00000009
+DATA shiftCounts604<>+20(SB)/4, $0x00000009
+DATA shiftCounts604<>+24(SB)/4, $0x00000009
+DATA shiftCounts604<>+28(SB)/4, $0x00000009
+GLOBL shiftCounts604<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts605<>+0(SB)/4, $0x00000008
+DATA shiftCounts605<>+4(SB)/4, $0x00000008
+DATA shiftCounts605<>+8(SB)/4, $0x00000008
+DATA shiftCounts605<>+12(SB)/4, $0x00000008
+DATA shiftCounts605<>+16(SB)/4, $0x00000007
+DATA shiftCounts605<>+20(SB)/4, $0x00000007
+DATA shiftCounts605<>+24(SB)/4, $0x00000007
+DATA shiftCounts605<>+28(SB)/4, $0x00000007
+GLOBL shiftCounts605<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts606<>+0(SB)/4, $0x00000006
+DATA shiftCounts606<>+4(SB)/4, $0x00000006
+DATA shiftCounts606<>+8(SB)/4, $0x00000006
+DATA shiftCounts606<>+12(SB)/4, $0x00000006
+DATA shiftCounts606<>+16(SB)/4, $0x00000005
+DATA shiftCounts606<>+20(SB)/4, $0x00000005
+DATA shiftCounts606<>+24(SB)/4, $0x00000005
+DATA shiftCounts606<>+28(SB)/4, $0x00000005
+GLOBL shiftCounts606<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts607<>+0(SB)/4, $0x00000004
+DATA shiftCounts607<>+4(SB)/4, $0x00000004
+DATA shiftCounts607<>+8(SB)/4, $0x00000004
+DATA shiftCounts607<>+12(SB)/4, $0x00000004
+DATA shiftCounts607<>+16(SB)/4, $0x00000003
+DATA shiftCounts607<>+20(SB)/4, $0x00000003
+DATA shiftCounts607<>+24(SB)/4, $0x00000003
+DATA shiftCounts607<>+28(SB)/4, $0x00000003
+GLOBL shiftCounts607<>(SB), RODATA|NOPTR, $32
+
+DATA shiftCounts608<>+0(SB)/4, $0x00000002
+DATA shiftCounts608<>+4(SB)/4, $0x00000002
+DATA shiftCounts608<>+8(SB)/4, $0x00000002
+DATA shiftCounts608<>+12(SB)/4, $0x00000002
+DATA shiftCounts608<>+16(SB)/4, $0x00000001
+DATA shiftCounts608<>+20(SB)/4, $0x00000001
+DATA shiftCounts608<>+24(SB)/4, $0x00000001
+DATA shiftCounts608<>+28(SB)/4, $0x00000001
+GLOBL shiftCounts608<>(SB), RODATA|NOPTR, $32
+
+// func unpack32AVX2_32(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX
+TEXT ·unpack32AVX2_32(SB), NOSPLIT, $0-32
+	MOVQ    in+0(FP), AX
+	MOVQ    out+8(FP), CX
+	MOVQ    offset+16(FP), DX
+	SHLQ    $0x02, DX
+	ADDQ    DX, CX
+	VMOVDQU (AX), Y0
+	VMOVDQU Y0, (CX)
+	VMOVDQU 32(AX), Y0
+	VMOVDQU Y0, 32(CX)
+	VMOVDQU 64(AX), Y0
+	VMOVDQU Y0, 64(CX)
+	VMOVDQU 96(AX), Y0
+	VMOVDQU Y0, 96(CX)
+	VMOVDQU 128(AX), Y0
+	VMOVDQU Y0, 128(CX)
+	VMOVDQU 160(AX), Y0
+	VMOVDQU Y0, 160(CX)
+	VMOVDQU 192(AX), Y0
+	VMOVDQU Y0, 192(CX)
+	VMOVDQU 224(AX), Y0
+	VMOVDQU Y0, 224(CX)
+	VMOVDQU 256(AX), Y0
+	VMOVDQU Y0, 256(CX)
+	VMOVDQU 288(AX), Y0
+	VMOVDQU Y0, 288(CX)
+	VMOVDQU 320(AX), Y0
+	VMOVDQU Y0, 320(CX)
+	VMOVDQU 352(AX), Y0
+	VMOVDQU Y0, 352(CX)
+	VMOVDQU 384(AX), Y0
+	VMOVDQU Y0, 384(CX)
+	VMOVDQU 416(AX), Y0
+	VMOVDQU Y0, 416(CX)
+	VMOVDQU 448(AX), Y0
+	VMOVDQU Y0, 448(CX)
+	VMOVDQU 480(AX), Y0
+	VMOVDQU Y0, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_1(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_1(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x00000001, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts368<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts113<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts117<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts121<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts125<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts374<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts376<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts378<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts380<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts382<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts384<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts386<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts115<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts119<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts123<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts127<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_2(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_2(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x00000003, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts32<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts99<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts163<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts165<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts360<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts181<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts183<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts185<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts32<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts99<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts163<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts165<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts360<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts181<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts183<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts185<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_3(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_3(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts335<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts50<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts338<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts340<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts342<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VMOVDQU        (AX), Y3
+	VPSRLVD        shiftCounts387<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts2<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts388<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts389<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts52<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts390<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts391<>+0(SB), Y3, Y3
+	VBROADCASTI128 32(AX), Y4
+	VPSLLVD        shiftCounts392<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts352<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts354<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts356<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts358<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts54<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_4(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_4(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x0000000f, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts66<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts57<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts133<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts192<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts66<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts57<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts133<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts192<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts66<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts57<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts133<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts192<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts66<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts57<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts133<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts192<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_5(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_5(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x0000001f, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts82<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts318<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts319<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VMOVDQU        (AX), Y3
+	VPSRLVD        shiftCounts393<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts2<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts394<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts395<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VMOVDQU        16(AX), Y3
+	VPSRLVD        shiftCounts396<>+0(SB), Y3, Y3
+	VBROADCASTI128 32(AX), Y4
+	VPSLLVD        shiftCounts4<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts324<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts326<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts397<>+0(SB), Y3, Y3
+	VBROADCASTI128 48(AX), Y4
+	VPSLLVD        shiftCounts392<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts398<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts399<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts400<>+0(SB), Y3, Y3
+	VBROADCASTI128 64(AX), Y4
+	VPSLLVD        shiftCounts401<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VBROADCASTI128 64(AX), Y3
+	VPSRLVD        shiftCounts90<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VBROADCASTI128 64(AX), Y3
+	VPSRLVD        shiftCounts332<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VBROADCASTI128 64(AX), Y3
+	VPSRLVD        shiftCounts402<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_6(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_6(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x0000003f, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts134<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts201<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts204<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts403<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts310<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts312<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VMOVDQU        16(AX), Y3
+	VPSRLVD        shiftCounts404<>+0(SB), Y3, Y3
+	VBROADCASTI128 32(AX), Y4
+	VPSLLVD        shiftCounts4<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts216<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts219<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts134<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts201<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts204<>+0(SB), Y3, Y3
+	VBROADCASTI128 64(AX), Y4
+	VPSLLVD        shiftCounts403<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VBROADCASTI128 64(AX), Y3
+	VPSRLVD        shiftCounts310<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VBROADCASTI128 64(AX), Y3
+	VPSRLVD        shiftCounts312<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VMOVDQU        64(AX), Y3
+	VPSRLVD        shiftCounts404<>+0(SB), Y3, Y3
+	VBROADCASTI128 80(AX), Y4
+	VPSLLVD        shiftCounts4<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VBROADCASTI128 80(AX), Y3
+	VPSRLVD        shiftCounts216<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VBROADCASTI128 80(AX), Y3
+	VPSRLVD        shiftCounts219<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_7(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_7(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x0000007f, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts285<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts139<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VMOVDQU        (AX), Y3
+	VPSRLVD        shiftCounts405<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts4<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts406<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts407<>+0(SB), Y3, Y3
+	VBROADCASTI128 32(AX), Y4
+	VPSLLVD        shiftCounts392<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts288<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts408<>+0(SB), Y3, Y3
+	VBROADCASTI128 48(AX), Y4
+	VPSLLVD        shiftCounts409<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts141<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts145<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VMOVDQU        48(AX), Y3
+	VPSRLVD        shiftCounts410<>+0(SB), Y3, Y3
+	VBROADCASTI128 64(AX), Y4
+	VPSLLVD        shiftCounts2<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VBROADCASTI128 64(AX), Y3
+	VPSRLVD        shiftCounts296<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VMOVDQU        64(AX), Y3
+	VPSRLVD        shiftCounts411<>+0(SB), Y3, Y3
+	VBROADCASTI128 80(AX), Y4
+	VPSLLVD        shiftCounts6<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VBROADCASTI128 80(AX), Y3
+	VPSRLVD        shiftCounts412<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VBROADCASTI128 80(AX), Y3
+	VPSRLVD        shiftCounts413<>+0(SB), Y3, Y3
+	VBROADCASTI128 96(AX), Y4
+	VPSLLVD        shiftCounts401<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VBROADCASTI128 96(AX), Y3
+	VPSRLVD        shiftCounts147<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VBROADCASTI128 96(AX), Y3
+	VPSRLVD        shiftCounts151<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_8(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_8(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x000000ff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts128<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts414<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts128<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts414<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts128<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts414<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts128<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts414<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VBROADCASTI128 64(AX), Y3
+	VPSRLVD        shiftCounts128<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VBROADCASTI128 64(AX), Y3
+	VPSRLVD        shiftCounts414<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VBROADCASTI128 80(AX), Y3
+	VPSRLVD        shiftCounts128<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VBROADCASTI128 80(AX), Y3
+	VPSRLVD        shiftCounts414<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VBROADCASTI128 96(AX), Y3
+	VPSRLVD        shiftCounts128<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VBROADCASTI128 96(AX), Y3
+	VPSRLVD        shiftCounts414<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VBROADCASTI128 112(AX), Y3
+	VPSRLVD        shiftCounts128<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VBROADCASTI128 112(AX), Y3
+	VPSRLVD        shiftCounts414<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_9(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_9(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x000001ff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts264<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts415<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts409<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts416<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts417<>+0(SB), Y3, Y3
+	VBROADCASTI128 32(AX), Y4
+	VPSLLVD        shiftCounts392<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts269<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VMOVDQU        32(AX), Y3
+	VPSRLVD        shiftCounts418<>+0(SB), Y3, Y3
+	VBROADCASTI128 48(AX), Y4
+	VPSLLVD        shiftCounts6<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts419<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VMOVDQU        48(AX), Y3
+	VPSRLVD        shiftCounts420<>+0(SB), Y3, Y3
+	VBROADCASTI128 64(AX), Y4
+	VPSLLVD        shiftCounts2<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VBROADCASTI128 64(AX), Y3
+	VPSRLVD        shiftCounts421<>+0(SB), Y3, Y3
+	VBROADCASTI128 80(AX), Y4
+	VPSLLVD        shiftCounts422<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VBROADCASTI128 80(AX), Y3
+	VPSRLVD        shiftCounts423<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VBROADCASTI128 80(AX), Y3
+	VPSRLVD        shiftCounts424<>+0(SB), Y3, Y3
+	VBROADCASTI128 96(AX), Y4
+	VPSLLVD        shiftCounts401<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VBROADCASTI128 96(AX), Y3
+	VPSRLVD        shiftCounts277<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VMOVDQU        96(AX), Y3
+	VPSRLVD        shiftCounts425<>+0(SB), Y3, Y3
+	VBROADCASTI128 112(AX), Y4
+	VPSLLVD        shiftCounts8<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VBROADCASTI128 112(AX), Y3
+	VPSRLVD        shiftCounts426<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VMOVDQU        112(AX), Y3
+	VPSRLVD        shiftCounts427<>+0(SB), Y3, Y3
+	VBROADCASTI128 128(AX), Y4
+	VPSLLVD        shiftCounts4<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VBROADCASTI128 128(AX), Y3
+	VPSRLVD        shiftCounts428<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_10(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_10(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x000003ff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts108<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts429<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts403<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts258<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VMOVDQU        16(AX), Y3
+	VPSRLVD        shiftCounts430<>+0(SB), Y3, Y3
+	VBROADCASTI128 32(AX), Y4
+	VPSLLVD        shiftCounts4<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts159<>+0(SB), Y3, Y3
+	VBROADCASTI128 48(AX), Y4
+	VPSLLVD        shiftCounts431<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts153<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VMOVDQU        48(AX), Y3
+	VPSRLVD        shiftCounts432<>+0(SB), Y3, Y3
+	VBROADCASTI128 64(AX), Y4
+	VPSLLVD        shiftCounts8<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VBROADCASTI128 64(AX), Y3
+	VPSRLVD        shiftCounts433<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VBROADCASTI128 80(AX), Y3
+	VPSRLVD        shiftCounts108<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VBROADCASTI128 80(AX), Y3
+	VPSRLVD        shiftCounts429<>+0(SB), Y3, Y3
+	VBROADCASTI128 96(AX), Y4
+	VPSLLVD        shiftCounts403<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VBROADCASTI128 96(AX), Y3
+	VPSRLVD        shiftCounts258<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VMOVDQU        96(AX), Y3
+	VPSRLVD        shiftCounts430<>+0(SB), Y3, Y3
+	VBROADCASTI128 112(AX), Y4
+	VPSLLVD        shiftCounts4<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VBROADCASTI128 112(AX), Y3
+	VPSRLVD        shiftCounts159<>+0(SB), Y3, Y3
+	VBROADCASTI128 128(AX), Y4
+	VPSLLVD        shiftCounts431<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VBROADCASTI128 128(AX), Y3
+	VPSRLVD        shiftCounts153<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VMOVDQU        128(AX), Y3
+	VPSRLVD        shiftCounts432<>+0(SB), Y3, Y3
+	VBROADCASTI128 144(AX), Y4
+	VPSLLVD        shiftCounts8<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VBROADCASTI128 144(AX), Y3
+	VPSRLVD        shiftCounts433<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_11(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_11(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x000007ff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts228<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VMOVDQU        (AX), Y3
+	VPSRLVD        shiftCounts434<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts10<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts435<>+0(SB), Y3, Y3
+	VBROADCASTI128 32(AX), Y4
+	VPSLLVD        shiftCounts436<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts232<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VMOVDQU        32(AX), Y3
+	VPSRLVD        shiftCounts437<>+0(SB), Y3, Y3
+	VBROADCASTI128 48(AX), Y4
+	VPSLLVD        shiftCounts8<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts438<>+0(SB), Y3, Y3
+	VBROADCASTI128 64(AX), Y4
+	VPSLLVD        shiftCounts422<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VBROADCASTI128 64(AX), Y3
+	VPSRLVD        shiftCounts236<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VMOVDQU        64(AX), Y3
+	VPSRLVD        shiftCounts439<>+0(SB), Y3, Y3
+	VBROADCASTI128 80(AX), Y4
+	VPSLLVD        shiftCounts6<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VBROADCASTI128 80(AX), Y3
+	VPSRLVD        shiftCounts440<>+0(SB), Y3, Y3
+	VBROADCASTI128 96(AX), Y4
+	VPSLLVD        shiftCounts409<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VBROADCASTI128 96(AX), Y3
+	VPSRLVD        shiftCounts242<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VMOVDQU        96(AX), Y3
+	VPSRLVD        shiftCounts441<>+0(SB), Y3, Y3
+	VBROADCASTI128 112(AX), Y4
+	VPSLLVD        shiftCounts4<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VBROADCASTI128 112(AX), Y3
+	VPSRLVD        shiftCounts442<>+0(SB), Y3, Y3
+	VBROADCASTI128 128(AX), Y4
+	VPSLLVD        shiftCounts401<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VBROADCASTI128 128(AX), Y3
+	VPSRLVD        shiftCounts246<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VMOVDQU        128(AX), Y3
+	VPSRLVD        shiftCounts443<>+0(SB), Y3, Y3
+	VBROADCASTI128 144(AX), Y4
+	VPSLLVD        shiftCounts2<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VBROADCASTI128 144(AX), Y3
+	VPSRLVD        shiftCounts444<>+0(SB), Y3, Y3
+	VBROADCASTI128 160(AX), Y4
+	VPSLLVD        shiftCounts392<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VBROADCASTI128 160(AX), Y3
+	VPSRLVD        shiftCounts445<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_12(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_12(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x00000fff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts220<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VMOVDQU        (AX), Y3
+	VPSRLVD        shiftCounts300<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts8<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts446<>+0(SB), Y3, Y3
+	VBROADCASTI128 32(AX), Y4
+	VPSLLVD        shiftCounts447<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts254<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts220<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VMOVDQU        48(AX), Y3
+	VPSRLVD        shiftCounts300<>+0(SB), Y3, Y3
+	VBROADCASTI128 64(AX), Y4
+	VPSLLVD        shiftCounts8<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VBROADCASTI128 64(AX), Y3
+	VPSRLVD        shiftCounts446<>+0(SB), Y3, Y3
+	VBROADCASTI128 80(AX), Y4
+	VPSLLVD        shiftCounts447<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VBROADCASTI128 80(AX), Y3
+	VPSRLVD        shiftCounts254<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VBROADCASTI128 96(AX), Y3
+	VPSRLVD        shiftCounts220<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VMOVDQU        96(AX), Y3
+	VPSRLVD        shiftCounts300<>+0(SB), Y3, Y3
+	VBROADCASTI128 112(AX), Y4
+	VPSLLVD        shiftCounts8<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VBROADCASTI128 112(AX), Y3
+	VPSRLVD        shiftCounts446<>+0(SB), Y3, Y3
+	VBROADCASTI128 128(AX), Y4
+	VPSLLVD        shiftCounts447<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VBROADCASTI128 128(AX), Y3
+	VPSRLVD        shiftCounts254<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VBROADCASTI128 144(AX), Y3
+	VPSRLVD        shiftCounts220<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VMOVDQU        144(AX), Y3
+	VPSRLVD        shiftCounts300<>+0(SB), Y3, Y3
+	VBROADCASTI128 160(AX), Y4
+	VPSLLVD        shiftCounts8<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VBROADCASTI128 160(AX), Y3
+	VPSRLVD        shiftCounts446<>+0(SB), Y3, Y3
+	VBROADCASTI128 176(AX), Y4
+	VPSLLVD        shiftCounts447<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VBROADCASTI128 176(AX), Y3
+	VPSRLVD        shiftCounts254<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_13(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_13(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x00001fff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts197<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VMOVDQU        (AX), Y3
+	VPSRLVD        shiftCounts448<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts6<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VMOVDQU        16(AX), Y3
+	VPSRLVD        shiftCounts449<>+0(SB), Y3, Y3
+	VBROADCASTI128 32(AX), Y4
+	VPSLLVD        shiftCounts12<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts450<>+0(SB), Y3, Y3
+	VBROADCASTI128 48(AX), Y4
+	VPSLLVD        shiftCounts409<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts451<>+0(SB), Y3, Y3
+	VBROADCASTI128 64(AX), Y4
+	VPSLLVD        shiftCounts452<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VBROADCASTI128 64(AX), Y3
+	VPSRLVD        shiftCounts205<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VMOVDQU        64(AX), Y3
+	VPSRLVD        shiftCounts453<>+0(SB), Y3, Y3
+	VBROADCASTI128 80(AX), Y4
+	VPSLLVD        shiftCounts4<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VMOVDQU        80(AX), Y3
+	VPSRLVD        shiftCounts454<>+0(SB), Y3, Y3
+	VBROADCASTI128 96(AX), Y4
+	VPSLLVD        shiftCounts10<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VBROADCASTI128 96(AX), Y3
+	VPSRLVD        shiftCounts455<>+0(SB), Y3, Y3
+	VBROADCASTI128 112(AX), Y4
+	VPSLLVD        shiftCounts401<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VBROADCASTI128 112(AX), Y3
+	VPSRLVD        shiftCounts456<>+0(SB), Y3, Y3
+	VBROADCASTI128 128(AX), Y4
+	VPSLLVD        shiftCounts436<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VBROADCASTI128 128(AX), Y3
+	VPSRLVD        shiftCounts212<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VMOVDQU        128(AX), Y3
+	VPSRLVD        shiftCounts457<>+0(SB), Y3, Y3
+	VBROADCASTI128 144(AX), Y4
+	VPSLLVD        shiftCounts2<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VMOVDQU        144(AX), Y3
+	VPSRLVD        shiftCounts458<>+0(SB), Y3, Y3
+	VBROADCASTI128 160(AX), Y4
+	VPSLLVD        shiftCounts8<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VBROADCASTI128 160(AX), Y3
+	VPSRLVD        shiftCounts459<>+0(SB), Y3, Y3
+	VBROADCASTI128 176(AX), Y4
+	VPSLLVD        shiftCounts392<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VBROADCASTI128 176(AX), Y3
+	VPSRLVD        shiftCounts460<>+0(SB), Y3, Y3
+	VBROADCASTI128 192(AX), Y4
+	VPSLLVD        shiftCounts422<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VBROADCASTI128 192(AX), Y3
+	VPSRLVD        shiftCounts461<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_14(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_14(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x00003fff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts186<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VMOVDQU        (AX), Y3
+	VPSRLVD        shiftCounts462<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts4<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VMOVDQU        16(AX), Y3
+	VPSRLVD        shiftCounts463<>+0(SB), Y3, Y3
+	VBROADCASTI128 32(AX), Y4
+	VPSLLVD        shiftCounts8<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VMOVDQU        32(AX), Y3
+	VPSRLVD        shiftCounts290<>+0(SB), Y3, Y3
+	VBROADCASTI128 48(AX), Y4
+	VPSLLVD        shiftCounts12<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts464<>+0(SB), Y3, Y3
+	VBROADCASTI128 64(AX), Y4
+	VPSLLVD        shiftCounts403<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VBROADCASTI128 64(AX), Y3
+	VPSRLVD        shiftCounts465<>+0(SB), Y3, Y3
+	VBROADCASTI128 80(AX), Y4
+	VPSLLVD        shiftCounts431<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VBROADCASTI128 80(AX), Y3
+	VPSRLVD        shiftCounts466<>+0(SB), Y3, Y3
+	VBROADCASTI128 96(AX), Y4
+	VPSLLVD        shiftCounts467<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VBROADCASTI128 96(AX), Y3
+	VPSRLVD        shiftCounts282<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VBROADCASTI128 112(AX), Y3
+	VPSRLVD        shiftCounts186<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VMOVDQU        112(AX), Y3
+	VPSRLVD        shiftCounts462<>+0(SB), Y3, Y3
+	VBROADCASTI128 128(AX), Y4
+	VPSLLVD        shiftCounts4<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VMOVDQU        128(AX), Y3
+	VPSRLVD        shiftCounts463<>+0(SB), Y3, Y3
+	VBROADCASTI128 144(AX), Y4
+	VPSLLVD        shiftCounts8<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VMOVDQU        144(AX), Y3
+	VPSRLVD        shiftCounts290<>+0(SB), Y3, Y3
+	VBROADCASTI128 160(AX), Y4
+	VPSLLVD        shiftCounts12<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VBROADCASTI128 160(AX), Y3
+	VPSRLVD        shiftCounts464<>+0(SB), Y3, Y3
+	VBROADCASTI128 176(AX), Y4
+	VPSLLVD        shiftCounts403<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VBROADCASTI128 176(AX), Y3
+	VPSRLVD        shiftCounts465<>+0(SB), Y3, Y3
+	VBROADCASTI128 192(AX), Y4
+	VPSLLVD        shiftCounts431<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VBROADCASTI128 192(AX), Y3
+	VPSRLVD        shiftCounts466<>+0(SB), Y3, Y3
+	VBROADCASTI128 208(AX), Y4
+	VPSLLVD        shiftCounts467<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VBROADCASTI128 208(AX), Y3
+	VPSRLVD        shiftCounts282<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_15(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_15(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x00007fff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts170<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VMOVDQU        (AX), Y3
+	VPSRLVD        shiftCounts468<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts2<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VMOVDQU        16(AX), Y3
+	VPSRLVD        shiftCounts469<>+0(SB), Y3, Y3
+	VBROADCASTI128 32(AX), Y4
+	VPSLLVD        shiftCounts4<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VMOVDQU        32(AX), Y3
+	VPSRLVD        shiftCounts470<>+0(SB), Y3, Y3
+	VBROADCASTI128 48(AX), Y4
+	VPSLLVD        shiftCounts6<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VMOVDQU        48(AX), Y3
+	VPSRLVD        shiftCounts471<>+0(SB), Y3, Y3
+	VBROADCASTI128 64(AX), Y4
+	VPSLLVD        shiftCounts8<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VMOVDQU        64(AX), Y3
+	VPSRLVD        shiftCounts472<>+0(SB), Y3, Y3
+	VBROADCASTI128 80(AX), Y4
+	VPSLLVD        shiftCounts10<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VMOVDQU        80(AX), Y3
+	VPSRLVD        shiftCounts473<>+0(SB), Y3, Y3
+	VBROADCASTI128 96(AX), Y4
+	VPSLLVD        shiftCounts12<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VMOVDQU        96(AX), Y3
+	VPSRLVD        shiftCounts474<>+0(SB), Y3, Y3
+	VBROADCASTI128 112(AX), Y4
+	VPSLLVD        shiftCounts14<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VBROADCASTI128 112(AX), Y3
+	VPSRLVD        shiftCounts475<>+0(SB), Y3, Y3
+	VBROADCASTI128 128(AX), Y4
+	VPSLLVD        shiftCounts392<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VBROADCASTI128 128(AX), Y3
+	VPSRLVD        shiftCounts476<>+0(SB), Y3, Y3
+	VBROADCASTI128 144(AX), Y4
+	VPSLLVD        shiftCounts401<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VBROADCASTI128 144(AX), Y3
+	VPSRLVD        shiftCounts477<>+0(SB), Y3, Y3
+	VBROADCASTI128 160(AX), Y4
+	VPSLLVD        shiftCounts409<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VBROADCASTI128 160(AX), Y3
+	VPSRLVD        shiftCounts478<>+0(SB), Y3, Y3
+	VBROADCASTI128 176(AX), Y4
+	VPSLLVD        shiftCounts422<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VBROADCASTI128 176(AX), Y3
+	VPSRLVD        shiftCounts479<>+0(SB), Y3, Y3
+	VBROADCASTI128 192(AX), Y4
+	VPSLLVD        shiftCounts436<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VBROADCASTI128 192(AX), Y3
+	VPSRLVD        shiftCounts480<>+0(SB), Y3, Y3
+	VBROADCASTI128 208(AX), Y4
+	VPSLLVD        shiftCounts452<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VBROADCASTI128 208(AX), Y3
+	VPSRLVD        shiftCounts481<>+0(SB), Y3, Y3
+	VBROADCASTI128 224(AX), Y4
+	VPSLLVD        shiftCounts482<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VBROADCASTI128 224(AX), Y3
+	VPSRLVD        shiftCounts483<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_16(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_16(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x0000ffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts484<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts484<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts484<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts484<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VBROADCASTI128 64(AX), Y3
+	VPSRLVD        shiftCounts484<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VBROADCASTI128 80(AX), Y3
+	VPSRLVD        shiftCounts484<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VBROADCASTI128 96(AX), Y3
+	VPSRLVD        shiftCounts484<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VBROADCASTI128 112(AX), Y3
+	VPSRLVD        shiftCounts484<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VBROADCASTI128 128(AX), Y3
+	VPSRLVD        shiftCounts484<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VBROADCASTI128 144(AX), Y3
+	VPSRLVD        shiftCounts484<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VBROADCASTI128 160(AX), Y3
+	VPSRLVD        shiftCounts484<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VBROADCASTI128 176(AX), Y3
+	VPSRLVD        shiftCounts484<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VBROADCASTI128 192(AX), Y3
+	VPSRLVD        shiftCounts484<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VBROADCASTI128 208(AX), Y3
+	VPSRLVD        shiftCounts484<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VBROADCASTI128 224(AX), Y3
+	VPSRLVD        shiftCounts484<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VBROADCASTI128 240(AX), Y3
+	VPSRLVD        shiftCounts484<>+0(SB), Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_17(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_17(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x0001ffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts485<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts486<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts487<>+0(SB), Y3, Y3
+	VBROADCASTI128 32(AX), Y4
+	VPSLLVD        shiftCounts482<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts488<>+0(SB), Y3, Y3
+	VBROADCASTI128 48(AX), Y4
+	VPSLLVD        shiftCounts452<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts489<>+0(SB), Y3, Y3
+	VBROADCASTI128 64(AX), Y4
+	VPSLLVD        shiftCounts436<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VBROADCASTI128 64(AX), Y3
+	VPSRLVD        shiftCounts490<>+0(SB), Y3, Y3
+	VBROADCASTI128 80(AX), Y4
+	VPSLLVD        shiftCounts422<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VBROADCASTI128 80(AX), Y3
+	VPSRLVD        shiftCounts491<>+0(SB), Y3, Y3
+	VBROADCASTI128 96(AX), Y4
+	VPSLLVD        shiftCounts409<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VBROADCASTI128 96(AX), Y3
+	VPSRLVD        shiftCounts492<>+0(SB), Y3, Y3
+	VBROADCASTI128 112(AX), Y4
+	VPSLLVD        shiftCounts401<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VBROADCASTI128 112(AX), Y3
+	VPSRLVD        shiftCounts493<>+0(SB), Y3, Y3
+	VBROADCASTI128 128(AX), Y4
+	VPSLLVD        shiftCounts392<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VMOVDQU        128(AX), Y3
+	VPSRLVD        shiftCounts178<>+0(SB), Y3, Y3
+	VBROADCASTI128 144(AX), Y4
+	VPSLLVD        shiftCounts16<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VMOVDQU        144(AX), Y3
+	VPSRLVD        shiftCounts494<>+0(SB), Y3, Y3
+	VBROADCASTI128 160(AX), Y4
+	VPSLLVD        shiftCounts14<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VMOVDQU        160(AX), Y3
+	VPSRLVD        shiftCounts495<>+0(SB), Y3, Y3
+	VBROADCASTI128 176(AX), Y4
+	VPSLLVD        shiftCounts12<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VMOVDQU        176(AX), Y3
+	VPSRLVD        shiftCounts496<>+0(SB), Y3, Y3
+	VBROADCASTI128 192(AX), Y4
+	VPSLLVD        shiftCounts10<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VMOVDQU        192(AX), Y3
+	VPSRLVD        shiftCounts497<>+0(SB), Y3, Y3
+	VBROADCASTI128 208(AX), Y4
+	VPSLLVD        shiftCounts8<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VMOVDQU        208(AX), Y3
+	VPSRLVD        shiftCounts498<>+0(SB), Y3, Y3
+	VBROADCASTI128 224(AX), Y4
+	VPSLLVD        shiftCounts6<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VMOVDQU        224(AX), Y3
+	VPSRLVD        shiftCounts499<>+0(SB), Y3, Y3
+	VBROADCASTI128 240(AX), Y4
+	VPSLLVD        shiftCounts4<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VMOVDQU        240(AX), Y3
+	VPSRLVD        shiftCounts500<>+0(SB), Y3, Y3
+	VBROADCASTI128 256(AX), Y4
+	VPSLLVD        shiftCounts2<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_18(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_18(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x0003ffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts501<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts502<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts503<>+0(SB), Y3, Y3
+	VBROADCASTI128 32(AX), Y4
+	VPSLLVD        shiftCounts467<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts504<>+0(SB), Y3, Y3
+	VBROADCASTI128 48(AX), Y4
+	VPSLLVD        shiftCounts431<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts505<>+0(SB), Y3, Y3
+	VBROADCASTI128 64(AX), Y4
+	VPSLLVD        shiftCounts403<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VMOVDQU        64(AX), Y3
+	VPSRLVD        shiftCounts190<>+0(SB), Y3, Y3
+	VBROADCASTI128 80(AX), Y4
+	VPSLLVD        shiftCounts16<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VMOVDQU        80(AX), Y3
+	VPSRLVD        shiftCounts506<>+0(SB), Y3, Y3
+	VBROADCASTI128 96(AX), Y4
+	VPSLLVD        shiftCounts12<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VMOVDQU        96(AX), Y3
+	VPSRLVD        shiftCounts507<>+0(SB), Y3, Y3
+	VBROADCASTI128 112(AX), Y4
+	VPSLLVD        shiftCounts8<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VMOVDQU        112(AX), Y3
+	VPSRLVD        shiftCounts508<>+0(SB), Y3, Y3
+	VBROADCASTI128 128(AX), Y4
+	VPSLLVD        shiftCounts4<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VBROADCASTI128 144(AX), Y3
+	VPSRLVD        shiftCounts501<>+0(SB), Y3, Y3
+	VBROADCASTI128 160(AX), Y4
+	VPSLLVD        shiftCounts502<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VBROADCASTI128 160(AX), Y3
+	VPSRLVD        shiftCounts503<>+0(SB), Y3, Y3
+	VBROADCASTI128 176(AX), Y4
+	VPSLLVD        shiftCounts467<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VBROADCASTI128 176(AX), Y3
+	VPSRLVD        shiftCounts504<>+0(SB), Y3, Y3
+	VBROADCASTI128 192(AX), Y4
+	VPSLLVD        shiftCounts431<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VBROADCASTI128 192(AX), Y3
+	VPSRLVD        shiftCounts505<>+0(SB), Y3, Y3
+	VBROADCASTI128 208(AX), Y4
+	VPSLLVD        shiftCounts403<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VMOVDQU        208(AX), Y3
+	VPSRLVD        shiftCounts190<>+0(SB), Y3, Y3
+	VBROADCASTI128 224(AX), Y4
+	VPSLLVD        shiftCounts16<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VMOVDQU        224(AX), Y3
+	VPSRLVD        shiftCounts506<>+0(SB), Y3, Y3
+	VBROADCASTI128 240(AX), Y4
+	VPSLLVD        shiftCounts12<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VMOVDQU        240(AX), Y3
+	VPSRLVD        shiftCounts507<>+0(SB), Y3, Y3
+	VBROADCASTI128 256(AX), Y4
+	VPSLLVD        shiftCounts8<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VMOVDQU        256(AX), Y3
+	VPSRLVD        shiftCounts508<>+0(SB), Y3, Y3
+	VBROADCASTI128 272(AX), Y4
+	VPSLLVD        shiftCounts4<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_19(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_19(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x0007ffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts509<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts482<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts510<>+0(SB), Y3, Y3
+	VBROADCASTI128 32(AX), Y4
+	VPSLLVD        shiftCounts422<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VBROADCASTI128 32(AX), Y3
+	VPSRLVD        shiftCounts511<>+0(SB), Y3, Y3
+	VBROADCASTI128 48(AX), Y4
+	VPSLLVD        shiftCounts392<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VMOVDQU        48(AX), Y3
+	VPSRLVD        shiftCounts512<>+0(SB), Y3, Y3
+	VBROADCASTI128 64(AX), Y4
+	VPSLLVD        shiftCounts14<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VMOVDQU        64(AX), Y3
+	VPSRLVD        shiftCounts513<>+0(SB), Y3, Y3
+	VBROADCASTI128 80(AX), Y4
+	VPSLLVD        shiftCounts8<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VMOVDQU        80(AX), Y3
+	VPSRLVD        shiftCounts514<>+0(SB), Y3, Y3
+	VMOVDQU        96(AX), Y4
+	VPSLLVD        shiftCounts205<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VBROADCASTI128 112(AX), Y3
+	VPSRLVD        shiftCounts515<>+0(SB), Y3, Y3
+	VBROADCASTI128 128(AX), Y4
+	VPSLLVD        shiftCounts436<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VBROADCASTI128 128(AX), Y3
+	VPSRLVD        shiftCounts516<>+0(SB), Y3, Y3
+	VBROADCASTI128 144(AX), Y4
+	VPSLLVD        shiftCounts401<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VMOVDQU        144(AX), Y3
+	VPSRLVD        shiftCounts517<>+0(SB), Y3, Y3
+	VBROADCASTI128 160(AX), Y4
+	VPSLLVD        shiftCounts16<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VMOVDQU        160(AX), Y3
+	VPSRLVD        shiftCounts518<>+0(SB), Y3, Y3
+	VBROADCASTI128 176(AX), Y4
+	VPSLLVD        shiftCounts10<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VMOVDQU        176(AX), Y3
+	VPSRLVD        shiftCounts519<>+0(SB), Y3, Y3
+	VMOVDQU        192(AX), Y4
+	VPSLLVD        shiftCounts212<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VBROADCASTI128 208(AX), Y3
+	VPSRLVD        shiftCounts520<>+0(SB), Y3, Y3
+	VBROADCASTI128 224(AX), Y4
+	VPSLLVD        shiftCounts452<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VBROADCASTI128 224(AX), Y3
+	VPSRLVD        shiftCounts521<>+0(SB), Y3, Y3
+	VBROADCASTI128 240(AX), Y4
+	VPSLLVD        shiftCounts409<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VMOVDQU        240(AX), Y3
+	VPSRLVD        shiftCounts522<>+0(SB), Y3, Y3
+	VBROADCASTI128 256(AX), Y4
+	VPSLLVD        shiftCounts18<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VMOVDQU        256(AX), Y3
+	VPSRLVD        shiftCounts523<>+0(SB), Y3, Y3
+	VBROADCASTI128 272(AX), Y4
+	VPSLLVD        shiftCounts12<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VMOVDQU        272(AX), Y3
+	VPSRLVD        shiftCounts524<>+0(SB), Y3, Y3
+	VBROADCASTI128 288(AX), Y4
+	VPSLLVD        shiftCounts6<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_20(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_20(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x000fffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts525<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts526<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts527<>+0(SB), Y3, Y3
+	VBROADCASTI128 32(AX), Y4
+	VPSLLVD        shiftCounts447<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VMOVDQU        32(AX), Y3
+	VPSRLVD        shiftCounts223<>+0(SB), Y3, Y3
+	VBROADCASTI128 48(AX), Y4
+	VPSLLVD        shiftCounts16<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VMOVDQU        48(AX), Y3
+	VPSRLVD        shiftCounts528<>+0(SB), Y3, Y3
+	VBROADCASTI128 64(AX), Y4
+	VPSLLVD        shiftCounts8<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VBROADCASTI128 80(AX), Y3
+	VPSRLVD        shiftCounts525<>+0(SB), Y3, Y3
+	VBROADCASTI128 96(AX), Y4
+	VPSLLVD        shiftCounts526<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VBROADCASTI128 96(AX), Y3
+	VPSRLVD        shiftCounts527<>+0(SB), Y3, Y3
+	VBROADCASTI128 112(AX), Y4
+	VPSLLVD        shiftCounts447<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VMOVDQU        112(AX), Y3
+	VPSRLVD        shiftCounts223<>+0(SB), Y3, Y3
+	VBROADCASTI128 128(AX), Y4
+	VPSLLVD        shiftCounts16<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VMOVDQU        128(AX), Y3
+	VPSRLVD        shiftCounts528<>+0(SB), Y3, Y3
+	VBROADCASTI128 144(AX), Y4
+	VPSLLVD        shiftCounts8<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VBROADCASTI128 160(AX), Y3
+	VPSRLVD        shiftCounts525<>+0(SB), Y3, Y3
+	VBROADCASTI128 176(AX), Y4
+	VPSLLVD        shiftCounts526<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VBROADCASTI128 176(AX), Y3
+	VPSRLVD        shiftCounts527<>+0(SB), Y3, Y3
+	VBROADCASTI128 192(AX), Y4
+	VPSLLVD        shiftCounts447<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VMOVDQU        192(AX), Y3
+	VPSRLVD        shiftCounts223<>+0(SB), Y3, Y3
+	VBROADCASTI128 208(AX), Y4
+	VPSLLVD        shiftCounts16<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VMOVDQU        208(AX), Y3
+	VPSRLVD        shiftCounts528<>+0(SB), Y3, Y3
+	VBROADCASTI128 224(AX), Y4
+	VPSLLVD        shiftCounts8<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VBROADCASTI128 240(AX), Y3
+	VPSRLVD        shiftCounts525<>+0(SB), Y3, Y3
+	VBROADCASTI128 256(AX), Y4
+	VPSLLVD        shiftCounts526<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VBROADCASTI128 256(AX), Y3
+	VPSRLVD        shiftCounts527<>+0(SB), Y3, Y3
+	VBROADCASTI128 272(AX), Y4
+	VPSLLVD        shiftCounts447<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VMOVDQU        272(AX), Y3
+	VPSRLVD        shiftCounts223<>+0(SB), Y3, Y3
+	VBROADCASTI128 288(AX), Y4
+	VPSLLVD        shiftCounts16<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VMOVDQU        288(AX), Y3
+	VPSRLVD        shiftCounts528<>+0(SB), Y3, Y3
+	VBROADCASTI128 304(AX), Y4
+	VPSLLVD        shiftCounts8<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_21(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_21(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x001fffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts529<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts452<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VBROADCASTI128 16(AX), Y3
+	VPSRLVD        shiftCounts530<>+0(SB), Y3, Y3
+	VBROADCASTI128 32(AX), Y4
+	VPSLLVD        shiftCounts392<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VMOVDQU        32(AX), Y3
+	VPSRLVD        shiftCounts231<>+0(SB), Y3, Y3
+	VBROADCASTI128 48(AX), Y4
+	VPSLLVD        shiftCounts12<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VMOVDQU        48(AX), Y3
+	VPSRLVD        shiftCounts531<>+0(SB), Y3, Y3
+	VMOVDQU        64(AX), Y4
+	VPSLLVD        shiftCounts232<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VBROADCASTI128 80(AX), Y3
+	VPSRLVD        shiftCounts532<>+0(SB), Y3, Y3
+	VBROADCASTI128 96(AX), Y4
+	VPSLLVD        shiftCounts401<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VMOVDQU        96(AX), Y3
+	VPSRLVD        shiftCounts235<>+0(SB), Y3, Y3
+	VBROADCASTI128 112(AX), Y4
+	VPSLLVD        shiftCounts14<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VMOVDQU        112(AX), Y3
+	VPSRLVD        shiftCounts533<>+0(SB), Y3, Y3
+	VMOVDQU        128(AX), Y4
+	VPSLLVD        shiftCounts236<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VBROADCASTI128 144(AX), Y3
+	VPSRLVD        shiftCounts534<>+0(SB), Y3, Y3
+	VBROADCASTI128 160(AX), Y4
+	VPSLLVD        shiftCounts409<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VMOVDQU        160(AX), Y3
+	VPSRLVD        shiftCounts240<>+0(SB), Y3, Y3
+	VBROADCASTI128 176(AX), Y4
+	VPSLLVD        shiftCounts16<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VMOVDQU        176(AX), Y3
+	VPSRLVD        shiftCounts535<>+0(SB), Y3, Y3
+	VMOVDQU        192(AX), Y4
+	VPSLLVD        shiftCounts242<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VBROADCASTI128 208(AX), Y3
+	VPSRLVD        shiftCounts536<>+0(SB), Y3, Y3
+	VBROADCASTI128 224(AX), Y4
+	VPSLLVD        shiftCounts422<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VMOVDQU        224(AX), Y3
+	VPSRLVD        shiftCounts245<>+0(SB), Y3, Y3
+	VBROADCASTI128 240(AX), Y4
+	VPSLLVD        shiftCounts18<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VMOVDQU        240(AX), Y3
+	VPSRLVD        shiftCounts537<>+0(SB), Y3, Y3
+	VMOVDQU        256(AX), Y4
+	VPSLLVD        shiftCounts246<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VBROADCASTI128 272(AX), Y3
+	VPSRLVD        shiftCounts538<>+0(SB), Y3, Y3
+	VBROADCASTI128 288(AX), Y4
+	VPSLLVD        shiftCounts436<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VMOVDQU        288(AX), Y3
+	VPSRLVD        shiftCounts249<>+0(SB), Y3, Y3
+	VBROADCASTI128 304(AX), Y4
+	VPSLLVD        shiftCounts20<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VMOVDQU        304(AX), Y3
+	VPSRLVD        shiftCounts539<>+0(SB), Y3, Y3
+	VBROADCASTI128 320(AX), Y4
+	VPSLLVD        shiftCounts10<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_22(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_22(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x003fffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts540<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts467<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VMOVDQU        16(AX), Y3
+	VPSRLVD        shiftCounts157<>+0(SB), Y3, Y3
+	VBROADCASTI128 32(AX), Y4
+	VPSLLVD        shiftCounts20<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VMOVDQU        32(AX), Y3
+	VPSRLVD        shiftCounts541<>+0(SB), Y3, Y3
+	VMOVDQU        48(AX), Y4
+	VPSLLVD        shiftCounts258<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VBROADCASTI128 64(AX), Y3
+	VPSRLVD        shiftCounts323<>+0(SB), Y3, Y3
+	VBROADCASTI128 80(AX), Y4
+	VPSLLVD        shiftCounts431<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VMOVDQU        80(AX), Y3
+	VPSRLVD        shiftCounts261<>+0(SB), Y3, Y3
+	VBROADCASTI128 96(AX), Y4
+	VPSLLVD        shiftCounts16<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VMOVDQU        96(AX), Y3
+	VPSRLVD        shiftCounts542<>+0(SB), Y3, Y3
+	VMOVDQU        112(AX), Y4
+	VPSLLVD        shiftCounts153<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VBROADCASTI128 128(AX), Y3
+	VPSRLVD        shiftCounts543<>+0(SB), Y3, Y3
+	VBROADCASTI128 144(AX), Y4
+	VPSLLVD        shiftCounts403<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VMOVDQU        144(AX), Y3
+	VPSRLVD        shiftCounts263<>+0(SB), Y3, Y3
+	VBROADCASTI128 160(AX), Y4
+	VPSLLVD        shiftCounts12<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VBROADCASTI128 176(AX), Y3
+	VPSRLVD        shiftCounts540<>+0(SB), Y3, Y3
+	VBROADCASTI128 192(AX), Y4
+	VPSLLVD        shiftCounts467<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VMOVDQU        192(AX), Y3
+	VPSRLVD        shiftCounts157<>+0(SB), Y3, Y3
+	VBROADCASTI128 208(AX), Y4
+	VPSLLVD        shiftCounts20<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VMOVDQU        208(AX), Y3
+	VPSRLVD        shiftCounts541<>+0(SB), Y3, Y3
+	VMOVDQU        224(AX), Y4
+	VPSLLVD        shiftCounts258<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VBROADCASTI128 240(AX), Y3
+	VPSRLVD        shiftCounts323<>+0(SB), Y3, Y3
+	VBROADCASTI128 256(AX), Y4
+	VPSLLVD        shiftCounts431<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VMOVDQU        256(AX), Y3
+	VPSRLVD        shiftCounts261<>+0(SB), Y3, Y3
+	VBROADCASTI128 272(AX), Y4
+	VPSLLVD        shiftCounts16<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VMOVDQU        272(AX), Y3
+	VPSRLVD        shiftCounts542<>+0(SB), Y3, Y3
+	VMOVDQU        288(AX), Y4
+	VPSLLVD        shiftCounts153<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VBROADCASTI128 304(AX), Y3
+	VPSRLVD        shiftCounts543<>+0(SB), Y3, Y3
+	VBROADCASTI128 320(AX), Y4
+	VPSLLVD        shiftCounts403<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VMOVDQU        320(AX), Y3
+	VPSRLVD        shiftCounts263<>+0(SB), Y3, Y3
+	VBROADCASTI128 336(AX), Y4
+	VPSLLVD        shiftCounts12<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_23(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_23(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x007fffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts544<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts436<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VMOVDQU        16(AX), Y3
+	VPSRLVD        shiftCounts545<>+0(SB), Y3, Y3
+	VBROADCASTI128 32(AX), Y4
+	VPSLLVD        shiftCounts18<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VMOVDQU        32(AX), Y3
+	VPSRLVD        shiftCounts546<>+0(SB), Y3, Y3
+	VMOVDQU        48(AX), Y4
+	VPSLLVD        shiftCounts416<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VMOVDQU        64(AX), Y3
+	VPSRLVD        shiftCounts268<>+0(SB), Y3, Y3
+	VBROADCASTI128 80(AX), Y4
+	VPSLLVD        shiftCounts22<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VMOVDQU        80(AX), Y3
+	VPSRLVD        shiftCounts547<>+0(SB), Y3, Y3
+	VMOVDQU        96(AX), Y4
+	VPSLLVD        shiftCounts269<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VBROADCASTI128 112(AX), Y3
+	VPSRLVD        shiftCounts548<>+0(SB), Y3, Y3
+	VBROADCASTI128 128(AX), Y4
+	VPSLLVD        shiftCounts401<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VMOVDQU        128(AX), Y3
+	VPSRLVD        shiftCounts272<>+0(SB), Y3, Y3
+	VMOVDQU        144(AX), Y4
+	VPSLLVD        shiftCounts419<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VBROADCASTI128 160(AX), Y3
+	VPSRLVD        shiftCounts549<>+0(SB), Y3, Y3
+	VBROADCASTI128 176(AX), Y4
+	VPSLLVD        shiftCounts422<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VMOVDQU        176(AX), Y3
+	VPSRLVD        shiftCounts550<>+0(SB), Y3, Y3
+	VBROADCASTI128 192(AX), Y4
+	VPSLLVD        shiftCounts16<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VMOVDQU        192(AX), Y3
+	VPSRLVD        shiftCounts551<>+0(SB), Y3, Y3
+	VMOVDQU        208(AX), Y4
+	VPSLLVD        shiftCounts423<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VMOVDQU        224(AX), Y3
+	VPSRLVD        shiftCounts276<>+0(SB), Y3, Y3
+	VBROADCASTI128 240(AX), Y4
+	VPSLLVD        shiftCounts20<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VMOVDQU        240(AX), Y3
+	VPSRLVD        shiftCounts552<>+0(SB), Y3, Y3
+	VMOVDQU        256(AX), Y4
+	VPSLLVD        shiftCounts277<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VBROADCASTI128 272(AX), Y3
+	VPSRLVD        shiftCounts553<>+0(SB), Y3, Y3
+	VBROADCASTI128 288(AX), Y4
+	VPSLLVD        shiftCounts392<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VMOVDQU        288(AX), Y3
+	VPSRLVD        shiftCounts280<>+0(SB), Y3, Y3
+	VMOVDQU        304(AX), Y4
+	VPSLLVD        shiftCounts426<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VBROADCASTI128 320(AX), Y3
+	VPSRLVD        shiftCounts554<>+0(SB), Y3, Y3
+	VBROADCASTI128 336(AX), Y4
+	VPSLLVD        shiftCounts409<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VMOVDQU        336(AX), Y3
+	VPSRLVD        shiftCounts555<>+0(SB), Y3, Y3
+	VBROADCASTI128 352(AX), Y4
+	VPSLLVD        shiftCounts14<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_24(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_24(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x00ffffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts556<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts557<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VMOVDQU        16(AX), Y3
+	VPSRLVD        shiftCounts226<>+0(SB), Y3, Y3
+	VBROADCASTI128 32(AX), Y4
+	VPSLLVD        shiftCounts16<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts556<>+0(SB), Y3, Y3
+	VBROADCASTI128 64(AX), Y4
+	VPSLLVD        shiftCounts557<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VMOVDQU        64(AX), Y3
+	VPSRLVD        shiftCounts226<>+0(SB), Y3, Y3
+	VBROADCASTI128 80(AX), Y4
+	VPSLLVD        shiftCounts16<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VBROADCASTI128 96(AX), Y3
+	VPSRLVD        shiftCounts556<>+0(SB), Y3, Y3
+	VBROADCASTI128 112(AX), Y4
+	VPSLLVD        shiftCounts557<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VMOVDQU        112(AX), Y3
+	VPSRLVD        shiftCounts226<>+0(SB), Y3, Y3
+	VBROADCASTI128 128(AX), Y4
+	VPSLLVD        shiftCounts16<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VBROADCASTI128 144(AX), Y3
+	VPSRLVD        shiftCounts556<>+0(SB), Y3, Y3
+	VBROADCASTI128 160(AX), Y4
+	VPSLLVD        shiftCounts557<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VMOVDQU        160(AX), Y3
+	VPSRLVD        shiftCounts226<>+0(SB), Y3, Y3
+	VBROADCASTI128 176(AX), Y4
+	VPSLLVD        shiftCounts16<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VBROADCASTI128 192(AX), Y3
+	VPSRLVD        shiftCounts556<>+0(SB), Y3, Y3
+	VBROADCASTI128 208(AX), Y4
+	VPSLLVD        shiftCounts557<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VMOVDQU        208(AX), Y3
+	VPSRLVD        shiftCounts226<>+0(SB), Y3, Y3
+	VBROADCASTI128 224(AX), Y4
+	VPSLLVD        shiftCounts16<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VBROADCASTI128 240(AX), Y3
+	VPSRLVD        shiftCounts556<>+0(SB), Y3, Y3
+	VBROADCASTI128 256(AX), Y4
+	VPSLLVD        shiftCounts557<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VMOVDQU        256(AX), Y3
+	VPSRLVD        shiftCounts226<>+0(SB), Y3, Y3
+	VBROADCASTI128 272(AX), Y4
+	VPSLLVD        shiftCounts16<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VBROADCASTI128 288(AX), Y3
+	VPSRLVD        shiftCounts556<>+0(SB), Y3, Y3
+	VBROADCASTI128 304(AX), Y4
+	VPSLLVD        shiftCounts557<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VMOVDQU        304(AX), Y3
+	VPSRLVD        shiftCounts226<>+0(SB), Y3, Y3
+	VBROADCASTI128 320(AX), Y4
+	VPSLLVD        shiftCounts16<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VBROADCASTI128 336(AX), Y3
+	VPSRLVD        shiftCounts556<>+0(SB), Y3, Y3
+	VBROADCASTI128 352(AX), Y4
+	VPSLLVD        shiftCounts557<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VMOVDQU        352(AX), Y3
+	VPSRLVD        shiftCounts226<>+0(SB), Y3, Y3
+	VBROADCASTI128 368(AX), Y4
+	VPSLLVD        shiftCounts16<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_25(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_25(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x01ffffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts558<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts422<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VMOVDQU        16(AX), Y3
+	VPSRLVD        shiftCounts559<>+0(SB), Y3, Y3
+	VMOVDQU        32(AX), Y4
+	VPSLLVD        shiftCounts139<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VBROADCASTI128 48(AX), Y3
+	VPSRLVD        shiftCounts560<>+0(SB), Y3, Y3
+	VBROADCASTI128 64(AX), Y4
+	VPSLLVD        shiftCounts401<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VMOVDQU        64(AX), Y3
+	VPSRLVD        shiftCounts287<>+0(SB), Y3, Y3
+	VMOVDQU        80(AX), Y4
+	VPSLLVD        shiftCounts406<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VMOVDQU        96(AX), Y3
+	VPSRLVD        shiftCounts149<>+0(SB), Y3, Y3
+	VBROADCASTI128 112(AX), Y4
+	VPSLLVD        shiftCounts24<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VMOVDQU        112(AX), Y3
+	VPSRLVD        shiftCounts561<>+0(SB), Y3, Y3
+	VMOVDQU        128(AX), Y4
+	VPSLLVD        shiftCounts288<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VMOVDQU        144(AX), Y3
+	VPSRLVD        shiftCounts137<>+0(SB), Y3, Y3
+	VBROADCASTI128 160(AX), Y4
+	VPSLLVD        shiftCounts20<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VMOVDQU        160(AX), Y3
+	VPSRLVD        shiftCounts562<>+0(SB), Y3, Y3
+	VMOVDQU        176(AX), Y4
+	VPSLLVD        shiftCounts141<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VMOVDQU        192(AX), Y3
+	VPSRLVD        shiftCounts293<>+0(SB), Y3, Y3
+	VMOVDQU        208(AX), Y4
+	VPSLLVD        shiftCounts145<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VBROADCASTI128 224(AX), Y3
+	VPSRLVD        shiftCounts563<>+0(SB), Y3, Y3
+	VBROADCASTI128 240(AX), Y4
+	VPSLLVD        shiftCounts409<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VMOVDQU        240(AX), Y3
+	VPSRLVD        shiftCounts564<>+0(SB), Y3, Y3
+	VMOVDQU        256(AX), Y4
+	VPSLLVD        shiftCounts296<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VBROADCASTI128 272(AX), Y3
+	VPSRLVD        shiftCounts565<>+0(SB), Y3, Y3
+	VBROADCASTI128 288(AX), Y4
+	VPSLLVD        shiftCounts392<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VMOVDQU        288(AX), Y3
+	VPSRLVD        shiftCounts298<>+0(SB), Y3, Y3
+	VMOVDQU        304(AX), Y4
+	VPSLLVD        shiftCounts412<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VMOVDQU        320(AX), Y3
+	VPSRLVD        shiftCounts143<>+0(SB), Y3, Y3
+	VBROADCASTI128 336(AX), Y4
+	VPSLLVD        shiftCounts22<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VMOVDQU        336(AX), Y3
+	VPSRLVD        shiftCounts566<>+0(SB), Y3, Y3
+	VMOVDQU        352(AX), Y4
+	VPSLLVD        shiftCounts147<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VMOVDQU        368(AX), Y3
+	VPSRLVD        shiftCounts567<>+0(SB), Y3, Y3
+	VBROADCASTI128 384(AX), Y4
+	VPSLLVD        shiftCounts18<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_26(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_26(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x03ffffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts568<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts431<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VMOVDQU        16(AX), Y3
+	VPSRLVD        shiftCounts307<>+0(SB), Y3, Y3
+	VMOVDQU        32(AX), Y4
+	VPSLLVD        shiftCounts201<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VMOVDQU        48(AX), Y3
+	VPSRLVD        shiftCounts309<>+0(SB), Y3, Y3
+	VBROADCASTI128 64(AX), Y4
+	VPSLLVD        shiftCounts24<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VMOVDQU        64(AX), Y3
+	VPSRLVD        shiftCounts569<>+0(SB), Y3, Y3
+	VMOVDQU        80(AX), Y4
+	VPSLLVD        shiftCounts310<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VMOVDQU        96(AX), Y3
+	VPSRLVD        shiftCounts209<>+0(SB), Y3, Y3
+	VMOVDQU        112(AX), Y4
+	VPSLLVD        shiftCounts312<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VBROADCASTI128 128(AX), Y3
+	VPSRLVD        shiftCounts570<>+0(SB), Y3, Y3
+	VBROADCASTI128 144(AX), Y4
+	VPSLLVD        shiftCounts403<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VMOVDQU        144(AX), Y3
+	VPSRLVD        shiftCounts315<>+0(SB), Y3, Y3
+	VMOVDQU        160(AX), Y4
+	VPSLLVD        shiftCounts216<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VMOVDQU        176(AX), Y3
+	VPSRLVD        shiftCounts317<>+0(SB), Y3, Y3
+	VBROADCASTI128 192(AX), Y4
+	VPSLLVD        shiftCounts20<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VBROADCASTI128 208(AX), Y3
+	VPSRLVD        shiftCounts568<>+0(SB), Y3, Y3
+	VBROADCASTI128 224(AX), Y4
+	VPSLLVD        shiftCounts431<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VMOVDQU        224(AX), Y3
+	VPSRLVD        shiftCounts307<>+0(SB), Y3, Y3
+	VMOVDQU        240(AX), Y4
+	VPSLLVD        shiftCounts201<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VMOVDQU        256(AX), Y3
+	VPSRLVD        shiftCounts309<>+0(SB), Y3, Y3
+	VBROADCASTI128 272(AX), Y4
+	VPSLLVD        shiftCounts24<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VMOVDQU        272(AX), Y3
+	VPSRLVD        shiftCounts569<>+0(SB), Y3, Y3
+	VMOVDQU        288(AX), Y4
+	VPSLLVD        shiftCounts310<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VMOVDQU        304(AX), Y3
+	VPSRLVD        shiftCounts209<>+0(SB), Y3, Y3
+	VMOVDQU        320(AX), Y4
+	VPSLLVD        shiftCounts312<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VBROADCASTI128 336(AX), Y3
+	VPSRLVD        shiftCounts570<>+0(SB), Y3, Y3
+	VBROADCASTI128 352(AX), Y4
+	VPSLLVD        shiftCounts403<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VMOVDQU        352(AX), Y3
+	VPSRLVD        shiftCounts315<>+0(SB), Y3, Y3
+	VMOVDQU        368(AX), Y4
+	VPSLLVD        shiftCounts216<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VMOVDQU        384(AX), Y3
+	VPSRLVD        shiftCounts317<>+0(SB), Y3, Y3
+	VBROADCASTI128 400(AX), Y4
+	VPSLLVD        shiftCounts20<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_27(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_27(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x07ffffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts571<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts409<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VMOVDQU        16(AX), Y3
+	VPSRLVD        shiftCounts572<>+0(SB), Y3, Y3
+	VMOVDQU        32(AX), Y4
+	VPSLLVD        shiftCounts318<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VMOVDQU        48(AX), Y3
+	VPSRLVD        shiftCounts573<>+0(SB), Y3, Y3
+	VMOVDQU        64(AX), Y4
+	VPSLLVD        shiftCounts319<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VBROADCASTI128 80(AX), Y3
+	VPSRLVD        shiftCounts574<>+0(SB), Y3, Y3
+	VBROADCASTI128 96(AX), Y4
+	VPSLLVD        shiftCounts401<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VMOVDQU        96(AX), Y3
+	VPSRLVD        shiftCounts97<>+0(SB), Y3, Y3
+	VMOVDQU        112(AX), Y4
+	VPSLLVD        shiftCounts394<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VMOVDQU        128(AX), Y3
+	VPSRLVD        shiftCounts321<>+0(SB), Y3, Y3
+	VMOVDQU        144(AX), Y4
+	VPSLLVD        shiftCounts395<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VBROADCASTI128 160(AX), Y3
+	VPSRLVD        shiftCounts575<>+0(SB), Y3, Y3
+	VBROADCASTI128 176(AX), Y4
+	VPSLLVD        shiftCounts392<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VMOVDQU        176(AX), Y3
+	VPSRLVD        shiftCounts89<>+0(SB), Y3, Y3
+	VMOVDQU        192(AX), Y4
+	VPSLLVD        shiftCounts324<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VMOVDQU        208(AX), Y3
+	VPSRLVD        shiftCounts92<>+0(SB), Y3, Y3
+	VMOVDQU        224(AX), Y4
+	VPSLLVD        shiftCounts326<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VMOVDQU        240(AX), Y3
+	VPSRLVD        shiftCounts95<>+0(SB), Y3, Y3
+	VBROADCASTI128 256(AX), Y4
+	VPSLLVD        shiftCounts26<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VMOVDQU        256(AX), Y3
+	VPSRLVD        shiftCounts576<>+0(SB), Y3, Y3
+	VMOVDQU        272(AX), Y4
+	VPSLLVD        shiftCounts398<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VMOVDQU        288(AX), Y3
+	VPSRLVD        shiftCounts84<>+0(SB), Y3, Y3
+	VMOVDQU        304(AX), Y4
+	VPSLLVD        shiftCounts399<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VMOVDQU        320(AX), Y3
+	VPSRLVD        shiftCounts87<>+0(SB), Y3, Y3
+	VBROADCASTI128 336(AX), Y4
+	VPSLLVD        shiftCounts24<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VMOVDQU        336(AX), Y3
+	VPSRLVD        shiftCounts577<>+0(SB), Y3, Y3
+	VMOVDQU        352(AX), Y4
+	VPSLLVD        shiftCounts90<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VMOVDQU        368(AX), Y3
+	VPSRLVD        shiftCounts578<>+0(SB), Y3, Y3
+	VMOVDQU        384(AX), Y4
+	VPSLLVD        shiftCounts332<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VMOVDQU        400(AX), Y3
+	VPSRLVD        shiftCounts579<>+0(SB), Y3, Y3
+	VBROADCASTI128 416(AX), Y4
+	VPSLLVD        shiftCounts22<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_28(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_28(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x0fffffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts580<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts447<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VMOVDQU        16(AX), Y3
+	VPSRLVD        shiftCounts156<>+0(SB), Y3, Y3
+	VMOVDQU        32(AX), Y4
+	VPSLLVD        shiftCounts57<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VMOVDQU        48(AX), Y3
+	VPSRLVD        shiftCounts195<>+0(SB), Y3, Y3
+	VMOVDQU        64(AX), Y4
+	VPSLLVD        shiftCounts133<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VMOVDQU        80(AX), Y3
+	VPSRLVD        shiftCounts131<>+0(SB), Y3, Y3
+	VBROADCASTI128 96(AX), Y4
+	VPSLLVD        shiftCounts24<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VBROADCASTI128 112(AX), Y3
+	VPSRLVD        shiftCounts580<>+0(SB), Y3, Y3
+	VBROADCASTI128 128(AX), Y4
+	VPSLLVD        shiftCounts447<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VMOVDQU        128(AX), Y3
+	VPSRLVD        shiftCounts156<>+0(SB), Y3, Y3
+	VMOVDQU        144(AX), Y4
+	VPSLLVD        shiftCounts57<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VMOVDQU        160(AX), Y3
+	VPSRLVD        shiftCounts195<>+0(SB), Y3, Y3
+	VMOVDQU        176(AX), Y4
+	VPSLLVD        shiftCounts133<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VMOVDQU        192(AX), Y3
+	VPSRLVD        shiftCounts131<>+0(SB), Y3, Y3
+	VBROADCASTI128 208(AX), Y4
+	VPSLLVD        shiftCounts24<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VBROADCASTI128 224(AX), Y3
+	VPSRLVD        shiftCounts580<>+0(SB), Y3, Y3
+	VBROADCASTI128 240(AX), Y4
+	VPSLLVD        shiftCounts447<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VMOVDQU        240(AX), Y3
+	VPSRLVD        shiftCounts156<>+0(SB), Y3, Y3
+	VMOVDQU        256(AX), Y4
+	VPSLLVD        shiftCounts57<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VMOVDQU        272(AX), Y3
+	VPSRLVD        shiftCounts195<>+0(SB), Y3, Y3
+	VMOVDQU        288(AX), Y4
+	VPSLLVD        shiftCounts133<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VMOVDQU        304(AX), Y3
+	VPSRLVD        shiftCounts131<>+0(SB), Y3, Y3
+	VBROADCASTI128 320(AX), Y4
+	VPSLLVD        shiftCounts24<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VBROADCASTI128 336(AX), Y3
+	VPSRLVD        shiftCounts580<>+0(SB), Y3, Y3
+	VBROADCASTI128 352(AX), Y4
+	VPSLLVD        shiftCounts447<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VMOVDQU        352(AX), Y3
+	VPSRLVD        shiftCounts156<>+0(SB), Y3, Y3
+	VMOVDQU        368(AX), Y4
+	VPSLLVD        shiftCounts57<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VMOVDQU        384(AX), Y3
+	VPSRLVD        shiftCounts195<>+0(SB), Y3, Y3
+	VMOVDQU        400(AX), Y4
+	VPSLLVD        shiftCounts133<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VMOVDQU        416(AX), Y3
+	VPSRLVD        shiftCounts131<>+0(SB), Y3, Y3
+	VBROADCASTI128 432(AX), Y4
+	VPSLLVD        shiftCounts24<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_29(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_29(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x1fffffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts581<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts401<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VMOVDQU        16(AX), Y3
+	VPSRLVD        shiftCounts582<>+0(SB), Y3, Y3
+	VMOVDQU        32(AX), Y4
+	VPSLLVD        shiftCounts50<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VMOVDQU        48(AX), Y3
+	VPSRLVD        shiftCounts583<>+0(SB), Y3, Y3
+	VMOVDQU        64(AX), Y4
+	VPSLLVD        shiftCounts338<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VMOVDQU        80(AX), Y3
+	VPSRLVD        shiftCounts584<>+0(SB), Y3, Y3
+	VMOVDQU        96(AX), Y4
+	VPSLLVD        shiftCounts340<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VMOVDQU        112(AX), Y3
+	VPSRLVD        shiftCounts585<>+0(SB), Y3, Y3
+	VMOVDQU        128(AX), Y4
+	VPSLLVD        shiftCounts342<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VBROADCASTI128 144(AX), Y3
+	VPSRLVD        shiftCounts586<>+0(SB), Y3, Y3
+	VBROADCASTI128 160(AX), Y4
+	VPSLLVD        shiftCounts392<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VMOVDQU        160(AX), Y3
+	VPSRLVD        shiftCounts344<>+0(SB), Y3, Y3
+	VMOVDQU        176(AX), Y4
+	VPSLLVD        shiftCounts388<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VMOVDQU        192(AX), Y3
+	VPSRLVD        shiftCounts346<>+0(SB), Y3, Y3
+	VMOVDQU        208(AX), Y4
+	VPSLLVD        shiftCounts389<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VMOVDQU        224(AX), Y3
+	VPSRLVD        shiftCounts348<>+0(SB), Y3, Y3
+	VMOVDQU        240(AX), Y4
+	VPSLLVD        shiftCounts52<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VMOVDQU        256(AX), Y3
+	VPSRLVD        shiftCounts350<>+0(SB), Y3, Y3
+	VMOVDQU        272(AX), Y4
+	VPSLLVD        shiftCounts390<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VMOVDQU        288(AX), Y3
+	VPSRLVD        shiftCounts48<>+0(SB), Y3, Y3
+	VBROADCASTI128 304(AX), Y4
+	VPSLLVD        shiftCounts28<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VMOVDQU        304(AX), Y3
+	VPSRLVD        shiftCounts587<>+0(SB), Y3, Y3
+	VMOVDQU        320(AX), Y4
+	VPSLLVD        shiftCounts352<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VMOVDQU        336(AX), Y3
+	VPSRLVD        shiftCounts588<>+0(SB), Y3, Y3
+	VMOVDQU        352(AX), Y4
+	VPSLLVD        shiftCounts354<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VMOVDQU        368(AX), Y3
+	VPSRLVD        shiftCounts589<>+0(SB), Y3, Y3
+	VMOVDQU        384(AX), Y4
+	VPSLLVD        shiftCounts356<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VMOVDQU        400(AX), Y3
+	VPSRLVD        shiftCounts590<>+0(SB), Y3, Y3
+	VMOVDQU        416(AX), Y4
+	VPSLLVD        shiftCounts358<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VMOVDQU        432(AX), Y3
+	VPSRLVD        shiftCounts591<>+0(SB), Y3, Y3
+	VBROADCASTI128 448(AX), Y4
+	VPSLLVD        shiftCounts26<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_30(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_30(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x3fffffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts592<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts403<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VMOVDQU        16(AX), Y3
+	VPSRLVD        shiftCounts65<>+0(SB), Y3, Y3
+	VMOVDQU        32(AX), Y4
+	VPSLLVD        shiftCounts99<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VMOVDQU        48(AX), Y3
+	VPSRLVD        shiftCounts40<>+0(SB), Y3, Y3
+	VMOVDQU        64(AX), Y4
+	VPSLLVD        shiftCounts163<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VMOVDQU        80(AX), Y3
+	VPSRLVD        shiftCounts46<>+0(SB), Y3, Y3
+	VMOVDQU        96(AX), Y4
+	VPSLLVD        shiftCounts165<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VMOVDQU        112(AX), Y3
+	VPSRLVD        shiftCounts63<>+0(SB), Y3, Y3
+	VMOVDQU        128(AX), Y4
+	VPSLLVD        shiftCounts360<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VMOVDQU        144(AX), Y3
+	VPSRLVD        shiftCounts36<>+0(SB), Y3, Y3
+	VMOVDQU        160(AX), Y4
+	VPSLLVD        shiftCounts181<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VMOVDQU        176(AX), Y3
+	VPSRLVD        shiftCounts105<>+0(SB), Y3, Y3
+	VMOVDQU        192(AX), Y4
+	VPSLLVD        shiftCounts183<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VMOVDQU        208(AX), Y3
+	VPSRLVD        shiftCounts62<>+0(SB), Y3, Y3
+	VBROADCASTI128 224(AX), Y4
+	VPSLLVD        shiftCounts28<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VBROADCASTI128 240(AX), Y3
+	VPSRLVD        shiftCounts592<>+0(SB), Y3, Y3
+	VBROADCASTI128 256(AX), Y4
+	VPSLLVD        shiftCounts403<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VMOVDQU        256(AX), Y3
+	VPSRLVD        shiftCounts65<>+0(SB), Y3, Y3
+	VMOVDQU        272(AX), Y4
+	VPSLLVD        shiftCounts99<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VMOVDQU        288(AX), Y3
+	VPSRLVD        shiftCounts40<>+0(SB), Y3, Y3
+	VMOVDQU        304(AX), Y4
+	VPSLLVD        shiftCounts163<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VMOVDQU        320(AX), Y3
+	VPSRLVD        shiftCounts46<>+0(SB), Y3, Y3
+	VMOVDQU        336(AX), Y4
+	VPSLLVD        shiftCounts165<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VMOVDQU        352(AX), Y3
+	VPSRLVD        shiftCounts63<>+0(SB), Y3, Y3
+	VMOVDQU        368(AX), Y4
+	VPSLLVD        shiftCounts360<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VMOVDQU        384(AX), Y3
+	VPSRLVD        shiftCounts36<>+0(SB), Y3, Y3
+	VMOVDQU        400(AX), Y4
+	VPSLLVD        shiftCounts181<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VMOVDQU        416(AX), Y3
+	VPSRLVD        shiftCounts105<>+0(SB), Y3, Y3
+	VMOVDQU        432(AX), Y4
+	VPSLLVD        shiftCounts183<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VMOVDQU        448(AX), Y3
+	VPSRLVD        shiftCounts62<>+0(SB), Y3, Y3
+	VBROADCASTI128 464(AX), Y4
+	VPSLLVD        shiftCounts28<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_31(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_31(SB), NOSPLIT, $0-32
+	MOVQ           in+0(FP), AX
+	MOVQ           out+8(FP), CX
+	MOVQ           offset+16(FP), DX
+	SHLQ           $0x02, DX
+	ADDQ           DX, CX
+	MOVL           $0x7fffffff, DX
+	VMOVD          DX, X0
+	VPBROADCASTD   X0, Y0
+	VPXOR          Y1, Y1, Y1
+	MOVL           $0x00000007, DX
+	VMOVD          DX, X2
+	VPBROADCASTD   X2, Y2
+	VBROADCASTI128 (AX), Y3
+	VPSRLVD        shiftCounts593<>+0(SB), Y3, Y3
+	VBROADCASTI128 16(AX), Y4
+	VPSLLVD        shiftCounts392<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, (CX)
+	VMOVDQU        16(AX), Y3
+	VPSRLVD        shiftCounts594<>+0(SB), Y3, Y3
+	VMOVDQU        32(AX), Y4
+	VPSLLVD        shiftCounts113<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 32(CX)
+	VMOVDQU        48(AX), Y3
+	VPSRLVD        shiftCounts595<>+0(SB), Y3, Y3
+	VMOVDQU        64(AX), Y4
+	VPSLLVD        shiftCounts117<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 64(CX)
+	VMOVDQU        80(AX), Y3
+	VPSRLVD        shiftCounts596<>+0(SB), Y3, Y3
+	VMOVDQU        96(AX), Y4
+	VPSLLVD        shiftCounts121<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 96(CX)
+	VMOVDQU        112(AX), Y3
+	VPSRLVD        shiftCounts597<>+0(SB), Y3, Y3
+	VMOVDQU        128(AX), Y4
+	VPSLLVD        shiftCounts125<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 128(CX)
+	VMOVDQU        144(AX), Y3
+	VPSRLVD        shiftCounts598<>+0(SB), Y3, Y3
+	VMOVDQU        160(AX), Y4
+	VPSLLVD        shiftCounts374<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 160(CX)
+	VMOVDQU        176(AX), Y3
+	VPSRLVD        shiftCounts599<>+0(SB), Y3, Y3
+	VMOVDQU        192(AX), Y4
+	VPSLLVD        shiftCounts376<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 192(CX)
+	VMOVDQU        208(AX), Y3
+	VPSRLVD        shiftCounts600<>+0(SB), Y3, Y3
+	VMOVDQU        224(AX), Y4
+	VPSLLVD        shiftCounts378<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 224(CX)
+	VMOVDQU        240(AX), Y3
+	VPSRLVD        shiftCounts601<>+0(SB), Y3, Y3
+	VMOVDQU        256(AX), Y4
+	VPSLLVD        shiftCounts380<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 256(CX)
+	VMOVDQU        272(AX), Y3
+	VPSRLVD        shiftCounts602<>+0(SB), Y3, Y3
+	VMOVDQU        288(AX), Y4
+	VPSLLVD        shiftCounts382<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 288(CX)
+	VMOVDQU        304(AX), Y3
+	VPSRLVD        shiftCounts603<>+0(SB), Y3, Y3
+	VMOVDQU        320(AX), Y4
+	VPSLLVD        shiftCounts384<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 320(CX)
+	VMOVDQU        336(AX), Y3
+	VPSRLVD        shiftCounts604<>+0(SB), Y3, Y3
+	VMOVDQU        352(AX), Y4
+	VPSLLVD        shiftCounts386<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 352(CX)
+	VMOVDQU        368(AX), Y3
+	VPSRLVD        shiftCounts605<>+0(SB), Y3, Y3
+	VMOVDQU        384(AX), Y4
+	VPSLLVD        shiftCounts115<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 384(CX)
+	VMOVDQU        400(AX), Y3
+	VPSRLVD        shiftCounts606<>+0(SB), Y3, Y3
+	VMOVDQU        416(AX), Y4
+	VPSLLVD        shiftCounts119<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 416(CX)
+	VMOVDQU        432(AX), Y3
+	VPSRLVD        shiftCounts607<>+0(SB), Y3, Y3
+	VMOVDQU        448(AX), Y4
+	VPSLLVD        shiftCounts123<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y4
+	VPADDD         Y4, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y4
+	VPERM2I128     $0x08, Y4, Y4, Y4
+	VPADDD         Y4, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 448(CX)
+	VMOVDQU        464(AX), Y3
+	VPSRLVD        shiftCounts608<>+0(SB), Y3, Y3
+	VBROADCASTI128 480(AX), Y4
+	VPSLLVD        shiftCounts30<>+0(SB), Y4, Y4
+	VPOR           Y4, Y3, Y3
+	VPAND          Y0, Y3, Y3
+	VPSLLDQ        $0x04, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSLLDQ        $0x08, Y3, Y0
+	VPADDD         Y0, Y3, Y3
+	VPSHUFD        $0xff, Y3, Y0
+	VPERM2I128     $0x08, Y0, Y0, Y0
+	VPADDD         Y0, Y3, Y3
+	VPADDD         Y1, Y3, Y3
+	VPERMD         Y3, Y2, Y1
+	VMOVDQU        Y3, 480(CX)
+	VZEROUPPER
+	RET
+
+// func unpackDelta32AVX2_32(in *byte, out uintptr, offset int, seed *byte)
+// Requires: AVX, AVX2
+TEXT ·unpackDelta32AVX2_32(SB), NOSPLIT, $0-32
+	MOVQ         in+0(FP), AX
+	MOVQ         out+8(FP), CX
+	MOVQ         offset+16(FP), DX
+	SHLQ         $0x02, DX
+	ADDQ         DX, CX
+	VPXOR        Y0, Y0, Y0
+	MOVL         $0x00000007, DX
+	VMOVD        DX, X1
+	VPBROADCASTD X1, Y1
+	VMOVDQU      (AX), Y2
+	VPSLLDQ      $0x04, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSLLDQ      $0x08, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSHUFD      $0xff, Y2, Y3
+	VPERM2I128   $0x08, Y3, Y3, Y3
+	VPADDD       Y3, Y2, Y2
+	VPADDD       Y0, Y2, Y2
+	VPERMD       Y2, Y1, Y0
+	VMOVDQU      Y2, (CX)
+	VMOVDQU      32(AX), Y2
+	VPSLLDQ      $0x04, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSLLDQ      $0x08, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSHUFD      $0xff, Y2, Y3
+	VPERM2I128   $0x08, Y3, Y3, Y3
+	VPADDD       Y3, Y2, Y2
+	VPADDD       Y0, Y2, Y2
+	VPERMD       Y2, Y1, Y0
+	VMOVDQU      Y2, 32(CX)
+	VMOVDQU      64(AX), Y2
+	VPSLLDQ      $0x04, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSLLDQ      $0x08, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSHUFD      $0xff, Y2, Y3
+	VPERM2I128   $0x08, Y3, Y3, Y3
+	VPADDD       Y3, Y2, Y2
+	VPADDD       Y0, Y2, Y2
+	VPERMD       Y2, Y1, Y0
+	VMOVDQU      Y2, 64(CX)
+	VMOVDQU      96(AX), Y2
+	VPSLLDQ      $0x04, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSLLDQ      $0x08, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSHUFD      $0xff, Y2, Y3
+	VPERM2I128   $0x08, Y3, Y3, Y3
+	VPADDD       Y3, Y2, Y2
+	VPADDD       Y0, Y2, Y2
+	VPERMD       Y2, Y1, Y0
+	VMOVDQU      Y2, 96(CX)
+	VMOVDQU      128(AX), Y2
+	VPSLLDQ      $0x04, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSLLDQ      $0x08, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSHUFD      $0xff, Y2, Y3
+	VPERM2I128   $0x08, Y3, Y3, Y3
+	VPADDD       Y3, Y2, Y2
+	VPADDD       Y0, Y2, Y2
+	VPERMD       Y2, Y1, Y0
+	VMOVDQU      Y2, 128(CX)
+	VMOVDQU      160(AX), Y2
+	VPSLLDQ      $0x04, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSLLDQ      $0x08, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSHUFD      $0xff, Y2, Y3
+	VPERM2I128   $0x08, Y3, Y3, Y3
+	VPADDD       Y3, Y2, Y2
+	VPADDD       Y0, Y2, Y2
+	VPERMD       Y2, Y1, Y0
+	VMOVDQU      Y2, 160(CX)
+	VMOVDQU      192(AX), Y2
+	VPSLLDQ      $0x04, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSLLDQ      $0x08, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSHUFD      $0xff, Y2, Y3
+	VPERM2I128   $0x08, Y3, Y3, Y3
+	VPADDD       Y3, Y2, Y2
+	VPADDD       Y0, Y2, Y2
+	VPERMD       Y2, Y1, Y0
+	VMOVDQU      Y2, 192(CX)
+	VMOVDQU      224(AX), Y2
+	VPSLLDQ      $0x04, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSLLDQ      $0x08, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSHUFD      $0xff, Y2, Y3
+	VPERM2I128   $0x08, Y3, Y3, Y3
+	VPADDD       Y3, Y2, Y2
+	VPADDD       Y0, Y2, Y2
+	VPERMD       Y2, Y1, Y0
+	VMOVDQU      Y2, 224(CX)
+	VMOVDQU      256(AX), Y2
+	VPSLLDQ      $0x04, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSLLDQ      $0x08, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSHUFD      $0xff, Y2, Y3
+	VPERM2I128   $0x08, Y3, Y3, Y3
+	VPADDD       Y3, Y2, Y2
+	VPADDD       Y0, Y2, Y2
+	VPERMD       Y2, Y1, Y0
+	VMOVDQU      Y2, 256(CX)
+	VMOVDQU      288(AX), Y2
+	VPSLLDQ      $0x04, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSLLDQ      $0x08, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSHUFD      $0xff, Y2, Y3
+	VPERM2I128   $0x08, Y3, Y3, Y3
+	VPADDD       Y3, Y2, Y2
+	VPADDD       Y0, Y2, Y2
+	VPERMD       Y2, Y1, Y0
+	VMOVDQU      Y2, 288(CX)
+	VMOVDQU      320(AX), Y2
+	VPSLLDQ      $0x04, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSLLDQ      $0x08, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSHUFD      $0xff, Y2, Y3
+	VPERM2I128   $0x08, Y3, Y3, Y3
+	VPADDD       Y3, Y2, Y2
+	VPADDD       Y0, Y2, Y2
+	VPERMD       Y2, Y1, Y0
+	VMOVDQU      Y2, 320(CX)
+	VMOVDQU      352(AX), Y2
+	VPSLLDQ      $0x04, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSLLDQ      $0x08, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSHUFD      $0xff, Y2, Y3
+	VPERM2I128   $0x08, Y3, Y3, Y3
+	VPADDD       Y3, Y2, Y2
+	VPADDD       Y0, Y2, Y2
+	VPERMD       Y2, Y1, Y0
+	VMOVDQU      Y2, 352(CX)
+	VMOVDQU      384(AX), Y2
+	VPSLLDQ      $0x04, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSLLDQ      $0x08, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSHUFD      $0xff, Y2, Y3
+	VPERM2I128   $0x08, Y3, Y3, Y3
+	VPADDD       Y3, Y2, Y2
+	VPADDD       Y0, Y2, Y2
+	VPERMD       Y2, Y1, Y0
+	VMOVDQU      Y2, 384(CX)
+	VMOVDQU      416(AX), Y2
+	VPSLLDQ      $0x04, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSLLDQ      $0x08, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSHUFD      $0xff, Y2, Y3
+	VPERM2I128   $0x08, Y3, Y3, Y3
+	VPADDD       Y3, Y2, Y2
+	VPADDD       Y0, Y2, Y2
+	VPERMD       Y2, Y1, Y0
+	VMOVDQU      Y2, 416(CX)
+	VMOVDQU      448(AX), Y2
+	VPSLLDQ      $0x04, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSLLDQ      $0x08, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSHUFD      $0xff, Y2, Y3
+	VPERM2I128   $0x08, Y3, Y3, Y3
+	VPADDD       Y3, Y2, Y2
+	VPADDD       Y0, Y2, Y2
+	VPERMD       Y2, Y1, Y0
+	VMOVDQU      Y2, 448(CX)
+	VMOVDQU      480(AX), Y2
+	VPSLLDQ      $0x04, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSLLDQ      $0x08, Y2, Y3
+	VPADDD       Y3, Y2, Y2
+	VPSHUFD      $0xff, Y2, Y3
+	VPERM2I128   $0x08, Y3, Y3, Y3
+	VPADDD       Y3, Y2, Y2
+	VPADDD       Y0, Y2, Y2
+	VPERMD       Y2, Y1, Y0
+	VMOVDQU      Y2, 480(CX)
+	VZEROUPPER
+	RET
@@ -0,0 +1,269 @@
+package fastpfor
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// PagedPFORCodec is the Codec of the original FastPFOR design: blocks of 128
+// values are grouped into pages of up to 65536 values, and the exception high
+// bits of all blocks of a page are collected into one bit-packed array per width
+// instead of a table per block. For long lists this saves the per-block
+// exception metadata and the StreamVByte control bytes. With Delta set, the
+// differences to the preceding value are packed, which suits sorted sequences.
+//
+// The encoding is the value count (uvarint) followed by the pages:
+//
+//	metaLen   uvarint, length of the metadata
+//	metadata  per block its bit width b, exception count, and if there are
+//	          exceptions the width of its largest value and their positions
+//	          (one byte each)
+//	payload   per block the interleaved lanes at b (16*b bytes)
+//	bitmap    uint32, bit w-1 is set if there are exceptions with high bits of width w
+//	arrays    per set bit w, the number of high bits (uvarint) and the high bits
+//	          bit-packed at width w, least significant bit first
+//
+// High bits of width 1 are always 1, so those exceptions only store their
+// position. The bit widths are chosen with the cost model of JavaFastPFOR.
+// Unlike the block format, the pages carry no header flags, so blocks can only
+// be decoded as part of their page.
+type PagedPFORCodec struct {
+	Delta bool
+}
+
+var _ Codec = PagedPFORCodec{}
+
+const (
+	pagedPageBlocks = 512 // blocks per page (65536 values)
+	pagedBitmapLen  = 4
+	pagedMaxMetaLen = pagedPageBlocks * (3 + blockSize)
+)
+
+// Pack implements Codec.
+func (c PagedPFORCodec) Pack(dst []byte, values []uint32) []byte {
+	dst = binary.AppendUvarint(dst, uint64(len(values)))
+	if c.Delta && len(values) > 0 {
+		deltas := make([]uint32, len(values))
+		var prev uint32
+		for i, v := range values {
+			deltas[i], prev = v-prev, v
+		}
+		values = deltas
+	}
+	for off := 0; off < len(values); off += pagedPageBlocks * blockSize {
+		dst = appendPagedPage(dst, values[off:min(off+pagedPageBlocks*blockSize, len(values))])
+	}
+	return dst
+}
+
+// appendPagedPage appends the page of the blocks in values to dst.
+func appendPagedPage(dst []byte, values []uint32) []byte {
+	var meta, payload []byte
+	var high [33][]uint32 // exception high bits by width
+	var positions [blockSize]byte
+	var highBits [blockSize]uint32
+	for off := 0; off < len(values); off += blockSize {
+		block := values[off:min(off+blockSize, len(values))]
+		b, excCount, maxBits := javaBestBitWidth(block)
+		start := len(payload)
+		payload = append(payload, make([]byte, payloadBytes(b))...)
+		meta = append(meta, byte(b), byte(excCount))
+		if excCount == 0 {
+			if b > 0 {
+				packLanes(payload[start:], block, b)
+			}
+			continue
+		}
+		mask := packLanesExceptions(payload[start:], block, b)
+		n := exceptionsFromMask(block, b, mask, positions[:], highBits[:])
+		meta = append(meta, byte(maxBits))
+		meta = append(meta, positions[:n]...)
+		high[maxBits-b] = append(high[maxBits-b], highBits[:n]...)
+	}
+
+	dst = binary.AppendUvarint(dst, uint64(len(meta)))
+	dst = append(dst, meta...)
+	dst = append(dst, payload...)
+	var bitmap uint32
+	for w := 2; w <= 32; w++ {
+		if len(high[w]) > 0 {
+			bitmap |= 1 << (w - 1)
+		}
+	}
+	dst = binary.LittleEndian.AppendUint32(dst, bitmap)
+	for w := 2; w <= 32; w++ {
+		if len(high[w]) > 0 {
+			dst = binary.AppendUvarint(dst, uint64(len(high[w])))
+			dst = appendPackedBits(dst, high[w], w)
+		}
+	}
+	return dst
+}
+
+// Unpack implements Codec.
+func (c PagedPFORCodec) Unpack(dst []uint32, buf []byte) ([]uint32, int, error) {
+	count, off, err := readCodecCount(buf, blockSize/2) // every block has 2 metadata bytes
+	if err != nil {
+		return nil, 0, err
+	}
+	dst = ensureUint32Cap(dst, count, numBlocks(count)*blockSize)
+	for page := 0; page < count; page += pagedPageBlocks * blockSize {
+		n, err := unpackPagedPage(dst[page:page+min(numBlocks(count-page), pagedPageBlocks)*blockSize],
+			buf[off:], min(count-page, pagedPageBlocks*blockSize))
+		if err != nil {
+			return nil, 0, fmt.Errorf("page %d: %w", page/(pagedPageBlocks*blockSize), err)
+		}
+		off += n
+	}
+	dst = dst[:count]
+	if c.Delta {
+		var prev uint32
+		for i, d := range dst {
+			prev += d
+			dst[i] = prev
+		}
+	}
+	return dst, off, nil
+}
+
+// unpackPagedPage decodes the page of count values at the start of buf into dst,
+// which must hold the whole blocks, and returns the number of bytes consumed.
+func unpackPagedPage(dst []uint32, buf []byte, count int) (int, error) {
+	metaLen, off := binary.Uvarint(buf)
+	if off <= 0 || metaLen > pagedMaxMetaLen || uint64(len(buf)-off) < metaLen {
+		return 0, fmt.Errorf("%w: page metadata truncated", ErrInvalidBuffer)
+	}
+	meta := buf[off : off+int(metaLen)]
+	off += int(metaLen)
+
+	// Validate the metadata and count the payload bytes and high bits per width
+	var highCount [33]int
+	payloadLen, m := 0, 0
+	for block := 0; block < count; block += blockSize {
+		n := min(count-block, blockSize)
+		if len(meta)-m < 2 {
+			return 0, fmt.Errorf("%w: metadata of block %d truncated", ErrInvalidBuffer, block/blockSize)
+		}
+		b, excCount := int(meta[m]), int(meta[m+1])
+		m += 2
+		if b > 32 {
+			return 0, fmt.Errorf("%w: invalid bit width %d", ErrInvalidBuffer, b)
+		}
+		payloadLen += payloadBytes(b)
+		if excCount == 0 {
+			continue
+		}
+		if excCount > n || len(meta)-m < 1+excCount {
+			return 0, fmt.Errorf("%w: exceptions of block %d truncated", ErrInvalidBuffer, block/blockSize)
+		}
+		maxBits := int(meta[m])
+		if maxBits <= b || maxBits > 32 {
+			return 0, fmt.Errorf("%w: invalid exception width %d at bit width %d", ErrInvalidBuffer, maxBits, b)
+		}
+		prev := -1
+		for _, pos := range meta[m+1 : m+1+excCount] {
+			if int(pos) <= prev || int(pos) >= n {
+				return 0, fmt.Errorf("%w: invalid exception position %d", ErrInvalidBuffer, pos)
+			}
+			prev = int(pos)
+		}
+		m += 1 + excCount
+		highCount[maxBits-b] += excCount
+	}
+	if m != len(meta) {
+		return 0, fmt.Errorf("%w: metadata length %d does not match its blocks (%d bytes)", ErrInvalidBuffer, len(meta), m)
+	}
+	if len(buf)-off < payloadLen+pagedBitmapLen {
+		return 0, fmt.Errorf("%w: page payload truncated", ErrInvalidBuffer)
+	}
+	payload := buf[off : off+payloadLen]
+	off += payloadLen
+	bitmap := binary.LittleEndian.Uint32(buf[off:])
+	off += pagedBitmapLen
+
+	var high [33][]byte
+	for w := 2; w <= 32; w++ {
+		if (bitmap>>(w-1)&1 != 0) != (highCount[w] > 0) {
+			return 0, fmt.Errorf("%w: exception bitmap does not match the metadata at width %d", ErrInvalidBuffer, w)
+		}
+		if highCount[w] == 0 {
+			continue
+		}
+		n, k := binary.Uvarint(buf[off:])
+		if k <= 0 || n != uint64(highCount[w]) {
+			return 0, fmt.Errorf("%w: invalid exception count at width %d", ErrInvalidBuffer, w)
+		}
+		off += k
+		size := (highCount[w]*w + 7) / 8
+		if len(buf)-off < size {
+			return 0, fmt.Errorf("%w: exceptions of width %d truncated", ErrInvalidBuffer, w)
+		}
+		high[w] = buf[off : off+size]
+		off += size
+	}
+	if bitmap&1 != 0 {
+		return 0, fmt.Errorf("%w: exception bitmap sets width 1", ErrInvalidBuffer)
+	}
+
+	// Unpack the blocks and patch the exceptions in block order
+	var next [33]int
+	m, p := 0, 0
+	for block := 0; block < count; block += blockSize {
+		n := min(count-block, blockSize)
+		b, excCount := int(meta[m]), int(meta[m+1])
+		m += 2
+		values := dst[block : block+blockSize]
+		unpackLanes(values, payload[p:p+payloadBytes(b)], n, b)
+		p += payloadBytes(b)
+		if excCount == 0 {
+			continue
+		}
+		w := int(meta[m]) - b
+		for _, pos := range meta[m+1 : m+1+excCount] {
+			h := uint32(1)
+			if w > 1 {
+				h = readPackedBits(high[w], next[w]*w, w)
+			}
+			next[w]++
+			values[pos] |= h << b
+		}
+		m += 1 + excCount
+	}
+	return off, nil
+}
+
+// appendPackedBits appends the values bit-packed at width w (1-32), least
+// significant bit first, in (len(values)*w+7)/8 bytes.
+func appendPackedBits(dst []byte, values []uint32, w int) []byte {
+	var acc uint64
+	var n int
+	for _, v := range values {
+		acc |= uint64(v) << n
+		for n += w; n >= 8; n -= 8 {
+			dst = append(dst, byte(acc))
+			acc >>= 8
+		}
+	}
+	if n > 0 {
+		dst = append(dst, byte(acc))
+	}
+	return dst
+}
+
+// readPackedBits returns the w-bit value (1-32) at bit offset pos of data written
+// by appendPackedBits.
+func readPackedBits(data []byte, pos, w int) uint32 {
+	var acc uint64
+	for i, b := range data[pos>>3 : (pos+w+7)>>3] {
+		acc |= uint64(b) << (8 * i)
+	}
+	return uint32(acc>>(pos&7)) & uint32(1<<w-1)
+}
+
+// MaxEncodedLen implements Codec. A block never takes more than its metadata,
+// its payload at bit width 32 and 32 bits per exception.
+func (PagedPFORCodec) MaxEncodedLen(n int) int {
+	pages := (numBlocks(n) + pagedPageBlocks - 1) / pagedPageBlocks
+	pageOverhead := uvarintLen(pagedMaxMetaLen) + pagedBitmapLen + 31*(uvarintLen(pagedPageBlocks*blockSize)+1)
+	return uvarintLen(n) + pages*pageOverhead + numBlocks(n)*(3+blockSize+2*payloadBytes(32))
+}
@@ -0,0 +1,112 @@
+package fastpfor
+
+import (
+	"encoding/binary"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// genOutliers returns n small values with an outlier of 12 to 28 bits every 40
+// values, so that most blocks have a few exceptions.
+func genOutliers(n int) []uint32 {
+	rng := rand.New(rand.NewSource(4045))
+	values := make([]uint32, n)
+	for i := range values {
+		values[i] = uint32(rng.Intn(16))
+		if i%40 == 7 {
+			values[i] = uint32(rng.Intn(1<<28)) | 1<<(12+rng.Intn(16))
+		}
+	}
+	return values
+}
+
+// TestPagedPFORCodec verifies round trips across several pages and that the
+// shared exception arrays are smaller than the per-block exception areas.
+func TestPagedPFORCodec(t *testing.T) {
+	assert := assert.New(t)
+
+	values := genOutliers(2*pagedPageBlocks*blockSize + 1000)
+	for _, c := range []PagedPFORCodec{{}, {Delta: true}} {
+		buf := c.Pack(nil, values)
+		assert.LessOrEqual(len(buf), c.MaxEncodedLen(len(values)))
+		got, n, err := c.Unpack(nil, buf)
+		assert.NoError(err)
+		assert.Equal(len(buf), n)
+		assert.Equal(values, got)
+	}
+
+	paged := PagedPFORCodec{}.Pack(nil, values)
+	blocks := PFORCodec{}.Pack(nil, values)
+	assert.Less(len(paged), len(blocks), "paged %d bytes, blocks %d bytes", len(paged), len(blocks))
+}
+
+// TestPagedPFORCodecWidths verifies exceptions of all high bit widths, including
+// width 1, which stores no high bits.
+func TestPagedPFORCodecWidths(t *testing.T) {
+	assert := assert.New(t)
+
+	values := make([]uint32, 32*blockSize)
+	for w := 1; w <= 32; w++ {
+		block := values[(w-1)*blockSize : w*blockSize]
+		for i := range block {
+			block[i] = uint32(i % 2)
+		}
+		block[3] = uint32(1<<w-1) | 1
+		block[90] = 1 << (w - 1)
+	}
+	buf := PagedPFORCodec{}.Pack(nil, values)
+	got, _, err := PagedPFORCodec{}.Unpack(nil, buf)
+	assert.NoError(err)
+	assert.Equal(values, got)
+
+	assert.Equal([]uint32{0}, packedBitsRoundTrip(nil, 7))
+	for w := 1; w <= 32; w++ {
+		values := []uint32{1<<w - 1, 0, 1 << (w - 1), 5 & (1<<w - 1)}
+		assert.Equal(values, packedBitsRoundTrip(values, w), "width %d", w)
+	}
+}
+
+// packedBitsRoundTrip packs values at width w and reads them back.
+func packedBitsRoundTrip(values []uint32, w int) []uint32 {
+	buf := appendPackedBits(nil, values, w)
+	if len(buf) != (len(values)*w+7)/8 {
+		return nil
+	}
+	out := make([]uint32, max(len(values), 1))
+	for i := range values {
+		out[i] = readPackedBits(buf, i*w, w)
+	}
+	return out
+}
+
+// TestPagedPFORCodecMalformed verifies that inconsistent pages are rejected.
+func TestPagedPFORCodecMalformed(t *testing.T) {
+	assert := assert.New(t)
+
+	values := genOutliers(2 * blockSize)
+	buf := PagedPFORCodec{}.Pack(nil, values)
+	_, k := binary.Uvarint(buf)
+	metaLen, n := binary.Uvarint(buf[k:])
+	meta := k + n
+
+	corrupt := func(f func(b []byte)) error {
+		b := append([]byte(nil), buf...)
+		f(b)
+		_, _, err := PagedPFORCodec{}.Unpack(nil, b)
+		return err
+	}
+	assert.ErrorIs(corrupt(func(b []byte) { b[meta] = 33 }), ErrInvalidBuffer)    // bit width
+	assert.ErrorIs(corrupt(func(b []byte) { b[meta+2] = 0 }), ErrInvalidBuffer)   // exception width
+	assert.ErrorIs(corrupt(func(b []byte) { b[meta+3] = 200 }), ErrInvalidBuffer) // position
+	bitmap := meta + int(metaLen)
+	for m := meta; m < meta+int(metaLen); m += 2 {
+		bitmap += payloadBytes(int(buf[m]))
+		if buf[m+1] > 0 {
+			m += 1 + int(buf[m+1])
+		}
+	}
+	assert.ErrorIs(corrupt(func(b []byte) { b[bitmap] |= 1 }), ErrInvalidBuffer)
+	assert.ErrorIs(corrupt(func(b []byte) { b[bitmap+3] ^= 0x80 }), ErrInvalidBuffer)
+}
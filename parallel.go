@@ -0,0 +1,121 @@
+package fastpfor
+
+import (
+	"fmt"
+	"runtime"
+	"slices"
+	"sync"
+)
+
+// PackBlocksParallel packs each of the independent blocks in values like
+// PackUint32, using up to workers goroutines, and appends the blocks to dst in
+// the order of values. The result is byte-identical to packing the blocks one
+// after another. If workers is below 1, runtime.GOMAXPROCS(0) goroutines are used.
+//
+// Each worker packs a contiguous run of blocks into its own buffer with an
+// Encoder, so the extra capacity of the value slices is not used. Panics if a
+// block holds more than BlockSize values.
+func PackBlocksParallel(dst []byte, values [][]uint32, workers int) []byte {
+	for i, block := range values {
+		if len(block) > blockSize {
+			panic(fmt.Sprintf("fastpfor: PackBlocksParallel: block %d holds %d values (max %d)", i, len(block), blockSize))
+		}
+	}
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, len(values))
+	if workers <= 1 {
+		var enc Encoder
+		for _, block := range values {
+			dst = enc.Pack(dst, block)
+		}
+		return dst
+	}
+
+	parts := make([][]byte, workers)
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			enc := GetEncoder()
+			defer PutEncoder(enc)
+			var part []byte
+			for _, block := range values[w*len(values)/workers : (w+1)*len(values)/workers] {
+				part = enc.Pack(part, block)
+			}
+			parts[w] = part
+		}()
+	}
+	wg.Wait()
+
+	total := 0
+	for _, part := range parts {
+		total += len(part)
+	}
+	dst = slices.Grow(dst, total)
+	for _, part := range parts {
+		dst = append(dst, part...)
+	}
+	return dst
+}
+
+// UnpackAllUint32Parallel decodes a sequence frame like UnpackAllUint32, using up
+// to workers goroutines for bulk scans of large frames. The block boundaries are
+// located from the block headers first, then each worker decodes a contiguous
+// run of blocks directly into its part of dst. If workers is below 1,
+// runtime.GOMAXPROCS(0) goroutines are used.
+//
+// The result matches UnpackAllUint32; of several invalid blocks, the error of
+// the first one is returned.
+func UnpackAllUint32Parallel(dst []uint32, buf []byte, workers int) ([]uint32, int, error) {
+	count, numBlocks, off, err := readFrameHeader(buf)
+	if err != nil {
+		return nil, 0, err
+	}
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, numBlocks)
+	if workers <= 1 {
+		return UnpackAllUint32(dst, buf)
+	}
+
+	offsets := make([]int, numBlocks+1)
+	offsets[0] = off
+	for b := range numBlocks {
+		n, err := BlockLength(buf[offsets[b]:])
+		if err != nil {
+			return nil, 0, err
+		}
+		if offsets[b]+n > len(buf) {
+			return nil, 0, fmt.Errorf("%w: sequence block at offset %d truncated (need %d bytes, got %d)",
+				ErrInvalidBuffer, offsets[b], n, len(buf)-offsets[b])
+		}
+		offsets[b+1] = offsets[b] + n
+	}
+
+	dst = ensureUint32Cap(dst, count, numBlocks*blockSize)
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for b := w * numBlocks / workers; b < (w+1)*numBlocks/workers; b++ {
+				if _, err := unpackFrameBlock(dst, buf, offsets[b], b*blockSize, count); err != nil {
+					errs[w] = err
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	return dst[:count], offsets[numBlocks], nil
+}
@@ -0,0 +1,103 @@
+package fastpfor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPackBlocksParallel(t *testing.T) {
+	assert := assert.New(t)
+
+	var blocks [][]uint32
+	for i := range 37 {
+		switch i % 3 {
+		case 0:
+			blocks = append(blocks, genDataWithLargeExceptions())
+		case 1:
+			blocks = append(blocks, genMixed(blockSize)[:i])
+		default:
+			blocks = append(blocks, genMonotonic(blockSize))
+		}
+	}
+	var want []byte
+	for _, block := range blocks {
+		want = PackUint32(want, block)
+	}
+
+	prefix := []byte{1, 2, 3}
+	for _, workers := range []int{0, 1, 2, 5, 100} {
+		got := PackBlocksParallel(append([]byte(nil), prefix...), blocks, workers)
+		assert.Equal(append(append([]byte(nil), prefix...), want...), got, "workers %d", workers)
+	}
+
+	assert.Equal(prefix, PackBlocksParallel(prefix, nil, 4))
+	assert.Panics(func() { PackBlocksParallel(nil, [][]uint32{make([]uint32, blockSize+1)}, 2) })
+}
+
+func BenchmarkPackBlocksParallel(b *testing.B) {
+	blocks := make([][]uint32, 1024)
+	for i := range blocks {
+		blocks[i] = genMixed(blockSize)
+	}
+	var dst []byte
+	b.ReportAllocs()
+	for range b.N {
+		dst = PackBlocksParallel(dst[:0], blocks, 0)
+	}
+	resultBytes = dst
+}
+
+func TestUnpackAllUint32Parallel(t *testing.T) {
+	assert := assert.New(t)
+
+	values := append(genMixed(blockSize), genDataWithLargeExceptions()...)
+	for len(values) < 20*blockSize+17 {
+		values = append(values, genMonotonic(blockSize)...)
+	}
+	values = values[:20*blockSize+17]
+	for _, pack := range []func([]byte, []uint32) []byte{PackAllUint32, PackAllDeltaUint32} {
+		frame := append(pack(nil, values), 0xFF) // trailing data is not consumed
+		for _, workers := range []int{0, 1, 3, 100} {
+			got, n, err := UnpackAllUint32Parallel(nil, frame, workers)
+			assert.NoError(err)
+			assert.Equal(values, got, "workers %d", workers)
+			assert.Equal(len(frame)-1, n)
+		}
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		got, n, err := UnpackAllUint32Parallel(nil, PackAllUint32(nil, nil), 4)
+		assert.NoError(err)
+		assert.Empty(got)
+		assert.Equal(1, n)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		frame := PackAllUint32(nil, values)
+		for _, buf := range [][]byte{frame[:len(frame)-1], frame[:len(frame)/2], {0xFF}} {
+			_, _, err := UnpackAllUint32Parallel(nil, buf, 4)
+			assert.ErrorIs(err, ErrInvalidBuffer)
+		}
+		// A wrong count in a middle block is reported like UnpackAllUint32 does
+		other := PackAllUint32(nil, values[:3*blockSize])
+		short := append(other[:len(other)-len(PackUint32(nil, values[2*blockSize:3*blockSize]))], PackUint32(nil, values[:5])...)
+		_, _, wantErr := UnpackAllUint32(nil, short)
+		_, _, err := UnpackAllUint32Parallel(nil, short, 3)
+		assert.Equal(wantErr, err)
+	})
+}
+
+func BenchmarkUnpackAllUint32Parallel(b *testing.B) {
+	values := make([]uint32, 0, 1024*blockSize)
+	for len(values) < cap(values) {
+		values = append(values, genMixed(blockSize)...)
+	}
+	frame := PackAllUint32(nil, values)
+	dst := make([]uint32, len(values))
+	b.ReportAllocs()
+	for range b.N {
+		dst, _, _ = UnpackAllUint32Parallel(dst[:0], frame, 0)
+	}
+	resultU32 = dst
+}
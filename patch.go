@@ -0,0 +1,340 @@
+package fastpfor
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/mhr3/streamvbyte"
+)
+
+// Run-coded exception positions. Bursty outliers produce exceptions at consecutive
+// positions, which the encoder stores as runs whenever that is smaller than one
+// byte per position. The high bit of the StreamVByte length marks this form
+// (StreamVByte data of a block never exceeds 2^14 bytes), and a length byte
+// precedes the run codes:
+//
+//	count(1) + svbLen|patchRunsFlag(2) + runsLen(1) + runs(runsLen) + StreamVByte(svbLen)
+//
+// A run code byte below patchRunStart is a single position. A byte b at or above
+// patchRunStart starts a run at position b-patchRunStart, and the following byte
+// holds the run length minus 2.
+//
+// Blocks with many scattered exceptions store the positions as a bitmap instead,
+// marked by the second-highest bit of the StreamVByte length. Bit i of the
+// little-endian bitmap is set if position i is an exception:
+//
+//	count(1) + svbLen|patchBitmapFlag(2) + bitmap(16) + StreamVByte(svbLen)
+//
+// The encoder picks the smallest of the three forms, preferring plain positions
+// and then runs on ties, so the bitmap is only used for more than 16 exceptions.
+//
+// Independently of the positions, the high bits are stored as fixed-width
+// little-endian integers instead of StreamVByte if that is smaller, which is the
+// case if they all need the same number of bytes (such as exceptions near 2^32).
+// The third-highest bit of the StreamVByte length marks this form, and the width
+// in bytes is the length divided by the exception count:
+//
+//	count(1) + svbLen|patchRawFlag(2) + positions + high bits(svbLen)
+const (
+	patchRunsFlag    = 1 << 15
+	patchBitmapFlag  = 1 << 14
+	patchRawFlag     = 1 << 13
+	patchSVBLenMask  = patchRawFlag - 1
+	patchRunStart    = 0x80
+	patchMetaBytes   = 3 // count(1) + svbLen(2)
+	patchBitmapBytes = blockSize / 8
+)
+
+// patchLayout describes an exception area. Offsets are relative to its start.
+type patchLayout struct {
+	excCount int
+	runs     bool // positions are run-coded
+	bitmap   bool // positions are a bitmap
+	posOff   int  // offset of the positions (or run codes or bitmap)
+	posLen   int  // length of the positions (or run codes or bitmap)
+	svbLen   int  // length of the StreamVByte data (or raw high bits)
+	rawWidth int  // bytes per raw high bits value, 0 for StreamVByte
+}
+
+// svbOff returns the offset of the StreamVByte data.
+func (l patchLayout) svbOff() int {
+	return l.posOff + l.posLen
+}
+
+// size returns the total length of the exception area.
+func (l patchLayout) size() int {
+	return l.posOff + l.posLen + l.svbLen
+}
+
+// readPatchLayout reads the metadata of the exception area at the start of patch.
+// Only the metadata must be present in patch, not the positions or StreamVByte data.
+func readPatchLayout(patch []byte) (patchLayout, error) {
+	if len(patch) < patchMetaBytes {
+		return patchLayout{}, fmt.Errorf("fastpfor: truncated exception metadata (need %d bytes, got %d)",
+			patchMetaBytes, len(patch))
+	}
+	l := patchLayout{excCount: int(patch[0]), posOff: patchMetaBytes}
+	if l.excCount > blockSize {
+		return patchLayout{}, fmt.Errorf("fastpfor: invalid exception count %d", l.excCount)
+	}
+	svbLen := int(bo.Uint16(patch[1:3]))
+	l.svbLen = svbLen & patchSVBLenMask
+	l.posLen = l.excCount
+	if svbLen&patchRawFlag != 0 {
+		if l.excCount == 0 || l.svbLen == 0 || l.svbLen%l.excCount != 0 || l.svbLen > 4*l.excCount {
+			return patchLayout{}, fmt.Errorf("fastpfor: raw high bits of %d bytes do not fit %d exceptions",
+				l.svbLen, l.excCount)
+		}
+		l.rawWidth = l.svbLen / l.excCount
+		svbLen &^= patchRawFlag
+	}
+	switch svbLen &^ patchSVBLenMask {
+	case patchRunsFlag | patchBitmapFlag:
+		return patchLayout{}, fmt.Errorf("fastpfor: exception positions both run-coded and a bitmap")
+	case patchBitmapFlag:
+		l.bitmap = true
+		l.posLen = patchBitmapBytes
+	case patchRunsFlag:
+		if len(patch) < patchMetaBytes+1 {
+			return patchLayout{}, fmt.Errorf("fastpfor: truncated exception metadata (need %d bytes, got %d)",
+				patchMetaBytes+1, len(patch))
+		}
+		l.runs = true
+		l.posOff++
+		l.posLen = int(patch[patchMetaBytes])
+	}
+	if err := checkPatchLimits(l); err != nil {
+		return patchLayout{}, err
+	}
+	return l, nil
+}
+
+// positions returns the exception positions of the exception area patch, which
+// must hold the positions (or run codes or bitmap). Run-coded and bitmap positions
+// are expanded into scratch; plain positions alias patch. The positions are not
+// validated against the block.
+func (l patchLayout) positions(patch []byte, scratch *[blockSize]byte) ([]byte, error) {
+	if len(patch) < l.svbOff() {
+		return nil, fmt.Errorf("fastpfor: truncated exception positions (need %d bytes, got %d)",
+			l.posLen, len(patch)-l.posOff)
+	}
+	codes := patch[l.posOff:l.svbOff()]
+	if l.bitmap {
+		return l.bitmapPositions(codes, scratch)
+	}
+	if !l.runs {
+		return codes, nil
+	}
+	n := 0
+	for i := 0; i < len(codes); i++ {
+		pos, run := int(codes[i]), 1
+		if pos >= patchRunStart {
+			if i++; i == len(codes) {
+				return nil, fmt.Errorf("fastpfor: truncated exception run at code %d", i-1)
+			}
+			pos, run = pos-patchRunStart, int(codes[i])+2
+		}
+		if n+run > l.excCount || pos+run > blockSize {
+			return nil, fmt.Errorf("fastpfor: exception runs exceed %d positions", l.excCount)
+		}
+		for j := range run {
+			scratch[n+j] = byte(pos + j)
+		}
+		n += run
+	}
+	if n != l.excCount {
+		return nil, fmt.Errorf("fastpfor: exception runs hold %d positions, want %d", n, l.excCount)
+	}
+	return scratch[:n], nil
+}
+
+// highBitsAt returns the high bits of exception i from data, which holds the
+// StreamVByte data (or raw high bits) of the exception area.
+func (l patchLayout) highBitsAt(data []byte, i int) uint32 {
+	if l.rawWidth > 0 {
+		return svbReadValue(data[i*l.rawWidth:], l.rawWidth)
+	}
+	return svbDecodeOne(data, l.excCount, i)
+}
+
+// exceptionIndex returns the index of the exception at pos in the exception area
+// patch, which must hold the whole area, and whether pos has an exception. Plain
+// positions are scanned up to pos, run-coded positions are expanded first and
+// bitmap positions are counted.
+func (l patchLayout) exceptionIndex(patch []byte, pos uint32) (int, bool, error) {
+	if l.bitmap {
+		excIndex, ok := bitmapIndex(readPositionBitmap(patch[l.posOff:]), pos)
+		if ok && excIndex >= l.excCount {
+			return 0, false, fmt.Errorf("fastpfor: exception bitmap holds more than %d positions", l.excCount)
+		}
+		return excIndex, ok, nil
+	}
+
+	positions := patch[l.posOff:l.svbOff()]
+	if l.runs {
+		var scratch [blockSize]byte
+		var err error
+		if positions, err = l.positions(patch, &scratch); err != nil {
+			return 0, false, err
+		}
+	}
+	// Positions are sorted ascending
+	for excIndex, p := range positions {
+		if uint32(p) >= pos {
+			return excIndex, uint32(p) == pos, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// positionBitmap returns the exception positions of the exception area patch,
+// which must hold the positions (or run codes or bitmap), as a bitmap (see
+// patchBitmapFlag) for repeated lookups with bitmapIndex.
+func (l patchLayout) positionBitmap(patch []byte) ([2]uint64, error) {
+	var scratch [blockSize]byte
+	positions, err := l.positions(patch, &scratch)
+	if err != nil {
+		return [2]uint64{}, err
+	}
+	var bitmap [2]uint64
+	for _, pos := range positions {
+		bitmap[pos>>6] |= 1 << (pos & 63)
+	}
+	return bitmap, nil
+}
+
+// bitmapIndex returns the index of the exception at pos in the position bitmap,
+// which is the number of positions before pos, and whether pos has an exception.
+func bitmapIndex(bitmap [2]uint64, pos uint32) (int, bool) {
+	w, bit := pos>>6, uint64(1)<<(pos&63)
+	if w >= 2 || bitmap[w]&bit == 0 {
+		return 0, false
+	}
+	excIndex := bits.OnesCount64(bitmap[w] & (bit - 1))
+	if w == 1 {
+		excIndex += bits.OnesCount64(bitmap[0])
+	}
+	return excIndex, true
+}
+
+// decodeHighBits decodes the high bits of all exceptions from data, which holds
+// the StreamVByte data (or raw high bits) of the exception area, into dst.
+func (l patchLayout) decodeHighBits(data []byte, dst []uint32) []uint32 {
+	if l.rawWidth > 0 {
+		dst = dst[:l.excCount]
+		for i := range dst {
+			dst[i] = svbReadValue(data[i*l.rawWidth:], l.rawWidth)
+		}
+		return dst
+	}
+	return streamvbyte.DecodeUint32(data, l.excCount, &streamvbyte.DecodeOptions[uint32]{
+		Buffer: dst[:l.excCount],
+	})
+}
+
+// highBitsCursor reads the high bits of the exceptions in order, from StreamVByte
+// data or raw high bits.
+type highBitsCursor struct {
+	svb      svbCursor
+	raw      []byte
+	rawWidth int
+	index    int
+}
+
+// highBitsCursor returns a cursor positioned at exception 0 of data, which holds
+// the StreamVByte data (or raw high bits) of the exception area.
+func (l patchLayout) highBitsCursor(data []byte) highBitsCursor {
+	if l.rawWidth > 0 {
+		return highBitsCursor{raw: data, rawWidth: l.rawWidth}
+	}
+	return highBitsCursor{svb: svbNewCursor(data, l.excCount)}
+}
+
+// seekTo positions the cursor at exception index.
+func (c *highBitsCursor) seekTo(index int) {
+	if c.rawWidth > 0 {
+		c.index = index
+		return
+	}
+	c.svb.svbSeekTo(index)
+}
+
+// readCurrent returns the high bits of the current exception.
+func (c *highBitsCursor) readCurrent() uint32 {
+	if c.rawWidth > 0 {
+		return svbReadValue(c.raw[c.index*c.rawWidth:], c.rawWidth)
+	}
+	return c.svb.svbReadCurrent()
+}
+
+// advance moves the cursor to the next exception.
+func (c *highBitsCursor) advance() {
+	if c.rawWidth > 0 {
+		c.index++
+		return
+	}
+	c.svb.svbAdvance()
+}
+
+// putRawHighBits writes the high bits as fixed-width integers of width bytes to
+// dst, which must hold width*len(highBits) bytes.
+func putRawHighBits(dst []byte, highBits []uint32, width int) {
+	for i, h := range highBits {
+		for b := range width {
+			dst[i*width+b] = byte(h >> (8 * b))
+		}
+	}
+}
+
+// bitmapPositions expands the position bitmap into scratch.
+func (l patchLayout) bitmapPositions(bitmap []byte, scratch *[blockSize]byte) ([]byte, error) {
+	mask := readPositionBitmap(bitmap)
+	if n := bits.OnesCount64(mask[0]) + bits.OnesCount64(mask[1]); n != l.excCount {
+		return nil, fmt.Errorf("fastpfor: exception bitmap holds %d positions, want %d", n, l.excCount)
+	}
+	n := 0
+	for w, word := range mask {
+		for ; word != 0; word &= word - 1 {
+			scratch[n] = byte(w<<6 + bits.TrailingZeros64(word))
+			n++
+		}
+	}
+	return scratch[:n], nil
+}
+
+// readPositionBitmap returns the words of the position bitmap at the start of bitmap.
+func readPositionBitmap(bitmap []byte) [2]uint64 {
+	return [2]uint64{bo.Uint64(bitmap), bo.Uint64(bitmap[8:])}
+}
+
+// bitmapWithin reports whether all positions of the bitmap are below count.
+func bitmapWithin(bitmap [2]uint64, count int) bool {
+	for w, word := range bitmap {
+		if n := count - w<<6; n < 64 && word>>max(n, 0) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// encodeRuns writes the run codes of the ascending positions to dst and returns
+// their length. dst must hold len(positions) bytes, which the run codes never exceed.
+func encodeRuns(dst, positions []byte) int {
+	n := 0
+	for i := 0; i < len(positions); {
+		j := i + 1
+		for j < len(positions) && positions[j] == positions[j-1]+1 {
+			j++
+		}
+		if j-i == 1 {
+			dst[n] = positions[i]
+			n++
+		} else {
+			dst[n] = patchRunStart + positions[i]
+			dst[n+1] = byte(j - i - 2)
+			n += 2
+		}
+		i = j
+	}
+	return n
+}
@@ -0,0 +1,340 @@
+package fastpfor
+
+import (
+	"math/bits"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// genBurstyExceptions returns small values with bursts of large outliers at
+// consecutive positions.
+func genBurstyExceptions() []uint32 {
+	values := make([]uint32, blockSize)
+	for i := range values {
+		values[i] = uint32(i % 8)
+		if (i >= 10 && i < 30) || (i >= 70 && i < 75) || i == 100 {
+			values[i] = 1<<24 | uint32(i)
+		}
+	}
+	return values
+}
+
+// TestExceptionRuns verifies that clustered exception positions are run-coded
+// and decoded by all decoders.
+func TestExceptionRuns(t *testing.T) {
+	assert := assert.New(t)
+
+	values := genBurstyExceptions()
+	buf := PackUint32(nil, values)
+
+	e, err := Explain(buf)
+	assert.NoError(err)
+	assert.True(e.PositionRuns)
+	assert.Len(e.Exceptions, 26)
+	// Two runs (2 bytes each) and one single position, plus the length byte
+	assert.Equal(6, e.SVBOffset-e.PatchOffset-patchMetaBytes)
+	assert.Contains(e.String(), "position runs")
+
+	got, err := UnpackUint32(nil, buf)
+	assert.NoError(err)
+	assert.Equal(values, got)
+	got, err = UnpackFirstN(nil, buf, 72)
+	assert.NoError(err)
+	assert.Equal(values[:72], got)
+
+	slim := NewSlimReader()
+	assert.NoError(slim.Load(buf))
+	// The run codes are decoded once on Load
+	assert.Equal(26, bits.OnesCount64(slim.excBitmap[0])+bits.OnesCount64(slim.excBitmap[1]))
+	for i, want := range values {
+		v, err := slim.Get(i)
+		assert.NoError(err)
+		assert.Equal(want, v, "Get(%d)", i)
+		v, err = GetAt(buf, i)
+		assert.NoError(err)
+		assert.Equal(want, v, "GetAt(%d)", i)
+	}
+	part, err := slim.DecodeRange(nil, 20, 90)
+	assert.NoError(err)
+	assert.Equal(values[20:90], part)
+	assert.NoError(VerifyBlock(buf))
+
+	header, payload, patch, err := SplitEncoded(buf)
+	assert.NoError(err)
+	assembled, err := AssembleEncoded(header, payload, patch)
+	assert.NoError(err)
+	assert.Equal(buf, assembled)
+
+	t.Run("scattered", func(t *testing.T) {
+		// Isolated exceptions keep one byte per position
+		e, err := Explain(PackUint32(nil, genDataWithLargeExceptions()))
+		assert.NoError(err)
+		assert.False(e.PositionRuns)
+	})
+
+	t.Run("delta", func(t *testing.T) {
+		sorted := make([]uint32, blockSize)
+		var v uint32
+		for i := range sorted {
+			v += uint32(i % 3)
+			if i >= 40 && i < 60 {
+				v += 1 << 20
+			}
+			sorted[i] = v
+		}
+		buf := PackDeltaUint32(nil, append([]uint32(nil), sorted...))
+		e, err := Explain(buf)
+		assert.NoError(err)
+		assert.True(e.PositionRuns)
+		got, err := UnpackUint32(nil, buf)
+		assert.NoError(err)
+		assert.Equal(sorted, got)
+	})
+}
+
+// TestExceptionRunsMalformed verifies that inconsistent run codes are rejected.
+func TestExceptionRunsMalformed(t *testing.T) {
+	assert := assert.New(t)
+
+	buf := PackUint32(nil, genBurstyExceptions())
+	header, payload, patch, err := SplitEncoded(buf)
+	assert.NoError(err)
+	runs := patch[patchMetaBytes+1 : patchMetaBytes+1+int(patch[patchMetaBytes])]
+	assert.Equal([]byte{patchRunStart + 10, 18, patchRunStart + 70, 3, 100}, runs)
+
+	for name, mutate := range map[string]func(p []byte){
+		"tooFewPositions":  func(p []byte) { p[patchMetaBytes+2] = 17 },
+		"tooManyPositions": func(p []byte) { p[patchMetaBytes+4] = 4 },
+		"beyondBlock":      func(p []byte) { p[patchMetaBytes+3] = patchRunStart + 127 },
+		"truncatedRun":     func(p []byte) { p[patchMetaBytes+5] = patchRunStart + 100 },
+		"descending":       func(p []byte) { p[patchMetaBytes+5] = 5 },
+	} {
+		t.Run(name, func(t *testing.T) {
+			corrupt := append([]byte(nil), patch...)
+			mutate(corrupt)
+			_, err := AssembleEncoded(header, payload, corrupt)
+			assert.ErrorIs(err, ErrInvalidBuffer)
+
+			block := append(append([]byte(nil), buf[:len(buf)-len(patch)]...), corrupt...)
+			assert.ErrorIs(NewSlimReader().Load(block), ErrInvalidBuffer)
+			if name != "descending" {
+				_, err = UnpackUint32(nil, block)
+				assert.ErrorIs(err, ErrInvalidBuffer)
+			}
+		})
+	}
+}
+
+// TestEncodeRuns verifies the run codes of ascending positions.
+func TestEncodeRuns(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, tc := range []struct {
+		positions []byte
+		want      []byte
+	}{
+		{nil, []byte{}},
+		{[]byte{5}, []byte{5}},
+		{[]byte{5, 6}, []byte{patchRunStart + 5, 0}},
+		{[]byte{1, 3, 4, 5, 127}, []byte{1, patchRunStart + 3, 1, 127}},
+	} {
+		dst := make([]byte, len(tc.positions))
+		n := encodeRuns(dst, tc.positions)
+		assert.Equal(tc.want, dst[:n], "%v", tc.positions)
+
+		l := patchLayout{excCount: len(tc.positions), runs: true, posLen: n}
+		var scratch [blockSize]byte
+		got, err := l.positions(dst[:n], &scratch)
+		assert.NoError(err)
+		assert.Equal(tc.positions, nilIfEmptyBytes(got))
+	}
+
+	all := make([]byte, blockSize)
+	for i := range all {
+		all[i] = byte(i)
+	}
+	dst := make([]byte, blockSize)
+	assert.Equal([]byte{patchRunStart, blockSize - 2}, dst[:encodeRuns(dst, all)])
+}
+
+// genScatteredExceptions returns n small values with a large outlier at every
+// third position.
+func genScatteredExceptions(n int) []uint32 {
+	values := make([]uint32, n)
+	for i := range values {
+		values[i] = uint32(i % 8)
+		if i%3 == 1 {
+			values[i] = 1<<24 | uint32(i)
+		}
+	}
+	return values
+}
+
+// TestExceptionBitmap verifies that many scattered exception positions are
+// stored as a bitmap and decoded by all decoders.
+func TestExceptionBitmap(t *testing.T) {
+	for _, n := range []int{blockSize, 100} {
+		assert := assert.New(t)
+
+		values := genScatteredExceptions(n)
+		buf := PackUint32(nil, values)
+
+		e, err := Explain(buf)
+		assert.NoError(err)
+		assert.True(e.PositionBitmap)
+		assert.False(e.PositionRuns)
+		assert.Len(e.Exceptions, (n+1)/3)
+		assert.Equal(patchBitmapBytes, e.SVBOffset-e.PositionsOffset)
+		assert.Contains(e.String(), "position bitmap")
+
+		got, err := UnpackUint32(nil, buf)
+		assert.NoError(err)
+		assert.Equal(values, got)
+		got, err = UnpackFirstN(nil, buf, 72)
+		assert.NoError(err)
+		assert.Equal(values[:72], got)
+
+		r := NewReader()
+		assert.NoError(r.LoadLazy(buf))
+		slim := NewSlimReader()
+		assert.NoError(slim.Load(buf))
+		for i, want := range values {
+			v, err := slim.Get(i)
+			assert.NoError(err)
+			assert.Equal(want, v, "SlimReader.Get(%d)", i)
+			v, err = GetAt(buf, i)
+			assert.NoError(err)
+			assert.Equal(want, v, "GetAt(%d)", i)
+			v, err = r.Get(i)
+			assert.NoError(err)
+			assert.Equal(want, v, "Reader.Get(%d)", i)
+		}
+		part, err := slim.DecodeRange(nil, 20, 90)
+		assert.NoError(err)
+		assert.Equal(values[20:90], part)
+		assert.NoError(VerifyBlock(buf))
+
+		header, payload, patch, err := SplitEncoded(buf)
+		assert.NoError(err)
+		assembled, err := AssembleEncoded(header, payload, patch)
+		assert.NoError(err)
+		assert.Equal(buf, assembled)
+	}
+}
+
+// TestExceptionBitmapMalformed verifies that inconsistent bitmaps are rejected.
+func TestExceptionBitmapMalformed(t *testing.T) {
+	assert := assert.New(t)
+
+	buf := PackUint32(nil, genScatteredExceptions(100))
+	header, payload, patch, err := SplitEncoded(buf)
+	assert.NoError(err)
+
+	for name, mutate := range map[string]func(p []byte){
+		"tooFewPositions":  func(p []byte) { p[patchMetaBytes] &^= 1 << 1 },
+		"tooManyPositions": func(p []byte) { p[patchMetaBytes] |= 1 },
+		"beyondCount": func(p []byte) {
+			p[patchMetaBytes] &^= 1 << 1
+			p[patchMetaBytes+15] |= 1 << 7
+		},
+		"runsAndBitmap": func(p []byte) { p[2] |= patchRunsFlag >> 8 },
+	} {
+		t.Run(name, func(t *testing.T) {
+			corrupt := append([]byte(nil), patch...)
+			mutate(corrupt)
+			_, err := AssembleEncoded(header, payload, corrupt)
+			assert.ErrorIs(err, ErrInvalidBuffer)
+
+			block := append(append([]byte(nil), buf[:len(buf)-len(patch)]...), corrupt...)
+			_, err = UnpackUint32(nil, block)
+			assert.ErrorIs(err, ErrInvalidBuffer)
+			assert.ErrorIs(NewSlimReader().Load(block), ErrInvalidBuffer)
+		})
+	}
+}
+
+// TestRawHighBits verifies that high bits of the same byte length are stored
+// fixed-width and decoded by all decoders.
+func TestRawHighBits(t *testing.T) {
+	assert := assert.New(t)
+
+	values := genDataWithLargeExceptions()
+	buf := PackUint32(nil, values)
+	e, err := Explain(buf)
+	assert.NoError(err)
+	assert.True(e.RawHighBits)
+	assert.Equal(4*len(e.Exceptions), e.SVBBytes)
+	assert.Equal(4, e.Exceptions[0].SVBBytes)
+	assert.Contains(e.String(), "raw [")
+
+	got, err := UnpackUint32(nil, buf)
+	assert.NoError(err)
+	assert.Equal(values, got)
+	got, err = UnpackFirstN(nil, buf, 50)
+	assert.NoError(err)
+	assert.Equal(values[:50], got)
+
+	r := NewReader()
+	assert.NoError(r.LoadLazy(buf))
+	slim := NewSlimReader()
+	assert.NoError(slim.Load(buf))
+	for i, want := range values {
+		v, err := slim.Get(i)
+		assert.NoError(err)
+		assert.Equal(want, v, "SlimReader.Get(%d)", i)
+		v, err = r.Get(i)
+		assert.NoError(err)
+		assert.Equal(want, v, "Reader.Get(%d)", i)
+	}
+	part, err := slim.DecodeRange(nil, 7, 100)
+	assert.NoError(err)
+	assert.Equal(values[7:100], part)
+	assert.NoError(VerifyBlock(buf))
+
+	header, payload, patch, err := SplitEncoded(buf)
+	assert.NoError(err)
+	_, err = AssembleEncoded(header, payload, patch)
+	assert.NoError(err)
+
+	// A raw length that is no multiple of the exception count is rejected
+	corrupt := append([]byte(nil), patch...)
+	corrupt[1]--
+	_, err = AssembleEncoded(header, payload, corrupt)
+	assert.ErrorIs(err, ErrInvalidBuffer)
+
+	// High bits of different byte lengths keep StreamVByte
+	for i := 0; i < 20; i += 2 {
+		values[i*6] = 1<<10 + uint32(i)
+	}
+	e, err = Explain(PackUint32(nil, values))
+	assert.NoError(err)
+	assert.False(e.RawHighBits)
+}
+
+func nilIfEmptyBytes(b []byte) []byte {
+	if len(b) == 0 {
+		return nil
+	}
+	return b
+}
+
+func BenchmarkUnpackExceptionRuns(b *testing.B) {
+	buf := PackUint32(nil, genBurstyExceptions())
+	dst := make([]uint32, 0, blockSize)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(buf)))
+	for range b.N {
+		dst, _ = UnpackUint32(dst[:0], buf)
+	}
+}
+
+func BenchmarkUnpackExceptionBitmap(b *testing.B) {
+	buf := PackUint32(nil, genScatteredExceptions(blockSize))
+	dst := make([]uint32, 0, blockSize)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(buf)))
+	for range b.N {
+		dst, _ = UnpackUint32(dst[:0], buf)
+	}
+}
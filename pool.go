@@ -0,0 +1,81 @@
+package fastpfor
+
+import "sync"
+
+// Package-level pools of readers and coders. Services that load many blocks per
+// second can take a value from a pool per request and return it afterwards, so
+// their decode buffers and scratch blocks are reused instead of collected.
+var (
+	readerPool     = sync.Pool{New: func() any { return new(Reader) }}
+	slimReaderPool = sync.Pool{New: func() any { return new(SlimReader) }}
+	// encoderPool also backs PackDeltaUint32, because the kernel function
+	// variables let a stack allocated scratch block escape: that costs an
+	// allocation per block, a pooled Encoder does not (see
+	// BenchmarkEncoderPackDelta).
+	encoderPool = sync.Pool{New: func() any { return new(Encoder) }}
+	decoderPool = sync.Pool{New: func() any { return new(Decoder) }}
+)
+
+// GetReader returns an unloaded Reader from the package pool. Its decode buffer
+// may be reused from an earlier block. Return it with PutReader once done:
+//
+//	r := fastpfor.GetReader()
+//	defer fastpfor.PutReader(r)
+//	if err := r.Load(buf); err != nil {
+//		return err
+//	}
+func GetReader() *Reader {
+	return readerPool.Get().(*Reader)
+}
+
+// PutReader unloads r and returns it to the package pool. The reader keeps its
+// decode buffer but drops references to loaded blocks and borrowed values.
+// Neither r nor values obtained from it (e.g. by Decode) may be used afterwards.
+func PutReader(r *Reader) {
+	values := r.values
+	if r.borrowed {
+		values = nil
+	}
+	*r = Reader{values: values[:0]}
+	readerPool.Put(r)
+}
+
+// GetSlimReader returns an unloaded SlimReader from the package pool. Return it
+// with PutSlimReader once done.
+func GetSlimReader() *SlimReader {
+	return slimReaderPool.Get().(*SlimReader)
+}
+
+// PutSlimReader unloads r and returns it to the package pool. r must not be
+// used afterwards.
+func PutSlimReader(r *SlimReader) {
+	*r = SlimReader{}
+	slimReaderPool.Put(r)
+}
+
+// GetEncoder returns an Encoder from the package pool. Return it with
+// PutEncoder once done.
+func GetEncoder() *Encoder {
+	return encoderPool.Get().(*Encoder)
+}
+
+// PutEncoder returns e to the package pool. e must not be used afterwards.
+// Its Options and learned state are reset, since the package functions encode
+// with pooled Encoders.
+func PutEncoder(e *Encoder) {
+	e.Options = EncodeOptions{}
+	e.Reset()
+	encoderPool.Put(e)
+}
+
+// GetDecoder returns a Decoder from the package pool. Return it with
+// PutDecoder once done.
+func GetDecoder() *Decoder {
+	return decoderPool.Get().(*Decoder)
+}
+
+// PutDecoder returns d to the package pool. Neither d nor values decoded into
+// its output block may be used afterwards.
+func PutDecoder(d *Decoder) {
+	decoderPool.Put(d)
+}
@@ -0,0 +1,65 @@
+package fastpfor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPools(t *testing.T) {
+	assert := assert.New(t)
+	values := genDataWithLargeExceptions()
+	buf := PackUint32(nil, values)
+
+	for range 3 {
+		r := GetReader()
+		assert.False(r.IsLoaded())
+		assert.NoError(r.Load(buf))
+		assert.Equal(values, r.Decode(nil))
+		PutReader(r)
+
+		s := GetSlimReader()
+		assert.False(s.IsLoaded())
+		assert.NoError(s.Load(buf))
+		assert.Equal(values, s.Decode(nil))
+		PutSlimReader(s)
+
+		e := GetEncoder()
+		assert.Equal(buf, e.Pack(nil, values))
+		PutEncoder(e)
+
+		d := GetDecoder()
+		got, err := d.Unpack(nil, buf)
+		assert.NoError(err)
+		assert.Equal(values, got)
+		PutDecoder(d)
+	}
+
+	t.Run("encoderOptionsReset", func(t *testing.T) {
+		e := GetEncoder()
+		e.Options.NoExceptions = true
+		PutEncoder(e)
+		assert.Zero(e.Options)
+	})
+
+	t.Run("borrowedValuesDropped", func(t *testing.T) {
+		r := NewReaderFromValues(values, false)
+		PutReader(r)
+		assert.Nil(r.values)
+		assert.Nil(r.buf)
+		assert.False(r.IsLoaded())
+	})
+}
+
+func BenchmarkPooledReader(b *testing.B) {
+	buf := PackUint32(nil, genDataWithLargeExceptions())
+	b.ReportAllocs()
+	for range b.N {
+		r := GetReader()
+		if err := r.Load(buf); err != nil {
+			b.Fatal(err)
+		}
+		resultU32 = r.values
+		PutReader(r)
+	}
+}
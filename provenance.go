@@ -0,0 +1,153 @@
+package fastpfor
+
+import (
+	"fmt"
+	"time"
+)
+
+// Provenance records where a block came from, for lineage tracking in pipelines
+// that replicate compressed blocks between systems. It is stored in an optional
+// 20-byte record between the header (and its extension) and the payload:
+// WriterID (4 bytes), CreateTime as Unix nanoseconds (8 bytes) and SourceOffset
+// (8 bytes), all little-endian. Decoders skip the record transparently.
+type Provenance struct {
+	WriterID     uint32    // identifier of the writing process or system
+	CreateTime   time.Time // creation time (nanosecond precision, zero if unknown)
+	SourceOffset uint64    // offset of the first value in the source stream
+}
+
+// BlockInfo describes an encoded block as read from its header, without
+// decoding the payload.
+type BlockInfo struct {
+	Version      int  // format version (see FormatVersion)
+	Count        int  // number of values
+	BitWidth     int  // bit width of the packed payload
+	IntType      int  // integer type marker (IntTypeUint16, IntTypeUint32, ...)
+	Delta        bool // values are delta-encoded
+	ZigZag       bool // deltas are zigzag-encoded
+	Exceptions   bool // an exception area follows the payload
+	WillOverflow bool // delta decoding overflows uint32
+	Signed       bool // values are zigzag-encoded int32 (see PackInt32)
+	Float        bool // values are XOR-encoded float32 (see PackFloat32)
+	Float64      bool // values are float64 halves (see PackFloat64)
+	Length       int  // total encoded length in bytes (see BlockLength)
+
+	// Range is the range record of the block, or nil if it has none.
+	Range *ValueRange
+
+	// Checksum is the checksum record of the block, or nil if it has none.
+	Checksum *uint32
+
+	// Provenance is the provenance record of the block, or nil if it has none.
+	Provenance *Provenance
+}
+
+// ReadBlockInfo returns the header information and the range, checksum and provenance
+// records of the block at the start of buf.
+func ReadBlockInfo(buf []byte) (BlockInfo, error) {
+	header, count, payloadStart, err := readHeader(buf)
+	if err != nil {
+		return BlockInfo{}, err
+	}
+	length, err := BlockLength(buf)
+	if err != nil {
+		return BlockInfo{}, err
+	}
+	_, bitWidth, intType, hasExceptions, hasDelta, hasZigZag, willOverflow := decodeHeader(header)
+	info := BlockInfo{
+		Version:      headerVersion(header),
+		Count:        count,
+		BitWidth:     bitWidth,
+		IntType:      intType,
+		Delta:        hasDelta,
+		ZigZag:       hasZigZag,
+		Exceptions:   hasExceptions,
+		WillOverflow: willOverflow,
+		Signed:       header&headerSignedFlag != 0,
+		Float:        header&headerFloatFlag != 0,
+		Float64:      header&headerFloat64Flag != 0,
+		Length:       length,
+	}
+	if header&headerRangeFlag != 0 {
+		r := decodeRange(buf[rangeStart(header, payloadStart):])
+		info.Range = &r
+	}
+	if header&headerChecksumFlag != 0 {
+		c := bo.Uint32(buf[checksumStart(header, payloadStart):])
+		info.Checksum = &c
+	}
+	if header&headerProvenanceFlag != 0 {
+		p := decodeProvenance(buf[payloadStart-headerProvenanceBytes : payloadStart])
+		info.Provenance = &p
+	}
+	return info, nil
+}
+
+// SetProvenance appends a copy of the block at the start of buf to dst, with its
+// provenance record set to p (an existing record is replaced). The block can be
+// produced by any Pack function.
+func SetProvenance(dst, buf []byte, p Provenance) ([]byte, error) {
+	return rewriteProvenance(dst, buf, &p)
+}
+
+// StripProvenance appends a copy of the block at the start of buf to dst without
+// its provenance record. Blocks without a record are copied as-is.
+func StripProvenance(dst, buf []byte) ([]byte, error) {
+	return rewriteProvenance(dst, buf, nil)
+}
+
+// rewriteProvenance copies the block in buf to dst, replacing its provenance
+// record with p, or removing it if p is nil.
+func rewriteProvenance(dst, buf []byte, p *Provenance) ([]byte, error) {
+	header, _, payloadStart, err := readHeader(buf)
+	if err != nil {
+		return dst, err
+	}
+	length, err := BlockLength(buf)
+	if err != nil {
+		return dst, err
+	}
+	if len(buf) < length {
+		return dst, fmt.Errorf("%w: buffer truncated (need %d bytes, got %d)",
+			ErrInvalidBuffer, length, len(buf))
+	}
+
+	extEnd := payloadStart
+	if header&headerProvenanceFlag != 0 {
+		extEnd -= headerProvenanceBytes
+	}
+	header &^= headerProvenanceFlag
+	if p != nil {
+		header |= headerProvenanceFlag
+	}
+
+	dst = bo.AppendUint32(dst, header)
+	dst = append(dst, buf[headerBytes:extEnd]...)
+	if p != nil {
+		dst = appendProvenance(dst, *p)
+	}
+	return append(dst, buf[payloadStart:length]...), nil
+}
+
+// appendProvenance appends the 20-byte provenance record of p to dst.
+func appendProvenance(dst []byte, p Provenance) []byte {
+	var nanos int64
+	if !p.CreateTime.IsZero() {
+		nanos = p.CreateTime.UnixNano()
+	}
+	dst = bo.AppendUint32(dst, p.WriterID)
+	dst = bo.AppendUint64(dst, uint64(nanos))
+	return bo.AppendUint64(dst, p.SourceOffset)
+}
+
+// decodeProvenance decodes a 20-byte provenance record.
+func decodeProvenance(record []byte) Provenance {
+	p := Provenance{
+		WriterID:     bo.Uint32(record[0:4]),
+		SourceOffset: bo.Uint64(record[12:20]),
+	}
+	if nanos := int64(bo.Uint64(record[4:12])); nanos != 0 {
+		p.CreateTime = time.Unix(0, nanos)
+	}
+	return p
+}
@@ -0,0 +1,123 @@
+package fastpfor
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestProvenance verifies that provenance records round-trip and are skipped by decoders.
+func TestProvenance(t *testing.T) {
+	assert := assert.New(t)
+
+	p := Provenance{
+		WriterID:     42,
+		CreateTime:   time.Date(2024, 5, 17, 12, 30, 0, 123456789, time.UTC),
+		SourceOffset: 1 << 40,
+	}
+
+	for name, tc := range map[string]struct {
+		values []uint32
+		buf    []byte
+	}{
+		"plain":      {genSequential(blockSize), PackUint32(nil, genSequential(blockSize))},
+		"exceptions": {genDataWithLargeExceptions(), PackUint32(nil, genDataWithLargeExceptions())},
+		"delta":      {genMixed(blockSize), PackDeltaUint32(nil, genMixed(blockSize))},
+		"empty":      {nil, PackUint32(nil, nil)},
+		"wide": {genDataWithSmallExceptions(),
+			packInternal(nil, genDataWithSmallExceptions(), headerTypeUint32Flag|headerWideFlag)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			buf, err := SetProvenance(nil, tc.buf, p)
+			assert.NoError(err)
+			assert.Len(buf, len(tc.buf)+headerProvenanceBytes)
+
+			info, err := ReadBlockInfo(buf)
+			assert.NoError(err)
+			assert.Equal(len(tc.values), info.Count)
+			assert.Equal(len(buf), info.Length)
+			if assert.NotNil(info.Provenance) {
+				assert.Equal(p.WriterID, info.Provenance.WriterID)
+				assert.True(p.CreateTime.Equal(info.Provenance.CreateTime))
+				assert.Equal(p.SourceOffset, info.Provenance.SourceOffset)
+			}
+
+			// All decoders skip the record
+			got, err := UnpackUint32(nil, buf)
+			assert.NoError(err)
+			assert.Equal(len(tc.values), len(got))
+			if len(tc.values) > 0 {
+				assert.Equal(tc.values, got)
+			}
+			slim := NewSlimReader()
+			assert.NoError(slim.Load(buf))
+			assert.Equal(tc.values, nilIfEmpty(slim.Decode(nil)))
+			assert.NoError(VerifyBlock(buf))
+
+			// Replacing keeps a single record, stripping restores the original
+			again, err := SetProvenance(nil, buf, Provenance{WriterID: 7})
+			assert.NoError(err)
+			assert.Len(again, len(buf))
+			info, err = ReadBlockInfo(again)
+			assert.NoError(err)
+			assert.Equal(uint32(7), info.Provenance.WriterID)
+			assert.True(info.Provenance.CreateTime.IsZero())
+
+			stripped, err := StripProvenance(nil, again)
+			assert.NoError(err)
+			assert.Equal(tc.buf, stripped)
+		})
+	}
+
+	t.Run("noProvenance", func(t *testing.T) {
+		buf := PackUint32(nil, []uint32{1, 2, 3})
+		info, err := ReadBlockInfo(buf)
+		assert.NoError(err)
+		assert.Nil(info.Provenance)
+		assert.Equal(3, info.Count)
+		assert.Equal(IntTypeUint32, info.IntType)
+
+		stripped, err := StripProvenance(nil, buf)
+		assert.NoError(err)
+		assert.Equal(buf, stripped)
+	})
+
+	t.Run("appendsToDst", func(t *testing.T) {
+		first := PackUint32(nil, []uint32{1, 2, 3})
+		stream, err := SetProvenance(slices.Clone(first), first, p)
+		assert.NoError(err)
+		assert.Equal(first, stream[:len(first)])
+		n, err := BlockLength(stream[len(first):])
+		assert.NoError(err)
+		assert.Equal(len(stream)-len(first), n)
+	})
+
+	t.Run("splitAssembleDropsRecord", func(t *testing.T) {
+		orig := PackUint32(nil, genDataWithLargeExceptions())
+		buf, err := SetProvenance(nil, orig, p)
+		assert.NoError(err)
+		header, payload, patch, err := SplitEncoded(buf)
+		assert.NoError(err)
+		assembled, err := AssembleEncoded(header, payload, patch)
+		assert.NoError(err)
+		assert.Equal(orig, assembled)
+	})
+
+	t.Run("truncatedRecord", func(t *testing.T) {
+		buf, err := SetProvenance(nil, PackUint32(nil, nil), p)
+		assert.NoError(err)
+		_, err = ReadBlockInfo(buf[:len(buf)-1])
+		assert.ErrorIs(err, ErrInvalidBuffer)
+		_, err = UnpackUint32(nil, buf[:len(buf)-1])
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+}
+
+func nilIfEmpty(values []uint32) []uint32 {
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
@@ -0,0 +1,182 @@
+package fastpfor
+
+import "slices"
+
+// Pushdown is implemented by readers that can answer simple aggregates over a
+// block, so query engines can delegate them without knowing the codec internals.
+// Sorted blocks (delta-encoded without zigzag or overflow) are answered from the
+// first and last value or with binary search, other blocks with a single scan.
+//
+// The aggregates do not change the iteration position of the reader.
+type Pushdown interface {
+	// Min returns the smallest value, or (0, false) if the block is empty, not
+	// loaded or cannot be decoded.
+	Min() (uint32, bool)
+	// Max returns the largest value, or (0, false) if the block is empty, not
+	// loaded or cannot be decoded.
+	Max() (uint32, bool)
+	// Sum returns the sum of all values, or (0, false) if the block is not loaded
+	// or cannot be decoded.
+	Sum() (uint64, bool)
+	// CountRange returns the number of values v with lo <= v <= hi, or (0, false)
+	// if the block is not loaded or cannot be decoded.
+	CountRange(lo, hi uint32) (int, bool)
+}
+
+var (
+	_ Pushdown = (*Reader)(nil)
+	_ Pushdown = (*SlimReader)(nil)
+)
+
+// Min returns the smallest value in the block.
+func (r *Reader) Min() (uint32, bool) {
+	if !r.loaded || r.count == 0 {
+		return 0, false
+	}
+	r.ensureDecoded()
+	if r.pushdownSorted() {
+		return r.values[0], true
+	}
+	return slices.Min(r.values[:r.count]), true
+}
+
+// Max returns the largest value in the block.
+func (r *Reader) Max() (uint32, bool) {
+	if !r.loaded || r.count == 0 {
+		return 0, false
+	}
+	r.ensureDecoded()
+	if r.pushdownSorted() {
+		return r.values[r.count-1], true
+	}
+	return slices.Max(r.values[:r.count]), true
+}
+
+// Sum returns the sum of all values in the block.
+func (r *Reader) Sum() (uint64, bool) {
+	if !r.loaded {
+		return 0, false
+	}
+	r.ensureDecoded()
+	return sumValues(r.values[:r.count]), true
+}
+
+// CountRange returns the number of values v in the block with lo <= v <= hi.
+func (r *Reader) CountRange(lo, hi uint32) (int, bool) {
+	if !r.loaded {
+		return 0, false
+	}
+	r.ensureDecoded()
+	if r.pushdownSorted() {
+		return countRangeSorted(r.values[:r.count], lo, hi), true
+	}
+	return countRangeScan(r.values[:r.count], lo, hi), true
+}
+
+// pushdownSorted reports whether the decoded values are known to be non-decreasing.
+// Overflowing delta blocks wrap around and are therefore not sorted.
+func (r *Reader) pushdownSorted() bool {
+	return r.isSorted && r.overflowPos == 0
+}
+
+// Min returns the smallest value in the block. For sorted blocks only the first
+// value is decoded.
+func (r *SlimReader) Min() (uint32, bool) {
+	if r.flags&slimFlagLoaded == 0 || r.count == 0 {
+		return 0, false
+	}
+	if r.pushdownSorted() {
+		return r.getSingle(0), true
+	}
+	var values [2 * blockSize]uint32
+	decoded := r.decodeAll(values[:0])
+	return slices.Min(decoded), true
+}
+
+// Max returns the largest value in the block.
+func (r *SlimReader) Max() (uint32, bool) {
+	if r.flags&slimFlagLoaded == 0 || r.count == 0 {
+		return 0, false
+	}
+	var values [2 * blockSize]uint32
+	decoded := r.decodeAll(values[:0])
+	if r.pushdownSorted() {
+		return decoded[len(decoded)-1], true
+	}
+	return slices.Max(decoded), true
+}
+
+// Sum returns the sum of all values in the block.
+func (r *SlimReader) Sum() (uint64, bool) {
+	if r.flags&slimFlagLoaded == 0 {
+		return 0, false
+	}
+	var values [2 * blockSize]uint32
+	decoded := r.decodeAll(values[:0])
+	return sumValues(decoded), true
+}
+
+// CountRange returns the number of values v in the block with lo <= v <= hi.
+func (r *SlimReader) CountRange(lo, hi uint32) (int, bool) {
+	if r.flags&slimFlagLoaded == 0 {
+		return 0, false
+	}
+	var values [2 * blockSize]uint32
+	decoded := r.decodeAll(values[:0])
+	if r.pushdownSorted() {
+		return countRangeSorted(decoded, lo, hi), true
+	}
+	return countRangeScan(decoded, lo, hi), true
+}
+
+// pushdownSorted reports whether the block is known to be non-decreasing.
+func (r *SlimReader) pushdownSorted() bool {
+	return r.IsSorted() && r.flags&slimFlagWillOverflow == 0
+}
+
+// decodeAll decodes all values into dst like Decode, without touching the reader
+// state. The wrapped values of overflowing blocks are returned as decoded.
+func (r *SlimReader) decodeAll(dst []uint32) []uint32 {
+	overflowPos := r.overflowPos
+	dst = r.Decode(dst)
+	r.overflowPos = overflowPos
+	return dst
+}
+
+// sumValues returns the sum of values without wrapping.
+func sumValues(values []uint32) uint64 {
+	var sum uint64
+	for _, v := range values {
+		sum += uint64(v)
+	}
+	return sum
+}
+
+// countRangeSorted counts the values in [lo, hi] of non-decreasing values.
+func countRangeSorted(values []uint32, lo, hi uint32) int {
+	if lo > hi {
+		return 0
+	}
+	start, _ := slices.BinarySearch(values, lo)
+	if hi == ^uint32(0) {
+		return len(values) - start
+	}
+	end, _ := slices.BinarySearch(values[start:], hi+1)
+	return end
+}
+
+// countRangeScan counts the values in [lo, hi] of arbitrary values.
+// The subtraction maps the range to [0, hi-lo], so a single comparison suffices.
+func countRangeScan(values []uint32, lo, hi uint32) int {
+	if lo > hi {
+		return 0
+	}
+	span := hi - lo
+	n := 0
+	for _, v := range values {
+		if v-lo <= span {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,174 @@
+package fastpfor
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pushdownReaders returns a Reader and a SlimReader loaded with buf.
+func pushdownReaders(t *testing.T, buf []byte) map[string]Pushdown {
+	t.Helper()
+	reader := NewReader()
+	assert.NoError(t, reader.Load(buf))
+	slim := NewSlimReader()
+	assert.NoError(t, slim.Load(buf))
+	return map[string]Pushdown{"Reader": reader, "SlimReader": slim}
+}
+
+// TestPushdown compares the aggregates against the decoded values.
+func TestPushdown(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	sorted := genMonotonic(blockSize)
+	mixed := genMixed(blockSize)
+
+	for name, buf := range map[string][]byte{
+		"plain":      PackUint32(nil, mixed),
+		"exceptions": PackUint32(nil, genDataWithLargeExceptions()),
+		"delta":      PackDeltaUint32(nil, sorted),
+		"zigzag":     PackDeltaUint32(nil, mixed),
+		"partial":    PackDeltaUint32(nil, sorted[:37]),
+		"overflow":   PackAlreadyDeltaUint32(nil, []uint32{0xFFFFFFF0, 0x20, 5}),
+		"single":     PackUint32(nil, []uint32{7}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			values, err := UnpackUint32(nil, buf)
+			if err != nil {
+				var overflowErr *ErrOverflow
+				assert.ErrorAs(err, &overflowErr)
+			}
+
+			for rname, p := range pushdownReaders(t, buf) {
+				minV, ok := p.Min()
+				assert.True(ok, rname)
+				assert.Equal(slices.Min(values), minV, rname)
+				maxV, ok := p.Max()
+				assert.True(ok, rname)
+				assert.Equal(slices.Max(values), maxV, rname)
+				sum, ok := p.Sum()
+				assert.True(ok, rname)
+				assert.Equal(sumValues(values), sum, rname)
+
+				bounds := [][2]uint32{{0, ^uint32(0)}, {maxV, maxV}, {minV, minV}, {maxV, minV}}
+				for range 20 {
+					lo := values[rng.Intn(len(values))]
+					bounds = append(bounds, [2]uint32{lo, lo + uint32(rng.Intn(1<<20))})
+				}
+				for _, b := range bounds {
+					n, ok := p.CountRange(b[0], b[1])
+					assert.True(ok, rname)
+					assert.Equal(countRangeScan(values, b[0], b[1]), n,
+						"%s CountRange(%d, %d)", rname, b[0], b[1])
+				}
+			}
+		})
+	}
+
+	t.Run("keepsPosition", func(t *testing.T) {
+		assert := assert.New(t)
+		for rname, p := range pushdownReaders(t, PackDeltaUint32(nil, sorted)) {
+			it := p.(interface {
+				Next() (uint32, uint8, bool)
+			})
+			it.Next()
+			p.Min()
+			p.Max()
+			p.Sum()
+			p.CountRange(0, 100)
+			_, pos, ok := it.Next()
+			assert.True(ok, rname)
+			assert.Equal(uint8(1), pos, rname)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert := assert.New(t)
+		for rname, tc := range map[string]struct {
+			p      Pushdown
+			loaded bool
+		}{
+			"notLoaded":     {NewReader(), false},
+			"slimNotLoaded": {NewSlimReader(), false},
+			"emptyBlock":    {pushdownReaders(t, PackUint32(nil, nil))["Reader"], true},
+			"slimEmpty":     {pushdownReaders(t, PackUint32(nil, nil))["SlimReader"], true},
+		} {
+			p, loaded := tc.p, tc.loaded
+			_, ok := p.Min()
+			assert.False(ok, rname)
+			_, ok = p.Max()
+			assert.False(ok, rname)
+			sum, ok := p.Sum()
+			assert.Zero(sum, rname)
+			assert.Equal(loaded, ok, rname)
+			n, ok := p.CountRange(0, ^uint32(0))
+			assert.Zero(n, rname)
+			assert.Equal(loaded, ok, rname)
+		}
+	})
+
+	t.Run("limitsAfterLoad", func(t *testing.T) {
+		assert := assert.New(t)
+		t.Cleanup(func() { SetDecodeLimits(DecodeLimits{}) })
+		// The exception area was validated on Load, so tightened limits do not
+		// fail the aggregates
+		values := genDataWithLargeExceptions()
+		slim := NewSlimReader()
+		assert.NoError(slim.Load(PackUint32(nil, values)))
+		SetDecodeLimits(DecodeLimits{MaxExceptions: 1})
+
+		minV, ok := slim.Min()
+		assert.True(ok)
+		assert.Equal(slices.Min(values), minV)
+		maxV, ok := slim.Max()
+		assert.True(ok)
+		assert.Equal(slices.Max(values), maxV)
+		sum, ok := slim.Sum()
+		assert.True(ok)
+		assert.Equal(sumValues(values), sum)
+		n, ok := slim.CountRange(0, ^uint32(0))
+		assert.True(ok)
+		assert.Equal(len(values), n)
+	})
+
+	t.Run("fromValues", func(t *testing.T) {
+		assert := assert.New(t)
+		r := NewReaderFromValues([]uint32{3, 5, 5, 9}, true)
+		minV, _ := r.Min()
+		maxV, _ := r.Max()
+		assert.Equal(uint32(3), minV)
+		assert.Equal(uint32(9), maxV)
+		sum, _ := r.Sum()
+		assert.Equal(uint64(22), sum)
+		n, _ := r.CountRange(4, 5)
+		assert.Equal(2, n)
+		n, _ = r.CountRange(5, ^uint32(0))
+		assert.Equal(3, n)
+	})
+}
+
+func BenchmarkPushdownCountRange(b *testing.B) {
+	for name, buf := range map[string][]byte{
+		"sorted":   PackDeltaUint32(nil, genMonotonic(blockSize)),
+		"unsorted": PackUint32(nil, genMixed(blockSize)),
+	} {
+		b.Run("Reader/"+name, func(b *testing.B) {
+			r := NewReader()
+			_ = r.Load(buf)
+			b.ReportAllocs()
+			for range b.N {
+				_, _ = r.CountRange(100, 1000)
+			}
+		})
+		b.Run("SlimReader/"+name, func(b *testing.B) {
+			r := NewSlimReader()
+			_ = r.Load(buf)
+			b.ReportAllocs()
+			for range b.N {
+				_, _ = r.CountRange(100, 1000)
+			}
+		})
+	}
+}
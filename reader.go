@@ -27,6 +27,22 @@ type Reader struct {
 
 	// overflowPos is the 0-based index of first overflow during delta decoding (0 = no overflow)
 	overflowPos uint8
+
+	// borrowed indicates that values is owned by the caller (see NewReaderFromValues)
+	// and must not be reused as the decode buffer
+	borrowed bool
+
+	// buf is the block loaded with LoadLazy until all values are decoded
+	buf []byte
+
+	// lanes has bit i set once lane i (positions i, i+4, ...) of buf is decoded
+	lanes uint8
+
+	// laneAccess indicates that buf is decoded lane by lane (no delta encoding, scalar kernels)
+	laneAccess bool
+
+	// intType is the integer type recorded in the block header (IntTypeUint16, ...)
+	intType uint8
 }
 
 // ErrInvalidBuffer is returned when the buffer is too small or malformed.
@@ -43,22 +59,48 @@ func NewReader() *Reader {
 	return &Reader{}
 }
 
+// NewReaderFromValues creates a Reader over already decoded values, e.g. from a
+// cache, without copying them. The reader provides the same Get/Next/SkipTo API
+// as after Load. If sorted is true, the values must be monotonically increasing
+// and SkipTo gallops over them.
+//
+// The reader aliases values, so they must not be modified while it is in use.
+// A later Load does not write into values. Panics if values holds more than
+// one block (128 values), as positions are reported as uint8.
+func NewReaderFromValues(values []uint32, sorted bool) *Reader {
+	if len(values) > blockSize {
+		panic("fastpfor: NewReaderFromValues: more than one block of values")
+	}
+	return &Reader{
+		values:   values,
+		count:    len(values),
+		isSorted: sorted,
+		loaded:   true,
+		borrowed: true,
+		intType:  IntTypeUint32,
+	}
+}
+
 // Load a FastPFOR-compressed byte buffer into the reader.
 // This resets all internal state and can be called multiple times to reuse the reader.
 // The buffer must contain a valid single block (packed with PackUint32, PackDeltaUint32, or PackAlreadyDeltaUint32).
 func (r *Reader) Load(buf []byte) error {
 	// Quick header check for isSorted flag before unpacking
-	if len(buf) < headerBytes {
-		return fmt.Errorf("%w: buffer too small for header (need %d bytes, got %d)",
-			ErrInvalidBuffer, headerBytes, len(buf))
+	header, count, _, err := readHeader(buf)
+	if err != nil {
+		return err
 	}
-	header := bo.Uint32(buf[:headerBytes])
-	count, _, _, _, hasDelta, hasZigZag, _ := decodeHeader(header)
+	_, _, intType, _, hasDelta, hasZigZag, _ := decodeHeader(header)
+	mode := headerDeltaMode(header)
 
 	// Unpack using the standard function (reuses r.values buffer)
 	r.overflowPos = 0
 
-	values, err := UnpackUint32(r.values, buf)
+	dst := r.values
+	if r.borrowed {
+		dst = nil
+	}
+	values, err := UnpackUint32(dst, buf)
 
 	if err != nil {
 		var overflowErr *ErrOverflow
@@ -71,14 +113,150 @@ func (r *Reader) Load(buf []byte) error {
 
 	// Update state
 	r.values = values
+	r.borrowed = false
+	r.buf = nil
 	r.count = count
-	r.isSorted = hasDelta && !hasZigZag // Delta without zigzag implies sorted/monotonic
+	// D1 deltas without zigzag imply sorted values, D4 and DM deltas only per lane or
+	// group; DD deltas need zigzag whenever the spacing shrinks, even for sorted values
+	r.isSorted = hasDelta && (mode == DeltaDD || !hasZigZag) && (mode == DeltaD1 || isNonDecreasing(values))
+	r.intType = uint8(intType)
 	r.pos = 0
 	r.loaded = true
 
 	return nil
 }
 
+// loadValues loads a copy of decoded values, e.g. of an inline block of a
+// BlockIndex, as if they had been unpacked by Load.
+func (r *Reader) loadValues(values []uint32) {
+	dst := r.values
+	if r.borrowed {
+		dst = nil
+	}
+	r.values = append(dst[:0], values...)
+	r.borrowed = false
+	r.buf = nil
+	r.overflowPos = 0
+	r.count = len(values)
+	r.isSorted = isNonDecreasing(values)
+	r.intType = IntTypeUint32
+	r.pos = 0
+	r.loaded = true
+}
+
+// LoadLazy loads a FastPFOR-compressed byte buffer like Load, but defers unpacking
+// until the values are accessed. Only the header and the structure of the
+// exception area are validated eagerly. For blocks without delta encoding, Get
+// decodes just the lane of the requested position (every 4th value) on first
+// access, so workloads that load many blocks but touch few values don't pay a
+// full decode per block. All other accesses, and any access to a delta-encoded
+// block, decode the whole block once. OverflowPos is only known after that.
+// With SIMD kernels, the whole block decodes faster than a single lane in
+// scalar code, so there the first access always decodes the whole block.
+// The buffer must remain unmodified until the block is fully decoded.
+func (r *Reader) LoadLazy(buf []byte) error {
+	header, count, payloadStart, err := readHeader(buf)
+	if err != nil {
+		return err
+	}
+	_, bitWidth, intType, hasExceptions, hasDelta, hasZigZag, _ := decodeHeader(header)
+
+	payloadEnd := payloadStart + payloadBytes(bitWidth)
+	if len(buf) < payloadEnd {
+		return fmt.Errorf("%w: buffer truncated (need %d bytes, got %d)",
+			ErrInvalidBuffer, payloadEnd, len(buf))
+	}
+	if hasExceptions {
+		n, err := BlockLength(buf)
+		if err != nil {
+			return err
+		}
+		if len(buf) < n {
+			return fmt.Errorf("%w: buffer truncated (need %d bytes, got %d)",
+				ErrInvalidBuffer, n, len(buf))
+		}
+		if err := validatePatch(buf[payloadEnd:n], count); err != nil {
+			return err
+		}
+	}
+
+	if r.borrowed {
+		r.values = nil
+	}
+	r.values = ensureUint32Cap(r.values, count, blockSize)
+	r.borrowed = false
+	r.buf = buf
+	if count == 0 {
+		r.buf = nil
+	}
+	r.lanes = 0
+	r.laneAccess = !hasDelta && !simdAvailable
+	r.overflowPos = 0
+	r.count = count
+	r.isSorted = hasDelta && !hasZigZag && headerDeltaMode(header) == DeltaD1
+	r.intType = uint8(intType)
+	r.pos = 0
+	r.loaded = true
+	return nil
+}
+
+// ensureDecoded decodes all pending values of a lazily loaded block.
+func (r *Reader) ensureDecoded() {
+	if r.buf == nil {
+		return
+	}
+	values, err := UnpackUint32(r.values, r.buf)
+	if err != nil {
+		// LoadLazy validated the block, so only overflows can be reported here
+		var overflowErr *ErrOverflow
+		if errors.As(err, &overflowErr) {
+			r.overflowPos = overflowErr.Position
+		}
+	}
+	if values != nil {
+		r.values = values
+	}
+	r.buf = nil
+}
+
+// ensureLane decodes the lane of pos of a lazily loaded block, or the whole
+// block if it can't be decoded lane by lane.
+func (r *Reader) ensureLane(pos int) {
+	if !r.laneAccess {
+		r.ensureDecoded()
+		return
+	}
+	lane := pos & 3
+	if r.lanes&(1<<lane) != 0 {
+		return
+	}
+
+	header, _, payloadStart, _ := readHeader(r.buf)
+	_, bitWidth, _, hasExceptions, _, _, _ := decodeHeader(header)
+	payloadEnd := payloadStart + payloadBytes(bitWidth)
+	values := r.values[:r.count]
+	if bitWidth == 0 {
+		for i := lane; i < len(values); i += 4 {
+			values[i] = 0
+		}
+	} else {
+		unpackLaneInterleaved(values, r.buf[payloadStart:payloadEnd], lane, bitWidth, len(values))
+	}
+	if hasExceptions {
+		_ = applyExceptionsLane(values, r.buf[payloadEnd:], lane, bitWidth)
+	}
+	if base := frameBase(r.buf, header); base != 0 {
+		for i := lane; i < len(values); i += 4 {
+			values[i] += base
+		}
+	}
+
+	r.lanes |= 1 << lane
+	if r.lanes == 0x0F {
+		r.buf = nil
+	}
+}
+
 // IsLoaded returns whether the reader has been loaded with data.
 func (r *Reader) IsLoaded() bool {
 	return r.loaded
@@ -108,6 +286,9 @@ func (r *Reader) Get(pos int) (uint32, error) {
 	if pos < 0 || pos >= r.count {
 		return 0, ErrPositionOutOfRange
 	}
+	if r.buf != nil {
+		r.ensureLane(pos)
+	}
 	return r.values[pos], nil
 }
 
@@ -124,6 +305,7 @@ func (r *Reader) Next() (value uint32, pos uint8, ok bool) {
 	if !r.loaded || r.pos >= r.count {
 		return 0, 0, false
 	}
+	r.ensureDecoded()
 	value = r.values[r.pos]
 	pos = uint8(r.pos)
 	r.pos++
@@ -131,7 +313,9 @@ func (r *Reader) Next() (value uint32, pos uint8, ok bool) {
 }
 
 // SkipTo advances to and returns the first value >= req.
-// This method is designed for sorted data where values are monotonically increasing.
+// This method is designed for sorted data where values are monotonically increasing,
+// which it searches by galloping from the current position, so short forward skips
+// are cheap.
 // Returns (value, pos, true) if found, or (0, 0, false) if not loaded or no value >= req exists.
 //
 // Note: For non-sorted data (including delta+zigzag sawtooth patterns), this method
@@ -140,26 +324,36 @@ func (r *Reader) SkipTo(req uint32) (value uint32, pos uint8, ok bool) {
 	if !r.loaded || r.count == 0 {
 		return 0, 0, false
 	}
+	r.ensureDecoded()
 
-	// For sorted data (delta without zigzag), use binary search
+	// For sorted data (delta without zigzag), gallop from the current position
 	if r.isSorted {
-		return r.skipToBinarySearch(req)
+		return r.skipToGallop(req)
 	}
 
 	// For non-sorted data (including delta+zigzag), use linear scan
 	return r.skipToLinear(req)
 }
 
-// skipToBinarySearch performs binary search for sorted data.
-// Searches from current position to end using slices.BinarySearch.
-func (r *Reader) skipToBinarySearch(req uint32) (value uint32, pos uint8, ok bool) {
-	// Search in the slice from current position to end
-	searchSlice := r.values[r.pos:]
-	idx, _ := slices.BinarySearch(searchSlice, req)
-
-	// Convert relative index to absolute position
-	absPos := r.pos + idx
-
+// skipToGallop finds the first value >= req in sorted data by galloping from
+// the current position: it probes at distances 1, 2, 4, ... until it passes req
+// and then binary-searches the last step. A skip of d positions thus costs
+// O(log d) comparisons instead of O(log n) for a search over the whole remainder,
+// which pays off for the many short forward skips of an intersection.
+func (r *Reader) skipToGallop(req uint32) (value uint32, pos uint8, ok bool) {
+	lo := r.pos
+	if lo >= r.count {
+		return 0, 0, false
+	}
+	// Invariant: all values before lo are < req
+	hi := lo
+	for step := 1; hi < r.count && r.values[hi] < req; step <<= 1 {
+		lo = hi + 1
+		hi += step
+	}
+	hi = min(hi, r.count-1)
+	idx, _ := slices.BinarySearch(r.values[lo:hi+1], req)
+	absPos := lo + idx
 	if absPos >= r.count {
 		r.pos = r.count
 		return 0, 0, false
@@ -189,6 +383,7 @@ func (r *Reader) Decode(dst []uint32) []uint32 {
 	if !r.loaded {
 		return nil
 	}
+	r.ensureDecoded()
 	if cap(dst) < r.count {
 		dst = make([]uint32, r.count)
 	} else {
@@ -198,8 +393,100 @@ func (r *Reader) Decode(dst []uint32) []uint32 {
 	return dst
 }
 
+// DecodeRange copies the values at positions [start, end) into dst and returns
+// dst resized to end-start, like SlimReader.DecodeRange. A lazily loaded block
+// only decodes the lanes that the range touches, which are all four from four
+// values on. Returns ErrNotLoaded if the reader is not loaded or
+// ErrPositionOutOfRange if the range is invalid.
+func (r *Reader) DecodeRange(dst []uint32, start, end int) ([]uint32, error) {
+	if !r.loaded {
+		return nil, ErrNotLoaded
+	}
+	if start < 0 || end > r.count || start > end {
+		return nil, ErrPositionOutOfRange
+	}
+	for pos := start; r.buf != nil && pos < min(end, start+4); pos++ {
+		r.ensureLane(pos)
+	}
+	n := end - start
+	if cap(dst) < n {
+		dst = make([]uint32, n)
+	} else {
+		dst = dst[:n]
+	}
+	copy(dst, r.values[start:end])
+	return dst, nil
+}
+
+// GetMany returns the values at positions in dst, resized to len(positions), so
+// that dst[i] is the value at positions[i], like SlimReader.GetMany. Positions
+// may be unordered and repeat. A lazily loaded block decodes each lane that a
+// position falls into once, instead of checking the lane per Get call.
+// Returns ErrNotLoaded if the reader is not loaded or ErrPositionOutOfRange if
+// any position is out of range.
+func (r *Reader) GetMany(positions []int, dst []uint32) ([]uint32, error) {
+	if !r.loaded {
+		return nil, ErrNotLoaded
+	}
+	var lanes uint8
+	for _, pos := range positions {
+		if pos < 0 || pos >= r.count {
+			return nil, ErrPositionOutOfRange
+		}
+		lanes |= 1 << (pos & 3)
+	}
+	for lane := 0; r.buf != nil && lane < 4; lane++ {
+		if lanes&(1<<lane) != 0 {
+			r.ensureLane(lane)
+		}
+	}
+	dst = ensureUint32Cap(dst, len(positions), len(positions))
+	for i, pos := range positions {
+		dst[i] = r.values[pos]
+	}
+	return dst, nil
+}
+
+// IntType returns the integer type recorded in the block header (IntTypeUint16
+// for blocks packed with PackUint16 or PackDeltaUint16, IntTypeUint32 otherwise).
+func (r *Reader) IntType() int {
+	return int(r.intType)
+}
+
+// GetUint16 returns the value at the specified position of a uint16 block.
+// Returns ErrNotLoaded, ErrPositionOutOfRange, or ErrInvalidBuffer if the block
+// is not marked as IntTypeUint16 or the value does not fit into uint16.
+func (r *Reader) GetUint16(pos int) (uint16, error) {
+	v, err := r.Get(pos)
+	if err != nil {
+		return 0, err
+	}
+	if r.intType != IntTypeUint16 {
+		return 0, errNotUint16(int(r.intType))
+	}
+	if v > 0xFFFF {
+		return 0, fmt.Errorf("%w: decoded value exceeds uint16", ErrInvalidBuffer)
+	}
+	return uint16(v), nil
+}
+
+// DecodeUint16 decodes all values of a uint16 block into dst (which will be
+// resized as needed). Returns ErrNotLoaded, or ErrInvalidBuffer if the block is
+// not marked as IntTypeUint16 or a value does not fit into uint16.
+func (r *Reader) DecodeUint16(dst []uint16) ([]uint16, error) {
+	if !r.loaded {
+		return nil, ErrNotLoaded
+	}
+	if r.intType != IntTypeUint16 {
+		return nil, errNotUint16(int(r.intType))
+	}
+	r.ensureDecoded()
+	return narrowUint16(dst, r.values[:r.count])
+}
+
 // IsSorted returns whether the data is known to be sorted (monotonically increasing).
-// This is true when delta encoding was used without zigzag (positive deltas only).
+// This is true when D1 delta encoding was used without zigzag (positive deltas
+// only), and after Load also for sorted D4 and DM delta blocks.
 func (r *Reader) IsSorted() bool {
 	return r.isSorted
 }
@@ -207,7 +494,7 @@ func (r *Reader) IsSorted() bool {
 // OverflowPos returns the 0-based index of the first overflow detected during delta decoding.
 // Returns 0 if no overflow occurred. Note: 0 cannot indicate an actual overflow since the
 // first element (index 0) is just copied; overflow can only occur at index 1 or later.
-// Only meaningful after Load() has been called.
+// Only meaningful after Load() has been called (or the block of LoadLazy was decoded).
 func (r *Reader) OverflowPos() uint8 {
 	return r.overflowPos
 }
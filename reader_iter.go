@@ -0,0 +1,45 @@
+//go:build go1.23
+
+package fastpfor
+
+import "iter"
+
+// Values returns an iterator over the positions and values of the block, for use
+// with range:
+//
+//	for pos, v := range r.Values() {
+//	    ...
+//	}
+//
+// The iterator yields nothing if the reader is not loaded. It does not change the
+// iteration position of Next and SkipTo.
+func (r *Reader) Values() iter.Seq2[int, uint32] {
+	return func(yield func(int, uint32) bool) {
+		if !r.loaded {
+			return
+		}
+		r.ensureDecoded()
+		for i, v := range r.values[:r.count] {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the positions and values of the block, decoding
+// incrementally like Next (O(1) per value, also for delta blocks). The iterator
+// yields nothing if the reader is not loaded. It does not change the iteration
+// position of Next and SkipTo.
+func (r *SlimReader) Values() iter.Seq2[int, uint32] {
+	return func(yield func(int, uint32) bool) {
+		it := *r
+		it.Reset()
+		for {
+			v, pos, ok := it.Next()
+			if !ok || !yield(int(pos), v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,82 @@
+//go:build go1.23
+
+package fastpfor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestReaderValues verifies that Values iterates all values without changing
+// the iteration position.
+func TestReaderValues(t *testing.T) {
+	assert := assert.New(t)
+
+	for name, buf := range map[string][]byte{
+		"plain":      PackUint32(nil, genMixed(blockSize)),
+		"exceptions": PackUint32(nil, genDataWithLargeExceptions()),
+		"delta":      PackDeltaUint32(nil, genMonotonic(100)),
+		"zigzag":     PackDeltaUint32(nil, genMixed(77)),
+		"empty":      PackUint32(nil, nil),
+	} {
+		want, err := UnpackUint32(nil, buf)
+		assert.NoError(err)
+
+		r := NewReader()
+		slim := NewSlimReader()
+		for _, v := range r.Values() {
+			assert.Fail("unloaded reader yielded", "%s: %d", name, v)
+		}
+		for _, v := range slim.Values() {
+			assert.Fail("unloaded slim reader yielded", "%s: %d", name, v)
+		}
+		assert.NoError(r.Load(buf))
+		assert.NoError(slim.Load(buf))
+
+		// Advance both readers, Values must start from the beginning anyway
+		r.Next()
+		slim.Next()
+		for reader, values := range map[string]func(yield func(int, uint32) bool){
+			"Reader":     r.Values(),
+			"SlimReader": slim.Values(),
+		} {
+			var got []uint32
+			for pos, v := range values {
+				assert.Equal(len(got), pos, "%s %s", name, reader)
+				got = append(got, v)
+			}
+			assert.Equal(want, nilIfEmpty(got), "%s %s", name, reader)
+
+			// Early exit
+			n := 0
+			for range values {
+				n++
+				if n == 3 {
+					break
+				}
+			}
+			assert.Equal(min(3, len(want)), n, "%s %s", name, reader)
+		}
+		if len(want) > 1 {
+			assert.Equal(1, r.Pos(), name)
+			assert.Equal(1, slim.Pos(), name)
+			v, _, _ := slim.Next()
+			assert.Equal(want[1], v, name)
+		}
+	}
+}
+
+func BenchmarkSlimReaderValues(b *testing.B) {
+	buf := PackDeltaUint32(nil, genMonotonic(blockSize))
+	slim := NewSlimReader()
+	_ = slim.Load(buf)
+	b.ReportAllocs()
+	var sum uint32
+	for range b.N {
+		for _, v := range slim.Values() {
+			sum += v
+		}
+	}
+	_ = sum
+}
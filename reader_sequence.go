@@ -0,0 +1,350 @@
+package fastpfor
+
+import (
+	"fmt"
+	"sort"
+)
+
+// SequenceReader provides access to a concatenation of blocks (e.g. the output of
+// ExternalSorter) with global positions: Get, Next and SkipTo work across block
+// boundaries. Only the block currently accessed is decoded, into an embedded
+// Reader.
+//
+// If every block is sorted (delta-encoded without zigzag or overflow) and no
+// block starts below the last value of the block before it, the sequence is
+// treated as sorted. Load then records the first and last value of every block
+// (the last one from the range record, see SetBlockRange, or by decoding the
+// block), and SkipTo binary-searches the last values instead of decoding the
+// blocks in between, so only a single block has to be decoded per SkipTo.
+// LoadIndexed takes the block boundaries and values from a BlockIndex instead.
+//
+// Blocks may differ in their flags (plain, delta, zigzag, with or without
+// exceptions, any header form), and each block is decoded according to its own
+// header. In unsorted sequences, SkipTo passes over blocks that cannot hold a
+// match without decoding them: blocks with a range record (see SetBlockRange)
+// whose maximum is below the requested value, and all-zero blocks (bit width 0
+// without exceptions).
+//
+// A SequenceReader is not safe for concurrent use.
+type SequenceReader struct {
+	buf     []byte
+	offsets []int      // byte offset of each non-empty block, plus the end offset
+	starts  []int      // global position of the first value of each block, plus the total count
+	firsts  []uint32   // first value of each block (only for sorted sequences)
+	lasts   []uint32   // last value of each block (only for sorted sequences)
+	maxes   []uint32   // upper bound of the values of each block (only for unsorted sequences, if any block is bounded)
+	inline  [][]uint32 // values of each inline block (only for indexes with inline entries)
+	sorted  bool
+	loaded  bool
+
+	pos    int // global position for sequential iteration
+	block  int // index of the block loaded into reader (-1 if none)
+	reader Reader
+}
+
+// NewSequenceReader creates an empty SequenceReader that must be loaded with
+// Load() before use.
+func NewSequenceReader() *SequenceReader {
+	return &SequenceReader{block: -1}
+}
+
+// Load loads a concatenation of blocks. All block headers and lengths are
+// validated. As long as the blocks are sorted, each block without a range record
+// is decoded once to find its last value; other payloads are not decoded. Empty
+// blocks are skipped.
+// The buffer must remain valid while the reader is in use.
+func (r *SequenceReader) Load(buf []byte) error {
+	r.offsets = r.offsets[:0]
+	r.starts = r.starts[:0]
+	r.firsts = r.firsts[:0]
+	r.lasts = r.lasts[:0]
+	r.maxes = r.maxes[:0]
+	r.inline = nil
+	r.sorted = true
+	r.loaded = false
+
+	total := 0
+	bounded := false
+	var scratch [blockSize]uint32
+	for off, blocks := 0, 1; off < len(buf); blocks++ {
+		if err := checkBlockLimit(blocks); err != nil {
+			return err
+		}
+		header, count, payloadStart, err := readHeader(buf[off:])
+		if err != nil {
+			return fmt.Errorf("block at offset %d: %w", off, err)
+		}
+		length, err := BlockLength(buf[off:])
+		if err != nil {
+			return fmt.Errorf("block at offset %d: %w", off, err)
+		}
+		if len(buf)-off < length {
+			return fmt.Errorf("%w: block at offset %d truncated (need %d bytes, got %d)",
+				ErrInvalidBuffer, off, length, len(buf)-off)
+		}
+		if count > 0 {
+			_, bitWidth, _, hasExceptions, hasDelta, hasZigZag, willOverflow := decodeHeader(header)
+			if !hasDelta || hasZigZag || willOverflow || headerDeltaMode(header) != DeltaD1 {
+				r.sorted = false
+			}
+			if r.sorted {
+				first, last, err := sortedBlockBounds(buf[off:off+length], header, payloadStart, bitWidth, hasExceptions, &scratch)
+				if err != nil {
+					return fmt.Errorf("block at offset %d: %w", off, err)
+				}
+				if n := len(r.lasts); n > 0 && first < r.lasts[n-1] {
+					r.sorted = false
+				}
+				r.firsts = append(r.firsts, first)
+				r.lasts = append(r.lasts, last)
+			}
+			upper, ok := blockUpperBound(buf[off:], header, payloadStart, bitWidth, hasExceptions)
+			r.maxes = append(r.maxes, upper)
+			bounded = bounded || ok
+			r.offsets = append(r.offsets, off)
+			r.starts = append(r.starts, total)
+			total += count
+		}
+		off += length
+	}
+	r.offsets = append(r.offsets, len(buf))
+	r.starts = append(r.starts, total)
+	if !r.sorted {
+		r.firsts = r.firsts[:0]
+		r.lasts = r.lasts[:0]
+	}
+	if r.sorted || !bounded {
+		r.maxes = r.maxes[:0]
+	}
+
+	r.buf = buf
+	r.pos = 0
+	r.block = -1
+	r.loaded = true
+	return nil
+}
+
+// LoadIndexed loads a concatenation of blocks described by idx (see
+// NewBlockIndex), without reading any block header. For sorted indexes, SkipTo
+// binary-searches the last values of the index and decodes only the block
+// holding the result. Blocks are validated against the index when they are
+// decoded. Inline blocks (see NewInlineBlockIndex) are read from the index.
+func (r *SequenceReader) LoadIndexed(buf []byte, idx *BlockIndex) error {
+	r.loaded = false
+	if idx.Size() > len(buf) {
+		return fmt.Errorf("%w: index covers %d bytes, got %d", ErrInvalidBuffer, idx.Size(), len(buf))
+	}
+	n := idx.Len()
+	if err := checkBlockLimit(n); err != nil {
+		return err
+	}
+	r.offsets = r.offsets[:0]
+	r.starts = r.starts[:0]
+	r.firsts = r.firsts[:0]
+	r.lasts = r.lasts[:0]
+	r.maxes = r.maxes[:0]
+	r.inline = nil
+	r.sorted = idx.IsSorted()
+	total := 0
+	for i := range n {
+		e := idx.Entry(i)
+		if e.Inline != nil && r.inline == nil {
+			r.inline = make([][]uint32, n)
+		}
+		if e.Inline != nil {
+			r.inline[i] = e.Inline
+		}
+		r.offsets = append(r.offsets, e.Offset)
+		r.starts = append(r.starts, total)
+		if r.sorted {
+			r.firsts = append(r.firsts, e.First)
+			r.lasts = append(r.lasts, e.Last)
+		}
+		total += e.Count
+	}
+	r.offsets = append(r.offsets, idx.Size())
+	r.starts = append(r.starts, total)
+
+	r.buf = buf
+	r.pos = 0
+	r.block = -1
+	r.loaded = true
+	return nil
+}
+
+// IsLoaded returns whether the reader has been loaded with data.
+func (r *SequenceReader) IsLoaded() bool {
+	return r.loaded
+}
+
+// Len returns the total number of values in all blocks.
+func (r *SequenceReader) Len() int {
+	if !r.loaded {
+		return 0
+	}
+	return r.starts[len(r.starts)-1]
+}
+
+// NumBlocks returns the number of non-empty blocks.
+func (r *SequenceReader) NumBlocks() int {
+	if !r.loaded {
+		return 0
+	}
+	return len(r.offsets) - 1
+}
+
+// IsSorted returns whether the sequence is known to be sorted (see SequenceReader).
+func (r *SequenceReader) IsSorted() bool {
+	return r.loaded && r.sorted
+}
+
+// Pos returns the current global position for sequential iteration.
+func (r *SequenceReader) Pos() int {
+	return r.pos
+}
+
+// Reset resets the reader position to the beginning for sequential iteration.
+func (r *SequenceReader) Reset() {
+	r.pos = 0
+}
+
+// Get returns the value at the global position pos.
+// Returns an error if the reader is not loaded, pos is out of range or the
+// block holding pos cannot be decoded.
+func (r *SequenceReader) Get(pos int) (uint32, error) {
+	if !r.loaded {
+		return 0, ErrNotLoaded
+	}
+	if pos < 0 || pos >= r.Len() {
+		return 0, ErrPositionOutOfRange
+	}
+	if err := r.loadBlock(r.blockOf(pos)); err != nil {
+		return 0, err
+	}
+	return r.reader.values[pos-r.starts[r.block]], nil
+}
+
+// Next returns the next value in sequence and its global position.
+// Returns (value, pos, true) on success, or (0, 0, false) if not loaded, no more
+// elements exist or the next block cannot be decoded.
+func (r *SequenceReader) Next() (value uint32, pos int, ok bool) {
+	if !r.loaded || r.pos >= r.Len() {
+		return 0, 0, false
+	}
+	block := r.block
+	if block < 0 || r.pos < r.starts[block] || r.pos >= r.starts[block+1] {
+		block = r.blockOf(r.pos)
+	}
+	if r.loadBlock(block) != nil {
+		return 0, 0, false
+	}
+	pos = r.pos
+	r.pos++
+	return r.reader.values[pos-r.starts[block]], pos, true
+}
+
+// SkipTo advances to and returns the first value >= req at or after the current
+// position, together with its global position.
+// Returns (value, pos, true) if found, or (0, 0, false) if not loaded, no value
+// >= req exists or a block cannot be decoded.
+//
+// For sorted sequences only one block is decoded (located via the block last
+// values), other sequences are scanned block by block in iteration order,
+// passing over blocks known to hold no value >= req.
+func (r *SequenceReader) SkipTo(req uint32) (value uint32, pos int, ok bool) {
+	if !r.loaded || r.pos >= r.Len() {
+		return 0, 0, false
+	}
+	numBlocks := r.NumBlocks()
+	block := r.blockOf(r.pos)
+	if r.sorted {
+		// The first block with a last value >= req holds the result
+		block += sort.Search(numBlocks-block, func(i int) bool {
+			return r.lasts[block+i] >= req
+		})
+	}
+
+	for ; block < numBlocks; block++ {
+		if len(r.maxes) > 0 && r.maxes[block] < req {
+			continue
+		}
+		if r.loadBlock(block) != nil {
+			return 0, 0, false
+		}
+		r.pos = max(r.pos, r.starts[block])
+		r.reader.pos = r.pos - r.starts[block]
+		if v, p, found := r.reader.SkipTo(req); found {
+			pos = r.starts[block] + int(p)
+			r.pos = pos + 1
+			return v, pos, true
+		}
+	}
+	r.pos = r.Len()
+	return 0, 0, false
+}
+
+// sortedBlockBounds returns the first and last value of the sorted block at the
+// start of buf. The first delta is the first value; the last value is the maximum
+// of the range record, if any, or decoded into scratch.
+func sortedBlockBounds(buf []byte, header uint32, payloadStart, bitWidth int, hasExceptions bool, scratch *[blockSize]uint32) (uint32, uint32, error) {
+	first, err := rawValueAt(buf, payloadStart, bitWidth, hasExceptions, 0)
+	if err != nil {
+		return 0, 0, err
+	}
+	if header&headerRangeFlag != 0 {
+		return first, decodeRange(buf[rangeStart(header, payloadStart):]).Max, nil
+	}
+	values, err := UnpackUint32(scratch[:0], buf)
+	if err != nil {
+		return 0, 0, err
+	}
+	return first, values[len(values)-1], nil
+}
+
+// blockUpperBound returns an upper bound of the values of the block at the start
+// of buf, read from its range record, or 0 for all-zero blocks. It reports false
+// (with mathMaxUint32) if the block has to be decoded to bound its values.
+func blockUpperBound(buf []byte, header uint32, payloadStart, bitWidth int, hasExceptions bool) (uint32, bool) {
+	switch {
+	case header&headerRangeFlag != 0:
+		return decodeRange(buf[rangeStart(header, payloadStart):]).Max, true
+	case bitWidth == 0 && !hasExceptions:
+		// Zero values, zero deltas from zero or the base of a frame-of-reference block
+		return frameBase(buf, header), true
+	}
+	return mathMaxUint32, false
+}
+
+// blockOf returns the index of the block holding the global position pos.
+func (r *SequenceReader) blockOf(pos int) int {
+	return sort.Search(len(r.starts)-1, func(i int) bool {
+		return r.starts[i+1] > pos
+	})
+}
+
+// loadBlock decodes block i into the embedded reader, unless it is loaded already.
+// Overflowing blocks are kept with their wrapped values (see Reader.Load).
+func (r *SequenceReader) loadBlock(i int) error {
+	if r.block == i {
+		return nil
+	}
+	r.block = -1
+	if r.inline != nil && r.inline[i] != nil {
+		r.reader.loadValues(r.inline[i])
+	} else if err := r.reader.Load(r.buf[r.offsets[i]:r.offsets[i+1]]); err != nil {
+		return err
+	}
+	if want := r.starts[i+1] - r.starts[i]; r.reader.Len() != want {
+		return fmt.Errorf("%w: block %d holds %d values, want %d",
+			ErrInvalidBuffer, i, r.reader.Len(), want)
+	}
+	if r.sorted {
+		// SkipTo relies on the bounds of sorted blocks
+		values := r.reader.values[:r.reader.count]
+		if values[0] != r.firsts[i] || values[len(values)-1] != r.lasts[i] {
+			return fmt.Errorf("%w: block %d does not match its recorded bounds", ErrInvalidBuffer, i)
+		}
+	}
+	r.block = i
+	return nil
+}
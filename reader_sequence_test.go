@@ -0,0 +1,331 @@
+package fastpfor
+
+import (
+	"errors"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// sequenceBlocks packs values as concatenated blocks of at most 128 values.
+func sequenceBlocks(values []uint32, pack func([]byte, []uint32) []byte) []byte {
+	var buf []byte
+	for len(values) > 0 {
+		n := min(len(values), blockSize)
+		buf = pack(buf, slices.Clone(values[:n]))
+		values = values[n:]
+	}
+	return buf
+}
+
+// mixedKindBlocks packs values as concatenated blocks of at most 128 values,
+// cycling through block kinds: plain, delta, ranged plain, extended-count and
+// ranged delta blocks.
+func mixedKindBlocks(values []uint32) []byte {
+	var buf []byte
+	for i := 0; len(values) > 0; i++ {
+		n := min(len(values), blockSize)
+		block := slices.Clone(values[:n])
+		switch i % 5 {
+		case 0:
+			buf = PackUint32(buf, block)
+		case 1:
+			buf = PackDeltaUint32(buf, block)
+		case 2:
+			buf, _ = SetBlockRange(buf, PackUint32(nil, block))
+		case 3:
+			buf = packInternal(buf, block, headerTypeUint32Flag|headerWideFlag)
+		case 4:
+			buf, _ = SetBlockRange(buf, PackDeltaUint32(nil, block))
+		}
+		values = values[n:]
+	}
+	return buf
+}
+
+// skipToScan is the reference implementation of SequenceReader.SkipTo.
+func skipToScan(values []uint32, from int, req uint32) (int, bool) {
+	for i := from; i < len(values); i++ {
+		if values[i] >= req {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func TestSequenceReader(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	sorted := genMonotonic(1000)
+	// Duplicates across block boundaries
+	dups := make([]uint32, 500)
+	for i := range dups {
+		dups[i] = 5 + 4*uint32(i/300)
+	}
+	// All-zero blocks between blocks of random values
+	zeros := genMixed(1000)
+	clear(zeros[128:384])
+	clear(zeros[640:768])
+
+	for name, tc := range map[string]struct {
+		values     []uint32
+		buf        []byte
+		wantSorted bool
+	}{
+		"sorted":     {sorted, sequenceBlocks(sorted, PackDeltaUint32), true},
+		"duplicates": {dups, sequenceBlocks(dups, PackDeltaUint32), true},
+		"plain":      {sorted, sequenceBlocks(sorted, PackUint32), false},
+		"mixed":      {genMixed(700), sequenceBlocks(genMixed(700), PackDeltaUint32), false},
+		"single":     {[]uint32{42}, PackDeltaUint32(nil, []uint32{42}), true},
+		"kinds":      {genMixed(1300), mixedKindBlocks(genMixed(1300)), false},
+		"zeros":      {zeros, mixedKindBlocks(zeros), false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			r := NewSequenceReader()
+			assert.NoError(r.Load(tc.buf))
+			assert.Equal(len(tc.values), r.Len())
+			assert.Equal((len(tc.values)+blockSize-1)/blockSize, r.NumBlocks())
+			assert.Equal(tc.wantSorted, r.IsSorted())
+
+			// Random access
+			for range 200 {
+				pos := rng.Intn(len(tc.values))
+				v, err := r.Get(pos)
+				assert.NoError(err)
+				assert.Equal(tc.values[pos], v, "Get(%d)", pos)
+			}
+
+			// Sequential iteration
+			r.Reset()
+			for i, want := range tc.values {
+				v, pos, ok := r.Next()
+				assert.True(ok)
+				assert.Equal(i, pos)
+				assert.Equal(want, v)
+			}
+			_, _, ok := r.Next()
+			assert.False(ok)
+
+			// SkipTo from the start and after previous skips
+			maxV := slices.Max(tc.values)
+			for range 50 {
+				r.Reset()
+				from := 0
+				for range 5 {
+					req := uint32(rng.Intn(int(maxV) + 2))
+					wantPos, wantOK := skipToScan(tc.values, from, req)
+					v, pos, ok := r.SkipTo(req)
+					assert.Equal(wantOK, ok, "SkipTo(%d) from %d", req, from)
+					if !wantOK {
+						assert.Equal(r.Len(), r.Pos())
+						break
+					}
+					assert.Equal(wantPos, pos, "SkipTo(%d) from %d", req, from)
+					assert.Equal(tc.values[wantPos], v)
+					from = pos + 1
+					assert.Equal(from, r.Pos())
+				}
+			}
+		})
+	}
+
+	t.Run("emptyBlocksSkipped", func(t *testing.T) {
+		assert := assert.New(t)
+		buf := PackDeltaUint32(nil, nil)
+		buf = PackDeltaUint32(buf, []uint32{1, 2, 3})
+		buf = PackDeltaUint32(buf, nil)
+		buf = PackDeltaUint32(buf, []uint32{7, 8})
+		r := NewSequenceReader()
+		assert.NoError(r.Load(buf))
+		assert.Equal(5, r.Len())
+		assert.Equal(2, r.NumBlocks())
+		assert.True(r.IsSorted())
+		v, pos, ok := r.SkipTo(4)
+		assert.True(ok)
+		assert.Equal(uint32(7), v)
+		assert.Equal(3, pos)
+	})
+
+	t.Run("unsortedAcrossBlocks", func(t *testing.T) {
+		assert := assert.New(t)
+		buf := PackDeltaUint32(nil, []uint32{100, 200})
+		buf = PackDeltaUint32(buf, []uint32{1, 2})
+		r := NewSequenceReader()
+		assert.NoError(r.Load(buf))
+		assert.False(r.IsSorted())
+		v, pos, ok := r.SkipTo(150)
+		assert.True(ok)
+		assert.Equal(uint32(200), v)
+		assert.Equal(1, pos)
+	})
+
+	t.Run("overlappingBlocks", func(t *testing.T) {
+		assert := assert.New(t)
+		// The first values increase, but the first block ends above the start of
+		// the second one
+		buf := PackDeltaUint32(nil, []uint32{1, 100})
+		buf = PackDeltaUint32(buf, []uint32{50, 60})
+		r := NewSequenceReader()
+		assert.NoError(r.Load(buf))
+		assert.False(r.IsSorted())
+		v, pos, ok := r.SkipTo(55)
+		assert.True(ok)
+		assert.Equal(uint32(100), v)
+		assert.Equal(1, pos)
+
+		// Touching blocks are sorted, also with the last value from a range record
+		ranged, err := SetBlockRange(nil, PackDeltaUint32(nil, []uint32{1, 50}))
+		assert.NoError(err)
+		buf = PackDeltaUint32(ranged, []uint32{50, 60})
+		assert.NoError(r.Load(buf))
+		assert.True(r.IsSorted())
+		v, pos, ok = r.SkipTo(55)
+		assert.True(ok)
+		assert.Equal(uint32(60), v)
+		assert.Equal(3, pos)
+	})
+
+	t.Run("boundedBlocksNotDecoded", func(t *testing.T) {
+		assert := assert.New(t)
+		// The range record of the first block understates its values, so the
+		// value 500 is only found if the block is decoded
+		first, err := SetBlockRange(nil, PackUint32(nil, []uint32{1, 500, 2}))
+		assert.NoError(err)
+		bo.PutUint32(first[headerBytes+4:], 10)
+		buf := append(first, PackUint32(nil, make([]uint32, 100))...)
+		buf = PackUint32(buf, []uint32{3, 600})
+
+		r := NewSequenceReader()
+		assert.NoError(r.Load(buf))
+		assert.False(r.IsSorted())
+		v, pos, ok := r.SkipTo(400)
+		assert.True(ok)
+		assert.Equal(uint32(600), v)
+		assert.Equal(104, pos)
+		assert.Equal(2, r.block)
+
+		r.Reset()
+		v, pos, ok = r.SkipTo(0)
+		assert.True(ok)
+		assert.Equal(uint32(1), v)
+		assert.Equal(0, pos)
+		v, pos, ok = r.SkipTo(0)
+		assert.True(ok)
+		assert.Equal(uint32(500), v)
+		assert.Equal(1, pos)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		assert := assert.New(t)
+		r := NewSequenceReader()
+		_, err := r.Get(0)
+		assert.ErrorIs(err, ErrNotLoaded)
+		_, _, ok := r.Next()
+		assert.False(ok)
+		_, _, ok = r.SkipTo(0)
+		assert.False(ok)
+
+		buf := sequenceBlocks(sorted, PackDeltaUint32)
+		assert.ErrorIs(r.Load(buf[:len(buf)-1]), ErrInvalidBuffer)
+		assert.NoError(r.Load(buf))
+		_, err = r.Get(len(sorted))
+		assert.ErrorIs(err, ErrPositionOutOfRange)
+	})
+}
+
+func BenchmarkSequenceReaderSkipTo(b *testing.B) {
+	values := genMonotonic(64 * blockSize)
+	buf := sequenceBlocks(values, PackDeltaUint32)
+	r := NewSequenceReader()
+	_ = r.Load(buf)
+	step := values[len(values)-1] / 32
+	b.ReportAllocs()
+	for range b.N {
+		r.Reset()
+		for req := uint32(0); ; req += step {
+			if _, _, ok := r.SkipTo(req); !ok {
+				break
+			}
+		}
+	}
+}
+
+// assertTypedError asserts that err wraps one of the package's sentinel errors.
+func assertTypedError(t *testing.T, err error, msgAndArgs ...any) {
+	t.Helper()
+	for _, sentinel := range []error{ErrInvalidBuffer, ErrInvalidFlags, ErrUnsupportedFeature,
+		ErrInvalidBlockLength, ErrPositionOutOfRange, ErrValueOutOfRange} {
+		if errors.Is(err, sentinel) {
+			return
+		}
+	}
+	assert.Fail(t, "untyped error: "+err.Error(), msgAndArgs...)
+}
+
+// assertSequenceConsistent checks that a loaded reader either fails with typed
+// errors or decodes the same values through Get, Next and SkipTo.
+func assertSequenceConsistent(t *testing.T, r *SequenceReader) {
+	t.Helper()
+	values := make([]uint32, 0, r.Len())
+	for pos := range r.Len() {
+		v, err := r.Get(pos)
+		if err != nil {
+			// Corrupt payloads are only detected on decode
+			assertTypedError(t, err, "Get(%d)", pos)
+			return
+		}
+		values = append(values, v)
+	}
+	_, err := r.Get(r.Len())
+	assert.ErrorIs(t, err, ErrPositionOutOfRange)
+
+	r.Reset()
+	for i, want := range values {
+		v, pos, ok := r.Next()
+		if !assert.True(t, ok, "Next at %d", i) {
+			return
+		}
+		assert.Equal(t, i, pos)
+		assert.Equal(t, want, v, "Next at %d", i)
+	}
+	_, _, ok := r.Next()
+	assert.False(t, ok)
+
+	if len(values) == 0 || !slices.IsSorted(values) {
+		return
+	}
+	for _, req := range []uint32{0, 1, values[len(values)/2], ^uint32(0)} {
+		r.Reset()
+		v, pos, ok := r.SkipTo(req)
+		want, wantOK := skipToScan(values, 0, req)
+		assert.Equal(t, wantOK, ok, "SkipTo(%d)", req)
+		if ok && wantOK {
+			assert.Equal(t, want, pos, "SkipTo(%d)", req)
+			assert.Equal(t, values[want], v, "SkipTo(%d)", req)
+		}
+	}
+}
+
+// FuzzSequenceReader mutates whole concatenations of blocks and checks that
+// loading and reading them never panics and either succeeds consistently or
+// returns typed errors.
+func FuzzSequenceReader(f *testing.F) {
+	f.Add([]byte{})
+	f.Add(sequenceBlocks(genMixed(3*blockSize+5), PackUint32))
+	f.Add(sequenceBlocks(genMonotonic(2*blockSize+17), PackDeltaUint32))
+	f.Add(sequenceBlocks(genDataWithLargeExceptions(), PackUint32))
+	tagged, _ := SetBlockRange(nil, PackDeltaUint32(nil, genMonotonic(40)))
+	f.Add(append(tagged, PackUint32(nil, genSequential(9))...))
+
+	r := NewSequenceReader()
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := r.Load(data); err != nil {
+			assertTypedError(t, err)
+			assert.False(t, r.IsLoaded())
+			return
+		}
+		assertSequenceConsistent(t, r)
+	})
+}
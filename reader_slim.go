@@ -8,22 +8,67 @@ import "fmt"
 //
 // SlimReader is optimized for scenarios with millions of readers where memory is
 // critical and the underlying data is provided via MMAP. Each SlimReader instance
-// uses only ~40 bytes of memory (vs Reader which allocates up to 512+ bytes for
+// uses only ~80 bytes of memory (vs Reader which allocates up to 512+ bytes for
 // the decoded values buffer).
 
 // SlimReader is safe for concurrent read access to the same underlying buffer,
 // but each SlimReader instance should not be accessed concurrently.
 type SlimReader struct {
-	buf         []byte // 24 bytes - slice header pointing to compressed data
-	lastValue   uint32 // 4 bytes - cumulative value for delta iteration
-	count       uint8  // 1 byte - element count (0-128)
-	bitWidth    uint8  // 1 byte - bit width for packed values (0-32)
-	flags       uint8  // 1 byte - packed flags (includes loaded flag)
-	pos         uint8  // 1 byte - current iteration position
-	payloadEnd  uint16 // 2 bytes - offset where payload ends (exceptions start)
-	excPos      uint8  // 1 byte - current exception index for iteration
-	overflowPos uint8  // 1 byte - 0-based index of first overflow (0 = no overflow detected)
-	// Total: 24 + 4 + 8 = 36 bytes, aligned to 40 bytes
+	buf         []byte    // 24 bytes - slice header pointing to compressed data
+	lastValue   uint32    // 4 bytes - cumulative value for delta iteration
+	count       uint8     // 1 byte - element count (0-128)
+	bitWidth    uint8     // 1 byte - bit width for packed values (0-32)
+	flags       uint8     // 1 byte - packed flags (includes loaded flag)
+	pos         uint8     // 1 byte - current iteration position
+	payloadEnd  uint16    // 2 bytes - offset where payload ends (exceptions start)
+	excPos      uint8     // 1 byte - current exception index for iteration
+	overflowPos uint8     // 1 byte - 0-based index of first overflow (0 = no overflow detected)
+	payloadOff  uint8     // 1 byte - offset where payload starts (header + optional extension)
+	deltaMode   uint8     // 1 byte - delta mode of delta blocks (see DeltaMode)
+	patch       slimPatch // 7 bytes - layout of the validated exception area
+	excBitmap   [2]uint64 // 16 bytes - run-coded or bitmap exception positions
+	deltaState  [4]uint32 // 16 bytes - D4: last value of each lane, DD: first-order delta in [0]
+	// Total: 24 + 4 + 10 + 7 + 16 + 16 = 77 bytes, aligned to 80 bytes
+}
+
+// slimPatch is the layout of an exception area (see patchLayout) in 7 bytes,
+// which SlimReader validates once on Load.
+type slimPatch struct {
+	excCount uint8
+	posLen   uint8
+	rawWidth uint8
+	runs     bool
+	bitmap   bool
+	svbLen   uint16
+}
+
+// newSlimPatch returns the compact form of l.
+func newSlimPatch(l patchLayout) slimPatch {
+	return slimPatch{
+		excCount: uint8(l.excCount),
+		posLen:   uint8(l.posLen),
+		rawWidth: uint8(l.rawWidth),
+		runs:     l.runs,
+		bitmap:   l.bitmap,
+		svbLen:   uint16(l.svbLen),
+	}
+}
+
+// layout returns the full form of p.
+func (p slimPatch) layout() patchLayout {
+	l := patchLayout{
+		excCount: int(p.excCount),
+		runs:     p.runs,
+		bitmap:   p.bitmap,
+		posOff:   patchMetaBytes,
+		posLen:   int(p.posLen),
+		svbLen:   int(p.svbLen),
+		rawWidth: int(p.rawWidth),
+	}
+	if p.runs {
+		l.posOff++
+	}
+	return l
 }
 
 // SlimReader flag bits
@@ -33,6 +78,12 @@ const (
 	slimFlagExceptions   = 1 << 2
 	slimFlagLoaded       = 1 << 3
 	slimFlagWillOverflow = 1 << 4
+
+	// Bits 5-6 hold the integer type of the header
+	slimIntTypeShift = 5
+
+	// slimFlagFOR marks frame-of-reference and constant blocks, whose base is read from the buffer
+	slimFlagFOR = 1 << 7
 )
 
 // NewSlimReader creates an empty SlimReader that must be loaded with Load() before use.
@@ -44,29 +95,51 @@ func NewSlimReader() *SlimReader {
 // This resets all internal state and can be called multiple times to reuse the reader.
 // The buffer must remain valid for the lifetime of the SlimReader (ideal for MMAP).
 // Delta encoding is auto-detected from the header flag.
+// The exception area is validated (and checked against the decode limits) once,
+// so later accesses cannot fail on it, even if the limits are tightened.
 func (r *SlimReader) Load(buf []byte) error {
-	if len(buf) < headerBytes {
-		return fmt.Errorf("%w: buffer too small for header (need %d bytes, got %d)",
-			ErrInvalidBuffer, headerBytes, len(buf))
-	}
-
-	header := bo.Uint32(buf[:headerBytes])
-	count, bitWidth, _, hasExceptions, hasDelta, hasZigZag, willOverflow := decodeHeader(header)
-
-	if count < 0 || count > blockSize {
-		return fmt.Errorf("%w: invalid element count %d", ErrInvalidBuffer, count)
+	header, count, payloadStart, err := readHeader(buf)
+	if err != nil {
+		return err
 	}
+	_, bitWidth, intType, hasExceptions, hasDelta, hasZigZag, willOverflow := decodeHeader(header)
 
 	payloadLen := payloadBytes(bitWidth)
-	minNeeded := headerBytes + payloadLen
+	minNeeded := payloadStart + payloadLen
 
 	if len(buf) < minNeeded {
 		return fmt.Errorf("%w: buffer truncated (need %d bytes, got %d)",
 			ErrInvalidBuffer, minNeeded, len(buf))
 	}
+	var patch slimPatch
+	var excBitmap [2]uint64
+	if hasExceptions {
+		if len(buf) < minNeeded+patchMetaBytes {
+			return fmt.Errorf("%w: buffer truncated (need %d bytes, got %d)",
+				ErrInvalidBuffer, minNeeded+patchMetaBytes, len(buf))
+		}
+		l, err := readPatchLayout(buf[minNeeded:])
+		if err != nil {
+			return patchError(err)
+		}
+		if len(buf) < minNeeded+l.size() {
+			return fmt.Errorf("%w: buffer truncated (need %d bytes, got %d)",
+				ErrInvalidBuffer, minNeeded+l.size(), len(buf))
+		}
+		if err := validatePatch(buf[minNeeded:minNeeded+l.size()], count); err != nil {
+			return err
+		}
+		patch = newSlimPatch(l)
+		if l.runs || l.bitmap {
+			// Decode the positions once instead of on every access
+			if excBitmap, err = l.positionBitmap(buf[minNeeded:]); err != nil {
+				return patchError(err)
+			}
+		}
+	}
 
 	// Build flags
-	var flags uint8 = slimFlagLoaded
+	flags := slimFlagLoaded | uint8(intType)<<slimIntTypeShift
 	if hasDelta {
 		flags |= slimFlagDelta
 	}
@@ -79,6 +152,9 @@ func (r *SlimReader) Load(buf []byte) error {
 	if willOverflow {
 		flags |= slimFlagWillOverflow
 	}
+	if hasBaseRecord(buf, header) {
+		flags |= slimFlagFOR
+	}
 
 	// Reset all state
 	r.buf = buf
@@ -86,10 +162,15 @@ func (r *SlimReader) Load(buf []byte) error {
 	r.bitWidth = uint8(bitWidth)
 	r.flags = flags
 	r.payloadEnd = uint16(minNeeded)
+	r.payloadOff = uint8(payloadStart)
 	r.pos = 0
 	r.excPos = 0
 	r.lastValue = 0
+	r.deltaState = [4]uint32{}
 	r.overflowPos = 0
+	r.deltaMode = uint8(headerDeltaMode(header))
+	r.patch = patch
+	r.excBitmap = excBitmap
 
 	return nil
 }
@@ -99,9 +180,15 @@ func (r *SlimReader) IsLoaded() bool {
 	return r.flags&slimFlagLoaded != 0
 }
 
-// IsSorted returns true if the data is sorted (delta-encoded without zigzag).
+// IsSorted returns true if the data is sorted (D1 delta-encoded without zigzag).
 func (r *SlimReader) IsSorted() bool {
-	return r.flags&slimFlagDelta != 0 && r.flags&slimFlagZigZag == 0
+	return r.flags&slimFlagDelta != 0 && r.flags&slimFlagZigZag == 0 && DeltaMode(r.deltaMode) == DeltaD1
+}
+
+// IntType returns the integer type recorded in the block header (IntTypeUint16
+// for blocks packed with PackUint16 or PackDeltaUint16, IntTypeUint32 otherwise).
+func (r *SlimReader) IntType() int {
+	return int(r.flags>>slimIntTypeShift) & headerTypeMask
 }
 
 // OverflowPos returns the 0-based index of the first overflow detected during iteration.
@@ -138,7 +225,7 @@ func (r *SlimReader) Get(pos int) (uint32, error) {
 
 	// For delta-encoded data, we must decode all values up to pos for prefix sum
 	if r.flags&slimFlagDelta != 0 {
-		return r.getWithDelta(uint32(pos)), nil
+		return r.getWithDelta(uint32(pos))
 	}
 
 	// For non-delta data, extract just the single value
@@ -160,15 +247,28 @@ func (r *SlimReader) getSingle(pos uint32) uint32 {
 		value = r.applyExceptionIfPresent(pos, value, bitWidth)
 	}
 
-	return value
+	return value + r.base()
+}
+
+// base returns the base of a frame-of-reference or constant block, or 0 for other blocks.
+func (r *SlimReader) base() uint32 {
+	if r.flags&slimFlagFOR == 0 {
+		return 0
+	}
+	return bo.Uint32(r.buf[headerBytes+headerWideBytes:])
 }
 
 // extractValue extracts a single value from the interleaved bit-packed lanes.
+func (r *SlimReader) extractValue(pos uint32, bitWidth int) uint32 {
+	return extractLaneValue(r.buf[r.payloadOff:r.payloadEnd], pos, bitWidth)
+}
+
+// extractLaneValue extracts the value at pos from an interleaved bit-packed payload.
 // Lane layout: values are split into 4 lanes, each encoding every 4th element.
 // Lane 0: v0, v4, v8, ... Lane 1: v1, v5, v9, ... etc.
 // Lanes are interleaved in 16-byte blocks in the payload.
 // I benchmarked that a 1-lane layout wouldn't be taht much faster than the 4-lane layout.
-func (r *SlimReader) extractValue(pos uint32, bitWidth int) uint32 {
+func extractLaneValue(payload []byte, pos uint32, bitWidth int) uint32 {
 	// Determine which lane and position within the lane
 	// Using bit operations: pos & 3 = pos % 4, pos >> 2 = pos / 4
 	lane := int(pos) & 3
@@ -185,7 +285,6 @@ func (r *SlimReader) extractValue(pos uint32, bitWidth int) uint32 {
 	// Calculate byte offset in payload for this lane's word
 	// Each 16-byte block has one word from each lane
 	// Word N of lane L is at: block N * 16 + lane L * 4
-	payload := r.buf[headerBytes:r.payloadEnd]
 	byteOffset := wordInLane<<4 + lane<<2 // wordInLane*16 + lane*4
 
 	// Read the value, handling the case where it spans two words
@@ -213,39 +312,55 @@ func (r *SlimReader) extractValue(pos uint32, bitWidth int) uint32 {
 	return uint32(acc & mask)
 }
 
+// gatherLanesScalar extracts the values at positions from an interleaved
+// bit-packed payload into dst[:len(positions)] (see extractLaneValue).
+func gatherLanesScalar(dst []uint32, payload []byte, positions []int, bitWidth int) {
+	for i, pos := range positions {
+		dst[i] = extractLaneValue(payload, uint32(pos), bitWidth)
+	}
+}
+
 // applyExceptionIfPresent checks if pos has an exception and applies it.
 func (r *SlimReader) applyExceptionIfPresent(pos uint32, value uint32, bitWidth int) uint32 {
+	l := r.patch.layout()
 	patch := r.buf[r.payloadEnd:]
-	excCount := int(patch[0])
-	if excCount == 0 {
+	var excIndex int
+	var ok bool
+	if l.runs || l.bitmap {
+		excIndex, ok = bitmapIndex(r.excBitmap, pos)
+	} else {
+		excIndex, ok, _ = l.exceptionIndex(patch, pos) // plain positions are only scanned
+	}
+	if !ok {
 		return value
 	}
+	return value | l.highBitsAt(patch[l.svbOff():l.size()], excIndex)<<bitWidth
+}
 
-	positions := patch[3 : 3+excCount]
-
-	// Find if pos is in the exception list (positions are sorted ascending)
-	var excIndex int
-	for excIndex = range positions {
-		if uint32(positions[excIndex]) == pos {
-			goto applyException
-		}
-		if uint32(positions[excIndex]) > pos {
-			return value // Passed our position, no exception
-		}
+// applyExceptionAt applies the exception for pos from the exception area patch,
+// if there is one. Returns an error if the exception area is malformed or
+// exceeds the decode limits.
+func applyExceptionAt(patch []byte, pos uint32, value uint32, bitWidth int) (uint32, error) {
+	l, err := readPatchLayout(patch)
+	if err != nil {
+		return 0, err
 	}
-	return value // No exception for this position
-
-applyException:
-	// Decode only the needed exception high bit using StreamVByte random access
-	svbData := patch[3+excCount:]
-	highBit := svbDecodeOne(svbData, excCount, excIndex)
-
-	// Apply the exception
-	return value | (highBit << bitWidth)
+	if len(patch) < l.size() {
+		return 0, fmt.Errorf("fastpfor: truncated exception area (need %d bytes, got %d)", l.size(), len(patch))
+	}
+	excIndex, ok, err := l.exceptionIndex(patch, pos)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return value, nil
+	}
+	// Decode only the needed exception high bits using random access
+	return value | l.highBitsAt(patch[l.svbOff():l.size()], excIndex)<<bitWidth, nil
 }
 
 // getWithDelta decodes values with delta encoding (requires prefix sum).
-func (r *SlimReader) getWithDelta(pos uint32) uint32 {
+func (r *SlimReader) getWithDelta(pos uint32) (uint32, error) {
 	var values [2 * blockSize]uint32
 
 	count := int(r.count)
@@ -253,13 +368,15 @@ func (r *SlimReader) getWithDelta(pos uint32) uint32 {
 
 	// Decode packed values
 	if bitWidth > 0 {
-		unpackLanes(values[:count], r.buf[headerBytes:r.payloadEnd], count, bitWidth)
+		unpackLanes(values[:count], r.buf[r.payloadOff:r.payloadEnd], count, bitWidth)
 	}
 
 	// Apply exceptions if present, using values[blockSize:] as scratch
 	if r.flags&slimFlagExceptions != 0 {
 		scratch := values[blockSize : 2*blockSize]
-		_, _ = applyExceptions(values[:count], r.buf, int(r.payloadEnd), count, bitWidth, scratch)
+		if _, err := r.applyExceptions(values[:count], bitWidth, scratch); err != nil {
+			return 0, err
+		}
 	}
 
 	// Apply delta decoding (with overflow detection if will-overflow flag is set)
@@ -270,10 +387,75 @@ func (r *SlimReader) getWithDelta(pos uint32) uint32 {
 			r.overflowPos = overflowPos
 		}
 	} else {
-		deltaDecode(values[:count], values[:count], useZigZag)
+		deltaDecodeMode(values[:count], DeltaMode(r.deltaMode), useZigZag)
 	}
 
-	return values[pos]
+	return values[pos], nil
+}
+
+// applyExceptions applies the exceptions of the validated exception area to dst,
+// using scratch for the high bits (see applyPatch).
+func (r *SlimReader) applyExceptions(dst []uint32, bitWidth int, scratch []uint32) (int, error) {
+	n, err := applyPatch(dst, r.buf[r.payloadEnd:], r.patch.layout(), len(dst), bitWidth, scratch)
+	if err != nil {
+		return 0, patchError(err)
+	}
+	return n, nil
+}
+
+// GetMany returns the values at positions in dst, resized to len(positions), so
+// that dst[i] is the value at positions[i]. Positions may be unordered and repeat.
+// For non-delta data, the values are extracted directly from the packed lanes
+// (with AVX2 gathers where available) and the exception area is decoded at most
+// once, which makes scattered lookups much cheaper than one Get call per position.
+// For delta data, the block is decoded once.
+// Returns ErrNotLoaded if the reader is not loaded or ErrPositionOutOfRange if
+// any position is out of range.
+func (r *SlimReader) GetMany(positions []int, dst []uint32) ([]uint32, error) {
+	if r.flags&slimFlagLoaded == 0 {
+		return nil, ErrNotLoaded
+	}
+	count := int(r.count)
+	for _, pos := range positions {
+		if pos < 0 || pos >= count {
+			return nil, ErrPositionOutOfRange
+		}
+	}
+	dst = ensureUint32Cap(dst, len(positions), len(positions))
+	if len(positions) == 0 {
+		return dst, nil
+	}
+
+	if r.flags&slimFlagDelta != 0 {
+		var values [2 * blockSize]uint32
+		decoded := r.Decode(values[:0])
+		for i, pos := range positions {
+			dst[i] = decoded[pos]
+		}
+		return dst, nil
+	}
+
+	bitWidth := int(r.bitWidth)
+	if bitWidth == 0 {
+		clear(dst)
+	} else {
+		gatherLanes(dst, r.buf[r.payloadOff:r.payloadEnd], positions, bitWidth)
+	}
+
+	// Expand the exception high bits to their positions once
+	if r.flags&slimFlagExceptions != 0 {
+		var high [2 * blockSize]uint32
+		if _, err := r.applyExceptions(high[:count], bitWidth, high[blockSize:]); err != nil {
+			return nil, err
+		}
+		for i, pos := range positions {
+			dst[i] |= high[pos]
+		}
+	}
+	if base := r.base(); base != 0 {
+		addBase(dst, base)
+	}
+	return dst, nil
 }
 
 // GetSafe returns the value at the specified position and whether the position is valid.
@@ -293,11 +475,12 @@ func (r *SlimReader) Reset() {
 	r.pos = 0
 	r.excPos = 0
 	r.lastValue = 0
+	r.deltaState = [4]uint32{}
 }
 
 // Next returns the next value in sequence and its position.
 // Returns (value, pos, true) on success, or (0, 0, false) if not loaded or no more elements.
-// For both delta and non-delta data, this is O(1) per call.
+// This is O(1) per call for all delta modes.
 func (r *SlimReader) Next() (value uint32, pos uint8, ok bool) {
 	if r.flags&slimFlagLoaded == 0 || r.pos >= r.count {
 		return 0, 0, false
@@ -329,15 +512,44 @@ func (r *SlimReader) nextValue() uint32 {
 		if r.flags&slimFlagZigZag != 0 {
 			value = uint32(zigzagDecode32(value))
 		}
+		// Blocks with the will-overflow flag are always D1 (see readHeader), the
+		// other modes cannot skip the overflow detection below
+		switch DeltaMode(r.deltaMode) {
+		case DeltaD4:
+			// deltaState holds the last value of each lane
+			lane := r.pos & (laneCount - 1)
+			value += r.deltaState[lane]
+			r.deltaState[lane] = value
+			return value
+		case DeltaDD:
+			// deltaState[0] holds the first-order delta, the sum of the
+			// second-order deltas since position 1; position 0 holds the first
+			// value itself
+			if r.pos > 0 {
+				r.deltaState[0] += value
+				value = r.deltaState[0]
+			}
+			value += r.lastValue
+			r.lastValue = value
+			return value
+		case DeltaDM:
+			// lastValue holds the last value of the previous group of four
+			value += r.lastValue
+			if r.pos%4 == 3 {
+				r.lastValue = value
+			}
+			return value
+		}
 		value += r.lastValue
 		// Detect overflow only when will-overflow flag is set
 		if r.flags&slimFlagWillOverflow != 0 && r.overflowPos == 0 && value < r.lastValue {
 			r.overflowPos = r.pos // 0-based index (always >= 1 when overflow occurs)
 		}
 		r.lastValue = value
+		return value
 	}
 
-	return value
+	return value + r.base()
 }
 
 // SkipTo advances to and returns the first value >= req.
@@ -387,13 +599,16 @@ func (r *SlimReader) Decode(dst []uint32) []uint32 {
 	if bitWidth == 0 {
 		clear(dst[:count])
 	} else {
-		unpackLanes(dst[:count], r.buf[headerBytes:r.payloadEnd], count, bitWidth)
+		unpackLanes(dst[:count], r.buf[r.payloadOff:r.payloadEnd], count, bitWidth)
 	}
 
 	// Apply exceptions if present, using dst[blockSize:] as scratch
 	if r.flags&slimFlagExceptions != 0 {
 		scratch := dst[blockSize : 2*blockSize]
-		_, _ = applyExceptions(dst[:count], r.buf, int(r.payloadEnd), count, bitWidth, scratch)
+		_, _ = r.applyExceptions(dst[:count], bitWidth, scratch) // validated by Load
+	}
+	if base := r.base(); base != 0 {
+		addBase(dst[:count], base)
 	}
 
 	// Apply delta decoding if needed (with overflow detection if will-overflow flag is set)
@@ -405,9 +620,109 @@ func (r *SlimReader) Decode(dst []uint32) []uint32 {
 				r.overflowPos = overflowPos
 			}
 		} else {
-			deltaDecode(dst, dst, useZigZag)
+			deltaDecodeMode(dst, DeltaMode(r.deltaMode), useZigZag)
 		}
 	}
 
 	return dst
 }
+
+// GetUint16 returns the value at the specified position of a uint16 block like Get.
+// Returns ErrNotLoaded, ErrPositionOutOfRange, or ErrInvalidBuffer if the block
+// is not marked as IntTypeUint16 or the value does not fit into uint16.
+func (r *SlimReader) GetUint16(pos int) (uint16, error) {
+	v, err := r.Get(pos)
+	if err != nil {
+		return 0, err
+	}
+	if intType := r.IntType(); intType != IntTypeUint16 {
+		return 0, errNotUint16(intType)
+	}
+	if v > 0xFFFF {
+		return 0, fmt.Errorf("%w: decoded value exceeds uint16", ErrInvalidBuffer)
+	}
+	return uint16(v), nil
+}
+
+// DecodeUint16 decodes all values of a uint16 block into dst (which will be
+// resized as needed). Returns ErrNotLoaded, or ErrInvalidBuffer if the block is
+// not marked as IntTypeUint16 or a value does not fit into uint16.
+func (r *SlimReader) DecodeUint16(dst []uint16) ([]uint16, error) {
+	if r.flags&slimFlagLoaded == 0 {
+		return nil, ErrNotLoaded
+	}
+	if intType := r.IntType(); intType != IntTypeUint16 {
+		return nil, errNotUint16(intType)
+	}
+	var values [2 * blockSize]uint32
+	return narrowUint16(dst, r.Decode(values[:0]))
+}
+
+// DecodeRange decodes the values at positions [start, end) into dst and returns
+// dst resized to end-start. Only the exceptions that are needed for the range are
+// decoded from the StreamVByte area, so the cost is proportional to the range size.
+// For non-delta data, only the values in the range are extracted. For delta data,
+// all values up to end are required for the prefix sum.
+// Returns ErrNotLoaded if the reader is not loaded, ErrPositionOutOfRange if
+// the range is invalid or ErrInvalidBuffer if the exception area is malformed.
+func (r *SlimReader) DecodeRange(dst []uint32, start, end int) ([]uint32, error) {
+	if r.flags&slimFlagLoaded == 0 {
+		return nil, ErrNotLoaded
+	}
+	if start < 0 || end > int(r.count) || start > end {
+		return nil, ErrPositionOutOfRange
+	}
+	n := end - start
+	if cap(dst) < n {
+		dst = make([]uint32, n)
+	} else {
+		dst = dst[:n]
+	}
+	if n == 0 {
+		return dst, nil
+	}
+
+	bitWidth := int(r.bitWidth)
+
+	if r.flags&slimFlagDelta == 0 {
+		if bitWidth == 0 {
+			clear(dst)
+		} else {
+			for i := range dst {
+				dst[i] = r.extractValue(uint32(start+i), bitWidth)
+			}
+		}
+		if r.flags&slimFlagExceptions != 0 {
+			if err := applyExceptionsRange(dst, r.buf[r.payloadEnd:], start, end, bitWidth); err != nil {
+				return nil, patchError(err)
+			}
+		}
+		if base := r.base(); base != 0 {
+			addBase(dst, base)
+		}
+		return dst, nil
+	}
+
+	// Delta data: decode the prefix [0, end)
+	var values [blockSize]uint32
+	if bitWidth > 0 {
+		unpackLanes(values[:r.count], r.buf[r.payloadOff:r.payloadEnd], int(r.count), bitWidth)
+	}
+	if r.flags&slimFlagExceptions != 0 {
+		if err := applyExceptionsRange(values[:end], r.buf[r.payloadEnd:], 0, end, bitWidth); err != nil {
+			return nil, patchError(err)
+		}
+	}
+
+	useZigZag := r.flags&slimFlagZigZag != 0
+	if r.flags&slimFlagWillOverflow != 0 {
+		overflowPos := deltaDecodeWithOverflow(values[:end], values[:end], useZigZag)
+		if r.overflowPos == 0 && overflowPos > 0 {
+			r.overflowPos = overflowPos
+		}
+	} else {
+		deltaDecodeMode(values[:end], DeltaMode(r.deltaMode), useZigZag)
+	}
+	copy(dst, values[start:end])
+	return dst, nil
+}
@@ -200,6 +200,193 @@ func TestSlimReaderDecode(t *testing.T) {
 	}
 }
 
+// TestSlimReaderDecodeRange tests DecodeRange against a full Decode.
+func TestSlimReaderDecodeRange(t *testing.T) {
+	assert := assert.New(t)
+
+	zigzagExc := make([]uint32, blockSize)
+	for i := range zigzagExc {
+		zigzagExc[i] = uint32(1000 + (i%3)*7 - (i%5)*11)
+		if i%17 == 0 {
+			zigzagExc[i] += 1 << 20
+		}
+	}
+	blocks := map[string][]byte{
+		"plain":           PackUint32(nil, genSequential(blockSize)),
+		"smallExceptions": PackUint32(nil, genDataWithSmallExceptions()),
+		"largeExceptions": PackUint32(nil, genDataWithLargeExceptions()),
+		"partial":         PackUint32(nil, genDataWithSmallExceptions()[:77]),
+		"delta":           PackDeltaUint32(nil, genMonotonic(blockSize)),
+		"deltaZigZagExc":  PackDeltaUint32(nil, zigzagExc),
+	}
+	for name, buf := range blocks {
+		t.Run(name, func(t *testing.T) {
+			reader, err := loadSlimReader(buf)
+			assert.NoError(err)
+			want := reader.Decode(nil)
+
+			n := reader.Len()
+			var dst []uint32
+			for start := 0; start <= n; start += 3 {
+				for end := start; end <= n; end += 5 {
+					dst, err = reader.DecodeRange(dst, start, end)
+					assert.NoError(err)
+					if start == end {
+						assert.Empty(dst)
+						continue
+					}
+					assert.Equal(want[start:end], dst, "range [%d,%d)", start, end)
+				}
+			}
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		reader := NewSlimReader()
+		_, err := reader.DecodeRange(nil, 0, 0)
+		assert.ErrorIs(err, ErrNotLoaded)
+
+		reader, err = loadSlimReader(PackUint32(nil, []uint32{1, 2, 3}))
+		assert.NoError(err)
+		_, err = reader.DecodeRange(nil, 0, 4)
+		assert.ErrorIs(err, ErrPositionOutOfRange)
+		_, err = reader.DecodeRange(nil, -1, 2)
+		assert.ErrorIs(err, ErrPositionOutOfRange)
+		_, err = reader.DecodeRange(nil, 2, 1)
+		assert.ErrorIs(err, ErrPositionOutOfRange)
+	})
+}
+
+// TestSlimReaderDecodeRangeMalformed verifies that a malformed exception area
+// TestSlimReaderMalformedPatch verifies that Load rejects a malformed exception
+// area instead of decoding unpatched values later.
+func TestSlimReaderMalformedPatch(t *testing.T) {
+	assert := assert.New(t)
+
+	for name, buf := range map[string][]byte{
+		"plain": PackUint32(nil, genDataWithLargeExceptions()),
+		"delta": PackDeltaUint32(nil, genMixed(blockSize)),
+	} {
+		reader := NewSlimReader()
+		assert.ErrorIs(reader.Load(buf[:len(buf)-2]), ErrInvalidBuffer, name)
+		assert.False(reader.IsLoaded(), name)
+		assert.NoError(reader.Load(buf), name)
+		assert.NotZero(reader.flags&slimFlagExceptions, name)
+	}
+}
+
+// TestSlimReaderLimitsAfterLoad verifies that tightening the decode limits after
+// Load does not strip the exceptions of a loaded block.
+func TestSlimReaderLimitsAfterLoad(t *testing.T) {
+	assert := assert.New(t)
+	t.Cleanup(func() { SetDecodeLimits(DecodeLimits{}) })
+
+	outliers := make([]uint32, blockSize)
+	for i := range outliers {
+		outliers[i] = uint32(i % 8)
+	}
+	outliers[10], outliers[50], outliers[100] = 1<<25, 1<<25, 1<<25
+
+	for name, values := range map[string][]uint32{
+		"plain":  outliers,
+		"runs":   genBurstyExceptions(),
+		"bitmap": genScatteredExceptions(blockSize),
+	} {
+		SetDecodeLimits(DecodeLimits{})
+		buf := PackUint32(nil, values)
+		reader := NewSlimReader()
+		assert.NoError(reader.Load(buf), name)
+		assert.NotZero(reader.flags&slimFlagExceptions, name)
+		SetDecodeLimits(DecodeLimits{MaxExceptions: 1})
+
+		v, err := reader.Get(100)
+		assert.NoError(err, name)
+		assert.Equal(values[100], v, name)
+		got, err := reader.GetMany([]int{100, 10, 50}, nil)
+		assert.NoError(err, name)
+		assert.Equal([]uint32{values[100], values[10], values[50]}, got, name)
+		assert.Equal(values, reader.Decode(nil), name)
+		for i, want := range values {
+			v, pos, ok := reader.Next()
+			assert.True(ok, name)
+			assert.Equal(uint8(i), pos, name)
+			assert.Equal(want, v, "%s: Next at %d", name, i)
+		}
+		reader.Reset()
+		v, pos, ok := reader.SkipTo(values[100])
+		assert.True(ok, name)
+		assert.Equal(values[100], v, name)
+		assert.LessOrEqual(int(pos), 100, name)
+
+		// A new Load checks the exception area against the tightened limits
+		var limitErr *ErrLimitExceeded
+		assert.ErrorAs(reader.Load(buf), &limitErr, name)
+	}
+}
+
+// TestSlimReaderGetMany verifies batched lookups against Decode with all kernel selections.
+func TestSlimReaderGetMany(t *testing.T) {
+	assert := assert.New(t)
+	defer SetCPUFeatures(SetCPUFeatures(DetectedCPUFeatures()))
+
+	blocks := map[string][]byte{
+		"plain":           PackUint32(nil, genMixed(blockSize)),
+		"zeros":           PackUint32(nil, make([]uint32, blockSize)),
+		"smallExceptions": PackUint32(nil, genDataWithSmallExceptions()),
+		"largeExceptions": PackUint32(nil, genDataWithLargeExceptions()),
+		"exceptionRuns":   PackUint32(nil, genBurstyExceptions()),
+		"partial":         PackUint32(nil, genDataWithSmallExceptions()[:77]),
+		"delta":           PackDeltaUint32(nil, genMonotonic(blockSize)),
+	}
+	for _, width := range []int{1, 7, 13, 31, 32} {
+		values := make([]uint32, blockSize)
+		for i := range values {
+			values[i] = uint32(uint64(i)*2654435761) & uint32(uint64(1)<<width-1)
+		}
+		blocks[fmt.Sprintf("width%d", width)] = PackUint32(nil, values)
+	}
+
+	for _, features := range []CPUFeatures{0, DetectedCPUFeatures()} {
+		SetCPUFeatures(features)
+		for name, buf := range blocks {
+			reader, err := loadSlimReader(buf)
+			assert.NoError(err)
+			want := reader.Decode(nil)
+
+			n := reader.Len()
+			positions := make([]int, 0, 3*n)
+			for i := range 3 * n {
+				positions = append(positions, (i*37)%n)
+			}
+			var dst []uint32
+			for _, k := range []int{0, 1, 8, 13, n, len(positions)} {
+				dst, err = reader.GetMany(positions[:k], dst)
+				assert.NoError(err)
+				assert.Len(dst, k)
+				for i, pos := range positions[:k] {
+					if want[pos] != dst[i] {
+						assert.Failf("wrong value", "%s %s k=%d pos=%d", features, name, k, pos)
+						break
+					}
+				}
+			}
+		}
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		reader := NewSlimReader()
+		_, err := reader.GetMany([]int{0}, nil)
+		assert.ErrorIs(err, ErrNotLoaded)
+
+		reader, err = loadSlimReader(PackUint32(nil, []uint32{1, 2, 3}))
+		assert.NoError(err)
+		_, err = reader.GetMany([]int{0, 3}, nil)
+		assert.ErrorIs(err, ErrPositionOutOfRange)
+		_, err = reader.GetMany([]int{-1}, nil)
+		assert.ErrorIs(err, ErrPositionOutOfRange)
+	})
+}
+
 // TestSlimReaderEmpty tests SlimReader with empty data.
 func TestSlimReaderEmpty(t *testing.T) {
 	assert := assert.New(t)
@@ -489,6 +676,65 @@ func BenchmarkSlimReaderDecode(b *testing.B) {
 	}
 }
 
+// BenchmarkSlimReaderGetMany compares batched lookups of scattered positions
+// with one Get call per position and a full decode.
+func BenchmarkSlimReaderGetMany(b *testing.B) {
+	packed := PackUint32(nil, genDataWithSmallExceptions())
+	reader, _ := loadSlimReader(packed)
+	positions := make([]int, 32)
+	for i := range positions {
+		positions[i] = (i * 37) % blockSize
+	}
+	dst := make([]uint32, len(positions))
+
+	b.Run("GetMany", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			dst, _ = reader.GetMany(positions, dst)
+		}
+	})
+	b.Run("GetManyScalar", func(b *testing.B) {
+		defer SetCPUFeatures(SetCPUFeatures(DetectedCPUFeatures() &^ CPUFeatureAVX2))
+		b.ReportAllocs()
+		for range b.N {
+			dst, _ = reader.GetMany(positions, dst)
+		}
+	})
+	b.Run("Get", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			for i, pos := range positions {
+				dst[i], _ = reader.Get(pos)
+			}
+		}
+	})
+	b.Run("Decode", func(b *testing.B) {
+		all := make([]uint32, 2*blockSize)
+		b.ReportAllocs()
+		for range b.N {
+			all = reader.Decode(all)
+			for i, pos := range positions {
+				dst[i] = all[pos]
+			}
+		}
+	})
+}
+
+// BenchmarkSlimReaderDecodeRange benchmarks decoding a small range of a block with exceptions.
+func BenchmarkSlimReaderDecodeRange(b *testing.B) {
+	packed := PackUint32(nil, genDataWithSmallExceptions())
+	reader, _ := loadSlimReader(packed)
+	dst := make([]uint32, 8)
+
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		start := (i * 8) % 120
+		_, _ = reader.DecodeRange(dst, start, start+8)
+	}
+}
+
 // BenchmarkLoadSlimReader benchmarks SlimReader creation.
 func BenchmarkLoadSlimReader(b *testing.B) {
 	values := make([]uint32, 128)
@@ -548,24 +794,24 @@ func BenchmarkSlimReaderNext(b *testing.B) {
 	}
 }
 
-// BenchmarkSlimReaderNextDelta benchmarks SlimReader.Next with delta data.
+// BenchmarkSlimReaderNextDelta benchmarks SlimReader.Next with delta data in
+// every delta mode.
 func BenchmarkSlimReaderNextDelta(b *testing.B) {
 	values := make([]uint32, 128)
 	for i := range values {
 		values[i] = uint32(i * 100)
 	}
-	valuesCopy := make([]uint32, len(values))
-	copy(valuesCopy, values)
-	packed := PackDeltaUint32(nil, valuesCopy)
-	reader, _ := loadSlimReader(packed)
-
-	b.ResetTimer()
-	b.ReportAllocs()
-
-	for i := 0; i < b.N; i++ {
-		if reader.Pos() >= reader.Len() {
-			reader.Reset()
-		}
-		_, _, _ = reader.Next()
+	for _, mode := range []DeltaMode{DeltaD1, DeltaD4, DeltaDM, DeltaDD} {
+		packed := PackDeltaUint32Mode(nil, values, mode)
+		reader, _ := loadSlimReader(packed)
+		b.Run(mode.String(), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if reader.Pos() >= reader.Len() {
+					reader.Reset()
+				}
+				_, _, _ = reader.Next()
+			}
+		})
 	}
 }
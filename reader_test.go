@@ -66,7 +66,7 @@ func ExampleloadReader_delta() {
 		panic(err)
 	}
 
-	// SkipTo finds the first value >= target (binary search)
+	// SkipTo finds the first value >= target (galloping search)
 	val, pos, ok := reader.SkipTo(300)
 	if ok {
 		fmt.Printf("SkipTo(300): pos=%d, val=%d\n", pos, val)
@@ -345,6 +345,38 @@ func TestReaderSkipToBinarySearch(t *testing.T) {
 	assert.False(ok)
 }
 
+// TestReaderSkipToGallop compares the galloping SkipTo on sorted data with a
+// linear scan for skips of all distances, including requests behind the cursor.
+func TestReaderSkipToGallop(t *testing.T) {
+	assert := assert.New(t)
+	rng := rand.New(rand.NewSource(4058))
+
+	for _, n := range []int{1, 2, 3, 77, blockSize} {
+		values := make([]uint32, n)
+		for i := 1; i < n; i++ {
+			values[i] = values[i-1] + uint32(rng.Intn(4)) // with duplicates
+		}
+		packed := PackDeltaUint32(nil, values)
+		gallop, err := loadReader(packed)
+		assert.NoError(err)
+		linear, err := loadReader(packed)
+		assert.NoError(err)
+		assert.True(gallop.IsSorted())
+
+		for req := uint32(0); req <= values[n-1]+2; req += uint32(rng.Intn(12)) {
+			want, wantPos, wantOK := linear.skipToLinear(req)
+			got, gotPos, gotOK := gallop.SkipTo(req)
+			assert.Equal(wantOK, gotOK, "n=%d req=%d", n, req)
+			assert.Equal(want, got, "n=%d req=%d", n, req)
+			assert.Equal(wantPos, gotPos, "n=%d req=%d", n, req)
+			assert.Equal(linear.pos, gallop.pos, "n=%d req=%d", n, req)
+		}
+		_, _, ok := gallop.SkipTo(values[n-1] + 1)
+		assert.False(ok, "n=%d: beyond the last value", n)
+		assert.Equal(n, gallop.pos, "n=%d", n)
+	}
+}
+
 // TestReaderSkipToFromBeginning tests SkipTo starting from position 0.
 func TestReaderSkipToFromBeginning(t *testing.T) {
 	assert := assert.New(t)
@@ -424,6 +456,99 @@ func TestReaderDecode(t *testing.T) {
 	assert.NotEqual(uint32(999), val, "Decode() should return a copy, not the internal slice")
 }
 
+// TestReaderDecodeRange tests DecodeRange against a full Decode, for eagerly and
+// lazily loaded blocks.
+func TestReaderDecodeRange(t *testing.T) {
+	assert := assert.New(t)
+
+	blocks := map[string][]byte{
+		"plain":      PackUint32(nil, genSequential(blockSize)),
+		"exceptions": PackUint32(nil, genDataWithLargeExceptions()),
+		"partial":    PackUint32(nil, genDataWithSmallExceptions()[:77]),
+		"delta":      PackDeltaUint32(nil, genMonotonic(blockSize)),
+		"frame":      PackFORUint32(nil, genMixed(blockSize)),
+	}
+	for name, buf := range blocks {
+		want, err := UnpackUint32(nil, buf)
+		assert.NoError(err)
+		var dst []uint32
+		for start := 0; start <= len(want); start += 3 {
+			for end := start; end <= len(want); end += 5 {
+				for _, lazy := range []bool{false, true} {
+					reader := NewReader()
+					if lazy {
+						assert.NoError(reader.LoadLazy(buf))
+					} else {
+						assert.NoError(reader.Load(buf))
+					}
+					dst, err = reader.DecodeRange(dst, start, end)
+					assert.NoError(err)
+					if start == end {
+						assert.Empty(dst)
+						continue
+					}
+					assert.Equal(want[start:end], dst, "%s range [%d,%d) lazy=%t", name, start, end, lazy)
+				}
+			}
+		}
+	}
+
+	reader := NewReader()
+	_, err := reader.DecodeRange(nil, 0, 0)
+	assert.ErrorIs(err, ErrNotLoaded)
+	assert.NoError(reader.Load(PackUint32(nil, []uint32{1, 2, 3})))
+	_, err = reader.DecodeRange(nil, 0, 4)
+	assert.ErrorIs(err, ErrPositionOutOfRange)
+	_, err = reader.DecodeRange(nil, -1, 2)
+	assert.ErrorIs(err, ErrPositionOutOfRange)
+	_, err = reader.DecodeRange(nil, 2, 1)
+	assert.ErrorIs(err, ErrPositionOutOfRange)
+}
+
+// TestReaderGetMany verifies batched lookups against Decode, for eagerly and
+// lazily loaded blocks.
+func TestReaderGetMany(t *testing.T) {
+	assert := assert.New(t)
+
+	positions := []int{3, 70, 12, 64, 3, 0, 76}
+	blocks := map[string][]byte{
+		"plain":      PackUint32(nil, genSequential(blockSize)),
+		"exceptions": PackUint32(nil, genDataWithLargeExceptions()),
+		"partial":    PackUint32(nil, genDataWithSmallExceptions()[:77]),
+		"delta":      PackDeltaUint32(nil, genMonotonic(blockSize)),
+		"frame":      PackFORUint32(nil, genMixed(blockSize)),
+	}
+	for name, buf := range blocks {
+		want, err := UnpackUint32(nil, buf)
+		assert.NoError(err)
+		for _, lazy := range []bool{false, true} {
+			for k := range len(positions) + 1 {
+				reader := NewReader()
+				if lazy {
+					assert.NoError(reader.LoadLazy(buf))
+				} else {
+					assert.NoError(reader.Load(buf))
+				}
+				got, err := reader.GetMany(positions[:k], nil)
+				assert.NoError(err)
+				assert.Len(got, k)
+				for i, pos := range positions[:k] {
+					assert.Equal(want[pos], got[i], "%s position %d lazy=%t", name, pos, lazy)
+				}
+			}
+		}
+	}
+
+	reader := NewReader()
+	_, err := reader.GetMany([]int{0}, nil)
+	assert.ErrorIs(err, ErrNotLoaded)
+	assert.NoError(reader.Load(PackUint32(nil, []uint32{1, 2, 3})))
+	_, err = reader.GetMany([]int{0, 3}, nil)
+	assert.ErrorIs(err, ErrPositionOutOfRange)
+	_, err = reader.GetMany([]int{-1}, nil)
+	assert.ErrorIs(err, ErrPositionOutOfRange)
+}
+
 // TestLoadReaderInvalidBuffer tests error handling for invalid buffers.
 func TestLoadReaderInvalidBuffer(t *testing.T) {
 	testCases := []struct {
@@ -490,7 +615,7 @@ func TestLoadReaderAutoDeltaDetect(t *testing.T) {
 		assert.Equal(want, got, "Get(%d)", i)
 	}
 
-	// SkipTo should use binary search since data is sorted
+	// SkipTo should gallop since data is sorted
 	val, pos, ok := reader.SkipTo(30)
 	assert.True(ok)
 	assert.Equal(uint32(35), val)
@@ -658,6 +783,159 @@ func TestReaderLoad(t *testing.T) {
 	}
 }
 
+// TestReaderLoadLazy verifies that lazily loaded blocks decode lane by lane and
+// match eagerly loaded blocks for every access.
+func TestReaderLoadLazy(t *testing.T) {
+	assert := assert.New(t)
+
+	blocks := map[string][]byte{
+		"plain":           PackUint32(nil, genMixed(blockSize)),
+		"zeros":           PackUint32(nil, make([]uint32, blockSize)),
+		"smallExceptions": PackUint32(nil, genDataWithSmallExceptions()),
+		"largeExceptions": PackUint32(nil, genDataWithLargeExceptions()),
+		"exceptionRuns":   PackUint32(nil, genBurstyExceptions()),
+		"partial":         PackUint32(nil, genDataWithSmallExceptions()[:77]),
+		"empty":           PackUint32(nil, nil),
+		"delta":           PackDeltaUint32(nil, genMonotonic(blockSize)),
+		"overflow":        PackAlreadyDeltaUint32(nil, []uint32{1, 2, 0xFFFFFFFF, 1}),
+	}
+	defer SetCPUFeatures(SetCPUFeatures(DetectedCPUFeatures()))
+	for _, features := range []CPUFeatures{0, DetectedCPUFeatures()} {
+		SetCPUFeatures(features)
+		for name, buf := range blocks {
+			eager, err := loadReader(buf)
+			assert.NoError(err)
+			want := eager.Decode(nil)
+
+			// Get decodes one lane at a time (scalar kernels) or the whole block
+			reader := NewReader()
+			assert.NoError(reader.LoadLazy(buf), name)
+			assert.Equal(eager.Len(), reader.Len())
+			assert.Equal(eager.IsSorted(), reader.IsSorted())
+			for i := reader.Len() - 1; i >= 0; i-- {
+				got, err := reader.Get(i)
+				assert.NoError(err)
+				assert.Equal(want[i], got, "%s Get(%d)", name, i)
+			}
+			assert.Nil(reader.buf, name)
+			assert.Equal(eager.OverflowPos(), reader.OverflowPos(), name)
+
+			// Other accesses decode the rest of a partially decoded block
+			assert.NoError(reader.LoadLazy(buf))
+			if reader.Len() > 5 {
+				got, err := reader.Get(5)
+				assert.NoError(err)
+				assert.Equal(want[5], got)
+				if reader.laneAccess {
+					assert.Equal(uint8(1<<1), reader.lanes, name)
+				}
+			}
+			assert.Equal(want, reader.Decode(nil), name)
+			assert.Nil(reader.buf, name)
+
+			for _, access := range []func(r *Reader) any{
+				func(r *Reader) any { v, p, ok := r.Next(); return []any{v, p, ok} },
+				func(r *Reader) any { v, p, ok := r.SkipTo(1000); return []any{v, p, ok} },
+				func(r *Reader) any { v, ok := r.Min(); return []any{v, ok} },
+				func(r *Reader) any { v, ok := r.Max(); return []any{v, ok} },
+				func(r *Reader) any { v, ok := r.Sum(); return []any{v, ok} },
+				func(r *Reader) any { v, ok := r.CountRange(100, 10000); return []any{v, ok} },
+			} {
+				assert.NoError(reader.LoadLazy(buf))
+				assert.Equal(access(eager), access(reader), name)
+				eager.Reset()
+			}
+		}
+	}
+
+	t.Run("reload", func(t *testing.T) {
+		reader := NewReader()
+		assert.NoError(reader.LoadLazy(PackUint32(nil, genMixed(blockSize))))
+		values := []uint32{10, 20, 30}
+		assert.NoError(reader.Load(PackUint32(nil, values)))
+		assert.Equal(values, reader.Decode(nil))
+
+		borrowed := []uint32{1, 2, 3}
+		reader = NewReaderFromValues(borrowed, true)
+		assert.NoError(reader.LoadLazy(PackUint32(nil, []uint32{7, 8, 9})))
+		assert.Equal([]uint32{7, 8, 9}, reader.Decode(nil))
+		assert.Equal([]uint32{1, 2, 3}, borrowed)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		reader := NewReader()
+		assert.ErrorIs(reader.LoadLazy(nil), ErrInvalidBuffer)
+
+		buf := PackUint32(nil, genDataWithLargeExceptions())
+		for _, cut := range []int{8, len(buf) - 1} {
+			assert.ErrorIs(reader.LoadLazy(buf[:cut]), ErrInvalidBuffer, "cut %d", cut)
+		}
+		_, err := reader.Get(0)
+		assert.ErrorIs(err, ErrNotLoaded)
+	})
+}
+
+// TestNewReaderFromValues tests a reader over already decoded values.
+func TestNewReaderFromValues(t *testing.T) {
+	assert := assert.New(t)
+
+	values := []uint32{10, 20, 30, 40, 50}
+	reader := NewReaderFromValues(values, true)
+	assert.True(reader.IsLoaded())
+	assert.True(reader.IsSorted())
+	assert.Equal(len(values), reader.Len())
+
+	got, err := reader.Get(2)
+	assert.NoError(err)
+	assert.Equal(uint32(30), got)
+
+	val, pos, ok := reader.SkipTo(35)
+	assert.True(ok)
+	assert.Equal(uint32(40), val)
+	assert.Equal(uint8(3), pos)
+
+	val, pos, ok = reader.Next()
+	assert.True(ok)
+	assert.Equal(uint32(50), val)
+	assert.Equal(uint8(4), pos)
+
+	_, _, ok = reader.Next()
+	assert.False(ok)
+
+	assert.Equal(values, reader.Decode(nil))
+
+	// The reader aliases values without copying
+	values[0] = 5
+	got, err = reader.Get(0)
+	assert.NoError(err)
+	assert.Equal(uint32(5), got)
+
+	// Loading a block must not overwrite the caller's values
+	assert.NoError(reader.Load(PackUint32(nil, []uint32{1, 2, 3})))
+	assert.Equal([]uint32{5, 20, 30, 40, 50}, values)
+	assert.Equal(3, reader.Len())
+	assert.False(reader.IsSorted())
+	got, err = reader.Get(2)
+	assert.NoError(err)
+	assert.Equal(uint32(3), got)
+
+	// Unsorted values use linear scan
+	reader = NewReaderFromValues([]uint32{7, 3, 9, 1}, false)
+	val, pos, ok = reader.SkipTo(8)
+	assert.True(ok)
+	assert.Equal(uint32(9), val)
+	assert.Equal(uint8(2), pos)
+
+	// Empty values
+	reader = NewReaderFromValues(nil, false)
+	assert.True(reader.IsLoaded())
+	assert.Zero(reader.Len())
+	_, _, ok = reader.SkipTo(0)
+	assert.False(ok)
+
+	assert.Panics(func() { NewReaderFromValues(make([]uint32, blockSize+1), false) })
+}
+
 // ----------------------------------------------------------------------------
 // Reader Benchmarks
 // ----------------------------------------------------------------------------
@@ -677,6 +955,34 @@ func BenchmarkLoadReader(b *testing.B) {
 	}
 }
 
+// BenchmarkLoadReaderLazy compares loading blocks with exceptions and reading
+// a single value, eagerly and lazily, with loading blocks that are never read.
+func BenchmarkLoadReaderLazy(b *testing.B) {
+	packed := PackUint32(nil, genDataWithSmallExceptions())
+	reader := NewReader()
+
+	b.Run("Load", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := range b.N {
+			_ = reader.Load(packed)
+			_, _ = reader.Get(i % blockSize)
+		}
+	})
+	b.Run("LoadLazy", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := range b.N {
+			_ = reader.LoadLazy(packed)
+			_, _ = reader.Get(i % blockSize)
+		}
+	})
+	b.Run("LoadLazyUntouched", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			_ = reader.LoadLazy(packed)
+		}
+	})
+}
+
 func BenchmarkLoadReaderDelta(b *testing.B) {
 	values := make([]uint32, 128)
 	for i := range values {
@@ -752,6 +1058,26 @@ func BenchmarkReaderSkipTo(b *testing.B) {
 	}
 }
 
+// BenchmarkReaderSkipToShort measures the short forward skips typical for
+// intersections, where every request lands a few positions ahead.
+func BenchmarkReaderSkipToShort(b *testing.B) {
+	values := make([]uint32, blockSize)
+	for i := range values {
+		values[i] = uint32(i * 10)
+	}
+	reader, _ := loadReader(PackDeltaUint32(nil, values))
+
+	b.ReportAllocs()
+	for range b.N {
+		reader.Reset()
+		for req := uint32(0); ; req += 25 {
+			if _, _, ok := reader.SkipTo(req); !ok {
+				break
+			}
+		}
+	}
+}
+
 func BenchmarkReaderDecode(b *testing.B) {
 	values := make([]uint32, 128)
 	for i := range values {
@@ -0,0 +1,93 @@
+package fastpfor
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DecodeSample records one sampled block decode.
+type DecodeSample struct {
+	BitWidth   int           // bit width of the payload
+	Exceptions int           // number of exceptions
+	Count      int           // number of values
+	Duration   time.Duration // time spent in the decoder
+}
+
+// DecodeSampler records every Nth block decoded by UnpackUint32,
+// UnpackUint32WithBuffer and UnpackUint32WithBufferAndLength (and the readers
+// and helpers built on them) into a ring buffer, so production systems can see
+// which blocks are expensive to decode without timing every block. Blocks that
+// are not sampled only cost an atomic increment.
+//
+// A DecodeSampler is safe for concurrent use.
+type DecodeSampler struct {
+	every uint64
+	calls atomic.Uint64
+
+	mu      sync.Mutex
+	samples []DecodeSample // ring buffer
+	next    int            // index of the next sample in samples
+	full    bool           // samples has wrapped around
+}
+
+// NewDecodeSampler creates a sampler recording every every-th decoded block and
+// keeping the last size samples. Panics if every or size is less than 1.
+func NewDecodeSampler(every, size int) *DecodeSampler {
+	if every < 1 || size < 1 {
+		panic("fastpfor: NewDecodeSampler: every and size must be positive")
+	}
+	return &DecodeSampler{
+		every:   uint64(every),
+		samples: make([]DecodeSample, size),
+	}
+}
+
+// decodeSampler holds the installed sampler, or nil if decodes are not sampled.
+var decodeSampler atomic.Pointer[DecodeSampler]
+
+// SetDecodeSampler installs s for all decoders and returns the previously
+// installed sampler. nil disables sampling:
+//
+//	defer fastpfor.SetDecodeSampler(fastpfor.SetDecodeSampler(sampler))
+func SetDecodeSampler(s *DecodeSampler) *DecodeSampler {
+	return decodeSampler.Swap(s)
+}
+
+// Samples appends the recorded samples to dst, oldest first, and returns the
+// extended slice.
+func (s *DecodeSampler) Samples(dst []DecodeSample) []DecodeSample {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.full {
+		dst = append(dst, s.samples[s.next:]...)
+	}
+	return append(dst, s.samples[:s.next]...)
+}
+
+// due counts a decode and reports whether it is to be sampled.
+func (s *DecodeSampler) due() bool {
+	return s.calls.Add(1)%s.every == 0
+}
+
+// record adds the sample of decoding the block at the start of buf. Blocks
+// with an invalid header are not recorded.
+func (s *DecodeSampler) record(buf []byte, d time.Duration) {
+	header, count, payloadStart, err := readHeader(buf)
+	if err != nil {
+		return
+	}
+	_, bitWidth, _, hasExceptions, _, _, _ := decodeHeader(header)
+	sample := DecodeSample{BitWidth: bitWidth, Count: count, Duration: d}
+	if payloadEnd := payloadStart + payloadBytes(bitWidth); hasExceptions && payloadEnd < len(buf) {
+		sample.Exceptions = int(buf[payloadEnd])
+	}
+
+	s.mu.Lock()
+	s.samples[s.next] = sample
+	s.next++
+	if s.next == len(s.samples) {
+		s.next, s.full = 0, true
+	}
+	s.mu.Unlock()
+}
@@ -0,0 +1,77 @@
+package fastpfor
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeSampler(t *testing.T) {
+	assert := assert.New(t)
+
+	plain := PackUint32(nil, genSequential(blockSize))
+	exceptions := PackUint32(nil, genDataWithSmallExceptions())
+	var buf []uint32
+
+	s := NewDecodeSampler(2, 3)
+	defer SetDecodeSampler(SetDecodeSampler(s))
+	for range 3 {
+		_, _ = UnpackUint32(buf[:0], plain)
+		_, _ = UnpackUint32(buf[:0], exceptions) // every second decode
+	}
+	samples := s.Samples(nil)
+	assert.Len(samples, 3)
+	for _, sample := range samples {
+		assert.Equal(getBitWidth(exceptions), sample.BitWidth)
+		assert.Equal(getExceptionCount(exceptions), sample.Exceptions)
+		assert.Equal(blockSize, sample.Count)
+		assert.GreaterOrEqual(sample.Duration, time.Duration(0))
+	}
+
+	// The ring buffer keeps the last samples, oldest first
+	s = NewDecodeSampler(1, 3)
+	SetDecodeSampler(s)
+	for n := 1; n <= 5; n++ {
+		_, _, _ = UnpackUint32WithLength(nil, PackUint32(nil, genSequential(n)))
+	}
+	var counts []int
+	for _, sample := range s.Samples(nil) {
+		counts = append(counts, sample.Count)
+	}
+	assert.Equal([]int{3, 4, 5}, counts)
+
+	// Invalid blocks are not recorded
+	_, err := UnpackUint32WithBuffer(nil, make([]uint32, blockSize), []byte{1})
+	assert.Error(err)
+	assert.Len(s.Samples(nil), 3)
+
+	assert.Same(s, SetDecodeSampler(nil))
+	_, _ = UnpackUint32(nil, plain)
+	assert.Len(s.Samples(nil), 3)
+
+	assert.Panics(func() { NewDecodeSampler(0, 1) })
+	assert.Panics(func() { NewDecodeSampler(1, 0) })
+}
+
+func TestDecodeSamplerConcurrent(t *testing.T) {
+	s := NewDecodeSampler(3, 16)
+	defer SetDecodeSampler(SetDecodeSampler(s))
+	block := PackDeltaUint32(nil, genMonotonic(blockSize))
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r := NewReader()
+			for range 300 {
+				_ = r.Load(block)
+				_ = s.Samples(nil)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Len(t, s.Samples(nil), 16)
+}
@@ -0,0 +1,197 @@
+package fastpfor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"time"
+)
+
+// ErrNonCanonical is returned by VerifyBlock if a block decodes, but is not encoded
+// the way this package would encode its values (e.g. flipped payload bits that
+// still decode, a wrong bit width or a newer format version with SetRelaxedHeaders).
+var ErrNonCanonical = errors.New("fastpfor: block is not in canonical form")
+
+// canonicalFlags are the header flags that are preserved when re-encoding a block
+// for the canonical-form check. The exception flag is derived from the values.
+const canonicalFlags = headerTypeMask<<headerTypeShift | headerDeltaFlag | headerZigZagFlag |
+	headerWillOverflowFlag | headerWideFlag | headerSignedFlag |
+	headerFloatFlag | headerFloat64Flag | headerDeltaModeMask<<headerDeltaModeShift
+
+// VerifyBlock fully decodes the block at the start of buf and checks that it is
+// in canonical form: re-encoding the stored (packed) values with the same header
+// flags must reproduce the block byte by byte. Trailing bytes after the block are
+// ignored. Returns ErrInvalidBuffer if the block cannot be decoded and
+// ErrNonCanonical if it decodes to different bytes.
+func VerifyBlock(buf []byte) error {
+	n, err := BlockLength(buf)
+	if err != nil {
+		return err
+	}
+	if len(buf) < n {
+		return fmt.Errorf("%w: buffer truncated (need %d bytes, got %d)", ErrInvalidBuffer, n, len(buf))
+	}
+	block := buf[:n]
+	header, count, payloadStart, err := readHeader(block)
+	if err != nil {
+		return err
+	}
+	_, bitWidth, _, hasExceptions, _, _, _ := decodeHeader(header)
+	if bitWidth > 32 {
+		return fmt.Errorf("%w: invalid bit width %d", ErrInvalidBuffer, bitWidth)
+	}
+
+	// Reconstruct the stored values (before delta decoding), with scratch space
+	// for applying and re-collecting exceptions.
+	var values [2 * blockSize]uint32
+	stored := values[:count]
+	payloadEnd := payloadStart + payloadBytes(bitWidth)
+	if bitWidth > 0 && count > 0 {
+		unpackLanes(stored, block[payloadStart:payloadEnd], count, bitWidth)
+	}
+	if hasExceptions {
+		if _, err := applyExceptions(stored, block, payloadEnd, count, bitWidth, values[blockSize:]); err != nil {
+			return patchError(err)
+		}
+	}
+
+	// Frame-of-reference blocks keep their base, which must be the smallest value,
+	// and blocks of identical values are constant blocks
+	codec := blockCodec(block, header)
+	if codec == codecFOR && count > 0 && (slices.Min(stored) != 0 || slices.Max(stored) == 0) {
+		return ErrNonCanonical
+	}
+	canonical := packInternalCodec(nil, stored, header&canonicalFlags, nil, nil, codec, frameBase(block, header))
+	if header&headerRangeFlag != 0 {
+		// Re-deriving the record also verifies it against the values
+		if canonical, err = SetBlockRange(nil, canonical); err != nil {
+			return err
+		}
+	}
+	if header&headerChecksumFlag != 0 {
+		// Re-deriving the record also verifies it against the values
+		if canonical, err = SetBlockChecksum(nil, canonical); err != nil {
+			return err
+		}
+	}
+	if header&headerProvenanceFlag != 0 {
+		p := decodeProvenance(block[payloadStart-headerProvenanceBytes : payloadStart])
+		if canonical, err = SetProvenance(nil, canonical, p); err != nil {
+			return err
+		}
+	}
+	if !bytes.Equal(canonical, block) {
+		return ErrNonCanonical
+	}
+	return nil
+}
+
+// Anomaly describes a block that failed verification.
+type Anomaly struct {
+	Block  int   // index of the block in the stream
+	Offset int   // byte offset of the block in the stream
+	Err    error // verification error (see VerifyBlock)
+}
+
+// Scrubber periodically verifies randomly sampled blocks of a stream of
+// concatenated blocks (e.g. a memory-mapped file) to detect silent corruption.
+// Every block that fails VerifyBlock is passed to the report function.
+//
+// A Scrubber is not safe for concurrent use.
+type Scrubber struct {
+	stream  []byte
+	offsets []int
+	report  func(Anomaly)
+	rng     *rand.Rand
+
+	checked   int
+	anomalies int
+}
+
+// NewScrubber indexes the blocks of stream and returns a Scrubber reporting
+// anomalies to report. Returns an error if the stream cannot be split into blocks.
+func NewScrubber(stream []byte, report func(Anomaly)) (*Scrubber, error) {
+	var offsets []int
+	for off := 0; off < len(stream); {
+		n, err := BlockLength(stream[off:])
+		if err != nil {
+			return nil, fmt.Errorf("block %d at offset %d: %w", len(offsets), off, err)
+		}
+		if off+n > len(stream) {
+			return nil, fmt.Errorf("%w: block %d at offset %d truncated", ErrInvalidBuffer, len(offsets), off)
+		}
+		offsets = append(offsets, off)
+		off += n
+	}
+	return &Scrubber{
+		stream:  stream,
+		offsets: offsets,
+		report:  report,
+		rng:     rand.New(rand.NewSource(time.Now().UnixNano())),
+	}, nil
+}
+
+// Len returns the number of blocks in the stream.
+func (s *Scrubber) Len() int {
+	return len(s.offsets)
+}
+
+// Checked returns the number of block verifications performed so far.
+func (s *Scrubber) Checked() int {
+	return s.checked
+}
+
+// Anomalies returns the number of failed block verifications so far.
+func (s *Scrubber) Anomalies() int {
+	return s.anomalies
+}
+
+// Check verifies the block with the given index and reports it if it fails.
+// Panics if block is out of range.
+func (s *Scrubber) Check(block int) error {
+	off := s.offsets[block]
+	s.checked++
+	err := VerifyBlock(s.stream[off:])
+	if err != nil {
+		s.anomalies++
+		if s.report != nil {
+			s.report(Anomaly{Block: block, Offset: off, Err: err})
+		}
+	}
+	return err
+}
+
+// Sample verifies n randomly chosen blocks (with replacement) and returns the
+// number of anomalies found.
+func (s *Scrubber) Sample(n int) int {
+	if len(s.offsets) == 0 {
+		return 0
+	}
+	found := 0
+	for range n {
+		if s.Check(s.rng.Intn(len(s.offsets))) != nil {
+			found++
+		}
+	}
+	return found
+}
+
+// Run samples the given rate of blocks every interval until ctx is done.
+// The rate is the fraction of blocks to verify per interval (at least one block
+// is verified per interval). Run returns ctx.Err().
+func (s *Scrubber) Run(ctx context.Context, interval time.Duration, rate float64) error {
+	perTick := max(1, int(rate*float64(len(s.offsets))))
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			s.Sample(perTick)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package fastpfor
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// scrubStream builds a stream covering all block kinds.
+func scrubStream() []byte {
+	var stream []byte
+	stream = PackUint32(stream, genSequential(blockSize))
+	stream = PackUint32(stream, genDataWithSmallExceptions())
+	stream = PackUint32(stream, genDataWithLargeExceptions())
+	stream = PackUint32(stream, nil)
+	stream = PackDeltaUint32(stream, genMonotonic(99))
+	stream = PackDeltaUint32(stream, genMixed(blockSize))
+	stream = PackAlreadyDeltaUint32(stream, []uint32{0xFFFFFFFF, 1, 2})
+	stream = PackUint16(stream, []uint16{1, 2, 65535})
+	stream = packInternal(stream, genDataWithLargeExceptions(), headerTypeUint32Flag|headerWideFlag)
+	stream = packInternal(stream, genSequential(50), headerTypeUint32Flag|headerWideFlag)
+	return stream
+}
+
+// TestVerifyBlock verifies that encoder output is canonical and corruption is detected.
+func TestVerifyBlock(t *testing.T) {
+	assert := assert.New(t)
+
+	stream := scrubStream()
+	for buf := stream; len(buf) > 0; {
+		assert.NoError(VerifyBlock(buf))
+		n, err := BlockLength(buf)
+		assert.NoError(err)
+		buf = buf[n:]
+	}
+
+	t.Run("payloadBitFlip", func(t *testing.T) {
+		buf := PackUint32(nil, genSequential(100))
+		// The last lane words only hold zero padding beyond count 100
+		buf[len(buf)-1] ^= 0x80
+		assert.ErrorIs(VerifyBlock(buf), ErrNonCanonical)
+	})
+
+	t.Run("reservedHeaderBit", func(t *testing.T) {
+		buf := PackUint32(nil, genSequential(blockSize))
+		bo.PutUint32(buf, bo.Uint32(buf)|1<<24)
+		assert.ErrorIs(VerifyBlock(buf), ErrUnsupportedFeature)
+
+		SetRelaxedHeaders(true)
+		defer SetRelaxedHeaders(false)
+		assert.ErrorIs(VerifyBlock(buf), ErrNonCanonical)
+	})
+
+	t.Run("widerThanNeeded", func(t *testing.T) {
+		// Hand-craft a width-8 block for values that fit into 7 bits
+		values := genSequential(blockSize)
+		buf := bo.AppendUint32(nil, encodeHeader(blockSize, 8, headerTypeUint32Flag))
+		buf = append(buf, make([]byte, payloadBytes(8))...)
+		packLanes(buf[headerBytes:], values, 8)
+		got, err := UnpackUint32(nil, buf)
+		assert.NoError(err)
+		assert.Equal(values, got)
+		assert.ErrorIs(VerifyBlock(buf), ErrNonCanonical)
+	})
+
+	t.Run("exceptionPosition", func(t *testing.T) {
+		buf := PackUint32(nil, genDataWithLargeExceptions())
+		_, payload, _, err := SplitEncoded(buf)
+		assert.NoError(err)
+		buf[headerBytes+len(payload)+3] = blockSize // first exception position
+		assert.ErrorIs(VerifyBlock(buf), ErrInvalidBuffer)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		buf := PackUint32(nil, genSequential(blockSize))
+		assert.ErrorIs(VerifyBlock(buf[:len(buf)-1]), ErrInvalidBuffer)
+	})
+}
+
+// TestScrubber verifies sampling, reporting and counters.
+func TestScrubber(t *testing.T) {
+	assert := assert.New(t)
+
+	stream := scrubStream()
+	var anomalies []Anomaly
+	s, err := NewScrubber(stream, func(a Anomaly) { anomalies = append(anomalies, a) })
+	assert.NoError(err)
+	assert.Equal(10, s.Len())
+
+	assert.Zero(s.Sample(100))
+	assert.Equal(100, s.Checked())
+	assert.Empty(anomalies)
+
+	// Corrupt the padding of the second delta block (99 values)
+	corrupt := slices.Clone(stream)
+	s, err = NewScrubber(corrupt, func(a Anomaly) { anomalies = append(anomalies, a) })
+	assert.NoError(err)
+	off := s.offsets[4]
+	n, err := BlockLength(corrupt[off:])
+	assert.NoError(err)
+	corrupt[off+n-1] ^= 0x01
+
+	for i := range s.Len() {
+		err := s.Check(i)
+		if i == 4 {
+			assert.ErrorIs(err, ErrNonCanonical)
+		} else {
+			assert.NoError(err)
+		}
+	}
+	assert.Equal(1, s.Anomalies())
+	if assert.Len(anomalies, 1) {
+		assert.Equal(4, anomalies[0].Block)
+		assert.Equal(off, anomalies[0].Offset)
+	}
+
+	t.Run("run", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		s, err := NewScrubber(stream, nil)
+		assert.NoError(err)
+		assert.ErrorIs(s.Run(ctx, time.Millisecond, 0.5), context.DeadlineExceeded)
+		assert.Greater(s.Checked(), 0)
+		assert.Zero(s.Anomalies())
+	})
+
+	t.Run("invalidStream", func(t *testing.T) {
+		_, err := NewScrubber(stream[:len(stream)-1], nil)
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		s, err := NewScrubber(nil, nil)
+		assert.NoError(err)
+		assert.Zero(s.Sample(10))
+	})
+}
+
+func BenchmarkVerifyBlock(b *testing.B) {
+	buf := PackUint32(nil, genDataWithSmallExceptions())
+	b.ReportAllocs()
+	for range b.N {
+		_ = VerifyBlock(buf)
+	}
+}
@@ -0,0 +1,173 @@
+package fastpfor
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// ErrIncompatibleSegment is returned when an Encoder appends to a segment that
+// was written with other options or another format version.
+var ErrIncompatibleSegment = errors.New("fastpfor: incompatible segment")
+
+// A segment holds concatenated blocks (see SequenceReader) behind a header that
+// records how they were encoded:
+//
+//	magic          4 bytes, "FPSG"
+//	version        1 byte, FormatVersion of the writer
+//	flags          1 byte, bit 0: NoExceptions, bit 1: ExactSize, bit 2: Adaptive
+//	maxExceptions  uvarint, EncodeOptions.MaxExceptions
+//	exceptionCost  varint, EncodeOptions.ExceptionCost
+//
+// The same values appended by Encoders with the same options and format version
+// always produce the same segment. With EncodeOptions.Adaptive, the Encoders must
+// also have packed the same blocks since their last Reset.
+const segmentMagic = "FPSG"
+
+const (
+	segmentNoExceptionsFlag = 1 << iota
+	segmentExactSizeFlag
+	segmentAdaptiveFlag
+	segmentFlagsMask = segmentNoExceptionsFlag | segmentExactSizeFlag | segmentAdaptiveFlag
+)
+
+// SegmentHeader describes the encoding of the blocks of a segment.
+type SegmentHeader struct {
+	// Version is the FormatVersion of the writer.
+	Version int
+	// Options are the normalized EncodeOptions of the writer (see StartSegment).
+	Options EncodeOptions
+}
+
+// StartSegment appends a segment header holding FormatVersion and the options of
+// the Encoder to dst. Append blocks with AppendSegment and AppendSegmentDelta and
+// read them back with ReadSegmentHeader and SequenceReader:
+//
+//	seg := enc.StartSegment(nil)
+//	seg, err := enc.AppendSegment(seg, values)
+//	...
+//	_, n, err := fastpfor.ReadSegmentHeader(seg)
+//	...
+//	err = r.Load(seg[n:]) // r is a SequenceReader
+//
+// The header stores the options in normalized form: options without any effect
+// on the width selection are stored as their defaults, so that Encoders whose
+// options select the same widths can append to the same segment.
+func (e *Encoder) StartSegment(dst []byte) []byte {
+	opts := e.Options.normalized()
+	var flags byte
+	if opts.NoExceptions {
+		flags |= segmentNoExceptionsFlag
+	}
+	if opts.ExactSize {
+		flags |= segmentExactSizeFlag
+	}
+	if opts.Adaptive {
+		flags |= segmentAdaptiveFlag
+	}
+	dst = append(dst, segmentMagic...)
+	dst = append(dst, FormatVersion, flags)
+	dst = binary.AppendUvarint(dst, uint64(opts.MaxExceptions))
+	return binary.AppendVarint(dst, int64(opts.ExceptionCost))
+}
+
+// AppendSegment packs values of any length into blocks like Pack and appends
+// them to the segment seg. All blocks but the last of a call hold 128 values.
+//
+// Returns ErrIncompatibleSegment and seg unchanged if seg was written with other
+// options or another format version than those of the Encoder, and the errors of
+// ReadSegmentHeader.
+func (e *Encoder) AppendSegment(seg []byte, values []uint32) ([]byte, error) {
+	if err := e.checkSegment(seg); err != nil {
+		return seg, err
+	}
+	for len(values) > 0 {
+		n := min(len(values), blockSize)
+		seg = e.Pack(seg, values[:n])
+		values = values[n:]
+	}
+	return seg, nil
+}
+
+// AppendSegmentDelta is like AppendSegment but packs the blocks like PackDeltaMode.
+func (e *Encoder) AppendSegmentDelta(seg []byte, values []uint32, mode DeltaMode) ([]byte, error) {
+	if err := e.checkSegment(seg); err != nil {
+		return seg, err
+	}
+	for len(values) > 0 {
+		n := min(len(values), blockSize)
+		seg = e.PackDeltaMode(seg, values[:n], mode)
+		values = values[n:]
+	}
+	return seg, nil
+}
+
+// checkSegment returns an error if the header of seg does not match the Encoder.
+func (e *Encoder) checkSegment(seg []byte) error {
+	h, _, err := ReadSegmentHeader(seg)
+	if err != nil {
+		return err
+	}
+	if h.Version != FormatVersion {
+		return fmt.Errorf("%w: format version %d, encoder writes %d", ErrIncompatibleSegment, h.Version, FormatVersion)
+	}
+	if opts := e.Options.normalized(); h.Options != opts {
+		return fmt.Errorf("%w: options %+v, encoder uses %+v", ErrIncompatibleSegment, h.Options, opts)
+	}
+	return nil
+}
+
+// ReadSegmentHeader reads the segment header at the start of buf and returns it
+// with its length, the offset of the first block.
+//
+// Returns ErrInvalidBuffer if buf does not start with a segment header and
+// ErrUnsupportedFeature if the header has unknown flags.
+func ReadSegmentHeader(buf []byte) (SegmentHeader, int, error) {
+	const fixedLen = len(segmentMagic) + 2
+	if len(buf) < fixedLen || string(buf[:len(segmentMagic)]) != segmentMagic {
+		return SegmentHeader{}, 0, fmt.Errorf("%w: missing segment header", ErrInvalidBuffer)
+	}
+	version, flags := buf[len(segmentMagic)], buf[len(segmentMagic)+1]
+	if flags&^segmentFlagsMask != 0 {
+		return SegmentHeader{}, 0, fmt.Errorf("%w: segment flags %#x", ErrUnsupportedFeature, flags)
+	}
+	off := fixedLen
+	maxExc, n := binary.Uvarint(buf[off:])
+	if n <= 0 || maxExc >= blockSize {
+		return SegmentHeader{}, 0, fmt.Errorf("%w: invalid segment header", ErrInvalidBuffer)
+	}
+	off += n
+	cost, n := binary.Varint(buf[off:])
+	if n <= 0 || int64(int(cost)) != cost {
+		return SegmentHeader{}, 0, fmt.Errorf("%w: invalid segment header", ErrInvalidBuffer)
+	}
+	off += n
+	h := SegmentHeader{
+		Version: int(version),
+		Options: EncodeOptions{
+			MaxExceptions: int(maxExc),
+			ExceptionCost: int(cost),
+			NoExceptions:  flags&segmentNoExceptionsFlag != 0,
+			ExactSize:     flags&segmentExactSizeFlag != 0,
+			Adaptive:      flags&segmentAdaptiveFlag != 0,
+		},
+	}
+	return h, off, nil
+}
+
+// normalized returns the options with the fields that do not affect the width
+// selection set to their defaults: MaxExceptions outside 1-127 does not limit
+// the up to 128 exceptions of a block, NoExceptions overrides all others and
+// ExactSize overrides Adaptive.
+func (o EncodeOptions) normalized() EncodeOptions {
+	if o.NoExceptions {
+		return EncodeOptions{NoExceptions: true}
+	}
+	if o.ExactSize {
+		o.Adaptive = false
+	}
+	if o.MaxExceptions <= 0 || o.MaxExceptions >= blockSize {
+		o.MaxExceptions = 0
+	}
+	return o
+}
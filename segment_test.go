@@ -0,0 +1,109 @@
+package fastpfor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSegment(t *testing.T) {
+	assert := assert.New(t)
+
+	values := genMonotonic(3*blockSize + 17)
+	for _, p := range []Profile{ProfileBalanced, ProfileStorage, ProfileLatency} {
+		enc := Encoder{Options: p.Options()}
+		seg := enc.StartSegment(nil)
+		seg, err := enc.AppendSegmentDelta(seg, values[:200], DeltaD1)
+		assert.NoError(err)
+		seg, err = enc.AppendSegment(seg, values[200:])
+		assert.NoError(err)
+
+		h, n, err := ReadSegmentHeader(seg)
+		assert.NoError(err)
+		assert.Equal(SegmentHeader{Version: FormatVersion, Options: p.Options()}, h)
+
+		r := NewSequenceReader()
+		assert.NoError(r.Load(seg[n:]))
+		got := make([]uint32, 0, len(values))
+		for v, _, ok := r.Next(); ok; v, _, ok = r.Next() {
+			got = append(got, v)
+		}
+		assert.Equal(values, got)
+
+		// Segments are reproducible
+		other := Encoder{Options: p.Options()}
+		again, err := other.AppendSegmentDelta(other.StartSegment(nil), values[:200], DeltaD1)
+		assert.NoError(err)
+		again, err = other.AppendSegment(again, values[200:])
+		assert.NoError(err)
+		assert.Equal(seg, again)
+	}
+}
+
+func TestSegmentIncompatible(t *testing.T) {
+	assert := assert.New(t)
+
+	values := genMixed(blockSize)
+	enc := Encoder{Options: EncodeOptions{MaxExceptions: 8}}
+	seg := enc.StartSegment(nil)
+
+	for _, opts := range []EncodeOptions{
+		{},
+		{MaxExceptions: 9},
+		{MaxExceptions: 8, ExceptionCost: 1},
+		{MaxExceptions: 8, ExactSize: true},
+		{MaxExceptions: 8, Adaptive: true},
+		{NoExceptions: true},
+	} {
+		other := Encoder{Options: opts}
+		got, err := other.AppendSegment(seg, values)
+		assert.ErrorIs(err, ErrIncompatibleSegment, "%+v", opts)
+		assert.Equal(seg, got)
+	}
+
+	// Options without effect on the width selection are compatible
+	var balanced Encoder
+	noLimit := Encoder{Options: EncodeOptions{MaxExceptions: blockSize}}
+	_, err := noLimit.AppendSegment(balanced.StartSegment(nil), values)
+	assert.NoError(err)
+	latency := Encoder{Options: ProfileLatency.Options()}
+	noExc := Encoder{Options: EncodeOptions{NoExceptions: true, ExceptionCost: 3}}
+	_, err = noExc.AppendSegment(latency.StartSegment(nil), values)
+	assert.NoError(err)
+
+	// Another format version
+	seg[len(segmentMagic)]++
+	_, err = enc.AppendSegment(seg, values)
+	assert.ErrorIs(err, ErrIncompatibleSegment)
+}
+
+func TestReadSegmentHeaderMalformed(t *testing.T) {
+	assert := assert.New(t)
+
+	enc := Encoder{Options: EncodeOptions{MaxExceptions: 100, ExceptionCost: -300, Adaptive: true}}
+	seg := enc.StartSegment(nil)
+	h, n, err := ReadSegmentHeader(seg)
+	assert.NoError(err)
+	assert.Equal(len(seg), n)
+	assert.Equal(enc.Options, h.Options)
+
+	for i := range seg {
+		_, _, err := ReadSegmentHeader(seg[:i])
+		assert.ErrorIs(err, ErrInvalidBuffer, "length %d", i)
+	}
+
+	bad := append([]byte(nil), seg...)
+	bad[0] = 'X'
+	_, _, err = ReadSegmentHeader(bad)
+	assert.ErrorIs(err, ErrInvalidBuffer)
+
+	bad = append([]byte(nil), seg...)
+	bad[len(segmentMagic)+1] |= 0x80
+	_, _, err = ReadSegmentHeader(bad)
+	assert.ErrorIs(err, ErrUnsupportedFeature)
+
+	bad = append([]byte(nil), seg...)
+	bad[len(segmentMagic)+2] = blockSize
+	_, _, err = ReadSegmentHeader(bad)
+	assert.ErrorIs(err, ErrInvalidBuffer)
+}
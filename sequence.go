@@ -0,0 +1,126 @@
+package fastpfor
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// A sequence frame holds an arbitrary number of values as concatenated blocks:
+//
+//	count   uvarint, total number of values
+//	blocks  ceil(count/128) blocks, all but the last holding 128 values
+//
+// The frame header lets UnpackAllUint32 size the result up front and detect
+// truncated frames.
+
+// PackAllUint32 encodes values of any length as a sequence frame and appends it
+// to dst. The values are split into blocks of 128 values, each packed like
+// PackUint32. The input slice is not mutated.
+func PackAllUint32(dst []byte, values []uint32) []byte {
+	return packAll(dst, values, PackUint32)
+}
+
+// PackAllDeltaUint32 is like PackAllUint32 but packs each block like
+// PackDeltaUint32, which suits sorted sequences. Every block is delta-encoded
+// independently, so blocks can be decoded without their predecessors.
+// The input slice is not mutated.
+func PackAllDeltaUint32(dst []byte, values []uint32) []byte {
+	return packAll(dst, values, PackDeltaUint32)
+}
+
+// PackUpTo packs the first min(len(values), 128) values like PackUint32 and
+// appends the block to dst. It returns the extended buffer and the number of
+// values consumed, so chunking loops can advance by it instead of slicing the
+// input into blocks themselves:
+//
+//	for len(values) > 0 {
+//		var n int
+//		dst, n = fastpfor.PackUpTo(dst, values)
+//		values = values[n:]
+//	}
+//
+// Without values, an empty block is appended and 0 is returned. The input
+// slice is not mutated: the capacity beyond the packed values is not used as
+// scratch space, so blocks with exceptions allocate it.
+func PackUpTo(dst []byte, values []uint32) ([]byte, int) {
+	n := min(len(values), blockSize)
+	return PackUint32(dst, values[:n:n]), n
+}
+
+// packAll writes the frame header and packs values block by block with pack.
+func packAll(dst []byte, values []uint32, pack func([]byte, []uint32) []byte) []byte {
+	dst = binary.AppendUvarint(dst, uint64(len(values)))
+	var scratch [2 * blockSize]uint32 // cap >= 256 keeps exception handling allocation-free
+	for len(values) > 0 {
+		n := min(len(values), blockSize)
+		copy(scratch[:n], values[:n])
+		values = values[n:]
+		dst = pack(dst, scratch[:n])
+	}
+	return dst
+}
+
+// UnpackAllUint32 decodes a sequence frame produced by PackAllUint32 or
+// PackAllDeltaUint32 into dst (which will be resized as needed) and returns the
+// number of bytes consumed from buf, so frames can be concatenated with other data.
+//
+// Returns ErrInvalidBuffer if the frame is truncated or its blocks do not match
+// the value count in the frame header.
+func UnpackAllUint32(dst []uint32, buf []byte) ([]uint32, int, error) {
+	count, numBlocks, off, err := readFrameHeader(buf)
+	if err != nil {
+		return nil, 0, err
+	}
+	if count == 0 {
+		if dst == nil {
+			return nil, off, nil
+		}
+		return dst[:0], off, nil
+	}
+
+	// Decode every block directly into dst, the last block needs a full block of capacity
+	dst = ensureUint32Cap(dst, count, numBlocks*blockSize)
+	for i := 0; i < count; i += blockSize {
+		n, err := unpackFrameBlock(dst, buf, off, i, count)
+		if err != nil {
+			return nil, 0, err
+		}
+		off += n
+	}
+	return dst[:count], off, nil
+}
+
+// readFrameHeader reads the value count of a sequence frame and returns it with
+// the number of blocks and the offset of the first block.
+func readFrameHeader(buf []byte) (count, numBlocks, off int, err error) {
+	count64, off := binary.Uvarint(buf)
+	if off <= 0 {
+		return 0, 0, 0, fmt.Errorf("%w: invalid sequence frame header", ErrInvalidBuffer)
+	}
+	blocks := (count64 + blockSize - 1) / blockSize
+	// Every block needs at least a header, reject bogus counts before allocating
+	if blocks > uint64(len(buf)-off)/headerBytes {
+		return 0, 0, 0, fmt.Errorf("%w: sequence frame truncated (%d values announced)",
+			ErrInvalidBuffer, count64)
+	}
+	if err := checkBlockLimit(int(blocks)); err != nil {
+		return 0, 0, 0, err
+	}
+	return int(count64), int(blocks), off, nil
+}
+
+// unpackFrameBlock decodes the block at buf[off:] into dst[i:] and checks that
+// it holds the values i up to min(i+blockSize, count) of the frame. It returns
+// the length of the block.
+func unpackFrameBlock(dst []uint32, buf []byte, off, i, count int) (int, error) {
+	want := min(count-i, blockSize)
+	values, n, err := UnpackUint32WithLength(dst[i:i:i+blockSize], buf[off:])
+	if err != nil {
+		return 0, err
+	}
+	if len(values) != want {
+		return 0, fmt.Errorf("%w: sequence block at offset %d holds %d values, want %d",
+			ErrInvalidBuffer, off, len(values), want)
+	}
+	return n, nil
+}
@@ -0,0 +1,180 @@
+package fastpfor
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Sequence64 provides random access to a monotonically non-decreasing sequence
+// of uint64 values (e.g. log sequence numbers or file offsets) encoded with
+// PackSequence64. The encoding is:
+//
+//	count   uvarint, total number of values
+//	blocks  ceil(count/128) times:
+//	  base  8 bytes (little-endian), the first value of the block
+//	  block delta block of the uint32 gaps between consecutive values
+//	        (the first gap is 0, see PackAlreadyDeltaUint32)
+//
+// The prefix sums of the gaps may wrap around uint32 within a block; as every
+// gap is below 2^32, each wrap shows up as a decrease of the decoded uint32 sum
+// and is carried into the upper 32 bits when reconstructing the values.
+//
+// Get decodes the block holding the requested position and keeps it, so
+// sequential and clustered lookups decode every block only once. A Sequence64
+// is not safe for concurrent use.
+type Sequence64 struct {
+	buf     []byte
+	count   int
+	bases   []uint64 // first value of each block
+	offsets []int    // byte offset of each block, plus the end offset
+
+	block   int // index of the block in values (-1 if none)
+	values  [blockSize]uint64
+	scratch [blockSize]uint32 // decoded prefix sums
+}
+
+// PackSequence64 encodes a monotonically non-decreasing sequence of uint64
+// values of any length and appends it to dst.
+//
+// Returns ErrValueOutOfRange if a value is smaller than its predecessor or
+// exceeds it by 2^32 or more, as gaps are stored as uint32.
+func PackSequence64(dst []byte, values []uint64) ([]byte, error) {
+	for i := 1; i < len(values); i++ {
+		if values[i] < values[i-1] || values[i]-values[i-1] > math.MaxUint32 {
+			return dst, fmt.Errorf("%w: gap between positions %d and %d is not in [0, 2^32)",
+				ErrValueOutOfRange, i-1, i)
+		}
+	}
+
+	dst = binary.AppendUvarint(dst, uint64(len(values)))
+	var gaps [2 * blockSize]uint32 // cap >= 256 keeps exception handling allocation-free
+	for len(values) > 0 {
+		n := min(len(values), blockSize)
+		gaps[0] = 0
+		for i := 1; i < n; i++ {
+			gaps[i] = uint32(values[i] - values[i-1])
+		}
+		dst = bo.AppendUint64(dst, values[0])
+		dst = PackAlreadyDeltaUint32(dst, gaps[:n])
+		values = values[n:]
+	}
+	return dst, nil
+}
+
+// NewSequence64 creates a Sequence64 over buf, as produced by PackSequence64.
+// All block headers and lengths are validated, but no payload is decoded.
+// The buffer must remain valid while the sequence is in use.
+func NewSequence64(buf []byte) (*Sequence64, error) {
+	count, off := binary.Uvarint(buf)
+	if off <= 0 {
+		return nil, fmt.Errorf("%w: invalid sequence header", ErrInvalidBuffer)
+	}
+	numBlocks := (count + blockSize - 1) / blockSize
+	// Every block needs at least a base and a header, reject bogus counts before allocating
+	if numBlocks > uint64(len(buf)-off)/(8+headerBytes) {
+		return nil, fmt.Errorf("%w: sequence truncated (%d values announced)", ErrInvalidBuffer, count)
+	}
+	if err := checkBlockLimit(int(numBlocks)); err != nil {
+		return nil, err
+	}
+
+	s := &Sequence64{
+		buf:     buf,
+		count:   int(count),
+		bases:   make([]uint64, numBlocks),
+		offsets: make([]int, numBlocks+1),
+		block:   -1,
+	}
+	for i := range s.bases {
+		if len(buf)-off < 8 {
+			return nil, fmt.Errorf("%w: sequence truncated at block %d", ErrInvalidBuffer, i)
+		}
+		s.bases[i] = bo.Uint64(buf[off:])
+		off += 8
+		_, n, _, err := readHeader(buf[off:])
+		if err != nil {
+			return nil, err
+		}
+		want := min(s.count-i*blockSize, blockSize)
+		if n != want {
+			return nil, fmt.Errorf("%w: sequence block %d holds %d values, want %d",
+				ErrInvalidBuffer, i, n, want)
+		}
+		length, err := BlockLength(buf[off:])
+		if err != nil {
+			return nil, err
+		}
+		if len(buf)-off < length {
+			return nil, fmt.Errorf("%w: sequence truncated at block %d", ErrInvalidBuffer, i)
+		}
+		s.offsets[i] = off
+		off += length
+	}
+	s.offsets[numBlocks] = off
+	s.buf = buf[:off]
+	return s, nil
+}
+
+// Len returns the number of values in the sequence.
+func (s *Sequence64) Len() int {
+	return s.count
+}
+
+// EncodedLen returns the number of bytes of the encoded sequence.
+func (s *Sequence64) EncodedLen() int {
+	return len(s.buf)
+}
+
+// Get returns the value at position pos.
+// Returns ErrPositionOutOfRange if pos is outside the sequence and an error if
+// the block holding pos cannot be decoded.
+func (s *Sequence64) Get(pos int) (uint64, error) {
+	if pos < 0 || pos >= s.count {
+		return 0, ErrPositionOutOfRange
+	}
+	block := pos / blockSize
+	if err := s.loadBlock(block); err != nil {
+		return 0, err
+	}
+	return s.values[pos%blockSize], nil
+}
+
+// Decode appends all values of the sequence to dst.
+func (s *Sequence64) Decode(dst []uint64) ([]uint64, error) {
+	for block := range s.bases {
+		if err := s.loadBlock(block); err != nil {
+			return dst, err
+		}
+		n := min(s.count-block*blockSize, blockSize)
+		dst = append(dst, s.values[:n]...)
+	}
+	return dst, nil
+}
+
+// loadBlock decodes block i into s.values, unless it is decoded already.
+func (s *Sequence64) loadBlock(i int) error {
+	if s.block == i {
+		return nil
+	}
+	s.block = -1
+	sums, err := UnpackUint32(s.scratch[:0], s.buf[s.offsets[i]:s.offsets[i+1]])
+	if err != nil {
+		// Wrapped prefix sums are expected, they are carried below
+		var overflow *ErrOverflow
+		if !errors.As(err, &overflow) {
+			return err
+		}
+	}
+	var high, prev uint64
+	for j, sum := range sums {
+		if uint64(sum) < prev {
+			high += 1 << 32
+		}
+		prev = uint64(sum)
+		s.values[j] = s.bases[i] + high + prev
+	}
+	s.block = i
+	return nil
+}
@@ -0,0 +1,99 @@
+package fastpfor
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// genMonotonic64 generates n non-decreasing uint64 values starting at start,
+// with gaps up to maxGap.
+func genMonotonic64(rng *rand.Rand, n int, start, maxGap uint64) []uint64 {
+	values := make([]uint64, n)
+	v := start
+	for i := range values {
+		values[i] = v
+		v += uint64(rng.Int63n(int64(maxGap) + 1))
+	}
+	return values
+}
+
+func TestSequence64(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+
+	for name, values := range map[string][]uint64{
+		"empty":        nil,
+		"single":       {math.MaxUint64},
+		"smallGaps":    genMonotonic64(rng, 1000, 1<<40, 16),
+		"wrappingGaps": genMonotonic64(rng, 700, 0, math.MaxUint32), // prefix sums wrap in every block
+		"maxGaps":      {0, math.MaxUint32, 2 * math.MaxUint32, 2 * math.MaxUint32, 3 * math.MaxUint32},
+		"constant":     make([]uint64, 300),
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert := assert.New(t)
+			buf, err := PackSequence64([]byte{0xAA}, values)
+			assert.NoError(err)
+
+			s, err := NewSequence64(append(buf[1:], 0xFF))
+			assert.NoError(err)
+			assert.Equal(len(values), s.Len())
+			assert.Equal(len(buf)-1, s.EncodedLen())
+
+			decoded, err := s.Decode(nil)
+			assert.NoError(err)
+			assert.Equal(len(values), len(decoded))
+			if len(values) > 0 {
+				assert.Equal(values, decoded)
+			}
+
+			for range 100 {
+				if len(values) == 0 {
+					break
+				}
+				pos := rng.Intn(len(values))
+				v, err := s.Get(pos)
+				assert.NoError(err)
+				assert.Equal(values[pos], v, "Get(%d)", pos)
+			}
+			_, err = s.Get(len(values))
+			assert.ErrorIs(err, ErrPositionOutOfRange)
+		})
+	}
+
+	t.Run("notMonotonic", func(t *testing.T) {
+		_, err := PackSequence64(nil, []uint64{5, 4})
+		assert.ErrorIs(t, err, ErrValueOutOfRange)
+		_, err = PackSequence64(nil, []uint64{0, math.MaxUint32 + 1})
+		assert.ErrorIs(t, err, ErrValueOutOfRange)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		buf, err := PackSequence64(nil, genMonotonic64(rng, 300, 0, 1000))
+		assert.NoError(t, err)
+		for _, cut := range []int{0, 1, 9, len(buf) - 1} {
+			_, err := NewSequence64(buf[:cut])
+			assert.ErrorIs(t, err, ErrInvalidBuffer, "cut=%d", cut)
+		}
+	})
+}
+
+func BenchmarkSequence64Get(b *testing.B) {
+	rng := rand.New(rand.NewSource(1))
+	values := genMonotonic64(rng, 64*blockSize, 1<<40, 1<<20)
+	buf, _ := PackSequence64(nil, values)
+	s, _ := NewSequence64(buf)
+	b.Run("sequential", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := range b.N {
+			_, _ = s.Get(i % len(values))
+		}
+	})
+	b.Run("random", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := range b.N {
+			_, _ = s.Get((i * 7919) % len(values))
+		}
+	})
+}
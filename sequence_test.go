@@ -0,0 +1,128 @@
+package fastpfor
+
+import (
+	"encoding/binary"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPackAllUint32(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, n := range []int{0, 1, 127, 128, 129, 1000, 4 * blockSize} {
+		mixed := genMixed(n)
+		sorted := genMonotonic(n)
+		for name, pack := range map[string]func([]byte, []uint32) []byte{
+			"plain": PackAllUint32,
+			"delta": PackAllDeltaUint32,
+		} {
+			for _, values := range [][]uint32{mixed, sorted} {
+				orig := slices.Clone(values)
+				buf := pack([]byte{0xAA}, values)
+				assert.Equal(orig, values, "input should not be mutated")
+				assert.Equal(byte(0xAA), buf[0])
+
+				got, consumed, err := UnpackAllUint32(nil, append(buf[1:], 0xFF))
+				assert.NoError(err)
+				assert.Equal(len(buf)-1, consumed, "%s n=%d", name, n)
+				assert.Equal(len(values), len(got))
+				if n > 0 {
+					assert.Equal(values, got, "%s n=%d", name, n)
+				}
+			}
+		}
+	}
+
+	t.Run("reuseDst", func(t *testing.T) {
+		values := genMixed(300)
+		dst := make([]uint32, 0, 3*blockSize)
+		got, _, err := UnpackAllUint32(dst, PackAllUint32(nil, values))
+		assert.NoError(err)
+		assert.Equal(values, got)
+		assert.Same(&dst[:1][0], &got[0])
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		buf := PackAllUint32(nil, genMixed(300))
+		for _, cut := range []int{0, 1, 5, len(buf) - 1} {
+			_, _, err := UnpackAllUint32(nil, buf[:cut])
+			assert.ErrorIs(err, ErrInvalidBuffer, "cut=%d", cut)
+		}
+	})
+
+	t.Run("countMismatch", func(t *testing.T) {
+		buf := binary.AppendUvarint(nil, 200)
+		buf = PackUint32(buf, genSequential(blockSize))
+		buf = PackUint32(buf, genSequential(blockSize)) // 128 instead of 72 values
+		_, _, err := UnpackAllUint32(nil, buf)
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+
+	t.Run("hugeCount", func(t *testing.T) {
+		buf := binary.AppendUvarint(nil, 1<<62)
+		_, _, err := UnpackAllUint32(nil, PackUint32(buf, genSequential(blockSize)))
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+}
+
+func BenchmarkUnpackAllUint32(b *testing.B) {
+	buf := PackAllDeltaUint32(nil, genMonotonic(16*blockSize))
+	dst := make([]uint32, 0, 16*blockSize)
+	b.ReportAllocs()
+	for range b.N {
+		dst, _, _ = UnpackAllUint32(dst[:0], buf)
+	}
+}
+
+// TestPackUpTo verifies chunking loops over PackUpTo produce one block per 128
+// values and leave the input untouched.
+func TestPackUpTo(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, n := range []int{1, 127, 128, 129, 256, 1000} {
+		values := genMixed(n)
+		orig := slices.Clone(values)
+		var buf []byte
+		var blocks int
+		for rest := values; len(rest) > 0; blocks++ {
+			var consumed int
+			buf, consumed = PackUpTo(buf, rest)
+			assert.Equal(min(len(rest), blockSize), consumed)
+			rest = rest[consumed:]
+		}
+		assert.Equal(orig, values, "input should not be mutated")
+		assert.Equal((n+blockSize-1)/blockSize, blocks)
+
+		var got []uint32
+		for off := 0; off < len(buf); {
+			block, err := UnpackUint32(nil, buf[off:])
+			assert.NoError(err)
+			length, err := BlockLength(buf[off:])
+			assert.NoError(err)
+			got = append(got, block...)
+			off += length
+		}
+		assert.Equal(values, got, "n=%d", n)
+	}
+
+	buf, consumed := PackUpTo([]byte{0xAA}, nil)
+	assert.Zero(consumed)
+	got, err := UnpackUint32(nil, buf[1:])
+	assert.NoError(err)
+	assert.Empty(got)
+
+	// The values after the block are left alone, even with exceptions
+	values := append(genDataWithLargeExceptions(), 1, 2, 3)
+	orig := slices.Clone(values)
+	buf, consumed = PackUpTo(nil, values)
+	assert.Equal(blockSize, consumed)
+	assert.Equal(orig, values)
+
+	values = genSequential(3 * blockSize)
+	allocs := testing.AllocsPerRun(10, func() {
+		buf, _ = PackUpTo(buf[:0], values)
+	})
+	assert.Zero(allocs)
+}
@@ -13,15 +13,58 @@ const (
 	maxPayloadBytes = 32 * 16
 )
 
-func initSIMDSelection() {
+// detectCPUFeatures returns the CPU features relevant for kernel selection.
+func detectCPUFeatures() CPUFeatures {
+	var f CPUFeatures
 	if cpu.X86.HasSSE2 {
+		f |= CPUFeatureSSE2
+	}
+	if cpu.X86.HasAVX2 {
+		f |= CPUFeatureAVX2
+	}
+	if cpu.X86.HasAVX512F {
+		f |= CPUFeatureAVX512
+	}
+	return f
+}
+
+// initSIMDSelection installs the SIMD kernels supported by features. The scalar
+// kernels must be installed before (see selectKernels).
+func initSIMDSelection(features CPUFeatures) {
+	if features&CPUFeatureAVX2 != 0 {
+		gatherLanes = gatherLanesAVX2Preferred
+	}
+	if features&CPUFeatureSSE2 != 0 {
 		packLanes = packLanesSIMDPreferred
+		packLanesExceptions = packLanesExceptionsSIMDPreferred
 		unpackLanes = unpackLanesSIMDPreferred
 		deltaEncode = deltaEncodeSIMD
 		// Auto-select decode strategy based on alignment.
 		deltaDecode = deltaDecodeAuto
 		deltaDecodeWithOverflow = deltaDecodeWithOverflowSIMD
+		deltaDecodeD4 = deltaDecodeD4SIMD
+		collectExceptions = collectExceptionsSIMD
+		zigzagEncodeBlock = zigzagEncodeSIMD
+		zigzagDecodeBlock = zigzagDecodeSIMD
 		simdAvailable = true
+		packKernels, unpackKernels = packKernelsSSE2(), unpackKernelsSSE2()
+		unalignedKernels = false
+		if features&CPUFeatureAVX2 != 0 {
+			packKernels, unpackKernels = packKernelsAVX2(), unpackKernelsAVX2()
+			unpackDeltaKernels = unpackDeltaKernelsAVX2()
+			unpackDeltaLanes = unpackDeltaLanesSIMDPreferred
+			unalignedKernels = true
+		}
+		if features&CPUFeatureAVX512 != 0 {
+			packKernels, unpackKernels = packKernelsAVX512(), unpackKernelsAVX512()
+			unpackDeltaKernels = unpackDeltaKernelsAVX512()
+			unpackDeltaLanes = unpackDeltaLanesSIMDPreferred
+			unalignedKernels = true
+			deltaDecode = deltaDecodeAVX512Preferred
+			deltaDecodeWithOverflow = deltaDecodeWithOverflowAVX512Preferred
+			zigzagEncodeBlock = zigzagEncodeAVX512Preferred
+			zigzagDecodeBlock = zigzagDecodeAVX512Preferred
+		}
 		return
 	}
 }
@@ -222,17 +265,75 @@ func unpack32_32(in *byte, out uintptr, offset int, seed *byte)
 
 var zeroSeed byte
 
+// Bit packing kernels by bit width, called through the trampolines below. A
+// table of addresses replaces a switch over all widths; a variant for another
+// instruction set only needs its own table (see dispatch_amd64.s).
+var (
+	packKernels   = packKernelsSSE2()
+	unpackKernels = unpackKernelsSSE2()
+)
+
+// unpackDeltaKernels are the fused unpack and delta decode kernels by bit width
+// (see unpackDeltaLanes). The SSE2 tier has none.
+var unpackDeltaKernels *[33]uintptr
+
+// Kernel tables and trampolines provided by dispatch_amd64.s. The trampolines
+// jump to kernel, so unlike calls through function values the arguments don't
+// escape and the stack buffers of simdPack and simdUnpack stay on the stack.
+
+func packKernelsSSE2() *[33]uintptr
+
+func unpackKernelsSSE2() *[33]uintptr
+
+func packKernelsAVX2() *[33]uintptr
+
+func unpackKernelsAVX2() *[33]uintptr
+
+func unpackDeltaKernelsAVX2() *[33]uintptr
+
+func packKernelsAVX512() *[33]uintptr
+
+func unpackKernelsAVX512() *[33]uintptr
+
+func unpackDeltaKernelsAVX512() *[33]uintptr
+
+//go:noescape
+func pack32Call(in uintptr, out *byte, offset int, seed *byte, kernel uintptr)
+
+//go:noescape
+func unpack32Call(in *byte, out uintptr, offset int, seed *byte, kernel uintptr)
+
 func packLanesSIMDPreferred(dst []byte, values []uint32, bitWidth int) {
 	if !simdPack(dst, values, bitWidth) {
 		packLanesScalar(dst, values, bitWidth)
 	}
 }
 
+// packLanesExceptionsSIMDPreferred computes the exception bitmap with one
+// vectorized pass, which also tells simdPack whether the values need masking.
+func packLanesExceptionsSIMDPreferred(dst []byte, values []uint32, bitWidth int) [2]uint64 {
+	if bitWidth >= 32 || len(values) == 0 || len(values) > blockSize {
+		return packLanesExceptionsScalar(dst, values, bitWidth)
+	}
+	mask := exceptionMaskSIMD(values, bitWidth)
+	if bitWidth > 0 && !simdPackValues(dst, values, bitWidth, mask == [2]uint64{}) {
+		packLanesScalar(dst, values, bitWidth)
+	}
+	return mask
+}
+
 // simdPack encodes up to 128 uint32 values (zero-filled) into dst using SIMD bit packing.
 // dst must have space for bitWidth*16 bytes (same as scalar payload).
-// Note: We use a switch instead of a dispatch table to allow the compiler to prove
-// that the stack-allocated buffers don't escape (function pointers break escape analysis).
+//
+// The kernels read and write the caller's slices directly when the kernels
+// accept their alignment (see kernelAligned). Only partial blocks and values
+// exceeding bitWidth (exceptions) are masked into an aligned copy first.
 func simdPack(dst []byte, values []uint32, bitWidth int) bool {
+	return simdPackValues(dst, values, bitWidth, len(values) == blockSize && !exceedsBitWidth(values, bitWidth))
+}
+
+// simdPackValues is simdPack for values known to fit into bitWidth (fit) or not.
+func simdPackValues(dst []byte, values []uint32, bitWidth int, fit bool) bool {
 	if bitWidth <= 0 || bitWidth > 32 || len(values) > blockSize {
 		return false
 	}
@@ -241,105 +342,50 @@ func simdPack(dst []byte, values []uint32, bitWidth int) bool {
 		return false
 	}
 
-	var valueStorage [blockSize + 4]uint32
-	valuesBuf := alignedUint32Slice(&valueStorage)
-	// Precompute mask; avoid shift overflow when bitWidth == 32
-	var mask uint32 = 0xFFFFFFFF
-	if bitWidth < 32 {
-		mask = (1 << bitWidth) - 1
-	}
-	for i, v := range values {
-		valuesBuf[i] = v & mask
-	}
-	var payloadStorage [maxPayloadBytes + 16]byte
-	payloadBuf := alignedByteSlice(&payloadStorage)
-
-	inPtr := uintptr(unsafe.Pointer(&valuesBuf[0]))
-	outPtr := &payloadBuf[0]
-
-	// Hot path: Common bit widths (4-12) are most frequent in real data.
-	// This helps branch prediction by checking likely cases first.
-	if bitWidth >= 4 && bitWidth <= 12 {
-		switch bitWidth {
-		case 8:
-			pack32_8(inPtr, outPtr, 0, &zeroSeed)
-		case 4:
-			pack32_4(inPtr, outPtr, 0, &zeroSeed)
-		case 7:
-			pack32_7(inPtr, outPtr, 0, &zeroSeed)
-		case 6:
-			pack32_6(inPtr, outPtr, 0, &zeroSeed)
-		case 5:
-			pack32_5(inPtr, outPtr, 0, &zeroSeed)
-		case 10:
-			pack32_10(inPtr, outPtr, 0, &zeroSeed)
-		case 9:
-			pack32_9(inPtr, outPtr, 0, &zeroSeed)
-		case 11:
-			pack32_11(inPtr, outPtr, 0, &zeroSeed)
-		case 12:
-			pack32_12(inPtr, outPtr, 0, &zeroSeed)
+	in := values
+	if len(values) < blockSize || !kernelAligned(unsafe.Pointer(&values[0])) || !fit {
+		var valueStorage [blockSize + 4]uint32
+		in = alignedUint32Slice(&valueStorage)
+		// Precompute mask; avoid shift overflow when bitWidth == 32
+		var mask uint32 = 0xFFFFFFFF
+		if bitWidth < 32 {
+			mask = (1 << bitWidth) - 1
+		}
+		for i, v := range values {
+			in[i] = v & mask
 		}
-		copy(dst[:needed], payloadBuf[:needed])
-		return true
 	}
-
-	// Cold path: Less common bit widths
-	switch bitWidth {
-	case 1:
-		pack32_1(inPtr, outPtr, 0, &zeroSeed)
-	case 2:
-		pack32_2(inPtr, outPtr, 0, &zeroSeed)
-	case 3:
-		pack32_3(inPtr, outPtr, 0, &zeroSeed)
-	case 13:
-		pack32_13(inPtr, outPtr, 0, &zeroSeed)
-	case 14:
-		pack32_14(inPtr, outPtr, 0, &zeroSeed)
-	case 15:
-		pack32_15(inPtr, outPtr, 0, &zeroSeed)
-	case 16:
-		pack32_16(inPtr, outPtr, 0, &zeroSeed)
-	case 17:
-		pack32_17(inPtr, outPtr, 0, &zeroSeed)
-	case 18:
-		pack32_18(inPtr, outPtr, 0, &zeroSeed)
-	case 19:
-		pack32_19(inPtr, outPtr, 0, &zeroSeed)
-	case 20:
-		pack32_20(inPtr, outPtr, 0, &zeroSeed)
-	case 21:
-		pack32_21(inPtr, outPtr, 0, &zeroSeed)
-	case 22:
-		pack32_22(inPtr, outPtr, 0, &zeroSeed)
-	case 23:
-		pack32_23(inPtr, outPtr, 0, &zeroSeed)
-	case 24:
-		pack32_24(inPtr, outPtr, 0, &zeroSeed)
-	case 25:
-		pack32_25(inPtr, outPtr, 0, &zeroSeed)
-	case 26:
-		pack32_26(inPtr, outPtr, 0, &zeroSeed)
-	case 27:
-		pack32_27(inPtr, outPtr, 0, &zeroSeed)
-	case 28:
-		pack32_28(inPtr, outPtr, 0, &zeroSeed)
-	case 29:
-		pack32_29(inPtr, outPtr, 0, &zeroSeed)
-	case 30:
-		pack32_30(inPtr, outPtr, 0, &zeroSeed)
-	case 31:
-		pack32_31(inPtr, outPtr, 0, &zeroSeed)
-	case 32:
-		pack32_32(inPtr, outPtr, 0, &zeroSeed)
-	default:
-		return false
+	out := dst
+	if !kernelAligned(unsafe.Pointer(&dst[0])) {
+		var payloadStorage [maxPayloadBytes + 16]byte
+		out = alignedByteSlice(&payloadStorage)
 	}
 
-	copy(dst[:needed], payloadBuf[:needed])
+	pack32Call(uintptr(unsafe.Pointer(&in[0])), &out[0], 0, &zeroSeed, packKernels[bitWidth])
+	if &out[0] != &dst[0] {
+		copy(dst[:needed], out[:needed])
+	}
 	return true
 }
 
+// exceedsBitWidth reports whether any of the 128 values needs more than bitWidth bits.
+func exceedsBitWidth(values []uint32, bitWidth int) bool {
+	if bitWidth >= 32 {
+		return false
+	}
+	if isAligned16Uint32(&values[0]) {
+		return int(maxBits128_32(&values[0], 0, &zeroSeed)) > bitWidth
+	}
+	lo, hi := exceptionMaskSIMDAsm(&values[0], blockSize, uint32(1)<<bitWidth-1)
+	return lo|hi != 0
+}
+
+// maxBits128_32 returns the bit width of the largest of 128 16-byte aligned
+// values (provided by maxbits_amd64.s).
+//
+//go:noescape
+func maxBits128_32(in *uint32, offset int, seed *byte) uint8
+
 func unpackLanesSIMDPreferred(dst []uint32, payload []byte, count, bitWidth int) {
 	if !simdUnpack(dst, payload, bitWidth, count) {
 		unpackLanesScalar(dst, payload, count, bitWidth)
@@ -347,12 +393,23 @@ func unpackLanesSIMDPreferred(dst []uint32, payload []byte, count, bitWidth int)
 }
 
 // simdUnpack decodes a SIMD-packed payload into dst (count <= 128).
-// Note: We use a switch instead of a dispatch table to allow the compiler to prove
-// that the stack-allocated buffers don't escape (function pointers break escape analysis).
 //
-// Optimization: When dst is 16-byte aligned and count == blockSize, we unpack directly
-// into dst, avoiding one 512-byte copy operation (Option C from optimization plan).
+// The kernel reads the payload in place and writes a full block directly into
+// dst when the kernels accept their alignment (see kernelAligned), avoiding the
+// copies through aligned stack buffers.
 func simdUnpack(dst []uint32, payload []byte, bitWidth, count int) bool {
+	return simdUnpackWith(dst, payload, bitWidth, count, unpackKernels)
+}
+
+// unpackDeltaLanesSIMDPreferred decodes delta blocks with the fused kernels.
+func unpackDeltaLanesSIMDPreferred(dst []uint32, payload []byte, count, bitWidth int) {
+	if !simdUnpackWith(dst, payload, bitWidth, count, unpackDeltaKernels) {
+		unpackDeltaLanesSeparate(dst, payload, count, bitWidth)
+	}
+}
+
+// simdUnpackWith is simdUnpack with the unpack kernels of kernels.
+func simdUnpackWith(dst []uint32, payload []byte, bitWidth, count int, kernels *[33]uintptr) bool {
 	if bitWidth <= 0 || bitWidth > 32 || count < 0 || count > blockSize {
 		return false
 	}
@@ -361,105 +418,25 @@ func simdUnpack(dst []uint32, payload []byte, bitWidth, count int) bool {
 		return false
 	}
 
-	// Copy payload to aligned buffer (required for SIMD alignment)
-	var payloadStorage [maxPayloadBytes + 16]byte
-	payloadBuf := alignedByteSlice(&payloadStorage)
-	copy(payloadBuf[:needed], payload[:needed])
-	inPtr := &payloadBuf[0]
+	in := payload
+	if !kernelAligned(unsafe.Pointer(&payload[0])) {
+		var payloadStorage [maxPayloadBytes + 16]byte
+		in = alignedByteSlice(&payloadStorage)
+		copy(in[:needed], payload[:needed])
+	}
 
-	// Optimization: If dst is aligned and we're unpacking a full block,
-	// write directly to dst to avoid the output copy.
 	var outPtr uintptr
-	var valueStorage [blockSize + 4]uint32
 	var valuesBuf []uint32
-	directWrite := count == blockSize && isAligned16Uint32(&dst[0])
+	directWrite := count == blockSize && kernelAligned(unsafe.Pointer(&dst[0]))
 	if directWrite {
 		outPtr = uintptr(unsafe.Pointer(&dst[0]))
 	} else {
+		var valueStorage [blockSize + 4]uint32
 		valuesBuf = alignedUint32Slice(&valueStorage)
 		outPtr = uintptr(unsafe.Pointer(&valuesBuf[0]))
 	}
 
-	// Hot path: Common bit widths (4-12) are most frequent in real data.
-	// This helps branch prediction by checking likely cases first.
-	if bitWidth >= 4 && bitWidth <= 12 {
-		switch bitWidth {
-		case 8:
-			unpack32_8(inPtr, outPtr, 0, &zeroSeed)
-		case 4:
-			unpack32_4(inPtr, outPtr, 0, &zeroSeed)
-		case 7:
-			unpack32_7(inPtr, outPtr, 0, &zeroSeed)
-		case 6:
-			unpack32_6(inPtr, outPtr, 0, &zeroSeed)
-		case 5:
-			unpack32_5(inPtr, outPtr, 0, &zeroSeed)
-		case 10:
-			unpack32_10(inPtr, outPtr, 0, &zeroSeed)
-		case 9:
-			unpack32_9(inPtr, outPtr, 0, &zeroSeed)
-		case 11:
-			unpack32_11(inPtr, outPtr, 0, &zeroSeed)
-		case 12:
-			unpack32_12(inPtr, outPtr, 0, &zeroSeed)
-		}
-		if !directWrite {
-			copy(dst[:count], valuesBuf[:count])
-		}
-		return true
-	}
-
-	// Cold path: Less common bit widths
-	switch bitWidth {
-	case 1:
-		unpack32_1(inPtr, outPtr, 0, &zeroSeed)
-	case 2:
-		unpack32_2(inPtr, outPtr, 0, &zeroSeed)
-	case 3:
-		unpack32_3(inPtr, outPtr, 0, &zeroSeed)
-	case 13:
-		unpack32_13(inPtr, outPtr, 0, &zeroSeed)
-	case 14:
-		unpack32_14(inPtr, outPtr, 0, &zeroSeed)
-	case 15:
-		unpack32_15(inPtr, outPtr, 0, &zeroSeed)
-	case 16:
-		unpack32_16(inPtr, outPtr, 0, &zeroSeed)
-	case 17:
-		unpack32_17(inPtr, outPtr, 0, &zeroSeed)
-	case 18:
-		unpack32_18(inPtr, outPtr, 0, &zeroSeed)
-	case 19:
-		unpack32_19(inPtr, outPtr, 0, &zeroSeed)
-	case 20:
-		unpack32_20(inPtr, outPtr, 0, &zeroSeed)
-	case 21:
-		unpack32_21(inPtr, outPtr, 0, &zeroSeed)
-	case 22:
-		unpack32_22(inPtr, outPtr, 0, &zeroSeed)
-	case 23:
-		unpack32_23(inPtr, outPtr, 0, &zeroSeed)
-	case 24:
-		unpack32_24(inPtr, outPtr, 0, &zeroSeed)
-	case 25:
-		unpack32_25(inPtr, outPtr, 0, &zeroSeed)
-	case 26:
-		unpack32_26(inPtr, outPtr, 0, &zeroSeed)
-	case 27:
-		unpack32_27(inPtr, outPtr, 0, &zeroSeed)
-	case 28:
-		unpack32_28(inPtr, outPtr, 0, &zeroSeed)
-	case 29:
-		unpack32_29(inPtr, outPtr, 0, &zeroSeed)
-	case 30:
-		unpack32_30(inPtr, outPtr, 0, &zeroSeed)
-	case 31:
-		unpack32_31(inPtr, outPtr, 0, &zeroSeed)
-	case 32:
-		unpack32_32(inPtr, outPtr, 0, &zeroSeed)
-	default:
-		return false
-	}
+	unpack32Call(&in[0], outPtr, 0, &zeroSeed, kernels[bitWidth])
 
 	if !directWrite {
 		copy(dst[:count], valuesBuf[:count])
@@ -467,6 +444,16 @@ func simdUnpack(dst []uint32, payload []byte, bitWidth, count int) bool {
 	return true
 }
 
+// unalignedKernels indicates that the selected bit packing kernels accept
+// unaligned values and payloads (AVX2 and AVX-512); the SSE2 kernels require
+// 16-byte alignment.
+var unalignedKernels bool
+
+// kernelAligned reports whether the bit packing kernels can access p in place.
+func kernelAligned(p unsafe.Pointer) bool {
+	return unalignedKernels || uintptr(p)&15 == 0
+}
+
 // isAligned16Uint32 checks if a uint32 pointer is 16-byte aligned.
 func isAligned16Uint32(p *uint32) bool {
 	return uintptr(unsafe.Pointer(p))&15 == 0
@@ -488,17 +475,15 @@ func alignedByteSlice(storage *[maxPayloadBytes + 16]byte) []byte {
 	return storage[offset : offset+maxPayloadBytes]
 }
 
-func align16(ptr uintptr) uintptr {
-	const mask = 16 - 1 // mask to round up to the next 16-byte boundary
-	return (ptr + mask) &^ mask
-}
-
 //go:noescape
 func deltaEncodeSIMDAsm(dst *uint32, src *uint32, n int) uint32
 
 //go:noescape
 func deltaDecodeSIMDAsm(dst *uint32, src *uint32, n int)
 
+//go:noescape
+func deltaDecodeD4SIMDAsm(dst *uint32, src *uint32, n int)
+
 //go:noescape
 func zigzagEncodeSIMDAsm(buf *uint32, n int)
 
@@ -508,8 +493,20 @@ func zigzagDecodeSIMDAsm(buf *uint32, n int)
 //go:noescape
 func deltaDecodeWithOverflowSIMDAsm(dst *uint32, src *uint32, n int) uint8
 
+//go:noescape
+func exceptionMaskSIMDAsm(values *uint32, n int, limit uint32) (lo uint64, hi uint64)
+
+//go:noescape
+func gatherLanesAVX2(payload *byte, positions *int, n int, bitWidth uint32, mask uint32, out *uint32)
+
 // deltaEncodeSIMD encodes the deltas of src into dst using SIMD instructions.
-// This function uses aligned temporary buffers to satisfy SIMD alignment requirements.
+// The kernel writes directly into an aligned dst that does not overlap src;
+// otherwise it goes through an aligned temporary buffer.
+//
+// The kernel flags decreases with a signed compare, which also fires for sorted
+// values crossing 2^31. Flagged input is therefore rechecked unsigned, so that
+// zigzag is chosen exactly as by deltaEncodeScalar and the blocks do not depend
+// on the kernel tier.
 func deltaEncodeSIMD(dst, src []uint32) bool {
 	n := len(src)
 	if n == 0 {
@@ -520,16 +517,20 @@ func deltaEncodeSIMD(dst, src []uint32) bool {
 		return deltaEncodeScalar(dst, src)
 	}
 
-	// Use aligned temporary buffers for SIMD operations
-	var srcStorage [blockSize + 4]uint32
-	srcBuf := alignedUint32Slice(&srcStorage)
-	copy(srcBuf[:n], src)
+	d, s := uintptr(unsafe.Pointer(&dst[0])), uintptr(unsafe.Pointer(&src[0]))
+	if d&15 == 0 && (d+uintptr(4*n) <= s || s+uintptr(4*n) <= d) {
+		if deltaEncodeSIMDAsm(&dst[0], &src[0], n) != 0 && !isNonDecreasing(src[:n]) {
+			zigzagEncodeSIMDAsm(&dst[0], n)
+			return true
+		}
+		return false
+	}
 
 	var dstStorage [blockSize + 4]uint32
 	dstBuf := alignedUint32Slice(&dstStorage)
 
-	need := deltaEncodeSIMDAsm(&dstBuf[0], &srcBuf[0], n)
-	if need != 0 {
+	need := deltaEncodeSIMDAsm(&dstBuf[0], &src[0], n)
+	if need != 0 && !isNonDecreasing(src[:n]) {
 		zigzagEncodeSIMDAsm(&dstBuf[0], n)
 		copy(dst[:n], dstBuf[:n])
 		return true
@@ -640,3 +641,84 @@ func deltaDecodeWithOverflowSIMD(dst, deltas []uint32, useZigZag bool) uint8 {
 	copy(dst[:n], dstBuf[:n])
 	return overflowPos
 }
+
+// deltaDecodeD4SIMD reconstructs D4-coded values in place, one vector addition
+// per four values. The kernel uses unaligned loads and reads each row before
+// writing it, so buf needs no alignment or temporary copy.
+func deltaDecodeD4SIMD(buf []uint32) {
+	n := len(buf) &^ 3
+	if n > 0 {
+		deltaDecodeD4SIMDAsm(&buf[0], &buf[0], n)
+	}
+	for i := max(n, 4); i < len(buf); i++ {
+		buf[i] += buf[i-4]
+	}
+}
+
+// collectExceptionsSIMD is the SIMD variant of collectExceptionsDirect. A single
+// vectorized pass computes the bitmap of all positions exceeding bitWidth, so only
+// the actual exceptions are visited afterwards.
+func collectExceptionsSIMD(values []uint32, bitWidth int, dst []byte, highBits []uint32) int {
+	n := len(values)
+	if bitWidth >= 32 || n == 0 {
+		return 0
+	}
+	if n > blockSize {
+		return collectExceptionsDirect(values, bitWidth, dst, highBits)
+	}
+
+	return exceptionsFromMask(values, bitWidth, exceptionMaskSIMD(values, bitWidth), dst, highBits)
+}
+
+// exceptionMaskSIMD returns the bitmap of the positions of values (0 < len <= 128)
+// exceeding bitWidth (< 32).
+func exceptionMaskSIMD(values []uint32, bitWidth int) [2]uint64 {
+	n := len(values)
+	limit := uint32(1)<<bitWidth - 1
+	var mask [2]uint64
+	mask[0], mask[1] = exceptionMaskSIMDAsm(&values[0], n, limit)
+	for i := n &^ 3; i < n; i++ {
+		if values[i] > limit {
+			mask[i>>6] |= 1 << (i & 63)
+		}
+	}
+	return mask
+}
+
+// zigzagEncodeSIMD zigzag-encodes buf in place. The kernel requires 16-byte
+// alignment, so leading values up to the first aligned element are encoded
+// with scalar code.
+func zigzagEncodeSIMD(buf []uint32) {
+	i := 0
+	for ; i < len(buf) && !isAligned16Uint32(&buf[i]); i++ {
+		buf[i] = zigzagEncode32(int32(buf[i]))
+	}
+	if i < len(buf) {
+		zigzagEncodeSIMDAsm(&buf[i], len(buf)-i)
+	}
+}
+
+// zigzagDecodeSIMD decodes the zigzag codes in buf in place (see zigzagEncodeSIMD).
+func zigzagDecodeSIMD(buf []uint32) {
+	i := 0
+	for ; i < len(buf) && !isAligned16Uint32(&buf[i]); i++ {
+		buf[i] = uint32(zigzagDecode32(buf[i]))
+	}
+	if i < len(buf) {
+		zigzagDecodeSIMDAsm(&buf[i], len(buf)-i)
+	}
+}
+
+// gatherLanesAVX2Preferred extracts groups of 8 positions with AVX2 gathers and
+// the remaining positions with scalar code. The positions must be valid block
+// positions, so every gathered word lies within the payload.
+func gatherLanesAVX2Preferred(dst []uint32, payload []byte, positions []int, bitWidth int) {
+	n := len(positions) &^ 7
+	if n > 0 && len(payload) >= payloadBytes(bitWidth) {
+		mask := uint32(uint64(1)<<bitWidth - 1)
+		gatherLanesAVX2(&payload[0], &positions[0], n, uint32(bitWidth), mask, &dst[0])
+	} else {
+		n = 0
+	}
+	gatherLanesScalar(dst[n:len(positions)], payload, positions[n:], bitWidth)
+}
@@ -2,7 +2,9 @@
 
 package fastpfor
 
-func initSIMDSelection() {}
+func detectCPUFeatures() CPUFeatures { return 0 }
+
+func initSIMDSelection(_ CPUFeatures) {}
 
 func simdPack(_ []byte, _ []uint32, _ int) bool {
 	return false
@@ -3,8 +3,11 @@
 package fastpfor
 
 import (
+	"bytes"
 	"fmt"
+	"slices"
 	"testing"
+	"unsafe"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -52,6 +55,243 @@ func TestSIMDPackRoundTripShort(t *testing.T) {
 	}
 }
 
+// TestSIMDKernelDispatch verifies the kernel tables dispatch every bit width to
+// the kernel of that width (compared with the scalar lane layout) and that the
+// trampolines keep the stack buffers from escaping.
+func TestSIMDKernelDispatch(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Zero(packKernels[0])
+	assert.Zero(unpackKernels[0])
+	values := make([]uint32, blockSize)
+	for bitWidth := 1; bitWidth <= 32; bitWidth++ {
+		assert.NotZero(packKernels[bitWidth], "pack kernel %d", bitWidth)
+		assert.NotZero(unpackKernels[bitWidth], "unpack kernel %d", bitWidth)
+		for i := range values {
+			values[i] = uint32(i*2654435761) >> (32 - bitWidth)
+		}
+		payload := make([]byte, bitWidth*16)
+		assert.True(simdPack(payload, values, bitWidth))
+		scalar := make([]byte, bitWidth*16)
+		packLanesScalar(scalar, values, bitWidth)
+		assert.Equal(scalar, payload, "width %d", bitWidth)
+
+		got := make([]uint32, blockSize-1) // not a full block: decode via the stack buffer
+		assert.True(simdUnpack(got, payload, bitWidth, len(got)))
+		assert.Equal(values[:len(got)], got, "width %d", bitWidth)
+
+		allocs := testing.AllocsPerRun(10, func() {
+			simdPack(payload, values, bitWidth)
+			simdUnpack(got, payload, bitWidth, len(got))
+		})
+		assert.Zero(allocs, "width %d", bitWidth)
+	}
+}
+
+// TestPackKernelTiersMatchSSE2 verifies that the AVX2 and AVX-512 kernels write
+// and read the same payload as the SSE2 kernels for every bit width.
+func TestPackKernelTiersMatchSSE2(t *testing.T) {
+	if !IsSIMDavailable() {
+		t.Skip("SIMD disabled")
+	}
+	assert := assert.New(t)
+	defer SetCPUFeatures(SetCPUFeatures(DetectedCPUFeatures()))
+
+	tiers := []CPUFeatures{CPUFeatureSSE2}
+	for _, tier := range []CPUFeatures{CPUFeatureSSE2 | CPUFeatureAVX2, CPUFeatureSSE2 | CPUFeatureAVX512} {
+		if DetectedCPUFeatures()&tier == tier {
+			tiers = append(tiers, tier)
+		}
+	}
+	if len(tiers) == 1 {
+		t.Skip("neither AVX2 nor AVX-512 available")
+	}
+
+	values := make([]uint32, blockSize)
+	for bitWidth := 1; bitWidth <= 32; bitWidth++ {
+		for i := range values {
+			values[i] = uint32(uint64(i)*2654435761+uint64(bitWidth)) & uint32(uint64(1)<<bitWidth-1)
+		}
+		values[blockSize-1] = uint32(uint64(1)<<bitWidth - 1)
+
+		var reference []byte
+		for _, features := range tiers {
+			SetCPUFeatures(features)
+			payload := make([]byte, bitWidth*16)
+			assert.True(simdPack(payload, values, bitWidth))
+			if reference == nil {
+				reference = payload
+			} else {
+				assert.Equal(reference, payload, "%s width %d", features, bitWidth)
+			}
+
+			for _, n := range []int{blockSize, blockSize - 1} {
+				got := make([]uint32, n)
+				assert.True(simdUnpack(got, payload, bitWidth, n))
+				assert.Equal(values[:n], got, "%s width %d n %d", features, bitWidth, n)
+			}
+		}
+	}
+}
+
+// TestSIMDPackUnpackInPlace verifies that the kernels working on the caller's
+// slices match the scalar code at every alignment and don't touch the bytes
+// around the payload and the values.
+func TestSIMDPackUnpackInPlace(t *testing.T) {
+	if !IsSIMDavailable() {
+		t.Skip("SIMD disabled")
+	}
+	assert := assert.New(t)
+	defer SetCPUFeatures(SetCPUFeatures(DetectedCPUFeatures()))
+
+	const guard = 64
+	values := NewAlignedUint32Slice(blockSize + 8)
+	payloadStorage := NewAlignedUint32Slice((maxPayloadBytes + 2*guard + 16) / 4)
+	payloadBuf := unsafe.Slice((*byte)(unsafe.Pointer(&payloadStorage[0])), maxPayloadBytes+2*guard+16)
+	for _, features := range kernelTiers() {
+		SetCPUFeatures(features)
+		for bitWidth := 1; bitWidth <= 32; bitWidth++ {
+			for _, shift := range []int{0, 1, 2, 3} {
+				in := values[shift : shift+blockSize]
+				for i := range in {
+					in[i] = uint32(uint64(i)*2654435761) & uint32(uint64(1)<<bitWidth-1)
+				}
+				if bitWidth < 32 && shift%2 == 0 {
+					in[7] = 1 << bitWidth // exception, must be masked
+				}
+				want := make([]byte, bitWidth*16)
+				packLanesScalar(want, in, bitWidth)
+
+				for i := range payloadBuf {
+					payloadBuf[i] = 0xA5
+				}
+				payload := payloadBuf[guard+4*shift:][:bitWidth*16]
+				assert.True(simdPack(payload, in, bitWidth))
+				assert.Equal(want, payload, "%s width %d shift %d", features, bitWidth, shift)
+				assert.Equal(bytes.Repeat([]byte{0xA5}, guard+4*shift), payloadBuf[:guard+4*shift])
+				assert.Equal(bytes.Repeat([]byte{0xA5}, guard), payloadBuf[guard+4*shift+bitWidth*16:][:guard])
+
+				out := NewAlignedUint32Slice(blockSize + 8)
+				for i := range out {
+					out[i] = 0xDEADBEEF
+				}
+				assert.True(simdUnpack(out[shift:], payload, bitWidth, blockSize))
+				wantValues := make([]uint32, blockSize)
+				unpackLanesScalar(wantValues, want, blockSize, bitWidth)
+				assert.Equal(wantValues, out[shift:shift+blockSize], "%s width %d shift %d", features, bitWidth, shift)
+				for _, i := range []int{0, 1, 2, 3, blockSize + 4, blockSize + 5, blockSize + 6, blockSize + 7} {
+					if i < shift || i >= shift+blockSize {
+						assert.Equal(uint32(0xDEADBEEF), out[i])
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestUnpackDeltaLanesFused verifies the fused unpack and delta decode kernels
+// against unpacking followed by the scalar delta decode.
+func TestUnpackDeltaLanesFused(t *testing.T) {
+	if !IsSIMDavailable() {
+		t.Skip("SIMD disabled")
+	}
+	assert := assert.New(t)
+	defer SetCPUFeatures(SetCPUFeatures(DetectedCPUFeatures()))
+
+	deltas := make([]uint32, blockSize)
+	for _, features := range kernelTiers() {
+		SetCPUFeatures(features)
+		for bitWidth := 1; bitWidth <= 32; bitWidth++ {
+			for i := range deltas {
+				deltas[i] = uint32(uint64(i+1)*2654435761) & uint32(uint64(1)<<bitWidth-1)
+			}
+			payload := make([]byte, bitWidth*16)
+			packLanesScalar(payload, deltas, bitWidth)
+			for _, count := range []int{blockSize, blockSize - 1, 5} {
+				want := make([]uint32, count)
+				unpackLanesScalar(want, payload, count, bitWidth)
+				deltaDecodeScalar(want, want, false)
+				for _, shift := range []int{0, 1} {
+					out := NewAlignedUint32Slice(blockSize + 2)
+					unpackDeltaLanes(out[shift:shift+count], payload, count, bitWidth)
+					assert.Equal(want, out[shift:shift+count], "%s width %d count %d shift %d", features, bitWidth, count, shift)
+				}
+			}
+		}
+	}
+}
+
+// TestPackDeltaDoesNotMutateInput packs delta blocks from unaligned and partial
+// inputs with every kernel selection and checks the input is left untouched.
+func TestPackDeltaDoesNotMutateInput(t *testing.T) {
+	assert := assert.New(t)
+	defer SetCPUFeatures(SetCPUFeatures(DetectedCPUFeatures()))
+
+	storage := make([]uint32, blockSize+1)
+	for _, features := range append([]CPUFeatures{0}, kernelTiers()...) {
+		SetCPUFeatures(features)
+		for _, src := range [][]uint32{genMonotonic(blockSize), genMixed(blockSize), genDataWithLargeExceptions()} {
+			for _, n := range []int{blockSize, blockSize - 3, 1} {
+				values := storage[1 : 1+n]
+				copy(values, src)
+				buf := PackDeltaUint32(nil, values)
+				assert.Equal(src[:n], values, "%s n %d", features, n)
+				got, err := UnpackUint32(nil, buf)
+				assert.NoError(err)
+				assert.Equal(src[:n], got, "%s n %d", features, n)
+			}
+		}
+	}
+}
+
+// TestAVX512KernelsMatchScalar verifies the AVX-512 delta and zigzag kernels
+// against the scalar ones, including unaligned slices and partial registers.
+func TestAVX512KernelsMatchScalar(t *testing.T) {
+	if DetectedCPUFeatures()&CPUFeatureAVX512 == 0 {
+		t.Skip("AVX-512 not available")
+	}
+	assert := assert.New(t)
+
+	storage := make([]uint32, blockSize+1)
+	for _, n := range []int{1, 3, 15, 16, 17, 100, blockSize} {
+		for _, offset := range []int{0, 1} {
+			deltas := storage[offset : offset+n]
+			for i := range deltas {
+				deltas[i] = uint32(i*2654435761) >> 6
+			}
+			deltas[n/2] = 0xF0000000 // overflows once the sum exceeds 1<<28
+
+			for _, useZigZag := range []bool{false, true} {
+				want := make([]uint32, n)
+				deltaDecodeScalar(want, deltas, useZigZag)
+				got := make([]uint32, n)
+				input := slices.Clone(deltas)
+				deltaDecodeAVX512Preferred(got, deltas, useZigZag)
+				assert.Equal(want, got, "n=%d offset=%d zigzag=%v", n, offset, useZigZag)
+				assert.Equal(input, deltas, "input modified")
+
+				inPlace := slices.Clone(deltas)
+				deltaDecodeAVX512Preferred(inPlace, inPlace, useZigZag)
+				assert.Equal(want, inPlace, "in place n=%d zigzag=%v", n, useZigZag)
+
+				wantPos := deltaDecodeWithOverflowScalar(want, deltas, useZigZag)
+				gotPos := deltaDecodeWithOverflowAVX512Preferred(got, deltas, useZigZag)
+				assert.Equal(wantPos, gotPos, "overflow n=%d offset=%d zigzag=%v", n, offset, useZigZag)
+				assert.Equal(want, got)
+			}
+
+			want := slices.Clone(deltas)
+			zigzagEncodeScalar(want)
+			got := slices.Clone(deltas)
+			zigzagEncodeAVX512Preferred(got)
+			assert.Equal(want, got, "zigzag encode n=%d", n)
+			zigzagDecodeScalar(want)
+			zigzagDecodeAVX512Preferred(got)
+			assert.Equal(want, got, "zigzag decode n=%d", n)
+		}
+	}
+}
+
 // TestDeltaDecodeWithOverflowSIMDAsm tests the SIMD assembly implementation
 // of delta decode with overflow detection.
 func TestDeltaDecodeWithOverflowSIMDAsm(t *testing.T) {
@@ -175,6 +415,218 @@ func TestDeltaDecodeWithOverflowSIMDAsmMatchesScalar(t *testing.T) {
 	}
 }
 
+func TestCollectExceptionsSIMDMatchesScalar(t *testing.T) {
+	if !IsSIMDavailable() {
+		t.Skip("SIMD disabled")
+	}
+	assert := assert.New(t)
+
+	inputs := [][]uint32{
+		genDataWithSmallExceptions(),
+		genDataWithLargeExceptions(),
+		genMixed(blockSize),
+		genMixed(77), // tail handled outside the kernel
+		genSequential(3),
+		{0x80000000, 0x7FFFFFFF, 0xFFFFFFFF, 0, 1},
+	}
+	for _, values := range inputs {
+		for width := 0; width <= 32; width++ {
+			var posSIMD, posScalar [blockSize]byte
+			var highSIMD, highScalar [blockSize]uint32
+			nSIMD := collectExceptionsSIMD(values, width, posSIMD[:], highSIMD[:])
+			nScalar := collectExceptionsDirect(values, width, posScalar[:], highScalar[:])
+			assert.Equalf(nScalar, nSIMD, "len=%d width=%d", len(values), width)
+			assert.Equalf(posScalar[:nScalar], posSIMD[:nSIMD], "len=%d width=%d positions", len(values), width)
+			assert.Equalf(highScalar[:nScalar], highSIMD[:nSIMD], "len=%d width=%d high bits", len(values), width)
+		}
+	}
+}
+
+// TestZigZagBlockSIMDMatchesScalar verifies the in-place zigzag kernels for
+// every start alignment and a range of lengths.
+func TestZigZagBlockSIMDMatchesScalar(t *testing.T) {
+	if !IsSIMDavailable() {
+		t.Skip("SIMD disabled")
+	}
+	assert := assert.New(t)
+
+	storage := NewAlignedUint32Slice(blockSize + 4)
+	for offset := range 4 {
+		for _, n := range []int{0, 1, 3, 4, 5, 17, 64, blockSize} {
+			values := storage[offset : offset+n]
+			for i := range values {
+				values[i] = uint32(int32(i*7919) - 1<<20)
+			}
+			if n > 1 {
+				values[0], values[n-1] = 0x80000000, 0x7FFFFFFF // MinInt32, MaxInt32
+			}
+			want := slices.Clone(values)
+			zigzagEncodeScalar(want)
+
+			zigzagEncodeSIMD(values)
+			assert.Equalf(want, values, "encode offset=%d n=%d", offset, n)
+
+			zigzagDecodeScalar(want)
+			zigzagDecodeSIMD(values)
+			assert.Equalf(want, values, "decode offset=%d n=%d", offset, n)
+		}
+	}
+}
+
+// TestGatherLanesAVX2MatchesScalar verifies the AVX2 gather kernel for every bit
+// width against scalar lane extraction.
+func TestGatherLanesAVX2MatchesScalar(t *testing.T) {
+	if DetectedCPUFeatures()&CPUFeatureAVX2 == 0 {
+		t.Skip("AVX2 not available")
+	}
+	assert := assert.New(t)
+
+	var positions []int
+	for i := range 3 * blockSize {
+		positions = append(positions, (i*37+i/blockSize)%blockSize)
+	}
+	for width := 1; width <= 32; width++ {
+		values := make([]uint32, blockSize)
+		for i := range values {
+			values[i] = uint32(uint64(i)*2654435761) & uint32(uint64(1)<<width-1)
+		}
+		payload := make([]byte, payloadBytes(width))
+		packLanesScalar(payload, values, width)
+
+		for _, n := range []int{0, 1, 7, 8, 9, 16, blockSize, len(positions)} {
+			got := make([]uint32, n)
+			want := make([]uint32, n)
+			gatherLanesAVX2Preferred(got, payload, positions[:n], width)
+			gatherLanesScalar(want, payload, positions[:n], width)
+			assert.Equalf(want, got, "width=%d n=%d", width, n)
+			for i, pos := range positions[:n] {
+				if values[pos] != got[i] {
+					assert.Failf("wrong value", "width=%d pos=%d", width, pos)
+					break
+				}
+			}
+		}
+	}
+}
+
+// kernelTiers returns the detected SIMD tiers in increasing order.
+func kernelTiers() []CPUFeatures {
+	var tiers []CPUFeatures
+	for _, tier := range []CPUFeatures{CPUFeatureSSE2, CPUFeatureSSE2 | CPUFeatureAVX2, CPUFeatureSSE2 | CPUFeatureAVX512} {
+		if DetectedCPUFeatures()&tier == tier {
+			tiers = append(tiers, tier)
+		}
+	}
+	return tiers
+}
+
+// BenchmarkPackKernels compares the pack/unpack kernels of every tier,
+// including the buffer handling of simdPack and simdUnpack.
+func BenchmarkPackKernels(b *testing.B) {
+	defer SetCPUFeatures(SetCPUFeatures(DetectedCPUFeatures()))
+	values := make([]uint32, blockSize)
+	got := make([]uint32, blockSize)
+	for _, features := range kernelTiers() {
+		SetCPUFeatures(features)
+		for _, bitWidth := range []int{1, 7, 16, 23, 32} {
+			for i := range values {
+				values[i] = uint32(uint64(i)*2654435761) & uint32(uint64(1)<<bitWidth-1)
+			}
+			payload := make([]byte, bitWidth*16)
+			b.Run(fmt.Sprintf("%s/pack/%d", features, bitWidth), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					simdPack(payload, values, bitWidth)
+				}
+			})
+			b.Run(fmt.Sprintf("%s/unpack/%d", features, bitWidth), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					simdUnpack(got, payload, bitWidth, blockSize)
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkBitPackingKernels calls the pack/unpack kernels of every tier
+// directly on aligned buffers, without the copies around them.
+func BenchmarkBitPackingKernels(b *testing.B) {
+	var valueStorage [blockSize + 4]uint32
+	values := alignedUint32Slice(&valueStorage)
+	var payloadStorage [maxPayloadBytes + 16]byte
+	payload := alignedByteSlice(&payloadStorage)
+	in := uintptr(unsafe.Pointer(&values[0]))
+	out := uintptr(unsafe.Pointer(&values[0]))
+
+	defer SetCPUFeatures(SetCPUFeatures(DetectedCPUFeatures()))
+	for _, features := range kernelTiers() {
+		SetCPUFeatures(features)
+		pack, unpack := packKernels, unpackKernels
+		for _, bitWidth := range []int{1, 7, 16, 23, 32} {
+			b.Run(fmt.Sprintf("%s/pack/%d", features, bitWidth), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					pack32Call(in, &payload[0], 0, &zeroSeed, pack[bitWidth])
+				}
+			})
+			b.Run(fmt.Sprintf("%s/unpack/%d", features, bitWidth), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					unpack32Call(&payload[0], out, 0, &zeroSeed, unpack[bitWidth])
+				}
+			})
+		}
+	}
+}
+
+// BenchmarkDeltaZigZagKernels compares the delta decode and zigzag kernels of
+// every tier on a full block.
+func BenchmarkDeltaZigZagKernels(b *testing.B) {
+	defer SetCPUFeatures(SetCPUFeatures(DetectedCPUFeatures()))
+	deltas := NewAlignedUint32Slice(blockSize)
+	for i := range deltas {
+		deltas[i] = uint32(i % 7)
+	}
+	dst := NewAlignedUint32Slice(blockSize)
+	for _, features := range kernelTiers() {
+		SetCPUFeatures(features)
+		b.Run(features.String()+"/deltaDecode", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				deltaDecode(dst, deltas, false)
+			}
+		})
+		b.Run(features.String()+"/deltaDecodeWithOverflow", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				deltaDecodeWithOverflow(dst, deltas, false)
+			}
+		})
+		b.Run(features.String()+"/zigzag", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				zigzagEncodeBlock(dst)
+				zigzagDecodeBlock(dst)
+			}
+		})
+	}
+}
+
+// BenchmarkCollectExceptions compares the SIMD and scalar exception collection.
+func BenchmarkCollectExceptions(b *testing.B) {
+	if !IsSIMDavailable() {
+		b.Skip("SIMD disabled")
+	}
+	values := genDataWithLargeExceptions()
+	var positions [blockSize]byte
+	var highBits [blockSize]uint32
+
+	b.Run("SIMD", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			collectExceptionsSIMD(values, 8, positions[:], highBits[:])
+		}
+	})
+	b.Run("Scalar", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			collectExceptionsDirect(values, 8, positions[:], highBits[:])
+		}
+	})
+}
+
 // BenchmarkDeltaDecodeWithOverflow_SIMD benchmarks the SIMD implementation.
 // Note: This function is only called when overflow WILL occur (flag is set in header).
 func BenchmarkDeltaDecodeWithOverflow_SIMD(b *testing.B) {
@@ -0,0 +1,51 @@
+package fastpfor
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotSorted is returned by PackSortedUint32 if the values decrease somewhere.
+var ErrNotSorted = errors.New("fastpfor: values not sorted")
+
+// PackSortedUint32 delta-encodes non-decreasing values (such as the docIDs of a
+// posting list) like PackDeltaUint32 and appends the block to dst. Instead of
+// falling back to zigzag deltas, it verifies the order and returns ErrNotSorted
+// if a value is smaller than its predecessor, so blocks written with it always
+// have the sorted layout (D1 deltas without zigzag) that IsMonotonic answers from
+// the header. The values slice is not mutated.
+//
+// Returns ErrInvalidBlockLength if there are more than BlockSize values. On
+// error, dst is returned unchanged.
+func PackSortedUint32(dst []byte, values []uint32) ([]byte, error) {
+	e := encoderPool.Get().(*Encoder)
+	defer encoderPool.Put(e)
+	return e.PackSorted(dst, values)
+}
+
+// PackSorted delta-encodes up to BlockSize non-decreasing values like
+// PackSortedUint32 and appends the block to dst.
+func (e *Encoder) PackSorted(dst []byte, values []uint32) ([]byte, error) {
+	if err := validateBlockLength(len(values)); err != nil {
+		return dst, err
+	}
+	scratch := alignedScratch(&e.scratch)
+	deltas := scratch[:len(values)]
+	var violation uint32
+	var prev uint32
+	for i, v := range values {
+		violation |= b2u(v < prev)
+		deltas[i], prev = v-prev, v
+	}
+	if violation != 0 {
+		for i := 1; i < len(values); i++ {
+			if values[i] < values[i-1] {
+				return dst, fmt.Errorf("%w: value %d at position %d is smaller than its predecessor %d",
+					ErrNotSorted, values[i], i, values[i-1])
+			}
+		}
+	}
+	start := len(dst)
+	dst = packInternalScratch(dst, deltas, headerTypeUint32Flag|headerDeltaFlag, scratch[blockSize:], e.options())
+	return e.observe(dst, start), nil
+}
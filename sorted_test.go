@@ -0,0 +1,49 @@
+package fastpfor
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPackSortedUint32(t *testing.T) {
+	assert := assert.New(t)
+
+	inputs := map[string][]uint32{
+		"empty":      nil,
+		"single":     {42},
+		"monotonic":  genMonotonic(blockSize),
+		"duplicates": {3, 3, 3, 7, 7, 1 << 20, 1 << 20, 1 << 30},
+		"partial":    genMonotonic(77),
+		"largeGaps":  {0, 1 << 31, mathMaxUint32},
+	}
+	for name, values := range inputs {
+		input := slices.Clone(values)
+		buf, err := PackSortedUint32(nil, input)
+		assert.NoError(err, name)
+		assert.Equal(values, input, "%s: input mutated", name)
+		assert.Equal(PackDeltaUint32(nil, values), buf, name)
+
+		sorted, err := IsMonotonic(buf)
+		assert.NoError(err, name)
+		assert.True(sorted, name)
+		got, err := UnpackUint32(nil, buf)
+		assert.NoError(err, name)
+		assert.Equal(len(values), len(got), name)
+		if len(values) > 0 {
+			assert.Equal(values, got, name)
+		}
+	}
+
+	dst := []byte{0xAA}
+	values := genMonotonic(blockSize)
+	values[100] = values[99] - 1
+	buf, err := PackSortedUint32(dst, values)
+	assert.ErrorIs(err, ErrNotSorted)
+	assert.ErrorContains(err, "position 100")
+	assert.Equal(dst, buf)
+
+	_, err = PackSortedUint32(nil, make([]uint32, blockSize+1))
+	assert.ErrorIs(err, ErrInvalidBlockLength)
+}
@@ -0,0 +1,111 @@
+package fastpfor
+
+import (
+	"errors"
+	"io"
+)
+
+// ErrWriterClosed is returned when values are added to a closed StreamWriter.
+var ErrWriterClosed = errors.New("fastpfor: stream writer closed")
+
+// StreamWriter buffers incoming values and writes them to an underlying
+// io.Writer as concatenated blocks (see BlockLength and SequenceReader for
+// reading them back). Full 128-value blocks are written as soon as they are
+// complete; Flush and Close write the pending tail as a partial block.
+//
+// Write errors are sticky: once the underlying writer failed, all further calls
+// return the same error. A StreamWriter is not safe for concurrent use.
+type StreamWriter struct {
+	w       io.Writer
+	delta   bool
+	sums    bool
+	pending [2 * blockSize]uint32 // pending values, cap >= 256 keeps exception handling allocation-free
+	n       int
+	block   []byte
+	written int64
+	err     error
+}
+
+// NewStreamWriter creates a StreamWriter writing to w. If delta is true, blocks
+// are packed like PackDeltaUint32 (for sorted streams), otherwise like PackUint32.
+func NewStreamWriter(w io.Writer, delta bool) *StreamWriter {
+	return &StreamWriter{
+		w:     w,
+		delta: delta,
+		block: make([]byte, 0, MaxBlockSizeUint32()),
+	}
+}
+
+// SetChecksums controls whether blocks written from now on carry a checksum
+// record of their values (see PackUint32WithChecksum), so readers can detect
+// corruption on unreliable media or transfers with UnpackUint32Verified.
+func (s *StreamWriter) SetChecksums(enabled bool) {
+	s.sums = enabled
+}
+
+// Add appends values to the stream, writing every block that becomes full.
+func (s *StreamWriter) Add(values ...uint32) error {
+	if s.err != nil {
+		return s.err
+	}
+	for len(values) > 0 {
+		k := copy(s.pending[s.n:blockSize], values)
+		s.n += k
+		values = values[k:]
+		if s.n == blockSize {
+			if err := s.writeBlock(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Flush writes the pending values as a (partial) block. Values added afterwards
+// start a new block, so flushing often costs compression.
+func (s *StreamWriter) Flush() error {
+	if s.err != nil {
+		return s.err
+	}
+	if s.n == 0 {
+		return nil
+	}
+	return s.writeBlock()
+}
+
+// Close flushes the pending values. It does not close the underlying writer.
+// Adding values after Close returns ErrWriterClosed.
+func (s *StreamWriter) Close() error {
+	if err := s.Flush(); err != nil {
+		return err
+	}
+	s.err = ErrWriterClosed
+	return nil
+}
+
+// Written returns the number of bytes written to the underlying writer.
+func (s *StreamWriter) Written() int64 {
+	return s.written
+}
+
+// writeBlock packs and writes the pending values.
+func (s *StreamWriter) writeBlock() error {
+	switch {
+	case s.delta && s.sums:
+		s.block = PackDeltaUint32WithChecksum(s.block[:0], s.pending[:s.n])
+	case s.delta:
+		s.block = PackDeltaUint32(s.block[:0], s.pending[:s.n])
+	case s.sums:
+		s.block = PackUint32WithChecksum(s.block[:0], s.pending[:s.n])
+	default:
+		s.block = PackUint32(s.block[:0], s.pending[:s.n])
+	}
+	s.n = 0
+	m, err := s.w.Write(s.block)
+	s.written += int64(m)
+	if err == nil && m < len(s.block) {
+		err = io.ErrShortWrite
+	}
+	s.err = err
+	return err
+}
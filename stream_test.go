@@ -0,0 +1,127 @@
+package fastpfor
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// failingWriter accepts limit bytes and fails afterwards.
+type failingWriter struct {
+	limit int
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if len(p) > w.limit {
+		n := w.limit
+		w.limit = 0
+		return n, errors.New("disk full")
+	}
+	w.limit -= len(p)
+	return len(p), nil
+}
+
+// shortWriter reports writing one byte less than requested without an error.
+type shortWriter struct{}
+
+func (shortWriter) Write(p []byte) (int, error) {
+	return len(p) - 1, nil
+}
+
+func TestStreamWriter(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, delta := range []bool{false, true} {
+		values := genMonotonic(1000)
+		var out bytes.Buffer
+		s := NewStreamWriter(&out, delta)
+
+		// Add in uneven chunks
+		for rest := values; len(rest) > 0; {
+			n := min(len(rest), 77)
+			assert.NoError(s.Add(rest[:n]...))
+			rest = rest[n:]
+		}
+
+		// Only full blocks are written before Close
+		r := NewSequenceReader()
+		assert.NoError(r.Load(out.Bytes()))
+		assert.Equal(7*blockSize, r.Len())
+
+		assert.NoError(s.Close())
+		assert.Equal(int64(out.Len()), s.Written())
+		assert.ErrorIs(s.Add(1), ErrWriterClosed)
+
+		assert.NoError(r.Load(out.Bytes()))
+		assert.Equal(8, r.NumBlocks())
+		assert.Equal(delta, r.IsSorted())
+		for i, want := range values {
+			v, err := r.Get(i)
+			assert.NoError(err)
+			assert.Equal(want, v)
+		}
+	}
+
+	t.Run("flush", func(t *testing.T) {
+		var out bytes.Buffer
+		s := NewStreamWriter(&out, false)
+		assert.NoError(s.Flush()) // nothing pending, nothing written
+		assert.Zero(out.Len())
+		assert.NoError(s.Add(1, 2, 3))
+		assert.NoError(s.Flush())
+		assert.NoError(s.Add(4))
+		assert.NoError(s.Close())
+
+		r := NewSequenceReader()
+		assert.NoError(r.Load(out.Bytes()))
+		assert.Equal(2, r.NumBlocks())
+		assert.Equal(4, r.Len())
+	})
+
+	t.Run("checksums", func(t *testing.T) {
+		var out bytes.Buffer
+		s := NewStreamWriter(&out, true)
+		s.SetChecksums(true)
+		values := genMonotonic(300)
+		assert.NoError(s.Add(values...))
+		assert.NoError(s.Close())
+
+		var decoded []uint32
+		for buf := out.Bytes(); len(buf) > 0; {
+			n, err := BlockLength(buf)
+			assert.NoError(err)
+			block, err := UnpackUint32Verified(nil, buf[:n])
+			assert.NoError(err)
+			decoded = append(decoded, block...)
+			buf = buf[n:]
+		}
+		assert.Equal(values, decoded)
+	})
+
+	t.Run("writeError", func(t *testing.T) {
+		s := NewStreamWriter(&failingWriter{limit: 10}, false)
+		err := s.Add(genSequential(blockSize)...)
+		assert.EqualError(err, "disk full")
+		assert.Equal(int64(10), s.Written())
+		assert.Equal(err, s.Add(1))
+		assert.Equal(err, s.Close())
+	})
+
+	t.Run("shortWrite", func(t *testing.T) {
+		s := NewStreamWriter(shortWriter{}, false)
+		assert.NoError(s.Add(1))
+		assert.ErrorIs(s.Close(), io.ErrShortWrite)
+	})
+}
+
+func BenchmarkStreamWriter(b *testing.B) {
+	values := genMonotonic(16 * blockSize)
+	s := NewStreamWriter(io.Discard, true)
+	b.ReportAllocs()
+	for range b.N {
+		_ = s.Add(values...)
+	}
+}
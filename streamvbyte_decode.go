@@ -23,14 +23,32 @@ func svbControlBlockSize(ctrl byte) int {
 	return int(svbControlBlockSizeLUT[ctrl])
 }
 
+// svbDataLen returns the number of data bytes (excluding control bytes) used by the
+// first count values, based on the control bytes alone.
+func svbDataLen(controlBytes []byte, count int) int {
+	n := 0
+	full := count >> 2
+	for _, ctrl := range controlBytes[:full] {
+		n += svbControlBlockSize(ctrl)
+	}
+	for i := full << 2; i < count; i++ {
+		n += int((controlBytes[full]>>((i&3)*2))&0x03) + 1
+	}
+	return n
+}
+
 // svbDecodeOne decodes a single value from StreamVByte data at the given index.
 // The svbData should start at the StreamVByte payload (after the 2-byte length prefix).
 // count is the total number of encoded values.
 // This function is allocation-free and suitable for random access patterns.
+// Values beyond the end of truncated (corrupt) data decode as 0.
 func svbDecodeOne(svbData []byte, count, index int) uint32 {
 	// StreamVByte format: control bytes first, then data bytes
 	// Control bytes: one per 4 values, each 2-bit code = byteLength-1
 	numControlBytes := (count + 3) >> 2
+	if len(svbData) < numControlBytes {
+		return 0
+	}
 	controlBytes := svbData[:numControlBytes]
 	dataBytes := svbData[numControlBytes:]
 
@@ -50,7 +68,7 @@ func svbDecodeOne(svbData []byte, count, index int) uint32 {
 	for i := 0; i <= posInBlock; i++ {
 		code := (ctrl >> (i * 2)) & 0x03
 		byteLen := int(code) + 1
-		if i == posInBlock {
+		if i == posInBlock && dataOffset+byteLen <= len(dataBytes) {
 			value = svbReadValue(dataBytes[dataOffset:], byteLen)
 		}
 		dataOffset += byteLen
@@ -59,6 +77,97 @@ func svbDecodeOne(svbData []byte, count, index int) uint32 {
 	return value
 }
 
+// svbCursor provides efficient sequential iteration through StreamVByte data.
+// Seeking forward only sums the control blocks between the current and the target
+// position, so visiting an ascending subset of indexes costs O(target index / 4)
+// in total rather than per value as with svbDecodeOne.
+type svbCursor struct {
+	controlBytes []byte
+	dataBytes    []byte
+	count        int
+	dataOffset   int
+	blockIndex   int
+	posInBlock   int
+	currentCtrl  byte
+	intraOffset  int
+}
+
+// svbNewCursor creates a cursor positioned at index 0.
+func svbNewCursor(svbData []byte, count int) svbCursor {
+	numControlBytes := (count + 3) >> 2
+	c := svbCursor{
+		controlBytes: svbData[:numControlBytes],
+		dataBytes:    svbData[numControlBytes:],
+		count:        count,
+	}
+	if len(c.controlBytes) > 0 {
+		c.currentCtrl = c.controlBytes[0]
+	}
+	return c
+}
+
+// svbSeekTo positions the cursor at index. Seeking backwards restarts from the beginning.
+func (c *svbCursor) svbSeekTo(index int) {
+	targetBlock := index >> 2
+	targetPos := index & 0x03
+
+	if targetBlock < c.blockIndex || (targetBlock == c.blockIndex && targetPos < c.posInBlock) {
+		c.blockIndex = 0
+		c.posInBlock = 0
+		c.dataOffset = 0
+		c.intraOffset = 0
+		if len(c.controlBytes) > 0 {
+			c.currentCtrl = c.controlBytes[0]
+		}
+	}
+
+	for c.blockIndex < targetBlock {
+		c.dataOffset += svbControlBlockSize(c.controlBytes[c.blockIndex])
+		c.blockIndex++
+		c.posInBlock = 0
+		c.intraOffset = 0
+	}
+
+	if c.blockIndex < len(c.controlBytes) {
+		c.currentCtrl = c.controlBytes[c.blockIndex]
+	}
+
+	for c.posInBlock < targetPos {
+		code := (c.currentCtrl >> (c.posInBlock * 2)) & 0x03
+		c.intraOffset += int(code) + 1
+		c.posInBlock++
+	}
+}
+
+// svbReadCurrent decodes the value at the current position.
+func (c *svbCursor) svbReadCurrent() uint32 {
+	code := (c.currentCtrl >> (c.posInBlock * 2)) & 0x03
+	byteLen := int(code) + 1
+	return svbReadValue(c.dataBytes[c.dataOffset+c.intraOffset:], byteLen)
+}
+
+// svbAdvance moves the cursor to the next value.
+func (c *svbCursor) svbAdvance() {
+	code := (c.currentCtrl >> (c.posInBlock * 2)) & 0x03
+	c.intraOffset += int(code) + 1
+	c.posInBlock++
+
+	if c.posInBlock >= 4 {
+		c.dataOffset += c.intraOffset
+		c.blockIndex++
+		c.posInBlock = 0
+		c.intraOffset = 0
+		if c.blockIndex < len(c.controlBytes) {
+			c.currentCtrl = c.controlBytes[c.blockIndex]
+		}
+	}
+}
+
+// svbCurrentIndex returns the index of the current position.
+func (c *svbCursor) svbCurrentIndex() int {
+	return c.blockIndex*4 + c.posInBlock
+}
+
 // svbReadValue reads a variable-length encoded value (1-4 bytes).
 func svbReadValue(data []byte, byteLen int) uint32 {
 	switch byteLen {
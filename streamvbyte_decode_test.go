@@ -8,90 +8,6 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-// svbCursor provides efficient sequential iteration through StreamVByte data.
-// This is defined here for testing purposes - not used in production code.
-type svbCursor struct {
-	controlBytes []byte
-	dataBytes    []byte
-	count        int
-	dataOffset   int
-	blockIndex   int
-	posInBlock   int
-	currentCtrl  byte
-	intraOffset  int
-}
-
-func svbNewCursor(svbData []byte, count int) svbCursor {
-	numControlBytes := (count + 3) >> 2
-	c := svbCursor{
-		controlBytes: svbData[:numControlBytes],
-		dataBytes:    svbData[numControlBytes:],
-		count:        count,
-	}
-	if len(c.controlBytes) > 0 {
-		c.currentCtrl = c.controlBytes[0]
-	}
-	return c
-}
-
-func (c *svbCursor) svbSeekTo(index int) {
-	targetBlock := index >> 2
-	targetPos := index & 0x03
-
-	if targetBlock < c.blockIndex || (targetBlock == c.blockIndex && targetPos < c.posInBlock) {
-		c.blockIndex = 0
-		c.posInBlock = 0
-		c.dataOffset = 0
-		c.intraOffset = 0
-		if len(c.controlBytes) > 0 {
-			c.currentCtrl = c.controlBytes[0]
-		}
-	}
-
-	for c.blockIndex < targetBlock {
-		c.dataOffset += svbControlBlockSize(c.controlBytes[c.blockIndex])
-		c.blockIndex++
-		c.posInBlock = 0
-		c.intraOffset = 0
-	}
-
-	if c.blockIndex < len(c.controlBytes) {
-		c.currentCtrl = c.controlBytes[c.blockIndex]
-	}
-
-	for c.posInBlock < targetPos {
-		code := (c.currentCtrl >> (c.posInBlock * 2)) & 0x03
-		c.intraOffset += int(code) + 1
-		c.posInBlock++
-	}
-}
-
-func (c *svbCursor) svbReadCurrent() uint32 {
-	code := (c.currentCtrl >> (c.posInBlock * 2)) & 0x03
-	byteLen := int(code) + 1
-	return svbReadValue(c.dataBytes[c.dataOffset+c.intraOffset:], byteLen)
-}
-
-func (c *svbCursor) svbAdvance() {
-	code := (c.currentCtrl >> (c.posInBlock * 2)) & 0x03
-	c.intraOffset += int(code) + 1
-	c.posInBlock++
-
-	if c.posInBlock >= 4 {
-		c.dataOffset += c.intraOffset
-		c.blockIndex++
-		c.posInBlock = 0
-		c.intraOffset = 0
-		if c.blockIndex < len(c.controlBytes) {
-			c.currentCtrl = c.controlBytes[c.blockIndex]
-		}
-	}
-}
-
-func (c *svbCursor) svbCurrentIndex() int {
-	return c.blockIndex*4 + c.posInBlock
-}
-
 // TestSvbControlBlockSize tests the control block size calculation.
 func TestSvbControlBlockSize(t *testing.T) {
 	testCases := []struct {
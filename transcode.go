@@ -0,0 +1,239 @@
+package fastpfor
+
+import (
+	"fmt"
+	"slices"
+)
+
+// TranscodeMap decodes the block in buf, replaces every value v with fn(v) and
+// appends the re-encoded block to dst. This is intended for ID remapping during
+// segment merges (old docID -> new docID) without caller-side value slices.
+//
+// The encoding kind of the source block is preserved: delta blocks are
+// re-encoded with PackDeltaUint32 semantics (zigzag is selected again based on
+// the remapped values), frame-of-reference blocks are re-encoded with the new
+// minimum as base and plain blocks are re-packed as-is. The IntTypeUint16
+// marker is kept as long as all remapped values still fit into 16 bits,
+// otherwise the block is marked as IntTypeUint32. For signed blocks (see
+// PackInt32), fn receives and returns the int32 values as uint32 and the block
+// stays signed. Likewise, for float blocks (see PackFloat32) fn receives and
+// returns the float32 bit patterns and the block stays a float block. Halves of
+// float64 blocks (see PackFloat64) are rejected with ErrInvalidFlags, as their
+// values cannot be remapped independently of the other half.
+//
+// All intermediate values live in a single scratch array that is shared between
+// decoding, remapping and exception handling, so no per-stage buffers are needed.
+//
+// Returns the unmodified dst and an error if buf is invalid or delta decoding
+// overflows (see ErrOverflow).
+func TranscodeMap(dst, buf []byte, fn func(uint32) uint32) ([]byte, error) {
+	header, _, _, err := readHeader(buf)
+	if err != nil {
+		return dst, err
+	}
+	if header&headerFloat64Flag != 0 {
+		return dst, fmt.Errorf("%w: cannot remap half of a float64 block", ErrInvalidFlags)
+	}
+	_, _, intType, _, hasDelta, _, _ := decodeHeader(header)
+	signed := header&headerSignedFlag != 0
+	float := header&headerFloatFlag != 0
+
+	// scratch[:blockSize] holds the block, scratch[blockSize:] is exception scratch for packing
+	var scratch [2 * blockSize]uint32
+	values, err := UnpackUint32(scratch[:0], buf)
+	if err != nil {
+		return dst, err
+	}
+
+	if signed {
+		zigzagDecodeBlock(values)
+	}
+	if float {
+		xorDecodeBlock(values)
+	}
+
+	var orAll uint32
+	for i, v := range values {
+		v = fn(v)
+		values[i] = v
+		orAll |= v
+	}
+
+	flags := headerTypeUint32Flag
+	if intType == IntTypeUint16 && orAll <= 0xFFFF {
+		flags = headerTypeUint16Flag
+	}
+	if signed {
+		flags |= headerSignedFlag
+		zigzagEncodeBlock(values)
+	}
+	if float {
+		flags |= headerFloatFlag
+		xorEncodeBlock(values)
+	}
+	if hasDelta {
+		mode := headerDeltaMode(header)
+		flags |= headerDeltaFlag | uint32(mode)<<headerDeltaModeShift
+		if len(values) > 0 && deltaEncodeMode(values, values, mode) {
+			flags |= headerZigZagFlag
+		}
+	}
+	if hasBaseRecord(buf, header) {
+		return packFrame(dst, values, flags, nil, nil), nil
+	}
+	return packInternal(dst, values, flags), nil
+}
+
+// SortBlock decodes the block in buf, sorts its values and returns them
+// re-encoded as a delta block, so unsorted input (e.g. IDs in arrival order)
+// becomes compact and supports binary-search SkipTo afterwards. The IntTypeUint16
+// marker is kept.
+//
+// Signed blocks (see PackInt32) and float blocks (see PackFloat32 and
+// PackFloat64) are rejected with ErrInvalidFlags, as their values cannot be
+// stored as non-negative deltas.
+func SortBlock(buf []byte) ([]byte, error) {
+	header, err := checkSortable(buf)
+	if err != nil {
+		return nil, err
+	}
+	_, _, intType, _, _, _, _ := decodeHeader(header)
+
+	// scratch[:blockSize] holds the block, scratch[blockSize:] is exception scratch for packing
+	var scratch [2 * blockSize]uint32
+	values, err := UnpackUint32(scratch[:0], buf)
+	if err != nil {
+		return nil, err
+	}
+	slices.Sort(values)
+
+	flags := headerTypeUint32Flag | headerDeltaFlag
+	if intType == IntTypeUint16 {
+		flags = headerTypeUint16Flag | headerDeltaFlag
+	}
+	return packSortedDeltas(nil, values, flags), nil
+}
+
+// SortBlocks is SortBlock for a concatenation of blocks: it sorts the values of
+// all blocks in buf together and appends them to dst as delta blocks of
+// BlockSize values (the last one may be shorter), which a SequenceReader loads
+// as a sorted sequence. All values are held in memory; for streams that do not
+// fit, use an ExternalSorter. The output blocks are uint32 blocks, also for
+// IntTypeUint16 input. Empty blocks are dropped.
+//
+// Returns the error of the first invalid block, or ErrInvalidFlags for signed and
+// float blocks; dst is returned unchanged then.
+func SortBlocks(dst, buf []byte) ([]byte, error) {
+	var values []uint32
+	for off := 0; off < len(buf); {
+		if _, err := checkSortable(buf[off:]); err != nil {
+			return dst, fmt.Errorf("block at offset %d: %w", off, err)
+		}
+		block, n, err := UnpackUint32WithLength(nil, buf[off:])
+		if err != nil {
+			return dst, fmt.Errorf("block at offset %d: %w", off, err)
+		}
+		values = append(values, block...)
+		off += n
+	}
+	slices.Sort(values)
+
+	// scratch[:blockSize] holds a block, scratch[blockSize:] is exception scratch
+	// for packing, which must not reach into the following values
+	var scratch [2 * blockSize]uint32
+	for len(values) > 0 {
+		n := copy(scratch[:blockSize], values)
+		dst = packSortedDeltas(dst, scratch[:n], headerTypeUint32Flag|headerDeltaFlag)
+		values = values[n:]
+	}
+	return dst, nil
+}
+
+// checkSortable reads the header of the block in buf and rejects signed and
+// float blocks, whose values cannot be stored as non-negative deltas.
+func checkSortable(buf []byte) (uint32, error) {
+	header, _, _, err := readHeader(buf)
+	if err != nil {
+		return 0, err
+	}
+	if header&headerSignedFlag != 0 {
+		return 0, fmt.Errorf("%w: cannot sort a signed block", ErrInvalidFlags)
+	}
+	if header&(headerFloatFlag|headerFloat64Flag) != 0 {
+		return 0, fmt.Errorf("%w: cannot sort a float block", ErrInvalidFlags)
+	}
+	return header, nil
+}
+
+// packSortedDeltas appends the sorted values (up to BlockSize) as a delta block
+// with the given flags to dst. The values are overwritten with their deltas.
+func packSortedDeltas(dst []byte, values []uint32, flags uint32) []byte {
+	// Deltas of sorted values are non-negative, so they are computed directly
+	// instead of letting deltaEncode decide about zigzag.
+	for i := len(values) - 1; i > 0; i-- {
+		values[i] -= values[i-1]
+	}
+	return packInternal(dst, values, flags)
+}
+
+// DropFirstN returns a new block holding the values of the block in buf without
+// the first n, e.g. to persist the remainder of a posting block after a query
+// consumed its prefix. The block kind is preserved like in TranscodeMap: for
+// delta blocks the first remaining value becomes the new delta base, for
+// frame-of-reference blocks the minimum of the remainder becomes the base, and
+// the IntTypeUint16, signed and float markers are kept. Range, checksum and
+// provenance records are not carried over.
+//
+// Returns an error if n is negative or exceeds the number of values, if buf is
+// invalid or if delta decoding overflows (see ErrOverflow). Halves of float64
+// blocks (see PackFloat64) are rejected with ErrInvalidFlags, as dropping values
+// from one half would separate the pairs.
+func DropFirstN(buf []byte, n int) ([]byte, error) {
+	header, count, _, err := readHeader(buf)
+	if err != nil {
+		return nil, err
+	}
+	if n < 0 || n > count {
+		return nil, fmt.Errorf("%w: cannot drop %d of %d values", ErrInvalidBlockLength, n, count)
+	}
+	if header&headerFloat64Flag != 0 {
+		return nil, fmt.Errorf("%w: cannot drop values from half of a float64 block", ErrInvalidFlags)
+	}
+	_, _, intType, _, hasDelta, _, _ := decodeHeader(header)
+
+	// scratch[:blockSize] holds the block, scratch[blockSize:] is exception scratch for packing
+	var scratch [2 * blockSize]uint32
+	values, err := UnpackUint32(scratch[:0], buf)
+	if err != nil {
+		return nil, err
+	}
+	// Float values are XORed with their predecessor, so the remainder is
+	// encoded again from its first value on.
+	float := header&headerFloatFlag != 0
+	if float {
+		xorDecodeBlock(values)
+	}
+	values = values[n:]
+
+	flags := headerTypeUint32Flag
+	if intType == IntTypeUint16 {
+		flags = headerTypeUint16Flag
+	}
+	// Signed values are zigzag encoded one by one and stay as they are
+	flags |= header & headerSignedFlag
+	if float {
+		flags |= headerFloatFlag
+		xorEncodeBlock(values)
+	}
+	if hasDelta {
+		mode := headerDeltaMode(header)
+		flags |= headerDeltaFlag | uint32(mode)<<headerDeltaModeShift
+		if len(values) > 0 && deltaEncodeMode(values, values, mode) {
+			flags |= headerZigZagFlag
+		}
+	}
+	if hasBaseRecord(buf, header) {
+		return packFrame(nil, values, flags, nil, nil), nil
+	}
+	return packInternal(nil, values, flags), nil
+}
@@ -0,0 +1,328 @@
+package fastpfor
+
+import (
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestTranscodeMap verifies values are remapped and the block kind is preserved.
+func TestTranscodeMap(t *testing.T) {
+	assert := assert.New(t)
+
+	double := func(v uint32) uint32 { return v * 2 }
+
+	t.Run("plain", func(t *testing.T) {
+		src := genDataWithSmallExceptions()
+		buf := PackUint32(nil, src)
+		out, err := TranscodeMap(nil, buf, double)
+		assert.NoError(err)
+		got, err := UnpackUint32(nil, out)
+		assert.NoError(err)
+		for i, v := range src {
+			assert.Equal(v*2, got[i])
+		}
+		_, _, _, _, hasDelta, _, _ := decodeHeader(bo.Uint32(out))
+		assert.False(hasDelta)
+	})
+
+	t.Run("delta", func(t *testing.T) {
+		src := genMonotonic(blockSize)
+		buf := PackDeltaUint32(nil, append([]uint32(nil), src...))
+		out, err := TranscodeMap(nil, buf, double)
+		assert.NoError(err)
+		got, err := UnpackUint32(nil, out)
+		assert.NoError(err)
+		for i, v := range src {
+			assert.Equal(v*2, got[i])
+		}
+		sorted, err := IsMonotonic(out)
+		assert.NoError(err)
+		assert.True(sorted)
+	})
+
+	t.Run("deltaBecomesUnsorted", func(t *testing.T) {
+		src := []uint32{1, 2, 3, 4}
+		buf := PackDeltaUint32(nil, append([]uint32(nil), src...))
+		out, err := TranscodeMap(nil, buf, func(v uint32) uint32 { return 10 - v })
+		assert.NoError(err)
+		got, err := UnpackUint32(nil, out)
+		assert.NoError(err)
+		assert.Equal([]uint32{9, 8, 7, 6}, got)
+		_, _, _, _, hasDelta, hasZigZag, _ := decodeHeader(bo.Uint32(out))
+		assert.True(hasDelta)
+		assert.True(hasZigZag)
+	})
+
+	t.Run("signed", func(t *testing.T) {
+		buf := PackInt32(nil, []int32{-3, 0, 5, -1 << 31})
+		out, err := TranscodeMap(nil, buf, func(v uint32) uint32 { return uint32(int32(v) + 1) })
+		assert.NoError(err)
+		got, err := UnpackInt32(nil, out)
+		assert.NoError(err)
+		assert.Equal([]int32{-2, 1, 6, -1<<31 + 1}, got)
+	})
+
+	t.Run("float", func(t *testing.T) {
+		buf := PackFloat32(nil, []float32{1.5, -2, 0.25})
+		out, err := TranscodeMap(nil, buf, func(v uint32) uint32 {
+			return math.Float32bits(math.Float32frombits(v) * 2)
+		})
+		assert.NoError(err)
+		got, err := UnpackFloat32(nil, out)
+		assert.NoError(err)
+		assert.Equal([]float32{3, -4, 0.5}, got)
+	})
+
+	t.Run("uint16MarkerKept", func(t *testing.T) {
+		buf := PackUint16(nil, []uint16{1, 2, 3})
+		out, err := TranscodeMap(nil, buf, double)
+		assert.NoError(err)
+		_, _, intType, _, _, _, _ := decodeHeader(bo.Uint32(out))
+		assert.Equal(IntTypeUint16, intType)
+	})
+
+	t.Run("uint16MarkerWidened", func(t *testing.T) {
+		buf := PackUint16(nil, []uint16{1, 2, 0xFFFF})
+		out, err := TranscodeMap(nil, buf, func(v uint32) uint32 { return v + 1 })
+		assert.NoError(err)
+		_, _, intType, _, _, _, _ := decodeHeader(bo.Uint32(out))
+		assert.Equal(IntTypeUint32, intType)
+		got, err := UnpackUint32(nil, out)
+		assert.NoError(err)
+		assert.Equal([]uint32{2, 3, 0x10000}, got)
+	})
+
+	t.Run("appendsToDst", func(t *testing.T) {
+		prefix := []byte{0xAA, 0xBB}
+		buf := PackUint32(nil, []uint32{1, 2, 3})
+		out, err := TranscodeMap(prefix, buf, double)
+		assert.NoError(err)
+		assert.Equal(prefix, out[:2])
+		got, err := UnpackUint32(nil, out[2:])
+		assert.NoError(err)
+		assert.Equal([]uint32{2, 4, 6}, got)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		out, err := TranscodeMap([]byte{1}, []byte{1, 2}, double)
+		assert.ErrorIs(err, ErrInvalidBuffer)
+		assert.Equal([]byte{1}, out)
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		buf := PackAlreadyDeltaUint32(nil, []uint32{0xFFFFFFFF, 1})
+		_, err := TranscodeMap(nil, buf, double)
+		var overflow *ErrOverflow
+		assert.ErrorAs(err, &overflow)
+	})
+}
+
+func BenchmarkTranscodeMap(b *testing.B) {
+	buf := PackDeltaUint32(nil, genMonotonic(blockSize))
+	dst := make([]byte, 0, MaxBlockSizeUint32())
+	fn := func(v uint32) uint32 { return v + 7 }
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		dst, _ = TranscodeMap(dst[:0], buf, fn)
+	}
+}
+
+// TestSortBlock verifies that blocks are sorted and re-encoded as delta blocks.
+func TestSortBlock(t *testing.T) {
+	assert := assert.New(t)
+
+	for name, buf := range map[string][]byte{
+		"plain":      PackUint32(nil, genMixed(blockSize)),
+		"exceptions": PackUint32(nil, genDataWithLargeExceptions()),
+		"zigzag":     PackDeltaUint32(nil, genMixed(100)),
+		"sorted":     PackDeltaUint32(nil, genMonotonic(blockSize)),
+		"single":     PackUint32(nil, []uint32{42}),
+		"empty":      PackUint32(nil, nil),
+	} {
+		t.Run(name, func(t *testing.T) {
+			want, err := UnpackUint32(nil, buf)
+			assert.NoError(err)
+			want = slices.Clone(want)
+			slices.Sort(want)
+
+			out, err := SortBlock(buf)
+			assert.NoError(err)
+			got, err := UnpackUint32(nil, out)
+			assert.NoError(err)
+			assert.Equal(len(want), len(got))
+			if len(want) > 0 {
+				assert.Equal(want, got)
+			}
+
+			info, err := ReadBlockInfo(out)
+			assert.NoError(err)
+			assert.True(info.Delta)
+			assert.False(info.ZigZag)
+			sorted, err := IsMonotonic(out)
+			assert.NoError(err)
+			assert.True(sorted)
+		})
+	}
+
+	t.Run("uint16MarkerKept", func(t *testing.T) {
+		out, err := SortBlock(PackUint16(nil, []uint16{9, 1, 5}))
+		assert.NoError(err)
+		got, err := UnpackUint16(nil, out)
+		assert.NoError(err)
+		assert.Equal([]uint16{1, 5, 9}, got)
+	})
+
+	t.Run("signed", func(t *testing.T) {
+		_, err := SortBlock(PackInt32(nil, []int32{-1, 1}))
+		assert.ErrorIs(err, ErrInvalidFlags)
+	})
+
+	t.Run("float", func(t *testing.T) {
+		_, err := SortBlock(PackFloat32(nil, []float32{-1, 1}))
+		assert.ErrorIs(err, ErrInvalidFlags)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := SortBlock([]byte{1, 2})
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+}
+
+// TestSortBlocks verifies that the values of all blocks are sorted together.
+func TestSortBlocks(t *testing.T) {
+	assert := assert.New(t)
+	rng := rand.New(rand.NewSource(4004))
+
+	values := make([]uint32, 5*blockSize+17)
+	for i := range values {
+		values[i] = uint32(rng.Intn(1 << 20))
+	}
+	buf := mixedKindBlocks(values)
+	buf = PackUint32(buf, nil) // empty blocks are dropped
+	buf = PackUint16(buf, []uint16{7, 3})
+	want := append(slices.Clone(values), 7, 3)
+	slices.Sort(want)
+
+	dst := []byte{0xAA}
+	out, err := SortBlocks(dst, buf)
+	assert.NoError(err)
+	assert.Equal(dst, out[:1])
+	assert.Equal(want, decodeBlockStream(t, out[1:]))
+
+	r := NewSequenceReader()
+	assert.NoError(r.Load(out[1:]))
+	assert.True(r.IsSorted())
+	assert.Equal(6, r.NumBlocks())
+
+	out, err = SortBlocks(nil, nil)
+	assert.NoError(err)
+	assert.Empty(out)
+
+	out, err = SortBlocks(dst, append(PackUint32(nil, []uint32{1}), PackInt32(nil, []int32{-1})...))
+	assert.ErrorIs(err, ErrInvalidFlags)
+	assert.Equal(dst, out)
+	_, err = SortBlocks(nil, buf[:len(buf)-1])
+	assert.ErrorIs(err, ErrInvalidBuffer)
+}
+
+func BenchmarkSortBlock(b *testing.B) {
+	buf := PackUint32(nil, genMixed(blockSize))
+	b.ReportAllocs()
+	for range b.N {
+		_, _ = SortBlock(buf)
+	}
+}
+
+// TestDropFirstN verifies that the remainder of a block is re-encoded with its
+// kind preserved.
+func TestDropFirstN(t *testing.T) {
+	assert := assert.New(t)
+
+	for name, buf := range map[string][]byte{
+		"plain":      PackUint32(nil, genMixed(blockSize)),
+		"exceptions": PackUint32(nil, genDataWithLargeExceptions()),
+		"zigzag":     PackDeltaUint32(nil, genMixed(100)),
+		"sorted":     PackDeltaUint32(nil, genMonotonic(blockSize)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			all, err := UnpackUint32(nil, buf)
+			assert.NoError(err)
+			info, err := ReadBlockInfo(buf)
+			assert.NoError(err)
+			for _, n := range []int{0, 1, 5, len(all) - 1, len(all)} {
+				out, err := DropFirstN(buf, n)
+				assert.NoError(err)
+				got, err := UnpackUint32(nil, out)
+				assert.NoError(err)
+				assert.Equal(len(all)-n, len(got), "n=%d", n)
+				if len(got) > 0 {
+					assert.Equal(all[n:], got, "n=%d", n)
+				}
+				outInfo, err := ReadBlockInfo(out)
+				assert.NoError(err)
+				assert.Equal(info.Delta, outInfo.Delta, "n=%d", n)
+			}
+		})
+	}
+
+	t.Run("sortedStaysSorted", func(t *testing.T) {
+		out, err := DropFirstN(PackDeltaUint32(nil, []uint32{100, 200, 250, 1000}), 2)
+		assert.NoError(err)
+		got, err := UnpackUint32(nil, out)
+		assert.NoError(err)
+		assert.Equal([]uint32{250, 1000}, got)
+		sorted, err := IsMonotonic(out)
+		assert.NoError(err)
+		assert.True(sorted)
+	})
+
+	t.Run("markersKept", func(t *testing.T) {
+		out, err := DropFirstN(PackUint16(nil, []uint16{9, 1, 5}), 1)
+		assert.NoError(err)
+		got16, err := UnpackUint16(nil, out)
+		assert.NoError(err)
+		assert.Equal([]uint16{1, 5}, got16)
+
+		out, err = DropFirstN(PackInt32(nil, []int32{-3, 0, 5, -1 << 31}), 1)
+		assert.NoError(err)
+		got32, err := UnpackInt32(nil, out)
+		assert.NoError(err)
+		assert.Equal([]int32{0, 5, -1 << 31}, got32)
+
+		out, err = DropFirstN(PackFloat32(nil, []float32{1.5, -2, math.Pi}), 2)
+		assert.NoError(err)
+		gotF, err := UnpackFloat32(nil, out)
+		assert.NoError(err)
+		assert.Equal([]float32{math.Pi}, gotF)
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		_, err := DropFirstN(PackFloat64(nil, []float64{1, 2}), 1)
+		assert.ErrorIs(err, ErrInvalidFlags)
+	})
+
+	t.Run("outOfRange", func(t *testing.T) {
+		buf := PackUint32(nil, []uint32{1, 2, 3})
+		_, err := DropFirstN(buf, -1)
+		assert.ErrorIs(err, ErrInvalidBlockLength)
+		_, err = DropFirstN(buf, 4)
+		assert.ErrorIs(err, ErrInvalidBlockLength)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := DropFirstN([]byte{1, 2}, 0)
+		assert.ErrorIs(err, ErrInvalidBuffer)
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		buf := PackAlreadyDeltaUint32(nil, []uint32{math.MaxUint32, 1, 1})
+		_, err := DropFirstN(buf, 1)
+		var overflow *ErrOverflow
+		assert.ErrorAs(err, &overflow)
+	})
+}
@@ -0,0 +1,48 @@
+package fastpfor
+
+import "fmt"
+
+// FormatVersion is the block format version written by this package. It is
+// stored in bits 24-25 of the block header. A change of the block layout
+// increments the version, so earlier blocks remain decodable through DecodeAny
+// instead of being mis-decoded with the new layout.
+const FormatVersion = 0
+
+// blockDecoders holds the decoder of each supported format version, indexed by
+// version. When the layout changes, the decoder of the previous version stays in
+// this table.
+var blockDecoders = [FormatVersion + 1]func(dst []uint32, buf []byte) ([]uint32, int, error){
+	0: UnpackUint32WithLength,
+}
+
+// headerVersion returns the format version of a raw header word.
+func headerVersion(header uint32) int {
+	return int(header>>headerVersionShift) & headerVersionMask
+}
+
+// BlockVersion returns the format version of the block at the start of buf.
+func BlockVersion(buf []byte) (int, error) {
+	if len(buf) < headerBytes {
+		return 0, fmt.Errorf("%w: buffer too small for header (need %d bytes, got %d)",
+			ErrInvalidBuffer, headerBytes, len(buf))
+	}
+	return headerVersion(bo.Uint32(buf)), nil
+}
+
+// DecodeAny decodes the block at the start of buf with the decoder of its format
+// version into dst (which will be resized as needed) and returns the number of
+// bytes consumed, like UnpackUint32WithLength. Use it for data that may have been
+// written by earlier releases.
+//
+// Returns ErrUnsupportedFeature if the block has a newer format version than
+// FormatVersion, regardless of SetRelaxedHeaders.
+func DecodeAny(dst []uint32, buf []byte) ([]uint32, int, error) {
+	version, err := BlockVersion(buf)
+	if err != nil {
+		return nil, 0, err
+	}
+	if version >= len(blockDecoders) {
+		return nil, 0, fmt.Errorf("%w: format version %d is newer than %d", ErrUnsupportedFeature, version, FormatVersion)
+	}
+	return blockDecoders[version](dst, buf)
+}
@@ -0,0 +1,61 @@
+package fastpfor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestFormatVersion verifies that blocks carry the format version and that newer
+// versions are rejected by all decoders.
+func TestFormatVersion(t *testing.T) {
+	assert := assert.New(t)
+	t.Cleanup(func() { SetRelaxedHeaders(false) })
+
+	values := genDataWithSmallExceptions()
+	for _, buf := range [][]byte{
+		PackUint32(nil, values),
+		PackDeltaUint32(nil, genMixed(blockSize)),
+		PackUint16(nil, []uint16{1, 2, 3}),
+		PackUint32(nil, nil),
+	} {
+		version, err := BlockVersion(buf)
+		assert.NoError(err)
+		assert.Equal(FormatVersion, version)
+		info, err := ReadBlockInfo(buf)
+		assert.NoError(err)
+		assert.Equal(FormatVersion, info.Version)
+	}
+
+	buf := PackUint32([]byte{0xAA}, values)
+	decoded, n, err := DecodeAny([]uint32{1, 2, 3}, buf[1:])
+	assert.NoError(err)
+	assert.Equal(len(buf)-1, n)
+	assert.Equal(values, decoded)
+
+	newer := PackUint32(nil, values)
+	bo.PutUint32(newer, bo.Uint32(newer)|(FormatVersion+1)<<headerVersionShift)
+	version, err := BlockVersion(newer)
+	assert.NoError(err)
+	assert.Equal(FormatVersion+1, version)
+	_, _, err = DecodeAny(nil, newer)
+	assert.ErrorIs(err, ErrUnsupportedFeature)
+	_, err = UnpackUint32(nil, newer)
+	assert.ErrorIs(err, ErrUnsupportedFeature)
+	assert.ErrorIs(NewReader().Load(newer), ErrUnsupportedFeature)
+	assert.ErrorIs(NewSlimReader().Load(newer), ErrUnsupportedFeature)
+
+	// Relaxed decoding reads newer blocks with the current layout, DecodeAny does not
+	SetRelaxedHeaders(true)
+	decoded, err = UnpackUint32(nil, newer)
+	assert.NoError(err)
+	assert.Equal(values, decoded)
+	_, _, err = DecodeAny(nil, newer)
+	assert.ErrorIs(err, ErrUnsupportedFeature)
+	SetRelaxedHeaders(false)
+
+	_, err = BlockVersion([]byte{1, 2})
+	assert.ErrorIs(err, ErrInvalidBuffer)
+	_, _, err = DecodeAny(nil, nil)
+	assert.ErrorIs(err, ErrInvalidBuffer)
+}